@@ -8,6 +8,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 
@@ -17,6 +18,7 @@ import (
 
 	"storj.io/storj/internal/fpath"
 	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/health"
 	"storj.io/storj/pkg/miniogw"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/storage/streams"
@@ -30,6 +32,7 @@ type GatewayFlags struct {
 	SatelliteAddr     string `default:"localhost:7778" help:"the address to use for the satellite" setup:"true"`
 
 	miniogw.Config
+	Health health.Config
 }
 
 var (
@@ -178,9 +181,39 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 			"Perhaps your configuration is invalid?\n%s", err)
 	}
 
+	if runCfg.Health.Address != "" {
+		if err := serveHealth(ctx, metainfo); err != nil {
+			return err
+		}
+	}
+
 	return runCfg.Run(ctx)
 }
 
+// serveHealth serves the http probes of the gateway in the background, the
+// gateway is ready while it's able to reach the satellite
+func serveHealth(ctx context.Context, metainfo storj.Metainfo) error {
+	service := health.NewService(runCfg.Health)
+	service.Add("satellite", func(ctx context.Context) error {
+		_, err := metainfo.ListBuckets(ctx, storj.BucketListOptions{Direction: storj.After, Limit: 1})
+		return err
+	})
+
+	listener, err := net.Listen("tcp", runCfg.Health.Address)
+	if err != nil {
+		return err
+	}
+
+	server := health.NewServer(service, listener)
+	go func() {
+		err := server.Run(ctx)
+		if err != nil && err != http.ErrServerClosed {
+			zap.S().Error("health server died: ", err)
+		}
+	}()
+	return nil
+}
+
 func generateKey() (key string, err error) {
 	var buf [20]byte
 	_, err = rand.Read(buf[:])
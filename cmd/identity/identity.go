@@ -4,6 +4,7 @@
 package main
 
 import (
+	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
@@ -99,7 +100,7 @@ func cmdLeafExtensions(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	return printExtensions(ident.Leaf.Raw, ident.Leaf.ExtraExtensions)
+	return printExtensions(os.Stdout, ident.Leaf.Raw, ident.Leaf.ExtraExtensions)
 }
 
 func cmdRevokeLeaf(cmd *cobra.Command, args []string) (err error) {
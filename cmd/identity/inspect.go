@@ -0,0 +1,107 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/peertls"
+)
+
+var (
+	inspectCmd = &cobra.Command{
+		Use:         "inspect [service]",
+		Short:       "Print the node ID, difficulty, chain validity and extensions of an identity",
+		Args:        cobra.MaximumNArgs(1),
+		RunE:        cmdInspect,
+		Annotations: map[string]string{"type": "setup"},
+	}
+
+	inspectCfg struct {
+		Identity identity.PeerConfig
+	}
+)
+
+func init() {
+	rootCmd.AddCommand(inspectCmd)
+
+	cfgstruct.Bind(inspectCmd.Flags(), &inspectCfg, cfgstruct.IdentityDir(defaultIdentityDir))
+}
+
+func cmdInspect(cmd *cobra.Command, args []string) (err error) {
+	if len(args) > 0 {
+		inspectCfg.Identity = identity.PeerConfig{
+			CertPath: filepath.Join(identityDir, args[0], "identity.cert"),
+		}
+	}
+
+	ident, err := inspectCfg.Identity.Load()
+	if err != nil {
+		return err
+	}
+
+	return printIdentity(os.Stdout, ident, time.Now())
+}
+
+// printIdentity prints the node ID, difficulty, chain validity and extensions
+// of an identity, the validity periods are checked at now
+func printIdentity(w io.Writer, ident *identity.PeerIdentity, now time.Time) error {
+	difficulty, err := ident.ID.Difficulty()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Node ID: %s\n", ident.ID)
+	fmt.Fprintf(w, "Difficulty: %d\n", difficulty)
+
+	chain := append([]*x509.Certificate{ident.Leaf, ident.CA}, ident.RestChain...)
+	if err := peertls.VerifyPeerCertChains(nil, [][]*x509.Certificate{chain}); err != nil {
+		fmt.Fprintf(w, "Chain: invalid (%v)\n", err)
+	} else {
+		fmt.Fprintf(w, "Chain: valid (%d certificates)\n", len(chain))
+	}
+
+	printValidity(w, "Leaf", ident.Leaf, now)
+	printValidity(w, "CA", ident.CA, now)
+	for i, cert := range ident.RestChain {
+		printValidity(w, fmt.Sprintf("Parent %d", i+1), cert, now)
+	}
+
+	fmt.Fprintln(w, "Leaf:")
+	if err := printExtensions(w, ident.Leaf.Raw, ident.Leaf.ExtraExtensions); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "CA:")
+	return printExtensions(w, ident.CA.Raw, ident.CA.ExtraExtensions)
+}
+
+// printValidity prints the validity period of a certificate and whether it
+// has expired at now
+func printValidity(w io.Writer, name string, cert *x509.Certificate, now time.Time) {
+	// the certificates of identities are created without a validity period
+	// and peers don't check it
+	if cert.NotBefore.IsZero() && cert.NotAfter.IsZero() {
+		fmt.Fprintf(w, "%s certificate: valid (no validity period)\n", name)
+		return
+	}
+
+	status := "valid"
+	switch {
+	case now.Before(cert.NotBefore):
+		status = "not yet valid"
+	case now.After(cert.NotAfter):
+		status = "expired"
+	}
+	fmt.Fprintf(w, "%s certificate: %s (%s - %s)\n", name, status,
+		cert.NotBefore.Format(time.RFC3339), cert.NotAfter.Format(time.RFC3339))
+}
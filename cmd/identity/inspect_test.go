@@ -0,0 +1,70 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+)
+
+func TestInspectArgs(t *testing.T) {
+	assert.NoError(t, inspectCmd.Args(inspectCmd, nil))
+	assert.NoError(t, inspectCmd.Args(inspectCmd, []string{"storagenode"}))
+	assert.Error(t, inspectCmd.Args(inspectCmd, []string{"storagenode", "satellite"}))
+
+	assert.NotNil(t, inspectCmd.Flags().Lookup("identity.cert-path"))
+}
+
+func TestPrintValidity(t *testing.T) {
+	start := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert := &x509.Certificate{NotBefore: start, NotAfter: start.AddDate(1, 0, 0)}
+
+	for _, tt := range []struct {
+		now      time.Time
+		expected string
+	}{
+		{start.Add(-time.Hour), "CA certificate: not yet valid (2019-01-01T00:00:00Z - 2020-01-01T00:00:00Z)\n"},
+		{start.Add(time.Hour), "CA certificate: valid (2019-01-01T00:00:00Z - 2020-01-01T00:00:00Z)\n"},
+		{start.AddDate(2, 0, 0), "CA certificate: expired (2019-01-01T00:00:00Z - 2020-01-01T00:00:00Z)\n"},
+	} {
+		var out bytes.Buffer
+		printValidity(&out, "CA", cert, tt.now)
+		assert.Equal(t, tt.expected, out.String())
+	}
+
+	var out bytes.Buffer
+	printValidity(&out, "Leaf", &x509.Certificate{}, start)
+	assert.Equal(t, "Leaf certificate: valid (no validity period)\n", out.String())
+}
+
+func TestPrintIdentity(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	ident, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+	difficulty, err := ident.ID.Difficulty()
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, printIdentity(&out, ident.PeerIdentity(), time.Now()))
+
+	output := out.String()
+	assert.Contains(t, output, "Node ID: "+ident.ID.String()+"\n")
+	assert.Contains(t, output, fmt.Sprintf("Difficulty: %d\n", difficulty))
+	assert.Contains(t, output, "Chain: ")
+	assert.Contains(t, output, "Leaf certificate: valid (")
+	assert.Contains(t, output, "CA certificate: valid (")
+	assert.Contains(t, output, "Leaf:\nCert hash: ")
+	assert.Contains(t, output, "CA:\nCert hash: ")
+}
@@ -7,6 +7,7 @@ import (
 	"crypto/x509/pkix"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -193,14 +194,14 @@ func cmdAuthorize(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func printExtensions(cert []byte, exts []pkix.Extension) error {
+func printExtensions(w io.Writer, cert []byte, exts []pkix.Extension) error {
 	hash := pkcrypto.SHA256Hash(cert)
 	b64Hash, err := json.Marshal(hash)
 	if err != nil {
 		return err
 	}
-	fmt.Printf("Cert hash: %s\n", b64Hash)
-	fmt.Println("Extensions:")
+	fmt.Fprintf(w, "Cert hash: %s\n", b64Hash)
+	fmt.Fprintln(w, "Extensions:")
 	for _, e := range exts {
 		var data interface{}
 		switch e.Id.String() {
@@ -217,7 +218,7 @@ func printExtensions(cert []byte, exts []pkix.Extension) error {
 		if err != nil {
 			return err
 		}
-		fmt.Printf("\t%s: %s\n", e.Id, out)
+		fmt.Fprintf(w, "\t%s: %s\n", e.Id, out)
 	}
 	return nil
 }
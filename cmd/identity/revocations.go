@@ -4,8 +4,12 @@
 package main
 
 import (
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/base64"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 	"time"
 
@@ -14,6 +18,7 @@ import (
 
 	"storj.io/storj/pkg/cfgstruct"
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/peertls"
 )
 
 var (
@@ -25,6 +30,22 @@ var (
 		Annotations: map[string]string{"type": "setup"},
 	}
 
+	listRevocationsCmd = &cobra.Command{
+		Use:         "list [service]",
+		Short:       "Print revocation information from a revocation database",
+		Args:        cobra.MaximumNArgs(1),
+		RunE:        cmdRevocations,
+		Annotations: map[string]string{"type": "setup"},
+	}
+
+	addRevocationCmd = &cobra.Command{
+		Use:         "add <cert-chain-path> [service]",
+		Short:       "Add the revocation of a certificate chain's leaf to a revocation database",
+		Args:        cobra.RangeArgs(1, 2),
+		RunE:        cmdAddRevocation,
+		Annotations: map[string]string{"type": "setup"},
+	}
+
 	revCfg struct {
 		RevocationDBURL string `default:"bolt://$CONFDIR/revocations.db" help:"url for revocation database (e.g. bolt://some.db OR redis://127.0.0.1:6378?db=2&password=abc123)"`
 	}
@@ -32,35 +53,93 @@ var (
 
 func init() {
 	rootCmd.AddCommand(revocationsCmd)
+	revocationsCmd.AddCommand(listRevocationsCmd)
+	revocationsCmd.AddCommand(addRevocationCmd)
 
-	cfgstruct.Bind(revocationsCmd.Flags(), &revCfg, cfgstruct.ConfDir(defaultConfigDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(revocationsCmd.PersistentFlags(), &revCfg, cfgstruct.ConfDir(defaultConfigDir), cfgstruct.IdentityDir(defaultIdentityDir))
 }
 
-func cmdRevocations(cmd *cobra.Command, args []string) error {
+// openRevDB opens the revocation database of the service, or the configured
+// one when no service is given
+func openRevDB(service string) (*identity.RevocationDB, error) {
+	if service != "" {
+		revCfg.RevocationDBURL = "bolt://" + filepath.Join(configDir, service, "revocations.db")
+	}
+	return identity.NewRevDB(revCfg.RevocationDBURL)
+}
+
+func cmdRevocations(cmd *cobra.Command, args []string) (err error) {
+	var service string
 	if len(args) > 0 {
-		revCfg.RevocationDBURL = "bolt://" + filepath.Join(configDir, args[0], "revocations.db")
+		service = args[0]
 	}
 
-	revDB, err := identity.NewRevDB(revCfg.RevocationDBURL)
+	revDB, err := openRevDB(service)
 	if err != nil {
 		return err
 	}
+	defer func() { err = errs.Combine(err, revDB.Close()) }()
 
 	revs, err := revDB.List()
 	if err != nil {
 		return err
 	}
 
-	revErrs := new(errs.Group)
+	printRevocations(os.Stdout, revs)
+	return nil
+}
+
+// printRevocations prints the certificate hash, timestamp and signature of revocations
+func printRevocations(w io.Writer, revs []*peertls.Revocation) {
 	for _, rev := range revs {
-		// TODO: figure out why this doesn't base64 encode []byte fields!
-		//revJSON, err := json.MarshalIndent(rev.CertHash, "", "\t")
-		//if err != nil {
-		//	revErrs.Add(err)
-		//}
-		fmt.Printf("certificate hash: %s\n", base64.StdEncoding.EncodeToString(rev.CertHash))
-		fmt.Printf("\timestamp: %s\n", time.Unix(rev.Timestamp, 0).String())
-		fmt.Printf("\tsignature: %s\n", base64.StdEncoding.EncodeToString(rev.Signature))
+		// TODO: figure out why json.MarshalIndent doesn't base64 encode []byte fields!
+		fmt.Fprintf(w, "certificate hash: %s\n", base64.StdEncoding.EncodeToString(rev.CertHash))
+		fmt.Fprintf(w, "\timestamp: %s\n", time.Unix(rev.Timestamp, 0).String())
+		fmt.Fprintf(w, "\tsignature: %s\n", base64.StdEncoding.EncodeToString(rev.Signature))
+	}
+}
+
+// cmdAddRevocation stores the revocation extension of a leaf certificate
+// (e.g. created by `identity id revoke`) the same way a peer presenting the
+// certificate would, i.e. only if it's signed by the CA and newer than the
+// last known revocation.
+func cmdAddRevocation(cmd *cobra.Command, args []string) (err error) {
+	var service string
+	if len(args) > 1 {
+		service = args[1]
+	}
+
+	ident, err := identity.PeerConfig{CertPath: args[0]}.Load()
+	if err != nil {
+		return err
+	}
+
+	revExt, ok := revocationExtension(ident.Leaf)
+	if !ok {
+		return peertls.ErrRevocation.New("leaf certificate of %q doesn't contain a revocation", args[0])
+	}
+
+	revDB, err := openRevDB(service)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, revDB.Close()) }()
+
+	chain := append([]*x509.Certificate{ident.Leaf, ident.CA}, ident.RestChain...)
+	if err := revDB.Put(chain, revExt); err != nil {
+		return err
+	}
+
+	fmt.Printf("Added revocation for node %s\n", ident.ID)
+	return nil
+}
+
+// revocationExtension returns the revocation extension of a certificate
+func revocationExtension(cert *x509.Certificate) (pkix.Extension, bool) {
+	for _, ext := range cert.ExtraExtensions {
+		if ext.Id.Equal(peertls.ExtensionIDs[peertls.RevocationExtID]) {
+			return ext, true
+		}
 	}
-	return revErrs.Err()
+	return pkix.Extension{}, false
 }
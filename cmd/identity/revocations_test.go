@@ -0,0 +1,58 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/pkg/peertls"
+)
+
+func TestRevocationsArgs(t *testing.T) {
+	// the database url is shared by the subcommands
+	assert.NotNil(t, revocationsCmd.PersistentFlags().Lookup("revocation-dburl"))
+	for _, cmd := range []*cobra.Command{listRevocationsCmd, addRevocationCmd} {
+		assert.NotNil(t, cmd.InheritedFlags().Lookup("revocation-dburl"), cmd.Name())
+	}
+
+	assert.NoError(t, listRevocationsCmd.Args(listRevocationsCmd, []string{"storagenode"}))
+	assert.Error(t, listRevocationsCmd.Args(listRevocationsCmd, []string{"storagenode", "satellite"}))
+
+	assert.Error(t, addRevocationCmd.Args(addRevocationCmd, nil))
+	assert.NoError(t, addRevocationCmd.Args(addRevocationCmd, []string{"identity.cert"}))
+	assert.NoError(t, addRevocationCmd.Args(addRevocationCmd, []string{"identity.cert", "storagenode"}))
+	assert.Error(t, addRevocationCmd.Args(addRevocationCmd, []string{"identity.cert", "storagenode", "satellite"}))
+}
+
+func TestRevocationExtension(t *testing.T) {
+	other := pkix.Extension{Id: asn1.ObjectIdentifier{2, 999, 1}, Value: []byte{1}}
+	revocation := pkix.Extension{Id: peertls.ExtensionIDs[peertls.RevocationExtID], Value: []byte{2}}
+
+	_, ok := revocationExtension(&x509.Certificate{ExtraExtensions: []pkix.Extension{other}})
+	assert.False(t, ok)
+
+	ext, ok := revocationExtension(&x509.Certificate{ExtraExtensions: []pkix.Extension{other, revocation}})
+	assert.True(t, ok)
+	assert.Equal(t, revocation, ext)
+}
+
+func TestPrintRevocations(t *testing.T) {
+	timestamp := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+	var out bytes.Buffer
+	printRevocations(&out, []*peertls.Revocation{
+		{Timestamp: timestamp, CertHash: []byte("hash"), Signature: []byte("signature")},
+	})
+	assert.Equal(t, "certificate hash: aGFzaA==\n"+
+		"\timestamp: "+time.Unix(timestamp, 0).String()+"\n"+
+		"\tsignature: c2lnbmF0dXJl\n", out.String())
+}
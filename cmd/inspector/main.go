@@ -12,6 +12,8 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
@@ -19,6 +21,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/storj"
@@ -105,6 +108,44 @@ var (
 		Args:  cobra.MinimumNArgs(1),
 		RunE:  CreateCSVStats,
 	}
+	listDisqualifiedCmd = &cobra.Command{
+		Use:   "disqualified [limit]",
+		Short: "List the disqualified nodes, most recently disqualified first",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  ListDisqualified,
+	}
+	reinstateCmd = &cobra.Command{
+		Use:   "reinstate <node_id>",
+		Short: "Lift the disqualification of a node",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  Reinstate,
+	}
+	healthCmd = &cobra.Command{
+		Use:   "health",
+		Short: "commands for segment health",
+	}
+	tagsCmd = &cobra.Command{
+		Use:   "tags",
+		Short: "commands for signed node tags",
+	}
+	setTagsCmd = &cobra.Command{
+		Use:   "set <node_id> <name=value>...",
+		Short: "Sign tags of a node with the inspector identity and store them on the satellite",
+		Args:  cobra.MinimumNArgs(2),
+		RunE:  SetNodeTags,
+	}
+	getTagsCmd = &cobra.Command{
+		Use:   "get <node_id>",
+		Short: "Get the signed tags of a node",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  GetNodeTags,
+	}
+	segmentHealthCmd = &cobra.Command{
+		Use:   "segment <project_id> <bucket> <encrypted_path> [segment_index]",
+		Short: "Get the health of a segment, the last segment by default",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE:  SegmentHealth,
+	}
 )
 
 // Inspector gives access to kademlia and overlay cache
@@ -113,6 +154,7 @@ type Inspector struct {
 	kadclient     pb.KadInspectorClient
 	overlayclient pb.OverlayInspectorClient
 	statdbclient  pb.StatDBInspectorClient
+	healthclient  pb.HealthInspectorClient
 }
 
 // NewInspector creates a new gRPC inspector server for access to kad
@@ -140,6 +182,7 @@ func NewInspector(address, path string) (*Inspector, error) {
 		kadclient:     pb.NewKadInspectorClient(conn),
 		overlayclient: pb.NewOverlayInspectorClient(conn),
 		statdbclient:  pb.NewStatDBInspectorClient(conn),
+		healthclient:  pb.NewHealthInspectorClient(conn),
 	}, nil
 }
 
@@ -346,6 +389,58 @@ func CreateStats(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// ListDisqualified lists the disqualified nodes
+func ListDisqualified(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	var limit int64
+	if len(args) > 0 {
+		limit, err = strconv.ParseInt(args[0], 10, 32)
+		if err != nil {
+			return ErrArgs.New("limit must be an int")
+		}
+	}
+
+	res, err := i.statdbclient.ListDisqualified(context.Background(), &pb.ListDisqualifiedRequest{
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	for _, node := range res.Nodes {
+		fmt.Printf("%s disqualified at %s: %s\n", node.NodeId,
+			time.Unix(node.DisqualifiedAtUnixSec, 0).UTC().Format(time.RFC3339), node.Reason)
+	}
+	return nil
+}
+
+// Reinstate lifts the disqualification of a node
+func Reinstate(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	_, err = i.statdbclient.Reinstate(context.Background(), &pb.ReinstateRequest{
+		NodeId: nodeID,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Reinstated node %s\n", nodeID)
+	return nil
+}
+
 // CreateCSVStats creates node with stats in statdb based on a CSV
 func CreateCSVStats(cmd *cobra.Command, args []string) (err error) {
 	i, err := NewInspector(*Addr, *IdentityPath)
@@ -402,9 +497,104 @@ func CreateCSVStats(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// SegmentHealth gets the health of a segment
+func SegmentHealth(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	segmentIndex := int64(-1)
+	if len(args) > 3 {
+		segmentIndex, err = strconv.ParseInt(args[3], 10, 64)
+		if err != nil {
+			return ErrArgs.New("segment index must be an int")
+		}
+	}
+
+	res, err := i.healthclient.SegmentHealth(context.Background(), &pb.SegmentHealthRequest{
+		ProjectId:     args[0],
+		Bucket:        args[1],
+		EncryptedPath: []byte(args[2]),
+		SegmentIndex:  segmentIndex,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Println(prettyPrint(res))
+	return nil
+}
+
+// SetNodeTags signs tags of a node and stores them on the satellite
+func SetNodeTags(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	parsed, err := overlay.ParseTags(strings.Join(args[1:], ","))
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	var tags []*pb.NodeTag
+	for name, value := range parsed {
+		tag := &pb.NodeTag{
+			NodeId:          nodeID,
+			Name:            name,
+			Value:           value,
+			SignedAtUnixSec: time.Now().Unix(),
+		}
+		if err := overlay.SignTag(tag, i.identity); err != nil {
+			return err
+		}
+		tags = append(tags, tag)
+	}
+
+	_, err = i.overlayclient.SetNodeTags(context.Background(), &pb.SetNodeTagsRequest{Tags: tags})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Printf("Stored %d tags of node %s\n", len(tags), nodeID)
+	return nil
+}
+
+// GetNodeTags outputs the signed tags of a node
+func GetNodeTags(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr, *IdentityPath)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return ErrArgs.Wrap(err)
+	}
+
+	res, err := i.overlayclient.GetNodeTags(context.Background(), &pb.GetNodeTagsRequest{NodeId: nodeID})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	for _, tag := range res.Tags {
+		fmt.Printf("%s=%s (signed by %s at %s)\n", tag.Name, tag.Value, tag.SignerId,
+			time.Unix(tag.SignedAtUnixSec, 0).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(kadCmd)
 	rootCmd.AddCommand(statsCmd)
+	rootCmd.AddCommand(healthCmd)
+	rootCmd.AddCommand(tagsCmd)
 
 	kadCmd.AddCommand(countNodeCmd)
 	kadCmd.AddCommand(pingNodeCmd)
@@ -415,6 +605,13 @@ func init() {
 	statsCmd.AddCommand(getCSVStatsCmd)
 	statsCmd.AddCommand(createStatsCmd)
 	statsCmd.AddCommand(createCSVStatsCmd)
+	statsCmd.AddCommand(listDisqualifiedCmd)
+	statsCmd.AddCommand(reinstateCmd)
+
+	healthCmd.AddCommand(segmentHealthCmd)
+
+	tagsCmd.AddCommand(setTagsCmd)
+	tagsCmd.AddCommand(getTagsCmd)
 
 	flag.Parse()
 }
@@ -0,0 +1,66 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/auditlog"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func cmdAuditLog(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	layout := "2006-01-02"
+	start, err := time.Parse(layout, args[0])
+	if err != nil {
+		return errs.New("Invalid date format. Please use YYYY-MM-DD")
+	}
+	end, err := time.Parse(layout, args[1])
+	if err != nil {
+		return errs.New("Invalid date format. Please use YYYY-MM-DD")
+	}
+
+	// Ensure that start date is not after end date
+	if start.After(end) {
+		return errs.New("Invalid time period (%v) - (%v)", start, end)
+	}
+
+	db, err := satellitedb.NewWithReplicas(auditLogCfg.Database, auditLogCfg.Replicas)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	log := auditlog.New(zap.L(), db.AuditLog())
+
+	// the end date is included in the export
+	end = end.Add(24 * time.Hour)
+
+	// send output to stdout
+	if auditLogCfg.Output == "" {
+		return log.Export(ctx, start, end, os.Stdout)
+	}
+
+	// send output to file
+	file, err := os.Create(auditLogCfg.Output)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err = errs.Combine(err, file.Close())
+	}()
+
+	return log.Export(ctx, start, end, file)
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/bwagreement"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func cmdReplay(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	identity, err := replayCfg.Identity.Load()
+	if err != nil {
+		return errs.New("error loading satellite identity: %+v", err)
+	}
+
+	db, err := satellitedb.New(replayCfg.Database)
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	dump, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errs.Combine(err, dump.Close())
+	}()
+
+	server := bwagreement.NewServer(db.BandwidthAgreement(), db.CertDB(), identity.Leaf.PublicKey, zap.L(), identity.ID)
+	stats, err := server.Replay(ctx, dump)
+
+	fmt.Printf("stored %d, already stored %d, invalid %d agreements\n", stats.Stored, stats.Duplicate, stats.Invalid)
+	return err
+}
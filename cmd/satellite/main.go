@@ -16,7 +16,10 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/fpath"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/satellitedb"
@@ -25,6 +28,7 @@ import (
 // Satellite defines satellite configuration
 type Satellite struct {
 	Database string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+	Replicas satellitedb.ReplicaConfig
 
 	satellite.Config
 }
@@ -66,20 +70,52 @@ var (
 		Args:  cobra.MinimumNArgs(2),
 		RunE:  cmdPayments,
 	}
+	auditLogCmd = &cobra.Command{
+		Use:   "audit-log [start] [end]",
+		Short: "Export the audit log of console and admin api operations for a given period",
+		Long:  "Export the audit log of console and admin api operations from the start through the end date as csv. Format dates using YYYY-MM-DD",
+		Args:  cobra.MinimumNArgs(2),
+		RunE:  cmdAuditLog,
+	}
+	bwagreementCmd = &cobra.Command{
+		Use:   "bwagreement",
+		Short: "Manage bandwidth agreements",
+	}
+	replayCmd = &cobra.Command{
+		Use:   "replay [dump]",
+		Short: "Verify and store the bandwidth agreements of a dump",
+		Long:  "Verify and store the bandwidth agreements of a dump, agreements which are already stored are skipped, so a dump can be replayed more than once",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdReplay,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
 
 	diagCfg struct {
 		Database string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+		Replicas satellitedb.ReplicaConfig
+		OrderBy  string `help:"order of the uplinks: id, bytes or transactions" default:"id"`
+		Limit    int    `help:"maximum number of uplinks shown, 0 shows every uplink" default:"0"`
 	}
 	qdiagCfg struct {
 		Database   string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
 		QListLimit int    `help:"maximum segments that can be requested" default:"1000"`
 	}
 	paymentsCfg struct {
+		Database     string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+		Replicas     satellitedb.ReplicaConfig
+		Output       string `help:"destination of report output" default:""`
+		PayoutMethod string `help:"only include nodes preferring this payout method (l1 or zksync), empty includes all nodes" default:""`
+	}
+	auditLogCfg struct {
 		Database string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
-		Output   string `help:"destination of report output" default:""`
+		Replicas satellitedb.ReplicaConfig
+		Output   string `help:"destination of the exported audit log" default:""`
+	}
+	replayCfg struct {
+		Database string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+		Identity identity.Config
 	}
 
 	defaultConfDir = fpath.ApplicationDir("storj", "satellite")
@@ -116,11 +152,16 @@ func init() {
 	rootCmd.AddCommand(qdiagCmd)
 	rootCmd.AddCommand(reportsCmd)
 	reportsCmd.AddCommand(paymentsCmd)
+	reportsCmd.AddCommand(auditLogCmd)
+	rootCmd.AddCommand(bwagreementCmd)
+	bwagreementCmd.AddCommand(replayCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.Bind(diagCmd.Flags(), &diagCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.Bind(qdiagCmd.Flags(), &qdiagCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.Bind(paymentsCmd.Flags(), &paymentsCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(auditLogCmd.Flags(), &auditLogCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(replayCmd.Flags(), &replayCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -136,7 +177,7 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		zap.S().Error("Failed to initialize telemetry batcher: ", err)
 	}
 
-	db, err := satellitedb.New(runCfg.Database)
+	db, err := satellitedb.NewWithReplicas(runCfg.Database, runCfg.Replicas)
 
 	if err != nil {
 		return errs.New("Error starting master database on satellite: %+v", err)
@@ -156,6 +197,8 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	registerReloads(peer)
+
 	runError := peer.Run(ctx)
 	closeError := peer.Close()
 	return errs.Combine(runError, closeError)
@@ -181,7 +224,7 @@ func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 }
 
 func cmdDiag(cmd *cobra.Command, args []string) (err error) {
-	database, err := satellitedb.New(diagCfg.Database)
+	database, err := satellitedb.NewWithReplicas(diagCfg.Database, diagCfg.Replicas)
 	if err != nil {
 		return errs.New("error connecting to master database on satellite: %+v", err)
 	}
@@ -192,11 +235,14 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 		}
 	}()
 
-	//get all bandwidth agreements rows already ordered
-	stats, err := database.BandwidthAgreement().GetUplinkStats(context.Background(), time.Time{}, time.Now())
-	if err != nil {
-		fmt.Printf("error reading satellite database %v: %v\n", diagCfg.Database, err)
-		return err
+	orders := map[string]bwagreement.UplinkStatsOrder{
+		"id":           bwagreement.OrderByUplinkID,
+		"bytes":        bwagreement.OrderByTotalBytes,
+		"transactions": bwagreement.OrderByTotalTransactions,
+	}
+	order, ok := orders[diagCfg.OrderBy]
+	if !ok {
+		return errs.New("unknown order %q, expected id, bytes or transactions", diagCfg.OrderBy)
 	}
 
 	// initialize the table header (fields)
@@ -204,9 +250,29 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, padding, ' ', tabwriter.AlignRight|tabwriter.Debug)
 	fmt.Fprintln(w, "UplinkID\tTotal\t# Of Transactions\tPUT Action\tGET Action\t")
 
-	// populate the row fields
-	for _, s := range stats {
-		fmt.Fprint(w, s.NodeID, "\t", s.TotalBytes, "\t", s.TotalTransactions, "\t", s.PutActionCount, "\t", s.GetActionCount, "\t\n")
+	// page through the bandwidth agreements of every uplink
+	opts := bwagreement.UplinkStatsOptions{To: time.Now(), OrderBy: order, Limit: 1000}
+	shown := 0
+	for {
+		if diagCfg.Limit > 0 && diagCfg.Limit-shown < opts.Limit {
+			opts.Limit = diagCfg.Limit - shown
+		}
+		page, err := database.BandwidthAgreement().GetUplinkStatsPage(context.Background(), opts)
+		if err != nil {
+			fmt.Printf("error reading satellite database %v: %v\n", diagCfg.Database, err)
+			return err
+		}
+
+		// populate the row fields
+		for _, s := range page.Stats {
+			fmt.Fprint(w, s.NodeID, "\t", s.TotalBytes, "\t", s.TotalTransactions, "\t", s.PutActionCount, "\t", s.GetActionCount, "\t\n")
+		}
+		shown += len(page.Stats)
+
+		if page.Next == nil || (diagCfg.Limit > 0 && shown >= diagCfg.Limit) {
+			break
+		}
+		opts.Cursor = page.Next
 	}
 
 	// display the data
@@ -264,6 +330,12 @@ func cmdPayments(cmd *cobra.Command, args []string) (err error) {
 		return errs.New("Invalid time period (%v) - (%v)", start, end)
 	}
 
+	switch paymentsCfg.PayoutMethod {
+	case "", accounting.PayoutMethodL1, accounting.PayoutMethodZkSync:
+	default:
+		return errs.New("Invalid payout method %q", paymentsCfg.PayoutMethod)
+	}
+
 	// send output to stdout
 	if paymentsCfg.Output == "" {
 		return generateCSV(ctx, start, end, os.Stdout)
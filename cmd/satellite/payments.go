@@ -15,12 +15,13 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/satellite/satellitedb"
 )
 
 // generateCSV generates a payment report for all nodes for a given period
 func generateCSV(ctx context.Context, start time.Time, end time.Time, output io.Writer) error {
-	db, err := satellitedb.New(paymentsCfg.Database)
+	db, err := satellitedb.NewWithReplicas(paymentsCfg.Database, paymentsCfg.Replicas)
 	if err != nil {
 		return errs.New("error connecting to master database on satellite: %+v", err)
 	}
@@ -46,19 +47,39 @@ func generateCSV(ctx context.Context, start time.Time, end time.Time, output io.
 		"bytes:BWPut",
 		"date",
 		"walletAddress",
+		"payoutMethod",
+		"walletValidatedAt",
 	}
 	if err := w.Write(headers); err != nil {
 		return err
 	}
 
 	for _, row := range rows {
+		if paymentsCfg.PayoutMethod != "" && row.PayoutMethod != paymentsCfg.PayoutMethod {
+			continue
+		}
 		nid := row.NodeID
 		wallet, err := db.OverlayCache().GetWalletAddress(ctx, nid)
 		if err != nil {
 			return err
 		}
+		// wallets stored before they were validated may be malformed, they're
+		// left out, so the node is handled like a node without a wallet
+		if wallet != "" && overlay.ValidateWallet(wallet) != nil {
+			wallet = ""
+		}
 		row.Wallet = wallet
+		validation, err := db.OverlayCache().GetMetadataValidation(ctx, nid)
+		if err != nil {
+			return err
+		}
+
 		record := structToStringSlice(row)
+		if validation.WalletValidatedAt.IsZero() {
+			record = append(record, "")
+		} else {
+			record = append(record, validation.WalletValidatedAt.UTC().Format(time.RFC3339))
+		}
 		if err := w.Write(record); err != nil {
 			return err
 		}
@@ -86,6 +107,7 @@ func structToStringSlice(s *accounting.CSVRow) []string {
 		strconv.FormatInt(s.PutTotal, 10),
 		s.Date.Format("2006-01-02"),
 		s.Wallet,
+		s.PayoutMethod,
 	}
 	return record
 }
@@ -0,0 +1,54 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"strconv"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/satellite"
+)
+
+// registerReloads makes the settings that can change without a restart reloadable
+func registerReloads(peer *satellite.Peer) {
+	process.OnReload(func(values map[string]string) (err error) {
+		parseFloat := func(key string) float64 {
+			value, parseErr := strconv.ParseFloat(values[key], 64)
+			err = errs.Combine(err, parseErr)
+			return value
+		}
+		parseInt := func(key string) int64 {
+			value, parseErr := strconv.ParseInt(values[key], 10, 64)
+			err = errs.Combine(err, parseErr)
+			return value
+		}
+
+		preferences := &overlay.NodeSelectionConfig{
+			UptimeRatio:           parseFloat("overlay.node.uptime-ratio"),
+			UptimeCount:           parseInt("overlay.node.uptime-count"),
+			AuditSuccessRatio:     parseFloat("overlay.node.audit-success-ratio"),
+			AuditCount:            parseInt("overlay.node.audit-count"),
+			NewNodeAuditThreshold: parseInt("overlay.node.new-node-audit-threshold"),
+			NewNodePercentage:     parseFloat("overlay.node.new-node-percentage"),
+			UploadSuccessRatio:    parseFloat("overlay.node.upload-success-ratio"),
+		}
+		if err != nil {
+			return errs.New("invalid node selection settings: %v", err)
+		}
+
+		peer.Overlay.Endpoint.SetPreferences(preferences)
+		return nil
+	},
+		"overlay.node.uptime-ratio",
+		"overlay.node.uptime-count",
+		"overlay.node.audit-success-ratio",
+		"overlay.node.audit-count",
+		"overlay.node.new-node-audit-threshold",
+		"overlay.node.new-node-percentage",
+		"overlay.node.upload-success-ratio",
+	)
+}
@@ -115,6 +115,32 @@ func dashCmd(cmd *cobra.Command, args []string) (err error) {
 			color.Yellow("Loading...\n")
 		}
 
+		if usage := data.GetUsage(); len(usage) > 0 {
+			w = tabwriter.NewWriter(color.Output, 0, 0, 5, ' ', tabwriter.AlignRight)
+			fmt.Fprintf(w, "\nSatellite\t%s\t%s\t%s\t%s\t\n", color.GreenString("Disk"), color.GreenString("Pieces"), color.GreenString("Ingress"), color.GreenString("Egress"))
+			for _, satellite := range usage {
+				ingress := satellite.GetPutBandwidth() + satellite.GetPutRepairBandwidth()
+				egress := satellite.GetGetBandwidth() + satellite.GetGetAuditBandwidth() + satellite.GetGetRepairBandwidth()
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", satellite.SatelliteId,
+					color.WhiteString(memory.Size(satellite.GetUsedSpace()).Base10String()), whiteInt(satellite.GetPieces()),
+					color.WhiteString(memory.Size(ingress).Base10String()), color.WhiteString(memory.Size(egress).Base10String()))
+			}
+			if err = w.Flush(); err != nil {
+				return err
+			}
+		}
+
+		if payouts := data.GetPayouts(); len(payouts) > 0 {
+			w = tabwriter.NewWriter(color.Output, 0, 0, 5, ' ', tabwriter.AlignRight)
+			fmt.Fprintf(w, "\nMonth-end estimate\t%s\t%s\t\n", color.GreenString("Payout"), color.GreenString("Held"))
+			for _, payout := range payouts {
+				fmt.Fprintf(w, "%s\t%s\t%s\t\n", payout.SatelliteId, whiteDollars(payout.GetPayout()), whiteDollars(payout.GetHeld()))
+			}
+			if err = w.Flush(); err != nil {
+				return err
+			}
+		}
+
 		w = tabwriter.NewWriter(color.Output, 0, 0, 1, ' ', 0)
 		// TODO: Get addresses from server data
 		fmt.Fprintf(w, "\nBootstrap\t%s\n", color.WhiteString(data.GetBootstrapAddress()))
@@ -124,6 +150,24 @@ func dashCmd(cmd *cobra.Command, args []string) (err error) {
 		if err = w.Flush(); err != nil {
 			return err
 		}
+
+		if quarantined := data.GetQuarantined(); len(quarantined) > 0 {
+			w = tabwriter.NewWriter(color.Output, 0, 0, 5, ' ', tabwriter.AlignRight)
+			fmt.Fprintf(w, "\nRead errors\t%s\t\n", color.RedString("Quarantined pieces"))
+			for _, disk := range quarantined {
+				fmt.Fprintf(w, "%s\t%s\t\n", disk.GetDisk(), color.RedString(fmt.Sprintf("%d", disk.GetPieces())))
+			}
+			if err = w.Flush(); err != nil {
+				return err
+			}
+		}
+
+		for _, notification := range data.GetNotifications() {
+			if notification.GetRead() {
+				continue
+			}
+			_, _ = color.New(color.FgRed).Printf("\n%s: %s\n", notification.GetTitle(), notification.GetMessage())
+		}
 	}
 
 	return nil
@@ -133,6 +177,10 @@ func whiteInt(value int64) string {
 	return color.WhiteString(fmt.Sprintf("%+v", value))
 }
 
+func whiteDollars(cents float64) string {
+	return color.WhiteString(fmt.Sprintf("$%.2f", cents/100))
+}
+
 // clearScreen clears the screen so it can be redrawn
 func clearScreen() {
 	switch runtime.GOOS {
@@ -15,7 +15,9 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/storj"
@@ -68,6 +70,30 @@ var (
 		Short: "Display a dashbaord",
 		RunE:  dashCmd,
 	}
+	notificationsCmd = &cobra.Command{
+		Use:   "notifications",
+		Short: "List operator notifications",
+		RunE:  cmdNotifications,
+	}
+	talliesCmd = &cobra.Command{
+		Use:   "tallies",
+		Short: "Compare the bandwidth satellites recorded for this node with its own records",
+		RunE:  cmdTallies,
+	}
+	reputationCmd = &cobra.Command{
+		Use:   "reputation",
+		Short: "Display the audit and uptime statistics a satellite keeps for this node",
+		RunE:  cmdReputation,
+	}
+	migrateCmd = &cobra.Command{
+		Use:   "migrate-storage",
+		Short: "Copy pieces and databases to a new storage directory",
+		Long: "Copies pieces and databases to a new storage directory, verifying every copied file.\n" +
+			"Run it with --cutover=false while the node is running to copy most of the data,\n" +
+			"then stop the node and run it again to copy the remaining changes and switch\n" +
+			"storage.path in config.yaml to the new directory.",
+		RunE: cmdMigrateStorage,
+	}
 	runCfg   StorageNodeFlags
 	setupCfg StorageNodeFlags
 
@@ -76,6 +102,17 @@ var (
 		ExternalAddress string `default:":28967" help:"address that your node is listening on if using a tunneling service"`
 		BootstrapAddr   string `default:"bootstrap.storj.io:8888" help:"address of server the storage node was bootstrapped against"`
 	}
+	reputationCfg struct {
+		Identity      identity.Config
+		Satellite     string `default:"" help:"id of the satellite to query"`
+		SatelliteAddr string `default:"" help:"address of the satellite to query"`
+	}
+	migrateCfg struct {
+		From    string      `default:"" help:"current storage directory"`
+		To      string      `default:"" help:"new storage directory"`
+		Rate    memory.Size `default:"0" help:"maximum copy rate per second, 0 for unlimited"`
+		Cutover bool        `default:"true" help:"switch storage.path to the new directory after copying, the node must be stopped"`
+	}
 
 	defaultConfDir = fpath.ApplicationDir("storj", "storagenode")
 	// TODO: this path should be defined somewhere else
@@ -84,6 +121,8 @@ var (
 	confDir            string
 	identityDir        string
 	useColor           bool
+
+	markNotificationsRead bool
 )
 
 const (
@@ -118,11 +157,20 @@ func init() {
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(diagCmd)
 	rootCmd.AddCommand(dashboardCmd)
+	rootCmd.AddCommand(notificationsCmd)
+	rootCmd.AddCommand(talliesCmd)
+	rootCmd.AddCommand(reputationCmd)
+	rootCmd.AddCommand(migrateCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.BindSetup(configCmd.Flags(), &setupCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.Bind(diagCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
 	cfgstruct.Bind(dashboardCmd.Flags(), &dashboardCfg, cfgstruct.ConfDir(defaultDiagDir))
+	cfgstruct.Bind(notificationsCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	notificationsCmd.Flags().BoolVar(&markNotificationsRead, "mark-read", false, "mark all notifications as read")
+	cfgstruct.Bind(talliesCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(reputationCmd.Flags(), &reputationCfg, cfgstruct.ConfDir(defaultConfDir), cfgstruct.IdentityDir(defaultIdentityDir))
+	cfgstruct.Bind(migrateCmd.Flags(), &migrateCfg, cfgstruct.ConfDir(defaultConfDir))
 }
 
 func databaseConfig(config storagenode.Config) storagenodedb.Config {
@@ -171,6 +219,20 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	// bandwidth limits can be changed without a restart
+	process.OnReload(func(values map[string]string) error {
+		return peer.Storage.Endpoint.SetBandwidthLimits(
+			values["storage.satellite-ingress-limits"],
+			values["storage.satellite-egress-limits"])
+	}, "storage.satellite-ingress-limits", "storage.satellite-egress-limits")
+
+	// as can the deny list
+	process.OnReload(func(values map[string]string) error {
+		return peer.Storage.Endpoint.SetDenyList(
+			values["storage.denied-uplinks"],
+			values["storage.denied-satellites"])
+	}, "storage.denied-uplinks", "storage.denied-satellites")
+
 	runError := peer.Run(ctx)
 	closeError := peer.Close()
 
@@ -325,6 +387,38 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 	return err
 }
 
+func cmdNotifications(cmd *cobra.Command, args []string) (err error) {
+	db, err := storagenodedb.New(databaseConfig(runCfg.Config))
+	if err != nil {
+		return errs.New("Error starting master database on storagenode: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	notifications, err := db.PSDB().GetNotifications(100)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	for _, notification := range notifications {
+		status := "NEW"
+		if notification.Read {
+			status = "READ"
+		}
+		fmt.Fprint(w, notification.Created.Format("2006-01-02 15:04:05"), "\t", status, "\t", notification.Title, "\t", notification.Message, "\n")
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if markNotificationsRead {
+		return db.PSDB().MarkNotificationsRead()
+	}
+	return nil
+}
+
 func main() {
 	process.Exec(rootCmd)
 }
@@ -0,0 +1,113 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/process"
+)
+
+const storagePathKey = "storage.path"
+
+func cmdMigrateStorage(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	if migrateCfg.From == "" || migrateCfg.To == "" {
+		return errs.New("both --from and --to are required")
+	}
+	from, err := filepath.Abs(migrateCfg.From)
+	if err != nil {
+		return err
+	}
+	to, err := filepath.Abs(migrateCfg.To)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(to, 0700); err != nil {
+		return err
+	}
+
+	fmt.Printf("Copying %s to %s\n", from, to)
+	stats, err := pstore.Migrate(ctx, from, to, migrateCfg.Rate.Int64())
+	fmt.Printf("Copied %d files (%s), skipped %d unchanged files\n",
+		stats.Copied, memory.Size(stats.CopiedBytes).Base10String(), stats.Skipped)
+	if err != nil {
+		return err
+	}
+
+	if !migrateCfg.Cutover {
+		fmt.Println("Stop the storage node and run the command again with --cutover to finish the migration.")
+		return nil
+	}
+
+	configFile := filepath.Join(confDir, "config.yaml")
+	if err := setConfigValue(configFile, storagePathKey, to); err != nil {
+		return errs.New("unable to update %s: %v", configFile, err)
+	}
+	fmt.Printf("Updated %s in %s, the storage node can be started again.\n", storagePathKey, configFile)
+	fmt.Printf("Once the node is running fine, %s can be removed.\n", from)
+	return nil
+}
+
+// setConfigValue replaces the value of key in the config file, adding the
+// key when it isn't there yet. The file is replaced atomically, so a crash
+// leaves either the old or the new configuration behind.
+func setConfigValue(configFile, key, value string) (err error) {
+	data, err := ioutil.ReadFile(configFile)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	entry := key + ": " + strconv.Quote(value)
+
+	var updated bytes.Buffer
+	found := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(strings.TrimSpace(line), key+":") {
+			line, found = entry, true
+		}
+		updated.WriteString(line)
+		updated.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if !found {
+		updated.WriteString(entry)
+		updated.WriteByte('\n')
+	}
+
+	temp, err := ioutil.TempFile(filepath.Dir(configFile), filepath.Base(configFile)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(temp.Name())
+		}
+	}()
+
+	_, err = temp.Write(updated.Bytes())
+	err = errs.Combine(err, temp.Sync(), temp.Close())
+	if err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), configFile)
+}
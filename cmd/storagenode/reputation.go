@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+func cmdReputation(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	if reputationCfg.Satellite == "" || reputationCfg.SatelliteAddr == "" {
+		return errs.New("both --satellite and --satellite-addr are required")
+	}
+	satelliteID, err := storj.NodeIDFromString(reputationCfg.Satellite)
+	if err != nil {
+		return errs.New("invalid satellite id: %v", err)
+	}
+
+	ident, err := reputationCfg.Identity.Load()
+	if err != nil {
+		return err
+	}
+
+	// dialing by id verifies that we are talking to the requested satellite
+	conn, err := transport.NewClient(ident).DialNode(ctx, &pb.Node{
+		Id: satelliteID,
+		Address: &pb.NodeAddress{
+			Transport: pb.NodeTransport_TCP_TLS_GRPC,
+			Address:   reputationCfg.SatelliteAddr,
+		},
+		Type: pb.NodeType_SATELLITE,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	stats, err := pb.NewNodeStatsClient(conn).Reputation(ctx, &pb.ReputationRequest{})
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintf(w, "Node\t%s\n", stats.NodeId)
+	fmt.Fprintf(w, "Satellite\t%s\n", satelliteID)
+	fmt.Fprintf(w, "Audits\t%d successful of %d\t(%.4f)\n", stats.AuditSuccessCount, stats.AuditCount, stats.AuditRatio)
+	fmt.Fprintf(w, "Uptime checks\t%d successful of %d\t(%.4f)\n", stats.UptimeSuccessCount, stats.UptimeCount, stats.UptimeRatio)
+	return w.Flush()
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver"
+	"storj.io/storj/storagenode/storagenodedb"
+)
+
+func cmdTallies(cmd *cobra.Command, args []string) (err error) {
+	db, err := storagenodedb.New(databaseConfig(runCfg.Config))
+	if err != nil {
+		return errs.New("Error starting master database on storagenode: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	tallies, err := db.PSDB().GetNodeTallies(100)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprint(w, "Period\tSatellite\tAction\tSatellite recorded\tNode recorded\n")
+	for _, tally := range tallies {
+		start, end := time.Unix(tally.PeriodStartUnixSec, 0), time.Unix(tally.PeriodEndUnixSec, 0)
+		own, err := db.PSDB().GetSatelliteBandwidth(tally.SatelliteId, start, end)
+		if err != nil {
+			return err
+		}
+
+		different := map[pb.BandwidthAction]bool{}
+		for _, discrepancy := range psserver.CompareTally(tally, own) {
+			different[discrepancy.Action] = true
+		}
+
+		period := start.UTC().Format("2006-01-02")
+		recorded := []int64{tally.PutTotal, tally.GetTotal, tally.GetAuditTotal, tally.GetRepairTotal, tally.PutRepairTotal}
+		for i, action := range []pb.BandwidthAction{
+			pb.BandwidthAction_PUT,
+			pb.BandwidthAction_GET,
+			pb.BandwidthAction_GET_AUDIT,
+			pb.BandwidthAction_GET_REPAIR,
+			pb.BandwidthAction_PUT_REPAIR,
+		} {
+			mark := ""
+			if different[action] {
+				mark = "\t(differs)"
+			}
+			fmt.Fprint(w, period, "\t", tally.SatelliteId, "\t", action, "\t",
+				memory.Size(recorded[i]).Base10String(), "\t", memory.Size(own[action]).Base10String(), mark, "\n")
+		}
+		fmt.Fprintf(w, "%s\t%s\tAT_REST\t%.0f byte-hours\t-\n", period, tally.SatelliteId, tally.AtRestTotal)
+	}
+	return w.Flush()
+}
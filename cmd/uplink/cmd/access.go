@@ -0,0 +1,35 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/pkg/authservice"
+)
+
+func init() {
+	addCmd(&cobra.Command{
+		Use:   "access",
+		Short: "Print the access of the configured satellite, api key and encryption key, e.g. for registering it with an auth service",
+		RunE:  printAccess,
+	}, RootCmd)
+}
+
+func printAccess(cmd *cobra.Command, args []string) error {
+	access := authservice.Access{
+		SatelliteAddr: cfg.Client.PointerDBAddr,
+		APIKey:        cfg.Client.APIKey,
+		EncryptionKey: cfg.Enc.Key,
+		KeyDerivation: cfg.Enc.KeyDerivation,
+	}
+	serialized, err := access.Serialize()
+	if err != nil {
+		return err
+	}
+	fmt.Println(serialized)
+	return nil
+}
@@ -0,0 +1,273 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/process"
+	ecclient "storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/stream"
+)
+
+var benchmarkCfg struct {
+	satellite string
+	sizes     []string
+	count     int
+	bucket    string
+}
+
+func init() {
+	benchmarkCmd := addCmd(&cobra.Command{
+		Use:   "benchmark",
+		Short: "Uploads and downloads synthetic objects and reports the throughput and latency",
+		RunE:  benchmarkMain,
+	}, RootCmd)
+	benchmarkCmd.Flags().StringVar(&benchmarkCfg.satellite, "satellite", "", "address of the satellite to benchmark, defaults to the configured satellite")
+	benchmarkCmd.Flags().StringSliceVar(&benchmarkCfg.sizes, "sizes", []string{"1KiB", "1MiB", "64MiB"}, "sizes of the uploaded objects")
+	benchmarkCmd.Flags().IntVar(&benchmarkCfg.count, "count", 3, "how many objects of each size are uploaded and downloaded")
+	benchmarkCmd.Flags().StringVar(&benchmarkCfg.bucket, "bucket", "benchmark", "bucket for the synthetic objects, it's created and removed when it doesn't exist")
+}
+
+// benchmarkResult contains the measurements of transferring objects of the same size
+type benchmarkResult struct {
+	size memory.Size
+
+	upload   []time.Duration
+	download []time.Duration
+	// firstByte is the time until the first byte of a download was read
+	firstByte []time.Duration
+}
+
+// nodeObserver collects the duration of piece uploads per node
+type nodeObserver struct {
+	mu       sync.Mutex
+	success  map[storj.NodeID][]time.Duration
+	failures map[storj.NodeID]int
+}
+
+// ObservePut records the outcome of a piece upload
+func (observer *nodeObserver) ObservePut(node storj.NodeID, outcome ecclient.PutOutcome, duration time.Duration) {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	switch outcome {
+	case ecclient.PutSuccess:
+		observer.success[node] = append(observer.success[node], duration)
+	case ecclient.PutCanceled:
+		// long tail cancellation isn't a fault of the node
+	default:
+		observer.failures[node]++
+	}
+}
+
+func benchmarkMain(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	sizes, err := parseBenchmarkSizes(benchmarkCfg.sizes, benchmarkCfg.count)
+	if err != nil {
+		return err
+	}
+
+	if benchmarkCfg.satellite != "" {
+		cfg.Client.OverlayAddr = benchmarkCfg.satellite
+		cfg.Client.PointerDBAddr = benchmarkCfg.satellite
+	}
+
+	identity, err := cfg.Identity.Load()
+	if err != nil {
+		return err
+	}
+
+	observer := &nodeObserver{
+		success:  map[storj.NodeID][]time.Duration{},
+		failures: map[storj.NodeID]int{},
+	}
+	metainfo, streams, err := cfg.GetObservedMetainfo(ctx, identity, observer)
+	if err != nil {
+		return err
+	}
+
+	bucket := benchmarkCfg.bucket
+	_, err = metainfo.GetBucket(ctx, bucket)
+	if storj.ErrBucketNotFound.Has(err) {
+		_, err = metainfo.CreateBucket(ctx, bucket, &storj.Bucket{PathCipher: storj.Cipher(cfg.Enc.PathType)})
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, metainfo.DeleteBucket(ctx, bucket)) }()
+	} else if err != nil {
+		return err
+	}
+
+	var results []*benchmarkResult
+	for _, size := range sizes {
+		result := &benchmarkResult{size: size}
+		results = append(results, result)
+
+		for i := 0; i < benchmarkCfg.count; i++ {
+			path := fmt.Sprintf("benchmark-%d-%d-%d", time.Now().UnixNano(), size.Int64(), i)
+			err := benchmarkObject(ctx, metainfo, streams, bucket, path, size, result)
+			// the object is removed even if transferring it failed
+			if deleteErr := metainfo.DeleteObject(ctx, bucket, path); deleteErr != nil && !storj.ErrObjectNotFound.Has(deleteErr) {
+				err = errs.Combine(err, deleteErr)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	printBenchmarkResults(os.Stdout, results, observer)
+	return nil
+}
+
+// parseBenchmarkSizes parses the sizes of the benchmarked objects and checks
+// that at least one object of each size is transferred
+func parseBenchmarkSizes(values []string, count int) ([]memory.Size, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("count must be positive")
+	}
+
+	sizes := make([]memory.Size, len(values))
+	for i, s := range values {
+		if err := sizes[i].Set(s); err != nil {
+			return nil, fmt.Errorf("invalid size %q: %v", s, err)
+		}
+	}
+	return sizes, nil
+}
+
+// benchmarkObject uploads and downloads a single object of random data
+func benchmarkObject(ctx context.Context, metainfo storj.Metainfo, streams streams.Store, bucket, path string, size memory.Size, result *benchmarkResult) (err error) {
+	createInfo := storj.CreateObject{
+		RedundancyScheme: cfg.GetRedundancyScheme(),
+		EncryptionScheme: cfg.GetEncryptionScheme(),
+	}
+	obj, err := metainfo.CreateObject(ctx, bucket, path, &createInfo)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	if err := uploadStream(ctx, streams, obj, io.LimitReader(rand.Reader, size.Int64())); err != nil {
+		return err
+	}
+	result.upload = append(result.upload, time.Since(start))
+
+	start = time.Now()
+	readOnlyStream, err := metainfo.GetObjectStream(ctx, bucket, path)
+	if err != nil {
+		return err
+	}
+
+	download := stream.NewDownload(ctx, readOnlyStream, streams)
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	// the first read waits for the first segment to be retrieved
+	var first [1]byte
+	n, err := download.Read(first[:])
+	if err != nil && err != io.EOF {
+		return err
+	}
+	result.firstByte = append(result.firstByte, time.Since(start))
+
+	rest, err := io.Copy(ioutil.Discard, download)
+	if err != nil {
+		return err
+	}
+	result.download = append(result.download, time.Since(start))
+
+	if int64(n)+rest != size.Int64() {
+		return fmt.Errorf("downloaded %d bytes of %s, expected %d", int64(n)+rest, path, size.Int64())
+	}
+	return nil
+}
+
+// printBenchmarkResults prints the throughput and latency per object size
+// and the distribution of piece upload durations over the nodes
+func printBenchmarkResults(w io.Writer, results []*benchmarkResult, observer *nodeObserver) {
+	for _, result := range results {
+		fmt.Fprintf(w, "Size %s (%d objects):\n", result.size.Base2String(), len(result.upload))
+		fmt.Fprintf(w, "\tupload:     %s\n", formatThroughput(result.size, result.upload))
+		fmt.Fprintf(w, "\tdownload:   %s\n", formatThroughput(result.size, result.download))
+		fmt.Fprintf(w, "\tfirst byte: %s\n", formatDurations(result.firstByte))
+	}
+
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	nodes := map[storj.NodeID]struct{}{}
+	for node := range observer.success {
+		nodes[node] = struct{}{}
+	}
+	for node := range observer.failures {
+		nodes[node] = struct{}{}
+	}
+
+	// the median piece upload duration of every node, to show how the
+	// performance is distributed over the nodes
+	var medians []time.Duration
+	for node := range observer.success {
+		medians = append(medians, percentile(observer.success[node], 0.5))
+	}
+
+	failed := 0
+	for _, count := range observer.failures {
+		failed += count
+	}
+
+	fmt.Fprintf(w, "Nodes (%d, %d failed piece uploads):\n", len(nodes), failed)
+	fmt.Fprintf(w, "\tmedian piece upload: %s\n", formatDurations(medians))
+}
+
+// formatThroughput formats the average throughput and the distribution of
+// the durations of transferring objects of the given size
+func formatThroughput(size memory.Size, durations []time.Duration) string {
+	if len(durations) == 0 {
+		return "-"
+	}
+	var total time.Duration
+	for _, duration := range durations {
+		total += duration
+	}
+	average := total / time.Duration(len(durations))
+	throughput := memory.Size(size.Float64() / average.Seconds())
+	return fmt.Sprintf("%s/s, %s", throughput.Base2String(), formatDurations(durations))
+}
+
+// formatDurations formats the percentiles of durations
+func formatDurations(durations []time.Duration) string {
+	if len(durations) == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("min %v, p50 %v, p90 %v, p99 %v, max %v",
+		percentile(durations, 0),
+		percentile(durations, 0.5),
+		percentile(durations, 0.9),
+		percentile(durations, 0.99),
+		percentile(durations, 1),
+	)
+}
+
+// percentile returns the p-th percentile (0 <= p <= 1) of durations
+func percentile(durations []time.Duration, p float64) time.Duration {
+	sorted := append([]time.Duration{}, durations...)
+	sort.Slice(sorted, func(i, k int) bool { return sorted[i] < sorted[k] })
+	return sorted[int(p*float64(len(sorted)-1)+0.5)]
+}
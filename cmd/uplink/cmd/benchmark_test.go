@@ -0,0 +1,99 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/memory"
+	ecclient "storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestBenchmarkFlags(t *testing.T) {
+	benchmarkCmd, _, err := RootCmd.Find([]string{"benchmark"})
+	require.NoError(t, err)
+
+	flags := benchmarkCmd.Flags()
+	assert.Equal(t, "[1KiB,1MiB,64MiB]", flags.Lookup("sizes").DefValue)
+	assert.Equal(t, "3", flags.Lookup("count").DefValue)
+	assert.Equal(t, "benchmark", flags.Lookup("bucket").DefValue)
+
+	defer func(sizes []string, count int) {
+		benchmarkCfg.sizes, benchmarkCfg.count = sizes, count
+	}(benchmarkCfg.sizes, benchmarkCfg.count)
+	require.NoError(t, flags.Parse([]string{"--sizes", "4KiB,2MiB", "--count", "5"}))
+	assert.Equal(t, []string{"4KiB", "2MiB"}, benchmarkCfg.sizes)
+	assert.Equal(t, 5, benchmarkCfg.count)
+
+	sizes, err := parseBenchmarkSizes(benchmarkCfg.sizes, benchmarkCfg.count)
+	require.NoError(t, err)
+	assert.Equal(t, []memory.Size{4 * memory.KiB, 2 * memory.MiB}, sizes)
+
+	_, err = parseBenchmarkSizes([]string{"4 apples"}, 1)
+	assert.Error(t, err)
+	_, err = parseBenchmarkSizes([]string{"4KiB"}, 0)
+	assert.Error(t, err)
+}
+
+func TestPercentile(t *testing.T) {
+	durations := []time.Duration{5, 1, 4, 2, 3}
+
+	assert.Equal(t, time.Duration(1), percentile(durations, 0))
+	assert.Equal(t, time.Duration(3), percentile(durations, 0.5))
+	assert.Equal(t, time.Duration(5), percentile(durations, 0.9))
+	assert.Equal(t, time.Duration(5), percentile(durations, 1))
+
+	// the durations aren't sorted in place
+	assert.Equal(t, []time.Duration{5, 1, 4, 2, 3}, durations)
+}
+
+func TestFormatDurations(t *testing.T) {
+	assert.Equal(t, "-", formatDurations(nil))
+	assert.Equal(t, "min 1s, p50 2s, p90 3s, p99 3s, max 3s",
+		formatDurations([]time.Duration{3 * time.Second, time.Second, 2 * time.Second}))
+
+	assert.Equal(t, "-", formatThroughput(memory.MiB, nil))
+	assert.Equal(t, "2.0 MiB/s, min 1s, p50 1s, p90 1s, p99 1s, max 1s",
+		formatThroughput(2*memory.MiB, []time.Duration{time.Second, time.Second}))
+}
+
+func TestPrintBenchmarkResults(t *testing.T) {
+	observer := &nodeObserver{
+		success:  map[storj.NodeID][]time.Duration{},
+		failures: map[storj.NodeID]int{},
+	}
+	first, second := storj.NodeID{1}, storj.NodeID{2}
+	observer.ObservePut(first, ecclient.PutSuccess, time.Second)
+	observer.ObservePut(first, ecclient.PutSuccess, 3*time.Second)
+	observer.ObservePut(second, ecclient.PutFailed, time.Second)
+	// canceled uploads are neither successes nor failures
+	observer.ObservePut(second, ecclient.PutCanceled, time.Second)
+
+	assert.Len(t, observer.success[first], 2)
+	assert.Len(t, observer.success[second], 0)
+	assert.Equal(t, 1, observer.failures[second])
+
+	results := []*benchmarkResult{{
+		size:      memory.MiB,
+		upload:    []time.Duration{time.Second},
+		download:  []time.Duration{time.Second / 2},
+		firstByte: []time.Duration{time.Millisecond},
+	}}
+
+	var out bytes.Buffer
+	printBenchmarkResults(&out, results, observer)
+	assert.Equal(t, "Size 1.0 MiB (1 objects):\n"+
+		"\tupload:     1.0 MiB/s, min 1s, p50 1s, p90 1s, p99 1s, max 1s\n"+
+		"\tdownload:   2.0 MiB/s, min 500ms, p50 500ms, p90 500ms, p99 500ms, max 500ms\n"+
+		"\tfirst byte: min 1ms, p50 1ms, p90 1ms, p99 1ms, max 1ms\n"+
+		"Nodes (2, 1 failed piece uploads):\n"+
+		"\tmedian piece upload: min 3s, p50 3s, p90 3s, p99 3s, max 3s\n",
+		out.String())
+}
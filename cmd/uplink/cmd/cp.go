@@ -24,9 +24,14 @@ import (
 )
 
 var (
-	progress *bool
+	progress      *bool
+	parallelism   *int
+	deterministic bool
 )
 
+// downloadRetries is how many times a segment is retried during a parallel download
+const downloadRetries = 3
+
 func init() {
 	cpCmd := addCmd(&cobra.Command{
 		Use:   "cp",
@@ -34,6 +39,15 @@ func init() {
 		RunE:  copyMain,
 	}, RootCmd)
 	progress = cpCmd.Flags().Bool("progress", true, "if true, show progress")
+	parallelism = cpCmd.Flags().Int("parallelism", 4, "how many segments are downloaded concurrently to a local file")
+	addDeterministicFlag(cpCmd)
+}
+
+// addDeterministicFlag adds the flag for reproducible uploads to a command
+func addDeterministicFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&deterministic, "deterministic", false,
+		"derive the content keys and nonces from the root key and path, so uploading the same content to the same path produces identical encrypted data; "+
+			"uploading different content to the same path reuses keys and nonces, only use it for testing")
 }
 
 // upload transfers src from local machine to s3 compatible object dst
@@ -46,9 +60,9 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 		return fmt.Errorf("destination must be Storj URL: %s", dst)
 	}
 
-	// if object name not specified, default to filename
-	if strings.HasSuffix(dst.String(), "/") || dst.Path() == "" {
-		dst = dst.Join(src.Base())
+	dst, err = uploadDestination(src, dst)
+	if err != nil {
+		return err
 	}
 
 	var file *os.File
@@ -71,6 +85,10 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 		return fmt.Errorf("source cannot be a directory: %s", src)
 	}
 
+	if deterministic {
+		cfg.Enc.Deterministic = true
+	}
+
 	metainfo, streams, err := cfg.Metainfo(ctx)
 	if err != nil {
 		return err
@@ -87,7 +105,7 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 
 	reader := io.Reader(file)
 	var bar *progressbar.ProgressBar
-	if showProgress {
+	if showProgress && showUploadProgress(fileInfo) {
 		bar = progressbar.New(int(fileInfo.Size())).SetUnits(progressbar.U_BYTES)
 		bar.Start()
 		reader = bar.NewProxyReader(reader)
@@ -107,17 +125,47 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 	return nil
 }
 
+// uploadDestination returns the object uploaded to, when dst only names the
+// bucket or a prefix, the object is named like the file
+func uploadDestination(src fpath.FPath, dst fpath.FPath) (fpath.FPath, error) {
+	if strings.HasSuffix(dst.String(), "/") || dst.Path() == "" {
+		if src.Base() == "-" {
+			return dst, fmt.Errorf("destination object name required when reading from standard in: %s", dst)
+		}
+		return dst.Join(src.Base()), nil
+	}
+	return dst, nil
+}
+
+// showUploadProgress returns whether the progress of uploading the file can
+// be shown, the size of a pipe isn't known until it's closed
+func showUploadProgress(fileInfo os.FileInfo) bool {
+	return fileInfo.Mode().IsRegular()
+}
+
+// showDownloadProgress returns whether the progress of downloading to dst can
+// be shown, the progress bar is written to standard out, so it would corrupt the data
+func showDownloadProgress(dst fpath.FPath) bool {
+	return dst.Base() != "-"
+}
+
 func uploadStream(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader) error {
 	mutableStream, err := mutableObject.CreateStream(ctx)
 	if err != nil {
 		return err
 	}
 
+	// the segments are uploaded and committed one at a time, while the data
+	// is read, so data of unknown length, e.g. from a pipe, isn't buffered
 	upload := stream.NewUpload(ctx, mutableStream, streams)
 
 	_, err = io.Copy(upload, reader)
+	if err != nil {
+		// don't commit a truncated object, when reading failed
+		return utils.CombineErrors(err, upload.CloseWithError(err))
+	}
 
-	return utils.CombineErrors(err, upload.Close())
+	return upload.Close()
 }
 
 // download transfers s3 compatible object src to dst on local machine
@@ -140,21 +188,50 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 		return convertError(err, src)
 	}
 
-	download := stream.NewDownload(ctx, readOnlyStream, streams)
-	defer func() { err = errs.Combine(err, download.Close()) }()
+	if fileInfo, err := os.Stat(dst.Path()); err == nil && fileInfo.IsDir() {
+		dst = dst.Join((src.Base()))
+	}
 
 	var bar *progressbar.ProgressBar
-	var reader io.Reader
-	if showProgress {
+	if showProgress && showDownloadProgress(dst) {
 		bar = progressbar.New(int(readOnlyStream.Info().Size)).SetUnits(progressbar.U_BYTES)
 		bar.Start()
-		reader = bar.NewProxyReader(download)
+	}
+
+	if parallelDownload(dst, *parallelism) {
+		err = downloadParallel(ctx, readOnlyStream, streams, dst, bar)
 	} else {
-		reader = download
+		err = downloadSequential(ctx, readOnlyStream, streams, dst, bar)
+	}
+	if err != nil {
+		return err
 	}
 
-	if fileInfo, err := os.Stat(dst.Path()); err == nil && fileInfo.IsDir() {
-		dst = dst.Join((src.Base()))
+	if bar != nil {
+		bar.Finish()
+	}
+
+	if dst.Base() != "-" {
+		fmt.Printf("Downloaded %s to %s\n", src.String(), dst.String())
+	}
+
+	return nil
+}
+
+// parallelDownload returns whether the segments are downloaded concurrently,
+// standard out has to be written in order
+func parallelDownload(dst fpath.FPath, parallelism int) bool {
+	return dst.Base() != "-" && parallelism > 1
+}
+
+// downloadSequential downloads the stream in order, which also works for stdout
+func downloadSequential(ctx context.Context, readOnlyStream storj.ReadOnlyStream, streams streams.Store, dst fpath.FPath, bar *progressbar.ProgressBar) (err error) {
+	download := stream.NewDownload(ctx, readOnlyStream, streams)
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	var reader io.Reader = download
+	if bar != nil {
+		reader = bar.NewProxyReader(download)
 	}
 
 	var file *os.File
@@ -169,19 +246,42 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 	}
 
 	_, err = io.Copy(file, reader)
+	return err
+}
+
+// downloadParallel downloads several segments at once into a file, which is
+// preallocated as a sparse file so that every segment can be written at its offset
+func downloadParallel(ctx context.Context, readOnlyStream storj.ReadOnlyStream, streams streams.Store, dst fpath.FPath, bar *progressbar.ProgressBar) (err error) {
+	file, err := os.Create(dst.Path())
 	if err != nil {
 		return err
 	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
 
-	if bar != nil {
-		bar.Finish()
+	err = file.Truncate(readOnlyStream.Info().Size)
+	if err != nil {
+		return err
 	}
 
-	if dst.Base() != "-" {
-		fmt.Printf("Downloaded %s to %s\n", src.String(), dst.String())
+	var writer io.WriterAt = file
+	if bar != nil {
+		writer = &progressWriterAt{WriterAt: file, bar: bar}
 	}
 
-	return nil
+	return stream.DownloadAt(ctx, readOnlyStream, streams, writer, *parallelism, downloadRetries)
+}
+
+// progressWriterAt updates the progress bar with the written bytes
+type progressWriterAt struct {
+	io.WriterAt
+	bar *progressbar.ProgressBar
+}
+
+// WriteAt implements io.WriterAt
+func (writer *progressWriterAt) WriteAt(data []byte, offset int64) (int, error) {
+	n, err := writer.WriterAt.WriteAt(data, offset)
+	writer.bar.Add(n)
+	return n, err
 }
 
 // copy copies s3 compatible object src to s3 compatible object dst
@@ -194,6 +294,10 @@ func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) (err error) {
 		return fmt.Errorf("destination must be Storj URL: %s", dst)
 	}
 
+	if deterministic {
+		cfg.Enc.Deterministic = true
+	}
+
 	metainfo, streams, err := cfg.Metainfo(ctx)
 	if err != nil {
 		return err
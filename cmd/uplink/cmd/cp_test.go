@@ -0,0 +1,187 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	progressbar "github.com/cheggaaa/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestCopyFlags(t *testing.T) {
+	cpCmd, _, err := RootCmd.Find([]string{"cp"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "4", cpCmd.Flags().Lookup("parallelism").DefValue)
+
+	defer func(value int) { *parallelism = value }(*parallelism)
+	require.NoError(t, cpCmd.Flags().Parse([]string{"--parallelism", "8", "sj://bucket/object", "."}))
+	assert.Equal(t, 8, *parallelism)
+	assert.Error(t, cpCmd.Flags().Parse([]string{"--parallelism", "many"}))
+}
+
+func TestParallelDownload(t *testing.T) {
+	file, err := fpath.New("object")
+	require.NoError(t, err)
+	stdout, err := fpath.New("-")
+	require.NoError(t, err)
+
+	assert.True(t, parallelDownload(file, 4))
+	assert.False(t, parallelDownload(file, 1))
+	assert.False(t, parallelDownload(file, 0))
+
+	// standard out has to be written in order
+	assert.False(t, parallelDownload(stdout, 4))
+}
+
+func TestProgressWriterAt(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	file, err := os.Create(filepath.Join(ctx.Dir(), "download"))
+	require.NoError(t, err)
+	defer ctx.Check(file.Close)
+
+	bar := progressbar.New(10)
+	writer := &progressWriterAt{WriterAt: file, bar: bar}
+
+	// segments finish out of order
+	n, err := writer.WriteAt([]byte("fghij"), 5)
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.EqualValues(t, 5, bar.Get())
+
+	_, err = writer.WriteAt([]byte("abcde"), 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 10, bar.Get())
+
+	data := make([]byte, 10)
+	_, err = file.ReadAt(data, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "abcdefghij", string(data))
+}
+
+func TestUploadDestination(t *testing.T) {
+	for _, tt := range []struct {
+		src, dst string
+		// expected is the path of the object, empty when the upload is rejected
+		expected string
+	}{
+		{"file.txt", "sj://bucket", "file.txt"},
+		{"dir/file.txt", "sj://bucket/prefix/", "prefix/file.txt"},
+		{"file.txt", "sj://bucket/object", "object"},
+		{"-", "sj://bucket/object", "object"},
+		// standard in has no name to use
+		{"-", "sj://bucket", ""},
+		{"-", "sj://bucket/prefix/", ""},
+	} {
+		src, err := fpath.New(tt.src)
+		require.NoError(t, err)
+		dst, err := fpath.New(tt.dst)
+		require.NoError(t, err)
+
+		dst, err = uploadDestination(src, dst)
+		if tt.expected == "" {
+			assert.Error(t, err, tt.src+" "+tt.dst)
+			continue
+		}
+		require.NoError(t, err, tt.src+" "+tt.dst)
+		assert.Equal(t, "bucket", dst.Bucket())
+		assert.Equal(t, tt.expected, dst.Path())
+	}
+}
+
+func TestShowProgress(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	file, err := os.Create(filepath.Join(ctx.Dir(), "upload"))
+	require.NoError(t, err)
+	defer ctx.Check(file.Close)
+	fileInfo, err := file.Stat()
+	require.NoError(t, err)
+	assert.True(t, showUploadProgress(fileInfo))
+
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+	defer ctx.Check(reader.Close)
+	defer ctx.Check(writer.Close)
+	pipeInfo, err := reader.Stat()
+	require.NoError(t, err)
+	assert.False(t, showUploadProgress(pipeInfo))
+
+	dst, err := fpath.New("object")
+	require.NoError(t, err)
+	assert.True(t, showDownloadProgress(dst))
+
+	stdout, err := fpath.New("-")
+	require.NoError(t, err)
+	assert.False(t, showDownloadProgress(stdout))
+}
+
+func TestUploadStream(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	{ // the whole data is uploaded
+		store := &readingStore{}
+		err := uploadStream(ctx, store, uploadObject{}, strings.NewReader("abcdef"))
+		require.NoError(t, err)
+		assert.NoError(t, store.err)
+		assert.Equal(t, "abcdef", string(store.data))
+	}
+
+	{ // a failed read is passed to the store, so it doesn't commit a truncated object
+		failure := errors.New("read failed")
+		store := &readingStore{}
+		err := uploadStream(ctx, store, uploadObject{}, io.MultiReader(strings.NewReader("abc"), failingReader{failure}))
+		assert.Equal(t, failure, err)
+		assert.Equal(t, failure, store.err)
+		assert.Equal(t, "abc", string(store.data))
+	}
+}
+
+// readingStore records the data of an upload and the error reading it
+type readingStore struct {
+	streams.Store
+	data []byte
+	err  error
+}
+
+func (store *readingStore) Put(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (streams.Meta, error) {
+	store.data, store.err = ioutil.ReadAll(data)
+	return streams.Meta{}, store.err
+}
+
+type uploadObject struct{ storj.MutableObject }
+
+func (uploadObject) CreateStream(ctx context.Context) (storj.MutableStream, error) {
+	return uploadStreamInfo{}, nil
+}
+
+type uploadStreamInfo struct{ storj.MutableStream }
+
+func (uploadStreamInfo) Info() storj.Object {
+	return storj.Object{Bucket: storj.Bucket{Name: "bucket"}, Path: "object"}
+}
+
+// failingReader fails every read
+type failingReader struct{ err error }
+
+func (reader failingReader) Read(p []byte) (int, error) { return 0, reader.err }
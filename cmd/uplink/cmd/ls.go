@@ -17,6 +17,7 @@ import (
 
 var (
 	recursiveFlag *bool
+	newerThanFlag *time.Duration
 )
 
 func init() {
@@ -26,6 +27,7 @@ func init() {
 		RunE:  list,
 	}, RootCmd)
 	recursiveFlag = lsCmd.Flags().Bool("recursive", false, "if true, list recursively")
+	newerThanFlag = lsCmd.Flags().Duration("newer-than", 0, "only list objects modified within the given duration, e.g. 24h")
 }
 
 func list(cmd *cobra.Command, args []string) error {
@@ -91,12 +93,19 @@ func list(cmd *cobra.Command, args []string) error {
 func listFiles(ctx context.Context, metainfo storj.Metainfo, prefix fpath.FPath, prependBucket bool) error {
 	startAfter := ""
 
+	// the satellite only lists the objects matching the filter
+	var filter storj.ListFilter
+	if *newerThanFlag > 0 {
+		filter.ModifiedAfter = time.Now().Add(-*newerThanFlag)
+	}
+
 	for {
 		list, err := metainfo.ListObjects(ctx, prefix.Bucket(), storj.ListOptions{
 			Direction: storj.After,
 			Cursor:    startAfter,
 			Prefix:    prefix.Path(),
 			Recursive: *recursiveFlag,
+			Filter:    filter,
 		})
 		if err != nil {
 			return err
@@ -13,11 +13,12 @@ import (
 )
 
 func init() {
-	addCmd(&cobra.Command{
+	putCmd := addCmd(&cobra.Command{
 		Use:   "put",
 		Short: "Copies data from standard in to a Storj object",
 		RunE:  putMain,
 	}, RootCmd)
+	addDeterministicFlag(putCmd)
 }
 
 // putMain is the function executed when putCmd is called
@@ -5,6 +5,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -12,12 +13,19 @@ import (
 	"storj.io/storj/pkg/process"
 )
 
+var (
+	rmRecursiveFlag *bool
+	rmAllFlag       *bool
+)
+
 func init() {
-	addCmd(&cobra.Command{
+	rmCmd := addCmd(&cobra.Command{
 		Use:   "rm",
 		Short: "Delete an object",
 		RunE:  deleteObject,
 	}, RootCmd)
+	rmRecursiveFlag = rmCmd.Flags().Bool("recursive", false, "if true, delete all objects under the prefix")
+	rmAllFlag = rmCmd.Flags().Bool("all", false, "if true, --recursive deletes every object of the bucket when no prefix is given")
 }
 
 func deleteObject(cmd *cobra.Command, args []string) error {
@@ -41,6 +49,20 @@ func deleteObject(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if *rmRecursiveFlag {
+		if strings.Trim(dst.Path(), "/") == "" && !*rmAllFlag {
+			return fmt.Errorf("Deleting every object of %s requires --all", dst)
+		}
+
+		deleted, err := metainfo.DeleteObjects(ctx, dst.Bucket(), dst.Path(), *rmAllFlag)
+		if err != nil {
+			return convertError(err, dst)
+		}
+
+		fmt.Printf("Deleted %d objects under %s\n", deleted, dst)
+		return nil
+	}
+
 	err = metainfo.DeleteObject(ctx, dst.Bucket(), dst.Path())
 	if err != nil {
 		return convertError(err, dst)
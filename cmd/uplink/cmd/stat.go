@@ -0,0 +1,270 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	segmentsFlag  *bool
+	placementFlag *bool
+)
+
+func init() {
+	statCmd := addCmd(&cobra.Command{
+		Use:   "stat",
+		Short: "Show the stream and redundancy information of an object",
+		RunE:  stat,
+	}, RootCmd)
+	segmentsFlag = statCmd.Flags().Bool("segments", false, "if true, show the size and pieces of every segment")
+	placementFlag = statCmd.Flags().Bool("placement", false, "if true, summarize the nodes storing the pieces of every segment, it needs access to the overlay of the satellite")
+}
+
+// stat prints the information about an object, which is relevant for debugging its downloads
+func stat(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	src, err := statPath(args)
+	if err != nil {
+		return err
+	}
+
+	metainfo, _, err := cfg.Metainfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	readOnlyStream, err := metainfo.GetObjectStream(ctx, src.Bucket(), src.Path())
+	if err != nil {
+		return convertError(err, src)
+	}
+	info := readOnlyStream.Info()
+	printObjectInfo(os.Stdout, src, info)
+
+	if !*segmentsFlag && !*placementFlag {
+		return nil
+	}
+
+	segments, err := listSegments(ctx, readOnlyStream)
+	if err != nil {
+		return err
+	}
+
+	var nodes map[storj.NodeID]*pb.Node
+	if *placementFlag {
+		nodes, err = lookupPieceNodes(ctx, segments)
+		if err != nil {
+			return err
+		}
+	}
+
+	printSegments(os.Stdout, info, segments, nodes)
+	return nil
+}
+
+// statPath parses the object argument of stat, it has to be an object in a bucket
+func statPath(args []string) (fpath.FPath, error) {
+	if len(args) == 0 {
+		return fpath.FPath{}, fmt.Errorf("No object specified for stat")
+	}
+
+	src, err := fpath.New(args[0])
+	if err != nil {
+		return fpath.FPath{}, err
+	}
+	if src.IsLocal() {
+		return fpath.FPath{}, fmt.Errorf("No bucket specified, use format sj://bucket/path")
+	}
+	if src.Path() == "" {
+		return fpath.FPath{}, fmt.Errorf("No object specified, use format sj://bucket/path")
+	}
+	return src, nil
+}
+
+// printObjectInfo prints the stream information of an object
+func printObjectInfo(w io.Writer, src fpath.FPath, info storj.Object) {
+	fmt.Fprintf(w, "Path:         %s\n", src.String())
+	fmt.Fprintf(w, "Size:         %s (%d bytes)\n", memory.Size(info.Size).Base2String(), info.Size)
+	fmt.Fprintf(w, "Content type: %s\n", info.ContentType)
+	fmt.Fprintf(w, "Created:      %s\n", formatTime(info.Created))
+	fmt.Fprintf(w, "Modified:     %s\n", formatTime(info.Modified))
+	if !info.Expires.IsZero() {
+		fmt.Fprintf(w, "Expires:      %s\n", formatTime(info.Expires))
+	}
+	if info.FixedSegmentSize > 0 {
+		fmt.Fprintf(w, "Segments:     %d of %s\n", info.SegmentCount, memory.Size(info.FixedSegmentSize).Base2String())
+	} else {
+		fmt.Fprintf(w, "Segments:     %d\n", info.SegmentCount)
+	}
+	fmt.Fprintf(w, "Redundancy:   %d/%d/%d/%d (required/repair/optimal/total), share size %s\n",
+		info.RequiredShares, info.RepairShares, info.OptimalShares, info.TotalShares,
+		memory.Size(info.ShareSize).Base2String())
+	fmt.Fprintf(w, "Encryption:   %s, block size %s\n", cipherName(info.Cipher), memory.Size(info.BlockSize).Base2String())
+}
+
+// printSegments prints every segment of an object and how many pieces
+// are left of the remote segment with the fewest pieces
+func printSegments(w io.Writer, info storj.Object, segments []storj.Segment, nodes map[storj.NodeID]*pb.Node) {
+	fmt.Fprintln(w)
+	var remote, minPieces int
+	for _, segment := range segments {
+		printSegmentInfo(w, segment, nodes)
+		if segment.Inline == nil {
+			if remote == 0 || len(segment.Pieces) < minPieces {
+				minPieces = len(segment.Pieces)
+			}
+			remote++
+		}
+	}
+
+	if remote > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "Remote segments: %d, fewest pieces: %d of %d, %d needed to download\n",
+			remote, minPieces, info.TotalShares, info.RequiredShares)
+	}
+}
+
+// printSegmentInfo prints the size and pieces of a segment, and the summary
+// of the nodes storing them when they were looked up
+func printSegmentInfo(w io.Writer, segment storj.Segment, nodes map[storj.NodeID]*pb.Node) {
+	size := memory.Size(segment.Size).Base2String()
+	if segment.Inline != nil {
+		fmt.Fprintf(w, "SEG %d\t%s\tinline\n", segment.Index, size)
+		return
+	}
+	fmt.Fprintf(w, "SEG %d\t%s\tremote\t%d pieces\n", segment.Index, size, len(segment.Pieces))
+	if nodes == nil {
+		return
+	}
+
+	var online, offline, unknown int
+	countries := map[string]int{}
+	for _, piece := range segment.Pieces {
+		node, ok := nodes[piece.Location]
+		if !ok {
+			unknown++
+			continue
+		}
+		if node.IsUp {
+			online++
+		} else {
+			offline++
+		}
+		country := node.CountryCode
+		if country == "" {
+			country = "??"
+		}
+		countries[country]++
+	}
+	fmt.Fprintf(w, "\tnodes: %d online, %d offline, %d unknown; countries: %s\n", online, offline, unknown, formatCountries(countries))
+}
+
+// listSegments returns all segments of an object stream
+func listSegments(ctx context.Context, readOnlyStream storj.ReadOnlyStream) ([]storj.Segment, error) {
+	var all []storj.Segment
+	for index, more := int64(0), true; more; {
+		segments, hasMore, err := readOnlyStream.Segments(ctx, index, 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(segments) == 0 {
+			break
+		}
+		all = append(all, segments...)
+		index = segments[len(segments)-1].Index + 1
+		more = hasMore
+	}
+	return all, nil
+}
+
+// lookupPieceNodes looks up the nodes storing the pieces of the segments on the overlay
+func lookupPieceNodes(ctx context.Context, segments []storj.Segment) (map[storj.NodeID]*pb.Node, error) {
+	var nodeIDs storj.NodeIDList
+	seen := map[storj.NodeID]bool{}
+	for _, segment := range segments {
+		for _, piece := range segment.Pieces {
+			if !seen[piece.Location] {
+				seen[piece.Location] = true
+				nodeIDs = append(nodeIDs, piece.Location)
+			}
+		}
+	}
+
+	nodes := make(map[storj.NodeID]*pb.Node, len(nodeIDs))
+	if len(nodeIDs) == 0 {
+		return nodes, nil
+	}
+
+	identity, err := cfg.Identity.Load()
+	if err != nil {
+		return nil, err
+	}
+	oc, err := overlay.NewClient(identity, cfg.Client.OverlayAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to overlay: %v", err)
+	}
+	found, err := oc.BulkLookup(ctx, nodeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up the nodes of the pieces: %v", err)
+	}
+	for _, node := range found {
+		// nodes, which aren't known to the overlay anymore, are nil
+		if node != nil {
+			nodes[node.Id] = node
+		}
+	}
+	return nodes, nil
+}
+
+// formatCountries formats the number of nodes per country, most common first
+func formatCountries(countries map[string]int) string {
+	if len(countries) == 0 {
+		return "-"
+	}
+	codes := make([]string, 0, len(countries))
+	for code := range countries {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, k int) bool {
+		if countries[codes[i]] != countries[codes[k]] {
+			return countries[codes[i]] > countries[codes[k]]
+		}
+		return codes[i] < codes[k]
+	})
+
+	parts := make([]string, len(codes))
+	for i, code := range codes {
+		parts[i] = fmt.Sprintf("%s:%d", code, countries[code])
+	}
+	return strings.Join(parts, " ")
+}
+
+// cipherName returns the name of the cipher of an encryption scheme
+func cipherName(cipher storj.Cipher) string {
+	switch cipher {
+	case storj.Unencrypted:
+		return "unencrypted"
+	case storj.AESGCM:
+		return "AES-GCM"
+	case storj.SecretBox:
+		return "SecretBox"
+	default:
+		return fmt.Sprintf("unknown cipher %d", cipher)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/fpath"
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestStatFlags(t *testing.T) {
+	statCmd, _, err := RootCmd.Find([]string{"stat"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "false", statCmd.Flags().Lookup("segments").DefValue)
+	assert.Equal(t, "false", statCmd.Flags().Lookup("placement").DefValue)
+
+	defer func(segments, placement bool) {
+		*segmentsFlag, *placementFlag = segments, placement
+	}(*segmentsFlag, *placementFlag)
+	require.NoError(t, statCmd.Flags().Parse([]string{"--segments", "sj://bucket/object"}))
+	assert.True(t, *segmentsFlag)
+	assert.False(t, *placementFlag)
+}
+
+func TestStatPath(t *testing.T) {
+	src, err := statPath([]string{"sj://bucket/some/object"})
+	require.NoError(t, err)
+	assert.Equal(t, "bucket", src.Bucket())
+	assert.Equal(t, "some/object", src.Path())
+
+	for _, args := range [][]string{
+		{},
+		{"local/file"},
+		{"sj://bucket"},
+		{"sj://bucket/"},
+	} {
+		_, err := statPath(args)
+		assert.Error(t, err, args)
+	}
+}
+
+func TestPrintObjectInfo(t *testing.T) {
+	src, err := fpath.New("sj://bucket/object")
+	require.NoError(t, err)
+
+	created := time.Date(2019, 2, 3, 4, 5, 6, 0, time.UTC)
+	info := storj.Object{
+		ContentType: "text/plain",
+		Created:     created,
+		Modified:    created,
+		Stream: storj.Stream{
+			Size:             3 * memory.MiB.Int64(),
+			SegmentCount:     2,
+			FixedSegmentSize: -1,
+			RedundancyScheme: storj.RedundancyScheme{
+				ShareSize:      memory.KiB.Int32(),
+				RequiredShares: 2,
+				RepairShares:   3,
+				OptimalShares:  4,
+				TotalShares:    5,
+			},
+			EncryptionScheme: storj.EncryptionScheme{
+				Cipher:    storj.AESGCM,
+				BlockSize: memory.KiB.Int32(),
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	printObjectInfo(&out, src, info)
+	assert.Equal(t, "Path:         sj://bucket/object\n"+
+		"Size:         3.0 MiB (3145728 bytes)\n"+
+		"Content type: text/plain\n"+
+		"Created:      "+formatTime(created)+"\n"+
+		"Modified:     "+formatTime(created)+"\n"+
+		"Segments:     2\n"+
+		"Redundancy:   2/3/4/5 (required/repair/optimal/total), share size 1.0 KiB\n"+
+		"Encryption:   AES-GCM, block size 1.0 KiB\n",
+		out.String())
+
+	// the expiration and fixed segment size are only printed when they are set
+	info.Expires = created.Add(time.Hour)
+	info.FixedSegmentSize = 2 * memory.MiB.Int64()
+	out.Reset()
+	printObjectInfo(&out, src, info)
+	assert.Contains(t, out.String(), "Expires:      "+formatTime(info.Expires)+"\n")
+	assert.Contains(t, out.String(), "Segments:     2 of 2.0 MiB\n")
+}
+
+func TestPrintSegments(t *testing.T) {
+	online, offline, missing := storj.NodeID{1}, storj.NodeID{2}, storj.NodeID{3}
+	nodes := map[storj.NodeID]*pb.Node{
+		online:  {Id: online, IsUp: true, CountryCode: "DE"},
+		offline: {Id: offline},
+	}
+
+	info := storj.Object{Stream: storj.Stream{
+		RedundancyScheme: storj.RedundancyScheme{RequiredShares: 2, TotalShares: 4},
+	}}
+	segments := []storj.Segment{
+		{Index: 0, Size: memory.MiB.Int64(), Pieces: []storj.Piece{
+			{Number: 0, Location: online},
+			{Number: 1, Location: offline},
+			{Number: 2, Location: missing},
+		}},
+		{Index: 1, Size: 10, Inline: []byte("0123456789")},
+	}
+
+	var out bytes.Buffer
+	printSegments(&out, info, segments, nil)
+	assert.Equal(t, "\n"+
+		"SEG 0\t1.0 MiB\tremote\t3 pieces\n"+
+		"SEG 1\t10 B\tinline\n"+
+		"\n"+
+		"Remote segments: 1, fewest pieces: 3 of 4, 2 needed to download\n",
+		out.String())
+
+	// the placement is summarized when the nodes were looked up
+	out.Reset()
+	printSegmentInfo(&out, segments[0], nodes)
+	assert.Equal(t, "SEG 0\t1.0 MiB\tremote\t3 pieces\n"+
+		"\tnodes: 1 online, 1 offline, 1 unknown; countries: ??:1 DE:1\n",
+		out.String())
+
+	// objects without remote segments have no summary
+	out.Reset()
+	printSegments(&out, info, segments[1:], nil)
+	assert.Equal(t, "\nSEG 1\t10 B\tinline\n", out.String())
+}
+
+func TestFormatCountries(t *testing.T) {
+	assert.Equal(t, "-", formatCountries(nil))
+	assert.Equal(t, "US:3 DE:1 FR:1", formatCountries(map[string]int{"FR": 1, "US": 3, "DE": 1}))
+}
+
+func TestCipherName(t *testing.T) {
+	assert.Equal(t, "unencrypted", cipherName(storj.Unencrypted))
+	assert.Equal(t, "AES-GCM", cipherName(storj.AESGCM))
+	assert.Equal(t, "SecretBox", cipherName(storj.SecretBox))
+	assert.Equal(t, "unknown cipher 9", cipherName(storj.Cipher(9)))
+}
+
+func TestListSegments(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	var segments []storj.Segment
+	for i := int64(0); i < 5; i++ {
+		segments = append(segments, storj.Segment{Index: i})
+	}
+
+	all, err := listSegments(ctx, &pagedStream{segments: segments, pageSize: 2})
+	require.NoError(t, err)
+	assert.Equal(t, segments, all)
+
+	_, err = listSegments(ctx, &pagedStream{segments: segments, pageSize: 2, err: errors.New("unavailable")})
+	assert.Error(t, err)
+}
+
+// pagedStream returns the segments of an object in pages of pageSize
+type pagedStream struct {
+	storj.ReadOnlyStream
+	segments []storj.Segment
+	pageSize int64
+	err      error
+}
+
+func (stream *pagedStream) Segments(ctx context.Context, index int64, limit int64) ([]storj.Segment, bool, error) {
+	if stream.err != nil {
+		return nil, false, stream.err
+	}
+	end := index + stream.pageSize
+	if end >= int64(len(stream.segments)) {
+		return stream.segments[index:], false, nil
+	}
+	return stream.segments[index:end], true, nil
+}
@@ -111,7 +111,7 @@ func (ctx *Context) Dir(subs ...string) string {
 
 	ctx.once.Do(func() {
 		var err error
-		ctx.directory, err = ioutil.TempDir("", ctx.test.Name())
+		ctx.directory, err = ioutil.TempDir("", strings.Replace(ctx.test.Name(), "/", "_", -1))
 		if err != nil {
 			ctx.test.Fatal(err)
 		}
@@ -25,12 +25,17 @@ import (
 	"storj.io/storj/bootstrap"
 	"storj.io/storj/bootstrap/bootstrapdb"
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/abuse"
+	"storj.io/storj/pkg/accounting/egress"
+	"storj.io/storj/pkg/accounting/export"
+	"storj.io/storj/pkg/accounting/nodetally"
 	"storj.io/storj/pkg/accounting/rollup"
 	"storj.io/storj/pkg/accounting/tally"
 	"storj.io/storj/pkg/audit"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/datarepair/checker"
 	"storj.io/storj/pkg/datarepair/repairer"
+	"storj.io/storj/pkg/datarepair/slo"
 	"storj.io/storj/pkg/discovery"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
@@ -39,8 +44,13 @@ import (
 	"storj.io/storj/pkg/peertls"
 	"storj.io/storj/pkg/piecestore/psserver"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/purge"
+	"storj.io/storj/pkg/retain"
+	"storj.io/storj/pkg/sampling"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/takeout"
+	"storj.io/storj/pkg/usagealert"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
@@ -346,6 +356,11 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 					WhitelistSignedLeaf: false,
 				},
 			},
+			Concurrency: server.ConcurrencyConfig{
+				Limits:       "pointerdb.PointerDB/Put=200,pointerdb.PointerDB/List=200,pointerdb.PointerDB/ListStream=200,bandwidth.Bandwidth/BandwidthAgreements=100",
+				QueueSize:    100,
+				QueueTimeout: 5 * time.Second,
+			},
 			Kademlia: kademlia.Config{
 				Alpha:  5,
 				DBPath: storageDir, // TODO: replace with master db
@@ -364,12 +379,25 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 					NewNodeAuditThreshold: 0,
 					NewNodePercentage:     0,
 				},
+				Stray: overlay.StrayConfig{
+					Interval:   time.Hour,
+					OfflineFor: 720 * time.Hour,
+					BatchSize:  100,
+				},
+				Addresses: overlay.AddressConfig{
+					Interval:  time.Hour,
+					BatchSize: 100,
+					Timeout:   10 * time.Second,
+				},
 			},
 			Discovery: discovery.Config{
 				GraveyardInterval: 1 * time.Second,
 				DiscoveryInterval: 1 * time.Second,
 				RefreshInterval:   1 * time.Second,
 				RefreshLimit:      100,
+
+				ThroughputInterval: 1 * time.Second,
+				ThroughputLimit:    100,
 			},
 			PointerDB: pointerdb.Config{
 				DatabaseURL:          "bolt://" + filepath.Join(storageDir, "pointers.db"),
@@ -378,21 +406,48 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 				Overlay:              true,
 				BwExpiration:         45,
 			},
-			BwAgreement: bwagreement.Config{},
+			BwAgreement: bwagreement.Config{
+				QueueSize:     0,
+				BatchSize:     100,
+				FlushInterval: time.Second,
+				Retries:       10,
+				Cleaner: bwagreement.CleanerConfig{
+					Interval:  time.Hour,
+					Retention: 2160 * time.Hour,
+					Archive:   true,
+				},
+				Rollup: bwagreement.RollupConfig{
+					Interval: time.Hour,
+					Backfill: 720 * time.Hour,
+				},
+				RateLimit: bwagreement.RateLimitConfig{
+					Rate:  100,
+					Burst: 1000,
+				},
+				Anomaly: bwagreement.AnomalyConfig{
+					Ratio: 0.95,
+				},
+			},
 			Checker: checker.Config{
 				Interval: 30 * time.Second,
 			},
 			Repairer: repairer.Config{
 				MaxRepair:     10,
 				Interval:      time.Hour,
+				LeaseDuration: 10 * time.Minute,
 				OverlayAddr:   "", // overridden in satellite.New
 				PointerDBAddr: "", // overridden in satellite.New
 				MaxBufferMem:  4 * memory.MB,
 				APIKey:        "",
 			},
+			RepairSLO: slo.Config{
+				Target: 0.99,
+			},
 			Audit: audit.Config{
 				MaxRetriesStatDB: 0,
 				Interval:         30 * time.Second,
+				ShareTimeout:     30 * time.Second,
+				MaxReverifyCount: 3,
 			},
 			Tally: tally.Config{
 				Interval: 30 * time.Second,
@@ -400,6 +455,42 @@ func (planet *Planet) newSatellites(count int) ([]*satellite.Peer, error) {
 			Rollup: rollup.Config{
 				Interval: 120 * time.Second,
 			},
+			NodeTally: nodetally.Config{
+				Interval: time.Hour,
+			},
+			Export: export.Config{
+				Interval:  time.Hour,
+				BatchSize: 1000,
+			},
+			Egress: egress.Config{
+				SampleRate: 1,
+				Interval:   time.Hour,
+				Backfill:   24 * time.Hour,
+			},
+			Abuse: abuse.Config{
+				RefreshInterval: time.Minute,
+			},
+			Purge: purge.Config{
+				Interval:  time.Minute,
+				BatchSize: 100,
+			},
+			Retain: retain.Config{
+				FalsePositiveRate: 0.1,
+				GracePeriod:       time.Hour,
+			},
+			UsageAlert: usagealert.Config{
+				Interval:       time.Minute,
+				WebhookTimeout: 10 * time.Second,
+			},
+			Sampling: sampling.Config{
+				Interval: time.Hour,
+				Rate:     1,
+			},
+			Takeout: takeout.Config{
+				Interval:       time.Minute,
+				BatchSize:      100,
+				LinkExpiration: time.Hour,
+			},
 			Console: consoleweb.Config{
 				Address:      "127.0.0.1:0",
 				PasswordCost: console.TestPasswordCost,
@@ -489,6 +580,11 @@ func (planet *Planet) newStorageNodes(count int) ([]*storagenode.Peer, error) {
 
 				AgreementSenderCheckInterval: time.Hour,
 				CollectorInterval:            time.Hour,
+				PartialUploadExpiration:      time.Hour,
+				TrashRetention:               time.Hour,
+				SatelliteCleanupInterval:     time.Hour,
+				SatelliteCleanupGracePeriod:  time.Hour,
+				UsageRecalculationInterval:   time.Hour,
 			},
 		}
 		if planet.config.Reconfigure.StorageNode != nil {
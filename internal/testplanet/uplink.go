@@ -251,7 +251,7 @@ func (uplink *Uplink) getMetainfo(satellite *satellite.Peer) (db storj.Metainfo,
 		return nil, nil, err
 	}
 
-	ec := ecclient.NewClient(uplink.Identity, maxBufferMem.Int())
+	ec := ecclient.NewRenewingClient(uplink.Identity, maxBufferMem.Int(), nil, pdb)
 	fc, err := infectious.NewFEC(minThreshold, maxThreshold)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,150 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package abuse
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the abuse package
+	Error = errs.Class("abuse error")
+	// ErrInvalidBlock is returned when a block is missing information or has a malformed value
+	ErrInvalidBlock = errs.Class("invalid block")
+	// ErrNotFound is returned when a block doesn't exist
+	ErrNotFound = errs.Class("block not found")
+)
+
+// Kind is the kind of value which is blocked
+type Kind int
+
+const (
+	// KindUplink blocks an uplink node ID
+	KindUplink Kind = 1
+	// KindAPIKey blocks an api key
+	KindAPIKey Kind = 2
+	// KindIP blocks an ip address or a CIDR range
+	KindIP Kind = 3
+)
+
+// String returns the name of the kind
+func (kind Kind) String() string {
+	switch kind {
+	case KindUplink:
+		return "uplink"
+	case KindAPIKey:
+		return "apikey"
+	case KindIP:
+		return "ip"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText encodes the kind by its name
+func (kind Kind) MarshalText() ([]byte, error) {
+	return []byte(kind.String()), nil
+}
+
+// UnmarshalText decodes the kind from its name
+func (kind *Kind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "uplink":
+		*kind = KindUplink
+	case "apikey":
+		*kind = KindAPIKey
+	case "ip":
+		*kind = KindIP
+	default:
+		return ErrInvalidBlock.New("unknown kind %q", text)
+	}
+	return nil
+}
+
+// Block denies a value access to the public endpoints until it expires
+type Block struct {
+	ID        int64     `json:"id"`
+	Kind      Kind      `json:"kind"`
+	Value     string    `json:"value"`
+	Reason    string    `json:"reason"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// DB stores the blocked uplinks, api keys and ip ranges
+type DB interface {
+	// Create adds a block and returns it with its ID set
+	Create(ctx context.Context, block Block) (Block, error)
+	// Delete removes a block, returning ErrNotFound when it doesn't exist
+	Delete(ctx context.Context, id int64) error
+	// ListActive returns the blocks which expire after now
+	ListActive(ctx context.Context, now time.Time) ([]Block, error)
+}
+
+// normalize validates the block and converts the value into its canonical form
+func (block *Block) normalize(now time.Time) error {
+	block.Value = strings.TrimSpace(block.Value)
+	if block.Value == "" {
+		return ErrInvalidBlock.New("value is missing")
+	}
+	if block.Reason == "" {
+		return ErrInvalidBlock.New("reason is missing")
+	}
+	if block.CreatedBy == "" {
+		return ErrInvalidBlock.New("creator is missing")
+	}
+	if !block.ExpiresAt.After(now) {
+		return ErrInvalidBlock.New("block expires in the past")
+	}
+
+	switch block.Kind {
+	case KindUplink:
+		id, err := storj.NodeIDFromString(block.Value)
+		if err != nil {
+			return ErrInvalidBlock.Wrap(err)
+		}
+		block.Value = id.String()
+	case KindAPIKey:
+	case KindIP:
+		network, err := parseNetwork(block.Value)
+		if err != nil {
+			return err
+		}
+		block.Value = network.String()
+	default:
+		return ErrInvalidBlock.New("unknown kind %d", block.Kind)
+	}
+	return nil
+}
+
+// parseNetwork parses a CIDR range or a single ip address
+func parseNetwork(value string) (*net.IPNet, error) {
+	if strings.Contains(value, "/") {
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, ErrInvalidBlock.Wrap(err)
+		}
+		return network, nil
+	}
+
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil, ErrInvalidBlock.New("invalid ip address %q", value)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
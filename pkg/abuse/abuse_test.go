@@ -0,0 +1,128 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package abuse_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/abuse"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+// mockServerStream is a server stream, which only has a context
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *mockServerStream) Context() context.Context { return stream.ctx }
+
+func TestBlocks(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		service := abuse.NewService(zap.NewNop(), db.Abuse(), abuse.Config{RefreshInterval: time.Hour})
+		interceptor := service.UnaryInterceptor()
+		info := &grpc.UnaryServerInfo{FullMethod: "/test"}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+		call := func(ctx context.Context) error {
+			_, err := interceptor(ctx, nil, info, handler)
+			return err
+		}
+		fromIP := func(ip string) context.Context {
+			return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 7777}})
+		}
+		denied := func(err error) bool { return status.Code(err) == codes.PermissionDenied }
+
+		expires := time.Now().Add(time.Hour)
+
+		{ // invalid blocks are rejected
+			_, err := service.Block(ctx, abuse.Block{Kind: abuse.KindIP, Value: "not an ip", Reason: "dmca", CreatedBy: "operator", ExpiresAt: expires})
+			assert.True(t, abuse.ErrInvalidBlock.Has(err))
+
+			_, err = service.Block(ctx, abuse.Block{Kind: abuse.KindAPIKey, Value: "key", CreatedBy: "operator", ExpiresAt: expires})
+			assert.True(t, abuse.ErrInvalidBlock.Has(err))
+
+			_, err = service.Block(ctx, abuse.Block{Kind: abuse.KindAPIKey, Value: "key", Reason: "spam", CreatedBy: "operator", ExpiresAt: time.Now().Add(-time.Hour)})
+			assert.True(t, abuse.ErrInvalidBlock.Has(err))
+		}
+
+		network, err := service.Block(ctx, abuse.Block{Kind: abuse.KindIP, Value: "10.1.0.0/16", Reason: "abuse report", CreatedBy: "operator", ExpiresAt: expires})
+		require.NoError(t, err)
+
+		key, err := service.Block(ctx, abuse.Block{Kind: abuse.KindAPIKey, Value: "stolen key", Reason: "dmca", CreatedBy: "operator", ExpiresAt: expires})
+		require.NoError(t, err)
+
+		single, err := service.Block(ctx, abuse.Block{Kind: abuse.KindIP, Value: "192.168.1.5", Reason: "spam", CreatedBy: "operator", ExpiresAt: expires})
+		require.NoError(t, err)
+		assert.Equal(t, "192.168.1.5/32", single.Value)
+
+		blocks, err := service.List(ctx)
+		require.NoError(t, err)
+		assert.Len(t, blocks, 3)
+
+		assert.True(t, denied(call(fromIP("10.1.2.3"))))
+		assert.True(t, denied(call(fromIP("192.168.1.5"))))
+		assert.NoError(t, call(fromIP("10.2.0.1")))
+		assert.NoError(t, call(fromIP("192.168.1.6")))
+
+		assert.True(t, denied(call(auth.WithAPIKey(ctx, []byte("stolen key")))))
+		assert.NoError(t, call(auth.WithAPIKey(ctx, []byte("other key"))))
+
+		{ // streams are rejected the same way
+			interceptor := service.StreamInterceptor()
+			info := &grpc.StreamServerInfo{FullMethod: "/test"}
+			handler := func(srv interface{}, stream grpc.ServerStream) error { return nil }
+
+			callStream := func(ctx context.Context) error {
+				return interceptor(nil, &mockServerStream{ctx: ctx}, info, handler)
+			}
+
+			assert.True(t, denied(callStream(fromIP("10.1.2.3"))))
+			assert.True(t, denied(callStream(auth.WithAPIKey(ctx, []byte("stolen key")))))
+			assert.NoError(t, callStream(fromIP("10.2.0.1")))
+			assert.NoError(t, callStream(auth.WithAPIKey(ctx, []byte("other key"))))
+		}
+
+		{ // removing a block needs an audit reason
+			err := service.Unblock(ctx, network.ID, "operator", "")
+			assert.True(t, abuse.ErrInvalidBlock.Has(err))
+
+			require.NoError(t, service.Unblock(ctx, network.ID, "operator", "resolved"))
+			assert.NoError(t, call(fromIP("10.1.2.3")))
+
+			err = service.Unblock(ctx, network.ID, "operator", "resolved")
+			assert.True(t, abuse.ErrNotFound.Has(err))
+		}
+
+		{ // expired blocks are not listed
+			blocks, err := db.Abuse().ListActive(ctx, expires.Add(time.Second))
+			require.NoError(t, err)
+			assert.Len(t, blocks, 0)
+
+			blocks, err = db.Abuse().ListActive(ctx, time.Now())
+			require.NoError(t, err)
+			assert.Len(t, blocks, 2)
+			for _, block := range blocks {
+				assert.Contains(t, []int64{key.ID, single.ID}, block.ID)
+			}
+		}
+	})
+}
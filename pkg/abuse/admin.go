@@ -0,0 +1,112 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package abuse
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// blockRequest is the body of a request for adding a block
+type blockRequest struct {
+	Kind      Kind   `json:"kind"`
+	Value     string `json:"value"`
+	Reason    string `json:"reason"`
+	CreatedBy string `json:"created_by"`
+	// Expires is the duration of the block, e.g. "720h"
+	Expires string `json:"expires"`
+}
+
+// ServeHTTP implements the abuse admin api:
+//
+//	GET    /blocks                         lists the active blocks
+//	POST   /blocks                         adds a block, see blockRequest
+//	DELETE /blocks/<id>?by=<who>&reason=   removes a block
+func (service *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	path := strings.Trim(r.URL.Path, "/")
+	if path == "blocks" {
+		switch r.Method {
+		case http.MethodGet:
+			blocks, err := service.List(ctx)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			writeJSON(w, http.StatusOK, blocks)
+		case http.MethodPost:
+			var request blockRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			expires, err := time.ParseDuration(request.Expires)
+			if err != nil {
+				http.Error(w, "invalid expires: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			block, err := service.Block(ctx, Block{
+				Kind:      request.Kind,
+				Value:     request.Value,
+				Reason:    request.Reason,
+				CreatedBy: request.CreatedBy,
+				ExpiresAt: time.Now().Add(expires),
+			})
+			if err != nil {
+				http.Error(w, err.Error(), errorStatus(err))
+				return
+			}
+			writeJSON(w, http.StatusCreated, block)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] != "blocks" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodDelete {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	query := r.URL.Query()
+	if err := service.Unblock(ctx, id, query.Get("by"), query.Get("reason")); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// errorStatus returns the http status code for err
+func errorStatus(err error) int {
+	switch {
+	case ErrInvalidBlock.Has(err):
+		return http.StatusBadRequest
+	case ErrNotFound.Has(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(value)
+}
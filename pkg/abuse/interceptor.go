@@ -0,0 +1,80 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package abuse
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/storj"
+)
+
+// UnaryInterceptor rejects requests from blocked uplinks, api keys and ip addresses.
+//
+// The api key is read from the context, so the interceptor has to run after
+// the api key interceptor.
+func (service *Service) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if block := service.blocked(ctx); block != nil {
+			mon.Meter("abuse_rejected").Mark(1)
+			service.log.Debug("rejected blocked request",
+				zap.String("method", info.FullMethod),
+				zap.Int64("block", block.ID),
+				zap.Stringer("kind", block.Kind))
+			return nil, status.Error(codes.PermissionDenied, "access denied")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor rejects streams from blocked uplinks, api keys and ip
+// addresses, like UnaryInterceptor.
+func (service *Service) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if block := service.blocked(ss.Context()); block != nil {
+			mon.Meter("abuse_rejected").Mark(1)
+			service.log.Debug("rejected blocked stream",
+				zap.String("method", info.FullMethod),
+				zap.Int64("block", block.ID),
+				zap.Stringer("kind", block.Kind))
+			return status.Error(codes.PermissionDenied, "access denied")
+		}
+		return handler(srv, ss)
+	}
+}
+
+// blocked returns the block which applies to the caller of the request
+func (service *Service) blocked(ctx context.Context) *Block {
+	apikey, _ := auth.GetAPIKey(ctx)
+
+	var uplink *storj.NodeID
+	var ip net.IP
+	if p, ok := peer.FromContext(ctx); ok {
+		// only connections secured by tls carry the identity of the uplink
+		if _, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if pi, err := identity.PeerIdentityFromPeer(p); err == nil {
+				uplink = &pi.ID
+			}
+		}
+		if p.Addr != nil {
+			host, _, err := net.SplitHostPort(p.Addr.String())
+			if err != nil {
+				host = p.Addr.String()
+			}
+			ip = net.ParseIP(host)
+		}
+	}
+
+	return service.find(time.Now(), uplink, apikey, ip)
+}
@@ -0,0 +1,199 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package abuse
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/pkg/storj"
+)
+
+// Config contains the settings for blocking abusive uplinks
+type Config struct {
+	RefreshInterval time.Duration `help:"how often the blocks are reloaded from the database" default:"1m"`
+}
+
+// Service keeps the active blocks in memory and manages them,
+// every change is written to the audit log with its reason.
+type Service struct {
+	log   *zap.Logger
+	audit *zap.Logger
+	db    DB
+
+	Refresh *chore.Chore
+
+	mu     sync.RWMutex
+	active blocklist
+}
+
+// blocklist contains the active blocks indexed by their value
+type blocklist struct {
+	byID     map[int64]Block
+	uplinks  map[storj.NodeID]Block
+	apikeys  map[string]Block
+	networks []blockedNetwork
+}
+
+// blockedNetwork is a blocked ip range
+type blockedNetwork struct {
+	network *net.IPNet
+	block   Block
+}
+
+// NewService creates a new abuse service
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	service := &Service{
+		log:   log,
+		audit: log.Named("audit"),
+		db:    db,
+	}
+	service.Refresh = chore.New(log, "abuse:refresh", config.RefreshInterval, service.refresh)
+	return service
+}
+
+// Run loads the blocks and keeps reloading them until the context is canceled
+func (service *Service) Run(ctx context.Context) error {
+	if err := service.refresh(ctx); err != nil {
+		service.log.Error("could not load blocks", zap.Error(err))
+	}
+	return service.Refresh.Run(ctx)
+}
+
+// Block adds a block and enforces it immediately
+func (service *Service) Block(ctx context.Context, block Block) (_ Block, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	if err := block.normalize(now); err != nil {
+		return Block{}, err
+	}
+	block.CreatedAt = now
+
+	block, err = service.db.Create(ctx, block)
+	if err != nil {
+		return Block{}, Error.Wrap(err)
+	}
+
+	service.audit.Info("blocked",
+		zap.Int64("id", block.ID),
+		zap.Stringer("kind", block.Kind),
+		zap.String("value", block.Value),
+		zap.String("reason", block.Reason),
+		zap.String("by", block.CreatedBy),
+		zap.Time("expires", block.ExpiresAt))
+
+	return block, Error.Wrap(service.refresh(ctx))
+}
+
+// Unblock removes a block before it expires
+func (service *Service) Unblock(ctx context.Context, id int64, by, reason string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if by == "" || reason == "" {
+		return ErrInvalidBlock.New("removing a block requires who removes it and why")
+	}
+
+	if err := service.db.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	fields := []zap.Field{
+		zap.Int64("id", id),
+		zap.String("reason", reason),
+		zap.String("by", by),
+	}
+	service.mu.RLock()
+	if block, ok := service.active.byID[id]; ok {
+		fields = append(fields, zap.Stringer("kind", block.Kind), zap.String("value", block.Value))
+	}
+	service.mu.RUnlock()
+	service.audit.Info("unblocked", fields...)
+
+	return Error.Wrap(service.refresh(ctx))
+}
+
+// List returns the blocks which are currently enforced
+func (service *Service) List(ctx context.Context) (_ []Block, err error) {
+	defer mon.Task()(&ctx)(&err)
+	blocks, err := service.db.ListActive(ctx, time.Now())
+	return blocks, Error.Wrap(err)
+}
+
+// refresh reloads the active blocks from the database
+func (service *Service) refresh(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	blocks, err := service.db.ListActive(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	active := blocklist{
+		byID:    make(map[int64]Block, len(blocks)),
+		uplinks: make(map[storj.NodeID]Block),
+		apikeys: make(map[string]Block),
+	}
+	for _, block := range blocks {
+		active.byID[block.ID] = block
+		switch block.Kind {
+		case KindUplink:
+			id, err := storj.NodeIDFromString(block.Value)
+			if err != nil {
+				service.log.Warn("invalid blocked uplink", zap.Int64("id", block.ID), zap.Error(err))
+				continue
+			}
+			active.uplinks[id] = block
+		case KindAPIKey:
+			active.apikeys[block.Value] = block
+		case KindIP:
+			network, err := parseNetwork(block.Value)
+			if err != nil {
+				service.log.Warn("invalid blocked ip range", zap.Int64("id", block.ID), zap.Error(err))
+				continue
+			}
+			active.networks = append(active.networks, blockedNetwork{network, block})
+		}
+	}
+
+	service.mu.Lock()
+	service.active = active
+	service.mu.Unlock()
+
+	mon.IntVal("active_blocks").Observe(int64(len(blocks)))
+	return nil
+}
+
+// find returns the first active block matching the request, or nil when
+// the request isn't blocked
+func (service *Service) find(now time.Time, uplink *storj.NodeID, apikey []byte, ip net.IP) *Block {
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+
+	active := func(block Block) bool { return block.ExpiresAt.After(now) }
+
+	if uplink != nil {
+		if block, ok := service.active.uplinks[*uplink]; ok && active(block) {
+			return &block
+		}
+	}
+	if len(apikey) > 0 {
+		if block, ok := service.active.apikeys[string(apikey)]; ok && active(block) {
+			return &block
+		}
+	}
+	if ip != nil {
+		for _, blocked := range service.active.networks {
+			if blocked.network.Contains(ip) && active(blocked.block) {
+				return &blocked.block
+			}
+		}
+	}
+	return nil
+}
@@ -27,6 +27,25 @@ const (
 	LastRollup = "LastRollup"
 )
 
+// Payout methods a node operator can be paid with
+const (
+	// PayoutMethodL1 represents a regular on-chain transfer
+	PayoutMethodL1 = "l1"
+	// PayoutMethodZkSync represents a zkSync (L2) transfer, operators opt into it
+	// by reporting it as a wallet feature
+	PayoutMethodZkSync = "zksync"
+)
+
+// PayoutMethod returns the preferred payout method for the wallet features reported by a node
+func PayoutMethod(walletFeatures []string) string {
+	for _, feature := range walletFeatures {
+		if feature == PayoutMethodZkSync {
+			return PayoutMethodZkSync
+		}
+	}
+	return PayoutMethodL1
+}
+
 // CSVRow represents data from QueryPaymentInfo without exposing dbx
 type CSVRow struct {
 	NodeID            storj.NodeID
@@ -40,4 +59,5 @@ type CSVRow struct {
 	GetTotal          int64
 	Date              time.Time
 	Wallet            string
+	PayoutMethod      string
 }
@@ -52,4 +52,10 @@ type DB interface {
 	SaveRollup(ctx context.Context, latestTally time.Time, stats RollupStats) error
 	// QueryPaymentInfo queries StatDB, Accounting Rollup on nodeID
 	QueryPaymentInfo(ctx context.Context, start time.Time, end time.Time) ([]*CSVRow, error)
+	// DeleteRawForNodes removes the raw tallies of nodes which haven't been rolled up yet
+	DeleteRawForNodes(ctx context.Context, nodeIDs storj.NodeIDList) error
+	// GetRollupsAfter returns up to limit rollups with an id greater than id, ordered by id
+	GetRollupsAfter(ctx context.Context, id int64, limit int) ([]*Rollup, error)
+	// GetRawAfter returns up to limit raw tallies with an id greater than id, ordered by id
+	GetRawAfter(ctx context.Context, id int64, limit int) ([]*Raw, error)
 }
@@ -0,0 +1,181 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package egress
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// Config configures attributing the download egress to objects
+type Config struct {
+	SampleRate float64       `help:"fraction of the downloads, whose egress is attributed to their object, 1 attributes every download exactly and 0 disables the attribution" default:"1"`
+	Interval   time.Duration `help:"how often the egress of the agreements is rolled up per object, 0 disables the rollup" default:"1h"`
+	Backfill   time.Duration `help:"how far back the egress is rolled up when no object has been rolled up yet" default:"24h"`
+}
+
+// Attribution is the object a download allocation was issued for
+type Attribution struct {
+	SerialNumber string
+	ProjectID    uuid.UUID
+	Bucket       string
+	// ObjectPath is the encrypted path of the object within the bucket
+	ObjectPath string
+	// SampleRate is the fraction of the downloads, which were attributed when
+	// the allocation was issued
+	SampleRate float64
+	ExpiresAt  time.Time
+}
+
+// ObjectEgress is the download egress of an object. Sampled egress is scaled
+// by the sample rate, so it's an estimate of the whole egress.
+type ObjectEgress struct {
+	Bucket     string `json:"bucket"`
+	ObjectPath string `json:"objectPath"`
+	Egress     int64  `json:"egress"`
+}
+
+// DB stores the attributions and the hourly egress of the objects
+type DB interface {
+	// SaveAttribution records the object of a download allocation
+	SaveAttribution(ctx context.Context, attribution Attribution) error
+	// RollupEgress sums the GET agreements stored after from until to per
+	// attributed object and saves the sums as the egress of the hour starting at from
+	RollupEgress(ctx context.Context, from, to time.Time) error
+	// LastRollup returns the end of the last hour, which has been rolled up, or the zero time
+	LastRollup(ctx context.Context) (time.Time, error)
+	// DeleteExpired deletes the attributions of allocations, which expired before the given time
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+	// TopObjects returns up to limit objects of a project with the most
+	// egress during the hours starting from from until to, the most egress first
+	TopObjects(ctx context.Context, projectID uuid.UUID, from, to time.Time, limit int) ([]ObjectEgress, error)
+}
+
+// Service attributes the egress of downloads to objects. The object of every
+// sampled download allocation is recorded when the allocation is issued, the
+// GET agreements submitted by the storage nodes are rolled up per object hourly.
+//
+// Only agreements, which are stored before their hour has been rolled up, are
+// counted.
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	// next is the start of the next hour to roll up
+	next time.Time
+
+	Chore *chore.Chore
+}
+
+// New creates a new egress attribution service
+func New(log *zap.Logger, db DB, config Config) *Service {
+	service := &Service{
+		log:    log,
+		db:     db,
+		config: config,
+	}
+	service.Chore = chore.New(log, "egress:rollup", config.Interval, service.Rollup)
+	return service
+}
+
+// Run rolls up the completed hours every interval
+func (service *Service) Run(ctx context.Context) error {
+	if service.config.Interval <= 0 {
+		return nil
+	}
+	return service.Chore.Run(ctx)
+}
+
+// Attribute records the object of a download allocation, when the download is
+// sampled. The path is relative to the project, e.g. s0/bucket/object.
+func (service *Service) Attribute(ctx context.Context, projectID uuid.UUID, path storj.Path, pba *pb.PayerBandwidthAllocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if pba.GetAction() != pb.BandwidthAction_GET || !service.sampled() {
+		return nil
+	}
+
+	// the segment doesn't matter, the egress of all segments belongs to the object
+	parts := storj.SplitPath(path)
+	if len(parts) < 3 {
+		return nil
+	}
+
+	return Error.Wrap(service.db.SaveAttribution(ctx, Attribution{
+		SerialNumber: pba.GetSerialNumber(),
+		ProjectID:    projectID,
+		Bucket:       parts[1],
+		ObjectPath:   storj.JoinPaths(parts[2:]...),
+		SampleRate:   service.config.SampleRate,
+		ExpiresAt:    time.Unix(pba.GetExpirationUnixSec(), 0).UTC(),
+	}))
+}
+
+// sampled returns whether a download is attributed
+func (service *Service) sampled() bool {
+	rate := service.config.SampleRate
+	return rate >= 1 || rate > 0 && rand.Float64() < rate
+}
+
+// Rollup saves the egress of every completed hour, which hasn't been rolled
+// up yet, and deletes the attributions, which can't receive agreements anymore
+func (service *Service) Rollup(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.next.IsZero() {
+		last, err := service.db.LastRollup(ctx)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if last.IsZero() {
+			last = time.Now().Add(-service.config.Backfill)
+		}
+		service.next = last.UTC().Truncate(time.Hour)
+	}
+
+	end := time.Now().UTC().Truncate(time.Hour)
+	for service.next.Before(end) {
+		intervalEnd := service.next.Add(time.Hour)
+		if err := service.db.RollupEgress(ctx, service.next, intervalEnd); err != nil {
+			return Error.Wrap(err)
+		}
+		service.next = intervalEnd
+	}
+
+	// agreements of expired allocations have been stored before they expired,
+	// so they are in hours, which have been rolled up
+	deleted, err := service.db.DeleteExpired(ctx, service.next)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if deleted > 0 {
+		service.log.Debug("deleted expired attributions", zap.Int64("count", deleted))
+	}
+	return nil
+}
+
+// TopObjects returns up to limit objects of a project with the most egress
+// between from and to
+func (service *Service) TopObjects(ctx context.Context, projectID uuid.UUID, from, to time.Time, limit int) (_ []ObjectEgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 {
+		return nil, Error.New("limit has to be positive")
+	}
+	if !from.Before(to) {
+		return nil, Error.New("from has to be before to")
+	}
+
+	objects, err := service.db.TopObjects(ctx, projectID, from.UTC(), to.UTC(), limit)
+	return objects, Error.Wrap(err)
+}
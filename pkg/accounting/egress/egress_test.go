@@ -0,0 +1,116 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package egress_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/accounting/egress"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestEgressAttribution(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		id, err := uuid.New()
+		require.NoError(t, err)
+		projectID := *id
+
+		service := egress.New(zap.NewNop(), db.EgressAttributions(), egress.Config{
+			SampleRate: 1,
+			Interval:   time.Hour,
+			Backfill:   3 * time.Hour,
+		})
+
+		uplinkID := teststorj.NodeIDFromString("uplink")
+		nodeIDs := []pb.NodeID{
+			teststorj.NodeIDFromString("node1"),
+			teststorj.NodeIDFromString("node2"),
+		}
+
+		// the agreements are stored during the last completed hour
+		hour := time.Now().UTC().Truncate(time.Hour).Add(-time.Hour)
+		allocation := func(serial string, action pb.BandwidthAction) *pb.PayerBandwidthAllocation {
+			return &pb.PayerBandwidthAllocation{
+				SerialNumber:      serial,
+				UplinkId:          uplinkID,
+				Action:            action,
+				CreatedUnixSec:    hour.Add(30 * time.Minute).Unix(),
+				ExpirationUnixSec: time.Now().Add(time.Hour).Unix(),
+			}
+		}
+
+		first := allocation("first", pb.BandwidthAction_GET)
+		second := allocation("second", pb.BandwidthAction_GET)
+		sampled := allocation("sampled", pb.BandwidthAction_GET)
+		put := allocation("put", pb.BandwidthAction_PUT)
+
+		require.NoError(t, service.Attribute(ctx, projectID, "s0/bucket/first", first))
+		require.NoError(t, service.Attribute(ctx, projectID, "l/bucket/second", second))
+		require.NoError(t, service.Attribute(ctx, projectID, "l/bucket/put", put))
+		// downloads sampled at half the rate count twice
+		require.NoError(t, db.EgressAttributions().SaveAttribution(ctx, egress.Attribution{
+			SerialNumber: "sampled",
+			ProjectID:    projectID,
+			Bucket:       "other",
+			ObjectPath:   "sampled",
+			SampleRate:   0.5,
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}))
+
+		for _, nodeID := range nodeIDs {
+			for _, rba := range []*pb.RenterBandwidthAllocation{
+				{PayerAllocation: *first, StorageNodeId: nodeID, Total: 100},
+				{PayerAllocation: *second, StorageNodeId: nodeID, Total: 10},
+				{PayerAllocation: *sampled, StorageNodeId: nodeID, Total: 40},
+				{PayerAllocation: *put, StorageNodeId: nodeID, Total: 1000},
+			} {
+				require.NoError(t, db.BandwidthAgreement().ReplayAgreement(ctx, rba))
+			}
+		}
+
+		require.NoError(t, service.Rollup(ctx))
+
+		objects, err := service.TopObjects(ctx, projectID, hour.Add(-time.Hour), hour.Add(time.Hour), 10)
+		require.NoError(t, err)
+		assert.Equal(t, []egress.ObjectEgress{
+			{Bucket: "bucket", ObjectPath: "first", Egress: 200},
+			{Bucket: "other", ObjectPath: "sampled", Egress: 160},
+			{Bucket: "bucket", ObjectPath: "second", Egress: 20},
+		}, objects)
+
+		// the limit keeps the objects with the most egress
+		objects, err = service.TopObjects(ctx, projectID, hour, hour.Add(time.Hour), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []egress.ObjectEgress{{Bucket: "bucket", ObjectPath: "first", Egress: 200}}, objects)
+
+		// hours are rolled up only once
+		require.NoError(t, service.Rollup(ctx))
+		objects, err = service.TopObjects(ctx, projectID, hour, hour.Add(time.Hour), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []egress.ObjectEgress{{Bucket: "bucket", ObjectPath: "first", Egress: 200}}, objects)
+
+		// other projects don't see the objects
+		otherID, err := uuid.New()
+		require.NoError(t, err)
+		objects, err = service.TopObjects(ctx, *otherID, hour, hour.Add(time.Hour), 10)
+		require.NoError(t, err)
+		assert.Empty(t, objects)
+
+		_, err = service.TopObjects(ctx, projectID, hour, hour, 10)
+		assert.True(t, egress.Error.Has(err))
+	})
+}
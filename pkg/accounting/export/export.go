@@ -0,0 +1,165 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package export
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/chore"
+)
+
+// Tables, which are exported
+const (
+	// Rollups is the table of the rolled up node totals
+	Rollups = "rollups"
+	// Raws is the table of the raw tallies
+	Raws = "raws"
+)
+
+// Config contains configurable values for exporting accounting data
+type Config struct {
+	Interval  time.Duration `help:"how often new rollups and tallies are exported" default:"1h"`
+	Sink      string        `help:"url of the sink receiving the exported accounting data, e.g. file:///var/lib/storj/export, empty disables the export" default:""`
+	BatchSize int           `help:"maximum number of rows exported at once" default:"10000"`
+}
+
+// Sink receives the exported accounting data, e.g. to load it into a data
+// warehouse. Every batch contains rows with increasing ids and the sink is
+// responsible for remembering the id of the last row it has stored, so the
+// export continues where it stopped.
+type Sink interface {
+	// Cursor returns the id of the last stored row of table, 0 when nothing has been stored
+	Cursor(ctx context.Context, table string) (int64, error)
+	// WriteRollups stores a batch of rollups
+	WriteRollups(ctx context.Context, rollups []*accounting.Rollup) error
+	// WriteRaws stores a batch of raw tallies
+	WriteRaws(ctx context.Context, raws []*accounting.Raw) error
+}
+
+// OpenSink opens the sink of a url, only file:// urls are supported.
+func OpenSink(sinkURL string) (Sink, error) {
+	parsed, err := url.Parse(sinkURL)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	switch parsed.Scheme {
+	case "file":
+		return NewFileSink(parsed.Path)
+	default:
+		return nil, Error.New("unsupported sink scheme: %q", parsed.Scheme)
+	}
+}
+
+// Exporter incrementally exports the rollups and raw tallies to a sink, so
+// analytics don't have to query the satellite database.
+type Exporter struct {
+	log       *zap.Logger
+	db        accounting.DB
+	sink      Sink
+	batchSize int
+
+	Chore *chore.Chore
+}
+
+// New creates a new exporter, it doesn't export anything when sink is nil
+func New(log *zap.Logger, db accounting.DB, sink Sink, config Config) *Exporter {
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	exporter := &Exporter{
+		log:       log,
+		db:        db,
+		sink:      sink,
+		batchSize: batchSize,
+	}
+	exporter.Chore = chore.New(log, "accounting:export", config.Interval, exporter.Export)
+	return exporter
+}
+
+// Run exports the new rows at every interval
+func (exporter *Exporter) Run(ctx context.Context) error {
+	if exporter.sink == nil {
+		return nil
+	}
+	return exporter.Chore.Run(ctx)
+}
+
+// Export writes all rows, which haven't been stored by the sink yet
+func (exporter *Exporter) Export(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if exporter.sink == nil {
+		return nil
+	}
+
+	rollups, err := exporter.exportRollups(ctx)
+	if err != nil {
+		return err
+	}
+	raws, err := exporter.exportRaws(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rollups > 0 || raws > 0 {
+		exporter.log.Info("exported accounting data", zap.Int("rollups", rollups), zap.Int("raws", raws))
+	}
+	return nil
+}
+
+// exportRollups writes the new rollups in batches
+func (exporter *Exporter) exportRollups(ctx context.Context) (exported int, err error) {
+	cursor, err := exporter.sink.Cursor(ctx, Rollups)
+	if err != nil {
+		return exported, Error.Wrap(err)
+	}
+
+	for {
+		rollups, err := exporter.db.GetRollupsAfter(ctx, cursor, exporter.batchSize)
+		if err != nil {
+			return exported, Error.Wrap(err)
+		}
+		if len(rollups) == 0 {
+			return exported, nil
+		}
+		if err := exporter.sink.WriteRollups(ctx, rollups); err != nil {
+			return exported, Error.Wrap(err)
+		}
+
+		exported += len(rollups)
+		mon.Meter("accounting_export_rollups").Mark(len(rollups))
+		cursor = rollups[len(rollups)-1].ID
+	}
+}
+
+// exportRaws writes the new raw tallies in batches
+func (exporter *Exporter) exportRaws(ctx context.Context) (exported int, err error) {
+	cursor, err := exporter.sink.Cursor(ctx, Raws)
+	if err != nil {
+		return exported, Error.Wrap(err)
+	}
+
+	for {
+		raws, err := exporter.db.GetRawAfter(ctx, cursor, exporter.batchSize)
+		if err != nil {
+			return exported, Error.Wrap(err)
+		}
+		if len(raws) == 0 {
+			return exported, nil
+		}
+		if err := exporter.sink.WriteRaws(ctx, raws); err != nil {
+			return exported, Error.Wrap(err)
+		}
+
+		exported += len(raws)
+		mon.Meter("accounting_export_raws").Mark(len(raws))
+		cursor = raws[len(raws)-1].ID
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package export_test
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/export"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestExport(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		nodeIDs := make([]storj.NodeID, 3)
+		for i := range nodeIDs {
+			identity, err := testidentity.NewTestIdentity(ctx)
+			require.NoError(t, err)
+			nodeIDs[i] = identity.ID
+		}
+
+		now := time.Now().UTC()
+		atRest := make(map[storj.NodeID]float64)
+		stats := make(accounting.RollupStats)
+		stats[now] = make(map[storj.NodeID]*accounting.Rollup)
+		for _, id := range nodeIDs {
+			atRest[id] = 1000
+			stats[now][id] = &accounting.Rollup{NodeID: id, StartTime: now, PutTotal: 10, AtRestTotal: 1000}
+		}
+		require.NoError(t, db.Accounting().SaveAtRestRaw(ctx, now, now, atRest))
+		require.NoError(t, db.Accounting().SaveRollup(ctx, now, stats))
+
+		sink, err := export.NewFileSink(ctx.Dir("export"))
+		require.NoError(t, err)
+
+		exporter := export.New(zap.NewNop(), db.Accounting(), sink, export.Config{BatchSize: 2})
+		require.NoError(t, exporter.Export(ctx))
+
+		// three rows in batches of two are written as two files per table
+		files, err := ioutil.ReadDir(ctx.Dir("export"))
+		require.NoError(t, err)
+		assert.Len(t, files, 4)
+
+		for _, table := range []string{export.Rollups, export.Raws} {
+			cursor, err := sink.Cursor(ctx, table)
+			require.NoError(t, err)
+			assert.NotZero(t, cursor)
+		}
+
+		// nothing new is exported on the next run
+		require.NoError(t, exporter.Export(ctx))
+		files, err = ioutil.ReadDir(ctx.Dir("export"))
+		require.NoError(t, err)
+		assert.Len(t, files, 4)
+
+		// only the new rows are exported afterwards
+		later := now.Add(time.Hour)
+		require.NoError(t, db.Accounting().SaveAtRestRaw(ctx, later, later, map[storj.NodeID]float64{nodeIDs[0]: 500}))
+		require.NoError(t, exporter.Export(ctx))
+		files, err = ioutil.ReadDir(ctx.Dir("export"))
+		require.NoError(t, err)
+		assert.Len(t, files, 5)
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/accounting"
+)
+
+// FileSink writes every batch as a file of newline-delimited JSON rows, the
+// format which warehouses like BigQuery and Redshift load directly. The files
+// are named <table>-<first id>-<last id>.json, so the cursor is derived from
+// the files in the directory.
+type FileSink struct {
+	dir string
+}
+
+// NewFileSink creates a sink writing to dir
+func NewFileSink(dir string) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &FileSink{dir: dir}, nil
+}
+
+type rollupRow struct {
+	ID             int64     `json:"id"`
+	NodeID         string    `json:"node_id"`
+	StartTime      time.Time `json:"start_time"`
+	PutTotal       int64     `json:"put_total"`
+	GetTotal       int64     `json:"get_total"`
+	GetAuditTotal  int64     `json:"get_audit_total"`
+	GetRepairTotal int64     `json:"get_repair_total"`
+	PutRepairTotal int64     `json:"put_repair_total"`
+	AtRestTotal    float64   `json:"at_rest_total"`
+}
+
+type rawRow struct {
+	ID              int64     `json:"id"`
+	NodeID          string    `json:"node_id"`
+	IntervalEndTime time.Time `json:"interval_end_time"`
+	DataTotal       float64   `json:"data_total"`
+	DataType        int       `json:"data_type"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// Cursor returns the last id of the files of table
+func (sink *FileSink) Cursor(ctx context.Context, table string) (cursor int64, err error) {
+	files, err := ioutil.ReadDir(sink.dir)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	for _, file := range files {
+		var first, last int64
+		name := strings.TrimPrefix(file.Name(), table+"-")
+		if name == file.Name() || !strings.HasSuffix(name, ".json") {
+			continue
+		}
+		if _, err := fmt.Sscanf(name, "%d-%d.json", &first, &last); err != nil {
+			continue
+		}
+		if last > cursor {
+			cursor = last
+		}
+	}
+	return cursor, nil
+}
+
+// WriteRollups writes a batch of rollups to a new file
+func (sink *FileSink) WriteRollups(ctx context.Context, rollups []*accounting.Rollup) error {
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(rollups))
+	for _, rollup := range rollups {
+		rows = append(rows, rollupRow{
+			ID:             rollup.ID,
+			NodeID:         rollup.NodeID.String(),
+			StartTime:      rollup.StartTime.UTC(),
+			PutTotal:       rollup.PutTotal,
+			GetTotal:       rollup.GetTotal,
+			GetAuditTotal:  rollup.GetAuditTotal,
+			GetRepairTotal: rollup.GetRepairTotal,
+			PutRepairTotal: rollup.PutRepairTotal,
+			AtRestTotal:    rollup.AtRestTotal,
+		})
+	}
+	return sink.write(Rollups, rollups[0].ID, rollups[len(rollups)-1].ID, rows)
+}
+
+// WriteRaws writes a batch of raw tallies to a new file
+func (sink *FileSink) WriteRaws(ctx context.Context, raws []*accounting.Raw) error {
+	if len(raws) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(raws))
+	for _, raw := range raws {
+		rows = append(rows, rawRow{
+			ID:              raw.ID,
+			NodeID:          raw.NodeID.String(),
+			IntervalEndTime: raw.IntervalEndTime.UTC(),
+			DataTotal:       raw.DataTotal,
+			DataType:        raw.DataType,
+			CreatedAt:       raw.CreatedAt.UTC(),
+		})
+	}
+	return sink.write(Raws, raws[0].ID, raws[len(raws)-1].ID, rows)
+}
+
+// write writes the rows to a temporary file and renames it afterwards, so a
+// partially written batch doesn't move the cursor
+func (sink *FileSink) write(table string, first, last int64, rows []interface{}) (err error) {
+	name := filepath.Join(sink.dir, fmt.Sprintf("%s-%020d-%020d.json", table, first, last))
+
+	file, err := os.Create(name + ".tmp")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, os.Remove(file.Name()))
+		}
+	}()
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, row := range rows {
+		if err := encoder.Encode(row); err != nil {
+			return Error.Wrap(errs.Combine(err, file.Close()))
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return Error.Wrap(errs.Combine(err, file.Close()))
+	}
+	if err := file.Sync(); err != nil {
+		return Error.Wrap(errs.Combine(err, file.Close()))
+	}
+	if err := file.Close(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(os.Rename(file.Name(), name))
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodetally
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/transport"
+)
+
+// Config contains configurable values for publishing node tallies
+type Config struct {
+	Interval time.Duration `help:"how often to check whether the tallies of the previous day have to be published, 0 disables publishing" default:"1h"`
+}
+
+// Service publishes a signed summary of the rolled up totals of the
+// previous day to every storage node, so nodes can compare them with
+// their own records.
+type Service struct {
+	log       *zap.Logger
+	db        accounting.DB
+	cache     *overlay.Cache
+	transport transport.Client
+	identity  *identity.FullIdentity
+	config    Config
+
+	// published is the end of the last published period
+	published time.Time
+
+	Chore *chore.Chore
+}
+
+// New creates a new node tally service
+func New(log *zap.Logger, db accounting.DB, cache *overlay.Cache, transport transport.Client, identity *identity.FullIdentity, config Config) *Service {
+	service := &Service{
+		log:       log,
+		db:        db,
+		cache:     cache,
+		transport: transport,
+		identity:  identity,
+		config:    config,
+	}
+	service.Chore = chore.New(log, "accounting:nodetally", config.Interval, service.publish)
+	return service
+}
+
+// Run publishes the node tallies of every finished day
+func (service *Service) Run(ctx context.Context) error {
+	if service.config.Interval <= 0 {
+		return nil
+	}
+	return service.Chore.Run(ctx)
+}
+
+// Period returns the last finished period at now
+func Period(now time.Time) (start, end time.Time) {
+	end = now.UTC().Truncate(24 * time.Hour)
+	return end.Add(-24 * time.Hour), end
+}
+
+// publish sends the tallies of the last finished day to the nodes
+func (service *Service) publish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	start, end := Period(time.Now())
+	if !end.After(service.published) {
+		return nil
+	}
+
+	rows, err := service.db.QueryPaymentInfo(ctx, start, end)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var failed int
+	for _, row := range rows {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		tally := &pb.NodeTally{
+			SatelliteId:        service.identity.ID,
+			NodeId:             row.NodeID,
+			PeriodStartUnixSec: start.Unix(),
+			PeriodEndUnixSec:   end.Unix(),
+			AtRestTotal:        row.AtRestTotal,
+			PutTotal:           row.PutTotal,
+			GetTotal:           row.GetTotal,
+			GetAuditTotal:      row.GetAuditTotal,
+			GetRepairTotal:     row.GetRepairTotal,
+			PutRepairTotal:     row.PutRepairTotal,
+		}
+		if err := auth.SignMessage(tally, *service.identity); err != nil {
+			return Error.Wrap(err)
+		}
+
+		if err := service.send(ctx, tally); err != nil {
+			failed++
+			service.log.Debug("could not publish node tally", zap.String("Node ID", row.NodeID.String()), zap.Error(err))
+		}
+	}
+	mon.IntVal("node_tallies_failed").Observe(int64(failed))
+
+	// nodes which were offline miss the tally, it isn't worth delaying the
+	// tallies of every other node for them
+	service.published = end
+	return nil
+}
+
+// send sends a signed tally to its node
+func (service *Service) send(ctx context.Context, tally *pb.NodeTally) (err error) {
+	node, err := service.cache.Get(ctx, tally.NodeId)
+	if err != nil {
+		return err
+	}
+
+	conn, err := service.transport.DialNode(ctx, node)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	_, err = pb.NewPieceStoreRoutesClient(conn).Tally(ctx, tally)
+	return err
+}
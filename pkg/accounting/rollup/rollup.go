@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/chore"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -22,34 +23,26 @@ type Config struct {
 // Rollup is the service for totalling data on storage nodes on daily intervals
 type Rollup struct { // TODO: rename to service
 	logger *zap.Logger
-	ticker *time.Ticker
 	db     accounting.DB
+
+	Chore *chore.Chore
 }
 
 // New creates a new rollup service
 func New(logger *zap.Logger, db accounting.DB, interval time.Duration) *Rollup {
-	return &Rollup{
+	r := &Rollup{
 		logger: logger,
-		ticker: time.NewTicker(interval),
 		db:     db,
 	}
+	r.Chore = chore.New(logger, "rollup", interval, r.Query)
+	return r
 }
 
 // Run the Rollup loop
 func (r *Rollup) Run(ctx context.Context) (err error) {
 	r.logger.Info("Rollup service starting up")
 	defer mon.Task()(&ctx)(&err)
-	for {
-		err = r.Query(ctx)
-		if err != nil {
-			r.logger.Error("Query failed", zap.Error(err))
-		}
-		select {
-		case <-r.ticker.C: // wait for the next interval to happen
-		case <-ctx.Done(): // or the Rollup is canceled via context
-			return ctx.Err()
-		}
-	}
+	return r.Chore.Run(ctx)
 }
 
 // Query rolls up raw tally
@@ -13,6 +13,7 @@ import (
 
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/bwagreement"
+	"storj.io/storj/pkg/chore"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storj"
@@ -30,38 +31,31 @@ type Tally struct { // TODO: rename Tally to Service
 	overlay       pb.OverlayServer // TODO: this should be *overlay.Service
 	limit         int
 	logger        *zap.Logger
-	ticker        *time.Ticker
 	accountingDB  accounting.DB
 	bwAgreementDB bwagreement.DB // bwagreements database
+
+	Chore *chore.Chore
 }
 
 // New creates a new Tally
 func New(logger *zap.Logger, accountingDB accounting.DB, bwAgreementDB bwagreement.DB, pointerdb *pointerdb.Service, overlay pb.OverlayServer, limit int, interval time.Duration) *Tally {
-	return &Tally{
+	t := &Tally{
 		pointerdb:     pointerdb,
 		overlay:       overlay,
 		limit:         limit,
 		logger:        logger,
-		ticker:        time.NewTicker(interval),
 		accountingDB:  accountingDB,
 		bwAgreementDB: bwAgreementDB,
 	}
+	t.Chore = chore.New(logger, "tally", interval, t.Tally)
+	return t
 }
 
 // Run the Tally loop
 func (t *Tally) Run(ctx context.Context) (err error) {
 	t.logger.Info("Tally service starting up")
 	defer mon.Task()(&ctx)(&err)
-	for {
-		if err = t.Tally(ctx); err != nil {
-			t.logger.Error("Tally failed", zap.Error(err))
-		}
-		select {
-		case <-t.ticker.C: // wait for the next interval to happen
-		case <-ctx.Done(): // or the Tally is canceled via context
-			return ctx.Err()
-		}
-	}
+	return t.Chore.Run(ctx)
 }
 
 //Tally calculates data-at-rest and bandwidth usage once
@@ -0,0 +1,74 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Config configures the admin api of a peer
+type Config struct {
+	Address string `help:"address of the admin api, disabled when empty" default:""`
+}
+
+// Server serves the admin apis of all subsystems of a peer on a single
+// address. The subsystems register their handlers under their own paths.
+type Server struct {
+	listener net.Listener
+	mux      *http.ServeMux
+	server   http.Server
+}
+
+// NewServer creates an admin server, which serves on listener. A nil listener
+// disables the server, but handlers can still be registered.
+func NewServer(listener net.Listener) *Server {
+	mux := http.NewServeMux()
+	return &Server{
+		listener: listener,
+		mux:      mux,
+		server:   http.Server{Handler: mux},
+	}
+}
+
+// Handle registers the handler of a subsystem for path and everything below it
+func (server *Server) Handle(path string, handler http.Handler) {
+	server.mux.Handle(path, handler)
+	server.mux.Handle(path+"/", handler)
+}
+
+// ServeHTTP dispatches the request to the handler registered for its path
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mux.ServeHTTP(w, r)
+}
+
+// Run serves the admin apis until the context is canceled
+func (server *Server) Run(ctx context.Context) error {
+	if server.listener == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return server.server.Shutdown(context.Background())
+	})
+	group.Go(func() error {
+		defer cancel()
+		return server.server.Serve(server.listener)
+	})
+	return group.Wait()
+}
+
+// Close closes the server and the underlying listener
+func (server *Server) Close() error {
+	if server.listener == nil {
+		return nil
+	}
+	return server.server.Close()
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/pkg/admin"
+)
+
+func TestServer(t *testing.T) {
+	server := admin.NewServer(nil)
+	server.Handle("/chores", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "chores")
+	}))
+	server.Handle("/blocks", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "blocks")
+	}))
+
+	get := func(path string) (int, string) {
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder.Code, recorder.Body.String()
+	}
+
+	// the handlers get their path and everything below it
+	for path, expected := range map[string]string{
+		"/chores":             "chores",
+		"/chores/tally/pause": "chores",
+		"/blocks":             "blocks",
+		"/blocks/1":           "blocks",
+	} {
+		code, body := get(path)
+		assert.Equal(t, http.StatusOK, code, path)
+		assert.Equal(t, expected, body, path)
+	}
+
+	code, _ := get("/unknown")
+	assert.Equal(t, http.StatusNotFound, code)
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"net"
+
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	// ErrContainedNotFound is returned when a node isn't contained
+	ErrContainedNotFound = errs.Class("pending audit not found")
+	// ErrAlreadyExists is returned when a node is contained for another audit
+	ErrAlreadyExists = errs.Class("node is already contained for another audit")
+)
+
+// PendingAudit is an audit of a node, which timed out. The node is contained
+// and audited for the same stripe again, until it answers or its audit fails.
+type PendingAudit struct {
+	NodeID            storj.NodeID
+	Path              storj.Path
+	PieceID           string
+	PieceNumber       int
+	StripeIndex       int
+	ShareSize         int
+	ExpectedShareHash []byte
+	ReverifyCount     int
+}
+
+// Containment stores the pending audits of the contained nodes
+type Containment interface {
+	// Get returns the pending audit of a node, ErrContainedNotFound when it isn't contained
+	Get(ctx context.Context, nodeID storj.NodeID) (*PendingAudit, error)
+	// IncrementPending contains a node for the pending audit, or increments the
+	// reverify count when the node is contained for the same audit already,
+	// ErrAlreadyExists when it's contained for another one
+	IncrementPending(ctx context.Context, pending *PendingAudit) error
+	// Delete releases a node from containment, it returns whether the node was contained
+	Delete(ctx context.Context, nodeID storj.NodeID) (bool, error)
+}
+
+// isTimeout returns whether downloading a share failed, because the node
+// didn't answer in time
+func isTimeout(err error) bool {
+	err = errs.Unwrap(err)
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return true
+	}
+	return err == context.DeadlineExceeded || status.Code(err) == codes.DeadlineExceeded
+}
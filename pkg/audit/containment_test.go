@@ -0,0 +1,73 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestContainment(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		containment := db.Containment()
+		nodeID := teststorj.NodeIDFromString("contained")
+
+		_, err := containment.Get(ctx, nodeID)
+		assert.True(t, audit.ErrContainedNotFound.Has(err))
+
+		pending := &audit.PendingAudit{
+			NodeID:            nodeID,
+			Path:              "project/s0/bucket/object",
+			PieceID:           "piece",
+			PieceNumber:       3,
+			StripeIndex:       7,
+			ShareSize:         256,
+			ExpectedShareHash: []byte("hash"),
+		}
+		require.NoError(t, containment.IncrementPending(ctx, pending))
+
+		stored, err := containment.Get(ctx, nodeID)
+		require.NoError(t, err)
+		assert.Equal(t, pending, stored)
+
+		// the same audit increments the reverify count
+		require.NoError(t, containment.IncrementPending(ctx, pending))
+		require.NoError(t, containment.IncrementPending(ctx, pending))
+		stored, err = containment.Get(ctx, nodeID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, stored.ReverifyCount)
+
+		// another audit doesn't replace the pending one
+		other := *pending
+		other.StripeIndex = 8
+		err = containment.IncrementPending(ctx, &other)
+		assert.True(t, audit.ErrAlreadyExists.Has(err))
+
+		stored, err = containment.Get(ctx, nodeID)
+		require.NoError(t, err)
+		assert.Equal(t, 7, stored.StripeIndex)
+
+		deleted, err := containment.Delete(ctx, nodeID)
+		require.NoError(t, err)
+		assert.True(t, deleted)
+
+		deleted, err = containment.Delete(ctx, nodeID)
+		require.NoError(t, err)
+		assert.False(t, deleted)
+
+		_, err = containment.Get(ctx, nodeID)
+		assert.True(t, audit.ErrContainedNotFound.Has(err))
+	})
+}
@@ -18,12 +18,14 @@ import (
 	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
 )
 
 // Stripe keeps track of a stripe's index and its parent segment
 type Stripe struct {
 	Index         int
 	Segment       *pb.Pointer
+	Path          storj.Path
 	PBA           *pb.PayerBandwidthAllocation
 	Authorization *pb.SignedMessage
 }
@@ -79,18 +81,7 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 	if err != nil {
 		return nil, err
 	}
-	peerIdentity := &identity.PeerIdentity{ID: cursor.identity.ID, Leaf: cursor.identity.Leaf}
-	pba, err := cursor.allocation.PayerBandwidthAllocation(ctx, peerIdentity, pb.BandwidthAction_GET_AUDIT)
-	if err != nil {
-		return nil, err
-	}
-
-	signature, err := auth.GenerateSignature(cursor.identity.ID.Bytes(), cursor.identity)
-	if err != nil {
-		return nil, err
-	}
-
-	authorization, err := auth.NewSignedMessage(signature, cursor.identity)
+	pba, authorization, err := cursor.authorize(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -117,11 +108,63 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 	return &Stripe{
 		Index:         index,
 		Segment:       pointer,
+		Path:          path,
+		PBA:           pba,
+		Authorization: authorization,
+	}, nil
+}
+
+// PendingStripe returns the stripe of a pending audit, it's nil when the
+// segment has been deleted or replaced since
+func (cursor *Cursor) PendingStripe(ctx context.Context, pending *PendingAudit) (stripe *Stripe, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := cursor.pointers.Get(pending.Path)
+	if storage.ErrKeyNotFound.Has(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if pointer.GetType() != pb.Pointer_REMOTE || pointer.GetRemote().GetPieceId() != pending.PieceID {
+		return nil, nil
+	}
+
+	pba, authorization, err := cursor.authorize(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Stripe{
+		Index:         pending.StripeIndex,
+		Segment:       pointer,
+		Path:          pending.Path,
 		PBA:           pba,
 		Authorization: authorization,
 	}, nil
 }
 
+// authorize creates the bandwidth allocation and the authorization for
+// downloading the shares of a stripe
+func (cursor *Cursor) authorize(ctx context.Context) (*pb.PayerBandwidthAllocation, *pb.SignedMessage, error) {
+	peerIdentity := &identity.PeerIdentity{ID: cursor.identity.ID, Leaf: cursor.identity.Leaf}
+	pba, err := cursor.allocation.PayerBandwidthAllocation(ctx, peerIdentity, pb.BandwidthAction_GET_AUDIT)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signature, err := auth.GenerateSignature(cursor.identity.ID.Bytes(), cursor.identity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authorization, err := auth.NewSignedMessage(signature, cursor.identity)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pba, authorization, nil
+}
+
 func makeErasureScheme(rs *pb.RedundancyScheme) (eestream.ErasureScheme, error) {
 	required := int(rs.GetMinReq())
 	total := int(rs.GetTotal())
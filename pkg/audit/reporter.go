@@ -6,6 +6,8 @@ package audit
 import (
 	"context"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 )
@@ -16,8 +18,9 @@ type reporter interface {
 
 // Reporter records audit reports in statdb and implements the reporter interface
 type Reporter struct {
-	statdb     statdb.DB
-	maxRetries int
+	statdb      statdb.DB
+	containment Containment
+	maxRetries  int
 }
 
 // RecordAuditsInfo is a struct containing arguments/return values for RecordAudits()
@@ -25,113 +28,92 @@ type RecordAuditsInfo struct {
 	SuccessNodeIDs storj.NodeIDList
 	FailNodeIDs    storj.NodeIDList
 	OfflineNodeIDs storj.NodeIDList
+	// PendingAudits are the audits of the nodes, which timed out
+	PendingAudits []*PendingAudit
 }
 
 // NewReporter instantiates a reporter
-func NewReporter(sdb statdb.DB, maxRetries int) *Reporter {
-	return &Reporter{statdb: sdb, maxRetries: maxRetries}
+func NewReporter(sdb statdb.DB, containment Containment, maxRetries int) *Reporter {
+	return &Reporter{statdb: sdb, containment: containment, maxRetries: maxRetries}
 }
 
-// RecordAudits saves failed audit details to statdb
+// RecordAudits saves the audit outcomes of the nodes of a segment to statdb in a single batch
+// and contains the nodes, which timed out
 func (reporter *Reporter) RecordAudits(ctx context.Context, req *RecordAuditsInfo) (failed *RecordAuditsInfo, err error) {
-	successNodeIDs := req.SuccessNodeIDs
-	failNodeIDs := req.FailNodeIDs
-	offlineNodeIDs := req.OfflineNodeIDs
-
-	var errNodeIDs storj.NodeIDList
+	defer mon.Task()(&ctx)(&err)
 
-	retries := 0
-	for retries < reporter.maxRetries {
-		if len(successNodeIDs) == 0 && len(failNodeIDs) == 0 && len(offlineNodeIDs) == 0 {
-			return nil, nil
-		}
+	failedPending, containErr := reporter.contain(ctx, req.PendingAudits)
 
-		errNodeIDs = storj.NodeIDList{}
+	requests := updateRequests(req)
 
-		if len(successNodeIDs) > 0 {
-			successNodeIDs, err = reporter.recordAuditSuccessStatus(ctx, successNodeIDs)
-			if err != nil {
-				errNodeIDs = append(errNodeIDs, successNodeIDs...)
-			}
-		}
-		if len(failNodeIDs) > 0 {
-			failNodeIDs, err = reporter.recordAuditFailStatus(ctx, failNodeIDs)
-			if err != nil {
-				errNodeIDs = append(errNodeIDs, failNodeIDs...)
-			}
-		}
-		if len(offlineNodeIDs) > 0 {
-			offlineNodeIDs, err = reporter.recordOfflineStatus(ctx, offlineNodeIDs)
-			if err != nil {
-				errNodeIDs = append(errNodeIDs, offlineNodeIDs...)
-			}
-		}
-
-		retries++
+	var failedRequests []*statdb.UpdateRequest
+	for retries := 0; retries < reporter.maxRetries && len(requests) > 0; retries++ {
+		_, failedRequests, err = reporter.statdb.UpdateBatch(ctx, requests)
+		requests = failedRequests
 	}
-	if retries >= reporter.maxRetries && len(errNodeIDs) > 0 {
-		return &RecordAuditsInfo{
-			SuccessNodeIDs: successNodeIDs,
-			FailNodeIDs:    failNodeIDs,
-			OfflineNodeIDs: offlineNodeIDs,
-		}, Error.New("some nodes failed to be updated in statdb")
+	if len(failedRequests) > 0 || len(failedPending) > 0 {
+		failed = recordAuditsInfo(failedRequests)
+		failed.PendingAudits = failedPending
+		return failed, Error.New("some nodes failed to be updated in statdb: %v", errs.Combine(err, containErr))
 	}
 	return nil, nil
 }
 
-// recordAuditFailStatus updates nodeIDs in statdb with isup=true, auditsuccess=false
-func (reporter *Reporter) recordAuditFailStatus(ctx context.Context, failedAuditNodeIDs storj.NodeIDList) (failed storj.NodeIDList, err error) {
-	failedIDs := storj.NodeIDList{}
-
-	for _, nodeID := range failedAuditNodeIDs {
-		_, err := reporter.statdb.Update(ctx, &statdb.UpdateRequest{
-			NodeID:       nodeID,
-			IsUp:         true,
-			AuditSuccess: false,
-		})
-		if err != nil {
-			failedIDs = append(failedIDs, nodeID)
+// contain contains the nodes of the pending audits, nodes which are already
+// contained for another audit stay contained for that one
+func (reporter *Reporter) contain(ctx context.Context, pendingAudits []*PendingAudit) (failed []*PendingAudit, err error) {
+	var errlist errs.Group
+	for _, pending := range pendingAudits {
+		err := reporter.containment.IncrementPending(ctx, pending)
+		if err != nil && !ErrAlreadyExists.Has(err) {
+			failed = append(failed, pending)
+			errlist.Add(err)
 		}
 	}
-	if len(failedIDs) > 0 {
-		return failedIDs, Error.New("failed to record some audit fail statuses in statdb")
-	}
-	return nil, nil
+	return failed, errlist.Err()
 }
 
-// recordOfflineStatus updates nodeIDs in statdb with isup=false
+// updateRequests returns the statdb update requests of the audit outcomes,
+// offline nodes only have their uptime updated
 // TODO: offline nodes should maybe be marked as failing the audit in the future
-func (reporter *Reporter) recordOfflineStatus(ctx context.Context, offlineNodeIDs storj.NodeIDList) (failed storj.NodeIDList, err error) {
-	failedIDs := storj.NodeIDList{}
-
-	for _, nodeID := range offlineNodeIDs {
-		_, err := reporter.statdb.UpdateUptime(ctx, nodeID, false)
-		if err != nil {
-			failedIDs = append(failedIDs, nodeID)
-		}
-	}
-	if len(failedIDs) > 0 {
-		return failedIDs, Error.New("failed to record some audit offline statuses in statdb")
-	}
-	return nil, nil
-}
-
-// recordAuditSuccessStatus updates nodeIDs in statdb with isup=true, auditsuccess=true
-func (reporter *Reporter) recordAuditSuccessStatus(ctx context.Context, successNodeIDs storj.NodeIDList) (failed storj.NodeIDList, err error) {
-	failedIDs := storj.NodeIDList{}
-
-	for _, nodeID := range successNodeIDs {
-		_, err := reporter.statdb.Update(ctx, &statdb.UpdateRequest{
+func updateRequests(req *RecordAuditsInfo) []*statdb.UpdateRequest {
+	var requests []*statdb.UpdateRequest
+	for _, nodeID := range req.SuccessNodeIDs {
+		requests = append(requests, &statdb.UpdateRequest{
 			NodeID:       nodeID,
 			IsUp:         true,
 			AuditSuccess: true,
 		})
-		if err != nil {
-			failedIDs = append(failedIDs, nodeID)
-		}
 	}
-	if len(failedIDs) > 0 {
-		return failedIDs, Error.New("failed to record some audit success statuses in statdb")
+	for _, nodeID := range req.FailNodeIDs {
+		requests = append(requests, &statdb.UpdateRequest{
+			NodeID:       nodeID,
+			IsUp:         true,
+			AuditSuccess: false,
+		})
 	}
-	return nil, nil
+	for _, nodeID := range req.OfflineNodeIDs {
+		requests = append(requests, &statdb.UpdateRequest{
+			NodeID:     nodeID,
+			IsUp:       false,
+			UptimeOnly: true,
+		})
+	}
+	return requests
+}
+
+// recordAuditsInfo returns the audit outcomes of the update requests
+func recordAuditsInfo(requests []*statdb.UpdateRequest) *RecordAuditsInfo {
+	info := &RecordAuditsInfo{}
+	for _, request := range requests {
+		switch {
+		case !request.IsUp:
+			info.OfflineNodeIDs = append(info.OfflineNodeIDs, request.NodeID)
+		case request.AuditSuccess:
+			info.SuccessNodeIDs = append(info.SuccessNodeIDs, request.NodeID)
+		default:
+			info.FailNodeIDs = append(info.FailNodeIDs, request.NodeID)
+		}
+	}
+	return info
 }
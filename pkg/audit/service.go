@@ -13,6 +13,7 @@ import (
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 )
 
@@ -20,29 +21,37 @@ import (
 type Config struct {
 	MaxRetriesStatDB int           `help:"max number of times to attempt updating a statdb batch" default:"3"`
 	Interval         time.Duration `help:"how frequently segments are audited" default:"30s"`
+	ShareTimeout     time.Duration `help:"how long downloading a share may take before the node is contained, 0 waits forever" default:"30s"`
+	MaxReverifyCount int           `help:"how many times a contained node is audited again for the same stripe before it fails the audit" default:"3"`
 }
 
 // Service helps coordinate Cursor and Verifier to run the audit process continuously
 type Service struct {
 	log *zap.Logger
 
-	Cursor   *Cursor
-	Verifier *Verifier
-	Reporter reporter
+	Cursor      *Cursor
+	Verifier    *Verifier
+	Reporter    reporter
+	Containment Containment
+
+	maxReverifyCount int
 
 	ticker *time.Ticker
 }
 
 // NewService instantiates a Service with access to a Cursor and Verifier
-func NewService(log *zap.Logger, sdb statdb.DB, interval time.Duration, maxRetries int, pointers *pointerdb.Service, allocation *pointerdb.AllocationSigner, transport transport.Client, overlay *overlay.Cache, identity *identity.FullIdentity) (service *Service, err error) {
+func NewService(log *zap.Logger, sdb statdb.DB, containment Containment, config Config, pointers *pointerdb.Service, allocation *pointerdb.AllocationSigner, transport transport.Client, overlay *overlay.Cache, identity *identity.FullIdentity) (service *Service, err error) {
 	return &Service{
 		log: log,
 		// TODO: instead of overlay.Client use overlay.Service
-		Cursor:   NewCursor(pointers, allocation, identity),
-		Verifier: NewVerifier(transport, overlay, identity),
-		Reporter: NewReporter(sdb, maxRetries),
+		Cursor:      NewCursor(pointers, allocation, identity),
+		Verifier:    NewVerifier(transport, overlay, identity, config.ShareTimeout),
+		Reporter:    NewReporter(sdb, containment, config.MaxRetriesStatDB),
+		Containment: containment,
+
+		maxReverifyCount: config.MaxReverifyCount,
 
-		ticker: time.NewTicker(interval),
+		ticker: time.NewTicker(config.Interval),
 	}, nil
 }
 
@@ -74,6 +83,13 @@ func (service *Service) process(ctx context.Context) error {
 		return nil
 	}
 
+	// the contained nodes of the segment are audited again for their pending stripe
+	for _, piece := range stripe.Segment.GetRemote().GetRemotePieces() {
+		if err := service.reverify(ctx, piece.NodeId); err != nil {
+			service.log.Error("reverify", zap.String("Node ID", piece.NodeId.String()), zap.Error(err))
+		}
+	}
+
 	verifiedNodes, err := service.Verifier.verify(ctx, stripe)
 	if err != nil {
 		return err
@@ -87,3 +103,60 @@ func (service *Service) process(ctx context.Context) error {
 
 	return nil
 }
+
+// reverify audits a contained node again for the stripe of its pending
+// audit. The node is released when it answers, it fails the audit when it
+// has timed out more than the max reverify count.
+func (service *Service) reverify(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pending, err := service.Containment.Get(ctx, nodeID)
+	if ErrContainedNotFound.Has(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	stripe, err := service.Cursor.PendingStripe(ctx, pending)
+	if err != nil {
+		return err
+	}
+	if stripe == nil {
+		// the segment is gone, so the share can't be audited anymore
+		_, err = service.Containment.Delete(ctx, nodeID)
+		return err
+	}
+
+	outcome, err := service.Verifier.reverify(ctx, pending, stripe)
+	if err != nil {
+		return err
+	}
+
+	report := &RecordAuditsInfo{}
+	switch outcome {
+	case reverifySuccess:
+		report.SuccessNodeIDs = storj.NodeIDList{nodeID}
+	case reverifyFailed:
+		report.FailNodeIDs = storj.NodeIDList{nodeID}
+	case reverifyTimeout:
+		if pending.ReverifyCount+1 < service.maxReverifyCount {
+			report.PendingAudits = []*PendingAudit{pending}
+		} else {
+			service.log.Info("contained node timed out too often, failing its audit", zap.String("Node ID", nodeID.String()))
+			report.FailNodeIDs = storj.NodeIDList{nodeID}
+		}
+	case reverifyOffline:
+		// the node stays contained until it can be reached
+		report.OfflineNodeIDs = storj.NodeIDList{nodeID}
+	}
+
+	if len(report.SuccessNodeIDs) > 0 || len(report.FailNodeIDs) > 0 {
+		if _, err := service.Containment.Delete(ctx, nodeID); err != nil {
+			return err
+		}
+	}
+
+	_, err = service.Reporter.RecordAudits(ctx, report)
+	return err
+}
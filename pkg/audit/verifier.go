@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"time"
 
 	"github.com/vivint/infectious"
 	"github.com/zeebo/errs"
@@ -16,6 +17,7 @@ import (
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/pkcrypto"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
 )
@@ -36,24 +38,26 @@ type Verifier struct {
 
 type downloader interface {
 	DownloadShares(ctx context.Context, pointer *pb.Pointer, stripeIndex int, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (shares map[int]Share, nodes map[int]storj.NodeID, err error)
+	DownloadShare(ctx context.Context, pointer *pb.Pointer, stripeIndex, pieceNumber int, nodeID storj.NodeID, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (Share, error)
 }
 
 // defaultDownloader downloads shares from networked storage nodes
 type defaultDownloader struct {
-	transport transport.Client
-	overlay   *overlay.Cache
-	identity  *identity.FullIdentity
+	transport    transport.Client
+	overlay      *overlay.Cache
+	identity     *identity.FullIdentity
+	shareTimeout time.Duration
 	reporter
 }
 
 // newDefaultDownloader creates a defaultDownloader
-func newDefaultDownloader(transport transport.Client, overlay *overlay.Cache, id *identity.FullIdentity) *defaultDownloader {
-	return &defaultDownloader{transport: transport, overlay: overlay, identity: id}
+func newDefaultDownloader(transport transport.Client, overlay *overlay.Cache, id *identity.FullIdentity, shareTimeout time.Duration) *defaultDownloader {
+	return &defaultDownloader{transport: transport, overlay: overlay, identity: id, shareTimeout: shareTimeout}
 }
 
-// NewVerifier creates a Verifier
-func NewVerifier(transport transport.Client, overlay *overlay.Cache, id *identity.FullIdentity) *Verifier {
-	return &Verifier{downloader: newDefaultDownloader(transport, overlay, id)}
+// NewVerifier creates a Verifier, downloading a share times out after shareTimeout
+func NewVerifier(transport transport.Client, overlay *overlay.Cache, id *identity.FullIdentity, shareTimeout time.Duration) *Verifier {
+	return &Verifier{downloader: newDefaultDownloader(transport, overlay, id, shareTimeout)}
 }
 
 // getShare use piece store clients to download shares from a given node
@@ -67,6 +71,13 @@ func (d *defaultDownloader) getShare(ctx context.Context, stripeIndex, shareSize
 		return s, Error.New("no node returned from overlay for piece %s", id.String())
 	}
 	fromNode.Type.DPanicOnInvalid("audit getShare")
+
+	if d.shareTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.shareTimeout)
+		defer cancel()
+	}
+
 	ps, err := psclient.NewPSClient(ctx, d.transport, fromNode, 0)
 	if err != nil {
 		return s, err
@@ -130,8 +141,7 @@ func (d *defaultDownloader) DownloadShares(ctx context.Context, pointer *pb.Poin
 
 	// this downloads shares from nodes at the given stripe index
 	for i, node := range nodeSlice {
-		paddedSize := calcPadded(pointer.GetSegmentSize(), shareSize)
-		pieceSize := paddedSize / int64(pointer.Remote.Redundancy.GetMinReq())
+		pieceSize := calcPieceSize(pointer)
 
 		s, err := d.getShare(ctx, stripeIndex, shareSize, int(pieces[i].PieceNum), pieceID, pieceSize, node, pba, authorization)
 		if err != nil {
@@ -149,6 +159,26 @@ func (d *defaultDownloader) DownloadShares(ctx context.Context, pointer *pb.Poin
 	return shares, nodes, nil
 }
 
+// DownloadShare downloads the share of a single piece of the stripe from its node
+func (d *defaultDownloader) DownloadShare(ctx context.Context, pointer *pb.Pointer, stripeIndex, pieceNumber int,
+	nodeID storj.NodeID, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (share Share, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := d.overlay.Get(ctx, nodeID)
+	if err != nil {
+		return Share{}, err
+	}
+
+	shareSize := int(pointer.Remote.Redundancy.GetErasureShareSize())
+	pieceID := psclient.PieceID(pointer.Remote.GetPieceId())
+
+	share, err = d.getShare(ctx, stripeIndex, shareSize, pieceNumber, pieceID, calcPieceSize(pointer), node, pba, authorization)
+	if err != nil {
+		return Share{Error: err, PieceNumber: pieceNumber}, nil
+	}
+	return share, nil
+}
+
 func makeCopies(ctx context.Context, originals map[int]Share) (copies []infectious.Share, err error) {
 	defer mon.Task()(&ctx)(&err)
 	copies = make([]infectious.Share, 0, len(originals))
@@ -189,6 +219,13 @@ func auditShares(ctx context.Context, required, total int, originals map[int]Sha
 	return pieceNums, nil
 }
 
+// calcPieceSize returns the size of the pieces of a segment
+func calcPieceSize(pointer *pb.Pointer) int64 {
+	shareSize := int(pointer.Remote.Redundancy.GetErasureShareSize())
+	paddedSize := calcPadded(pointer.GetSegmentSize(), shareSize)
+	return paddedSize / int64(pointer.Remote.Redundancy.GetMinReq())
+}
+
 func calcPadded(size int64, blockSize int) int64 {
 	mod := size % int64(blockSize)
 	if mod == 0 {
@@ -206,9 +243,15 @@ func (verifier *Verifier) verify(ctx context.Context, stripe *Stripe) (verifiedN
 		return nil, err
 	}
 
+	// nodes which time out are contained instead of being counted as offline
 	var offlineNodes storj.NodeIDList
+	var timedOut []int
 	for pieceNum := range shares {
-		if shares[pieceNum].Error != nil {
+		switch err := shares[pieceNum].Error; {
+		case err == nil:
+		case isTimeout(err):
+			timedOut = append(timedOut, pieceNum)
+		default:
 			offlineNodes = append(offlineNodes, nodes[pieceNum])
 		}
 	}
@@ -226,15 +269,113 @@ func (verifier *Verifier) verify(ctx context.Context, stripe *Stripe) (verifiedN
 		failedNodes = append(failedNodes, nodes[pieceNum])
 	}
 
-	successNodes := getSuccessNodes(ctx, nodes, failedNodes, offlineNodes)
+	var pendingAudits []*PendingAudit
+	if len(timedOut) > 0 {
+		hashes, err := expectedShareHashes(ctx, required, total, shares, timedOut)
+		if err != nil {
+			return nil, err
+		}
+		for _, pieceNum := range timedOut {
+			pendingAudits = append(pendingAudits, &PendingAudit{
+				NodeID:            nodes[pieceNum],
+				Path:              stripe.Path,
+				PieceID:           pointer.Remote.GetPieceId(),
+				PieceNumber:       pieceNum,
+				StripeIndex:       stripe.Index,
+				ShareSize:         int(pointer.Remote.Redundancy.GetErasureShareSize()),
+				ExpectedShareHash: hashes[pieceNum],
+			})
+		}
+	}
+
+	var skippedNodes storj.NodeIDList
+	skippedNodes = append(skippedNodes, offlineNodes...)
+	for _, pending := range pendingAudits {
+		skippedNodes = append(skippedNodes, pending.NodeID)
+	}
+	successNodes := getSuccessNodes(ctx, nodes, failedNodes, skippedNodes)
 
 	return &RecordAuditsInfo{
 		SuccessNodeIDs: successNodes,
 		FailNodeIDs:    failedNodes,
 		OfflineNodeIDs: offlineNodes,
+		PendingAudits:  pendingAudits,
 	}, nil
 }
 
+// expectedShareHashes rebuilds the stripe from the downloaded shares and
+// returns the hashes of the shares of the given pieces
+func expectedShareHashes(ctx context.Context, required, total int, shares map[int]Share, pieceNums []int) (hashes map[int][]byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	f, err := infectious.NewFEC(required, total)
+	if err != nil {
+		return nil, err
+	}
+
+	copies, err := makeCopies(ctx, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	stripe, err := f.Decode(nil, copies)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[int]bool, len(pieceNums))
+	for _, pieceNum := range pieceNums {
+		wanted[pieceNum] = true
+	}
+
+	hashes = make(map[int][]byte, len(pieceNums))
+	err = f.Encode(stripe, func(share infectious.Share) {
+		if wanted[share.Number] {
+			hashes[share.Number] = pkcrypto.SHA256Hash(share.Data)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
+
+// reverify downloads the share of a pending audit from the contained node
+// again and returns the outcome
+func (verifier *Verifier) reverify(ctx context.Context, pending *PendingAudit, stripe *Stripe) (outcome reverifyOutcome, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	share, err := verifier.downloader.DownloadShare(ctx, stripe.Segment, stripe.Index, pending.PieceNumber, pending.NodeID, stripe.PBA, stripe.Authorization)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case share.Error == nil:
+		if bytes.Equal(pkcrypto.SHA256Hash(share.Data), pending.ExpectedShareHash) {
+			return reverifySuccess, nil
+		}
+		return reverifyFailed, nil
+	case isTimeout(share.Error):
+		return reverifyTimeout, nil
+	default:
+		return reverifyOffline, nil
+	}
+}
+
+// reverifyOutcome is the outcome of auditing a contained node again
+type reverifyOutcome int
+
+const (
+	// reverifySuccess is returned when the node returned the expected share
+	reverifySuccess reverifyOutcome = iota + 1
+	// reverifyFailed is returned when the node returned another share
+	reverifyFailed
+	// reverifyTimeout is returned when the node timed out again
+	reverifyTimeout
+	// reverifyOffline is returned when the node couldn't be reached
+	reverifyOffline
+)
+
 // getSuccessNodes uses the failed nodes and offline nodes arrays to determine which nodes passed the audit
 func getSuccessNodes(ctx context.Context, nodes map[int]storj.NodeID, failedNodes, offlineNodes storj.NodeIDList) (successNodes storj.NodeIDList) {
 	fails := make(map[storj.NodeID]bool)
@@ -0,0 +1,128 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package auditlog
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the audit log
+	Error = errs.Class("audit log error")
+)
+
+// exportBatchSize is the number of entries read at once while exporting
+const exportBatchSize = 1000
+
+// Entry records who did what, when and from where
+type Entry struct {
+	ID         int64     `json:"id"`
+	Actor      string    `json:"actor"`
+	Action     string    `json:"action"`
+	Target     string    `json:"target"`
+	RemoteAddr string    `json:"remote_addr"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// DB stores the audit log, entries can only be appended
+type DB interface {
+	// Append adds an entry and returns it with its ID and creation time set
+	Append(ctx context.Context, entry Entry) (Entry, error)
+	// List returns up to limit entries created in [since, until), oldest first
+	List(ctx context.Context, since, until time.Time, limit int, offset int64) ([]Entry, error)
+}
+
+// Log records the operations of authenticated users and operators
+type Log struct {
+	log *zap.Logger
+	db  DB
+}
+
+// New creates an audit log
+func New(log *zap.Logger, db DB) *Log {
+	return &Log{log: log, db: db}
+}
+
+// Record appends an entry for an operation of actor on target, the remote
+// address is taken from the context. The operation has already happened at
+// this point, so failures are only logged.
+func (log *Log) Record(ctx context.Context, actor, action, target string) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = log.db.Append(ctx, Entry{
+		Actor:      actor,
+		Action:     action,
+		Target:     target,
+		RemoteAddr: RemoteAddr(ctx),
+	})
+	if err != nil {
+		log.log.Error("failed to record audit log entry",
+			zap.String("actor", actor), zap.String("action", action), zap.String("target", target), zap.Error(err))
+	}
+}
+
+// Export writes the entries created in [since, until) as csv
+func (log *Log) Export(ctx context.Context, since, until time.Time, output io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	w := csv.NewWriter(output)
+	if err := w.Write([]string{"id", "createdAt", "actor", "action", "target", "remoteAddr"}); err != nil {
+		return Error.Wrap(err)
+	}
+
+	for offset := int64(0); ; {
+		entries, err := log.db.List(ctx, since, until, exportBatchSize, offset)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		for _, entry := range entries {
+			err := w.Write([]string{
+				strconv.FormatInt(entry.ID, 10),
+				entry.CreatedAt.UTC().Format(time.RFC3339),
+				entry.Actor,
+				entry.Action,
+				entry.Target,
+				entry.RemoteAddr,
+			})
+			if err != nil {
+				return Error.Wrap(err)
+			}
+		}
+		if len(entries) < exportBatchSize {
+			break
+		}
+		offset += int64(len(entries))
+	}
+
+	w.Flush()
+	return Error.Wrap(w.Error())
+}
+
+// key is a context value key type
+type key int
+
+// remoteAddrKey is the context key for the remote address
+const remoteAddrKey key = 0
+
+// WithRemoteAddr creates a new context with the address of the remote peer
+func WithRemoteAddr(ctx context.Context, addr string) context.Context {
+	return context.WithValue(ctx, remoteAddrKey, addr)
+}
+
+// RemoteAddr returns the address of the remote peer from the context
+func RemoteAddr(ctx context.Context) string {
+	addr, _ := ctx.Value(remoteAddrKey).(string)
+	return addr
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package auditlog_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/auditlog"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestRecordAndExport(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := auditlog.New(zap.NewNop(), db.AuditLog())
+
+		log.Record(auditlog.WithRemoteAddr(ctx, "10.0.0.1:1234"), "alice", "console:create-project", "project")
+		log.Record(ctx, "bob", "console:delete-project", "project")
+
+		since, until := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+		entries, err := db.AuditLog().List(ctx, since, until, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "alice", entries[0].Actor)
+		assert.Equal(t, "10.0.0.1:1234", entries[0].RemoteAddr)
+		assert.Equal(t, "bob", entries[1].Actor)
+		assert.Equal(t, "", entries[1].RemoteAddr)
+
+		entries, err = db.AuditLog().List(ctx, until, until.Add(time.Hour), 10, 0)
+		require.NoError(t, err)
+		assert.Len(t, entries, 0)
+
+		var exported bytes.Buffer
+		require.NoError(t, log.Export(ctx, since, until, &exported))
+
+		records, err := csv.NewReader(&exported).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, []string{"alice", "console:create-project", "project", "10.0.0.1:1234"}, records[1][2:])
+	})
+}
+
+func TestHandler(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		log := auditlog.New(zap.NewNop(), db.AuditLog())
+		handler := log.Handler("test", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/fail" {
+				http.Error(w, "failed", http.StatusBadRequest)
+			}
+		}))
+
+		request := func(method, path string) {
+			r := httptest.NewRequest(method, path, nil)
+			r.Header.Set(auditlog.OperatorHeader, "operator")
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+		}
+		request(http.MethodGet, "/read")
+		request(http.MethodPost, "/fail")
+		request(http.MethodPost, "/change?reason=test")
+
+		entries, err := db.AuditLog().List(ctx, time.Now().Add(-time.Hour), time.Now().Add(time.Hour), 10, 0)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "operator", entries[0].Actor)
+		assert.Equal(t, "test:POST", entries[0].Action)
+		assert.Equal(t, "/change?reason=test", entries[0].Target)
+		assert.NotEmpty(t, entries[0].RemoteAddr)
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package auditlog
+
+import (
+	"net/http"
+)
+
+// OperatorHeader is the header naming the operator who calls an admin api
+const OperatorHeader = "X-Operator"
+
+// Handler records every successful request of next, which isn't read-only.
+// The admin apis don't authenticate their callers, so the actor is the
+// operator named in the OperatorHeader.
+func (log *Log) Handler(component string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		if recorder.status >= http.StatusBadRequest {
+			return
+		}
+
+		actor := r.Header.Get(OperatorHeader)
+		if actor == "" {
+			actor = "unknown"
+		}
+		target := r.URL.Path
+		if r.URL.RawQuery != "" {
+			target += "?" + r.URL.RawQuery
+		}
+
+		ctx := WithRemoteAddr(r.Context(), r.RemoteAddr)
+		log.Record(ctx, actor, component+":"+r.Method, target)
+	})
+}
+
+// statusRecorder remembers the status code of a response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records the status code and writes it
+func (recorder *statusRecorder) WriteHeader(status int) {
+	recorder.status = status
+	recorder.ResponseWriter.WriteHeader(status)
+}
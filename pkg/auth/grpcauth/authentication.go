@@ -18,19 +18,42 @@ func NewAPIKeyInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{},
 		err error) {
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return handler(ctx, req)
-		}
-		apikeys, ok := md["apikey"]
-		if !ok || len(apikeys) == 0 {
-			return handler(ctx, req)
-		}
-
-		return handler(auth.WithAPIKey(ctx, []byte(apikeys[0])), req)
+		return handler(withAPIKey(ctx), req)
 	}
 }
 
+// NewAPIKeyStreamInterceptor creates instance of apikey interceptor for streams
+func NewAPIKeyStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: withAPIKey(ss.Context())})
+	}
+}
+
+// withAPIKey adds the api key of the incoming metadata to the context
+func withAPIKey(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	apikeys, ok := md["apikey"]
+	if !ok || len(apikeys) == 0 {
+		return ctx
+	}
+
+	return auth.WithAPIKey(ctx, []byte(apikeys[0]))
+}
+
+// serverStream is a server stream with a different context
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream
+func (stream *serverStream) Context() context.Context { return stream.ctx }
+
 // NewAPIKeyInjector injects api key to grpc connection context
 func NewAPIKeyInjector(APIKey string, callOpts ...grpc.CallOption) grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
@@ -39,3 +62,12 @@ func NewAPIKeyInjector(APIKey string, callOpts ...grpc.CallOption) grpc.UnaryCli
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+// NewAPIKeyStreamInjector injects api key to the streams of a grpc connection
+func NewAPIKeyStreamInjector(APIKey string, callOpts ...grpc.CallOption) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		opts = append(opts, callOpts...)
+		ctx = metadata.AppendToOutgoingContext(ctx, "apikey", APIKey)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
@@ -75,3 +75,70 @@ func TestAPIKeyInjector(t *testing.T) {
 		assert.Equal(t, tt.APIKey, strings.Join(md["apikey"], ""))
 	}
 }
+
+// mockServerStream is a server stream, which only has a context
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *mockServerStream) Context() context.Context { return stream.ctx }
+
+func TestAPIKeyStreamInterceptor(t *testing.T) {
+	for _, tt := range []struct {
+		APIKey string
+		err    error
+	}{
+		{"", status.Errorf(codes.Unauthenticated, "Invalid API credential")},
+		{"good key", nil},
+		{"wrong key", status.Errorf(codes.Unauthenticated, "Invalid API credential")},
+	} {
+		interceptor := NewAPIKeyStreamInterceptor()
+
+		// mock for stream handler
+		handler := func(srv interface{}, stream grpc.ServerStream) error {
+			APIKey, ok := auth.GetAPIKey(stream.Context())
+			if !ok || string(APIKey) != "good key" {
+				return status.Errorf(codes.Unauthenticated, "Invalid API credential")
+			}
+			return nil
+		}
+
+		ctx := context.Background()
+
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("apikey", tt.APIKey))
+		info := &grpc.StreamServerInfo{}
+
+		err := interceptor(nil, &mockServerStream{ctx: ctx}, info, handler)
+
+		assert.Equal(t, err, tt.err)
+	}
+}
+
+func TestAPIKeyStreamInjector(t *testing.T) {
+	for _, tt := range []struct {
+		APIKey string
+		err    error
+	}{
+		{"abc123", nil},
+		{"", nil},
+	} {
+		injector := NewAPIKeyStreamInjector(tt.APIKey)
+
+		// mock for streamer
+		var outputCtx context.Context
+		streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			outputCtx = ctx
+			return nil, nil
+		}
+
+		ctx := context.Background()
+		_, err := injector(ctx, &grpc.StreamDesc{}, nil, "/test.method", streamer)
+
+		assert.Equal(t, err, tt.err)
+
+		md, ok := metadata.FromOutgoingContext(outputCtx)
+		assert.Equal(t, true, ok)
+		assert.Equal(t, tt.APIKey, strings.Join(md["apikey"], ""))
+	}
+}
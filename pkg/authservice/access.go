@@ -0,0 +1,69 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package authservice
+
+import (
+	"encoding/json"
+
+	"github.com/btcsuite/btcutil/base58"
+)
+
+// accessVersion is the version byte of serialized accesses
+const accessVersion = 1
+
+// Access contains everything an uplink needs to access the objects of a
+// project: the satellite, the api key and the encryption key
+type Access struct {
+	SatelliteAddr string `json:"satellite_addr"`
+	APIKey        string `json:"api_key"`
+	EncryptionKey string `json:"encryption_key"`
+	// KeyDerivation is how the root key is derived from the encryption key,
+	// see miniogw.EncryptionConfig
+	KeyDerivation string `json:"key_derivation,omitempty"`
+}
+
+// Validate returns an error when the access misses a part
+func (access *Access) Validate() error {
+	switch {
+	case access.SatelliteAddr == "":
+		return ErrInvalidAccess.New("satellite address missing")
+	case access.APIKey == "":
+		return ErrInvalidAccess.New("api key missing")
+	case access.EncryptionKey == "":
+		return ErrInvalidAccess.New("encryption key missing")
+	}
+	return nil
+}
+
+// Serialize encodes the access into a string, which can be shared
+func (access *Access) Serialize() (string, error) {
+	if err := access.Validate(); err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(access)
+	if err != nil {
+		return "", ErrInvalidAccess.Wrap(err)
+	}
+	return base58.CheckEncode(data, accessVersion), nil
+}
+
+// ParseAccess decodes a serialized access
+func ParseAccess(serialized string) (*Access, error) {
+	data, version, err := base58.CheckDecode(serialized)
+	if err != nil {
+		return nil, ErrInvalidAccess.Wrap(err)
+	}
+	if version != accessVersion {
+		return nil, ErrInvalidAccess.New("unknown version %d", version)
+	}
+
+	access := &Access{}
+	if err := json.Unmarshal(data, access); err != nil {
+		return nil, ErrInvalidAccess.Wrap(err)
+	}
+	if err := access.Validate(); err != nil {
+		return nil, err
+	}
+	return access, nil
+}
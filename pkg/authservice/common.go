@@ -0,0 +1,20 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package authservice
+
+import (
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	// Error is a standard error class for this package.
+	Error = errs.Class("auth service error")
+	// ErrNotFound is returned when no access is registered for an access key id
+	ErrNotFound = errs.Class("access not found")
+	// ErrInvalidAccess is returned when a serialized access can't be parsed or is incomplete
+	ErrInvalidAccess = errs.Class("invalid access")
+
+	mon = monkit.Package()
+)
@@ -0,0 +1,76 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package authservice
+
+import (
+	"context"
+	"net"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/utils"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/boltdb"
+	"storj.io/storj/storage/redis"
+)
+
+// AccessesBucket is the bucket used with a bolt-backed accesses database
+const AccessesBucket = "accesses"
+
+// Config is the configuration of the auth service
+type Config struct {
+	Address     string `help:"address to serve the auth service api over" default:"localhost:8000"`
+	DatabaseURL string `help:"url to the database of the registered accesses" default:"bolt://$CONFDIR/accesses.db"`
+	AuthToken   string `help:"token gateways send to look up the registered accesses, lookups are disabled when it's empty" default:""`
+}
+
+// NewDB creates or opens the accesses database specified by the config
+func (c Config) NewDB() (storage.KeyValueStore, error) {
+	driver, source, err := utils.SplitDBURL(c.DatabaseURL)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	switch driver {
+	case "bolt":
+		db, err := boltdb.New(source, AccessesBucket)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return db, nil
+	case "redis":
+		db, err := redis.NewClientFrom(c.DatabaseURL)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return db, nil
+	default:
+		return nil, Error.New("database scheme not supported: %s", driver)
+	}
+}
+
+// Run serves the auth service until the context is canceled
+func (c Config) Run(ctx context.Context, log *zap.Logger) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	db, err := c.NewDB()
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	listener, err := net.Listen("tcp", c.Address)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	server := NewServer(NewService(log, db), listener, c.AuthToken)
+	if c.AuthToken == "" {
+		log.Warn("auth token not configured, gateways can't look up accesses")
+	}
+	log.Info("Auth service running", zap.String("address", listener.Addr().String()))
+
+	return server.Run(ctx)
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package authservice
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxRequestSize is the largest request body, which is read
+const maxRequestSize = 64 * 1024
+
+// Server serves the auth service http api
+type Server struct {
+	service   *Service
+	authToken string
+
+	listener net.Listener
+	server   http.Server
+}
+
+// NewServer creates a server for service. Gateways look up the accesses with
+// authToken, lookups are disabled when it's empty.
+func NewServer(service *Service, listener net.Listener, authToken string) *Server {
+	server := &Server{
+		service:   service,
+		authToken: authToken,
+		listener:  listener,
+	}
+	server.server.Handler = server
+	return server
+}
+
+// Run serves the api until the context is canceled
+func (server *Server) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return server.server.Shutdown(context.Background())
+	})
+	group.Go(func() error {
+		defer cancel()
+		err := server.server.Serve(server.listener)
+		if err == http.ErrServerClosed {
+			return nil
+		}
+		return err
+	})
+	return group.Wait()
+}
+
+// Close closes the server and the underlying listener
+func (server *Server) Close() error {
+	return server.server.Close()
+}
+
+// ServeHTTP implements the auth service api:
+//
+//	POST   /v1/access        {"access": "..."}      registers an access and returns its credentials
+//	GET    /v1/access/<id>                          returns the access and secret key, needs the auth token
+//	DELETE /v1/access/<id>   {"secret_key": "..."}  revokes the access
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case path == "v1/access" && r.Method == http.MethodPost:
+		server.register(w, r)
+	case strings.HasPrefix(path, "v1/access/") && r.Method == http.MethodGet:
+		server.lookup(w, r, strings.TrimPrefix(path, "v1/access/"))
+	case strings.HasPrefix(path, "v1/access/") && r.Method == http.MethodDelete:
+		server.revoke(w, r, strings.TrimPrefix(path, "v1/access/"))
+	case path == "v1/access" || strings.HasPrefix(path, "v1/access/"):
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// register exchanges an access for credentials
+func (server *Server) register(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Access string `json:"access"`
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&request); err != nil {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	credentials, err := server.service.Register(r.Context(), request.Access)
+	if err != nil {
+		server.fail(w, err)
+		return
+	}
+	writeJSON(w, credentials)
+}
+
+// lookup returns the access and secret key of an access key id to a gateway
+func (server *Server) lookup(w http.ResponseWriter, r *http.Request, accessKeyID string) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if server.authToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(server.authToken)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	access, secretKey, err := server.service.Lookup(r.Context(), accessKeyID)
+	if err != nil {
+		server.fail(w, err)
+		return
+	}
+	writeJSON(w, struct {
+		Access    *Access `json:"access"`
+		SecretKey string  `json:"secret_key"`
+	}{access, secretKey})
+}
+
+// revoke deletes the access of the credentials
+func (server *Server) revoke(w http.ResponseWriter, r *http.Request, accessKeyID string) {
+	var request struct {
+		SecretKey string `json:"secret_key"`
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&request); err != nil {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	err := server.service.Revoke(r.Context(), Credentials{AccessKeyID: accessKeyID, SecretKey: request.SecretKey})
+	if err != nil {
+		server.fail(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// fail writes the status of err, internal errors aren't revealed to the client
+func (server *Server) fail(w http.ResponseWriter, err error) {
+	switch {
+	case ErrInvalidAccess.Has(err):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case ErrNotFound.Has(err):
+		http.Error(w, "access not found", http.StatusNotFound)
+	default:
+		server.service.log.Error("auth service request failed", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
+
+// writeJSON writes value as the json response
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,199 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package authservice
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/json"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/encryption"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+const (
+	// accessKeyIDLength is the number of random bytes of an access key id,
+	// the stored access is encrypted with a key derived from it
+	accessKeyIDLength = 20
+	// secretKeyLength is the number of random bytes of a secret key
+	secretKeyLength = 30
+)
+
+// accessKeyIDEncoding encodes access key ids with upper case letters and
+// digits, like the ids of S3
+var accessKeyIDEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Credentials is the S3 style key pair of a registered access
+type Credentials struct {
+	AccessKeyID string `json:"access_key_id"`
+	SecretKey   string `json:"secret_key"`
+}
+
+// Record is a registered access with the secret key of its credentials
+type Record struct {
+	Access    string    `json:"access"`
+	SecretKey string    `json:"secret_key"`
+	Created   time.Time `json:"created"`
+}
+
+// Service exchanges accesses for credentials. The records are encrypted with
+// a key derived from the access key id, which isn't stored, so the database
+// alone doesn't reveal any access.
+type Service struct {
+	log *zap.Logger
+	db  storage.KeyValueStore
+}
+
+// NewService creates a new auth service storing the records in db
+func NewService(log *zap.Logger, db storage.KeyValueStore) *Service {
+	return &Service{log: log, db: db}
+}
+
+// Register stores the serialized access and returns the credentials for it
+func (service *Service) Register(ctx context.Context, serializedAccess string) (_ *Credentials, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := ParseAccess(serializedAccess); err != nil {
+		return nil, err
+	}
+
+	var id [accessKeyIDLength]byte
+	var secret [secretKeyLength]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if _, err := rand.Read(secret[:]); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	credentials := &Credentials{
+		AccessKeyID: accessKeyIDEncoding.EncodeToString(id[:]),
+		SecretKey:   base58.Encode(secret[:]),
+	}
+
+	data, err := json.Marshal(&Record{
+		Access:    serializedAccess,
+		SecretKey: credentials.SecretKey,
+		Created:   time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	dbKey, encKey, err := recordKeys(credentials.AccessKeyID)
+	if err != nil {
+		return nil, err
+	}
+	var nonce storj.Nonce
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	encrypted, err := encryption.Encrypt(data, storj.SecretBox, encKey, &nonce)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := service.db.Put(dbKey, append(nonce[:], encrypted...)); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	mon.Meter("access_registered").Mark(1)
+	return credentials, nil
+}
+
+// Lookup returns the access and the secret key registered for the access key id
+func (service *Service) Lookup(ctx context.Context, accessKeyID string) (_ *Access, secretKey string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	record, err := service.record(accessKeyID)
+	if err != nil {
+		return nil, "", err
+	}
+	access, err := ParseAccess(record.Access)
+	if err != nil {
+		return nil, "", Error.Wrap(err)
+	}
+	return access, record.SecretKey, nil
+}
+
+// Revoke deletes the access registered for the credentials
+func (service *Service) Revoke(ctx context.Context, credentials Credentials) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	record, err := service.record(credentials.AccessKeyID)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare([]byte(record.SecretKey), []byte(credentials.SecretKey)) != 1 {
+		// not revealing whether the access key id exists
+		return ErrNotFound.New("%s", credentials.AccessKeyID)
+	}
+
+	dbKey, _, err := recordKeys(credentials.AccessKeyID)
+	if err != nil {
+		return err
+	}
+	if err := service.db.Delete(dbKey); err != nil {
+		return Error.Wrap(err)
+	}
+	mon.Meter("access_revoked").Mark(1)
+	return nil
+}
+
+// record loads and decrypts the record of the access key id
+func (service *Service) record(accessKeyID string) (*Record, error) {
+	dbKey, encKey, err := recordKeys(accessKeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := service.db.Get(dbKey)
+	if storage.ErrKeyNotFound.Has(err) {
+		return nil, ErrNotFound.New("%s", accessKeyID)
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(value) < storj.NonceSize {
+		return nil, Error.New("record of %s is too short", accessKeyID)
+	}
+
+	var nonce storj.Nonce
+	copy(nonce[:], value)
+	data, err := encryption.Decrypt(value[storj.NonceSize:], storj.SecretBox, encKey, &nonce)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	record := &Record{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return record, nil
+}
+
+// recordKeys derives the database key and the encryption key of the record
+// of an access key id
+func recordKeys(accessKeyID string) (dbKey storage.Key, encKey *storj.Key, err error) {
+	if accessKeyID == "" {
+		return nil, nil, ErrNotFound.New("empty access key id")
+	}
+
+	root := storj.Key(sha256.Sum256([]byte(accessKeyID)))
+	lookupKey, err := encryption.DeriveKey(&root, "lookup")
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	encKey, err = encryption.DeriveKey(&root, "encryption")
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+	return storage.Key(lookupKey[:]), encKey, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package authservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestAccess(t *testing.T) {
+	access := &Access{SatelliteAddr: "127.0.0.1:7777", APIKey: "key", EncryptionKey: "secret"}
+
+	serialized, err := access.Serialize()
+	require.NoError(t, err)
+
+	parsed, err := ParseAccess(serialized)
+	require.NoError(t, err)
+	assert.Equal(t, access, parsed)
+
+	_, err = ParseAccess(serialized[1:])
+	assert.True(t, ErrInvalidAccess.Has(err))
+
+	_, err = (&Access{SatelliteAddr: "127.0.0.1:7777", APIKey: "key"}).Serialize()
+	assert.True(t, ErrInvalidAccess.Has(err))
+}
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db := teststore.New()
+	service := NewService(zaptest.NewLogger(t), db)
+
+	access := &Access{SatelliteAddr: "127.0.0.1:7777", APIKey: "key", EncryptionKey: "secret"}
+	serialized, err := access.Serialize()
+	require.NoError(t, err)
+
+	_, err = service.Register(ctx, "invalid")
+	assert.True(t, ErrInvalidAccess.Has(err))
+
+	credentials, err := service.Register(ctx, serialized)
+	require.NoError(t, err)
+	assert.NotEmpty(t, credentials.AccessKeyID)
+	assert.NotEmpty(t, credentials.SecretKey)
+
+	{ // the stored record reveals neither the access nor the access key id
+		keys, err := db.List(nil, 0)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.NotContains(t, string(keys[0]), credentials.AccessKeyID)
+
+		value, err := db.Get(keys[0])
+		require.NoError(t, err)
+		assert.NotContains(t, string(value), serialized)
+		assert.NotContains(t, string(value), credentials.SecretKey)
+	}
+
+	found, secretKey, err := service.Lookup(ctx, credentials.AccessKeyID)
+	require.NoError(t, err)
+	assert.Equal(t, access, found)
+	assert.Equal(t, credentials.SecretKey, secretKey)
+
+	_, _, err = service.Lookup(ctx, "UNKNOWN")
+	assert.True(t, ErrNotFound.Has(err))
+
+	err = service.Revoke(ctx, Credentials{AccessKeyID: credentials.AccessKeyID, SecretKey: "wrong"})
+	assert.True(t, ErrNotFound.Has(err))
+
+	require.NoError(t, service.Revoke(ctx, *credentials))
+	_, _, err = service.Lookup(ctx, credentials.AccessKeyID)
+	assert.True(t, ErrNotFound.Has(err))
+
+	keys, err := db.List(nil, 0)
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestServer(t *testing.T) {
+	service := NewService(zaptest.NewLogger(t), teststore.New())
+	server := NewServer(service, nil, "token")
+
+	serve := func(method, path, body, token string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	access := &Access{SatelliteAddr: "127.0.0.1:7777", APIKey: "key", EncryptionKey: "secret"}
+	serialized, err := access.Serialize()
+	require.NoError(t, err)
+
+	response := serve(http.MethodPost, "/v1/access", `{"access": "invalid"}`, "")
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = serve(http.MethodPost, "/v1/access", `{"access": "`+serialized+`"}`, "")
+	require.Equal(t, http.StatusOK, response.Code)
+	var credentials Credentials
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&credentials))
+
+	response = serve(http.MethodGet, "/v1/access/"+credentials.AccessKeyID, "", "wrong")
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+
+	response = serve(http.MethodGet, "/v1/access/"+credentials.AccessKeyID, "", "token")
+	require.Equal(t, http.StatusOK, response.Code)
+	var lookup struct {
+		Access    *Access `json:"access"`
+		SecretKey string  `json:"secret_key"`
+	}
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&lookup))
+	assert.Equal(t, access, lookup.Access)
+	assert.Equal(t, credentials.SecretKey, lookup.SecretKey)
+
+	response = serve(http.MethodDelete, "/v1/access/"+credentials.AccessKeyID, `{"secret_key": "`+credentials.SecretKey+`"}`, "")
+	assert.Equal(t, http.StatusNoContent, response.Code)
+
+	response = serve(http.MethodGet, "/v1/access/"+credentials.AccessKeyID, "", "token")
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default error class for the bloom filters
+var Error = errs.Class("bloom filter error")
+
+const (
+	// version is the version of the serialized filter
+	version = 1
+	// headerSize is the size of the version and the hash count of a serialized filter
+	headerSize = 2
+	// maxHashCount is the largest number of hash functions of a filter
+	maxHashCount = 32
+)
+
+// Filter is a bloom filter of piece ids. It may report ids as contained,
+// which weren't added, but never misses an added id.
+type Filter struct {
+	hashCount int
+	table     []byte
+}
+
+// NewOptimal returns a filter sized for expectedElements with the false
+// positive rate
+func NewOptimal(expectedElements int, falsePositiveRate float64) *Filter {
+	if expectedElements < 1 {
+		expectedElements = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.1
+	}
+
+	bitsPerElement := -math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)
+	hashCount := int(math.Ceil(bitsPerElement * math.Ln2))
+	if hashCount > maxHashCount {
+		hashCount = maxHashCount
+	}
+	size := int(math.Ceil(float64(expectedElements) * bitsPerElement / 8))
+
+	return &Filter{hashCount: hashCount, table: make([]byte, size)}
+}
+
+// NewFromBytes decodes a filter serialized with Bytes
+func NewFromBytes(data []byte) (*Filter, error) {
+	if len(data) < headerSize+1 {
+		return nil, Error.New("filter too short")
+	}
+	if data[0] != version {
+		return nil, Error.New("unsupported version %d", data[0])
+	}
+	hashCount := int(data[1])
+	if hashCount < 1 || hashCount > maxHashCount {
+		return nil, Error.New("invalid hash count %d", hashCount)
+	}
+	return &Filter{hashCount: hashCount, table: append([]byte(nil), data[headerSize:]...)}, nil
+}
+
+// Add adds an id to the filter
+func (filter *Filter) Add(id []byte) {
+	h1, h2 := hashes(id)
+	bits := uint64(len(filter.table)) * 8
+	for i := 0; i < filter.hashCount; i++ {
+		bit := (h1 + uint64(i)*h2) % bits
+		filter.table[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Contains returns whether the id may have been added to the filter
+func (filter *Filter) Contains(id []byte) bool {
+	h1, h2 := hashes(id)
+	bits := uint64(len(filter.table)) * 8
+	for i := 0; i < filter.hashCount; i++ {
+		bit := (h1 + uint64(i)*h2) % bits
+		if filter.table[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Bytes serializes the filter
+func (filter *Filter) Bytes() []byte {
+	data := make([]byte, headerSize, headerSize+len(filter.table))
+	data[0] = version
+	data[1] = byte(filter.hashCount)
+	return append(data, filter.table...)
+}
+
+// Size returns the size of the serialized filter
+func (filter *Filter) Size() int { return headerSize + len(filter.table) }
+
+// hashes returns the two hashes of an id, which the indexes of the bits are
+// derived from by double hashing
+func hashes(id []byte) (h1, h2 uint64) {
+	sum := sha256.Sum256(id)
+	h1 = binary.BigEndian.Uint64(sum[0:8])
+	// an odd step visits different bits for every hash function
+	h2 = binary.BigEndian.Uint64(sum[8:16]) | 1
+	return h1, h2
+}
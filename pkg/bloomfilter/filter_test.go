@@ -0,0 +1,65 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/bloomfilter"
+)
+
+func randomIDs(t *testing.T, count int) [][]byte {
+	ids := make([][]byte, count)
+	for i := range ids {
+		ids[i] = make([]byte, 32)
+		_, err := rand.Read(ids[i])
+		require.NoError(t, err)
+	}
+	return ids
+}
+
+func TestFilter(t *testing.T) {
+	const count = 10000
+	const rate = 0.05
+
+	filter := bloomfilter.NewOptimal(count, rate)
+	added := randomIDs(t, count)
+	for _, id := range added {
+		filter.Add(id)
+	}
+
+	decoded, err := bloomfilter.NewFromBytes(filter.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, filter.Size(), len(filter.Bytes()))
+
+	for _, id := range added {
+		require.True(t, filter.Contains(id))
+		require.True(t, decoded.Contains(id))
+	}
+
+	falsePositives := 0
+	for _, id := range randomIDs(t, count) {
+		if decoded.Contains(id) {
+			falsePositives++
+		}
+	}
+	assert.InDelta(t, rate, float64(falsePositives)/count, rate)
+}
+
+func TestNewFromBytesInvalid(t *testing.T) {
+	for _, data := range [][]byte{
+		nil,
+		{1, 3},
+		{2, 3, 0},
+		{1, 0, 0},
+		{1, 33, 0},
+	} {
+		_, err := bloomfilter.NewFromBytes(data)
+		assert.True(t, bloomfilter.Error.Has(err), "%v", data)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// AnomalyConfig configures flagging agreements, which claim a total near or
+// above the max size of their payer allocation
+type AnomalyConfig struct {
+	Ratio float64 `help:"fraction of the allocation's max size, at which the total of an agreement is flagged as suspicious, 0 only flags totals above the max size" default:"0.95"`
+}
+
+// SuspiciousNode summarizes the flagged agreements of a storage node
+type SuspiciousNode struct {
+	NodeID storj.NodeID
+	// Anomalies is the number of flagged agreements
+	Anomalies int64
+	// Exceeded is the number of flagged agreements, which claimed more than the max size
+	Exceeded int64
+	// LastFlagged is when the last agreement was flagged
+	LastFlagged time.Time
+}
+
+// checkTotal flags agreements, which claim a total near or above the max
+// size of their allocation, and rejects the ones above the max size.
+// Allocations without a max size aren't checked.
+func (s *Server) checkTotal(ctx context.Context, rba *pb.RenterBandwidthAllocation) error {
+	maxSize := rba.PayerAllocation.MaxSize
+	if maxSize <= 0 {
+		return nil
+	}
+
+	exceeded := rba.Total > maxSize
+	near := s.Anomaly.Ratio > 0 && float64(rba.Total) >= s.Anomaly.Ratio*float64(maxSize)
+	if !exceeded && !near {
+		return nil
+	}
+
+	mon.Counter("agreements_anomalous").Inc(1)
+	// a resubmitted agreement has already been flagged
+	if err := s.bwdb.SaveAnomaly(ctx, rba); err != nil && !isUniqueError(err) {
+		s.logger.Warn("could not save agreement anomaly", zap.String("Serial Number", rba.PayerAllocation.SerialNumber), zap.Error(err))
+	}
+
+	if exceeded {
+		return pb.ErrRenter.New("total %d exceeds max size %d", rba.Total, maxSize)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/bwagreement"
+	"storj.io/storj/pkg/bwagreement/testbwagreement"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAnomalies(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		satID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		satellite := bwagreement.NewServer(db.BandwidthAgreement(), db.CertDB(), satID.Leaf.PublicKey, zap.NewNop(), satID.ID)
+		satellite.Anomaly = bwagreement.AnomalyConfig{Ratio: 0.9}
+		require.NoError(t, db.CertDB().SavePublicKey(ctx, upID.ID, upID.Leaf.PublicKey))
+
+		ctxSN, storageNode := getPeerContext(ctx, t)
+		submit := func(maxSize, total int64) error {
+			pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, time.Hour)
+			require.NoError(t, err)
+			pba.MaxSize = maxSize
+			require.NoError(t, auth.SignMessage(pba, *satID))
+
+			rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode, upID, total)
+			require.NoError(t, err)
+			_, err = satellite.BandwidthAgreements(ctxSN, rba)
+			return err
+		}
+
+		// allocations without a max size aren't checked
+		assert.NoError(t, submit(0, 1000))
+		// totals well below the max size aren't flagged
+		assert.NoError(t, submit(1000, 500))
+		// totals near the max size are accepted, but flagged
+		assert.NoError(t, submit(1000, 950))
+		// totals above the max size are rejected and flagged
+		assert.Error(t, submit(1000, 1001))
+
+		since := time.Now().Add(-time.Hour)
+		nodes, err := db.BandwidthAgreement().GetSuspiciousNodes(ctx, since, 2)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, storageNode, nodes[0].NodeID)
+		assert.Equal(t, int64(2), nodes[0].Anomalies)
+		assert.Equal(t, int64(1), nodes[0].Exceeded)
+		assert.False(t, nodes[0].LastFlagged.IsZero())
+
+		nodes, err = db.BandwidthAgreement().GetSuspiciousNodes(ctx, since, 3)
+		require.NoError(t, err)
+		assert.Empty(t, nodes)
+
+		totals, err := db.BandwidthAgreement().GetTotals(ctx, since, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2450), totals[storageNode][pb.BandwidthAction_GET])
+	})
+}
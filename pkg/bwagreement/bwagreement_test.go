@@ -5,9 +5,11 @@ package bwagreement_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
@@ -15,6 +17,7 @@ import (
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
@@ -78,3 +81,78 @@ func testGetTotals(ctx context.Context, t *testing.T, b bwagreement.DB, snID *id
 	require.Equal(t, int64(0), total[pb.BandwidthAction_GET_REPAIR])
 	require.Equal(t, int64(0), total[pb.BandwidthAction_PUT_REPAIR])
 }
+
+func TestUplinkStatsPage(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		bwdb := db.BandwidthAgreement()
+		uplinks := []storj.NodeID{{1}, {2}, {3}}
+
+		// uplink 1 has the most bytes, uplink 3 the most transactions
+		for i, agreement := range []struct {
+			uplink storj.NodeID
+			action pb.BandwidthAction
+			total  int64
+		}{
+			{uplinks[0], pb.BandwidthAction_PUT, 5000},
+			{uplinks[1], pb.BandwidthAction_GET, 2000},
+			{uplinks[2], pb.BandwidthAction_GET, 100},
+			{uplinks[2], pb.BandwidthAction_GET_AUDIT, 100},
+			{uplinks[2], pb.BandwidthAction_PUT, 100},
+		} {
+			require.NoError(t, bwdb.CreateAgreement(ctx, &pb.RenterBandwidthAllocation{
+				PayerAllocation: pb.PayerBandwidthAllocation{
+					Action:       agreement.action,
+					SerialNumber: fmt.Sprintf("page-%d", i),
+					UplinkId:     agreement.uplink,
+				},
+				Total:         agreement.total,
+				StorageNodeId: storj.NodeID{9},
+			}))
+		}
+
+		// pages through all stats in the given order, two stats at a time
+		list := func(opts bwagreement.UplinkStatsOptions) (ids []storj.NodeID) {
+			opts.To = time.Now().UTC()
+			opts.Limit = 2
+			for {
+				page, err := bwdb.GetUplinkStatsPage(ctx, opts)
+				require.NoError(t, err)
+				require.True(t, len(page.Stats) <= 2)
+				for _, stat := range page.Stats {
+					ids = append(ids, stat.NodeID)
+				}
+				if page.Next == nil {
+					return ids
+				}
+				opts.Cursor = page.Next
+			}
+		}
+
+		assert.Equal(t, uplinks, list(bwagreement.UplinkStatsOptions{OrderBy: bwagreement.OrderByUplinkID}))
+		assert.Equal(t, uplinks, list(bwagreement.UplinkStatsOptions{OrderBy: bwagreement.OrderByTotalBytes}))
+		assert.Equal(t, []storj.NodeID{uplinks[2], uplinks[0], uplinks[1]},
+			list(bwagreement.UplinkStatsOptions{OrderBy: bwagreement.OrderByTotalTransactions}))
+
+		// only GET agreements are counted
+		gets := bwagreement.UplinkStatsOptions{Actions: []pb.BandwidthAction{pb.BandwidthAction_GET}, OrderBy: bwagreement.OrderByTotalBytes}
+		assert.Equal(t, []storj.NodeID{uplinks[1], uplinks[2]}, list(gets))
+
+		page, err := bwdb.GetUplinkStatsPage(ctx, bwagreement.UplinkStatsOptions{
+			To:      time.Now().UTC(),
+			Actions: []pb.BandwidthAction{pb.BandwidthAction_GET, pb.BandwidthAction_GET_AUDIT},
+			Limit:   10,
+		})
+		require.NoError(t, err)
+		require.Len(t, page.Stats, 2)
+		assert.Nil(t, page.Next)
+		assert.Equal(t, int64(200), page.Stats[1].TotalBytes)
+		assert.Equal(t, 2, page.Stats[1].TotalTransactions)
+		assert.Equal(t, 0, page.Stats[1].PutActionCount)
+
+		_, err = bwdb.GetUplinkStatsPage(ctx, bwagreement.UplinkStatsOptions{To: time.Now()})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/chore"
+)
+
+// CleanerConfig configures the removal of old agreements
+type CleanerConfig struct {
+	Interval  time.Duration `help:"how often to remove old agreements, 0 disables the cleanup" default:"24h"`
+	Retention time.Duration `help:"how long agreements are kept after they were created" default:"2160h"`
+	Archive   bool          `help:"move old agreements to the archive table instead of deleting them" default:"true"`
+}
+
+// Cleaner removes agreements, which are older than the retention period, so
+// the agreements table and its serial number checks don't slow down over
+// time.
+//
+// Only agreements, which have expired, are removed, because the server
+// rejects them before checking their serial number, and only agreements,
+// which have been tallied, are removed, so no bandwidth is lost.
+type Cleaner struct {
+	log        *zap.Logger
+	db         DB
+	accounting accounting.DB
+	config     CleanerConfig
+
+	Chore *chore.Chore
+}
+
+// NewCleaner creates a new agreement cleaner
+func NewCleaner(log *zap.Logger, db DB, accountingDB accounting.DB, config CleanerConfig) *Cleaner {
+	cleaner := &Cleaner{
+		log:        log,
+		db:         db,
+		accounting: accountingDB,
+		config:     config,
+	}
+	cleaner.Chore = chore.New(log, "agreements:cleaner", config.Interval, cleaner.Cleanup)
+	return cleaner
+}
+
+// Run removes old agreements every interval
+func (cleaner *Cleaner) Run(ctx context.Context) error {
+	if cleaner.config.Interval <= 0 {
+		return nil
+	}
+	return cleaner.Chore.Run(ctx)
+}
+
+// Cleanup deletes or archives the agreements, which are older than the
+// retention period
+func (cleaner *Cleaner) Cleanup(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	before := time.Now().Add(-cleaner.config.Retention)
+
+	lastTally, err := cleaner.accounting.LastTimestamp(ctx, accounting.LastBandwidthTally)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if lastTally.Before(before) {
+		before = lastTally
+	}
+
+	var removed int64
+	if cleaner.config.Archive {
+		removed, err = cleaner.db.ArchiveExpired(ctx, before)
+	} else {
+		removed, err = cleaner.db.DeleteExpired(ctx, before)
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	mon.Meter("bwagreement_cleaned").Mark64(removed)
+	if removed > 0 {
+		cleaner.log.Info("removed old agreements",
+			zap.Int64("count", removed),
+			zap.Bool("archived", cleaner.config.Archive),
+			zap.Time("before", before))
+	}
+	return nil
+}
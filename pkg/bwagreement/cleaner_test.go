@@ -0,0 +1,80 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/bwagreement"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestCleaner(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		snID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		now := time.Now()
+		old := now.Add(-100 * 24 * time.Hour)
+		replay := func(serialNum string, created, expires time.Time, total int64) {
+			require.NoError(t, db.BandwidthAgreement().ReplayAgreement(ctx, &pb.RenterBandwidthAllocation{
+				PayerAllocation: pb.PayerBandwidthAllocation{
+					Action:            pb.BandwidthAction_PUT,
+					SerialNumber:      serialNum,
+					UplinkId:          upID.ID,
+					CreatedUnixSec:    created.Unix(),
+					ExpirationUnixSec: expires.Unix(),
+				},
+				Total:         total,
+				StorageNodeId: snID.ID,
+			}))
+		}
+		putTotal := func() int64 {
+			totals, err := db.BandwidthAgreement().GetTotals(ctx, time.Time{}, now.UTC())
+			require.NoError(t, err)
+			return totals[snID.ID][pb.BandwidthAction_PUT]
+		}
+
+		replay("old-expired", old, old.Add(time.Hour), 1)
+		replay("old-valid", old, now.Add(time.Hour), 10)
+		replay("recent-expired", now.Add(-2*time.Hour), now.Add(-time.Hour), 100)
+
+		cleaner := bwagreement.NewCleaner(zap.NewNop(), db.BandwidthAgreement(), db.Accounting(), bwagreement.CleanerConfig{
+			Retention: 30 * 24 * time.Hour,
+			Archive:   true,
+		})
+
+		// nothing is removed before the agreements have been tallied
+		require.NoError(t, cleaner.Cleanup(ctx))
+		assert.Equal(t, int64(111), putTotal())
+
+		require.NoError(t, db.Accounting().SaveBWRaw(ctx, now, now, map[storj.NodeID][]int64{snID.ID: {111, 0, 0, 0, 0}}))
+
+		// only the old and expired agreement is removed
+		require.NoError(t, cleaner.Cleanup(ctx))
+		assert.Equal(t, int64(110), putTotal())
+
+		// the database removes everything, which was created and expired before the given time
+		replay("old-expired-2", old, old.Add(time.Hour), 1000)
+		deleted, err := db.BandwidthAgreement().DeleteExpired(ctx, now.Add(-30*time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
+		assert.Equal(t, int64(10), putTotal())
+	})
+}
@@ -0,0 +1,311 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrQueueClosed is returned when an agreement is queued after the queue has been closed
+var ErrQueueClosed = errs.Class("bwagreement queue closed")
+
+// Queue buffers verified agreements and writes them to the database in
+// batches, so that receiving agreements isn't bound by the database latency.
+//
+// When writing a batch fails, the agreements are kept and retried on the next
+// flush. While they are retried no new agreements are taken from the queue,
+// so a full queue blocks the senders until their requests time out and the
+// storage nodes send the agreements again later. Agreements, which still
+// can't be written after the configured number of retries, are dropped so
+// that they don't stall the queue.
+type Queue struct {
+	log    *zap.Logger
+	db     DB
+	uptime UptimeDB
+
+	batchSize     int
+	flushInterval time.Duration
+	retries       int
+
+	queue   chan *pb.RenterBandwidthAllocation
+	closing chan struct{}
+	once    sync.Once
+
+	mu     sync.RWMutex
+	closed bool
+
+	// pending contains the agreements, which haven't been written yet
+	pendingMu sync.Mutex
+	pending   map[string]struct{}
+
+	// flushMu ensures that Run and Close don't write the same batch twice
+	flushMu  sync.Mutex
+	batch    []*pb.RenterBandwidthAllocation
+	failures map[string]int
+}
+
+// NewQueue creates a queue, which writes agreements to db. When uptime is not
+// nil, every stored agreement counts as a successful uptime check of the
+// storage node.
+func NewQueue(log *zap.Logger, db DB, uptime UptimeDB, config Config) *Queue {
+	batchSize := config.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	retries := config.Retries
+	if retries < 0 {
+		retries = 0
+	}
+	return &Queue{
+		log:           log,
+		db:            db,
+		uptime:        uptime,
+		batchSize:     batchSize,
+		flushInterval: config.FlushInterval,
+		retries:       retries,
+		queue:         make(chan *pb.RenterBandwidthAllocation, config.QueueSize),
+		closing:       make(chan struct{}),
+		pending:       make(map[string]struct{}),
+		failures:      make(map[string]int),
+	}
+}
+
+// pendingKey returns the key, which identifies an agreement in the database
+func pendingKey(rba *pb.RenterBandwidthAllocation) string {
+	return rba.PayerAllocation.SerialNumber + rba.StorageNodeId.String()
+}
+
+// Enqueue queues a verified agreement to be written to the database. It
+// blocks while the queue is full. Agreements, which are already waiting in the
+// queue, are rejected with auth.ErrSerial.
+//
+// Enqueue doesn't read the database, so agreements, which have already been
+// stored, are only detected when their batch is written and are dropped then.
+// Storage nodes don't send agreements again, once they got a receipt.
+func (q *Queue) Enqueue(ctx context.Context, rba *pb.RenterBandwidthAllocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return ErrQueueClosed.New("")
+	}
+
+	key := pendingKey(rba)
+	if !q.addPending(key) {
+		return auth.ErrSerial.New("agreement %s is already queued", rba.PayerAllocation.SerialNumber)
+	}
+
+	select {
+	case q.queue <- rba:
+		mon.IntVal("bwagreement_queue_depth").Observe(int64(len(q.queue)))
+		return nil
+	case <-q.closing:
+		q.removePending(key)
+		return ErrQueueClosed.New("")
+	case <-ctx.Done():
+		q.removePending(key)
+		return ctx.Err()
+	}
+}
+
+// Run writes the queued agreements to the database, whenever a batch is full
+// or the flush interval has passed. When ctx is canceled the queued
+// agreements are written before returning.
+func (q *Queue) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	interval := q.flushInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// stop taking agreements while the current batch can't be written
+		queue := q.queue
+		if q.batchLen() >= q.batchSize {
+			queue = nil
+		}
+
+		select {
+		case rba := <-queue:
+			if q.add(rba) >= q.batchSize {
+				q.flush(ctx)
+			}
+		case <-ticker.C:
+			q.flush(ctx)
+		case <-q.closing:
+			return nil
+		case <-ctx.Done():
+			return errs.Combine(ctx.Err(), q.drain())
+		}
+	}
+}
+
+// Close stops accepting agreements and writes the queued ones to the database.
+func (q *Queue) Close() error {
+	q.once.Do(func() { close(q.closing) })
+
+	// wait for the agreements, which are being queued
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+
+	return q.drain()
+}
+
+// drain writes all queued agreements to the database
+func (q *Queue) drain() error {
+	// the caller's context may already be canceled, but the agreements
+	// still need to be written
+	ctx := context.Background()
+	for {
+		select {
+		case rba := <-q.queue:
+			if q.add(rba) >= q.batchSize {
+				q.flush(ctx)
+			}
+			continue
+		default:
+		}
+		break
+	}
+	q.flush(ctx)
+
+	if lost := q.batchLen(); lost > 0 {
+		return Error.New("failed to store %d queued agreements", lost)
+	}
+	return nil
+}
+
+// add appends an agreement to the current batch and returns the batch size
+func (q *Queue) add(rba *pb.RenterBandwidthAllocation) int {
+	q.flushMu.Lock()
+	defer q.flushMu.Unlock()
+	q.batch = append(q.batch, rba)
+	return len(q.batch)
+}
+
+// batchLen returns the number of agreements waiting to be written
+func (q *Queue) batchLen() int {
+	q.flushMu.Lock()
+	defer q.flushMu.Unlock()
+	return len(q.batch)
+}
+
+// flush writes the current batch to the database. Agreements, which couldn't
+// be written, stay in the batch to be retried on the next flush, unless they
+// have run out of retries.
+func (q *Queue) flush(ctx context.Context) {
+	q.flushMu.Lock()
+	defer q.flushMu.Unlock()
+
+	mon.IntVal("bwagreement_queue_depth").Observe(int64(len(q.queue)))
+	if len(q.batch) == 0 {
+		return
+	}
+
+	var stored, failed []*pb.RenterBandwidthAllocation
+	if err := q.db.CreateAgreements(ctx, q.batch); err == nil {
+		stored = q.batch
+	} else {
+		// a single duplicate fails the whole batch, so store them one by one
+		duplicates, dropped := 0, 0
+		for _, rba := range q.batch {
+			key := pendingKey(rba)
+			err := q.db.CreateAgreement(ctx, rba)
+			switch {
+			case err == nil:
+				stored = append(stored, rba)
+			case isUniqueError(err):
+				duplicates++
+				delete(q.failures, key)
+				q.removePending(key)
+			case q.failures[key] >= q.retries:
+				q.log.Error("dropping agreement, which could not be stored",
+					zap.String("Serial Number", rba.PayerAllocation.SerialNumber),
+					zap.String("Storage Node ID", rba.StorageNodeId.String()),
+					zap.Int("Retries", q.failures[key]),
+					zap.Error(err))
+				dropped++
+				delete(q.failures, key)
+				q.removePending(key)
+			default:
+				q.log.Warn("could not store agreement",
+					zap.String("Serial Number", rba.PayerAllocation.SerialNumber),
+					zap.String("Storage Node ID", rba.StorageNodeId.String()),
+					zap.Error(err))
+				q.failures[key]++
+				failed = append(failed, rba)
+			}
+		}
+		mon.Meter("bwagreement_queue_duplicate").Mark(duplicates)
+		mon.Meter("bwagreement_queue_failed").Mark(len(failed))
+		mon.Meter("bwagreement_queue_dropped").Mark(dropped)
+	}
+	mon.Meter("bwagreement_queue_stored").Mark(len(stored))
+
+	for _, rba := range stored {
+		key := pendingKey(rba)
+		delete(q.failures, key)
+		q.removePending(key)
+	}
+	q.batch = failed
+
+	q.updateUptime(ctx, stored)
+}
+
+// updateUptime counts the stored agreements as successful uptime checks,
+// once for every storage node
+func (q *Queue) updateUptime(ctx context.Context, stored []*pb.RenterBandwidthAllocation) {
+	if q.uptime == nil {
+		return
+	}
+
+	updated := make(map[storj.NodeID]struct{})
+	for _, rba := range stored {
+		if _, ok := updated[rba.StorageNodeId]; ok {
+			continue
+		}
+		updated[rba.StorageNodeId] = struct{}{}
+		if _, err := q.uptime.UpdateUptime(ctx, rba.StorageNodeId, true); err != nil {
+			q.log.Warn("could not update node uptime", zap.String("ID", rba.StorageNodeId.String()), zap.Error(err))
+		}
+	}
+}
+
+// IsPending returns whether the agreement is waiting in the queue
+func (q *Queue) IsPending(rba *pb.RenterBandwidthAllocation) bool {
+	q.pendingMu.Lock()
+	defer q.pendingMu.Unlock()
+	_, ok := q.pending[pendingKey(rba)]
+	return ok
+}
+
+func (q *Queue) addPending(key string) bool {
+	q.pendingMu.Lock()
+	defer q.pendingMu.Unlock()
+	if _, ok := q.pending[key]; ok {
+		return false
+	}
+	q.pending[key] = struct{}{}
+	return true
+}
+
+func (q *Queue) removePending(key string) {
+	q.pendingMu.Lock()
+	defer q.pendingMu.Unlock()
+	delete(q.pending, key)
+}
@@ -0,0 +1,175 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/bwagreement"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestQueue(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		snID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		newAgreement := func(serialNum string) *pb.RenterBandwidthAllocation {
+			return &pb.RenterBandwidthAllocation{
+				PayerAllocation: pb.PayerBandwidthAllocation{
+					Action:            pb.BandwidthAction_PUT,
+					SerialNumber:      serialNum,
+					UplinkId:          upID.ID,
+					ExpirationUnixSec: time.Now().Add(time.Hour).Unix(),
+				},
+				Total:         1000,
+				StorageNodeId: snID.ID,
+			}
+		}
+
+		require.NoError(t, db.BandwidthAgreement().CreateAgreement(ctx, newAgreement("stored")))
+
+		queue := bwagreement.NewQueue(zap.NewNop(), db.BandwidthAgreement(), nil, bwagreement.Config{
+			QueueSize:     10,
+			BatchSize:     10,
+			FlushInterval: time.Hour,
+		})
+		ctx.Go(func() error { return queue.Run(ctx) })
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, queue.Enqueue(ctx, newAgreement(strconv.Itoa(i))))
+		}
+
+		// agreements, which have already been stored, are dropped when written
+		require.NoError(t, queue.Enqueue(ctx, newAgreement("stored")))
+
+		// nothing is written before the queue is closed
+		err = queue.Enqueue(ctx, newAgreement("0"))
+		assert.True(t, auth.ErrSerial.Has(err))
+
+		require.NoError(t, queue.Close())
+		assert.True(t, bwagreement.ErrQueueClosed.Has(queue.Enqueue(ctx, newAgreement("closed"))))
+
+		totals, err := db.BandwidthAgreement().GetTotals(ctx, time.Time{}, time.Now().UTC())
+		require.NoError(t, err)
+		assert.Equal(t, int64(4000), totals[snID.ID][pb.BandwidthAction_PUT])
+	})
+}
+
+func TestQueueUptime(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		snID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		_, err = db.StatDB().Create(ctx, snID.ID, nil)
+		require.NoError(t, err)
+
+		queue := bwagreement.NewQueue(zap.NewNop(), db.BandwidthAgreement(), db.StatDB(), bwagreement.Config{
+			QueueSize:     10,
+			BatchSize:     10,
+			FlushInterval: time.Hour,
+		})
+		ctx.Go(func() error { return queue.Run(ctx) })
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, queue.Enqueue(ctx, &pb.RenterBandwidthAllocation{
+				PayerAllocation: pb.PayerBandwidthAllocation{
+					Action:            pb.BandwidthAction_PUT,
+					SerialNumber:      strconv.Itoa(i),
+					UplinkId:          upID.ID,
+					ExpirationUnixSec: time.Now().Add(time.Hour).Unix(),
+				},
+				Total:         1000,
+				StorageNodeId: snID.ID,
+			}))
+		}
+
+		// nothing is counted before the batch is written
+		stats, err := db.StatDB().Get(ctx, snID.ID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 0, stats.UptimeCount)
+
+		// and a batch counts once for every storage node
+		require.NoError(t, queue.Close())
+
+		stats, err = db.StatDB().Get(ctx, snID.ID)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, stats.UptimeCount)
+		assert.EqualValues(t, 1, stats.UptimeSuccessCount)
+	})
+}
+
+// failingDB fails to store any agreement
+type failingDB struct {
+	bwagreement.DB
+}
+
+func (failingDB) CreateAgreement(context.Context, *pb.RenterBandwidthAllocation) error {
+	return errs.New("failing")
+}
+
+func (failingDB) CreateAgreements(context.Context, []*pb.RenterBandwidthAllocation) error {
+	return errs.New("failing")
+}
+
+func TestQueueRetries(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		snID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		queue := bwagreement.NewQueue(zap.NewNop(), failingDB{db.BandwidthAgreement()}, nil, bwagreement.Config{
+			QueueSize:     10,
+			BatchSize:     10,
+			FlushInterval: time.Millisecond,
+			Retries:       3,
+		})
+		ctx.Go(func() error { return queue.Run(ctx) })
+
+		rba := &pb.RenterBandwidthAllocation{
+			PayerAllocation: pb.PayerBandwidthAllocation{
+				Action:            pb.BandwidthAction_PUT,
+				SerialNumber:      "failing",
+				UplinkId:          upID.ID,
+				ExpirationUnixSec: time.Now().Add(time.Hour).Unix(),
+			},
+			Total:         1000,
+			StorageNodeId: snID.ID,
+		}
+		require.NoError(t, queue.Enqueue(ctx, rba))
+
+		// the agreement is dropped after its retries
+		for queue.IsPending(rba) {
+			time.Sleep(time.Millisecond)
+		}
+		assert.NoError(t, queue.Close())
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement
+
+import (
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// RateLimitConfig configures how many agreements a storage node may submit
+type RateLimitConfig struct {
+	Rate  float64 `help:"agreements per second each storage node may submit, 0 disables rate limiting" default:"100"`
+	Burst int     `help:"number of agreements a storage node may submit at once before it's rate limited" default:"1000"`
+}
+
+// RateLimiter limits the agreement submissions of every storage node with
+// a separate token bucket
+type RateLimiter struct {
+	config RateLimitConfig
+
+	mu      sync.Mutex
+	buckets map[storj.NodeID]*tokenBucket
+	// prune is the number of buckets at which full buckets are removed
+	prune int
+}
+
+// tokenBucket contains the agreements a storage node may submit
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a new per storage node rate limiter
+func NewRateLimiter(config RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		config:  config,
+		buckets: map[storj.NodeID]*tokenBucket{},
+		prune:   1024,
+	}
+}
+
+// Allow returns whether the storage node may submit another agreement
+func (limiter *RateLimiter) Allow(nodeID storj.NodeID) bool {
+	if limiter.config.Rate <= 0 {
+		return true
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	now := time.Now()
+	bucket, ok := limiter.buckets[nodeID]
+	if !ok {
+		if len(limiter.buckets) >= limiter.prune {
+			limiter.removeFull(now)
+		}
+		bucket = &tokenBucket{tokens: limiter.burst(), last: now}
+		limiter.buckets[nodeID] = bucket
+	}
+
+	limiter.refill(bucket, now)
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// burst returns the capacity of a bucket
+func (limiter *RateLimiter) burst() float64 {
+	if limiter.config.Burst < 1 {
+		return 1
+	}
+	return float64(limiter.config.Burst)
+}
+
+// refill adds the tokens accumulated since the bucket was last used
+func (limiter *RateLimiter) refill(bucket *tokenBucket, now time.Time) {
+	bucket.tokens += now.Sub(bucket.last).Seconds() * limiter.config.Rate
+	if burst := limiter.burst(); bucket.tokens > burst {
+		bucket.tokens = burst
+	}
+	bucket.last = now
+}
+
+// removeFull removes the buckets of storage nodes, which haven't submitted
+// agreements for long enough to be full again, so the buckets of nodes,
+// which went away, don't accumulate
+func (limiter *RateLimiter) removeFull(now time.Time) {
+	for nodeID, bucket := range limiter.buckets {
+		limiter.refill(bucket, now)
+		if bucket.tokens >= limiter.burst() {
+			delete(limiter.buckets, nodeID)
+		}
+	}
+	if len(limiter.buckets)*2 > limiter.prune {
+		limiter.prune = len(limiter.buckets) * 2
+	}
+}
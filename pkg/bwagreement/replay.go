@@ -0,0 +1,94 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement
+
+import (
+	"context"
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+)
+
+// maxDumpMessageSize is the maximum size of a single agreement in a dump
+const maxDumpMessageSize = 64 * memory.KiB
+
+// ReplayStats counts the outcomes of replaying a dump of agreements
+type ReplayStats struct {
+	Stored    int
+	Duplicate int
+	Invalid   int
+}
+
+// WriteDump writes agreements as a dump, which can be replayed with Replay.
+// Every agreement is written as a varint length followed by the marshaled
+// agreement.
+func WriteDump(w io.Writer, agreements []*pb.RenterBandwidthAllocation) error {
+	writer := protoio.NewDelimitedWriter(w)
+	for _, rba := range agreements {
+		if err := writer.WriteMsg(rba); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// Replay verifies the signatures of the agreements in a dump and stores them
+// with their original creation time. Agreements which have already been
+// stored are skipped, so the same dump can be replayed more than once.
+// Agreements which don't verify are logged and skipped.
+//
+// Unlike BandwidthAgreements, Replay accepts expired agreements, since dumps
+// are used to rebuild the agreements after they have been lost.
+func (s *Server) Replay(ctx context.Context, dump io.Reader) (stats ReplayStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader := protoio.NewDelimitedReader(dump, maxDumpMessageSize.Int())
+	for {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		rba := &pb.RenterBandwidthAllocation{}
+		err := reader.ReadMsg(rba)
+		if err == io.EOF {
+			return stats, nil
+		}
+		if err != nil {
+			return stats, Error.Wrap(err)
+		}
+
+		if err := s.verifyReplay(ctx, rba); err != nil {
+			stats.Invalid++
+			s.logger.Warn("skipping invalid agreement",
+				zap.String("Serial Number", rba.PayerAllocation.SerialNumber),
+				zap.String("Storage Node ID", rba.StorageNodeId.String()),
+				zap.Error(err))
+			continue
+		}
+
+		err = s.bwdb.ReplayAgreement(ctx, rba)
+		switch {
+		case err == nil:
+			stats.Stored++
+		case isUniqueError(err):
+			stats.Duplicate++
+		default:
+			return stats, Error.Wrap(err)
+		}
+	}
+}
+
+// verifyReplay verifies an agreement without the checks, which only apply
+// while the agreement is sent by the storage node
+func (s *Server) verifyReplay(ctx context.Context, rba *pb.RenterBandwidthAllocation) error {
+	pba := rba.PayerAllocation
+	if pba.SatelliteId != s.NodeID {
+		return pb.ErrPayer.New("Satellite ID: %v vs %v", pba.SatelliteId, s.NodeID)
+	}
+	return s.verifySignature(ctx, rba)
+}
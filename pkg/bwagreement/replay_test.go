@@ -0,0 +1,94 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/bwagreement"
+	"storj.io/storj/pkg/bwagreement/testbwagreement"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestReplay(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		satID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		otherSatID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, db.CertDB().SavePublicKey(ctx, upID.ID, upID.Leaf.PublicKey))
+		server := bwagreement.NewServer(db.BandwidthAgreement(), db.CertDB(), satID.Leaf.PublicKey, zap.NewNop(), satID.ID)
+
+		storageNode := storj.NodeID{1}
+		created := time.Now().Add(-48 * time.Hour)
+
+		// an agreement, which expired before the agreements were lost
+		expired := &pb.PayerBandwidthAllocation{
+			SatelliteId:       satID.ID,
+			UplinkId:          upID.ID,
+			ExpirationUnixSec: created.Add(time.Hour).Unix(),
+			SerialNumber:      "expired",
+			Action:            pb.BandwidthAction_GET,
+			CreatedUnixSec:    created.Unix(),
+		}
+		require.NoError(t, auth.SignMessage(expired, *satID))
+		expiredRBA, err := testbwagreement.GenerateRenterBandwidthAllocation(expired, storageNode, upID, 1000)
+		require.NoError(t, err)
+
+		current, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_PUT, satID, upID, time.Hour)
+		require.NoError(t, err)
+		currentRBA, err := testbwagreement.GenerateRenterBandwidthAllocation(current, storageNode, upID, 666)
+		require.NoError(t, err)
+
+		foreign, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_PUT, otherSatID, upID, time.Hour)
+		require.NoError(t, err)
+		foreignRBA, err := testbwagreement.GenerateRenterBandwidthAllocation(foreign, storageNode, upID, 666)
+		require.NoError(t, err)
+
+		tampered, err := testbwagreement.GenerateRenterBandwidthAllocation(current, storageNode, upID, 666)
+		require.NoError(t, err)
+		tampered.Total = 1 << 30
+
+		var dump bytes.Buffer
+		err = bwagreement.WriteDump(&dump, []*pb.RenterBandwidthAllocation{expiredRBA, currentRBA, foreignRBA, tampered})
+		require.NoError(t, err)
+		replay := dump.Bytes()
+
+		stats, err := server.Replay(ctx, bytes.NewReader(replay))
+		require.NoError(t, err)
+		assert.Equal(t, bwagreement.ReplayStats{Stored: 2, Duplicate: 0, Invalid: 2}, stats)
+
+		stats, err = server.Replay(ctx, bytes.NewReader(replay))
+		require.NoError(t, err)
+		assert.Equal(t, bwagreement.ReplayStats{Stored: 0, Duplicate: 2, Invalid: 2}, stats)
+
+		// replayed agreements keep their creation time
+		totals, err := db.BandwidthAgreement().GetTotals(ctx, created.Add(-time.Hour), created.Add(time.Hour))
+		require.NoError(t, err)
+		require.Contains(t, totals, storageNode)
+		assert.EqualValues(t, 1000, totals[storageNode][pb.BandwidthAction_GET])
+		assert.EqualValues(t, 0, totals[storageNode][pb.BandwidthAction_PUT])
+
+		_, err = server.Replay(ctx, bytes.NewReader(replay[:len(replay)-1]))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/pkg/storj"
+)
+
+// TotalsBucket contains the bandwidth of a storage node during a time bucket
+type TotalsBucket struct {
+	NodeID        storj.NodeID
+	IntervalStart time.Time
+	// Totals contains the sum of each bandwidth action, indexed by pb.BandwidthAction
+	Totals []int64
+}
+
+// RollupConfig configures rolling up the agreements into hourly totals
+type RollupConfig struct {
+	Interval time.Duration `help:"how often agreements are rolled up into hourly totals, 0 disables the rollup" default:"1h"`
+	Backfill time.Duration `help:"how far back agreements are rolled up when no hourly totals exist yet" default:"720h"`
+}
+
+// Rollup sums the agreements of every completed hour per storage node and
+// action, so bucketed totals can be queried without scanning the agreements.
+//
+// Only agreements, which are stored before their hour has been rolled up, are
+// counted.
+type Rollup struct {
+	log    *zap.Logger
+	db     DB
+	config RollupConfig
+
+	// next is the start of the next hour to roll up
+	next time.Time
+
+	Chore *chore.Chore
+}
+
+// NewRollup creates a new agreement rollup
+func NewRollup(log *zap.Logger, db DB, config RollupConfig) *Rollup {
+	rollup := &Rollup{
+		log:    log,
+		db:     db,
+		config: config,
+	}
+	rollup.Chore = chore.New(log, "agreements:rollup", config.Interval, rollup.RollupTotals)
+	return rollup
+}
+
+// Run rolls up the completed hours every interval
+func (rollup *Rollup) Run(ctx context.Context) error {
+	if rollup.config.Interval <= 0 {
+		return nil
+	}
+	return rollup.Chore.Run(ctx)
+}
+
+// RollupTotals saves the totals of every completed hour, which hasn't been
+// rolled up yet
+func (rollup *Rollup) RollupTotals(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if rollup.next.IsZero() {
+		last, err := rollup.db.LastRollup(ctx)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if last.IsZero() {
+			last = time.Now().Add(-rollup.config.Backfill)
+		}
+		rollup.next = last.UTC().Truncate(time.Hour)
+	}
+
+	end := time.Now().UTC().Truncate(time.Hour)
+	for rollup.next.Before(end) {
+		intervalEnd := rollup.next.Add(time.Hour)
+
+		totals, err := rollup.db.GetTotals(ctx, rollup.next, intervalEnd)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(totals) > 0 {
+			if err := rollup.db.SaveRollup(ctx, rollup.next, totals); err != nil {
+				return Error.Wrap(err)
+			}
+			rollup.log.Debug("rolled up agreements", zap.Time("hour", rollup.next), zap.Int("nodes", len(totals)))
+		}
+
+		rollup.next = intervalEnd
+	}
+	return nil
+}
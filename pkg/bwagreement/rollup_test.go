@@ -0,0 +1,82 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/bwagreement"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestRollup(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		snID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		hour := time.Now().UTC().Truncate(time.Hour)
+		replay := func(serialNum string, action pb.BandwidthAction, created time.Time, total int64) {
+			require.NoError(t, db.BandwidthAgreement().ReplayAgreement(ctx, &pb.RenterBandwidthAllocation{
+				PayerAllocation: pb.PayerBandwidthAllocation{
+					Action:            action,
+					SerialNumber:      serialNum,
+					UplinkId:          upID.ID,
+					CreatedUnixSec:    created.Unix(),
+					ExpirationUnixSec: created.Add(time.Hour).Unix(),
+				},
+				Total:         total,
+				StorageNodeId: snID.ID,
+			}))
+		}
+
+		replay("put", pb.BandwidthAction_PUT, hour.Add(-3*time.Hour+10*time.Minute), 100)
+		replay("get", pb.BandwidthAction_GET, hour.Add(-3*time.Hour+20*time.Minute), 10)
+		replay("later", pb.BandwidthAction_PUT, hour.Add(-2*time.Hour+30*time.Minute), 1000)
+		// the current hour isn't complete yet
+		replay("current", pb.BandwidthAction_PUT, time.Now(), 10000)
+
+		config := bwagreement.RollupConfig{Backfill: 24 * time.Hour}
+		require.NoError(t, bwagreement.NewRollup(zap.NewNop(), db.BandwidthAgreement(), config).RollupTotals(ctx))
+
+		last, err := db.BandwidthAgreement().LastRollup(ctx)
+		require.NoError(t, err)
+		assert.True(t, last.Equal(hour.Add(-time.Hour)), last)
+
+		// a restarted rollup continues after the last rolled up hour
+		require.NoError(t, bwagreement.NewRollup(zap.NewNop(), db.BandwidthAgreement(), config).RollupTotals(ctx))
+
+		hourly, err := db.BandwidthAgreement().GetTotalsBucketed(ctx, hour.Add(-4*time.Hour), hour.Add(time.Hour), time.Hour)
+		require.NoError(t, err)
+		require.Len(t, hourly, 2)
+		assert.True(t, hourly[0].IntervalStart.Equal(hour.Add(-3*time.Hour)))
+		assert.Equal(t, snID.ID, hourly[0].NodeID)
+		assert.Equal(t, int64(100), hourly[0].Totals[pb.BandwidthAction_PUT])
+		assert.Equal(t, int64(10), hourly[0].Totals[pb.BandwidthAction_GET])
+		assert.True(t, hourly[1].IntervalStart.Equal(hour.Add(-2*time.Hour)))
+		assert.Equal(t, int64(1000), hourly[1].Totals[pb.BandwidthAction_PUT])
+
+		buckets, err := db.BandwidthAgreement().GetTotalsBucketed(ctx, hour.Add(-4*time.Hour), hour.Add(time.Hour), 4*time.Hour)
+		require.NoError(t, err)
+		require.Len(t, buckets, 1)
+		assert.True(t, buckets[0].IntervalStart.Equal(hour.Add(-4*time.Hour)))
+		assert.Equal(t, int64(1100), buckets[0].Totals[pb.BandwidthAction_PUT])
+
+		_, err = db.BandwidthAgreement().GetTotalsBucketed(ctx, hour.Add(-4*time.Hour), hour, time.Minute)
+		assert.Error(t, err)
+	})
+}
@@ -7,11 +7,14 @@ import (
 	"context"
 	"crypto"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
 	"storj.io/storj/pkg/auth"
@@ -19,6 +22,7 @@ import (
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -31,6 +35,15 @@ var (
 // Config is a configuration struct that is everything you need to start an
 // agreement receiver responsibility
 type Config struct {
+	QueueSize     int           `help:"number of verified agreements buffered before they are written to the database, 0 writes every agreement immediately" default:"1000"`
+	BatchSize     int           `help:"maximum number of agreements written to the database at once" default:"100"`
+	FlushInterval time.Duration `help:"how often the buffered agreements are written to the database" default:"1s"`
+	Retries       int           `help:"number of times writing a buffered agreement is retried before it's dropped" default:"10"`
+
+	Cleaner   CleanerConfig
+	Rollup    RollupConfig
+	RateLimit RateLimitConfig
+	Anomaly   AnomalyConfig
 }
 
 //UplinkStat contains information about an uplink's returned bandwidth agreement
@@ -46,10 +59,44 @@ type UplinkStat struct {
 type DB interface {
 	// CreateAgreement adds a new bandwidth agreement.
 	CreateAgreement(context.Context, *pb.RenterBandwidthAllocation) error
+	// CreateAgreements adds new bandwidth agreements in a single transaction.
+	CreateAgreements(context.Context, []*pb.RenterBandwidthAllocation) error
 	// GetTotalsSince returns the sum of each bandwidth type after (exluding) a given date range
 	GetTotals(context.Context, time.Time, time.Time) (map[storj.NodeID][]int64, error)
 	//GetTotals returns stats about an uplink
 	GetUplinkStats(context.Context, time.Time, time.Time) ([]UplinkStat, error)
+	// GetUplinkStatsPage returns a page of the stats of the uplinks selected by opts.
+	GetUplinkStatsPage(ctx context.Context, opts UplinkStatsOptions) (UplinkStatsPage, error)
+	// HasAgreement returns whether the storage node has already submitted the agreement with the serial number.
+	HasAgreement(ctx context.Context, serialNumber string, storageNodeID storj.NodeID) (bool, error)
+	// ReplayAgreement adds a bandwidth agreement with the creation time of its payer allocation.
+	ReplayAgreement(context.Context, *pb.RenterBandwidthAllocation) error
+	// DeleteExpired deletes the agreements, which were created and expired before the given time.
+	DeleteExpired(context.Context, time.Time) (int64, error)
+	// ArchiveExpired moves the agreements, which were created and expired before the given time, to the archive.
+	ArchiveExpired(context.Context, time.Time) (int64, error)
+	// SaveRollup stores the totals of each storage node during the hour starting at the given time.
+	SaveRollup(context.Context, time.Time, map[storj.NodeID][]int64) error
+	// LastRollup returns the end of the last hour, which has been rolled up, or the zero time.
+	LastRollup(context.Context) (time.Time, error)
+	// GetTotalsBucketed returns the rolled up totals of each storage node between from (inclusive) and to
+	// (exclusive), summed into buckets of the given interval.
+	GetTotalsBucketed(ctx context.Context, from, to time.Time, interval time.Duration) ([]TotalsBucket, error)
+	// SaveAnomaly flags an agreement, which claims a total near or above the max size of its allocation.
+	SaveAnomaly(context.Context, *pb.RenterBandwidthAllocation) error
+	// GetSuspiciousNodes returns the storage nodes with at least minAnomalies agreements flagged since the
+	// given time, most flagged first.
+	GetSuspiciousNodes(ctx context.Context, since time.Time, minAnomalies int) ([]SuspiciousNode, error)
+}
+
+// UptimeDB records the uptime of storage nodes
+type UptimeDB interface {
+	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (*statdb.NodeStats, error)
+}
+
+// StatsDB looks up the stats of storage nodes
+type StatsDB interface {
+	Get(ctx context.Context, nodeID storj.NodeID) (*statdb.NodeStats, error)
 }
 
 // Server is an implementation of the pb.BandwidthServer interface
@@ -59,16 +106,44 @@ type Server struct {
 	pkey   crypto.PublicKey
 	NodeID storj.NodeID
 	logger *zap.Logger
+
+	// Uptime, when set, counts the stored agreements as successful uptime checks of the storage node
+	Uptime UptimeDB
+	// UptimeInterval is the minimum time between two uptime checks of a storage node counted by
+	// agreements, which are written immediately
+	UptimeInterval time.Duration
+	// Queue, when set, buffers the verified agreements instead of writing them to the database immediately
+	Queue *Queue
+	// Identity, when set, signs a receipt for every accepted agreement
+	Identity *identity.FullIdentity
+	// Limiter, when set, throttles storage nodes submitting too many agreements
+	Limiter *RateLimiter
+	// Anomaly configures flagging agreements with totals near the max size of their allocation
+	Anomaly AnomalyConfig
+	// Stats, when set, rejects the agreements of disqualified storage nodes
+	Stats StatsDB
+
+	uptimeMu sync.Mutex
+	// uptimeUpdated is when the uptime of each storage node was last updated
+	uptimeUpdated map[storj.NodeID]time.Time
+	// uptimePrune is the number of entries at which the outdated ones are removed
+	uptimePrune int
 }
 
 // NewServer creates instance of Server
 func NewServer(db DB, upldb certdb.DB, pkey crypto.PublicKey, logger *zap.Logger, nodeID storj.NodeID) *Server {
 	// TODO: reorder arguments, rename logger -> log
-	return &Server{bwdb: db, certdb: upldb, pkey: pkey, logger: logger, NodeID: nodeID}
+	return &Server{bwdb: db, certdb: upldb, pkey: pkey, logger: logger, NodeID: nodeID,
+		uptimeUpdated: map[storj.NodeID]time.Time{}, uptimePrune: 1024}
 }
 
 // Close closes resources
-func (s *Server) Close() error { return nil }
+func (s *Server) Close() error {
+	if s.Queue != nil {
+		return s.Queue.Close()
+	}
+	return nil
+}
 
 // BandwidthAgreements receives and stores bandwidth agreements from storage nodes
 func (s *Server) BandwidthAgreements(ctx context.Context, rba *pb.RenterBandwidthAllocation) (reply *pb.AgreementsSummary, err error) {
@@ -83,6 +158,12 @@ func (s *Server) BandwidthAgreements(ctx context.Context, rba *pb.RenterBandwidt
 	if err != nil || rba.StorageNodeId != pi.ID {
 		return reply, auth.ErrBadID.New("Storage Node ID: %v vs %v", rba.StorageNodeId, pi.ID)
 	}
+	// throttle before verifying the signatures or touching the database
+	if s.Limiter != nil && !s.Limiter.Allow(pi.ID) {
+		mon.Counter("agreements_throttled").Inc(1)
+		reply.Status = pb.AgreementsSummary_THROTTLED
+		return reply, status.Errorf(codes.ResourceExhausted, "too many agreements from storage node %v", pi.ID)
+	}
 	//todo:  use whitelist for uplinks?
 	if pba.SatelliteId != s.NodeID {
 		return reply, pb.ErrPayer.New("Satellite ID: %v vs %v", pba.SatelliteId, s.NodeID)
@@ -96,21 +177,131 @@ func (s *Server) BandwidthAgreements(ctx context.Context, rba *pb.RenterBandwidt
 		return reply, err
 	}
 
+	if err = s.checkDisqualified(ctx, rba.StorageNodeId); err != nil {
+		return reply, err
+	}
+
+	if err = s.checkTotal(ctx, rba); err != nil {
+		return reply, err
+	}
+
+	if s.Queue != nil {
+		if err = s.Queue.Enqueue(ctx, rba); err != nil {
+			if auth.ErrSerial.Has(err) {
+				return reply, pb.ErrPayer.Wrap(err)
+			}
+			reply.Status = pb.AgreementsSummary_FAIL
+			return reply, pb.ErrPayer.Wrap(err)
+		}
+		// the queue rejects the duplicates waiting in it, the duplicates of
+		// stored agreements get a receipt again and are dropped when written
+		reply.Status = pb.AgreementsSummary_OK
+		reply.Receipt = s.receipt(rba)
+		s.logger.Debug("Queued Agreement...")
+		return reply, nil
+	}
+
 	//save and return rersults
 	if err = s.bwdb.CreateAgreement(ctx, rba); err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") ||
-			strings.Contains(err.Error(), "violates unique constraint") {
+		if isUniqueError(err) {
 			return reply, pb.ErrPayer.Wrap(auth.ErrSerial.Wrap(err))
 		}
 		reply.Status = pb.AgreementsSummary_FAIL
 		return reply, pb.ErrPayer.Wrap(err)
 	}
 	reply.Status = pb.AgreementsSummary_OK
+	reply.Receipt = s.receipt(rba)
 	s.logger.Debug("Stored Agreement...")
+
+	s.updateUptime(ctx, rba.StorageNodeId)
 	return reply, nil
 }
 
+// updateUptime counts a stored agreement as a successful uptime check, once
+// every UptimeInterval for every storage node
+func (s *Server) updateUptime(ctx context.Context, nodeID storj.NodeID) {
+	if s.Uptime == nil || !s.uptimeDue(nodeID, time.Now()) {
+		return
+	}
+	if _, err := s.Uptime.UpdateUptime(ctx, nodeID, true); err != nil {
+		s.logger.Warn("could not update node uptime", zap.String("ID", nodeID.String()), zap.Error(err))
+	}
+}
+
+// uptimeDue returns whether the uptime of the storage node is due for an
+// update and records the update
+func (s *Server) uptimeDue(nodeID storj.NodeID, now time.Time) bool {
+	s.uptimeMu.Lock()
+	defer s.uptimeMu.Unlock()
+
+	if updated, ok := s.uptimeUpdated[nodeID]; ok && now.Sub(updated) < s.UptimeInterval {
+		return false
+	}
+
+	// the entries of storage nodes, which went away, don't accumulate
+	if len(s.uptimeUpdated) >= s.uptimePrune {
+		for id, updated := range s.uptimeUpdated {
+			if now.Sub(updated) >= s.UptimeInterval {
+				delete(s.uptimeUpdated, id)
+			}
+		}
+		if len(s.uptimeUpdated)*2 > s.uptimePrune {
+			s.uptimePrune = len(s.uptimeUpdated) * 2
+		}
+	}
+	s.uptimeUpdated[nodeID] = now
+	return true
+}
+
+// checkDisqualified returns an error when the storage node is disqualified,
+// nodes without stats aren't disqualified
+func (s *Server) checkDisqualified(ctx context.Context, nodeID storj.NodeID) error {
+	if s.Stats == nil {
+		return nil
+	}
+	stats, err := s.Stats.Get(ctx, nodeID)
+	if err != nil {
+		s.logger.Debug("could not get node stats", zap.String("ID", nodeID.String()), zap.Error(err))
+		return nil
+	}
+	if stats.Disqualified {
+		mon.Counter("agreements_disqualified").Inc(1)
+		return status.Errorf(codes.PermissionDenied, "storage node %v is disqualified", nodeID)
+	}
+	return nil
+}
+
+// receipt returns a signed receipt for an accepted agreement, nil when the
+// server has no identity or signing fails
+func (s *Server) receipt(rba *pb.RenterBandwidthAllocation) *pb.AgreementReceipt {
+	if s.Identity == nil {
+		return nil
+	}
+
+	receipt := &pb.AgreementReceipt{
+		SerialNumber:    rba.PayerAllocation.SerialNumber,
+		StorageNodeId:   rba.StorageNodeId,
+		SatelliteId:     s.Identity.ID,
+		AcceptedUnixSec: time.Now().Unix(),
+	}
+	// the agreement has already been accepted, so a missing receipt
+	// shouldn't make the storage node send it again
+	if err := auth.SignMessage(receipt, *s.Identity); err != nil {
+		s.logger.Warn("could not sign agreement receipt", zap.String("Serial Number", receipt.SerialNumber), zap.Error(err))
+		return nil
+	}
+	return receipt
+}
+
 func (s *Server) verifySignature(ctx context.Context, rba *pb.RenterBandwidthAllocation) error {
+	if err := s.verifyRenterSignature(ctx, rba); err != nil {
+		return err
+	}
+	return s.verifyPayerSignature(rba)
+}
+
+// verifyRenterSignature verifies the uplink's signature of the agreement
+func (s *Server) verifyRenterSignature(ctx context.Context, rba *pb.RenterBandwidthAllocation) error {
 	pba := rba.GetPayerAllocation()
 
 	// Get renter's public key from uplink agreement db
@@ -131,8 +322,12 @@ func (s *Server) verifySignature(ctx context.Context, rba *pb.RenterBandwidthAll
 	if err := pkcrypto.HashAndVerifySignature(uplinkInfo, rbadBytes, rba.GetSignature()); err != nil {
 		return pb.ErrRenter.Wrap(auth.ErrVerify.Wrap(err))
 	}
+	return nil
+}
 
-	// verify Payer's (satellite) signature
+// verifyPayerSignature verifies the satellite's signature of the payer allocation
+func (s *Server) verifyPayerSignature(rba *pb.RenterBandwidthAllocation) error {
+	pba := rba.GetPayerAllocation()
 	pbad := pba
 	pbad.SetSignature(nil)
 	pbad.SetCerts(nil)
@@ -146,3 +341,9 @@ func (s *Server) verifySignature(ctx context.Context, rba *pb.RenterBandwidthAll
 	}
 	return nil
 }
+
+// isUniqueError returns whether err is caused by an agreement, which has already been stored
+func isUniqueError(err error) bool {
+	return strings.Contains(err.Error(), "UNIQUE constraint failed") ||
+		strings.Contains(err.Error(), "violates unique constraint")
+}
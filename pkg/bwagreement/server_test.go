@@ -16,8 +16,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testidentity"
@@ -41,6 +43,234 @@ func TestBandwidthAgreement(t *testing.T) {
 	})
 }
 
+func TestAgreementReceipt(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		satID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		satellite := bwagreement.NewServer(db.BandwidthAgreement(), db.CertDB(), satID.Leaf.PublicKey, zap.NewNop(), satID.ID)
+		satellite.Identity = satID
+
+		pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, db.CertDB().SavePublicKey(ctx, pba.UplinkId, upID.Leaf.PublicKey))
+
+		ctxSN, storageNode := getPeerContext(ctx, t)
+		rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode, upID, 666)
+		require.NoError(t, err)
+
+		reply, err := satellite.BandwidthAgreements(ctxSN, rba)
+		require.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+
+		receipt := reply.GetReceipt()
+		require.NotNil(t, receipt)
+		assert.Equal(t, pba.SerialNumber, receipt.SerialNumber)
+		assert.Equal(t, storageNode, receipt.StorageNodeId)
+		assert.Equal(t, satID.ID, receipt.SatelliteId)
+		assert.NoError(t, auth.VerifyMsg(receipt, satID.ID))
+
+		// a receipt can't be reused for another agreement
+		receipt.SerialNumber = "other"
+		assert.Error(t, auth.VerifyMsg(receipt, satID.ID))
+
+		// rejected agreements don't get a receipt
+		reply, err = satellite.BandwidthAgreements(ctxSN, rba)
+		assert.Error(t, err)
+		assert.Nil(t, reply.GetReceipt())
+	})
+}
+
+func TestAgreementReceiptQueued(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		satID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		satellite := bwagreement.NewServer(db.BandwidthAgreement(), db.CertDB(), satID.Leaf.PublicKey, zap.NewNop(), satID.ID)
+		satellite.Identity = satID
+		satellite.Queue = bwagreement.NewQueue(zap.NewNop(), db.BandwidthAgreement(), nil, bwagreement.Config{
+			QueueSize:     10,
+			BatchSize:     10,
+			FlushInterval: time.Hour,
+		})
+		defer ctx.Check(satellite.Close)
+		require.NoError(t, db.CertDB().SavePublicKey(ctx, upID.ID, upID.Leaf.PublicKey))
+
+		ctxSN, storageNode := getPeerContext(ctx, t)
+		pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, time.Hour)
+		require.NoError(t, err)
+		rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode, upID, 666)
+		require.NoError(t, err)
+
+		// a queued agreement gets a receipt
+		reply, err := satellite.BandwidthAgreements(ctxSN, rba)
+		require.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+		assert.NotNil(t, reply.GetReceipt())
+
+		// and it isn't queued again, while it waits to be written
+		reply, err = satellite.BandwidthAgreements(ctxSN, rba)
+		assert.True(t, auth.ErrSerial.Has(err))
+		assert.Equal(t, pb.AgreementsSummary_REJECTED, reply.Status)
+		assert.Nil(t, reply.GetReceipt())
+	})
+}
+
+func TestAgreementUptime(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		satID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		satellite := bwagreement.NewServer(db.BandwidthAgreement(), db.CertDB(), satID.Leaf.PublicKey, zap.NewNop(), satID.ID)
+		satellite.Uptime = db.StatDB()
+		satellite.UptimeInterval = time.Hour
+		require.NoError(t, db.CertDB().SavePublicKey(ctx, upID.ID, upID.Leaf.PublicKey))
+
+		ctxSN, storageNode := getPeerContext(ctx, t)
+		_, err = db.StatDB().Create(ctx, storageNode, nil)
+		require.NoError(t, err)
+
+		pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, time.Hour)
+		require.NoError(t, err)
+		rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode, upID, 666)
+		require.NoError(t, err)
+
+		// a stored agreement counts as a successful uptime check
+		reply, err := satellite.BandwidthAgreements(ctxSN, rba)
+		require.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+
+		stats, err := db.StatDB().Get(ctx, storageNode)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, stats.UptimeCount)
+		assert.EqualValues(t, 1, stats.UptimeSuccessCount)
+
+		// a rejected one doesn't
+		_, err = satellite.BandwidthAgreements(ctxSN, rba)
+		assert.Error(t, err)
+
+		stats, err = db.StatDB().Get(ctx, storageNode)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, stats.UptimeCount)
+
+		// and the next stored ones only count after the interval
+		pba, err = testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, time.Hour)
+		require.NoError(t, err)
+		rba, err = testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode, upID, 666)
+		require.NoError(t, err)
+
+		reply, err = satellite.BandwidthAgreements(ctxSN, rba)
+		require.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+
+		stats, err = db.StatDB().Get(ctx, storageNode)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, stats.UptimeCount)
+	})
+}
+
+func TestThrottling(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		satID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		satellite := bwagreement.NewServer(db.BandwidthAgreement(), db.CertDB(), satID.Leaf.PublicKey, zap.NewNop(), satID.ID)
+		satellite.Limiter = bwagreement.NewRateLimiter(bwagreement.RateLimitConfig{Rate: 0.001, Burst: 2})
+		require.NoError(t, db.CertDB().SavePublicKey(ctx, upID.ID, upID.Leaf.PublicKey))
+
+		ctxSN, storageNode := getPeerContext(ctx, t)
+		ctxOther, otherNode := getPeerContext(ctx, t)
+		submit := func(ctx context.Context, nodeID storj.NodeID) (*pb.AgreementsSummary, error) {
+			pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, time.Hour)
+			require.NoError(t, err)
+			rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, nodeID, upID, 666)
+			require.NoError(t, err)
+			return satellite.BandwidthAgreements(ctx, rba)
+		}
+
+		for i := 0; i < 2; i++ {
+			reply, err := submit(ctxSN, storageNode)
+			require.NoError(t, err)
+			assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+		}
+
+		reply, err := submit(ctxSN, storageNode)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, pb.AgreementsSummary_THROTTLED, reply.Status)
+
+		// other storage nodes aren't affected
+		reply, err = submit(ctxOther, otherNode)
+		require.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+	})
+}
+
+func TestDisqualifiedNode(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		satID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		satellite := bwagreement.NewServer(db.BandwidthAgreement(), db.CertDB(), satID.Leaf.PublicKey, zap.NewNop(), satID.ID)
+		satellite.Stats = db.StatDB()
+		require.NoError(t, db.CertDB().SavePublicKey(ctx, upID.ID, upID.Leaf.PublicKey))
+
+		ctxSN, storageNode := getPeerContext(ctx, t)
+		submit := func() (*pb.AgreementsSummary, error) {
+			pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, time.Hour)
+			require.NoError(t, err)
+			rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode, upID, 666)
+			require.NoError(t, err)
+			return satellite.BandwidthAgreements(ctxSN, rba)
+		}
+
+		// nodes without stats aren't disqualified
+		reply, err := submit()
+		require.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+
+		_, err = db.StatDB().Create(ctx, storageNode, nil)
+		require.NoError(t, err)
+		_, err = db.StatDB().Disqualify(ctx, storageNode, "testing")
+		require.NoError(t, err)
+
+		reply, err = submit()
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, pb.AgreementsSummary_REJECTED, reply.Status)
+
+		_, err = db.StatDB().Reinstate(ctx, storageNode)
+		require.NoError(t, err)
+
+		reply, err = submit()
+		require.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+	})
+}
+
 func getPeerContext(ctx context.Context, t *testing.T) (context.Context, storj.NodeID) {
 	ident, err := testidentity.NewTestIdentity(ctx)
 	if !assert.NoError(t, err) || !assert.NotNil(t, ident) {
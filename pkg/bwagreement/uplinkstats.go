@@ -0,0 +1,75 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement
+
+import (
+	"time"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// UplinkStatsOrder is the order of a page of uplink stats
+type UplinkStatsOrder int
+
+const (
+	// OrderByUplinkID orders the stats by ascending uplink id
+	OrderByUplinkID UplinkStatsOrder = iota
+	// OrderByTotalBytes orders the stats by descending total bytes, then by uplink id
+	OrderByTotalBytes
+	// OrderByTotalTransactions orders the stats by descending number of agreements, then by uplink id
+	OrderByTotalTransactions
+)
+
+// UplinkStatsOptions selects a page of uplink stats
+type UplinkStatsOptions struct {
+	// From (exclusive) and To (inclusive) limit the creation time of the agreements
+	From, To time.Time
+	// Actions, when not empty, only counts the agreements of the given actions
+	Actions []pb.BandwidthAction
+	OrderBy UplinkStatsOrder
+	// Cursor, when set, continues after the last stat of the previous page
+	Cursor *UplinkStatsCursor
+	// Limit is the maximum number of stats in the page, it has to be positive
+	Limit int
+}
+
+// UplinkStatsCursor is the position of the last stat of a page
+type UplinkStatsCursor struct {
+	// Value is the ordered value of the stat, unused when ordering by uplink id
+	Value    int64
+	UplinkID storj.NodeID
+}
+
+// UplinkStatsPage is a page of uplink stats
+type UplinkStatsPage struct {
+	Stats []UplinkStat
+	// Next continues with the next page, it's nil on the last page
+	Next *UplinkStatsCursor
+}
+
+// cursor returns the position of the stat in the given order
+func (stat *UplinkStat) cursor(order UplinkStatsOrder) *UplinkStatsCursor {
+	cursor := &UplinkStatsCursor{UplinkID: stat.NodeID}
+	switch order {
+	case OrderByTotalBytes:
+		cursor.Value = stat.TotalBytes
+	case OrderByTotalTransactions:
+		cursor.Value = int64(stat.TotalTransactions)
+	}
+	return cursor
+}
+
+// NewUplinkStatsPage creates a page of the stats queried with opts, stats
+// may contain one more stat than the limit to signal the page isn't the last one
+func NewUplinkStatsPage(stats []UplinkStat, opts UplinkStatsOptions) UplinkStatsPage {
+	if len(stats) <= opts.Limit {
+		return UplinkStatsPage{Stats: stats}
+	}
+	stats = stats[:opts.Limit]
+	return UplinkStatsPage{
+		Stats: stats,
+		Next:  stats[len(stats)-1].cursor(opts.OrderBy),
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+// VerifyAgreement runs every check of BandwidthAgreements and returns the
+// failed ones, so storage node operators can find out why an agreement is
+// rejected. Nothing is stored and no anomalies are flagged.
+func (s *Server) VerifyAgreement(ctx context.Context, rba *pb.RenterBandwidthAllocation) (reply *pb.AgreementVerification, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pi, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	// verifying is as expensive as submitting, so it's limited the same way
+	if s.Limiter != nil && !s.Limiter.Allow(pi.ID) {
+		mon.Counter("agreements_throttled").Inc(1)
+		return nil, status.Errorf(codes.ResourceExhausted, "too many agreements from storage node %v", pi.ID)
+	}
+
+	reply = &pb.AgreementVerification{}
+	fail := func(check pb.AgreementVerification_Check, format string, args ...interface{}) {
+		reply.Failures = append(reply.Failures, &pb.AgreementVerification_Failure{
+			Check:   check,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	pba := rba.PayerAllocation
+	if rba.StorageNodeId != pi.ID {
+		fail(pb.AgreementVerification_PEER_ID, "storage node id %v doesn't match the peer id %v", rba.StorageNodeId, pi.ID)
+	}
+	if pba.SatelliteId != s.NodeID {
+		fail(pb.AgreementVerification_SATELLITE_ID, "satellite id %v doesn't match %v", pba.SatelliteId, s.NodeID)
+	}
+	exp := time.Unix(pba.GetExpirationUnixSec(), 0).UTC()
+	if now := time.Now().UTC(); exp.Before(now) {
+		fail(pb.AgreementVerification_EXPIRATION, "expired at %v, now is %v", exp, now)
+	}
+
+	if err := s.verifyRenterSignature(ctx, rba); err != nil {
+		fail(pb.AgreementVerification_RENTER_SIGNATURE, "%v", err)
+	}
+	if err := s.verifyPayerSignature(rba); err != nil {
+		fail(pb.AgreementVerification_PAYER_SIGNATURE, "%v", err)
+	}
+
+	if s.Queue != nil && s.Queue.IsPending(rba) {
+		fail(pb.AgreementVerification_SERIAL_NUMBER, "agreement %s is already queued", pba.SerialNumber)
+	} else if exists, err := s.bwdb.HasAgreement(ctx, pba.SerialNumber, rba.StorageNodeId); err != nil {
+		return nil, Error.Wrap(err)
+	} else if exists {
+		fail(pb.AgreementVerification_SERIAL_NUMBER, "agreement %s has already been submitted", pba.SerialNumber)
+	}
+
+	if maxSize := pba.MaxSize; maxSize > 0 && rba.Total > maxSize {
+		fail(pb.AgreementVerification_TOTAL, "total %d exceeds max size %d", rba.Total, maxSize)
+	}
+
+	reply.Valid = len(reply.Failures) == 0
+	return reply, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bwagreement_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/bwagreement"
+	"storj.io/storj/pkg/bwagreement/testbwagreement"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestVerifyAgreement(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		upID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		satID, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+
+		satellite := bwagreement.NewServer(db.BandwidthAgreement(), db.CertDB(), satID.Leaf.PublicKey, zap.NewNop(), satID.ID)
+
+		pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, satID, upID, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, db.CertDB().SavePublicKey(ctx, pba.UplinkId, upID.Leaf.PublicKey))
+
+		ctxSN, storageNode := getPeerContext(ctx, t)
+		ctxOther, _ := getPeerContext(ctx, t)
+		rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, storageNode, upID, 666)
+		require.NoError(t, err)
+
+		checks := func(reply *pb.AgreementVerification) (checks []pb.AgreementVerification_Check) {
+			for _, failure := range reply.Failures {
+				assert.NotEmpty(t, failure.Message)
+				checks = append(checks, failure.Check)
+			}
+			return checks
+		}
+
+		// a valid agreement passes every check without being stored
+		reply, err := satellite.VerifyAgreement(ctxSN, rba)
+		require.NoError(t, err)
+		assert.True(t, reply.Valid)
+		assert.Empty(t, reply.Failures)
+
+		exists, err := db.BandwidthAgreement().HasAgreement(ctx, pba.SerialNumber, storageNode)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// another storage node can't submit the agreement
+		reply, err = satellite.VerifyAgreement(ctxOther, rba)
+		require.NoError(t, err)
+		assert.False(t, reply.Valid)
+		assert.Equal(t, []pb.AgreementVerification_Check{pb.AgreementVerification_PEER_ID}, checks(reply))
+
+		// every failed check is reported
+		tampered := *rba
+		tampered.Total = 1000
+		reply, err = satellite.VerifyAgreement(ctxOther, &tampered)
+		require.NoError(t, err)
+		assert.Equal(t, []pb.AgreementVerification_Check{
+			pb.AgreementVerification_PEER_ID,
+			pb.AgreementVerification_RENTER_SIGNATURE,
+		}, checks(reply))
+
+		// a submitted agreement can't be submitted again
+		summary, err := satellite.BandwidthAgreements(ctxSN, rba)
+		require.NoError(t, err)
+		require.Equal(t, pb.AgreementsSummary_OK, summary.Status)
+
+		reply, err = satellite.VerifyAgreement(ctxSN, rba)
+		require.NoError(t, err)
+		assert.False(t, reply.Valid)
+		assert.Equal(t, []pb.AgreementVerification_Check{pb.AgreementVerification_SERIAL_NUMBER}, checks(reply))
+	})
+}
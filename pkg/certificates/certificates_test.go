@@ -624,7 +624,7 @@ func TestCertificateSigner_Sign_E2E(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, opts)
 
-	service, err := server.New(opts, listener, nil, config)
+	service, err := server.New(opts, listener, nil, nil, config)
 	require.NoError(t, err)
 	require.NotNil(t, service)
 
@@ -5,6 +5,7 @@ package certificates
 
 import (
 	"context"
+	"net"
 	"os"
 
 	"github.com/zeebo/errs"
@@ -31,6 +32,7 @@ type CertServerConfig struct {
 	AuthorizationDBURL string `default:"bolt://$CONFDIR/authorizations.db" help:"url to the certificate signing authorization database"`
 	MinDifficulty      uint   `default:"30" help:"minimum difficulty of the requester's identity required to claim an authorization"`
 	CA                 identity.FullCAConfig
+	Portal             PortalConfig
 }
 
 // Sign submits a certificate signing request given the config
@@ -117,6 +119,19 @@ func (c CertServerConfig) Run(ctx context.Context, srv *server.Server) (err erro
 
 	ctx, cancel := context.WithCancel(ctx)
 	var group errgroup.Group
+	if c.Portal.Address != "" {
+		listener, err := net.Listen("tcp", c.Portal.Address)
+		if err != nil {
+			cancel()
+			return err
+		}
+		portal := NewPortal(zap.L().Named("portal"), authDB, listener, c.Portal)
+		certSrv.log.Info("Onboarding portal running", zap.String("address", listener.Addr().String()))
+		group.Go(func() error {
+			defer cancel()
+			return portal.Run(ctx)
+		})
+	}
 	group.Go(func() error {
 		defer cancel()
 		<-ctx.Done()
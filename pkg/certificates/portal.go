@@ -0,0 +1,230 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package certificates
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxPortalRequestSize is the largest request body, which is read
+const maxPortalRequestSize = 4 * 1024
+
+// ErrTokenLimit is used when an email has the maximum number of authorization tokens
+var ErrTokenLimit = errs.Class("authorization token limit error")
+
+// PortalConfig is a config struct for the node onboarding portal, which hands
+// out authorization tokens to node operators
+type PortalConfig struct {
+	Address     string        `help:"address of the node onboarding portal api, empty disables it" default:""`
+	MaxPerEmail int           `help:"maximum number of authorization tokens the portal creates for an email" default:"1"`
+	MaxPerIP    int           `help:"maximum number of token requests per ip address within the ip window" default:"5"`
+	IPWindow    time.Duration `help:"window of the per ip address request limit" default:"24h0m0s"`
+}
+
+// Portal is the http api of the node onboarding portal. Operators request
+// single-use authorization tokens for their emails, the requests are limited
+// per email and per ip address, so farms of nodes can't onboard themselves.
+type Portal struct {
+	log    *zap.Logger
+	authDB *AuthorizationDB
+	config PortalConfig
+
+	// mu serializes the token creation, so the limit per email holds
+	mu      sync.Mutex
+	limiter *windowLimiter
+
+	listener net.Listener
+	server   http.Server
+}
+
+// NewPortal creates a new onboarding portal creating the tokens in authDB
+func NewPortal(log *zap.Logger, authDB *AuthorizationDB, listener net.Listener, config PortalConfig) *Portal {
+	portal := &Portal{
+		log:      log,
+		authDB:   authDB,
+		config:   config,
+		limiter:  newWindowLimiter(config.MaxPerIP, config.IPWindow),
+		listener: listener,
+	}
+	portal.server.Handler = portal
+	return portal
+}
+
+// Run serves the portal until the context is canceled
+func (portal *Portal) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return portal.server.Shutdown(context.Background())
+	})
+	group.Go(func() error {
+		defer cancel()
+		err := portal.server.Serve(portal.listener)
+		if err == http.ErrServerClosed {
+			return nil
+		}
+		return err
+	})
+	return group.Wait()
+}
+
+// Close closes the portal and the underlying listener
+func (portal *Portal) Close() error {
+	return portal.server.Close()
+}
+
+// ServeHTTP implements the onboarding portal api:
+//
+//	POST /v1/authorizations  {"email": "..."}  creates an authorization token for the email
+func (portal *Portal) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case path == "v1/authorizations" && r.Method == http.MethodPost:
+		portal.createToken(w, r)
+	case path == "v1/authorizations":
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// createToken creates an authorization token for the email of the request
+func (portal *Portal) createToken(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Email string `json:"email"`
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPortalRequestSize)).Decode(&request); err != nil {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	email, err := normalizeEmail(request.Email)
+	if err != nil {
+		http.Error(w, "invalid email", http.StatusBadRequest)
+		return
+	}
+
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	if !portal.limiter.Allow(ip, time.Now()) {
+		mon.Meter("portal_ip_limited").Mark(1)
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return
+	}
+
+	token, err := portal.create(email)
+	if err != nil {
+		if ErrTokenLimit.Has(err) {
+			mon.Meter("portal_email_limited").Mark(1)
+			http.Error(w, "no more tokens for this email", http.StatusTooManyRequests)
+			return
+		}
+		portal.log.Error("failed to create authorization token", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	mon.Meter("portal_token_created").Mark(1)
+	portal.log.Info("authorization token created", zap.String("email", email), zap.String("ip", ip))
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(struct {
+		Token string `json:"token"`
+	}{token})
+}
+
+// create creates a token for the email, unless it has the maximum number of tokens
+func (portal *Portal) create(email string) (string, error) {
+	portal.mu.Lock()
+	defer portal.mu.Unlock()
+
+	auths, err := portal.authDB.Get(email)
+	if err != nil {
+		return "", err
+	}
+	if len(auths) >= portal.config.MaxPerEmail {
+		return "", ErrTokenLimit.New("%s has %d tokens", email, len(auths))
+	}
+
+	created, err := portal.authDB.Create(email, 1)
+	if err != nil {
+		return "", err
+	}
+	return created[0].Token.String(), nil
+}
+
+// normalizeEmail validates a plain email address and returns it in lower case,
+// so the limit per email can't be avoided by changing the case
+func normalizeEmail(email string) (string, error) {
+	address, err := mail.ParseAddress(email)
+	if err != nil {
+		return "", err
+	}
+	if address.Address != strings.TrimSpace(email) {
+		return "", errs.New("email with display name")
+	}
+	return strings.ToLower(address.Address), nil
+}
+
+// windowLimiter limits the number of requests of every key within a sliding window
+type windowLimiter struct {
+	max    int
+	window time.Duration
+
+	mu       sync.Mutex
+	requests map[string][]time.Time
+}
+
+// newWindowLimiter creates a limiter allowing max requests per key within window,
+// max below one disables the limit
+func newWindowLimiter(max int, window time.Duration) *windowLimiter {
+	return &windowLimiter{
+		max:      max,
+		window:   window,
+		requests: map[string][]time.Time{},
+	}
+}
+
+// Allow records a request of key at now and returns whether it's allowed
+func (limiter *windowLimiter) Allow(key string, now time.Time) bool {
+	if limiter.max < 1 {
+		return true
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	// the keys without recent requests are removed, so the map doesn't grow unbounded
+	for other, times := range limiter.requests {
+		if !now.Before(times[len(times)-1].Add(limiter.window)) {
+			delete(limiter.requests, other)
+		}
+	}
+
+	recent := limiter.requests[key][:0]
+	for _, t := range limiter.requests[key] {
+		if now.Before(t.Add(limiter.window)) {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= limiter.max {
+		limiter.requests[key] = recent
+		return false
+	}
+	limiter.requests[key] = append(recent, now)
+	return true
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package certificates
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+)
+
+func TestPortal(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	authDB, err := newTestAuthDB(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(authDB.Close)
+
+	portal := NewPortal(zaptest.NewLogger(t), authDB, nil, PortalConfig{MaxPerEmail: 1, MaxPerIP: 2, IPWindow: time.Hour})
+
+	request := func(body, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/authorizations", strings.NewReader(body))
+		req.RemoteAddr = ip + ":1234"
+		recorder := httptest.NewRecorder()
+		portal.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	response := request(`{"email": "Operator <operator@example.com>"}`, "10.0.0.1")
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = request(`{"email": "Operator@Example.com"}`, "10.0.0.1")
+	require.Equal(t, http.StatusOK, response.Code)
+	var created struct {
+		Token string `json:"token"`
+	}
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&created))
+
+	token, err := ParseToken(created.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "operator@example.com", token.UserID)
+
+	auths, err := authDB.Get("operator@example.com")
+	require.NoError(t, err)
+	require.Len(t, auths, 1)
+	assert.True(t, auths[0].Token.Equal(token))
+
+	// the email has its token already
+	response = request(`{"email": "operator@example.com"}`, "10.0.0.2")
+	assert.Equal(t, http.StatusTooManyRequests, response.Code)
+
+	// the ip address has used up its requests
+	response = request(`{"email": "other@example.com"}`, "10.0.0.1")
+	assert.Equal(t, http.StatusOK, response.Code)
+	response = request(`{"email": "another@example.com"}`, "10.0.0.1")
+	assert.Equal(t, http.StatusTooManyRequests, response.Code)
+
+	response = request(`{"email": "another@example.com"}`, "10.0.0.3")
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+func TestWindowLimiter(t *testing.T) {
+	limiter := newWindowLimiter(2, time.Minute)
+	now := time.Now()
+
+	assert.True(t, limiter.Allow("a", now))
+	assert.True(t, limiter.Allow("a", now.Add(time.Second)))
+	assert.False(t, limiter.Allow("a", now.Add(2*time.Second)))
+	assert.True(t, limiter.Allow("b", now.Add(2*time.Second)))
+
+	// the first request left the window
+	assert.True(t, limiter.Allow("a", now.Add(time.Minute)))
+	assert.False(t, limiter.Allow("a", now.Add(time.Minute)))
+
+	assert.True(t, newWindowLimiter(0, time.Minute).Allow("a", now))
+}
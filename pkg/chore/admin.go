@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package chore
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// ServeHTTP implements the chore admin api:
+//
+//	GET  /chores                  lists all chores with their status
+//	POST /chores/<name>/pause     pauses a chore
+//	POST /chores/<name>/resume    resumes a paused chore
+//	POST /chores/<name>/trigger   runs a chore as soon as possible
+func (group *Group) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	if path == "chores" {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(group.Status())
+		return
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[0] != "chores" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	chore := group.Get(parts[1])
+	if chore == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch parts[2] {
+	case "pause":
+		chore.Pause()
+	case "resume":
+		chore.Resume()
+	case "trigger":
+		chore.Trigger()
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(chore.Status())
+}
@@ -0,0 +1,245 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package chore
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is the default error class for chores
+	Error = errs.Class("chore error")
+)
+
+// Config contains the settings shared by all chores
+type Config struct {
+	Jitter      time.Duration `help:"maximum random delay added to every chore interval" default:"5s"`
+	MaxBackoff  time.Duration `help:"maximum delay between runs of a failing chore" default:"30m"`
+	MaxFailures int           `help:"consecutive failures after which a chore is reported unhealthy by the readiness probe, 0 never reports chores unhealthy" default:"3"`
+}
+
+// Chore runs a function periodically.
+//
+// Runs of a chore never overlap. When a run fails, the interval is doubled
+// for every consecutive failure, up to the maximum backoff.
+type Chore struct {
+	Name string
+
+	log      *zap.Logger
+	interval time.Duration
+	fn       func(ctx context.Context) error
+	wake     chan struct{}
+
+	mu         sync.Mutex
+	jitter     time.Duration
+	maxBackoff time.Duration
+	paused     bool
+	triggered  bool
+	running    bool
+	failures   int
+	lastRun    time.Time
+	lastError  error
+}
+
+// Status describes the current state of a chore
+type Status struct {
+	Name      string        `json:"name"`
+	Interval  time.Duration `json:"interval"`
+	Paused    bool          `json:"paused"`
+	Running   bool          `json:"running"`
+	Failures  int           `json:"failures"`
+	LastRun   time.Time     `json:"last_run"`
+	LastError string        `json:"last_error,omitempty"`
+}
+
+// New creates a chore which runs fn every interval
+func New(log *zap.Logger, name string, interval time.Duration, fn func(ctx context.Context) error) *Chore {
+	return &Chore{
+		Name:     name,
+		log:      log,
+		interval: interval,
+		fn:       fn,
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Configure sets the jitter and maximum backoff of the chore
+func (chore *Chore) Configure(config Config) {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+	chore.jitter = config.Jitter
+	chore.maxBackoff = config.MaxBackoff
+}
+
+// Run runs the chore until the context is canceled
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the first run only waits for the jitter
+	timer := time.NewTimer(chore.randomJitter())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-chore.wake:
+			if !chore.takeTrigger() {
+				// paused or resumed, restart waiting for the next run
+				stopTimer(timer)
+				if !chore.isPaused() {
+					timer.Reset(chore.delay())
+				}
+				continue
+			}
+		case <-timer.C:
+			if chore.isPaused() {
+				continue
+			}
+		}
+
+		chore.runOnce(ctx)
+
+		stopTimer(timer)
+		if !chore.isPaused() {
+			timer.Reset(chore.delay())
+		}
+	}
+}
+
+// Pause stops the chore from running until it's resumed
+func (chore *Chore) Pause() {
+	chore.mu.Lock()
+	chore.paused = true
+	chore.mu.Unlock()
+	chore.notify()
+}
+
+// Resume continues running a paused chore, starting a new interval
+func (chore *Chore) Resume() {
+	chore.mu.Lock()
+	chore.paused = false
+	chore.mu.Unlock()
+	chore.notify()
+}
+
+// Trigger runs the chore as soon as the current run has finished,
+// even when the chore is paused
+func (chore *Chore) Trigger() {
+	chore.mu.Lock()
+	chore.triggered = true
+	chore.mu.Unlock()
+	chore.notify()
+}
+
+// Status returns the current state of the chore
+func (chore *Chore) Status() Status {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+
+	status := Status{
+		Name:     chore.Name,
+		Interval: chore.interval,
+		Paused:   chore.paused,
+		Running:  chore.running,
+		Failures: chore.failures,
+		LastRun:  chore.lastRun,
+	}
+	if chore.lastError != nil {
+		status.LastError = chore.lastError.Error()
+	}
+	return status
+}
+
+func (chore *Chore) runOnce(ctx context.Context) {
+	chore.mu.Lock()
+	chore.running = true
+	chore.mu.Unlock()
+
+	err := chore.fn(ctx)
+
+	chore.mu.Lock()
+	chore.running = false
+	chore.lastRun = time.Now()
+	chore.lastError = err
+	if err != nil {
+		chore.failures++
+	} else {
+		chore.failures = 0
+	}
+	failures := chore.failures
+	chore.mu.Unlock()
+
+	if err != nil {
+		mon.Meter("chore_failures").Mark(1)
+		chore.log.Error("chore failed", zap.String("chore", chore.Name), zap.Int("failures", failures), zap.Error(err))
+	}
+}
+
+// notify wakes up the run loop, without blocking when it's busy
+func (chore *Chore) notify() {
+	select {
+	case chore.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (chore *Chore) takeTrigger() bool {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+	triggered := chore.triggered
+	chore.triggered = false
+	return triggered
+}
+
+func (chore *Chore) isPaused() bool {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+	return chore.paused
+}
+
+// delay returns how long to wait before the next run
+func (chore *Chore) delay() time.Duration {
+	chore.mu.Lock()
+	failures, maxBackoff := chore.failures, chore.maxBackoff
+	chore.mu.Unlock()
+
+	delay := chore.interval
+	for i := 0; i < failures && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff && maxBackoff >= chore.interval {
+		delay = maxBackoff
+	}
+	return delay + chore.randomJitter()
+}
+
+func (chore *Chore) randomJitter() time.Duration {
+	chore.mu.Lock()
+	jitter := chore.jitter
+	chore.mu.Unlock()
+
+	if jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(jitter)))
+}
+
+// stopTimer stops the timer and drains a pending tick
+func stopTimer(timer *time.Timer) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package chore_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/chore"
+)
+
+func TestChoreTrigger(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	runs := make(chan struct{})
+	fail := true
+	c := chore.New(zap.NewNop(), "test", time.Hour, func(ctx context.Context) error {
+		runs <- struct{}{}
+		if fail {
+			return errors.New("failure")
+		}
+		return nil
+	})
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx.Go(func() error {
+		_ = c.Run(runCtx)
+		return nil
+	})
+
+	// the first run starts immediately
+	<-runs
+	waitFor(t, func() bool { return !c.Status().Running })
+	assert.Equal(t, 1, c.Status().Failures)
+	assert.Equal(t, "failure", c.Status().LastError)
+
+	// triggering runs even a paused chore
+	fail = false
+	c.Pause()
+	c.Trigger()
+	<-runs
+	waitFor(t, func() bool { return !c.Status().Running })
+
+	status := c.Status()
+	assert.True(t, status.Paused)
+	assert.Equal(t, 0, status.Failures)
+	assert.Empty(t, status.LastError)
+
+	c.Resume()
+	assert.False(t, c.Status().Paused)
+}
+
+func TestGroupAdmin(t *testing.T) {
+	group := chore.NewGroup(chore.Config{})
+	c := chore.New(zap.NewNop(), "tally", time.Hour, func(ctx context.Context) error { return nil })
+	group.Add(c)
+
+	server := httptest.NewServer(group)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/chores")
+	require.NoError(t, err)
+	var statuses []chore.Status
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&statuses))
+	require.NoError(t, resp.Body.Close())
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "tally", statuses[0].Name)
+
+	resp, err = http.Post(server.URL+"/chores/tally/pause", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.True(t, c.Status().Paused)
+
+	resp, err = http.Post(server.URL+"/chores/unknown/pause", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestGroupCheck(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	group := chore.NewGroup(chore.Config{MaxFailures: 2, MaxBackoff: time.Hour})
+	runs := make(chan struct{})
+	c := chore.New(zap.NewNop(), "rollup", time.Hour, func(ctx context.Context) error {
+		runs <- struct{}{}
+		return errors.New("database unreachable")
+	})
+	group.Add(c)
+	require.NoError(t, group.Check(ctx))
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx.Go(func() error {
+		_ = c.Run(runCtx)
+		return nil
+	})
+
+	// a single failure isn't reported
+	<-runs
+	waitFor(t, func() bool { return !c.Status().Running })
+	require.NoError(t, group.Check(ctx))
+
+	c.Trigger()
+	<-runs
+	waitFor(t, func() bool { return !c.Status().Running })
+	err := group.Check(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rollup: database unreachable")
+
+	// paused chores aren't reported
+	c.Pause()
+	require.NoError(t, group.Check(ctx))
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	for i := 0; i < 100; i++ {
+		if condition() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("condition not reached")
+}
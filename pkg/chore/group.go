@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package chore
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Group keeps track of the chores of a process and provides the admin api
+// for controlling them.
+type Group struct {
+	config Config
+
+	mu     sync.Mutex
+	chores []*Chore
+}
+
+// NewGroup creates a group which applies config to all of its chores
+func NewGroup(config Config) *Group {
+	return &Group{config: config}
+}
+
+// Add adds chores to the group
+func (group *Group) Add(chores ...*Chore) {
+	group.mu.Lock()
+	defer group.mu.Unlock()
+
+	for _, chore := range chores {
+		chore.Configure(group.config)
+		group.chores = append(group.chores, chore)
+	}
+}
+
+// Get returns the chore with the specified name or nil when it doesn't exist
+func (group *Group) Get(name string) *Chore {
+	group.mu.Lock()
+	defer group.mu.Unlock()
+
+	for _, chore := range group.chores {
+		if chore.Name == name {
+			return chore
+		}
+	}
+	return nil
+}
+
+// Status returns the status of all chores
+func (group *Group) Status() []Status {
+	group.mu.Lock()
+	defer group.mu.Unlock()
+
+	statuses := make([]Status, 0, len(group.chores))
+	for _, chore := range group.chores {
+		statuses = append(statuses, chore.Status())
+	}
+	return statuses
+}
+
+// Check returns an error listing the chores, which failed at least the
+// configured maximum number of times in a row. Paused chores don't run, so
+// they aren't reported.
+func (group *Group) Check(ctx context.Context) error {
+	if group.config.MaxFailures <= 0 {
+		return nil
+	}
+
+	var failing []string
+	for _, status := range group.Status() {
+		if !status.Paused && status.Failures >= group.config.MaxFailures {
+			failing = append(failing, status.Name+": "+status.LastError)
+		}
+	}
+	if len(failing) == 0 {
+		return nil
+	}
+
+	sort.Strings(failing)
+	return Error.New("failing chores: %s", strings.Join(failing, "; "))
+}
@@ -12,8 +12,10 @@ import (
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/chore"
 	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/datarepair/slo"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/statdb"
@@ -38,6 +40,7 @@ type Checker interface {
 	Run(ctx context.Context) error
 	IdentifyInjuredSegments(ctx context.Context) (err error)
 	OfflineNodes(ctx context.Context, nodeIDs storj.NodeIDList) (offline []int32, err error)
+	Chore() *chore.Chore
 	Close() error
 }
 
@@ -50,13 +53,15 @@ type checker struct {
 	irrdb       irreparable.DB
 	limit       int
 	logger      *zap.Logger
-	ticker      *time.Ticker
+	chore       *chore.Chore
+	repairSLO   *slo.Service
 }
 
-// NewChecker creates a new instance of checker
-func NewChecker(pointerdb *pointerdb.Service, sdb statdb.DB, repairQueue queue.RepairQueue, overlay pb.OverlayServer, irrdb irreparable.DB, limit int, logger *zap.Logger, interval time.Duration) Checker {
+// NewChecker creates a new instance of checker, the segments found injured
+// or irreparable are recorded in repairSLO, when it isn't nil
+func NewChecker(pointerdb *pointerdb.Service, sdb statdb.DB, repairQueue queue.RepairQueue, overlay pb.OverlayServer, irrdb irreparable.DB, limit int, logger *zap.Logger, interval time.Duration, repairSLO *slo.Service) Checker {
 	// TODO: reorder arguments
-	return &checker{
+	c := &checker{
 		statdb:      sdb,
 		pointerdb:   pointerdb,
 		repairQueue: repairQueue,
@@ -64,28 +69,21 @@ func NewChecker(pointerdb *pointerdb.Service, sdb statdb.DB, repairQueue queue.R
 		irrdb:       irrdb,
 		limit:       limit,
 		logger:      logger,
-		ticker:      time.NewTicker(interval),
+		repairSLO:   repairSLO,
 	}
+	c.chore = chore.New(logger, "checker", interval, c.IdentifyInjuredSegments)
+	return c
 }
 
 // Run the checker loop
 func (c *checker) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
-
-	for {
-		err = c.IdentifyInjuredSegments(ctx)
-		if err != nil {
-			c.logger.Error("Checker failed", zap.Error(err))
-		}
-
-		select {
-		case <-c.ticker.C: // wait for the next interval to happen
-		case <-ctx.Done(): // or the checker is canceled via context
-			return ctx.Err()
-		}
-	}
+	return c.chore.Run(ctx)
 }
 
+// Chore returns the chore which runs the checker
+func (c *checker) Chore() *chore.Chore { return c.chore }
+
 // Close closes resources
 func (c *checker) Close() error { return nil }
 
@@ -137,6 +135,16 @@ func (c *checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
 
 				missingPieces := combineOfflineWithInvalid(offlineNodes, invalidNodes)
 
+				// pieces stored on a node which already holds a piece of the segment
+				// don't add any redundancy, so they have to be repaired as well
+				duplicates := pointerdb.DuplicatePieces(pieces)
+				if len(duplicates) > 0 {
+					mon.Meter("duplicate_piece_placements").Mark(len(duplicates))
+					c.logger.Warn("segment has multiple pieces on the same node",
+						zap.String("path", string(item.Key)), zap.Int("duplicates", len(duplicates)))
+					missingPieces = combineOfflineWithInvalid(missingPieces, duplicates)
+				}
+
 				numHealthy := len(nodeIDs) - len(missingPieces)
 				if (int32(numHealthy) >= pointer.Remote.Redundancy.MinReq) && (int32(numHealthy) < pointer.Remote.Redundancy.RepairThreshold) {
 					err = c.repairQueue.Enqueue(ctx, &pb.InjuredSegment{
@@ -146,6 +154,7 @@ func (c *checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
 					if err != nil {
 						return Error.New("error adding injured segment to queue %s", err)
 					}
+					c.repairSLO.Record(ctx, slo.Counts{Injured: 1})
 				} else if int32(numHealthy) < pointer.Remote.Redundancy.MinReq {
 					// make an entry in to the irreparable table
 					segmentInfo := &irreparable.RemoteSegmentInfo{
@@ -161,6 +170,7 @@ func (c *checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
 					if err != nil {
 						return Error.New("error handling irreparable segment to queue %s", err)
 					}
+					c.repairSLO.Record(ctx, slo.Counts{Irreparable: 1})
 				}
 			}
 			return nil
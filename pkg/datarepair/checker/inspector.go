@@ -0,0 +1,136 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"context"
+	"fmt"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/storj"
+)
+
+// Inspector is a gRPC service for inspecting the health of segments
+type Inspector struct {
+	pointerdb *pointerdb.Service
+	cache     *overlay.Cache
+	statdb    statdb.DB
+	criteria  *overlay.NodeSelectionConfig
+}
+
+// NewInspector creates an Inspector, nodes which don't match the reputation
+// requirements of criteria are reported as suspended
+func NewInspector(pointerdb *pointerdb.Service, cache *overlay.Cache, sdb statdb.DB, criteria *overlay.NodeSelectionConfig) *Inspector {
+	return &Inspector{
+		pointerdb: pointerdb,
+		cache:     cache,
+		statdb:    sdb,
+		criteria:  criteria,
+	}
+}
+
+// SegmentHealth returns the number of healthy pieces of a segment, the pieces
+// which are at risk and the estimated durability of the segment
+func (srv *Inspector) SegmentHealth(ctx context.Context, req *pb.SegmentHealthRequest) (_ *pb.SegmentHealthResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segment := "l"
+	if req.SegmentIndex >= 0 {
+		segment = fmt.Sprintf("s%d", req.SegmentIndex)
+	}
+	path := storj.JoinPaths(req.ProjectId, segment, req.Bucket, string(req.EncryptedPath))
+
+	pointer, err := srv.pointerdb.Get(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	remote := pointer.GetRemote()
+	if remote == nil {
+		return nil, Error.New("segment %q is inline", path)
+	}
+
+	pieces := remote.GetRemotePieces()
+	resp := &pb.SegmentHealthResponse{
+		MinReq:           remote.Redundancy.GetMinReq(),
+		RepairThreshold:  remote.Redundancy.GetRepairThreshold(),
+		SuccessThreshold: remote.Redundancy.GetSuccessThreshold(),
+		TotalPieces:      int32(len(pieces)),
+	}
+	if len(pieces) == 0 {
+		return resp, nil
+	}
+
+	var nodeIDs storj.NodeIDList
+	for _, piece := range pieces {
+		nodeIDs = append(nodeIDs, piece.NodeId)
+	}
+	nodes, err := srv.cache.GetAll(ctx, nodeIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var availability []float64
+	for i, piece := range pieces {
+		health := &pb.PieceHealth{
+			PieceNum: piece.PieceNum,
+			NodeId:   piece.NodeId,
+		}
+
+		// nodes which aren't in the cache anymore may not have stats either
+		if nodes[i] == nil {
+			health.Offline = true
+			resp.AtRiskPieces = append(resp.AtRiskPieces, health)
+			continue
+		}
+
+		stats, err := srv.statdb.Get(ctx, piece.NodeId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		health.Suspended = stats.AuditSuccessRatio < srv.criteria.AuditSuccessRatio ||
+			stats.UptimeRatio < srv.criteria.UptimeRatio
+
+		if health.Suspended {
+			resp.AtRiskPieces = append(resp.AtRiskPieces, health)
+			continue
+		}
+		resp.HealthyPieces++
+		availability = append(availability, stats.UptimeRatio)
+	}
+
+	resp.Durability = Durability(availability, int(resp.MinReq))
+	return resp, nil
+}
+
+// Durability returns the probability that at least minReq pieces are
+// available, when every piece is available independently with the given
+// probability
+func Durability(availability []float64, minReq int) float64 {
+	if minReq <= 0 {
+		return 1
+	}
+	if len(availability) < minReq {
+		return 0
+	}
+
+	// probability[k] is the probability that exactly k pieces are available
+	probability := make([]float64, len(availability)+1)
+	probability[0] = 1
+	for i, p := range availability {
+		for k := i + 1; k > 0; k-- {
+			probability[k] = probability[k]*(1-p) + probability[k-1]*p
+		}
+		probability[0] *= 1 - p
+	}
+
+	var durability float64
+	for _, p := range probability[minReq:] {
+		durability += p
+	}
+	return durability
+}
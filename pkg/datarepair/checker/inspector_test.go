@@ -0,0 +1,93 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/datarepair/checker"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestDurability(t *testing.T) {
+	assert.Equal(t, 1.0, checker.Durability(nil, 0))
+	assert.Equal(t, 0.0, checker.Durability([]float64{1, 1}, 3))
+	assert.InDelta(t, 0.5, checker.Durability([]float64{0.5}, 1), 1e-9)
+	assert.InDelta(t, 0.75, checker.Durability([]float64{0.5, 0.5}, 1), 1e-9)
+	assert.InDelta(t, 0.25, checker.Durability([]float64{0.5, 0.5}, 2), 1e-9)
+	assert.InDelta(t, 1.0, checker.Durability([]float64{1, 1, 0}, 2), 1e-9)
+}
+
+func TestSegmentHealth(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		time.Sleep(2 * time.Second)
+
+		const numberOfNodes = 6
+
+		pieces := make([]*pb.RemotePiece, 0, numberOfNodes)
+		for i, storagenode := range planet.StorageNodes {
+			pieces = append(pieces, &pb.RemotePiece{
+				PieceNum: int32(i),
+				NodeId:   storagenode.ID(),
+			})
+		}
+		// simulate offline nodes
+		for i := len(pieces); i < numberOfNodes; i++ {
+			pieces = append(pieces, &pb.RemotePiece{
+				PieceNum: int32(i),
+				NodeId:   storj.NodeID{byte(i)},
+			})
+		}
+
+		pointer := &pb.Pointer{
+			Remote: &pb.RemoteSegment{
+				Redundancy: &pb.RedundancyScheme{
+					MinReq:           int32(2),
+					RepairThreshold:  int32(3),
+					SuccessThreshold: int32(5),
+				},
+				PieceId:      "fake-piece-id",
+				RemotePieces: pieces,
+			},
+		}
+
+		satellite := planet.Satellites[0]
+		err := satellite.Metainfo.Service.Put("project/l/bucket/encrypted", pointer)
+		require.NoError(t, err)
+
+		health, err := satellite.Repair.Inspector.SegmentHealth(ctx, &pb.SegmentHealthRequest{
+			ProjectId:     "project",
+			Bucket:        "bucket",
+			EncryptedPath: []byte("encrypted"),
+			SegmentIndex:  -1,
+		})
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 2, health.MinReq)
+		assert.EqualValues(t, numberOfNodes, health.TotalPieces)
+		assert.EqualValues(t, len(planet.StorageNodes), health.HealthyPieces)
+		require.Len(t, health.AtRiskPieces, numberOfNodes-len(planet.StorageNodes))
+		for _, piece := range health.AtRiskPieces {
+			assert.True(t, piece.Offline)
+		}
+		assert.True(t, health.Durability >= 0 && health.Durability <= 1)
+
+		_, err = satellite.Repair.Inspector.SegmentHealth(ctx, &pb.SegmentHealthRequest{
+			ProjectId:     "project",
+			Bucket:        "bucket",
+			EncryptedPath: []byte("encrypted"),
+			SegmentIndex:  0,
+		})
+		assert.Error(t, err)
+	})
+}
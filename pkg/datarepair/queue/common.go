@@ -9,3 +9,6 @@ import (
 
 // Error is a standard error class for this package.
 var Error = errs.Class("queue error")
+
+// ErrLeaseLost is returned when a lease has expired and was claimed by another worker.
+var ErrLeaseLost = errs.Class("repair lease lost")
@@ -5,6 +5,7 @@ package queue
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"go.uber.org/zap"
@@ -21,6 +22,21 @@ type RepairQueue interface {
 	Dequeue(ctx context.Context) (pb.InjuredSegment, error)
 	// Peekqueue lists limit amount of injured segments.
 	Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSegment, error)
+	// Claim leases the next injured segment that isn't leased by another worker.
+	Claim(ctx context.Context, ttl time.Duration) (*Lease, error)
+	// Extend keeps a lease alive for another ttl.
+	Extend(ctx context.Context, lease *Lease, ttl time.Duration) error
+	// Complete removes a leased segment after it has been repaired.
+	Complete(ctx context.Context, lease *Lease) error
+}
+
+// Lease is a claim on an injured segment held by a single repair worker.
+// When a lease expires without being completed, the segment can be claimed
+// again by another worker.
+type Lease struct {
+	ID      int64
+	Segment pb.InjuredSegment
+	Expires time.Time
 }
 
 // Queue implements the RepairQueue interface
@@ -84,3 +100,25 @@ func (q *Queue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSegment,
 	}
 	return segs, nil
 }
+
+// Claim dequeues the next repair segment. The underlying storage.Queue
+// doesn't support leases, so segments are removed on claim and are not
+// handed out again when the lease expires.
+func (q *Queue) Claim(ctx context.Context, ttl time.Duration) (*Lease, error) {
+	seg, err := q.Dequeue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Lease{Segment: seg, Expires: time.Now().Add(ttl)}, nil
+}
+
+// Extend extends the lease expiration
+func (q *Queue) Extend(ctx context.Context, lease *Lease, ttl time.Duration) error {
+	lease.Expires = time.Now().Add(ttl)
+	return nil
+}
+
+// Complete is a no-op, since the segment was removed when it was claimed
+func (q *Queue) Complete(ctx context.Context, lease *Lease) error {
+	return nil
+}
@@ -8,8 +8,10 @@ import (
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/datarepair/queue"
@@ -54,6 +56,65 @@ func TestDequeueEmptyQueue(t *testing.T) {
 	})
 }
 
+func TestClaimLease(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		seg := &pb.InjuredSegment{
+			Path:       "abc",
+			LostPieces: []int32{int32(1), int32(3)},
+		}
+		require.NoError(t, q.Enqueue(ctx, seg))
+
+		lease, err := q.Claim(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, pb.Equal(&lease.Segment, seg))
+
+		// leased segments can't be claimed by another worker
+		_, err = q.Claim(ctx, time.Hour)
+		assert.Error(t, err)
+
+		require.NoError(t, q.Extend(ctx, lease, time.Hour))
+		require.NoError(t, q.Complete(ctx, lease))
+
+		_, err = q.Claim(ctx, time.Hour)
+		assert.Error(t, err)
+	})
+}
+
+func TestClaimExpiredLease(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		q := db.RepairQueue()
+
+		seg := &pb.InjuredSegment{
+			Path:       "abc",
+			LostPieces: []int32{int32(1), int32(3)},
+		}
+		require.NoError(t, q.Enqueue(ctx, seg))
+
+		// a lease in the past has already expired
+		expired, err := q.Claim(ctx, -time.Minute)
+		require.NoError(t, err)
+
+		lease, err := q.Claim(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, expired.ID, lease.ID)
+		assert.True(t, pb.Equal(&lease.Segment, seg))
+
+		// the previous holder has lost the segment
+		assert.True(t, queue.ErrLeaseLost.Has(q.Extend(ctx, expired, time.Hour)))
+		assert.True(t, queue.ErrLeaseLost.Has(q.Complete(ctx, expired)))
+
+		require.NoError(t, q.Complete(ctx, lease))
+	})
+}
+
 func TestSequential(t *testing.T) {
 	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
 		ctx := testcontext.New(t)
@@ -11,6 +11,7 @@ import (
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pointerdb/pdbclient"
+	"storj.io/storj/pkg/statdb"
 	ecclient "storj.io/storj/pkg/storage/ec"
 	"storj.io/storj/pkg/storage/segments"
 )
@@ -19,6 +20,7 @@ import (
 type Config struct {
 	MaxRepair     int           `help:"maximum segments that can be repaired concurrently" default:"100"`
 	Interval      time.Duration `help:"how frequently checker should audit segments" default:"3600s"`
+	LeaseDuration time.Duration `help:"how long a claimed segment stays leased to a repair worker without a heartbeat" default:"10m"`
 	OverlayAddr   string        `help:"Address to contact overlay server through"`
 	PointerDBAddr string        `help:"Address to contact pointerdb server through"`
 	MaxBufferMem  memory.Size   `help:"maximum buffer memory (in bytes) to be allocated for read buffers" default:"4M"`
@@ -26,7 +28,7 @@ type Config struct {
 }
 
 // GetSegmentRepairer creates a new segment repairer from storeConfig values
-func (c Config) GetSegmentRepairer(ctx context.Context, identity *identity.FullIdentity) (ss SegmentRepairer, err error) {
+func (c Config) GetSegmentRepairer(ctx context.Context, identity *identity.FullIdentity, sdb statdb.DB) (ss SegmentRepairer, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	var oc overlay.Client
@@ -41,5 +43,5 @@ func (c Config) GetSegmentRepairer(ctx context.Context, identity *identity.FullI
 	}
 
 	ec := ecclient.NewClient(identity, c.MaxBufferMem.Int())
-	return segments.NewSegmentRepairer(oc, ec, pdb), nil
+	return segments.NewSegmentRepairer(oc, ec, pdb, sdb), nil
 }
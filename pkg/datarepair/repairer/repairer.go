@@ -11,7 +11,9 @@ import (
 
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/datarepair/slo"
 	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage"
 )
@@ -24,21 +26,26 @@ type SegmentRepairer interface {
 // Service contains the information needed to run the repair service
 type Service struct {
 	queue    queue.RepairQueue
+	statdb   statdb.DB
 	config   *Config
 	identity *identity.FullIdentity
 	repairer SegmentRepairer
 	limiter  *sync2.Limiter
 	ticker   *time.Ticker
+	slo      *slo.Service
 }
 
-// NewService creates repairing service
-func NewService(queue queue.RepairQueue, config *Config, identity *identity.FullIdentity, interval time.Duration, concurrency int) *Service {
+// NewService creates repairing service, the outcomes of the repairs are
+// recorded in repairSLO, when it isn't nil
+func NewService(queue queue.RepairQueue, sdb statdb.DB, config *Config, identity *identity.FullIdentity, interval time.Duration, concurrency int, repairSLO *slo.Service) *Service {
 	return &Service{
 		queue:    queue,
+		statdb:   sdb,
 		config:   config,
 		identity: identity,
 		limiter:  sync2.NewLimiter(concurrency),
 		ticker:   time.NewTicker(interval),
+		slo:      repairSLO,
 	}
 }
 
@@ -50,7 +57,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	// TODO: close segment repairer, currently this leaks connections
-	service.repairer, err = service.config.GetSegmentRepairer(ctx, service.identity)
+	service.repairer, err = service.config.GetSegmentRepairer(ctx, service.identity, service.statdb)
 	if err != nil {
 		return err
 	}
@@ -72,9 +79,9 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	}
 }
 
-// process picks an item from repair queue and spawns a repair worker
+// process claims an item from repair queue and spawns a repair worker
 func (service *Service) process(ctx context.Context) error {
-	seg, err := service.queue.Dequeue(ctx)
+	lease, err := service.queue.Claim(ctx, service.config.LeaseDuration)
 	if err != nil {
 		if storage.ErrEmptyQueue.Has(err) {
 			return nil
@@ -83,7 +90,7 @@ func (service *Service) process(ctx context.Context) error {
 	}
 
 	service.limiter.Go(ctx, func() {
-		err := service.repairer.Repair(ctx, seg.GetPath(), seg.GetLostPieces())
+		err := service.repair(ctx, lease)
 		if err != nil {
 			zap.L().Error("Repair failed", zap.Error(err))
 		}
@@ -91,3 +98,42 @@ func (service *Service) process(ctx context.Context) error {
 
 	return nil
 }
+
+// repair repairs a leased segment while keeping the lease alive. When the
+// repair fails the lease is left to expire, so the segment is retried later.
+func (service *Service) repair(ctx context.Context, lease *queue.Lease) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	repairCtx, cancel := context.WithCancel(ctx)
+	heartbeat := make(chan struct{})
+	go func() {
+		defer close(heartbeat)
+		ticker := time.NewTicker(service.config.LeaseDuration / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := service.queue.Extend(repairCtx, lease, service.config.LeaseDuration); err != nil {
+					// another worker may hold the segment now, stop repairing it
+					zap.L().Error("Extending repair lease failed", zap.Error(err))
+					cancel()
+					return
+				}
+			case <-repairCtx.Done():
+				return
+			}
+		}
+	}()
+
+	seg := lease.Segment
+	err = service.repairer.Repair(repairCtx, seg.GetPath(), seg.GetLostPieces())
+	cancel()
+	<-heartbeat
+	if err != nil {
+		service.slo.Record(ctx, slo.Counts{Failed: 1})
+		return err
+	}
+	service.slo.Record(ctx, slo.Counts{Repaired: 1})
+
+	return service.queue.Complete(ctx, lease)
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package slo
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultReportDays is the number of days reported, when the request has no days
+	defaultReportDays = 30
+	// maxReportDays is the most days reported at once
+	maxReportDays = 366
+)
+
+// ServeHTTP implements the repair slo admin api:
+//
+//	GET /report?days=<n>   reports the repairs of the last days, the oldest first
+func (service *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.Trim(r.URL.Path, "/") != "report" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	days := defaultReportDays
+	if value := r.URL.Query().Get("days"); value != "" {
+		var err error
+		days, err = strconv.Atoi(value)
+		if err != nil || days <= 0 || days > maxReportDays {
+			http.Error(w, "invalid days", http.StatusBadRequest)
+			return
+		}
+	}
+
+	report, err := service.Report(r.Context(), days)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(report)
+}
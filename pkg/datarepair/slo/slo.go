@@ -0,0 +1,123 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package slo
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Config contains configurable values for the repair slo report
+type Config struct {
+	Target float64 `help:"fraction of the segments entering danger during a day, which should be repaired during the day" default:"0.99"`
+}
+
+// Counts are the outcomes of the repair pipeline
+type Counts struct {
+	// Injured are the segments, which entered danger and were queued for repair
+	Injured int64 `json:"injured"`
+	// Irreparable are the segments, which lost too many pieces to be repaired
+	Irreparable int64 `json:"irreparable"`
+	Repaired    int64 `json:"repaired"`
+	Failed      int64 `json:"failed"`
+}
+
+// DB stores the repair counts by day
+type DB interface {
+	// Increment adds the counts to the day starting at intervalStart
+	Increment(ctx context.Context, intervalStart time.Time, counts Counts) error
+	// List returns the counts of the days since the day starting at since, the oldest first
+	List(ctx context.Context, since time.Time) ([]*Day, error)
+}
+
+// Day is the report of the repairs during a day
+type Day struct {
+	IntervalStart time.Time `json:"interval_start"`
+	Counts
+
+	// RepairRatio is the number of repaired segments per segment entering
+	// danger, it's above 1 when a backlog of earlier days was repaired
+	RepairRatio float64 `json:"repair_ratio"`
+	// Met is whether the repair ratio reached the target
+	Met bool `json:"met"`
+}
+
+// Service records the outcomes of the repair pipeline and reports them by
+// day against the slo target
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+}
+
+// NewService creates a new repair slo service
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log:    log,
+		db:     db,
+		config: config,
+	}
+}
+
+// Record adds the counts to the current day. Repairs don't depend on the
+// report, so failing to record is only logged. Recording with a nil service
+// does nothing.
+func (service *Service) Record(ctx context.Context, counts Counts) {
+	if service == nil {
+		return
+	}
+
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.db.Increment(ctx, startOfDay(time.Now()), counts)
+	if err != nil {
+		service.log.Error("recording repair outcomes failed", zap.Error(err))
+	}
+}
+
+// Report returns the reports of the last days including the current one,
+// the oldest first. Days without any repairs have empty counts.
+func (service *Service) Report(ctx context.Context, days int) (_ []*Day, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if days <= 0 {
+		return nil, Error.New("invalid number of days %d", days)
+	}
+
+	today := startOfDay(time.Now())
+	since := today.AddDate(0, 0, 1-days)
+
+	stored, err := service.db.List(ctx, since)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	byDay := make(map[int64]*Day, len(stored))
+	for _, day := range stored {
+		byDay[day.IntervalStart.Unix()] = day
+	}
+
+	report := make([]*Day, 0, days)
+	for start := since; !start.After(today); start = start.AddDate(0, 0, 1) {
+		day, ok := byDay[start.Unix()]
+		if !ok {
+			day = &Day{IntervalStart: start}
+		}
+
+		day.RepairRatio = 1
+		if day.Injured > 0 {
+			day.RepairRatio = float64(day.Repaired) / float64(day.Injured)
+		}
+		day.Met = day.RepairRatio >= service.config.Target
+		report = append(report, day)
+	}
+	return report, nil
+}
+
+// startOfDay returns the start of the day of t in UTC
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package slo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/datarepair/slo"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestReport(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		service := slo.NewService(zap.NewNop(), db.RepairSLO(), slo.Config{Target: 0.9})
+
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		yesterday := today.AddDate(0, 0, -1)
+
+		// a week ago is outside of the report
+		require.NoError(t, db.RepairSLO().Increment(ctx, today.AddDate(0, 0, -7), slo.Counts{Injured: 100}))
+		require.NoError(t, db.RepairSLO().Increment(ctx, yesterday, slo.Counts{Injured: 10, Repaired: 5}))
+		require.NoError(t, db.RepairSLO().Increment(ctx, yesterday, slo.Counts{Repaired: 3, Failed: 2, Irreparable: 1}))
+
+		service.Record(ctx, slo.Counts{Injured: 1})
+		service.Record(ctx, slo.Counts{Injured: 1})
+		service.Record(ctx, slo.Counts{Repaired: 1})
+		service.Record(ctx, slo.Counts{Repaired: 1})
+
+		// recording without a service does nothing
+		var disabled *slo.Service
+		disabled.Record(ctx, slo.Counts{Injured: 1})
+
+		report, err := service.Report(ctx, 3)
+		require.NoError(t, err)
+		require.Len(t, report, 3)
+
+		// days without repairs met the target
+		assert.True(t, report[0].IntervalStart.Equal(yesterday.AddDate(0, 0, -1)))
+		assert.Equal(t, slo.Counts{}, report[0].Counts)
+		assert.Equal(t, 1.0, report[0].RepairRatio)
+		assert.True(t, report[0].Met)
+
+		assert.True(t, report[1].IntervalStart.Equal(yesterday))
+		assert.Equal(t, slo.Counts{Injured: 10, Irreparable: 1, Repaired: 8, Failed: 2}, report[1].Counts)
+		assert.Equal(t, 0.8, report[1].RepairRatio)
+		assert.False(t, report[1].Met)
+
+		assert.True(t, report[2].IntervalStart.Equal(today))
+		assert.Equal(t, slo.Counts{Injured: 2, Repaired: 2}, report[2].Counts)
+		assert.True(t, report[2].Met)
+
+		_, err = service.Report(ctx, 0)
+		assert.Error(t, err)
+	})
+}
@@ -10,11 +10,15 @@ import (
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
+	"storj.io/storj/pkg/chore"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
 )
 
 var (
@@ -30,42 +34,67 @@ type Config struct {
 	GraveyardInterval time.Duration `help:"the interval at which the the graveyard tries to resurrect nodes" default:"30s"`
 	DiscoveryInterval time.Duration `help:"the interval at which the satellite attempts to find new nodes via random node ID lookups" default:"1s"`
 	RefreshLimit      int           `help:"the amount of nodes refreshed at each interval" default:"100"`
+	AuditLiveness     bool          `help:"infer node liveness from audits and bandwidth agreements instead of pinging nodes" default:"false"`
+
+	ThroughputInterval time.Duration `help:"the interval at which the satellite collects the throughput reported by storage nodes" default:"1h"`
+	ThroughputLimit    int           `help:"the amount of nodes queried for their throughput at each interval" default:"100"`
 }
 
 // Discovery struct loads on cache, kad, and statdb
 type Discovery struct {
-	log    *zap.Logger
-	cache  *overlay.Cache
-	kad    *kademlia.Kademlia
-	statdb statdb.DB
-	config Config
+	log       *zap.Logger
+	cache     *overlay.Cache
+	kad       *kademlia.Kademlia
+	transport transport.Client
+	statdb    statdb.DB
+	config    Config
 
 	// refreshOffset tracks the offset of the current refresh cycle
 	refreshOffset int64
+	// throughputOffset tracks the offset of the current throughput cycle
+	throughputOffset int64
+
+	Refresh    *chore.Chore
+	Graveyard  *chore.Chore
+	Discover   *chore.Chore
+	Throughput *chore.Chore
 }
 
 // New returns a new discovery service.
-func New(logger *zap.Logger, ol *overlay.Cache, kad *kademlia.Kademlia, stat statdb.DB, config Config) *Discovery {
-	return &Discovery{
-		log:    logger,
-		cache:  ol,
-		kad:    kad,
-		statdb: stat,
-		config: config,
+func New(logger *zap.Logger, ol *overlay.Cache, kad *kademlia.Kademlia, tc transport.Client, stat statdb.DB, config Config) *Discovery {
+	discovery := &Discovery{
+		log:       logger,
+		cache:     ol,
+		kad:       kad,
+		transport: tc,
+		statdb:    stat,
+		config:    config,
 
 		refreshOffset: 0,
 	}
+	discovery.initChores()
+	return discovery
 }
 
 // NewDiscovery Returns a new Discovery instance with cache, kad, and statdb loaded on
-func NewDiscovery(logger *zap.Logger, ol *overlay.Cache, kad *kademlia.Kademlia, stat statdb.DB, config Config) *Discovery {
-	return &Discovery{
-		log:    logger,
-		cache:  ol,
-		kad:    kad,
-		statdb: stat,
-		config: config,
+func NewDiscovery(logger *zap.Logger, ol *overlay.Cache, kad *kademlia.Kademlia, tc transport.Client, stat statdb.DB, config Config) *Discovery {
+	discovery := &Discovery{
+		log:       logger,
+		cache:     ol,
+		kad:       kad,
+		transport: tc,
+		statdb:    stat,
+		config:    config,
 	}
+	discovery.initChores()
+	return discovery
+}
+
+func (discovery *Discovery) initChores() {
+	discovery.Refresh = chore.New(discovery.log, "discovery:refresh", discovery.config.RefreshInterval, discovery.refresh)
+	discovery.Graveyard = chore.New(discovery.log, "discovery:graveyard", discovery.config.GraveyardInterval, discovery.searchGraveyard)
+	discovery.Discover = chore.New(discovery.log, "discovery:discover", discovery.config.DiscoveryInterval, discovery.discover)
+	discovery.Throughput = chore.New(discovery.log, "discovery:throughput", discovery.config.ThroughputInterval, discovery.collectThroughput)
 }
 
 // Close closes resources
@@ -73,45 +102,33 @@ func (discovery *Discovery) Close() error { return nil }
 
 // Run runs the discovery service
 func (discovery *Discovery) Run(ctx context.Context) error {
-	refresh := time.NewTicker(discovery.config.RefreshInterval)
-	graveyard := time.NewTicker(discovery.config.GraveyardInterval)
-	discover := time.NewTicker(discovery.config.DiscoveryInterval)
-	defer refresh.Stop()
-	defer graveyard.Stop()
-	defer discover.Stop()
-
-	for {
-		select {
-		case <-refresh.C:
-			err := discovery.refresh(ctx)
-			if err != nil {
-				discovery.log.Error("error with cache refresh: ", zap.Error(err))
-			}
-		case <-discover.C:
-			err := discovery.discover(ctx)
-			if err != nil {
-				discovery.log.Error("error with cache discovery: ", zap.Error(err))
-			}
-		case <-graveyard.C:
-			err := discovery.searchGraveyard(ctx)
-			if err != nil {
-				discovery.log.Error("graveyard resurrection failed: ", zap.Error(err))
-			}
-		case <-ctx.Done():
-			return ctx.Err()
-		}
+	group, ctx := errgroup.WithContext(ctx)
+	group.Go(func() error { return discovery.Refresh.Run(ctx) })
+	group.Go(func() error { return discovery.Graveyard.Run(ctx) })
+	group.Go(func() error { return discovery.Discover.Run(ctx) })
+	if discovery.transport != nil && discovery.config.ThroughputInterval > 0 {
+		group.Go(func() error { return discovery.Throughput.Run(ctx) })
 	}
+	return group.Wait()
 }
 
 // refresh updates the cache db with the current DHT.
 // We currently do not penalize nodes that are unresponsive,
 // but should in the future.
 func (discovery *Discovery) refresh(ctx context.Context) error {
-	nodes := discovery.kad.Seen()
-	for _, v := range nodes {
-		if err := discovery.cache.Put(ctx, v.Id, *v); err != nil {
-			return err
-		}
+	seen := discovery.kad.Seen()
+	nodes := make([]pb.Node, 0, len(seen))
+	for _, v := range seen {
+		nodes = append(nodes, *v)
+	}
+	if failed, err := discovery.cache.PutAll(ctx, nodes); err != nil {
+		discovery.log.Error("could not update cache", zap.Int("failed", len(failed)), zap.Error(err))
+		return Error.Wrap(err)
+	}
+
+	// uptime is recorded by audits and bandwidth agreements instead
+	if discovery.config.AuditLiveness {
+		return nil
 	}
 
 	list, more, err := discovery.cache.Paginate(ctx, discovery.refreshOffset, discovery.config.RefreshLimit)
@@ -162,10 +179,87 @@ func (discovery *Discovery) refresh(ctx context.Context) error {
 	return nil
 }
 
+// collectThroughput asks a page of nodes for their recent throughput and
+// stores it in the overlay cache
+func (discovery *Discovery) collectThroughput(ctx context.Context) (err error) {
+	list, more, err := discovery.cache.Paginate(ctx, discovery.throughputOffset, discovery.config.ThroughputLimit)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if more == false {
+		discovery.throughputOffset = 0
+	} else {
+		discovery.throughputOffset += int64(len(list))
+	}
+
+	for _, node := range list {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if node.Type != pb.NodeType_STORAGE {
+			continue
+		}
+
+		throughput, err := discovery.queryThroughput(ctx, node)
+		if err != nil {
+			discovery.log.Debug("could not query node throughput", zap.String("ID", node.Id.String()), zap.Error(err))
+			continue
+		}
+
+		err = discovery.cache.UpdateThroughput(ctx, node.Id, throughput)
+		if err != nil {
+			discovery.log.Error("could not update node throughput", zap.String("ID", node.Id.String()), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// queryThroughput retrieves the throughput reported by the node
+func (discovery *Discovery) queryThroughput(ctx context.Context, node *pb.Node) (_ *pb.NodeThroughput, err error) {
+	conn, err := discovery.transport.DialNode(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	summary, err := pb.NewPieceStoreRoutesClient(conn).Throughput(ctx, &pb.ThroughputReq{})
+	if err != nil {
+		return nil, err
+	}
+	return convertThroughput(summary), nil
+}
+
+// convertThroughput converts the transfers reported by a node to rates,
+// a ratio is -1 when the node hasn't had any transfers of that kind
+func convertThroughput(summary *pb.ThroughputSummary) *pb.NodeThroughput {
+	throughput := &pb.NodeThroughput{
+		UploadSuccessRatio:   -1,
+		DownloadSuccessRatio: -1,
+	}
+	if summary.WindowSeconds > 0 {
+		throughput.IngressRate = summary.IngressBytes / summary.WindowSeconds
+		throughput.EgressRate = summary.EgressBytes / summary.WindowSeconds
+	}
+	if summary.Uploads > 0 {
+		throughput.UploadSuccessRatio = float64(summary.Uploads-summary.FailedUploads) / float64(summary.Uploads)
+	}
+	if summary.Downloads > 0 {
+		throughput.DownloadSuccessRatio = float64(summary.Downloads-summary.FailedDownloads) / float64(summary.Downloads)
+	}
+	return throughput
+}
+
 // graveyard attempts to ping all nodes in the Seen() map from Kademlia and adds them to the cache
 // if they respond. This is an attempt to resurrect nodes that may have gone offline in the last hour
 // and were removed from the cache due to an unsuccessful response.
 func (discovery *Discovery) searchGraveyard(ctx context.Context) error {
+	// nodes aren't removed from the cache without pings, so there's nothing to resurrect
+	if discovery.config.AuditLiveness {
+		return nil
+	}
+
 	seen := discovery.kad.Seen()
 
 	var errors errs.Group
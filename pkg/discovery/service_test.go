@@ -8,9 +8,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/satellite"
 )
 
 func TestCache_Refresh(t *testing.T) {
@@ -28,3 +31,50 @@ func TestCache_Refresh(t *testing.T) {
 		}
 	})
 }
+
+func TestAuditLiveness(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(index int, config *satellite.Config) {
+				config.Discovery.AuditLiveness = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		time.Sleep(5 * time.Second)
+
+		satellite := planet.Satellites[0]
+		service := satellite.Discovery.Service
+
+		// runOnce triggers a chore and waits until it has run
+		runOnce := func(chore *chore.Chore) {
+			lastRun := chore.Status().LastRun
+			chore.Trigger()
+			for !chore.Status().LastRun.After(lastRun) {
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+
+		// the nodes seen by kademlia are still added to the cache
+		for _, storageNode := range planet.StorageNodes {
+			_, err := satellite.Overlay.Service.Get(ctx, storageNode.ID())
+			require.NoError(t, err)
+		}
+
+		// an offline node isn't pinged, so it stays in the cache
+		offline := planet.StorageNodes[0]
+		require.NoError(t, planet.StopPeer(offline))
+		runOnce(service.Refresh)
+		runOnce(service.Graveyard)
+
+		_, err := satellite.Overlay.Service.Get(ctx, offline.ID())
+		require.NoError(t, err)
+
+		// and discovery doesn't record uptime checks
+		for _, storageNode := range planet.StorageNodes {
+			stats, err := satellite.DB.StatDB().Get(ctx, storageNode.ID())
+			require.NoError(t, err)
+			assert.EqualValues(t, 0, stats.UptimeCount)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package eestream
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+// ShareVerifier is an ErasureScheme that verifies every erasure share used for
+// decoding against the decoded stripe and keeps track of the pieces that
+// returned corrupted shares.
+type ShareVerifier struct {
+	ErasureScheme
+
+	mu        sync.Mutex
+	corrupted map[int]bool
+}
+
+// NewShareVerifier wraps es with share verification.
+func NewShareVerifier(es ErasureScheme) *ShareVerifier {
+	return &ShareVerifier{
+		ErasureScheme: es,
+		corrupted:     make(map[int]bool),
+	}
+}
+
+// Decode decodes the erasure shares and checks each of them by encoding the
+// corresponding share from the decoded stripe.
+func (v *ShareVerifier) Decode(out []byte, in map[int][]byte) ([]byte, error) {
+	// error correction may modify the shares, keep the originals for comparing
+	shares := make(map[int][]byte, len(in))
+	for num, data := range in {
+		shares[num] = append([]byte(nil), data...)
+	}
+
+	out, err := v.ErasureScheme.Decode(out, shares)
+	if err != nil {
+		return out, err
+	}
+
+	stripe := out[len(out)-v.StripeSize():]
+	expected := make([]byte, v.ErasureShareSize())
+	for num, data := range in {
+		if err := v.EncodeSingle(stripe, expected, num); err != nil {
+			return out, err
+		}
+		if !bytes.Equal(expected, data) {
+			v.mu.Lock()
+			v.corrupted[num] = true
+			v.mu.Unlock()
+		}
+	}
+	return out, nil
+}
+
+// CorruptedPieces returns the sorted piece numbers that returned at least
+// one corrupted erasure share.
+func (v *ShareVerifier) CorruptedPieces() []int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	pieces := make([]int, 0, len(v.corrupted))
+	for num := range v.corrupted {
+		pieces = append(pieces, num)
+	}
+	sort.Ints(pieces)
+	return pieces
+}
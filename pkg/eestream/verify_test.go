@@ -0,0 +1,38 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package eestream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vivint/infectious"
+)
+
+func TestShareVerifier(t *testing.T) {
+	fc, err := infectious.NewFEC(2, 4)
+	require.NoError(t, err)
+	verifier := NewShareVerifier(NewRSScheme(fc, 8))
+
+	stripe := randData(verifier.StripeSize())
+	shares := make(map[int][]byte)
+	err = verifier.Encode(stripe, func(num int, data []byte) {
+		shares[num] = append([]byte(nil), data...)
+	})
+	require.NoError(t, err)
+
+	// all shares are valid
+	out, err := verifier.Decode(nil, shares)
+	require.NoError(t, err)
+	assert.Equal(t, stripe, out)
+	assert.Empty(t, verifier.CorruptedPieces())
+
+	// a single corrupted share is corrected and reported
+	shares[2][0]++
+	out, err = verifier.Decode(nil, shares)
+	require.NoError(t, err)
+	assert.Equal(t, stripe, out)
+	assert.Equal(t, []int{2}, verifier.CorruptedPieces())
+}
@@ -7,6 +7,8 @@ import (
 	"crypto/hmac"
 	"crypto/sha512"
 
+	"golang.org/x/crypto/argon2"
+
 	"storj.io/storj/pkg/storj"
 )
 
@@ -125,3 +127,15 @@ func DeriveKey(key *storj.Key, message string) (*storj.Key, error) {
 
 	return derived, nil
 }
+
+// DeriveRootKey derives a root key from the given passphrase and salt using Argon2id
+func DeriveRootKey(passphrase, salt []byte) (*storj.Key, error) {
+	if len(salt) == 0 {
+		return nil, ErrInvalidConfig.New("salt is required for deriving the root key")
+	}
+
+	derived := new(storj.Key)
+	copy(derived[:], argon2.IDKey(passphrase, salt, 1, 64*1024, 4, uint32(len(derived))))
+
+	return derived, nil
+}
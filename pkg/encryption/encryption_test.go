@@ -0,0 +1,29 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveRootKey(t *testing.T) {
+	passphrase := []byte("correct horse battery staple")
+
+	key1, err := DeriveRootKey(passphrase, []byte("project-1"))
+	require.NoError(t, err)
+
+	again, err := DeriveRootKey(passphrase, []byte("project-1"))
+	require.NoError(t, err)
+	assert.Equal(t, key1, again, "derivation must be deterministic")
+
+	key2, err := DeriveRootKey(passphrase, []byte("project-2"))
+	require.NoError(t, err)
+	assert.NotEqual(t, key1, key2, "salt must change the key")
+
+	_, err = DeriveRootKey(passphrase, nil)
+	assert.True(t, ErrInvalidConfig.Has(err))
+}
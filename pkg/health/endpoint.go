@@ -0,0 +1,67 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// Endpoint implements the health rpc service
+type Endpoint struct {
+	service *Service
+}
+
+// NewEndpoint creates a health endpoint for service
+func NewEndpoint(service *Service) *Endpoint {
+	return &Endpoint{service: service}
+}
+
+// Liveness reports whether the process is responsive
+func (endpoint *Endpoint) Liveness(ctx context.Context, req *pb.HealthRequest) (_ *pb.HealthResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report, err := endpoint.service.Liveness(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return toResponse(report)
+}
+
+// Readiness checks the subsystems of the process
+func (endpoint *Endpoint) Readiness(ctx context.Context, req *pb.HealthRequest) (_ *pb.HealthResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report, err := endpoint.service.Readiness(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return toResponse(report)
+}
+
+// toResponse converts a report to its protobuf representation
+func toResponse(report *Report) (*pb.HealthResponse, error) {
+	checkedAt, err := ptypes.TimestampProto(report.CheckedAt)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response := &pb.HealthResponse{
+		Healthy:   report.Healthy,
+		CheckedAt: checkedAt,
+	}
+	for _, subsystem := range report.Subsystems {
+		response.Subsystems = append(response.Subsystems, &pb.SubsystemHealth{
+			Name:       subsystem.Name,
+			Healthy:    subsystem.Healthy,
+			Error:      subsystem.Error,
+			DurationMs: int64(subsystem.Duration / time.Millisecond),
+		})
+	}
+	return response, nil
+}
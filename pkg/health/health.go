@@ -0,0 +1,150 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Config configures the health probes of a process
+type Config struct {
+	Address string        `help:"address to serve the http liveness and readiness probes on, disabled when empty" default:""`
+	Timeout time.Duration `help:"maximum duration of checking a single subsystem" default:"5s"`
+}
+
+// Check returns an error when a subsystem isn't able to serve requests
+type Check func(ctx context.Context) error
+
+// Report is the result of a probe
+type Report struct {
+	Healthy    bool        `json:"healthy"`
+	Subsystems []Subsystem `json:"subsystems"`
+	CheckedAt  time.Time   `json:"checked_at"`
+}
+
+// Subsystem is the result of checking a single subsystem
+type Subsystem struct {
+	Name     string        `json:"name"`
+	Healthy  bool          `json:"healthy"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// Service checks the subsystems of a process.
+//
+// The liveness probe only tells whether the process is responsive, it
+// doesn't check the subsystems, so a failing dependency like the database
+// doesn't make the process restart. The readiness probe checks all
+// subsystems concurrently.
+type Service struct {
+	timeout time.Duration
+
+	mu     sync.Mutex
+	names  []string
+	checks map[string]Check
+}
+
+// NewService creates a health service without any subsystems
+func NewService(config Config) *Service {
+	return &Service{
+		timeout: config.Timeout,
+		checks:  map[string]Check{},
+	}
+}
+
+// Add adds a subsystem checked by the readiness probe, adding a subsystem
+// with the same name again replaces its check
+func (service *Service) Add(name string, check Check) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if _, exists := service.checks[name]; !exists {
+		service.names = append(service.names, name)
+	}
+	service.checks[name] = check
+}
+
+// Liveness reports whether the process is responsive
+func (service *Service) Liveness(ctx context.Context) (_ *Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return &Report{Healthy: true, Subsystems: []Subsystem{}, CheckedAt: time.Now().UTC()}, nil
+}
+
+// Readiness checks all subsystems, the process is ready when all of them are healthy
+func (service *Service) Readiness(ctx context.Context) (_ *Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	names := append([]string(nil), service.names...)
+	checks := make([]Check, len(names))
+	for i, name := range names {
+		checks[i] = service.checks[name]
+	}
+	service.mu.Unlock()
+
+	report := &Report{
+		Healthy:    true,
+		Subsystems: make([]Subsystem, len(names)),
+		CheckedAt:  time.Now().UTC(),
+	}
+
+	var wg sync.WaitGroup
+	for i := range names {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			report.Subsystems[i] = service.check(ctx, names[i], checks[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for _, subsystem := range report.Subsystems {
+		if !subsystem.Healthy {
+			report.Healthy = false
+			mon.Meter("health_unhealthy_subsystems").Mark(1)
+		}
+	}
+	return report, nil
+}
+
+// check runs a single check within the timeout
+func (service *Service) check(ctx context.Context, name string, check Check) Subsystem {
+	if service.timeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, service.timeout)
+		defer cancel()
+	}
+
+	started := time.Now()
+	err := runCheck(ctx, check)
+
+	subsystem := Subsystem{
+		Name:     name,
+		Healthy:  err == nil,
+		Duration: time.Since(started),
+	}
+	if err != nil {
+		subsystem.Error = err.Error()
+	}
+	return subsystem
+}
+
+// runCheck runs check and returns when either the check has finished or the
+// context is done, so a check blocked without respecting the context doesn't
+// block the probe
+func runCheck(ctx context.Context, check Check) error {
+	result := make(chan error, 1)
+	go func() {
+		result <- check(ctx)
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return Error.New("check didn't finish: %v", ctx.Err())
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/health"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestReadiness(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	service := health.NewService(health.Config{Timeout: 100 * time.Millisecond})
+
+	report, err := service.Readiness(ctx)
+	require.NoError(t, err)
+	assert.True(t, report.Healthy)
+	assert.Empty(t, report.Subsystems)
+
+	service.Add("database", func(ctx context.Context) error { return nil })
+	service.Add("disk", func(ctx context.Context) error { return errors.New("disk full") })
+	// checks which don't respect the context are abandoned after the timeout
+	blocked := make(chan struct{})
+	defer close(blocked)
+	service.Add("stuck", func(ctx context.Context) error {
+		<-blocked
+		return nil
+	})
+
+	report, err = service.Readiness(ctx)
+	require.NoError(t, err)
+	assert.False(t, report.Healthy)
+	require.Len(t, report.Subsystems, 3)
+
+	assert.Equal(t, "database", report.Subsystems[0].Name)
+	assert.True(t, report.Subsystems[0].Healthy)
+	assert.Empty(t, report.Subsystems[0].Error)
+
+	assert.Equal(t, "disk", report.Subsystems[1].Name)
+	assert.False(t, report.Subsystems[1].Healthy)
+	assert.Equal(t, "disk full", report.Subsystems[1].Error)
+
+	assert.Equal(t, "stuck", report.Subsystems[2].Name)
+	assert.False(t, report.Subsystems[2].Healthy)
+
+	// adding a subsystem again replaces its check
+	service.Add("disk", func(ctx context.Context) error { return nil })
+	service.Add("stuck", func(ctx context.Context) error { return nil })
+	report, err = service.Readiness(ctx)
+	require.NoError(t, err)
+	assert.True(t, report.Healthy)
+	assert.Len(t, report.Subsystems, 3)
+
+	// liveness doesn't depend on the subsystems
+	service.Add("database", func(ctx context.Context) error { return errors.New("unreachable") })
+	report, err = service.Liveness(ctx)
+	require.NoError(t, err)
+	assert.True(t, report.Healthy)
+}
+
+func TestEndpoint(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	service := health.NewService(health.Config{})
+	service.Add("database", func(ctx context.Context) error { return errors.New("unreachable") })
+	endpoint := health.NewEndpoint(service)
+
+	live, err := endpoint.Liveness(ctx, &pb.HealthRequest{})
+	require.NoError(t, err)
+	assert.True(t, live.Healthy)
+	assert.NotNil(t, live.CheckedAt)
+
+	ready, err := endpoint.Readiness(ctx, &pb.HealthRequest{})
+	require.NoError(t, err)
+	assert.False(t, ready.Healthy)
+	require.Len(t, ready.Subsystems, 1)
+	assert.Equal(t, "database", ready.Subsystems[0].Name)
+	assert.Equal(t, "unreachable", ready.Subsystems[0].Error)
+}
+
+func TestServeHTTP(t *testing.T) {
+	service := health.NewService(health.Config{})
+	failing := errors.New("unreachable")
+	service.Add("database", func(ctx context.Context) error { return failing })
+
+	for _, test := range []struct {
+		method, path string
+		status       int
+		healthy      bool
+	}{
+		{http.MethodGet, "/live", http.StatusOK, true},
+		{http.MethodGet, "/ready", http.StatusServiceUnavailable, false},
+		{http.MethodPost, "/ready", http.StatusMethodNotAllowed, false},
+		{http.MethodGet, "/unknown", http.StatusNotFound, false},
+	} {
+		recorder := httptest.NewRecorder()
+		service.ServeHTTP(recorder, httptest.NewRequest(test.method, test.path, nil))
+		assert.Equal(t, test.status, recorder.Code, test.method+" "+test.path)
+
+		if test.status == http.StatusOK || test.status == http.StatusServiceUnavailable {
+			var report health.Report
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+			assert.Equal(t, test.healthy, report.Healthy)
+		}
+	}
+
+	failing = nil
+	recorder := httptest.NewRecorder()
+	service.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/ready", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Server serves the http probes of a health service, e.g. for the liveness
+// and readiness probes of kubernetes or the health checks of load balancers
+type Server struct {
+	listener net.Listener
+	server   http.Server
+}
+
+// NewServer creates a server for the probes of service
+func NewServer(service *Service, listener net.Listener) *Server {
+	return &Server{
+		listener: listener,
+		server:   http.Server{Handler: service},
+	}
+}
+
+// Run serves the probes until the context is canceled
+func (server *Server) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var group errgroup.Group
+	group.Go(func() error {
+		<-ctx.Done()
+		return server.server.Shutdown(context.Background())
+	})
+	group.Go(func() error {
+		defer cancel()
+		return server.server.Serve(server.listener)
+	})
+	return group.Wait()
+}
+
+// Close closes the server and the underlying listener
+func (server *Server) Close() error {
+	return server.server.Close()
+}
+
+// ServeHTTP implements the http probes, both respond with the report and
+// status 200 when healthy and 503 otherwise:
+//
+//	GET /live    reports whether the process is responsive
+//	GET /ready   checks all subsystems
+func (service *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var probe func(ctx context.Context) (*Report, error)
+	switch strings.Trim(r.URL.Path, "/") {
+	case "live":
+		probe = service.Liveness
+	case "ready":
+		probe = service.Readiness
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	report, err := probe(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !report.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -5,11 +5,13 @@ package kademlia
 
 import (
 	"fmt"
-	"regexp"
+	"strings"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 )
 
@@ -52,10 +54,16 @@ func (c Config) Verify(log *zap.Logger) error {
 	return c.Operator.Verify(log)
 }
 
+// knownWalletFeatures contains all wallet features a node is allowed to report
+var knownWalletFeatures = map[string]bool{
+	accounting.PayoutMethodZkSync: true,
+}
+
 // OperatorConfig defines properties related to storage node operator metadata
 type OperatorConfig struct {
-	Email  string `user:"true" help:"operator email address" default:""`
-	Wallet string `user:"true" help:"operator wallet adress" default:""`
+	Email          string `user:"true" help:"operator email address" default:""`
+	Wallet         string `user:"true" help:"operator wallet adress" default:""`
+	WalletFeatures string `user:"true" help:"comma separated list of payout methods the operator opts into (e.g. zksync), empty means L1 payouts" default:""`
 }
 
 // Verify verifies whether operator config is valid.
@@ -66,27 +74,59 @@ func (c OperatorConfig) Verify(log *zap.Logger) error {
 	if err := isOperatorWalletValid(log, c.Wallet); err != nil {
 		return err
 	}
+	if err := areWalletFeaturesValid(log, c.Features()); err != nil {
+		return err
+	}
 	return nil
 }
 
+// Features returns the configured wallet features
+func (c OperatorConfig) Features() []string {
+	var features []string
+	for _, feature := range strings.Split(c.WalletFeatures, ",") {
+		feature = strings.ToLower(strings.TrimSpace(feature))
+		if feature != "" {
+			features = append(features, feature)
+		}
+	}
+	return features
+}
+
+// isOperatorEmailValid only warns about a missing or invalid email address,
+// the satellites can't contact the operator, but they still pay the node
 func isOperatorEmailValid(log *zap.Logger, email string) error {
 	if email == "" {
 		log.Sugar().Warn("Operator email address isn't specified.")
+	} else if err := overlay.ValidateEmail(email); err != nil {
+		log.Sugar().Warn("Operator email address isn't valid, satellites won't store it: ", err)
 	} else {
 		log.Sugar().Info("Operator email: ", email)
 	}
 	return nil
 }
 
+// isOperatorWalletValid uses the same validation as the satellites, which
+// don't pay to wallet addresses they can't validate
 func isOperatorWalletValid(log *zap.Logger, wallet string) error {
 	if wallet == "" {
 		return fmt.Errorf("Operator wallet address isn't specified")
 	}
-	r := regexp.MustCompile("^0x[a-fA-F0-9]{40}$")
-	if match := r.MatchString(wallet); !match {
-		return fmt.Errorf("Operator wallet address isn't valid")
+	if err := overlay.ValidateWallet(wallet); err != nil {
+		return err
 	}
 
 	log.Sugar().Info("Operator wallet: ", wallet)
 	return nil
 }
+
+func areWalletFeaturesValid(log *zap.Logger, features []string) error {
+	for _, feature := range features {
+		if !knownWalletFeatures[feature] {
+			return fmt.Errorf("Operator wallet feature %q isn't supported", feature)
+		}
+	}
+	if len(features) > 0 {
+		log.Sugar().Info("Operator wallet features: ", strings.Join(features, ","))
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package kademlia_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/kademlia"
+)
+
+func TestOperatorWalletFeatures(t *testing.T) {
+	const wallet = "0x0123456789012345678901234567890123456789"
+
+	for _, tt := range []struct {
+		features string
+		expected []string
+		valid    bool
+	}{
+		{features: "", expected: nil, valid: true},
+		{features: "zksync", expected: []string{"zksync"}, valid: true},
+		{features: " zkSync, ", expected: []string{"zksync"}, valid: true},
+		{features: "zksync,lightning", expected: []string{"zksync", "lightning"}, valid: false},
+	} {
+		config := kademlia.OperatorConfig{Wallet: wallet, WalletFeatures: tt.features}
+		assert.Equal(t, tt.expected, config.Features(), tt.features)
+
+		err := config.Verify(zap.NewNop())
+		if tt.valid {
+			assert.NoError(t, err, tt.features)
+		} else {
+			assert.Error(t, err, tt.features)
+		}
+	}
+}
+
+func TestOperatorWallet(t *testing.T) {
+	for _, tt := range []struct {
+		wallet string
+		valid  bool
+	}{
+		{wallet: "", valid: false},
+		{wallet: "0x0", valid: false},
+		{wallet: "0123456789012345678901234567890123456789", valid: false},
+		{wallet: "0x0123456789abcdefABCDEF0123456789abcdef01", valid: true},
+	} {
+		config := kademlia.OperatorConfig{Wallet: tt.wallet, Email: "operator"}
+
+		// an invalid email address is only reported, the node is still paid
+		err := config.Verify(zap.NewNop())
+		if tt.valid {
+			assert.NoError(t, err, tt.wallet)
+		} else {
+			assert.Error(t, err, tt.wallet)
+		}
+	}
+}
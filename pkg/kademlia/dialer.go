@@ -68,6 +68,11 @@ func (dialer *Dialer) Lookup(ctx context.Context, self pb.Node, ask pb.Node, fin
 		return nil, errs.Combine(err, conn.disconnect())
 	}
 
+	for _, invalid := range resp.GetMetadataErrors() {
+		dialer.log.Warn("operator metadata was rejected, check the configuration of the "+invalid.GetField(),
+			zap.String("satellite", ask.Id.String()), zap.String("error", invalid.GetMessage()))
+	}
+
 	return resp.Response, conn.disconnect()
 }
 
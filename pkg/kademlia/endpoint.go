@@ -16,12 +16,22 @@ import (
 // EndpointError defines errors class for Endpoint
 var EndpointError = errs.Class("kademlia endpoint error")
 
+// MetadataChecker checks the operator metadata of the nodes checking in
+type MetadataChecker interface {
+	// CheckMetadata returns the invalid fields of the operator metadata of the node
+	CheckMetadata(ctx context.Context, node *pb.Node) ([]*pb.MetadataError, error)
+}
+
 // Endpoint implements the kademlia Endpoints
 type Endpoint struct {
 	log          *zap.Logger
 	service      *Kademlia
 	routingTable *RoutingTable
 	connected    int32
+
+	// Metadata checks the operator metadata of the nodes checking in with a
+	// pingback, the metadata isn't checked when it's nil
+	Metadata MetadataChecker
 }
 
 // NewEndpoint returns a new kademlia endpoint
@@ -44,7 +54,14 @@ func (endpoint *Endpoint) Query(ctx context.Context, req *pb.QueryRequest) (*pb.
 		return &pb.QueryResponse{}, EndpointError.New("could not find near endpoint: %v", err)
 	}
 
-	return &pb.QueryResponse{Sender: req.Sender, Response: nodes}, nil
+	response := &pb.QueryResponse{Sender: req.Sender, Response: nodes}
+	if req.GetPingback() && endpoint.Metadata != nil && req.Sender != nil {
+		response.MetadataErrors, err = endpoint.Metadata.CheckMetadata(ctx, req.Sender)
+		if err != nil {
+			endpoint.log.Warn("could not check operator metadata", zap.String("nodeID", req.Sender.Id.String()), zap.Error(err))
+		}
+	}
+	return response, nil
 }
 
 // pingback implements pingback for queries
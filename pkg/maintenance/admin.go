@@ -0,0 +1,56 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// ServeHTTP implements the maintenance admin api:
+//
+//	GET  /maintenance                     returns the status of the maintenance mode
+//	POST /maintenance/enable?reason=...   refuses writes until it's disabled
+//	POST /maintenance/disable             accepts writes again
+func (mode *Mode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch strings.Trim(r.URL.Path, "/") {
+	case "maintenance":
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+	case "maintenance/enable":
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		reason := r.URL.Query().Get("reason")
+		if reason == "" {
+			http.Error(w, "reason is missing", http.StatusBadRequest)
+			return
+		}
+		mode.Enable(reason)
+	case "maintenance/disable":
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		mode.Disable()
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(mode.Status())
+}
+
+// Refuse answers an http request with 503 Service Unavailable and a
+// Retry-After header
+func (mode *Mode) Refuse(w http.ResponseWriter) {
+	mon.Meter("maintenance_refused").Mark(1)
+	w.Header().Set("Retry-After", mode.RetryAfterSeconds())
+	http.Error(w, mode.Message(), http.StatusServiceUnavailable)
+}
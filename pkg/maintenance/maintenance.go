@@ -0,0 +1,175 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// RetryAfterKey is the key of the trailer, which tells clients in how many
+// seconds refused writes can be retried
+const RetryAfterKey = "retry-after"
+
+// Config configures the maintenance mode
+type Config struct {
+	Enabled      bool          `help:"start in maintenance mode, writes are refused until it's disabled" default:"false"`
+	RetryAfter   time.Duration `help:"how long clients are asked to wait before retrying refused writes" default:"5m"`
+	WriteMethods string        `help:"comma separated grpc methods, which are refused in maintenance mode, upload allocations are always refused" default:"pointerdb.PointerDB/Put,pointerdb.PointerDB/Delete,pointerdb.PointerDB/DeletePrefix,pointerdb.PointerDB/SetPrefixQuota,pointerdb.PointerDB/SetBucketLock"`
+}
+
+// Status is the state of the maintenance mode
+type Status struct {
+	Enabled bool      `json:"enabled"`
+	Reason  string    `json:"reason,omitempty"`
+	Since   time.Time `json:"since,omitempty"`
+	// RetryAfter is how long clients are asked to wait before retrying
+	RetryAfter time.Duration `json:"retryAfter"`
+}
+
+// Mode refuses writes while it's enabled, so the databases can be migrated
+// safely, while downloads continue to work. Writes are uploads, deletes and
+// other changes of the metainfo, as well as account changes in the console.
+type Mode struct {
+	log     *zap.Logger
+	config  Config
+	methods map[string]bool
+
+	mu     sync.Mutex
+	status Status
+}
+
+// NewMode creates a maintenance mode, which is enabled when configured
+func NewMode(log *zap.Logger, config Config) (*Mode, error) {
+	mode := &Mode{
+		log:     log,
+		config:  config,
+		methods: map[string]bool{},
+		status:  Status{RetryAfter: config.RetryAfter},
+	}
+
+	for _, method := range strings.Split(config.WriteMethods, ",") {
+		method = strings.TrimSpace(method)
+		if method == "" {
+			continue
+		}
+		if !strings.HasPrefix(method, "/") {
+			method = "/" + method
+		}
+		if strings.Count(method, "/") != 2 {
+			return nil, Error.New("expected <service>/<method>, got %q", method)
+		}
+		mode.methods[method] = true
+	}
+
+	if config.Enabled {
+		mode.Enable("enabled at start")
+	}
+	return mode, nil
+}
+
+// Enable refuses writes from now on
+func (mode *Mode) Enable(reason string) {
+	mode.mu.Lock()
+	defer mode.mu.Unlock()
+
+	if mode.status.Enabled {
+		mode.status.Reason = reason
+		return
+	}
+	mode.status.Enabled = true
+	mode.status.Reason = reason
+	mode.status.Since = time.Now().UTC()
+	mode.log.Info("maintenance mode enabled", zap.String("reason", reason))
+}
+
+// Disable accepts writes again
+func (mode *Mode) Disable() {
+	mode.mu.Lock()
+	defer mode.mu.Unlock()
+
+	if !mode.status.Enabled {
+		return
+	}
+	mode.log.Info("maintenance mode disabled", zap.Duration("duration", time.Since(mode.status.Since)))
+	mode.status = Status{RetryAfter: mode.config.RetryAfter}
+}
+
+// Enabled returns whether writes are refused
+func (mode *Mode) Enabled() bool {
+	mode.mu.Lock()
+	defer mode.mu.Unlock()
+	return mode.status.Enabled
+}
+
+// Status returns the state of the maintenance mode
+func (mode *Mode) Status() Status {
+	mode.mu.Lock()
+	defer mode.mu.Unlock()
+	return mode.status
+}
+
+// Message returns the message, which refused writes are answered with
+func (mode *Mode) Message() string {
+	return fmt.Sprintf("satellite is in maintenance mode, retry after %v", mode.config.RetryAfter)
+}
+
+// RetryAfterSeconds returns in how many seconds clients should retry refused writes
+func (mode *Mode) RetryAfterSeconds() string {
+	return strconv.Itoa(int(mode.config.RetryAfter.Seconds()))
+}
+
+// isWrite returns whether the request of the method changes data
+func (mode *Mode) isWrite(method string, req interface{}) bool {
+	if mode.methods[method] {
+		return true
+	}
+	// downloads need allocations as well, only uploads are refused
+	if allocation, ok := req.(*pb.PayerBandwidthAllocationRequest); ok {
+		return allocation.GetAction() == pb.BandwidthAction_PUT
+	}
+	return false
+}
+
+// UnaryInterceptor refuses writes with Unavailable while the maintenance mode
+// is enabled, the retry-after trailer tells clients when to retry
+func (mode *Mode) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !mode.Enabled() || !mode.isWrite(info.FullMethod, req) {
+			return handler(ctx, req)
+		}
+
+		mon.Meter("maintenance_refused").Mark(1)
+		mode.log.Debug("refused write", zap.String("method", info.FullMethod))
+		_ = grpc.SetTrailer(ctx, metadata.Pairs(RetryAfterKey, mode.RetryAfterSeconds()))
+		return nil, status.Error(codes.Unavailable, mode.Message())
+	}
+}
+
+// StreamInterceptor refuses write streams like UnaryInterceptor, streams are
+// writes when their method is one of the configured write methods
+func (mode *Mode) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !mode.Enabled() || !mode.isWrite(info.FullMethod, nil) {
+			return handler(srv, ss)
+		}
+
+		mon.Meter("maintenance_refused").Mark(1)
+		mode.log.Debug("refused write stream", zap.String("method", info.FullMethod))
+		ss.SetTrailer(metadata.Pairs(RetryAfterKey, mode.RetryAfterSeconds()))
+		return status.Error(codes.Unavailable, mode.Message())
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/maintenance"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	mode, err := maintenance.NewMode(zap.NewNop(), maintenance.Config{
+		RetryAfter:   time.Minute,
+		WriteMethods: "pointerdb.PointerDB/Put, /pointerdb.PointerDB/Delete",
+	})
+	require.NoError(t, err)
+	interceptor := mode.UnaryInterceptor()
+
+	call := func(method string, req interface{}) error {
+		_, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+		return err
+	}
+	get := &pb.PayerBandwidthAllocationRequest{Action: pb.BandwidthAction_GET}
+	put := &pb.PayerBandwidthAllocationRequest{Action: pb.BandwidthAction_PUT}
+	const allocation = "/pointerdb.PointerDB/PayerBandwidthAllocation"
+
+	assert.False(t, mode.Enabled())
+	assert.NoError(t, call("/pointerdb.PointerDB/Put", nil))
+	assert.NoError(t, call(allocation, put))
+
+	mode.Enable("migration")
+	assert.True(t, mode.Enabled())
+	assert.Equal(t, "migration", mode.Status().Reason)
+
+	for _, err := range []error{
+		call("/pointerdb.PointerDB/Put", nil),
+		call("/pointerdb.PointerDB/Delete", nil),
+		call(allocation, put),
+	} {
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	// downloads continue
+	assert.NoError(t, call("/pointerdb.PointerDB/Get", nil))
+	assert.NoError(t, call(allocation, get))
+
+	mode.Disable()
+	assert.False(t, mode.Enabled())
+	assert.NoError(t, call("/pointerdb.PointerDB/Put", nil))
+
+	_, err = maintenance.NewMode(zap.NewNop(), maintenance.Config{WriteMethods: "Put"})
+	assert.True(t, maintenance.Error.Has(err))
+
+	mode, err = maintenance.NewMode(zap.NewNop(), maintenance.Config{Enabled: true})
+	require.NoError(t, err)
+	assert.True(t, mode.Enabled())
+}
+
+// mockServerStream is a server stream, which records its trailer
+type mockServerStream struct {
+	grpc.ServerStream
+	trailer metadata.MD
+}
+
+func (stream *mockServerStream) Context() context.Context { return context.Background() }
+
+func (stream *mockServerStream) SetTrailer(md metadata.MD) { stream.trailer = md }
+
+func TestStreamInterceptor(t *testing.T) {
+	mode, err := maintenance.NewMode(zap.NewNop(), maintenance.Config{
+		RetryAfter:   time.Minute,
+		WriteMethods: "piecestore.PieceStoreRoutes/StoreResumable",
+	})
+	require.NoError(t, err)
+	interceptor := mode.StreamInterceptor()
+
+	stream := &mockServerStream{}
+	call := func(method string) error {
+		return interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: method},
+			func(srv interface{}, ss grpc.ServerStream) error { return nil })
+	}
+
+	assert.NoError(t, call("/piecestore.PieceStoreRoutes/StoreResumable"))
+
+	mode.Enable("migration")
+	assert.Equal(t, codes.Unavailable, status.Code(call("/piecestore.PieceStoreRoutes/StoreResumable")))
+	assert.Equal(t, []string{"60"}, stream.trailer.Get(maintenance.RetryAfterKey))
+	// reading streams continue
+	assert.NoError(t, call("/pointerdb.PointerDB/ListStream"))
+
+	mode.Disable()
+	assert.NoError(t, call("/piecestore.PieceStoreRoutes/StoreResumable"))
+}
+
+func TestServeHTTP(t *testing.T) {
+	mode, err := maintenance.NewMode(zap.NewNop(), maintenance.Config{RetryAfter: time.Minute})
+	require.NoError(t, err)
+
+	request := func(method, target string) (*httptest.ResponseRecorder, maintenance.Status) {
+		recorder := httptest.NewRecorder()
+		mode.ServeHTTP(recorder, httptest.NewRequest(method, target, nil))
+
+		var status maintenance.Status
+		if recorder.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &status))
+		}
+		return recorder, status
+	}
+
+	recorder, status := request(http.MethodGet, "/maintenance")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.False(t, status.Enabled)
+
+	recorder, _ = request(http.MethodPost, "/maintenance/enable")
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder, _ = request(http.MethodGet, "/maintenance/enable?reason=migration")
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	assert.False(t, mode.Enabled())
+
+	recorder, status = request(http.MethodPost, "/maintenance/enable?reason=migration")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, status.Enabled)
+	assert.Equal(t, "migration", status.Reason)
+	assert.False(t, status.Since.IsZero())
+
+	refused := httptest.NewRecorder()
+	mode.Refuse(refused)
+	assert.Equal(t, http.StatusServiceUnavailable, refused.Code)
+	assert.Equal(t, "60", refused.Header().Get("Retry-After"))
+
+	recorder, status = request(http.MethodPost, "/maintenance/disable")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.False(t, status.Enabled)
+
+	recorder, _ = request(http.MethodGet, "/unknown")
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
@@ -6,6 +6,7 @@ package kvmetainfo
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -140,6 +141,45 @@ func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path)
 	return store.Delete(ctx, path)
 }
 
+// DeleteObjects deletes all objects under prefix on the satellite, their pieces
+// are deleted from the storage nodes afterwards
+func (db *DB) DeleteObjects(ctx context.Context, bucket string, prefix storj.Path, wholeBucket bool) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return 0, err
+	}
+
+	encryptedPrefix := bucket
+	if prefix = strings.Trim(prefix, "/"); prefix != "" {
+		encryptedPrefix, err = streams.EncryptAfterBucket(bucket+"/"+prefix, bucketInfo.PathCipher, db.rootKey)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	for {
+		objects, remote, more, err := db.pointers.DeletePrefix(ctx, encryptedPrefix, wholeBucket)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += objects
+
+		// the pointers are already deleted, so pieces which can't be deleted
+		// now are left to the garbage collection of the storage nodes
+		for _, pointer := range remote {
+			if err := db.segments.DeletePieces(ctx, pointer); err != nil {
+				zap.S().Warnf("Failed deleting pieces of segment %s: %v", pointer.GetRemote().GetPieceId(), err)
+			}
+		}
+
+		if !more {
+			return deleted, nil
+		}
+	}
+}
+
 // ModifyPendingObject creates an interface for updating a partially uploaded object
 func (db *DB) ModifyPendingObject(ctx context.Context, bucket string, path storj.Path) (object storj.MutableObject, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -189,7 +229,7 @@ func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.List
 		endBefore = "\x7f\x7f\x7f\x7f\x7f\x7f\x7f"
 	}
 
-	items, more, err := objects.List(ctx, options.Prefix, startAfter, endBefore, options.Recursive, options.Limit, meta.All)
+	items, more, err := objects.ListFiltered(ctx, options.Prefix, startAfter, endBefore, options.Recursive, options.Limit, meta.All, options.Filter)
 	if err != nil {
 		return storj.ObjectList{}, err
 	}
@@ -312,7 +352,7 @@ func objectFromMeta(bucket storj.Bucket, path storj.Path, isPrefix bool, meta ob
 
 		Stream: storj.Stream{
 			Size:     meta.Size,
-			Checksum: []byte(meta.Checksum),
+			Checksum: meta.Checksum,
 		},
 	}
 }
@@ -341,8 +381,8 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		Expires:     lastSegment.Expiration, // TODO: use correct field
 
 		Stream: storj.Stream{
-			Size: stream.SegmentsSize*(stream.NumberOfSegments-1) + stream.LastSegmentSize,
-			// Checksum: []byte(object.Checksum),
+			Size:     stream.SegmentsSize*(stream.NumberOfSegments-1) + stream.LastSegmentSize,
+			Checksum: stream.Checksum,
 
 			SegmentCount:     stream.NumberOfSegments,
 			FixedSegmentSize: stream.SegmentsSize,
@@ -293,6 +293,57 @@ func TestDeleteObject(t *testing.T) {
 	})
 }
 
+func TestDeleteObjects(t *testing.T) {
+	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
+		// we wait a second for all the nodes to complete bootstrapping off the satellite
+		time.Sleep(2 * time.Second)
+
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		data := make([]byte, 32*memory.KB)
+		_, err = rand.Read(data)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		upload(ctx, t, db, streams, bucket, "dir/large", data)
+		upload(ctx, t, db, streams, bucket, "dir/small", nil)
+		upload(ctx, t, db, streams, bucket, "other", nil)
+
+		stored := func() (sum int64) {
+			for _, node := range planet.StorageNodes {
+				size, err := node.DB.PSDB().SumTTLSizes()
+				assert.NoError(t, err)
+				sum += size
+			}
+			return sum
+		}
+		assert.NotZero(t, stored())
+
+		// every object of the bucket is only deleted on request
+		_, err = db.DeleteObjects(ctx, bucket.Name, "", false)
+		assert.Error(t, err)
+
+		deleted, err := db.DeleteObjects(ctx, bucket.Name, "dir", false)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, deleted)
+		assert.Zero(t, stored(), "the pieces are deleted from the storage nodes")
+
+		list, err := db.ListObjects(ctx, bucket.Name, optionsRecursive("", "", storj.After, 0))
+		assert.NoError(t, err)
+		if assert.Len(t, list.Items, 1) {
+			assert.Equal(t, "other", list.Items[0].Path)
+		}
+
+		deleted, err = db.DeleteObjects(ctx, bucket.Name, "", true)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, deleted)
+	})
+}
+
 func TestListObjectsEmpty(t *testing.T) {
 	runTest(t, func(ctx context.Context, planet *testplanet.Planet, db *kvmetainfo.DB, buckets buckets.Store, streams streams.Store) {
 		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
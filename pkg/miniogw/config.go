@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"os"
+	"time"
 
 	"github.com/minio/cli"
 	minio "github.com/minio/minio/cmd"
@@ -16,9 +17,11 @@ import (
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/metainfo/kvmetainfo"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/piecestore/psclient"
 	"storj.io/storj/pkg/pointerdb/pdbclient"
 	"storj.io/storj/pkg/storage/buckets"
 	ecclient "storj.io/storj/pkg/storage/ec"
@@ -41,10 +44,41 @@ type RSConfig struct {
 // EncryptionConfig is a configuration struct that keeps details about
 // encrypting segments
 type EncryptionConfig struct {
-	Key       string      `help:"root key for encrypting the data"`
-	BlockSize memory.Size `help:"size (in bytes) of encrypted blocks" default:"1KiB"`
-	DataType  int         `help:"Type of encryption to use for content and metadata (1=AES-GCM, 2=SecretBox)" default:"1"`
-	PathType  int         `help:"Type of encryption to use for paths (0=Unencrypted, 1=AES-GCM, 2=SecretBox)" default:"1"`
+	Key           string      `help:"root key for encrypting the data"`
+	KeyDerivation string      `help:"how the root key is derived from the key (legacy=key is used as is, argon2id=key is a passphrase salted per project)" default:"legacy"`
+	BlockSize     memory.Size `help:"size (in bytes) of encrypted blocks" default:"1KiB"`
+	DataType      int         `help:"Type of encryption to use for content and metadata (1=AES-GCM, 2=SecretBox)" default:"1"`
+	PathType      int         `help:"Type of encryption to use for paths (0=Unencrypted, 1=AES-GCM, 2=SecretBox)" default:"1"`
+	Deterministic bool        `help:"derive the content keys and nonces from the root key and path, so uploads are reproducible; uploading different content to the same path reuses keys and nonces, only use it for testing" default:"false"`
+}
+
+const (
+	// KeyDerivationLegacy uses the configured key as the root key, keeping
+	// access to data uploaded before per project salts were introduced
+	KeyDerivationLegacy = "legacy"
+	// KeyDerivationArgon2id derives the root key from the configured
+	// passphrase with Argon2id using the project salt from the satellite
+	KeyDerivationArgon2id = "argon2id"
+)
+
+// RootKey returns the root key for encrypting the data of the project
+func (c EncryptionConfig) RootKey(ctx context.Context, pdb pdbclient.Client) (key *storj.Key, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch c.KeyDerivation {
+	case "", KeyDerivationLegacy:
+		key = new(storj.Key)
+		copy(key[:], c.Key)
+		return key, nil
+	case KeyDerivationArgon2id:
+		info, err := pdb.ProjectInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return encryption.DeriveRootKey([]byte(c.Key), info.GetProjectSalt())
+	default:
+		return nil, Error.New("unknown key derivation %q", c.KeyDerivation)
+	}
 }
 
 // MinioConfig is a configuration struct that keeps details about starting
@@ -65,6 +99,14 @@ type ClientConfig struct {
 	APIKey        string      `help:"API Key (TODO: this needs to change to macaroons somehow)"`
 	MaxInlineSize memory.Size `help:"max inline segment size in bytes" default:"4KiB"`
 	SegmentSize   memory.Size `help:"the size of a segment in bytes" default:"64MiB"`
+
+	UploadStatsInterval time.Duration `help:"how often anonymized upload success statistics are reported to the satellite, 0 disables reporting" default:"0"`
+
+	NewNodeRatio float64 `help:"the fraction of new, not yet vetted, nodes requested for uploads in addition to the vetted nodes, it's capped by the satellite and a negative ratio uses the satellite's ratio" default:"-1"`
+
+	LedgerPath string `help:"path of the local ledger of the signed bandwidth allocations (e.g. $CONFDIR/allocations.db), disabled when empty" default:""`
+
+	PieceStore psclient.Config
 }
 
 // ServerConfig determines how minio listens for requests
@@ -133,6 +175,12 @@ func (c Config) action(ctx context.Context, cliCtx *cli.Context, identity *ident
 
 // GetMetainfo returns an implementation of storj.Metainfo
 func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity) (db storj.Metainfo, ss streams.Store, err error) {
+	return c.GetObservedMetainfo(ctx, identity, nil)
+}
+
+// GetObservedMetainfo returns an implementation of storj.Metainfo, which
+// notifies observer about every piece upload
+func (c Config) GetObservedMetainfo(ctx context.Context, identity *identity.FullIdentity, observer ecclient.PieceObserver) (db storj.Metainfo, ss streams.Store, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if c.Client.OverlayAddr == "" || c.Client.PointerDBAddr == "" {
@@ -156,7 +204,20 @@ func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity
 		return nil, nil, Error.New("failed to connect to pointer DB: %v", err)
 	}
 
-	ec := ecclient.NewClient(identity, c.RS.MaxBufferMem.Int())
+	var ledger *psclient.Ledger
+	if c.Client.LedgerPath != "" {
+		// the ledger stays open as long as the client, the piece store clients store their allocations when they close
+		ledger, err = psclient.OpenLedger(c.Client.LedgerPath)
+		if err != nil {
+			return nil, nil, Error.New("failed to open ledger: %v", err)
+		}
+	}
+
+	// transfers outliving their allocations continue with fresh ones from the satellite
+	ec := ecclient.NewAccountedClient(identity, c.RS.MaxBufferMem.Int(), observer, pdb, c.Client.PieceStore, ledger)
+	if c.Client.UploadStatsInterval > 0 {
+		ec = ecclient.NewReportingClient(ec, oc, c.Client.UploadStatsInterval)
+	}
 	fc, err := infectious.NewFEC(c.RS.MinThreshold, c.RS.MaxThreshold)
 	if err != nil {
 		return nil, nil, Error.New("failed to create erasure coding client: %v", err)
@@ -166,17 +227,23 @@ func (c Config) GetMetainfo(ctx context.Context, identity *identity.FullIdentity
 		return nil, nil, Error.New("failed to create redundancy strategy: %v", err)
 	}
 
-	segments := segments.NewSegmentStore(oc, ec, pdb, rs, c.Client.MaxInlineSize.Int())
+	segments := segments.NewSegmentStoreWithNewNodeRatio(oc, ec, pdb, rs, c.Client.MaxInlineSize.Int(), c.Client.NewNodeRatio)
 
 	if c.RS.ErasureShareSize.Int()*c.RS.MinThreshold%c.Enc.BlockSize.Int() != 0 {
 		err = Error.New("EncryptionBlockSize must be a multiple of ErasureShareSize * RS MinThreshold")
 		return nil, nil, err
 	}
 
-	key := new(storj.Key)
-	copy(key[:], c.Enc.Key)
+	key, err := c.Enc.RootKey(ctx, pdb)
+	if err != nil {
+		return nil, nil, Error.New("failed to derive root key: %v", err)
+	}
 
-	streams, err := streams.NewStreamStore(segments, c.Client.SegmentSize.Int64(), key, c.Enc.BlockSize.Int(), storj.Cipher(c.Enc.DataType))
+	newStreamStore := streams.NewStreamStore
+	if c.Enc.Deterministic {
+		newStreamStore = streams.NewDeterministicStreamStore
+	}
+	streams, err := newStreamStore(segments, c.Client.SegmentSize.Int64(), key, c.Enc.BlockSize.Int(), storj.Cipher(c.Enc.DataType))
 	if err != nil {
 		return nil, nil, Error.New("failed to create stream store: %v", err)
 	}
@@ -5,6 +5,7 @@ package miniogw
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"io"
 	"strings"
@@ -369,7 +370,10 @@ func (layer *gatewayLayer) putObject(ctx context.Context, bucket, object string,
 		return minio.ObjectInfo{}, convertError(err, bucket, object)
 	}
 
-	err = upload(ctx, layer.gateway.streams, mutableObject, reader)
+	// the ETag is the same SHA-256 of the content which the stream store keeps
+	// as the checksum of the object, computing it here avoids fetching it again
+	checksum := sha256.New()
+	err = upload(ctx, layer.gateway.streams, mutableObject, io.TeeReader(reader, checksum))
 	if err != nil {
 		return minio.ObjectInfo{}, err
 	}
@@ -386,7 +390,7 @@ func (layer *gatewayLayer) putObject(ctx context.Context, bucket, object string,
 		Bucket:      bucket,
 		ModTime:     info.Modified,
 		Size:        info.Size,
-		ETag:        hex.EncodeToString(info.Checksum),
+		ETag:        hex.EncodeToString(checksum.Sum(nil)),
 		ContentType: info.ContentType,
 		UserDefined: info.Metadata,
 	}, nil
@@ -447,5 +451,10 @@ func convertError(err error, bucket, object string) error {
 		return minio.ObjectNotFound{Bucket: bucket, Object: object}
 	}
 
+	// the gateway's minio version has no object lock api, so locked objects are reported as access denied
+	if storj.ErrObjectLocked.Has(err) {
+		return minio.PrefixAccessDenied{Bucket: bucket, Object: object}
+	}
+
 	return err
 }
@@ -200,7 +200,7 @@ func TestPutObject(t *testing.T) {
 			assert.False(t, info.IsDir)
 			assert.True(t, time.Since(info.ModTime) < 1*time.Second)
 			assert.Equal(t, data.Size(), info.Size)
-			// assert.Equal(t, data.SHA256HexString(), info.ETag) TODO: when we start calculating checksums
+			assert.Equal(t, data.SHA256HexString(), info.ETag)
 			assert.Equal(t, serMetaInfo.ContentType, info.ContentType)
 			assert.Equal(t, serMetaInfo.UserDefined, info.UserDefined)
 		}
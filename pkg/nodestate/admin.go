@@ -0,0 +1,100 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestate
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/storj"
+)
+
+// eventRequest is the body of a request for recording an operator decision
+type eventRequest struct {
+	Kind   Kind   `json:"kind"`
+	Reason string `json:"reason"`
+}
+
+// ServeHTTP implements the node state admin api:
+//
+//	GET  /nodes/<id>          returns the state of the node
+//	POST /nodes/<id>/events   records a suspension, reinstatement,
+//	                          disqualification or exit, see eventRequest
+func (service *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] != "nodes" ||
+		(len(parts) == 3 && parts[2] != "events") {
+		http.NotFound(w, r)
+		return
+	}
+
+	nodeID, err := storj.NodeIDFromString(parts[1])
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	if len(parts) == 2 {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		state, err := service.State(ctx, nodeID)
+		if err != nil {
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+		writeJSON(w, http.StatusOK, state)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var request eventRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// only the decisions about known nodes are recorded
+	if _, err := service.Get(ctx, nodeID); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	if err := service.Record(ctx, nodeID, request.Kind, request.Reason); err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	state, err := service.State(ctx, nodeID)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	writeJSON(w, http.StatusCreated, state)
+}
+
+// errorStatus returns the http status code for err
+func errorStatus(err error) int {
+	switch {
+	case ErrInvalidEvent.Has(err):
+		return http.StatusBadRequest
+	case statdb.ErrNodeNotFound.Has(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestate
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/storj"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the nodestate package
+	Error = errs.Class("nodestate error")
+	// ErrInvalidEvent is returned when an event can't be recorded by an operator
+	ErrInvalidEvent = errs.Class("invalid node event")
+)
+
+// Kind is the kind of a state change of a node
+type Kind int
+
+const (
+	// KindJoined is recorded when the satellite first learns about a node
+	KindJoined Kind = 1
+	// KindVetted is recorded when a node completed enough audits to be trusted
+	KindVetted Kind = 2
+	// KindOffline is recorded when a node failed an uptime check after being online
+	KindOffline Kind = 3
+	// KindOnline is recorded when an offline node passed an uptime check
+	KindOnline Kind = 4
+	// KindSuspended is recorded when an operator suspends a node
+	KindSuspended Kind = 5
+	// KindReinstated is recorded when an operator lifts the suspension or the
+	// disqualification of a node
+	KindReinstated Kind = 6
+	// KindDisqualified is recorded when a node is disqualified, it stays
+	// disqualified until an operator reinstates it
+	KindDisqualified Kind = 7
+	// KindExited is recorded when a node left the network
+	KindExited Kind = 8
+)
+
+// String returns the name of the kind
+func (kind Kind) String() string {
+	switch kind {
+	case KindJoined:
+		return "joined"
+	case KindVetted:
+		return "vetted"
+	case KindOffline:
+		return "offline"
+	case KindOnline:
+		return "online"
+	case KindSuspended:
+		return "suspended"
+	case KindReinstated:
+		return "reinstated"
+	case KindDisqualified:
+		return "disqualified"
+	case KindExited:
+		return "exited"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText encodes the kind by its name
+func (kind Kind) MarshalText() ([]byte, error) {
+	return []byte(kind.String()), nil
+}
+
+// UnmarshalText decodes the kind from its name
+func (kind *Kind) UnmarshalText(text []byte) error {
+	for candidate := KindJoined; candidate <= KindExited; candidate++ {
+		if candidate.String() == string(text) {
+			*kind = candidate
+			return nil
+		}
+	}
+	return ErrInvalidEvent.New("unknown kind %q", text)
+}
+
+// Event is a state change of a node
+type Event struct {
+	ID        int64        `json:"id"`
+	NodeID    storj.NodeID `json:"node_id"`
+	Kind      Kind         `json:"kind"`
+	Reason    string       `json:"reason"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// DB is the append-only log of node events
+type DB interface {
+	// Append adds an event to the log
+	Append(ctx context.Context, event Event) error
+	// List returns the events of a node, oldest first
+	List(ctx context.Context, nodeID storj.NodeID) ([]Event, error)
+	// Last returns the most recent event of a node with one of the given kinds, nil when there is none
+	Last(ctx context.Context, nodeID storj.NodeID, kinds ...Kind) (*Event, error)
+	// ListAfter returns up to limit events of all nodes with an ID greater than afterID, oldest first
+	ListAfter(ctx context.Context, afterID int64, limit int) ([]Event, error)
+	// LastID returns the ID of the most recent event of all nodes, 0 when there is none
+	LastID(ctx context.Context) (int64, error)
+}
+
+// State is the combined view of a node: its overlay information, its
+// statistics and the state derived from its events
+type State struct {
+	Node  *pb.Node          `json:"node"`
+	Stats *statdb.NodeStats `json:"stats"`
+
+	Vetted       bool `json:"vetted"`
+	Online       bool `json:"online"`
+	Suspended    bool `json:"suspended"`
+	Disqualified bool `json:"disqualified"`
+	Exited       bool `json:"exited"`
+
+	Events []Event `json:"events"`
+}
+
+// fold derives the state of a node from its events, oldest first
+func (state *State) fold(events []Event) {
+	state.Online = true
+	for _, event := range events {
+		switch event.Kind {
+		case KindVetted:
+			state.Vetted = true
+		case KindOffline:
+			state.Online = false
+		case KindOnline:
+			state.Online = true
+		case KindSuspended:
+			state.Suspended = true
+		case KindReinstated:
+			state.Suspended = false
+			state.Disqualified = false
+		case KindDisqualified:
+			state.Disqualified = true
+		case KindExited:
+			state.Exited = true
+		}
+	}
+	state.Events = events
+}
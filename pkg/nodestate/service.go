@@ -0,0 +1,430 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestate
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/storj"
+)
+
+// Config configures the node state service
+type Config struct {
+	Webhook          string        `help:"url receiving node state changes as JSON POST requests, disabled when empty" default:""`
+	WebhookInterval  time.Duration `help:"how often new node state changes are pushed to the webhook" default:"10s"`
+	Reputation       statdb.ReputationConfig
+	Disqualification statdb.DisqualificationConfig
+}
+
+// Service records the state changes of nodes in an append-only log and
+// reads the state of a node from the overlay cache, the node statistics and
+// the log together.
+//
+// The overlay cache and the node statistics stay separate stores, Service
+// doesn't replace them. It implements statdb.DB as a decorator of the node
+// statistics, so it can be passed to every component updating node
+// statistics, which then records the resulting state changes without knowing
+// about them.
+type Service struct {
+	log     *zap.Logger
+	overlay overlay.DB
+	stats   statdb.DB
+	events  DB
+	config  Config
+
+	Webhook *Webhook
+
+	// vettedAuditCount is the number of audits a node needs to be vetted
+	vettedAuditCount int64
+	// disqualification is nil when nodes aren't disqualified automatically
+	disqualification *statdb.DisqualificationCriteria
+
+	mu sync.Mutex
+	// offline caches whether the last uptime event of a node is offline
+	offline map[storj.NodeID]bool
+}
+
+var _ statdb.DB = (*Service)(nil)
+
+// NewService creates a new node state service
+func NewService(log *zap.Logger, overlayDB overlay.DB, statDB statdb.DB, events DB, vettedAuditCount int64, config Config) *Service {
+	return &Service{
+		log:              log,
+		overlay:          overlayDB,
+		stats:            statDB,
+		events:           events,
+		config:           config,
+		vettedAuditCount: vettedAuditCount,
+		disqualification: config.Disqualification.Criteria(),
+		Webhook:          NewWebhook(log.Named("webhook"), events, config),
+		offline:          make(map[storj.NodeID]bool),
+	}
+}
+
+// State returns the combined state of a node, the overlay information is nil
+// when the node isn't in the overlay cache
+func (service *Service) State(ctx context.Context, nodeID storj.NodeID) (_ *State, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state := &State{}
+
+	state.Node, err = service.overlay.Get(ctx, nodeID)
+	if err != nil && err != overlay.ErrNodeNotFound {
+		return nil, Error.Wrap(err)
+	}
+
+	state.Stats, err = service.stats.Get(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	events, err := service.events.List(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	state.fold(events)
+
+	return state, nil
+}
+
+// Events returns up to limit events of all nodes with an ID greater than
+// afterID, oldest first, so consumers can follow the log
+func (service *Service) Events(ctx context.Context, afterID int64, limit int) (_ []Event, err error) {
+	defer mon.Task()(&ctx)(&err)
+	events, err := service.events.ListAfter(ctx, afterID, limit)
+	return events, Error.Wrap(err)
+}
+
+// Record records a state change decided by an operator, e.g. a suspension
+func (service *Service) Record(ctx context.Context, nodeID storj.NodeID, kind Kind, reason string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch kind {
+	case KindSuspended, KindReinstated, KindDisqualified, KindExited:
+	default:
+		return ErrInvalidEvent.New("%v events are recorded automatically", kind)
+	}
+	if nodeID.IsZero() {
+		return ErrInvalidEvent.Wrap(overlay.ErrEmptyNode)
+	}
+	if reason == "" {
+		return ErrInvalidEvent.New("reason is missing")
+	}
+
+	switch kind {
+	case KindDisqualified:
+		_, err = service.disqualify(ctx, nodeID, reason)
+		return err
+	case KindReinstated:
+		_, err = service.reinstate(ctx, nodeID, reason)
+		return err
+	}
+	return service.record(ctx, nodeID, kind, reason)
+}
+
+// record appends an event to the log and notifies the webhook
+func (service *Service) record(ctx context.Context, nodeID storj.NodeID, kind Kind, reason string) error {
+	event := Event{
+		NodeID:    nodeID,
+		Kind:      kind,
+		Reason:    reason,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := service.events.Append(ctx, event); err != nil {
+		return Error.Wrap(err)
+	}
+	mon.Meter("node_event_" + kind.String()).Mark(1)
+	service.log.Info("node state changed", zap.String("node", nodeID.String()), zap.Stringer("kind", kind), zap.String("reason", reason))
+
+	service.Webhook.Notify()
+	return nil
+}
+
+// recordUptime records when a node goes offline or comes back online. The
+// log is only queried the first time a node is updated, afterwards its
+// uptime state is taken from the cache.
+func (service *Service) recordUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) error {
+	service.mu.Lock()
+	wasOffline, cached := service.offline[nodeID]
+	service.mu.Unlock()
+
+	if !cached {
+		last, err := service.events.Last(ctx, nodeID, KindOffline, KindOnline)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		wasOffline = last != nil && last.Kind == KindOffline
+	}
+
+	// the state is changed before recording, so concurrent updates of the
+	// same node record the change only once
+	service.mu.Lock()
+	if current, ok := service.offline[nodeID]; ok {
+		wasOffline = current
+	}
+	service.offline[nodeID] = !isUp
+	service.mu.Unlock()
+
+	var err error
+	switch {
+	case !isUp && !wasOffline:
+		err = service.record(ctx, nodeID, KindOffline, "uptime check failed")
+	case isUp && wasOffline:
+		err = service.record(ctx, nodeID, KindOnline, "uptime check succeeded")
+	}
+	if err != nil {
+		// the next update reads the state from the log again
+		service.mu.Lock()
+		delete(service.offline, nodeID)
+		service.mu.Unlock()
+	}
+	return err
+}
+
+// recordVetted records when a node reaches the number of audits to be vetted,
+// the audit count increases by one with every update, so it's recorded once
+func (service *Service) recordVetted(ctx context.Context, stats *statdb.NodeStats) error {
+	if service.vettedAuditCount <= 0 || stats.AuditCount != service.vettedAuditCount {
+		return nil
+	}
+	return service.record(ctx, stats.NodeID, KindVetted, "audit threshold reached")
+}
+
+// disqualify marks the node as disqualified and records it, when it hasn't
+// been disqualified before
+func (service *Service) disqualify(ctx context.Context, nodeID storj.NodeID, reason string) (*statdb.NodeStats, error) {
+	// the database may store the time with a lower precision
+	start := time.Now().UTC().Truncate(time.Microsecond)
+	stats, err := service.stats.Disqualify(ctx, nodeID, reason)
+	if err != nil {
+		return nil, err
+	}
+	// nodes disqualified earlier have been disqualified before the start
+	if stats.Disqualified && !stats.DisqualifiedAt.Before(start) {
+		return stats, service.record(ctx, nodeID, KindDisqualified, reason)
+	}
+	return stats, nil
+}
+
+// reinstate lifts the disqualification of the node and records it
+func (service *Service) reinstate(ctx context.Context, nodeID storj.NodeID, reason string) (*statdb.NodeStats, error) {
+	stats, err := service.stats.Reinstate(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	return stats, service.record(ctx, nodeID, KindReinstated, reason)
+}
+
+// checkDisqualification disqualifies the node when disqualification is
+// enabled and its stats fall below the criteria
+func (service *Service) checkDisqualification(ctx context.Context, stats *statdb.NodeStats) error {
+	if service.disqualification == nil || stats.Disqualified {
+		return nil
+	}
+	reason := service.disqualification.Violated(stats)
+	if reason == "" {
+		return nil
+	}
+	_, err := service.disqualify(ctx, stats.NodeID, reason)
+	return err
+}
+
+// Create adds a new stats entry for node and records that it joined.
+func (service *Service) Create(ctx context.Context, nodeID storj.NodeID, initial *statdb.NodeStats) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats, err = service.stats.Create(ctx, nodeID, initial)
+	if err != nil {
+		return nil, err
+	}
+	return stats, service.record(ctx, nodeID, KindJoined, "created")
+}
+
+// Get returns node stats.
+func (service *Service) Get(ctx context.Context, nodeID storj.NodeID) (stats *statdb.NodeStats, err error) {
+	return service.stats.Get(ctx, nodeID)
+}
+
+// FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements.
+func (service *Service) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *statdb.NodeStats) (invalid storj.NodeIDList, err error) {
+	return service.stats.FindInvalidNodes(ctx, nodeIDs, maxStats)
+}
+
+// Update all parts of single storagenode's stats and records the resulting state changes.
+func (service *Service) Update(ctx context.Context, request *statdb.UpdateRequest) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats, err = service.stats.Update(ctx, service.weighted(request))
+	if err != nil {
+		return nil, err
+	}
+	service.afterUpdate(ctx, request, stats)
+	return stats, nil
+}
+
+// UpdateUptime updates a single storagenode's uptime stats and records when it goes offline or comes back.
+func (service *Service) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats, err = service.stats.Update(ctx, service.weighted(&statdb.UpdateRequest{
+		NodeID:     nodeID,
+		IsUp:       isUp,
+		UptimeOnly: true,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if err := service.recordUptime(ctx, nodeID, isUp); err != nil {
+		service.log.Warn("could not record uptime change", zap.String("node", nodeID.String()), zap.Error(err))
+	}
+	if err := service.checkDisqualification(ctx, stats); err != nil {
+		service.log.Warn("could not disqualify node", zap.String("node", nodeID.String()), zap.Error(err))
+	}
+	return stats, nil
+}
+
+// UpdateAuditSuccess updates a single storagenode's audit stats and records when it's vetted.
+func (service *Service) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats, err = service.stats.Update(ctx, service.weighted(&statdb.UpdateRequest{
+		NodeID:       nodeID,
+		AuditSuccess: auditSuccess,
+		AuditOnly:    true,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if err := service.recordVetted(ctx, stats); err != nil {
+		service.log.Warn("could not record vetting", zap.String("node", nodeID.String()), zap.Error(err))
+	}
+	if err := service.checkDisqualification(ctx, stats); err != nil {
+		service.log.Warn("could not disqualify node", zap.String("node", nodeID.String()), zap.Error(err))
+	}
+	return stats, nil
+}
+
+// UpdateBatch for updating multiple storage nodes' stats and records the resulting state changes.
+func (service *Service) UpdateBatch(ctx context.Context, requests []*statdb.UpdateRequest) (statslist []*statdb.NodeStats, failed []*statdb.UpdateRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	weighted := make([]*statdb.UpdateRequest, len(requests))
+	original := make(map[*statdb.UpdateRequest]*statdb.UpdateRequest, len(requests))
+	for i, request := range requests {
+		weighted[i] = service.weighted(request)
+		original[weighted[i]] = request
+	}
+
+	statslist, failed, err = service.stats.UpdateBatch(ctx, weighted)
+	for i, request := range failed {
+		if request, ok := original[request]; ok {
+			failed[i] = request
+		}
+	}
+
+	updated := make(map[storj.NodeID]*statdb.NodeStats, len(statslist))
+	for _, stats := range statslist {
+		updated[stats.NodeID] = stats
+	}
+	for _, request := range requests {
+		if stats, ok := updated[request.NodeID]; ok {
+			service.afterUpdate(ctx, request, stats)
+		}
+	}
+
+	return statslist, failed, err
+}
+
+// CreateEntryIfNotExists creates a node stats entry if it didn't already exist and records that it joined.
+func (service *Service) CreateEntryIfNotExists(ctx context.Context, nodeID storj.NodeID) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stats, err = service.stats.Get(ctx, nodeID)
+	if err == nil {
+		return stats, nil
+	}
+
+	stats, err = service.stats.CreateEntryIfNotExists(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the lookup may have failed for another reason than a missing entry
+	joined, err := service.events.Last(ctx, nodeID, KindJoined)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if joined == nil {
+		return stats, service.record(ctx, nodeID, KindJoined, "first contact")
+	}
+	return stats, nil
+}
+
+// Vet marks the node as vetted when its stats meet the criteria and records when it's vetted.
+func (service *Service) Vet(ctx context.Context, nodeID storj.NodeID, criteria *statdb.VettingCriteria) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	start := time.Now().UTC()
+	stats, err = service.stats.Vet(ctx, nodeID, criteria)
+	if err != nil {
+		return nil, err
+	}
+	// nodes vetted earlier have been vetted before the start
+	if stats.Vetted && !stats.VettedAt.Before(start) {
+		if err := service.record(ctx, nodeID, KindVetted, "vetting criteria met"); err != nil {
+			service.log.Warn("could not record vetting", zap.String("node", nodeID.String()), zap.Error(err))
+		}
+	}
+	return stats, nil
+}
+
+// Disqualify marks the node as disqualified and records when it's disqualified.
+func (service *Service) Disqualify(ctx context.Context, nodeID storj.NodeID, reason string) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.disqualify(ctx, nodeID, reason)
+}
+
+// Reinstate lifts the disqualification of the node and records that it's reinstated.
+func (service *Service) Reinstate(ctx context.Context, nodeID storj.NodeID) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.reinstate(ctx, nodeID, "reinstated by an operator")
+}
+
+// ListDisqualified returns up to limit disqualified nodes, most recently disqualified first.
+func (service *Service) ListDisqualified(ctx context.Context, limit int) (statslist []*statdb.NodeStats, err error) {
+	return service.stats.ListDisqualified(ctx, limit)
+}
+
+// weighted returns a copy of the request with the forgetting factors of the
+// reputations, lambdas set by the caller are kept
+func (service *Service) weighted(request *statdb.UpdateRequest) *statdb.UpdateRequest {
+	weighted := *request
+	if weighted.AuditLambda == 0 {
+		weighted.AuditLambda = service.config.Reputation.AuditLambda
+	}
+	if weighted.UptimeLambda == 0 {
+		weighted.UptimeLambda = service.config.Reputation.UptimeLambda
+	}
+	return &weighted
+}
+
+// afterUpdate records the state changes caused by an update request, the
+// stats are already updated, so failures are only logged
+func (service *Service) afterUpdate(ctx context.Context, request *statdb.UpdateRequest, stats *statdb.NodeStats) {
+	if err := service.recordUptime(ctx, request.NodeID, request.IsUp); err != nil {
+		service.log.Warn("could not record uptime change", zap.String("node", request.NodeID.String()), zap.Error(err))
+	}
+	if err := service.recordVetted(ctx, stats); err != nil {
+		service.log.Warn("could not record vetting", zap.String("node", request.NodeID.String()), zap.Error(err))
+	}
+	if err := service.checkDisqualification(ctx, stats); err != nil {
+		service.log.Warn("could not disqualify node", zap.String("node", request.NodeID.String()), zap.Error(err))
+	}
+}
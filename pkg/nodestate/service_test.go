@@ -0,0 +1,277 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestate_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/nodestate"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestService(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		service := nodestate.NewService(zap.NewNop(), db.OverlayCache(), db.StatDB(), db.NodeEvents(), 2, nodestate.Config{})
+		nodeID := teststorj.NodeIDFromString("node")
+
+		kinds := func() []nodestate.Kind {
+			events, err := service.Events(ctx, 0, 100)
+			require.NoError(t, err)
+			var kinds []nodestate.Kind
+			for _, event := range events {
+				assert.Equal(t, nodeID, event.NodeID)
+				kinds = append(kinds, event.Kind)
+			}
+			return kinds
+		}
+
+		// a node joins only once
+		_, err := service.CreateEntryIfNotExists(ctx, nodeID)
+		require.NoError(t, err)
+		_, err = service.CreateEntryIfNotExists(ctx, nodeID)
+		require.NoError(t, err)
+		assert.Equal(t, []nodestate.Kind{nodestate.KindJoined}, kinds())
+
+		// only transitions between offline and online are recorded
+		for _, isUp := range []bool{true, false, false, true, true} {
+			_, err = service.UpdateUptime(ctx, nodeID, isUp)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []nodestate.Kind{nodestate.KindJoined, nodestate.KindOffline, nodestate.KindOnline}, kinds())
+
+		// a node is vetted once it reached the audit threshold
+		for i := 0; i < 3; i++ {
+			_, err = service.UpdateAuditSuccess(ctx, nodeID, true)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []nodestate.Kind{nodestate.KindJoined, nodestate.KindOffline, nodestate.KindOnline, nodestate.KindVetted}, kinds())
+
+		// only operator decisions can be recorded
+		assert.Error(t, service.Record(ctx, nodeID, nodestate.KindVetted, "manual"))
+		assert.Error(t, service.Record(ctx, nodeID, nodestate.KindSuspended, ""))
+		require.NoError(t, service.Record(ctx, nodeID, nodestate.KindSuspended, "investigating"))
+
+		state, err := service.State(ctx, nodeID)
+		require.NoError(t, err)
+		assert.Nil(t, state.Node)
+		assert.Equal(t, int64(3), state.Stats.AuditCount)
+		assert.True(t, state.Vetted)
+		assert.True(t, state.Online)
+		assert.True(t, state.Suspended)
+		assert.False(t, state.Disqualified)
+		assert.Len(t, state.Events, 5)
+
+		require.NoError(t, service.Record(ctx, nodeID, nodestate.KindReinstated, "resolved"))
+		_, err = service.UpdateUptime(ctx, nodeID, false)
+		require.NoError(t, err)
+
+		state, err = service.State(ctx, nodeID)
+		require.NoError(t, err)
+		assert.False(t, state.Suspended)
+		assert.False(t, state.Online)
+
+		// the log can be followed from the last seen event
+		events, err := service.Events(ctx, state.Events[4].ID, 100)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, nodestate.KindReinstated, events[0].Kind)
+		assert.Equal(t, nodestate.KindOffline, events[1].Kind)
+	})
+}
+
+func TestDisqualification(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		service := nodestate.NewService(zap.NewNop(), db.OverlayCache(), db.StatDB(), db.NodeEvents(), 0, nodestate.Config{
+			Disqualification: statdb.DisqualificationConfig{
+				Enabled:           true,
+				AuditCount:        2,
+				AuditSuccessRatio: 0.6,
+				UptimeCount:       100,
+				UptimeRatio:       0.6,
+			},
+		})
+		nodeID := teststorj.NodeIDFromString("node")
+
+		cache := overlay.NewCache(db.OverlayCache(), service)
+		require.NoError(t, cache.Put(ctx, nodeID, pb.Node{
+			Id:           nodeID,
+			Type:         pb.NodeType_STORAGE,
+			Address:      &pb.NodeAddress{Address: "127.0.0.1:7777"},
+			Restrictions: &pb.NodeRestrictions{},
+		}))
+		selected := func() bool {
+			nodes, err := db.OverlayCache().SelectNodes(ctx, 1, &overlay.NodeCriteria{Type: pb.NodeType_STORAGE})
+			require.NoError(t, err)
+			return len(nodes) == 1
+		}
+		assert.True(t, selected())
+
+		// the ratio isn't checked before the audit count is reached
+		stats, err := service.UpdateAuditSuccess(ctx, nodeID, false)
+		require.NoError(t, err)
+		assert.False(t, stats.Disqualified)
+
+		for i := 0; i < 2; i++ {
+			_, err = service.UpdateAuditSuccess(ctx, nodeID, false)
+			require.NoError(t, err)
+		}
+		state, err := service.State(ctx, nodeID)
+		require.NoError(t, err)
+		assert.True(t, state.Disqualified)
+		assert.True(t, state.Stats.Disqualified)
+		assert.Contains(t, state.Stats.DisqualificationReason, "audit success ratio")
+		assert.False(t, selected())
+
+		// the disqualification is recorded once
+		var disqualifications int
+		for _, event := range state.Events {
+			if event.Kind == nodestate.KindDisqualified {
+				disqualifications++
+			}
+		}
+		assert.Equal(t, 1, disqualifications)
+
+		disqualified, err := service.ListDisqualified(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, disqualified, 1)
+		assert.Equal(t, nodeID, disqualified[0].NodeID)
+
+		// operators can reinstate nodes
+		require.NoError(t, service.Record(ctx, nodeID, nodestate.KindReinstated, "disk replaced"))
+		state, err = service.State(ctx, nodeID)
+		require.NoError(t, err)
+		assert.False(t, state.Disqualified)
+		assert.False(t, state.Stats.Disqualified)
+		assert.True(t, selected())
+	})
+}
+
+func TestWebhook(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		pushed := make(chan nodestate.Kind, 10)
+		webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var event struct {
+				Kind string `json:"kind"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			for kind := nodestate.KindJoined; kind <= nodestate.KindExited; kind++ {
+				if kind.String() == event.Kind {
+					pushed <- kind
+				}
+			}
+		}))
+		defer webhook.Close()
+
+		nodeID := teststorj.NodeIDFromString("node")
+		service := nodestate.NewService(zap.NewNop(), db.OverlayCache(), db.StatDB(), db.NodeEvents(), 0, nodestate.Config{
+			Webhook:         webhook.URL,
+			WebhookInterval: time.Hour,
+		})
+
+		// the events recorded before the webhook started aren't pushed
+		_, err := service.CreateEntryIfNotExists(ctx, nodeID)
+		require.NoError(t, err)
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		ctx.Go(func() error {
+			err := service.Webhook.Run(runCtx)
+			if err == context.Canceled {
+				return nil
+			}
+			return err
+		})
+		// the first run starts right away
+		for service.Webhook.Chore.Status().LastRun.IsZero() {
+			time.Sleep(time.Millisecond)
+		}
+
+		// the webhook is notified about new events, without waiting for the interval
+		for _, kind := range []nodestate.Kind{nodestate.KindSuspended, nodestate.KindExited} {
+			require.NoError(t, service.Record(ctx, nodeID, kind, "test"))
+			select {
+			case event := <-pushed:
+				assert.Equal(t, kind, event)
+			case <-time.After(10 * time.Second):
+				t.Fatal("event wasn't pushed")
+			}
+		}
+		assert.Len(t, pushed, 0)
+	})
+}
+
+func TestAdmin(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		service := nodestate.NewService(zap.NewNop(), db.OverlayCache(), db.StatDB(), db.NodeEvents(), 0, nodestate.Config{})
+		nodeID := teststorj.NodeIDFromString("node")
+
+		request := func(method, path, body string) (int, nodestate.State) {
+			recorder := httptest.NewRecorder()
+			service.ServeHTTP(recorder, httptest.NewRequest(method, path, strings.NewReader(body)))
+			var state nodestate.State
+			if recorder.Code/100 == 2 {
+				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&state))
+			}
+			return recorder.Code, state
+		}
+
+		// decisions about unknown nodes aren't recorded
+		code, _ := request(http.MethodPost, "/nodes/"+nodeID.String()+"/events", `{"kind": "suspended", "reason": "investigating"}`)
+		assert.Equal(t, http.StatusNotFound, code)
+
+		_, err := service.CreateEntryIfNotExists(ctx, nodeID)
+		require.NoError(t, err)
+
+		code, _ = request(http.MethodPost, "/nodes/"+nodeID.String()+"/events", `{"kind": "vetted", "reason": "manual"}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		code, _ = request(http.MethodPost, "/nodes/"+nodeID.String()+"/events", `{"kind": "exited"}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		for _, kind := range []string{"suspended", "disqualified", "exited"} {
+			code, _ = request(http.MethodPost, "/nodes/"+nodeID.String()+"/events", `{"kind": "`+kind+`", "reason": "operator"}`)
+			assert.Equal(t, http.StatusCreated, code, kind)
+		}
+
+		code, state := request(http.MethodGet, "/nodes/"+nodeID.String(), "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.True(t, state.Suspended)
+		assert.True(t, state.Disqualified)
+		assert.True(t, state.Exited)
+		assert.True(t, state.Stats.Disqualified)
+
+		code, _ = request(http.MethodGet, "/nodes/unknown", "")
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodestate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/chore"
+)
+
+// webhookBatch is the number of events read from the log at once
+const webhookBatch = 100
+
+// Webhook follows the event log and pushes the events to a webhook, so the
+// updates recording the events never wait for the webhook.
+//
+// It starts with the events recorded after it started. An event, which can't
+// be pushed, is retried with the next run, so the events are pushed in order.
+type Webhook struct {
+	log    *zap.Logger
+	events DB
+	url    string
+	client *http.Client
+
+	// lastID is the ID of the last pushed event
+	lastID int64
+
+	Chore *chore.Chore
+}
+
+// NewWebhook creates a webhook pushing the events of the log to the webhook of the config
+func NewWebhook(log *zap.Logger, events DB, config Config) *Webhook {
+	webhook := &Webhook{
+		log:    log,
+		events: events,
+		url:    config.Webhook,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	webhook.Chore = chore.New(log, "node event webhook", config.WebhookInterval, webhook.push)
+	return webhook
+}
+
+// Run pushes the new events every interval, until the context is canceled
+func (webhook *Webhook) Run(ctx context.Context) (err error) {
+	if webhook.url == "" {
+		return nil
+	}
+
+	webhook.lastID, err = webhook.events.LastID(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return webhook.Chore.Run(ctx)
+}
+
+// Notify pushes the new events as soon as possible
+func (webhook *Webhook) Notify() {
+	if webhook.url != "" {
+		webhook.Chore.Trigger()
+	}
+}
+
+// push pushes the events recorded since the last push
+func (webhook *Webhook) push(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		events, err := webhook.events.ListAfter(ctx, webhook.lastID, webhookBatch)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, event := range events {
+			if err := webhook.pushEvent(ctx, event); err != nil {
+				return Error.Wrap(err)
+			}
+			webhook.lastID = event.ID
+		}
+
+		if len(events) < webhookBatch {
+			return nil
+		}
+	}
+}
+
+// pushEvent posts the event as JSON to the webhook
+func (webhook *Webhook) pushEvent(ctx context.Context, event Event) (err error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, webhook.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhook.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode/100 != 2 {
+		return errs.New("node event webhook responded with %s", resp.Status)
+	}
+	return nil
+}
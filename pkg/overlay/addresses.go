@@ -0,0 +1,162 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+// AddressConfig configures the verification of the advertised addresses of nodes
+type AddressConfig struct {
+	Interval  time.Duration `help:"how often to verify that nodes answer at their advertised addresses, 0 disables the verification" default:"1h"`
+	BatchSize int           `help:"maximum number of nodes verified per run" default:"100"`
+	Timeout   time.Duration `help:"how long to wait for a node to answer at its address" default:"10s"`
+}
+
+// AddressMismatch is a node, whose advertised address was answered by a node
+// with a different identity
+type AddressMismatch struct {
+	NodeID     storj.NodeID
+	Address    string
+	AnsweredID storj.NodeID
+	DetectedAt time.Time
+}
+
+// AddressVerifier dials nodes at their advertised addresses and flags the
+// nodes, whose address is answered by a different identity. Flagged nodes
+// aren't selected for uploads while they advertise the flagged address, so a
+// hijacked address can't receive the pieces meant for the node.
+type AddressVerifier struct {
+	log       *zap.Logger
+	db        DB
+	transport transport.Client
+	config    AddressConfig
+
+	// offset is the position of the next batch in the overlay
+	offset int64
+
+	Chore *chore.Chore
+}
+
+// NewAddressVerifier creates a new address verifier
+func NewAddressVerifier(log *zap.Logger, db DB, transport transport.Client, config AddressConfig) *AddressVerifier {
+	verifier := &AddressVerifier{
+		log:       log,
+		db:        db,
+		transport: transport,
+		config:    config,
+	}
+	verifier.Chore = chore.New(log, "overlay:addresses", config.Interval, verifier.Verify)
+	return verifier
+}
+
+// Run verifies a batch of addresses every interval
+func (verifier *AddressVerifier) Run(ctx context.Context) error {
+	if verifier.config.Interval <= 0 {
+		return nil
+	}
+	return verifier.Chore.Run(ctx)
+}
+
+// Verify verifies the addresses of the next batch of nodes. Nodes, which
+// can't be reached, are left to the uptime checks and keep their flags.
+func (verifier *AddressVerifier) Verify(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodes, more, err := verifier.db.Paginate(ctx, verifier.offset, verifier.config.BatchSize)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if more {
+		verifier.offset += int64(len(nodes))
+	} else {
+		verifier.offset = 0
+	}
+
+	for _, node := range nodes {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		address := node.GetAddress().GetAddress()
+		if address == "" {
+			continue
+		}
+
+		answeredID, err := verifier.fetchID(ctx, address)
+		if err != nil {
+			verifier.log.Debug("could not verify node address", zap.String("Node ID", node.Id.String()), zap.String("address", address), zap.Error(err))
+			mon.Meter("address_verification_failures").Mark(1)
+			continue
+		}
+
+		if answeredID == node.Id {
+			if err := verifier.db.ClearAddressMismatch(ctx, node.Id); err != nil {
+				return Error.Wrap(err)
+			}
+			continue
+		}
+
+		verifier.log.Warn("node address answered by a different node",
+			zap.String("Node ID", node.Id.String()), zap.String("address", address),
+			zap.String("answered by", answeredID.String()))
+		mon.Meter("address_mismatches").Mark(1)
+
+		err = verifier.db.FlagAddressMismatch(ctx, AddressMismatch{
+			NodeID:     node.Id,
+			Address:    address,
+			AnsweredID: answeredID,
+			DetectedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// fetchID returns the id of the node answering at the address. The identity
+// isn't pinned when dialing, so the answering identity can be compared with
+// the advertised one.
+func (verifier *AddressVerifier) fetchID(ctx context.Context, address string) (_ storj.NodeID, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if verifier.config.Timeout > 0 {
+		var cancel func()
+		ctx, cancel = context.WithTimeout(ctx, verifier.config.Timeout)
+		defer cancel()
+	}
+
+	conn, err := verifier.transport.DialAddress(ctx, address)
+	if err != nil {
+		return storj.NodeID{}, err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	p := &peer.Peer{}
+	_, err = pb.NewNodesClient(conn).Ping(ctx, &pb.PingRequest{}, grpc.Peer(p))
+	if err != nil {
+		return storj.NodeID{}, err
+	}
+
+	pi, err := identity.PeerIdentityFromPeer(p)
+	if err != nil {
+		return storj.NodeID{}, err
+	}
+	return pi.ID, nil
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAddressMismatches(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+
+		nodeID := teststorj.NodeIDFromString("node")
+		answeredID := teststorj.NodeIDFromString("answered")
+		require.NoError(t, cache.Put(ctx, nodeID, pb.Node{
+			Id:           nodeID,
+			Type:         pb.NodeType_STORAGE,
+			Address:      &pb.NodeAddress{Address: "127.0.0.1:7777"},
+			Restrictions: &pb.NodeRestrictions{},
+		}))
+
+		selected := func() bool {
+			nodes, err := db.OverlayCache().SelectNodes(ctx, 1, &overlay.NodeCriteria{Type: pb.NodeType_STORAGE})
+			require.NoError(t, err)
+			return len(nodes) == 1
+		}
+		assert.True(t, selected())
+
+		// flags of other addresses don't matter
+		require.NoError(t, db.OverlayCache().FlagAddressMismatch(ctx, overlay.AddressMismatch{
+			NodeID:     nodeID,
+			Address:    "127.0.0.1:8888",
+			AnsweredID: answeredID,
+			DetectedAt: time.Now(),
+		}))
+		assert.True(t, selected())
+
+		// flagging again replaces the flag
+		require.NoError(t, db.OverlayCache().FlagAddressMismatch(ctx, overlay.AddressMismatch{
+			NodeID:     nodeID,
+			Address:    "127.0.0.1:7777",
+			AnsweredID: answeredID,
+			DetectedAt: time.Now(),
+		}))
+		assert.False(t, selected())
+
+		mismatches, err := db.OverlayCache().ListAddressMismatches(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, mismatches, 1)
+		assert.Equal(t, nodeID, mismatches[0].NodeID)
+		assert.Equal(t, "127.0.0.1:7777", mismatches[0].Address)
+		assert.Equal(t, answeredID, mismatches[0].AnsweredID)
+
+		require.NoError(t, db.OverlayCache().ClearAddressMismatch(ctx, nodeID))
+		assert.True(t, selected())
+
+		// deleting a node removes its flag
+		require.NoError(t, db.OverlayCache().FlagAddressMismatch(ctx, overlay.AddressMismatch{
+			NodeID:     nodeID,
+			Address:    "127.0.0.1:7777",
+			AnsweredID: answeredID,
+			DetectedAt: time.Now(),
+		}))
+		require.NoError(t, cache.Delete(ctx, nodeID))
+
+		mismatches, err = db.OverlayCache().ListAddressMismatches(ctx, 10)
+		require.NoError(t, err)
+		assert.Len(t, mismatches, 0)
+	})
+}
+
+// hijackedDB returns the addresses of other nodes for the hijacked nodes
+type hijackedDB struct {
+	overlay.DB
+	hijacked map[storj.NodeID]string
+}
+
+func (db *hijackedDB) Paginate(ctx context.Context, offset int64, limit int) ([]*pb.Node, bool, error) {
+	nodes, more, err := db.DB.Paginate(ctx, offset, limit)
+	for _, node := range nodes {
+		if address, ok := db.hijacked[node.Id]; ok {
+			node.Address = &pb.NodeAddress{Address: address}
+		}
+	}
+	return nodes, more, err
+}
+
+func TestAddressVerifier(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		// we wait a second for all the nodes to complete bootstrapping off the satellite
+		time.Sleep(2 * time.Second)
+
+		satellite := planet.Satellites[0]
+		victim, hijacker := planet.StorageNodes[0], planet.StorageNodes[1]
+
+		db := &hijackedDB{
+			DB:       satellite.DB.OverlayCache(),
+			hijacked: map[storj.NodeID]string{victim.ID(): hijacker.Addr()},
+		}
+		verifier := overlay.NewAddressVerifier(zaptest.NewLogger(t), db, satellite.Transport, overlay.AddressConfig{
+			BatchSize: 100,
+			Timeout:   10 * time.Second,
+		})
+		require.NoError(t, verifier.Verify(ctx))
+
+		mismatches, err := satellite.DB.OverlayCache().ListAddressMismatches(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, mismatches, 1)
+		assert.Equal(t, victim.ID(), mismatches[0].NodeID)
+		assert.Equal(t, hijacker.Addr(), mismatches[0].Address)
+		assert.Equal(t, hijacker.ID(), mismatches[0].AnsweredID)
+
+		// answering at the address again clears the flag
+		require.NoError(t, satellite.Overlay.Addresses.Verify(ctx))
+
+		mismatches, err = satellite.DB.OverlayCache().ListAddressMismatches(ctx, 10)
+		require.NoError(t, err)
+		assert.Len(t, mismatches, 0)
+	})
+}
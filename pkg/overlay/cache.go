@@ -6,6 +6,7 @@ package overlay
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -51,18 +52,58 @@ type DB interface {
 	List(ctx context.Context, cursor storj.NodeID, limit int) ([]*pb.Node, error)
 	// Paginate will page through the database nodes
 	Paginate(ctx context.Context, offset int64, limit int) ([]*pb.Node, bool, error)
+	// QueryNodes returns the nodes matching the query and whether there are more of them
+	QueryNodes(ctx context.Context, query NodeQuery) ([]*pb.Node, bool, error)
 	// Update updates node information
 	Update(ctx context.Context, value *pb.Node) error
+	// UpdateAll updates the information of multiple nodes in a single transaction
+	UpdateAll(ctx context.Context, values []*pb.Node) error
 	// Delete deletes node based on id
 	Delete(ctx context.Context, id storj.NodeID) error
 	// GetWalletAddress gets the node's wallet address
 	GetWalletAddress(ctx context.Context, id storj.NodeID) (string, error)
+	// GetByWallet returns the nodes paid to the wallet address
+	GetByWallet(ctx context.Context, wallet string) ([]*pb.Node, error)
+	// GetMissingWallet returns up to limit storage nodes without a wallet
+	// address ordered by id, starting after cursor
+	GetMissingWallet(ctx context.Context, cursor storj.NodeID, limit int) ([]*pb.Node, error)
+	// UpdateThroughput stores the recent throughput reported by the node
+	UpdateThroughput(ctx context.Context, id storj.NodeID, throughput *pb.NodeThroughput) error
+	// UpdateMetadataValidation records when the operator's email and wallet
+	// were last found valid, zero times keep the stored ones
+	UpdateMetadataValidation(ctx context.Context, id storj.NodeID, validation MetadataValidation) error
+	// GetMetadataValidation returns when the operator's email and wallet were last found valid
+	GetMetadataValidation(ctx context.Context, id storj.NodeID) (MetadataValidation, error)
+	// ListStray lists up to limit storage nodes with less than auditThreshold audits,
+	// which haven't been updated since lastSeenBefore
+	ListStray(ctx context.Context, auditThreshold int64, lastSeenBefore time.Time, limit int) (storj.NodeIDList, error)
+
+	// UpdateTag stores a signed tag of a node, replacing an earlier tag with the same name
+	UpdateTag(ctx context.Context, tag *pb.NodeTag) error
+	// GetTags returns the signed tags of a node
+	GetTags(ctx context.Context, nodeID storj.NodeID) ([]*pb.NodeTag, error)
+
+	// FlagAddressMismatch flags the address of a node as answered by a different
+	// node, nodes aren't selected while they advertise a flagged address
+	FlagAddressMismatch(ctx context.Context, mismatch AddressMismatch) error
+	// ClearAddressMismatch removes the flag of a node
+	ClearAddressMismatch(ctx context.Context, id storj.NodeID) error
+	// ListAddressMismatches returns up to limit flagged nodes, the most recently detected first
+	ListAddressMismatches(ctx context.Context, limit int) ([]AddressMismatch, error)
 }
 
 // Cache is used to store overlay data in Redis
 type Cache struct {
 	db     DB
 	statDB statdb.DB
+
+	// GeoIP resolves the countries of nodes when they are stored, nodes have no country when it's nil
+	GeoIP GeoIP
+	// Vetting are the criteria new nodes have to meet to be vetted when they
+	// are stored, only vetted nodes are selected as reputable nodes unless it's nil
+	Vetting *statdb.VettingCriteria
+
+	watchers watchers
 }
 
 // NewCache returns a new Cache
@@ -107,6 +148,8 @@ func (cache *Cache) FindStorageNodes(ctx context.Context, req *pb.FindStorageNod
 	freeDisk := req.GetOpts().GetRestrictions().FreeDisk
 	excludedNodes := req.GetOpts().ExcludedNodes
 	requestedCount := int(req.GetOpts().GetAmount())
+	allowedCountries := NormalizeCountries(req.GetOpts().GetAllowedCountries())
+	excludedCountries := NormalizeCountries(req.GetOpts().GetExcludedCountries())
 
 	// TODO: verify logic
 
@@ -118,12 +161,17 @@ func (cache *Cache) FindStorageNodes(ctx context.Context, req *pb.FindStorageNod
 		reputableNodeCount = requestedCount
 	}
 
+	tags, err := ParseTags(preferences.RequiredTags)
+	if err != nil {
+		return nil, err
+	}
+
 	auditCount := preferences.AuditCount
 	if auditCount < preferences.NewNodeAuditThreshold {
 		auditCount = preferences.NewNodeAuditThreshold
 	}
 
-	reputableNodes, err := cache.db.SelectNodes(ctx, reputableNodeCount, &NodeCriteria{
+	reputableNodes, err := cache.SelectVettedNodes(ctx, reputableNodeCount, &NodeCriteria{
 		Type: pb.NodeType_STORAGE,
 
 		FreeBandwidth: freeBandwidth,
@@ -134,14 +182,35 @@ func (cache *Cache) FindStorageNodes(ctx context.Context, req *pb.FindStorageNod
 		UptimeCount:        preferences.UptimeCount,
 		UptimeSuccessRatio: preferences.UptimeRatio,
 
-		Excluded: excludedNodes,
+		UploadSuccessRatio: preferences.UploadSuccessRatio,
+
+		Tags: tags,
+
+		ExcludedIDs:      excludedNodes,
+		DistinctIPPrefix: preferences.DistinctIP,
+
+		AllowedCountries:  allowedCountries,
+		ExcludedCountries: excludedCountries,
+
+		OnlineWindow: preferences.OnlineWindow,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	newNodeCount := int64(float64(reputableNodeCount) * preferences.NewNodePercentage)
-	newNodes, err := cache.db.SelectNewNodes(ctx, int(newNodeCount), &NewNodeCriteria{
+	// new nodes mustn't share a subnet with the selected reputable nodes either
+	if preferences.DistinctIP {
+		excludedNodes = append(append([]storj.NodeID{}, excludedNodes...), nodeIDs(reputableNodes)...)
+	}
+
+	// uploaders may request fewer new nodes than the satellite allows
+	newNodeRatio := preferences.NewNodePercentage
+	if requested := req.GetOpts().GetNewNodeRatio(); requested != nil && requested.Value >= 0 && requested.Value < newNodeRatio {
+		newNodeRatio = requested.Value
+	}
+
+	newNodeCount := int64(float64(reputableNodeCount) * newNodeRatio)
+	newNodes, err := cache.SelectNewNodes(ctx, int(newNodeCount), &NewNodeCriteria{
 		Type: pb.NodeType_STORAGE,
 
 		FreeBandwidth: freeBandwidth,
@@ -149,7 +218,15 @@ func (cache *Cache) FindStorageNodes(ctx context.Context, req *pb.FindStorageNod
 
 		AuditThreshold: preferences.NewNodeAuditThreshold,
 
-		Excluded: excludedNodes,
+		Tags: tags,
+
+		ExcludedIDs:      excludedNodes,
+		DistinctIPPrefix: preferences.DistinctIP,
+
+		AllowedCountries:  allowedCountries,
+		ExcludedCountries: excludedCountries,
+
+		OnlineWindow: preferences.OnlineWindow,
 	})
 	if err != nil {
 		return nil, err
@@ -166,6 +243,44 @@ func (cache *Cache) FindStorageNodes(ctx context.Context, req *pb.FindStorageNod
 	return nodes, nil
 }
 
+// SelectVettedNodes selects reputable nodes matching the criteria, when
+// vetting is enabled only vetted nodes are selected
+func (cache *Cache) SelectVettedNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*pb.Node, error) {
+	vetted := *criteria
+	vetted.Vetted = cache.Vetting != nil
+	return cache.db.SelectNodes(ctx, count, &vetted)
+}
+
+// SelectNewNodes selects new nodes matching the criteria, when vetting is
+// enabled the nodes, which haven't been vetted yet, are selected instead of
+// the nodes below the audit threshold
+func (cache *Cache) SelectNewNodes(ctx context.Context, count int, criteria *NewNodeCriteria) ([]*pb.Node, error) {
+	unvetted := *criteria
+	unvetted.Unvetted = cache.Vetting != nil
+	return cache.db.SelectNewNodes(ctx, count, &unvetted)
+}
+
+// vet vets the node when vetting is enabled and the node meets the criteria,
+// but isn't vetted yet. Failures aren't returned, the node is vetted the next
+// time it's stored.
+func (cache *Cache) vet(ctx context.Context, stats *statdb.NodeStats) {
+	if cache.Vetting == nil || stats.Vetted || !cache.Vetting.Met(stats) {
+		return
+	}
+	if _, err := cache.statDB.Vet(ctx, stats.NodeID, cache.Vetting); err != nil {
+		mon.Meter("overlay_vetting_failed").Mark(1)
+	}
+}
+
+// nodeIDs returns the ids of the nodes
+func nodeIDs(nodes []*pb.Node) []storj.NodeID {
+	ids := make([]storj.NodeID, 0, len(nodes))
+	for _, node := range nodes {
+		ids = append(ids, node.Id)
+	}
+	return ids
+}
+
 // GetAll looks up the provided ids from the overlay cache
 func (cache *Cache) GetAll(ctx context.Context, ids storj.NodeIDList) ([]*pb.Node, error) {
 	if len(ids) == 0 {
@@ -191,6 +306,7 @@ func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node)
 	if err != nil {
 		return err
 	}
+	cache.vet(ctx, stats)
 
 	value.Reputation = &pb.NodeStats{
 		AuditSuccessRatio:  stats.AuditSuccessRatio,
@@ -200,8 +316,101 @@ func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node)
 		UptimeSuccessCount: stats.UptimeSuccessCount,
 		UptimeCount:        stats.UptimeCount,
 	}
+	value.CountryCode = cache.nodeCountry(ctx, value.GetAddress().GetAddress())
+	sanitizeMetadata(&value)
+
+	existing := cache.existing(ctx, storj.NodeIDList{nodeID})
+	if err := cache.db.Update(ctx, &value); err != nil {
+		return err
+	}
+	cache.emitStored([]*pb.Node{&value}, existing)
+	return nil
+}
+
+// PutAll adds or updates multiple nodes in the cache with a single batched write.
+// Nodes without an id are skipped like in Put. When the reputation of a node
+// can't be loaded only that node fails, the others are still stored; when the
+// batched write fails none of the nodes are stored. The ids of the failed nodes
+// are returned together with the combined errors.
+func (cache *Cache) PutAll(ctx context.Context, nodes []pb.Node) (failed storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the last information about a node wins
+	index := map[storj.NodeID]int{}
+	var values []*pb.Node
+	for i := range nodes {
+		value := nodes[i]
+		if value.Id.IsZero() {
+			continue
+		}
+		if at, ok := index[value.Id]; ok {
+			values[at] = &value
+			continue
+		}
+		index[value.Id] = len(values)
+		values = append(values, &value)
+	}
 
-	return cache.db.Update(ctx, &value)
+	var errlist errs.Group
+	valid := values[:0]
+	for _, value := range values {
+		// get existing node rep, or create a new statdb node with 0 rep
+		stats, err := cache.statDB.CreateEntryIfNotExists(ctx, value.Id)
+		if err != nil {
+			errlist.Add(err)
+			failed = append(failed, value.Id)
+			continue
+		}
+		cache.vet(ctx, stats)
+
+		value.Reputation = &pb.NodeStats{
+			AuditSuccessRatio:  stats.AuditSuccessRatio,
+			AuditSuccessCount:  stats.AuditSuccessCount,
+			AuditCount:         stats.AuditCount,
+			UptimeRatio:        stats.UptimeRatio,
+			UptimeSuccessCount: stats.UptimeSuccessCount,
+			UptimeCount:        stats.UptimeCount,
+		}
+		value.CountryCode = cache.nodeCountry(ctx, value.GetAddress().GetAddress())
+		sanitizeMetadata(value)
+		valid = append(valid, value)
+	}
+
+	existing := cache.existing(ctx, nodeIDs(valid))
+	if err := cache.db.UpdateAll(ctx, valid); err != nil {
+		errlist.Add(err)
+		for _, value := range valid {
+			failed = append(failed, value.Id)
+		}
+	} else {
+		cache.emitStored(valid, existing)
+	}
+
+	return failed, OverlayError.Wrap(errlist.Err())
+}
+
+// UpdateThroughput stores the recent throughput reported by the node
+func (cache *Cache) UpdateThroughput(ctx context.Context, id storj.NodeID, throughput *pb.NodeThroughput) error {
+	if id.IsZero() {
+		return ErrEmptyNode
+	}
+	return cache.db.UpdateThroughput(ctx, id, throughput)
+}
+
+// UpdateTag stores a signed tag of a node, the tag has to be verified by the caller
+func (cache *Cache) UpdateTag(ctx context.Context, tag *pb.NodeTag) error {
+	if tag.NodeId.IsZero() {
+		return ErrEmptyNode
+	}
+	return cache.db.UpdateTag(ctx, tag)
+}
+
+// GetTags returns the signed tags of a node
+func (cache *Cache) GetTags(ctx context.Context, nodeID storj.NodeID) ([]*pb.NodeTag, error) {
+	if nodeID.IsZero() {
+		return nil, ErrEmptyNode
+	}
+	return cache.db.GetTags(ctx, nodeID)
 }
 
 // Delete will remove the node from the cache. Used when a node hard disconnects or fails
@@ -210,7 +419,11 @@ func (cache *Cache) Delete(ctx context.Context, id storj.NodeID) error {
 	if id.IsZero() {
 		return ErrEmptyNode
 	}
-	return cache.db.Delete(ctx, id)
+	if err := cache.db.Delete(ctx, id); err != nil {
+		return err
+	}
+	cache.emit(NodeEvent{Type: NodeRemoved, ID: id})
+	return nil
 }
 
 // ConnFailure implements the Transport Observer `ConnFailure` function
@@ -5,10 +5,17 @@ package overlay_test
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/pkg/overlay"
@@ -141,3 +148,644 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB, sdb statdb.D
 		assert.True(t, err == overlay.ErrEmptyNode)
 	}
 }
+
+// failingStatDB fails to load the stats of a single node
+type failingStatDB struct {
+	statdb.DB
+	fail storj.NodeID
+}
+
+func (db *failingStatDB) CreateEntryIfNotExists(ctx context.Context, nodeID storj.NodeID) (*statdb.NodeStats, error) {
+	if nodeID == db.fail {
+		return nil, errs.New("stats unavailable")
+	}
+	return db.DB.CreateEntryIfNotExists(ctx, nodeID)
+}
+
+// failingOverlayDB fails every batched write
+type failingOverlayDB struct {
+	overlay.DB
+}
+
+func (db *failingOverlayDB) UpdateAll(ctx context.Context, values []*pb.Node) error {
+	return errs.New("database unavailable")
+}
+
+func TestCache_PutAll(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		randomID := func() storj.NodeID {
+			var id storj.NodeID
+			_, _ = rand.Read(id[:])
+			return id
+		}
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+
+		{ // insert more nodes than fit into a single statement
+			var nodes []pb.Node
+			for i := 0; i < 100; i++ {
+				node := pb.Node{Id: randomID()}
+				if i%2 == 0 {
+					node.Metadata = &pb.NodeMetadata{Wallet: fmt.Sprintf("0x%040x", i)}
+				}
+				if i%3 == 0 {
+					node.Restrictions = &pb.NodeRestrictions{FreeDisk: int64(i), FreeBandwidth: int64(i)}
+				}
+				nodes = append(nodes, node)
+			}
+			// bootstrap nodes are skipped and the last duplicate wins
+			duplicate := nodes[1]
+			duplicate.Address = &pb.NodeAddress{Address: "127.0.0.1:7777"}
+			nodes = append(nodes, pb.Node{}, duplicate)
+
+			failed, err := cache.PutAll(ctx, nodes)
+			require.NoError(t, err)
+			assert.Empty(t, failed)
+
+			for i, node := range nodes[:100] {
+				stored, err := cache.Get(ctx, node.Id)
+				require.NoError(t, err)
+				assert.Equal(t, node.Metadata.GetWallet(), stored.Metadata.GetWallet())
+				assert.Equal(t, node.Restrictions.GetFreeDisk(), stored.Restrictions.GetFreeDisk(), i)
+			}
+
+			stored, err := cache.Get(ctx, duplicate.Id)
+			require.NoError(t, err)
+			assert.Equal(t, "127.0.0.1:7777", stored.Address.GetAddress())
+
+			// updating without metadata and restrictions keeps the stored values
+			failed, err = cache.PutAll(ctx, []pb.Node{{Id: nodes[0].Id, Address: &pb.NodeAddress{Address: "127.0.0.1:8888"}}})
+			require.NoError(t, err)
+			assert.Empty(t, failed)
+
+			stored, err = cache.Get(ctx, nodes[0].Id)
+			require.NoError(t, err)
+			assert.Equal(t, "127.0.0.1:8888", stored.Address.GetAddress())
+			assert.Equal(t, nodes[0].Metadata.GetWallet(), stored.Metadata.GetWallet())
+			assert.Equal(t, int64(0), stored.Restrictions.GetFreeDisk())
+		}
+
+		{ // a node without stats fails alone
+			good, bad := randomID(), randomID()
+			partial := overlay.NewCache(db.OverlayCache(), &failingStatDB{DB: db.StatDB(), fail: bad})
+
+			failed, err := partial.PutAll(ctx, []pb.Node{{Id: good}, {Id: bad}})
+			assert.True(t, overlay.OverlayError.Has(err))
+			assert.Equal(t, storj.NodeIDList{bad}, failed)
+
+			_, err = cache.Get(ctx, good)
+			assert.NoError(t, err)
+			_, err = cache.Get(ctx, bad)
+			assert.True(t, err == overlay.ErrNodeNotFound)
+		}
+
+		{ // a failing write stores none of the nodes
+			first, second := randomID(), randomID()
+			broken := overlay.NewCache(&failingOverlayDB{DB: db.OverlayCache()}, db.StatDB())
+
+			failed, err := broken.PutAll(ctx, []pb.Node{{Id: first}, {Id: second}})
+			assert.True(t, overlay.OverlayError.Has(err))
+			assert.Equal(t, storj.NodeIDList{first, second}, failed)
+
+			for _, id := range failed {
+				_, err = cache.Get(ctx, id)
+				assert.True(t, err == overlay.ErrNodeNotFound)
+			}
+		}
+
+		{ // the database rejects the whole batch when a node is invalid
+			err := db.OverlayCache().UpdateAll(ctx, []*pb.Node{{Id: randomID()}, {}})
+			assert.True(t, err == overlay.ErrEmptyNode)
+		}
+	})
+}
+
+func TestCache_SelectNodesDistinctIP(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+
+		addresses := []string{
+			"10.0.0.1:7777", "10.0.0.2:7777", // same /24 subnet
+			"10.0.1.1:7777",
+			"10.0.2.1:7777",
+			"[2001:db8::1]:7777", "[2001:db8::2]:7777", // same /64 subnet
+		}
+		subnets := map[string]string{}
+		var ids []storj.NodeID
+		for _, address := range addresses {
+			var id storj.NodeID
+			_, _ = rand.Read(id[:])
+			ids = append(ids, id)
+			subnets[address] = address[:strings.LastIndex(address, ".")+1]
+			if strings.HasPrefix(address, "[") {
+				subnets[address] = "2001:db8::/64"
+			}
+
+			err := cache.Put(ctx, id, pb.Node{
+				Id:      id,
+				Type:    pb.NodeType_STORAGE,
+				Address: &pb.NodeAddress{Address: address},
+				Restrictions: &pb.NodeRestrictions{
+					FreeBandwidth: 1,
+					FreeDisk:      1,
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		selectSubnets := func(excluded []storj.NodeID) []string {
+			nodes, err := db.OverlayCache().SelectNodes(ctx, len(addresses), &overlay.NodeCriteria{
+				Type:             pb.NodeType_STORAGE,
+				ExcludedIDs:      excluded,
+				DistinctIPPrefix: true,
+			})
+			require.NoError(t, err)
+
+			var selected []string
+			for _, node := range nodes {
+				selected = append(selected, subnets[node.Address.Address])
+			}
+			sort.Strings(selected)
+			return selected
+		}
+
+		assert.Equal(t, []string{"10.0.0.", "10.0.1.", "10.0.2.", "2001:db8::/64"}, selectSubnets(nil))
+
+		// the subnets of the excluded nodes aren't selected
+		assert.Equal(t, []string{"10.0.2.", "2001:db8::/64"}, selectSubnets(ids[1:3]))
+
+		// without distinct subnets all nodes are selected
+		nodes, err := db.OverlayCache().SelectNodes(ctx, len(addresses), &overlay.NodeCriteria{
+			Type:        pb.NodeType_STORAGE,
+			ExcludedIDs: ids[:1],
+		})
+		require.NoError(t, err)
+		assert.Len(t, nodes, len(addresses)-1)
+	})
+}
+
+func TestCache_SelectNodesCountries(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		countries, err := overlay.ParseNetworkCountries(strings.NewReader("10.0.0.0/24,DE\n10.0.1.0/24,FR\n10.0.2.0/24,US"))
+		require.NoError(t, err)
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+		cache.GeoIP = countries
+
+		addresses := map[string]string{
+			"10.0.0.1:7777": "DE",
+			"10.0.1.1:7777": "FR",
+			"10.0.2.1:7777": "US",
+			"10.0.3.1:7777": "",
+		}
+		for address, country := range addresses {
+			var id storj.NodeID
+			_, _ = rand.Read(id[:])
+
+			err := cache.Put(ctx, id, pb.Node{
+				Id:      id,
+				Type:    pb.NodeType_STORAGE,
+				Address: &pb.NodeAddress{Address: address},
+				Restrictions: &pb.NodeRestrictions{
+					FreeBandwidth: 1,
+					FreeDisk:      1,
+				},
+			})
+			require.NoError(t, err)
+
+			node, err := cache.Get(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, country, node.CountryCode)
+		}
+
+		selectCountries := func(allowed, excluded []string) []string {
+			nodes, err := db.OverlayCache().SelectNodes(ctx, len(addresses), &overlay.NodeCriteria{
+				Type:              pb.NodeType_STORAGE,
+				AllowedCountries:  allowed,
+				ExcludedCountries: excluded,
+			})
+			require.NoError(t, err)
+
+			var selected []string
+			for _, node := range nodes {
+				selected = append(selected, node.CountryCode)
+			}
+			sort.Strings(selected)
+			return selected
+		}
+
+		assert.Equal(t, []string{"", "DE", "FR", "US"}, selectCountries(nil, nil))
+		assert.Equal(t, []string{"DE", "FR"}, selectCountries([]string{"DE", "FR"}, nil))
+		// nodes with an unknown country are only excluded by allowed countries
+		assert.Equal(t, []string{"", "FR"}, selectCountries(nil, []string{"DE", "US"}))
+		assert.Equal(t, []string{"FR"}, selectCountries([]string{"DE", "FR"}, []string{"DE"}))
+	})
+}
+
+func TestCache_Query(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+
+		ratios := []float64{0.2, 0.5, 0.7, 0.9, 1}
+		for i, ratio := range ratios {
+			var id storj.NodeID
+			_, _ = rand.Read(id[:])
+
+			err := db.OverlayCache().Update(ctx, &pb.Node{
+				Id:      id,
+				Type:    pb.NodeType_STORAGE,
+				Address: &pb.NodeAddress{Address: "10.0.0." + strconv.Itoa(i) + ":7777"},
+				Restrictions: &pb.NodeRestrictions{
+					FreeBandwidth: int64(i) * 100,
+					FreeDisk:      int64(i) * 100,
+				},
+				Reputation: &pb.NodeStats{
+					AuditCount:        int64(i),
+					AuditSuccessRatio: ratio,
+					UptimeRatio:       1 - ratio,
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		queryRatios := func(query overlay.NodeQuery) (selected []float64, more bool) {
+			nodes, more, err := cache.Query(ctx, query)
+			require.NoError(t, err)
+			for _, node := range nodes {
+				selected = append(selected, node.Reputation.AuditSuccessRatio)
+			}
+			return selected, more
+		}
+
+		{ // bad nodes ordered from the worst
+			selected, more := queryRatios(overlay.NodeQuery{
+				AuditSuccessRatioBelow: 0.8,
+				OrderBy:                overlay.NodeOrderByAuditSuccessRatio,
+			})
+			assert.Equal(t, []float64{0.2, 0.5, 0.7}, selected)
+			assert.False(t, more)
+		}
+
+		{ // new nodes are left out
+			selected, _ := queryRatios(overlay.NodeQuery{
+				MinAuditCount:          2,
+				AuditSuccessRatioBelow: 0.8,
+				OrderBy:                overlay.NodeOrderByAuditSuccessRatio,
+			})
+			assert.Equal(t, []float64{0.7}, selected)
+		}
+
+		{ // uptime and free space thresholds
+			selected, _ := queryRatios(overlay.NodeQuery{
+				MinUptimeRatio: 0.2,
+				MinFreeDisk:    100,
+				FreeDiskBelow:  300,
+				OrderBy:        overlay.NodeOrderByFreeDisk,
+				Descending:     true,
+			})
+			assert.Equal(t, []float64{0.7, 0.5}, selected)
+		}
+
+		{ // pages don't overlap
+			first, more := queryRatios(overlay.NodeQuery{
+				OrderBy:    overlay.NodeOrderByUptimeRatio,
+				Descending: true,
+				Limit:      3,
+			})
+			assert.Equal(t, []float64{0.2, 0.5, 0.7}, first)
+			assert.True(t, more)
+
+			second, more := queryRatios(overlay.NodeQuery{
+				OrderBy:    overlay.NodeOrderByUptimeRatio,
+				Descending: true,
+				Offset:     3,
+				Limit:      3,
+			})
+			assert.Equal(t, []float64{0.9, 1}, second)
+			assert.False(t, more)
+		}
+
+		{ // node ids are in ascending order by default
+			nodes, _, err := cache.Query(ctx, overlay.NodeQuery{Type: pb.NodeType_STORAGE})
+			require.NoError(t, err)
+			require.Len(t, nodes, len(ratios))
+			assert.True(t, sort.SliceIsSorted(nodes, func(i, k int) bool {
+				return nodes[i].Id.Less(nodes[k].Id)
+			}))
+		}
+
+		_, _, err := cache.Query(ctx, overlay.NodeQuery{OrderBy: overlay.NodeOrder(100)})
+		assert.Error(t, err)
+		_, _, err = cache.Query(ctx, overlay.NodeQuery{Offset: -1})
+		assert.Error(t, err)
+	})
+}
+
+func TestCache_SelectNodesOnline(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+
+		var online, offline, unchecked storj.NodeID
+		for i, id := range []*storj.NodeID{&online, &offline, &unchecked} {
+			_, _ = rand.Read(id[:])
+			err := cache.Put(ctx, *id, pb.Node{
+				Id:      *id,
+				Type:    pb.NodeType_STORAGE,
+				Address: &pb.NodeAddress{Address: "10.0.0." + strconv.Itoa(i) + ":7777"},
+				Restrictions: &pb.NodeRestrictions{
+					FreeBandwidth: 1,
+					FreeDisk:      1,
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		_, err := db.StatDB().UpdateUptime(ctx, online, true)
+		require.NoError(t, err)
+		_, err = db.StatDB().UpdateUptime(ctx, offline, true)
+		require.NoError(t, err)
+		_, err = db.StatDB().UpdateUptime(ctx, offline, false)
+		require.NoError(t, err)
+
+		selectNodes := func(window time.Duration) (ids storj.NodeIDList) {
+			nodes, err := db.OverlayCache().SelectNodes(ctx, 3, &overlay.NodeCriteria{
+				Type:         pb.NodeType_STORAGE,
+				OnlineWindow: window,
+			})
+			require.NoError(t, err)
+			for _, node := range nodes {
+				ids = append(ids, node.Id)
+			}
+			return ids
+		}
+
+		assert.Len(t, selectNodes(0), 3)
+		assert.Equal(t, storj.NodeIDList{online}, selectNodes(time.Hour))
+
+		// the successful contact is outside of a short window
+		time.Sleep(10 * time.Millisecond)
+		assert.Empty(t, selectNodes(time.Millisecond))
+	})
+}
+
+func TestCache_SelectVettedNodes(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+		cache.Vetting = &statdb.VettingCriteria{AuditCount: 1, AuditSuccessRatio: 1}
+
+		var vetted, unvetted storj.NodeID
+		_, _ = rand.Read(vetted[:])
+		_, _ = rand.Read(unvetted[:])
+
+		put := func(id storj.NodeID, i int) {
+			err := cache.Put(ctx, id, pb.Node{
+				Id:      id,
+				Type:    pb.NodeType_STORAGE,
+				Address: &pb.NodeAddress{Address: "10.0.0." + strconv.Itoa(i) + ":7777"},
+				Restrictions: &pb.NodeRestrictions{
+					FreeBandwidth: 1,
+					FreeDisk:      1,
+				},
+			})
+			require.NoError(t, err)
+		}
+		put(vetted, 1)
+		put(unvetted, 2)
+
+		selectNodes := func() (vettedIDs, newIDs storj.NodeIDList) {
+			nodes, err := cache.SelectVettedNodes(ctx, 2, &overlay.NodeCriteria{Type: pb.NodeType_STORAGE})
+			require.NoError(t, err)
+			for _, node := range nodes {
+				vettedIDs = append(vettedIDs, node.Id)
+			}
+
+			nodes, err = cache.SelectNewNodes(ctx, 2, &overlay.NewNodeCriteria{Type: pb.NodeType_STORAGE})
+			require.NoError(t, err)
+			for _, node := range nodes {
+				newIDs = append(newIDs, node.Id)
+			}
+			return vettedIDs, newIDs
+		}
+
+		// fresh nodes are new until they're vetted
+		vettedIDs, newIDs := selectNodes()
+		assert.Empty(t, vettedIDs)
+		assert.ElementsMatch(t, storj.NodeIDList{vetted, unvetted}, newIDs)
+
+		// the node is vetted when it's stored after meeting the criteria
+		_, err := db.StatDB().UpdateAuditSuccess(ctx, vetted, true)
+		require.NoError(t, err)
+		_, err = db.StatDB().UpdateAuditSuccess(ctx, unvetted, false)
+		require.NoError(t, err)
+		put(vetted, 1)
+		put(unvetted, 2)
+
+		vettedIDs, newIDs = selectNodes()
+		assert.Equal(t, storj.NodeIDList{vetted}, vettedIDs)
+		assert.Equal(t, storj.NodeIDList{unvetted}, newIDs)
+
+		stats, err := db.StatDB().Get(ctx, vetted)
+		require.NoError(t, err)
+		assert.True(t, stats.Vetted)
+
+		// without vetting the nodes are split by the audit threshold
+		cache.Vetting = nil
+		vettedIDs, newIDs = selectNodes()
+		assert.Len(t, vettedIDs, 2)
+		assert.Empty(t, newIDs)
+	})
+}
+
+func TestCache_OperatorMetadata(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+
+		const wallet = "0x9b7488BF8b6A4FF21D610e3dd202723f705cD1C0"
+		var paid, invalid, missing storj.NodeID
+		for i, node := range []struct {
+			id       *storj.NodeID
+			metadata *pb.NodeMetadata
+		}{
+			{&paid, &pb.NodeMetadata{Wallet: wallet, Email: "operator@example.com"}},
+			{&invalid, &pb.NodeMetadata{Wallet: "0x123", Email: "Operator <operator@example.com>"}},
+			{&missing, nil},
+		} {
+			_, _ = rand.Read(node.id[:])
+			err := cache.Put(ctx, *node.id, pb.Node{
+				Id:       *node.id,
+				Type:     pb.NodeType_STORAGE,
+				Address:  &pb.NodeAddress{Address: "10.0.0." + strconv.Itoa(i) + ":7777"},
+				Metadata: node.metadata,
+			})
+			require.NoError(t, err)
+		}
+
+		// the wallet is matched case insensitively
+		nodes, err := cache.GetByWallet(ctx, strings.ToLower(wallet))
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, paid, nodes[0].Id)
+		assert.Equal(t, wallet, nodes[0].GetMetadata().GetWallet())
+		assert.Equal(t, "operator@example.com", nodes[0].GetMetadata().GetEmail())
+
+		_, err = cache.GetByWallet(ctx, "0x123")
+		assert.True(t, overlay.ErrInvalidMetadata.Has(err))
+
+		// invalid addresses are stored as missing
+		node, err := cache.Get(ctx, invalid)
+		require.NoError(t, err)
+		assert.Empty(t, node.GetMetadata().GetWallet())
+		assert.Empty(t, node.GetMetadata().GetEmail())
+
+		expected := storj.NodeIDList{invalid, missing}
+		sort.Slice(expected, func(i, k int) bool { return expected[i].Less(expected[k]) })
+
+		var ids storj.NodeIDList
+		var cursor storj.NodeID
+		for {
+			nodes, err := cache.GetMissingWallet(ctx, cursor, 1)
+			require.NoError(t, err)
+			if len(nodes) == 0 {
+				break
+			}
+			ids = append(ids, nodes[0].Id)
+			cursor = nodes[0].Id
+		}
+		assert.Equal(t, expected, ids)
+
+		// checking in reports the invalid fields and records the valid ones
+		validation, err := cache.GetMetadataValidation(ctx, paid)
+		require.NoError(t, err)
+		assert.True(t, validation.EmailValidatedAt.IsZero())
+		assert.True(t, validation.WalletValidatedAt.IsZero())
+
+		metadataErrors, err := cache.CheckMetadata(ctx, &pb.Node{Id: paid, Metadata: &pb.NodeMetadata{Wallet: wallet, Email: "operator"}})
+		require.NoError(t, err)
+		require.Len(t, metadataErrors, 1)
+		assert.Equal(t, "email", metadataErrors[0].Field)
+
+		validation, err = cache.GetMetadataValidation(ctx, paid)
+		require.NoError(t, err)
+		assert.True(t, validation.EmailValidatedAt.IsZero())
+		assert.False(t, validation.WalletValidatedAt.IsZero())
+
+		_, err = cache.GetMetadataValidation(ctx, storj.NodeID{1})
+		assert.Equal(t, overlay.ErrNodeNotFound, err)
+	})
+}
+
+func TestValidateMetadata(t *testing.T) {
+	for _, test := range []struct {
+		metadata *pb.NodeMetadata
+		valid    bool
+	}{
+		{nil, true},
+		{&pb.NodeMetadata{}, true},
+		{&pb.NodeMetadata{Wallet: "0x9b7488BF8b6A4FF21D610e3dd202723f705cD1C0", Email: "operator@example.com"}, true},
+		{&pb.NodeMetadata{Wallet: "9b7488BF8b6A4FF21D610e3dd202723f705cD1C0"}, false},
+		{&pb.NodeMetadata{Wallet: "0x9b7488BF8b6A4FF21D610e3dd202723f705cD1CZ"}, false},
+		{&pb.NodeMetadata{Email: "operator"}, false},
+		{&pb.NodeMetadata{Email: "Operator <operator@example.com>"}, false},
+		{&pb.NodeMetadata{Email: strings.Repeat("a", 250) + "@example.com"}, false},
+	} {
+		err := overlay.ValidateMetadata(test.metadata)
+		if test.valid {
+			assert.NoError(t, err, test.metadata.String())
+		} else {
+			assert.True(t, overlay.ErrInvalidMetadata.Has(err), test.metadata.String())
+		}
+		assert.Equal(t, test.valid, len(overlay.MetadataErrors(test.metadata)) == 0, test.metadata.String())
+	}
+}
+
+func TestCache_Watch(t *testing.T) {
+	t.Parallel()
+
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+
+		watchCtx, stopWatching := context.WithCancel(ctx)
+		events := cache.Watch(watchCtx, 10)
+
+		var first, second storj.NodeID
+		_, _ = rand.Read(first[:])
+		_, _ = rand.Read(second[:])
+
+		require.NoError(t, cache.Put(ctx, first, pb.Node{Id: first, Type: pb.NodeType_STORAGE}))
+		require.NoError(t, cache.Put(ctx, first, pb.Node{Id: first, Type: pb.NodeType_STORAGE}))
+		failed, err := cache.PutAll(ctx, []pb.Node{
+			{Id: first, Type: pb.NodeType_STORAGE},
+			{Id: second, Type: pb.NodeType_STORAGE},
+		})
+		require.NoError(t, err)
+		require.Empty(t, failed)
+		require.NoError(t, cache.Delete(ctx, first))
+
+		expected := []struct {
+			typ overlay.NodeEventType
+			id  storj.NodeID
+		}{
+			{overlay.NodeAdded, first},
+			{overlay.NodeUpdated, first},
+			{overlay.NodeUpdated, first},
+			{overlay.NodeAdded, second},
+			{overlay.NodeRemoved, first},
+		}
+		for _, want := range expected {
+			event := <-events
+			assert.Equal(t, want.typ, event.Type, want.id.String())
+			assert.Equal(t, want.id, event.ID)
+			if want.typ == overlay.NodeRemoved {
+				assert.Nil(t, event.Node)
+			} else if assert.NotNil(t, event.Node) {
+				assert.Equal(t, want.id, event.Node.Id)
+			}
+		}
+
+		// the channel is closed when the watcher stops
+		stopWatching()
+		_, ok := <-events
+		assert.False(t, ok)
+
+		// changes without watchers don't block
+		require.NoError(t, cache.Put(ctx, second, pb.Node{Id: second, Type: pb.NodeType_STORAGE}))
+	})
+}
@@ -6,6 +6,7 @@ package overlay
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/identity"
@@ -30,6 +31,7 @@ type Client interface {
 	Choose(ctx context.Context, op Options) ([]*pb.Node, error)
 	Lookup(ctx context.Context, nodeID storj.NodeID) (*pb.Node, error)
 	BulkLookup(ctx context.Context, nodeIDs storj.NodeIDList) ([]*pb.Node, error)
+	ReportUploadStats(ctx context.Context, stats *pb.UploadStats) error
 }
 
 // client is the overlay concrete implementation of the client interface
@@ -47,6 +49,13 @@ type Options struct {
 	AuditSuccess float64
 	AuditCount   int64
 	Excluded     storj.NodeIDList
+	// AllowedCountries restricts the nodes to these ISO country codes
+	AllowedCountries []string
+	// ExcludedCountries are the ISO country codes of nodes, which mustn't be chosen
+	ExcludedCountries []string
+	// NewNodeRatio is the fraction of new nodes chosen in addition to the
+	// vetted nodes, the satellite's ratio is used when it's nil
+	NewNodeRatio *float64
 }
 
 // NewClient returns a new intialized Overlay Client
@@ -77,14 +86,18 @@ var _ Client = (*client)(nil)
 func (client *client) Choose(ctx context.Context, op Options) ([]*pb.Node, error) {
 	var exIDs storj.NodeIDList
 	exIDs = append(exIDs, op.Excluded...)
+	opts := &pb.OverlayOptions{
+		Amount:            int64(op.Amount),
+		Restrictions:      &pb.NodeRestrictions{FreeDisk: op.Space, FreeBandwidth: op.Bandwidth},
+		ExcludedNodes:     exIDs,
+		AllowedCountries:  op.AllowedCountries,
+		ExcludedCountries: op.ExcludedCountries,
+	}
+	if op.NewNodeRatio != nil {
+		opts.NewNodeRatio = &wrappers.DoubleValue{Value: *op.NewNodeRatio}
+	}
 	// TODO(coyle): We will also need to communicate with the reputation service here
-	resp, err := client.conn.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
-		Opts: &pb.OverlayOptions{
-			Amount:        int64(op.Amount),
-			Restrictions:  &pb.NodeRestrictions{FreeDisk: op.Space, FreeBandwidth: op.Bandwidth},
-			ExcludedNodes: exIDs,
-		},
-	})
+	resp, err := client.conn.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{Opts: opts})
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -120,3 +133,9 @@ func (client *client) BulkLookup(ctx context.Context, nodeIDs storj.NodeIDList)
 	}
 	return nodes, nil
 }
+
+// ReportUploadStats sends anonymized upload outcome counts to the satellite
+func (client *client) ReportUploadStats(ctx context.Context, stats *pb.UploadStats) error {
+	_, err := client.conn.ReportUploadStats(ctx, stats)
+	return ClientError.Wrap(err)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/utils"
 )
@@ -25,6 +26,11 @@ var (
 type Config struct {
 	RefreshInterval time.Duration `help:"the interval at which the cache refreshes itself in seconds" default:"1s"`
 	Node            NodeSelectionConfig
+	Stray           StrayConfig
+	Vetting         VettingConfig
+	Addresses       AddressConfig
+	TagSigners      string `help:"a comma-separated list of node ids, which are authorized to sign node tags" default:""`
+	GeoIPPath       string `help:"path to a CSV file of networks and their ISO country codes formatted as <network>,<country> lines, empty disables the countries of nodes" default:""`
 }
 
 // LookupConfig is a configuration struct for querying the overlay cache with one or more node IDs
@@ -41,8 +47,47 @@ type NodeSelectionConfig struct {
 	AuditSuccessRatio float64 `help:"a node's ratio of successful audits" default:"0"`
 	AuditCount        int64   `help:"the number of times a node has been audited" default:"0"`
 
+	UploadSuccessRatio float64 `help:"a node's ratio of successful uploads as reported by the node, nodes which haven't reported aren't filtered" default:"0"`
+
 	NewNodeAuditThreshold int64   `help:"the number of audits a node must have to not be considered a New Node" default:"0"`
 	NewNodePercentage     float64 `help:"the percentage of new nodes allowed per request" default:"0.05"` // TODO: fix, this is not percentage, it's ratio
+
+	RequiredTags string `help:"a comma-separated list of name=value signed tags, which selected nodes must have" default:""`
+
+	DistinctIP bool `help:"select at most one node per /24 subnet (/64 for ipv6) for a segment, excluding the subnets of the nodes already holding it" default:"false"`
+
+	OnlineWindow time.Duration `help:"select only nodes, which were successfully contacted within this duration and not failed since, 0 disables the check" default:"4h"`
+}
+
+// VettingConfig configures the criteria new nodes have to meet to be vetted,
+// unvetted nodes are only selected as new nodes when vetting is enabled
+type VettingConfig struct {
+	Enabled           bool    `help:"select only vetted nodes as reputable nodes and vet new nodes meeting the criteria" default:"false"`
+	AuditCount        int64   `help:"the number of audits a node needs to be vetted" default:"100"`
+	AuditSuccessRatio float64 `help:"the ratio of successful audits a node needs to be vetted" default:"0.95"`
+	UptimeCount       int64   `help:"the number of uptime checks a node needs to be vetted" default:"100"`
+	UptimeRatio       float64 `help:"the ratio of successful uptime checks a node needs to be vetted" default:"0.95"`
+}
+
+// Criteria returns the vetting criteria, they're nil when vetting is disabled
+func (c VettingConfig) Criteria() *statdb.VettingCriteria {
+	if !c.Enabled {
+		return nil
+	}
+	return &statdb.VettingCriteria{
+		AuditCount:        c.AuditCount,
+		AuditSuccessRatio: c.AuditSuccessRatio,
+		UptimeCount:       c.UptimeCount,
+		UptimeRatio:       c.UptimeRatio,
+	}
+}
+
+// ParseTagSigners converts the node IDs of the authorized tag signers from the config
+func (c Config) ParseTagSigners() (ids storj.NodeIDList, err error) {
+	if c.TagSigners == "" {
+		return nil, nil
+	}
+	return LookupConfig{NodeIDsString: c.TagSigners, Delimiter: ","}.ParseIDs()
 }
 
 // ParseIDs converts the base58check encoded node ID strings from the config into node IDs
@@ -0,0 +1,136 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"sync"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// NodeEventType is the kind of change of a node in the cache
+type NodeEventType int
+
+const (
+	// NodeAdded is emitted when a node is stored in the cache for the first time
+	NodeAdded NodeEventType = iota
+	// NodeUpdated is emitted on every write of a node already in the cache,
+	// even when nothing changed
+	NodeUpdated
+	// NodeRemoved is emitted when a node is deleted from the cache
+	NodeRemoved
+)
+
+// String returns the name of the event type
+func (typ NodeEventType) String() string {
+	switch typ {
+	case NodeAdded:
+		return "added"
+	case NodeUpdated:
+		return "updated"
+	case NodeRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// NodeEvent is a change of a node in the cache
+type NodeEvent struct {
+	Type NodeEventType
+	ID   storj.NodeID
+	// Node is the node as it was stored, it's nil for removed nodes
+	Node *pb.Node
+}
+
+// watchers tracks the channels of the cache watchers
+type watchers struct {
+	mu       sync.Mutex
+	next     int
+	channels map[int]chan NodeEvent
+}
+
+// Watch returns a channel, which receives the changes of the cache until ctx
+// is canceled, then the channel is closed. The cache doesn't wait for slow
+// watchers, events which don't fit into the buffer of the channel are
+// dropped, so watchers mustn't rely on seeing every change.
+func (cache *Cache) Watch(ctx context.Context, buffer int) <-chan NodeEvent {
+	events := make(chan NodeEvent, buffer)
+
+	cache.watchers.mu.Lock()
+	if cache.watchers.channels == nil {
+		cache.watchers.channels = map[int]chan NodeEvent{}
+	}
+	id := cache.watchers.next
+	cache.watchers.next++
+	cache.watchers.channels[id] = events
+	cache.watchers.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		cache.watchers.mu.Lock()
+		defer cache.watchers.mu.Unlock()
+		delete(cache.watchers.channels, id)
+		close(events)
+	}()
+
+	return events
+}
+
+// watched returns whether anybody watches the cache
+func (cache *Cache) watched() bool {
+	cache.watchers.mu.Lock()
+	defer cache.watchers.mu.Unlock()
+	return len(cache.watchers.channels) > 0
+}
+
+// emit sends the event to all watchers, which have room for it
+func (cache *Cache) emit(event NodeEvent) {
+	cache.watchers.mu.Lock()
+	defer cache.watchers.mu.Unlock()
+
+	for _, events := range cache.watchers.channels {
+		select {
+		case events <- event:
+		default:
+			mon.Meter("overlay_events_dropped").Mark(1)
+		}
+	}
+}
+
+// existing returns which of the nodes are already in the cache, it's only
+// looked up when somebody watches the cache
+func (cache *Cache) existing(ctx context.Context, ids storj.NodeIDList) map[storj.NodeID]bool {
+	if !cache.watched() || len(ids) == 0 {
+		return nil
+	}
+
+	nodes, err := cache.db.GetAll(ctx, ids)
+	if err != nil {
+		// the nodes are reported as added
+		return nil
+	}
+
+	existing := make(map[storj.NodeID]bool, len(nodes))
+	for _, node := range nodes {
+		if node != nil {
+			existing[node.Id] = true
+		}
+	}
+	return existing
+}
+
+// emitStored emits the events of the stored nodes
+func (cache *Cache) emitStored(nodes []*pb.Node, existing map[storj.NodeID]bool) {
+	for _, node := range nodes {
+		typ := NodeAdded
+		if existing[node.Id] {
+			typ = NodeUpdated
+		}
+		cache.emit(NodeEvent{Type: typ, ID: node.Id, Node: node})
+	}
+}
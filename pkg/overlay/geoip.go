@@ -0,0 +1,135 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+// GeoIP resolves the country of an ip address
+type GeoIP interface {
+	// Country returns the ISO 3166-1 alpha-2 code of the country of the ip, it's empty when the country is unknown
+	Country(ctx context.Context, ip net.IP) (string, error)
+}
+
+// NetworkCountries is a GeoIP, which looks up the countries in a list of networks
+type NetworkCountries struct {
+	networks []networkCountry
+}
+
+// networkCountry is a network and the country it's located in
+type networkCountry struct {
+	network *net.IPNet
+	country string
+}
+
+// LoadNetworkCountries loads the networks and their countries from a CSV file
+func LoadNetworkCountries(path string) (_ *NetworkCountries, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	return ParseNetworkCountries(file)
+}
+
+// ParseNetworkCountries parses lines formatted as <network>,<country>, e.g.
+// 192.0.2.0/24,DE. Empty lines and lines starting with # are skipped.
+func ParseNetworkCountries(r io.Reader) (*NetworkCountries, error) {
+	countries := &NetworkCountries{}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, ",")
+		if len(fields) != 2 {
+			return nil, Error.New("line %d: expected <network>,<country>", line)
+		}
+
+		_, network, err := net.ParseCIDR(strings.TrimSpace(fields[0]))
+		if err != nil {
+			return nil, Error.New("line %d: %v", line, err)
+		}
+		country := NormalizeCountries([]string{fields[1]})
+		if len(country) != 1 || len(country[0]) != 2 {
+			return nil, Error.New("line %d: invalid country code %q", line, fields[1])
+		}
+
+		countries.networks = append(countries.networks, networkCountry{network: network, country: country[0]})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return countries, nil
+}
+
+// Country returns the country of the most specific network containing the ip
+func (countries *NetworkCountries) Country(ctx context.Context, ip net.IP) (string, error) {
+	country, longest := "", -1
+	for _, entry := range countries.networks {
+		if !entry.network.Contains(ip) {
+			continue
+		}
+		if ones, _ := entry.network.Mask.Size(); ones > longest {
+			country, longest = entry.country, ones
+		}
+	}
+	return country, nil
+}
+
+// NormalizeCountries converts country codes to upper case and drops the empty ones
+func NormalizeCountries(countries []string) []string {
+	var normalized []string
+	for _, country := range countries {
+		country = strings.ToUpper(strings.TrimSpace(country))
+		if country != "" {
+			normalized = append(normalized, country)
+		}
+	}
+	return normalized
+}
+
+// nodeCountry resolves the country of a node's address, the country is
+// unknown when the address can't be resolved
+func (cache *Cache) nodeCountry(ctx context.Context, address string) string {
+	if cache.GeoIP == nil || address == "" {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil || len(addrs) == 0 {
+			zap.L().Debug("unable to resolve node address", zap.String("address", address), zap.Error(err))
+			return ""
+		}
+		ip = addrs[0].IP
+	}
+
+	country, err := cache.GeoIP.Country(ctx, ip)
+	if err != nil {
+		zap.L().Debug("unable to resolve node country", zap.String("address", address), zap.Error(err))
+		return ""
+	}
+	return country
+}
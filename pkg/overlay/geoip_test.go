@@ -0,0 +1,56 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/overlay"
+)
+
+func TestNetworkCountries(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	countries, err := overlay.ParseNetworkCountries(strings.NewReader(`
+# comments and empty lines are skipped
+
+10.0.0.0/8,de
+10.1.0.0/16, FR
+2001:db8::/32,US
+`))
+	require.NoError(t, err)
+
+	for ip, expected := range map[string]string{
+		"10.0.0.1":    "DE",
+		"10.1.2.3":    "FR", // the most specific network wins
+		"2001:db8::1": "US",
+		"192.0.2.1":   "",
+	} {
+		country, err := countries.Country(ctx, net.ParseIP(ip))
+		require.NoError(t, err)
+		assert.Equal(t, expected, country, ip)
+	}
+
+	for _, invalid := range []string{
+		"10.0.0.0/8",
+		"10.0.0.0/8,DE,FR",
+		"10.0.0.0,DE",
+		"10.0.0.0/8,DEU",
+	} {
+		_, err := overlay.ParseNetworkCountries(strings.NewReader(invalid))
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestNormalizeCountries(t *testing.T) {
+	assert.Equal(t, []string{"DE", "US"}, overlay.NormalizeCountries([]string{" de", "", "US"}))
+	assert.Nil(t, overlay.NormalizeCountries(nil))
+}
@@ -7,16 +7,19 @@ import (
 	"context"
 
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 )
 
 // Inspector is a gRPC service for inspecting overlay cache internals
 type Inspector struct {
-	cache *Cache
+	cache      *Cache
+	tagSigners storj.NodeIDList
 }
 
-// NewInspector creates an Inspector
-func NewInspector(cache *Cache) *Inspector {
-	return &Inspector{cache: cache}
+// NewInspector creates an Inspector, node tags are only accepted when
+// they are signed by one of tagSigners
+func NewInspector(cache *Cache, tagSigners storj.NodeIDList) *Inspector {
+	return &Inspector{cache: cache, tagSigners: tagSigners}
 }
 
 // CountNodes returns the number of nodes in the cache
@@ -30,3 +33,33 @@ func (srv *Inspector) CountNodes(ctx context.Context, req *pb.CountNodesRequest)
 		Count: int64(len(overlayKeys)),
 	}, nil
 }
+
+// SetNodeTags verifies and stores signed tags of nodes
+func (srv *Inspector) SetNodeTags(ctx context.Context, req *pb.SetNodeTagsRequest) (_ *pb.SetNodeTagsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// verify every tag first, so an invalid tag doesn't leave the others half stored
+	for _, tag := range req.Tags {
+		if err := VerifyTag(tag, srv.tagSigners); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, tag := range req.Tags {
+		if err := srv.cache.UpdateTag(ctx, tag); err != nil {
+			return nil, Error.Wrap(err)
+		}
+	}
+	return &pb.SetNodeTagsResponse{}, nil
+}
+
+// GetNodeTags returns the signed tags of a node
+func (srv *Inspector) GetNodeTags(ctx context.Context, req *pb.GetNodeTagsRequest) (_ *pb.GetNodeTagsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tags, err := srv.cache.GetTags(ctx, req.NodeId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &pb.GetNodeTagsResponse{Tags: tags}, nil
+}
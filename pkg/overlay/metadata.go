@@ -0,0 +1,153 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"net/mail"
+	"regexp"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrInvalidMetadata is returned for operator metadata, which can't be used for payments or outreach
+var ErrInvalidMetadata = errs.Class("invalid operator metadata")
+
+// maxEmailLength is the longest email address, which can be delivered to
+const maxEmailLength = 254
+
+// walletPattern matches the ethereum addresses operators are paid to
+var walletPattern = regexp.MustCompile("^0x[a-fA-F0-9]{40}$")
+
+// ValidateWallet checks whether the wallet address can be paid to
+func ValidateWallet(wallet string) error {
+	if !walletPattern.MatchString(wallet) {
+		return ErrInvalidMetadata.New("wallet address %q isn't valid", wallet)
+	}
+	return nil
+}
+
+// ValidateEmail checks whether the email address is a plain address without a display name
+func ValidateEmail(email string) error {
+	if len(email) > maxEmailLength {
+		return ErrInvalidMetadata.New("email address is longer than %d characters", maxEmailLength)
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return ErrInvalidMetadata.New("email address %q isn't valid", email)
+	}
+	return nil
+}
+
+// ValidateMetadata checks the operator metadata sent by a node at check-in,
+// missing addresses are valid, the node just can't be paid or contacted
+func ValidateMetadata(metadata *pb.NodeMetadata) error {
+	walletErr, emailErr := validateAddresses(metadata)
+	return errs.Combine(walletErr, emailErr)
+}
+
+// validateAddresses checks the wallet and email address of the operator
+// metadata, missing addresses are valid
+func validateAddresses(metadata *pb.NodeMetadata) (walletErr, emailErr error) {
+	if wallet := metadata.GetWallet(); wallet != "" {
+		walletErr = ValidateWallet(wallet)
+	}
+	if email := metadata.GetEmail(); email != "" {
+		emailErr = ValidateEmail(email)
+	}
+	return walletErr, emailErr
+}
+
+// MetadataValidation is when the addresses of the operator metadata were
+// last found valid at check-in, zero times haven't been valid yet
+type MetadataValidation struct {
+	EmailValidatedAt  time.Time
+	WalletValidatedAt time.Time
+}
+
+// MetadataErrors returns the invalid fields of the operator metadata, so
+// they can be reported back to the node
+func MetadataErrors(metadata *pb.NodeMetadata) []*pb.MetadataError {
+	return metadataErrors(validateAddresses(metadata))
+}
+
+func metadataErrors(walletErr, emailErr error) []*pb.MetadataError {
+	var invalid []*pb.MetadataError
+	if walletErr != nil {
+		invalid = append(invalid, &pb.MetadataError{Field: "wallet", Message: walletErr.Error()})
+	}
+	if emailErr != nil {
+		invalid = append(invalid, &pb.MetadataError{Field: "email", Message: emailErr.Error()})
+	}
+	return invalid
+}
+
+// CheckMetadata validates the operator metadata of a node checking in and
+// records when its addresses were found valid. The invalid fields are
+// returned, the addresses are stored as missing by Put.
+func (cache *Cache) CheckMetadata(ctx context.Context, node *pb.Node) (_ []*pb.MetadataError, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if node.Id.IsZero() {
+		return nil, ErrEmptyNode
+	}
+	walletErr, emailErr := validateAddresses(node.GetMetadata())
+
+	now := time.Now()
+	var validation MetadataValidation
+	if node.GetMetadata().GetEmail() != "" && emailErr == nil {
+		validation.EmailValidatedAt = now
+	}
+	if node.GetMetadata().GetWallet() != "" && walletErr == nil {
+		validation.WalletValidatedAt = now
+	}
+
+	return metadataErrors(walletErr, emailErr), cache.db.UpdateMetadataValidation(ctx, node.Id, validation)
+}
+
+// GetMetadataValidation returns when the operator's email and wallet were last found valid
+func (cache *Cache) GetMetadataValidation(ctx context.Context, id storj.NodeID) (_ MetadataValidation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.GetMetadataValidation(ctx, id)
+}
+
+// sanitizeMetadata drops the invalid addresses of the operator metadata, so
+// they're stored as missing, the metadata of the caller isn't modified
+func sanitizeMetadata(node *pb.Node) {
+	walletErr, emailErr := validateAddresses(node.Metadata)
+	if walletErr == nil && emailErr == nil {
+		return
+	}
+	mon.Meter("overlay_invalid_operator_metadata").Mark(1)
+
+	metadata := *node.Metadata
+	if walletErr != nil {
+		metadata.Wallet = ""
+	}
+	if emailErr != nil {
+		metadata.Email = ""
+	}
+	node.Metadata = &metadata
+}
+
+// GetByWallet returns the nodes paid to the wallet address
+func (cache *Cache) GetByWallet(ctx context.Context, wallet string) (_ []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := ValidateWallet(wallet); err != nil {
+		return nil, err
+	}
+	return cache.db.GetByWallet(ctx, wallet)
+}
+
+// GetMissingWallet returns up to limit storage nodes without a valid wallet
+// address ordered by id, starting after cursor
+func (cache *Cache) GetMissingWallet(ctx context.Context, cursor storj.NodeID, limit int) (_ []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.GetMissingWallet(ctx, cursor, limit)
+}
@@ -76,3 +76,15 @@ func (m *MockClient) BulkLookup(ctx context.Context, nodeIDs storj.NodeIDList) (
 func (mr *MockClientMockRecorder) BulkLookup(ctx, nodeIDs interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkLookup", reflect.TypeOf((*MockClient)(nil).BulkLookup), ctx, nodeIDs)
 }
+
+// ReportUploadStats mocks base method
+func (m *MockClient) ReportUploadStats(ctx context.Context, stats *pb.UploadStats) error {
+	ret := m.ctrl.Call(m, "ReportUploadStats", ctx, stats)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportUploadStats indicates an expected call of ReportUploadStats
+func (mr *MockClientMockRecorder) ReportUploadStats(ctx, stats interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportUploadStats", reflect.TypeOf((*MockClient)(nil).ReportUploadStats), ctx, stats)
+}
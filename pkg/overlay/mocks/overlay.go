@@ -59,6 +59,11 @@ func (mo *Overlay) BulkLookup(ctx context.Context, reqs *pb.LookupRequests) (
 	return &pb.LookupResponses{LookupResponse: responses}, nil
 }
 
+// ReportUploadStats ignores the reported upload stats
+func (mo *Overlay) ReportUploadStats(ctx context.Context, stats *pb.UploadStats) (*pb.UploadStatsResponse, error) {
+	return &pb.UploadStatsResponse{}, nil
+}
+
 // Config specifies static nodes for mock overlay
 type Config struct {
 	Nodes string `help:"a comma-separated list of <node-id>:<ip>:<port>" default:""`
@@ -0,0 +1,71 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// NodeOrder is the order of the nodes returned by a query
+type NodeOrder int
+
+const (
+	// NodeOrderByID orders the nodes by their id
+	NodeOrderByID NodeOrder = iota
+	// NodeOrderByAuditSuccessRatio orders the nodes by their audit success ratio
+	NodeOrderByAuditSuccessRatio
+	// NodeOrderByUptimeRatio orders the nodes by their uptime ratio
+	NodeOrderByUptimeRatio
+	// NodeOrderByFreeDisk orders the nodes by their free disk space
+	NodeOrderByFreeDisk
+	// NodeOrderByFreeBandwidth orders the nodes by their free bandwidth
+	NodeOrderByFreeBandwidth
+)
+
+// NodeQuery filters and orders the nodes of the overlay cache, the zero
+// value of a filter matches every node
+type NodeQuery struct {
+	// Type restricts the nodes to a type, all types match pb.NodeType_INVALID
+	Type pb.NodeType
+
+	// MinAuditCount leaves out the nodes with fewer audits, so new nodes
+	// don't end up in a report of bad nodes
+	MinAuditCount int64
+
+	MinAuditSuccessRatio   float64
+	AuditSuccessRatioBelow float64
+	MinUptimeRatio         float64
+	UptimeRatioBelow       float64
+
+	MinFreeDisk        int64
+	FreeDiskBelow      int64
+	MinFreeBandwidth   int64
+	FreeBandwidthBelow int64
+
+	OrderBy    NodeOrder
+	Descending bool
+
+	Offset int64
+	Limit  int
+}
+
+// Query returns the nodes matching the query and whether there are more
+// nodes after the returned ones
+func (cache *Cache) Query(ctx context.Context, query NodeQuery) (_ []*pb.Node, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if query.Offset < 0 {
+		return nil, false, OverlayError.New("negative offset %d", query.Offset)
+	}
+	switch query.OrderBy {
+	case NodeOrderByID, NodeOrderByAuditSuccessRatio, NodeOrderByUptimeRatio,
+		NodeOrderByFreeDisk, NodeOrderByFreeBandwidth:
+	default:
+		return nil, false, OverlayError.New("unknown node order %d", query.OrderBy)
+	}
+
+	return cache.db.QueryNodes(ctx, query)
+}
@@ -5,6 +5,8 @@ package overlay
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -19,9 +21,11 @@ var ServerError = errs.Class("Server Error")
 
 // Server implements our overlay RPC service
 type Server struct {
-	log         *zap.Logger
-	cache       *Cache
-	metrics     *monkit.Registry
+	log     *zap.Logger
+	cache   *Cache
+	metrics *monkit.Registry
+
+	mu          sync.Mutex
 	preferences *NodeSelectionConfig
 }
 
@@ -38,6 +42,13 @@ func NewServer(log *zap.Logger, cache *Cache, preferences *NodeSelectionConfig)
 // Close closes resources
 func (server *Server) Close() error { return nil }
 
+// SetPreferences replaces the node selection preferences used by FindStorageNodes
+func (server *Server) SetPreferences(preferences *NodeSelectionConfig) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	server.preferences = preferences
+}
+
 // Lookup finds the address of a node in our overlay network
 func (server *Server) Lookup(ctx context.Context, req *pb.LookupRequest) (_ *pb.LookupResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -65,6 +76,39 @@ func (server *Server) BulkLookup(ctx context.Context, reqs *pb.LookupRequests) (
 	return nodesToLookupResponses(ns), nil
 }
 
+// ReportUploadStats records the upload outcome counts sent by an uplink
+func (server *Server) ReportUploadStats(ctx context.Context, stats *pb.UploadStats) (_ *pb.UploadStatsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	outcomes := []struct {
+		name  string
+		count int64
+	}{
+		{"success", stats.Success},
+		{"timeout", stats.Timeout},
+		{"refused", stats.Refused},
+		{"failed", stats.Failed},
+		{"canceled", stats.Canceled},
+	}
+
+	var attempts int64
+	for _, outcome := range outcomes {
+		if outcome.count < 0 {
+			return nil, ServerError.New("negative %s count %d", outcome.name, outcome.count)
+		}
+		attempts += outcome.count
+	}
+
+	for _, outcome := range outcomes {
+		mon.Meter("uplink_piece_upload_" + outcome.name).Mark(int(outcome.count))
+	}
+	if attempts > 0 {
+		mon.FloatVal("uplink_upload_success_rate").Observe(float64(stats.Success) / float64(attempts))
+	}
+
+	return &pb.UploadStatsResponse{}, nil
+}
+
 // NodeCriteria are the requirements for selecting nodes
 type NodeCriteria struct {
 	Type pb.NodeType
@@ -77,7 +121,28 @@ type NodeCriteria struct {
 	UptimeCount        int64
 	UptimeSuccessRatio float64
 
-	Excluded []storj.NodeID
+	UploadSuccessRatio float64
+
+	Tags map[string]string
+
+	// ExcludedIDs are never selected, e.g. the nodes already chosen for a segment
+	ExcludedIDs []storj.NodeID
+	// DistinctIPPrefix selects at most one node per /24 subnet (/64 for IPv6),
+	// none of which is shared with the excluded nodes
+	DistinctIPPrefix bool
+
+	// AllowedCountries restricts the nodes to these ISO country codes, nodes
+	// with an unknown country are left out
+	AllowedCountries []string
+	// ExcludedCountries are the ISO country codes of nodes, which are never selected
+	ExcludedCountries []string
+
+	// OnlineWindow selects only nodes, whose last uptime check succeeded
+	// within the window, it's disabled when zero
+	OnlineWindow time.Duration
+
+	// Vetted selects only nodes, which passed vetting
+	Vetted bool
 }
 
 // NewNodeCriteria are the requirement for selecting new nodes
@@ -89,13 +154,38 @@ type NewNodeCriteria struct {
 
 	AuditThreshold int64
 
-	Excluded []storj.NodeID
+	Tags map[string]string
+
+	// ExcludedIDs are never selected, e.g. the nodes already chosen for a segment
+	ExcludedIDs []storj.NodeID
+	// DistinctIPPrefix selects at most one node per /24 subnet (/64 for IPv6),
+	// none of which is shared with the excluded nodes
+	DistinctIPPrefix bool
+
+	// AllowedCountries restricts the nodes to these ISO country codes, nodes
+	// with an unknown country are left out
+	AllowedCountries []string
+	// ExcludedCountries are the ISO country codes of nodes, which are never selected
+	ExcludedCountries []string
+
+	// OnlineWindow selects only nodes, whose last uptime check succeeded
+	// within the window, it's disabled when zero
+	OnlineWindow time.Duration
+
+	// Unvetted selects the nodes, which haven't passed vetting yet, instead
+	// of the nodes below the audit threshold
+	Unvetted bool
 }
 
 // FindStorageNodes searches the overlay network for nodes that meet the provided requirements
 func (server *Server) FindStorageNodes(ctx context.Context, req *pb.FindStorageNodesRequest) (resp *pb.FindStorageNodesResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return server.FindStorageNodesWithPreferences(ctx, req, server.preferences)
+
+	server.mu.Lock()
+	preferences := server.preferences
+	server.mu.Unlock()
+
+	return server.FindStorageNodesWithPreferences(ctx, req, preferences)
 }
 
 // FindStorageNodesWithPreferences searches the overlay network for nodes that meet the provided requirements
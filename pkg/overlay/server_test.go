@@ -185,6 +185,16 @@ func TestNodeSelection(t *testing.T) {
 				ExpectedCount:  3,
 				ShouldFailWith: &overlay.ErrNotEnoughNodes,
 			},
+			{ // all nodes share the localhost subnet
+				Preferences: overlay.NodeSelectionConfig{
+					NewNodeAuditThreshold: 0,
+					NewNodePercentage:     0,
+					DistinctIP:            true,
+				},
+				RequestCount:   2,
+				ExpectedCount:  1,
+				ShouldFailWith: &overlay.ErrNotEnoughNodes,
+			},
 		} {
 			t.Logf("#%2d. %+v", i, tt)
 			endpoint := planet.Satellites[0].Overlay.Endpoint
@@ -0,0 +1,91 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/chore"
+)
+
+// StrayConfig configures the removal of stray nodes
+type StrayConfig struct {
+	Interval   time.Duration `help:"how often to look for stray nodes, 0 disables the cleanup" default:"24h"`
+	OfflineFor time.Duration `help:"how long an unvetted node must be offline to be removed" default:"720h"`
+	BatchSize  int           `help:"maximum number of stray nodes removed per run" default:"100"`
+}
+
+// StrayCleaner removes nodes which never completed vetting and have been
+// offline for a long time, so they don't slow down node selection.
+type StrayCleaner struct {
+	log        *zap.Logger
+	db         DB
+	accounting accounting.DB
+	config     StrayConfig
+
+	// auditThreshold is the number of audits a node needs to be vetted
+	auditThreshold int64
+
+	Chore *chore.Chore
+}
+
+// NewStrayCleaner creates a new stray node cleaner
+func NewStrayCleaner(log *zap.Logger, db DB, accountingDB accounting.DB, auditThreshold int64, config StrayConfig) *StrayCleaner {
+	cleaner := &StrayCleaner{
+		log:            log,
+		db:             db,
+		accounting:     accountingDB,
+		config:         config,
+		auditThreshold: auditThreshold,
+	}
+	cleaner.Chore = chore.New(log, "overlay:stray", config.Interval, cleaner.cleanup)
+	return cleaner
+}
+
+// Run removes stray nodes every interval
+func (cleaner *StrayCleaner) Run(ctx context.Context) error {
+	if cleaner.config.Interval <= 0 {
+		return nil
+	}
+	return cleaner.Chore.Run(ctx)
+}
+
+// cleanup removes a batch of stray nodes from the overlay together with
+// their raw tallies
+func (cleaner *StrayCleaner) cleanup(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cleaner.auditThreshold <= 0 {
+		// every node is vetted
+		return nil
+	}
+
+	ids, err := cleaner.db.ListStray(ctx, cleaner.auditThreshold, time.Now().Add(-cleaner.config.OfflineFor), cleaner.config.BatchSize)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// raw tallies are removed first, so that a failed run leaves the node
+	// in the overlay to be found again
+	if err := cleaner.accounting.DeleteRawForNodes(ctx, ids); err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, id := range ids {
+		if err := cleaner.db.Delete(ctx, id); err != nil {
+			return Error.Wrap(err)
+		}
+		cleaner.log.Info("removed stray node", zap.String("Node ID", id.String()))
+	}
+	mon.Meter("stray_nodes_removed").Mark(len(ids))
+
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestListStray(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+
+		storageID := teststorj.NodeIDFromString("storage")
+		satelliteID := teststorj.NodeIDFromString("satellite")
+		require.NoError(t, cache.Put(ctx, storageID, pb.Node{Id: storageID, Type: pb.NodeType_STORAGE}))
+		require.NoError(t, cache.Put(ctx, satelliteID, pb.Node{Id: satelliteID, Type: pb.NodeType_SATELLITE}))
+
+		{ // recently seen nodes aren't stray
+			ids, err := db.OverlayCache().ListStray(ctx, 1, time.Now().Add(-time.Hour), 10)
+			require.NoError(t, err)
+			assert.Len(t, ids, 0)
+		}
+
+		{ // vetted nodes aren't stray
+			ids, err := db.OverlayCache().ListStray(ctx, 0, time.Now().Add(time.Hour), 10)
+			require.NoError(t, err)
+			assert.Len(t, ids, 0)
+		}
+
+		ids, err := db.OverlayCache().ListStray(ctx, 1, time.Now().Add(time.Hour), 10)
+		require.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{storageID}, ids)
+
+		require.NoError(t, db.Accounting().SaveAtRestRaw(ctx, time.Now(), time.Now(), map[storj.NodeID]float64{storageID: 10}))
+		require.NoError(t, db.Accounting().DeleteRawForNodes(ctx, ids))
+
+		raws, err := db.Accounting().GetRaw(ctx)
+		require.NoError(t, err)
+		assert.Len(t, raws, 0)
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrTag is returned for invalid node tags
+var ErrTag = errs.Class("node tag error")
+
+// ParseTags parses a comma-separated list of name=value tags
+func ParseTags(tags string) (map[string]string, error) {
+	if tags == "" {
+		return nil, nil
+	}
+
+	parsed := make(map[string]string)
+	for _, tag := range strings.Split(tags, ",") {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, ErrTag.New("invalid tag %q, expected name=value", tag)
+		}
+		parsed[parts[0]] = parts[1]
+	}
+	return parsed, nil
+}
+
+// SignTag signs a node tag with the identity of the signer
+func SignTag(tag *pb.NodeTag, signer *identity.FullIdentity) error {
+	tag.SignerId = signer.ID
+	return ErrTag.Wrap(auth.SignMessage(tag, *signer))
+}
+
+// VerifyTag checks that a node tag is complete and signed by one of the
+// authorized signers
+func VerifyTag(tag *pb.NodeTag, signers storj.NodeIDList) error {
+	switch {
+	case tag.NodeId.IsZero():
+		return ErrTag.Wrap(auth.ErrMissing.New("node id"))
+	case tag.Name == "":
+		return ErrTag.Wrap(auth.ErrMissing.New("name"))
+	case tag.SignerId.IsZero():
+		return ErrTag.Wrap(auth.ErrMissing.New("signer id"))
+	}
+
+	authorized := false
+	for _, signer := range signers {
+		if signer == tag.SignerId {
+			authorized = true
+			break
+		}
+	}
+	if !authorized {
+		return ErrTag.New("signer %s isn't authorized", tag.SignerId)
+	}
+
+	return ErrTag.Wrap(auth.VerifyMsg(tag, tag.SignerId))
+}
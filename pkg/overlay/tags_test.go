@@ -0,0 +1,95 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestParseTags(t *testing.T) {
+	tags, err := overlay.ParseTags("")
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	tags, err = overlay.ParseTags("hardware=soc2,region=eu")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"hardware": "soc2", "region": "eu"}, tags)
+
+	for _, invalid := range []string{"hardware", "=soc2", "hardware=soc2,"} {
+		_, err = overlay.ParseTags(invalid)
+		assert.True(t, overlay.ErrTag.Has(err), invalid)
+	}
+}
+
+func TestVerifyTag(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	signer, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+	other, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	tag := &pb.NodeTag{
+		NodeId: teststorj.NodeIDFromString("storage"),
+		Name:   "hardware",
+		Value:  "soc2",
+	}
+	require.NoError(t, overlay.SignTag(tag, signer))
+
+	assert.NoError(t, overlay.VerifyTag(tag, storj.NodeIDList{other.ID, signer.ID}))
+	assert.Error(t, overlay.VerifyTag(tag, storj.NodeIDList{other.ID}))
+
+	tag.Value = "none"
+	assert.Error(t, overlay.VerifyTag(tag, storj.NodeIDList{signer.ID}))
+}
+
+func TestRequiredTags(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB())
+
+		tagged := teststorj.NodeIDFromString("tagged")
+		untagged := teststorj.NodeIDFromString("untagged")
+		for _, id := range []storj.NodeID{tagged, untagged} {
+			require.NoError(t, cache.Put(ctx, id, pb.Node{
+				Id:           id,
+				Type:         pb.NodeType_STORAGE,
+				Restrictions: &pb.NodeRestrictions{FreeBandwidth: 1, FreeDisk: 1},
+			}))
+		}
+
+		for _, value := range []string{"none", "soc2"} {
+			require.NoError(t, cache.UpdateTag(ctx, &pb.NodeTag{NodeId: tagged, Name: "hardware", Value: value}))
+		}
+
+		// a tag with the same name replaces the earlier one
+		tags, err := cache.GetTags(ctx, tagged)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		assert.Equal(t, "soc2", tags[0].Value)
+
+		nodes, err := cache.FindStorageNodes(ctx,
+			&pb.FindStorageNodesRequest{Opts: &pb.OverlayOptions{Amount: 2, Restrictions: &pb.NodeRestrictions{}}},
+			&overlay.NodeSelectionConfig{RequiredTags: "hardware=soc2"},
+		)
+		assert.True(t, overlay.ErrNotEnoughNodes.Has(err))
+		require.Len(t, nodes, 1)
+		assert.Equal(t, tagged, nodes[0].Id)
+	})
+}
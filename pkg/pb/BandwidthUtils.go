@@ -58,3 +58,33 @@ func (m *RenterBandwidthAllocation) SetCerts(certs [][]byte) {
 func (m *RenterBandwidthAllocation) SetSignature(signature []byte) {
 	m.Signature = signature
 }
+
+//SetCerts updates the certs field, completing the auth.SignedMsg interface
+func (m *NodeTally) SetCerts(certs [][]byte) {
+	m.Certs = certs
+}
+
+//SetSignature updates the signature field, completing the auth.SignedMsg interface
+func (m *NodeTally) SetSignature(signature []byte) {
+	m.Signature = signature
+}
+
+//SetCerts updates the certs field, completing the auth.SignedMsg interface
+func (m *NodeTag) SetCerts(certs [][]byte) {
+	m.Certs = certs
+}
+
+//SetSignature updates the signature field, completing the auth.SignedMsg interface
+func (m *NodeTag) SetSignature(signature []byte) {
+	m.Signature = signature
+}
+
+//SetCerts updates the certs field, completing the auth.SignedMsg interface
+func (m *AgreementReceipt) SetCerts(certs [][]byte) {
+	m.Certs = certs
+}
+
+//SetSignature updates the signature field, completing the auth.SignedMsg interface
+func (m *AgreementReceipt) SetSignature(signature []byte) {
+	m.Signature = signature
+}
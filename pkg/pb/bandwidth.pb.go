@@ -6,6 +6,7 @@ package pb
 import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
 
 import (
 	context "golang.org/x/net/context"
@@ -29,38 +30,82 @@ const (
 	AgreementsSummary_FAIL     AgreementsSummary_Status = 0
 	AgreementsSummary_OK       AgreementsSummary_Status = 1
 	AgreementsSummary_REJECTED AgreementsSummary_Status = 2
+	// THROTTLED means the storage node submitted too many agreements, it should retry later
+	AgreementsSummary_THROTTLED AgreementsSummary_Status = 3
 )
 
 var AgreementsSummary_Status_name = map[int32]string{
 	0: "FAIL",
 	1: "OK",
 	2: "REJECTED",
+	3: "THROTTLED",
 }
 var AgreementsSummary_Status_value = map[string]int32{
-	"FAIL":     0,
-	"OK":       1,
-	"REJECTED": 2,
+	"FAIL":      0,
+	"OK":        1,
+	"REJECTED":  2,
+	"THROTTLED": 3,
 }
 
 func (x AgreementsSummary_Status) String() string {
 	return proto.EnumName(AgreementsSummary_Status_name, int32(x))
 }
 func (AgreementsSummary_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bandwidth_da05cbbeac812a9d, []int{0, 0}
+	return fileDescriptor_bandwidth_8ba61acf4474c32f, []int{0, 0}
+}
+
+type AgreementVerification_Check int32
+
+const (
+	AgreementVerification_PEER_ID          AgreementVerification_Check = 0
+	AgreementVerification_SATELLITE_ID     AgreementVerification_Check = 1
+	AgreementVerification_EXPIRATION       AgreementVerification_Check = 2
+	AgreementVerification_RENTER_SIGNATURE AgreementVerification_Check = 3
+	AgreementVerification_PAYER_SIGNATURE  AgreementVerification_Check = 4
+	AgreementVerification_SERIAL_NUMBER    AgreementVerification_Check = 5
+	AgreementVerification_TOTAL            AgreementVerification_Check = 6
+)
+
+var AgreementVerification_Check_name = map[int32]string{
+	0: "PEER_ID",
+	1: "SATELLITE_ID",
+	2: "EXPIRATION",
+	3: "RENTER_SIGNATURE",
+	4: "PAYER_SIGNATURE",
+	5: "SERIAL_NUMBER",
+	6: "TOTAL",
+}
+var AgreementVerification_Check_value = map[string]int32{
+	"PEER_ID":          0,
+	"SATELLITE_ID":     1,
+	"EXPIRATION":       2,
+	"RENTER_SIGNATURE": 3,
+	"PAYER_SIGNATURE":  4,
+	"SERIAL_NUMBER":    5,
+	"TOTAL":            6,
+}
+
+func (x AgreementVerification_Check) String() string {
+	return proto.EnumName(AgreementVerification_Check_name, int32(x))
+}
+func (AgreementVerification_Check) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bandwidth_8ba61acf4474c32f, []int{2, 0}
 }
 
 type AgreementsSummary struct {
-	Status               AgreementsSummary_Status `protobuf:"varint,1,opt,name=status,proto3,enum=bandwidth.AgreementsSummary_Status" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Status AgreementsSummary_Status `protobuf:"varint,1,opt,name=status,proto3,enum=bandwidth.AgreementsSummary_Status" json:"status,omitempty"`
+	// receipt is the proof that the satellite accepted the agreement, set when status is OK
+	Receipt              *AgreementReceipt `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *AgreementsSummary) Reset()         { *m = AgreementsSummary{} }
 func (m *AgreementsSummary) String() string { return proto.CompactTextString(m) }
 func (*AgreementsSummary) ProtoMessage()    {}
 func (*AgreementsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bandwidth_da05cbbeac812a9d, []int{0}
+	return fileDescriptor_bandwidth_8ba61acf4474c32f, []int{0}
 }
 func (m *AgreementsSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AgreementsSummary.Unmarshal(m, b)
@@ -87,9 +132,180 @@ func (m *AgreementsSummary) GetStatus() AgreementsSummary_Status {
 	return AgreementsSummary_FAIL
 }
 
+func (m *AgreementsSummary) GetReceipt() *AgreementReceipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+// AgreementReceipt is signed by the satellite for every accepted agreement,
+// so storage nodes can dispute missing payments
+type AgreementReceipt struct {
+	SerialNumber         string   `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	StorageNodeId        NodeID   `protobuf:"bytes,2,opt,name=storage_node_id,json=storageNodeId,proto3,customtype=NodeID" json:"storage_node_id"`
+	SatelliteId          NodeID   `protobuf:"bytes,3,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	AcceptedUnixSec      int64    `protobuf:"varint,4,opt,name=accepted_unix_sec,json=acceptedUnixSec,proto3" json:"accepted_unix_sec,omitempty"`
+	Certs                [][]byte `protobuf:"bytes,5,rep,name=certs,proto3" json:"certs,omitempty"`
+	Signature            []byte   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgreementReceipt) Reset()         { *m = AgreementReceipt{} }
+func (m *AgreementReceipt) String() string { return proto.CompactTextString(m) }
+func (*AgreementReceipt) ProtoMessage()    {}
+func (*AgreementReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bandwidth_8ba61acf4474c32f, []int{1}
+}
+func (m *AgreementReceipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgreementReceipt.Unmarshal(m, b)
+}
+func (m *AgreementReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgreementReceipt.Marshal(b, m, deterministic)
+}
+func (dst *AgreementReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgreementReceipt.Merge(dst, src)
+}
+func (m *AgreementReceipt) XXX_Size() int {
+	return xxx_messageInfo_AgreementReceipt.Size(m)
+}
+func (m *AgreementReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgreementReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgreementReceipt proto.InternalMessageInfo
+
+func (m *AgreementReceipt) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *AgreementReceipt) GetAcceptedUnixSec() int64 {
+	if m != nil {
+		return m.AcceptedUnixSec
+	}
+	return 0
+}
+
+func (m *AgreementReceipt) GetCerts() [][]byte {
+	if m != nil {
+		return m.Certs
+	}
+	return nil
+}
+
+func (m *AgreementReceipt) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// AgreementVerification lists the failed checks of an agreement
+type AgreementVerification struct {
+	// valid is true when the agreement passed every check
+	Valid                bool                             `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Failures             []*AgreementVerification_Failure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *AgreementVerification) Reset()         { *m = AgreementVerification{} }
+func (m *AgreementVerification) String() string { return proto.CompactTextString(m) }
+func (*AgreementVerification) ProtoMessage()    {}
+func (*AgreementVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bandwidth_8ba61acf4474c32f, []int{2}
+}
+func (m *AgreementVerification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgreementVerification.Unmarshal(m, b)
+}
+func (m *AgreementVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgreementVerification.Marshal(b, m, deterministic)
+}
+func (dst *AgreementVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgreementVerification.Merge(dst, src)
+}
+func (m *AgreementVerification) XXX_Size() int {
+	return xxx_messageInfo_AgreementVerification.Size(m)
+}
+func (m *AgreementVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgreementVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgreementVerification proto.InternalMessageInfo
+
+func (m *AgreementVerification) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *AgreementVerification) GetFailures() []*AgreementVerification_Failure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+type AgreementVerification_Failure struct {
+	Check                AgreementVerification_Check `protobuf:"varint,1,opt,name=check,proto3,enum=bandwidth.AgreementVerification_Check" json:"check,omitempty"`
+	Message              string                      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *AgreementVerification_Failure) Reset()         { *m = AgreementVerification_Failure{} }
+func (m *AgreementVerification_Failure) String() string { return proto.CompactTextString(m) }
+func (*AgreementVerification_Failure) ProtoMessage()    {}
+func (*AgreementVerification_Failure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bandwidth_8ba61acf4474c32f, []int{2, 0}
+}
+func (m *AgreementVerification_Failure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgreementVerification_Failure.Unmarshal(m, b)
+}
+func (m *AgreementVerification_Failure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgreementVerification_Failure.Marshal(b, m, deterministic)
+}
+func (dst *AgreementVerification_Failure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgreementVerification_Failure.Merge(dst, src)
+}
+func (m *AgreementVerification_Failure) XXX_Size() int {
+	return xxx_messageInfo_AgreementVerification_Failure.Size(m)
+}
+func (m *AgreementVerification_Failure) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgreementVerification_Failure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgreementVerification_Failure proto.InternalMessageInfo
+
+func (m *AgreementVerification_Failure) GetCheck() AgreementVerification_Check {
+	if m != nil {
+		return m.Check
+	}
+	return AgreementVerification_PEER_ID
+}
+
+func (m *AgreementVerification_Failure) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AgreementsSummary)(nil), "bandwidth.AgreementsSummary")
+	proto.RegisterType((*AgreementReceipt)(nil), "bandwidth.AgreementReceipt")
+	proto.RegisterType((*AgreementVerification)(nil), "bandwidth.AgreementVerification")
+	proto.RegisterType((*AgreementVerification_Failure)(nil), "bandwidth.AgreementVerification.Failure")
 	proto.RegisterEnum("bandwidth.AgreementsSummary_Status", AgreementsSummary_Status_name, AgreementsSummary_Status_value)
+	proto.RegisterEnum("bandwidth.AgreementVerification_Check", AgreementVerification_Check_name, AgreementVerification_Check_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -105,6 +321,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type BandwidthClient interface {
 	BandwidthAgreements(ctx context.Context, in *RenterBandwidthAllocation, opts ...grpc.CallOption) (*AgreementsSummary, error)
+	// VerifyAgreement runs every check of BandwidthAgreements without storing the agreement
+	VerifyAgreement(ctx context.Context, in *RenterBandwidthAllocation, opts ...grpc.CallOption) (*AgreementVerification, error)
 }
 
 type bandwidthClient struct {
@@ -124,9 +342,20 @@ func (c *bandwidthClient) BandwidthAgreements(ctx context.Context, in *RenterBan
 	return out, nil
 }
 
+func (c *bandwidthClient) VerifyAgreement(ctx context.Context, in *RenterBandwidthAllocation, opts ...grpc.CallOption) (*AgreementVerification, error) {
+	out := new(AgreementVerification)
+	err := c.cc.Invoke(ctx, "/bandwidth.Bandwidth/VerifyAgreement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BandwidthServer is the server API for Bandwidth service.
 type BandwidthServer interface {
 	BandwidthAgreements(context.Context, *RenterBandwidthAllocation) (*AgreementsSummary, error)
+	// VerifyAgreement runs every check of BandwidthAgreements without storing the agreement
+	VerifyAgreement(context.Context, *RenterBandwidthAllocation) (*AgreementVerification, error)
 }
 
 func RegisterBandwidthServer(s *grpc.Server, srv BandwidthServer) {
@@ -151,6 +380,24 @@ func _Bandwidth_BandwidthAgreements_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bandwidth_VerifyAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenterBandwidthAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BandwidthServer).VerifyAgreement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bandwidth.Bandwidth/VerifyAgreement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BandwidthServer).VerifyAgreement(ctx, req.(*RenterBandwidthAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Bandwidth_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "bandwidth.Bandwidth",
 	HandlerType: (*BandwidthServer)(nil),
@@ -159,27 +406,56 @@ var _Bandwidth_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BandwidthAgreements",
 			Handler:    _Bandwidth_BandwidthAgreements_Handler,
 		},
+		{
+			MethodName: "VerifyAgreement",
+			Handler:    _Bandwidth_VerifyAgreement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bandwidth.proto",
 }
 
-func init() { proto.RegisterFile("bandwidth.proto", fileDescriptor_bandwidth_da05cbbeac812a9d) }
-
-var fileDescriptor_bandwidth_da05cbbeac812a9d = []byte{
-	// 210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x4a, 0xcc, 0x4b,
-	0x29, 0xcf, 0x4c, 0x29, 0xc9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x84, 0x0b, 0x48,
-	0x09, 0x14, 0x64, 0xa6, 0x26, 0xa7, 0x16, 0x97, 0xe4, 0x17, 0xa5, 0x42, 0x24, 0x95, 0xaa, 0xb8,
-	0x04, 0x1d, 0xd3, 0x8b, 0x52, 0x53, 0x73, 0x53, 0xf3, 0x4a, 0x8a, 0x83, 0x4b, 0x73, 0x73, 0x13,
-	0x8b, 0x2a, 0x85, 0xac, 0xb9, 0xd8, 0x8a, 0x4b, 0x12, 0x4b, 0x4a, 0x8b, 0x25, 0x18, 0x15, 0x18,
-	0x35, 0xf8, 0x8c, 0x94, 0xf5, 0x10, 0x66, 0x62, 0xa8, 0xd6, 0x0b, 0x06, 0x2b, 0x0d, 0x82, 0x6a,
-	0x51, 0xd2, 0xe0, 0x62, 0x83, 0x88, 0x08, 0x71, 0x70, 0xb1, 0xb8, 0x39, 0x7a, 0xfa, 0x08, 0x30,
-	0x08, 0xb1, 0x71, 0x31, 0xf9, 0x7b, 0x0b, 0x30, 0x0a, 0xf1, 0x70, 0x71, 0x04, 0xb9, 0x7a, 0xb9,
-	0x3a, 0x87, 0xb8, 0xba, 0x08, 0x30, 0x19, 0xe5, 0x73, 0x71, 0x3a, 0xc1, 0xcc, 0x15, 0x4a, 0xe2,
-	0x12, 0x86, 0x73, 0x10, 0x76, 0x08, 0x69, 0xeb, 0x21, 0x9c, 0x5c, 0x94, 0x5f, 0x5a, 0x92, 0x5a,
-	0xac, 0x17, 0x94, 0x9a, 0x57, 0x92, 0x5a, 0x84, 0x50, 0x9c, 0x93, 0x93, 0x9f, 0x9c, 0x58, 0x92,
-	0x99, 0x9f, 0x27, 0x25, 0x83, 0xcf, 0x9d, 0x4a, 0x0c, 0x4e, 0x2c, 0x51, 0x4c, 0x05, 0x49, 0x49,
-	0x6c, 0x60, 0x9f, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x6a, 0xd9, 0xfd, 0x29, 0x01,
-	0x00, 0x00,
+func init() { proto.RegisterFile("bandwidth.proto", fileDescriptor_bandwidth_8ba61acf4474c32f) }
+
+var fileDescriptor_bandwidth_8ba61acf4474c32f = []byte{
+	// 615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0x9d, 0xc4, 0x89, 0x6f, 0x92, 0xc6, 0x9d, 0xf6, 0x49, 0x56, 0x5e, 0xa5, 0x46, 0xae,
+	0xf4, 0x14, 0x3d, 0xa4, 0x48, 0x04, 0x01, 0x0b, 0xd8, 0x38, 0xcd, 0x14, 0x0c, 0x21, 0xa9, 0x26,
+	0x2e, 0x02, 0x36, 0x96, 0x63, 0xdf, 0xa6, 0x23, 0x1c, 0x3b, 0xb2, 0xc7, 0xd0, 0xae, 0x90, 0xf8,
+	0x65, 0x2c, 0xd9, 0xb3, 0x40, 0x62, 0xd1, 0xdf, 0x82, 0x62, 0xe7, 0x83, 0x8f, 0x8a, 0x8a, 0xe5,
+	0x39, 0x73, 0xce, 0x99, 0xdc, 0x33, 0xb9, 0x86, 0xe6, 0xd4, 0x0d, 0xfd, 0xf7, 0xdc, 0x17, 0x17,
+	0xdd, 0x45, 0x1c, 0x89, 0x88, 0xa8, 0x1b, 0xa2, 0x05, 0xb3, 0x68, 0x16, 0xe5, 0x74, 0x4b, 0x5b,
+	0x70, 0xf4, 0x30, 0x11, 0x51, 0x8c, 0x39, 0x63, 0x7c, 0x92, 0x60, 0xd7, 0x9c, 0xc5, 0x88, 0x73,
+	0x0c, 0x45, 0x32, 0x49, 0xe7, 0x73, 0x37, 0xbe, 0x22, 0x8f, 0x40, 0x49, 0x84, 0x2b, 0xd2, 0x44,
+	0x97, 0xda, 0x52, 0x67, 0xa7, 0x77, 0xd4, 0xdd, 0x5e, 0xf0, 0x9b, 0xba, 0x3b, 0xc9, 0xa4, 0x6c,
+	0x65, 0x21, 0xf7, 0xa1, 0x12, 0xa3, 0x87, 0x7c, 0x21, 0x74, 0xb9, 0x2d, 0x75, 0x6a, 0xbd, 0x7f,
+	0x6f, 0x72, 0xb3, 0x5c, 0xc2, 0xd6, 0x5a, 0xe3, 0x21, 0x28, 0x79, 0x10, 0xa9, 0x42, 0xe9, 0xc4,
+	0xb4, 0x86, 0x5a, 0x81, 0x28, 0x20, 0x8f, 0x9f, 0x6b, 0x12, 0xa9, 0x43, 0x95, 0xd1, 0x67, 0xf4,
+	0xd8, 0xa6, 0x03, 0x4d, 0x26, 0x0d, 0x50, 0xed, 0xa7, 0x6c, 0x6c, 0xdb, 0x43, 0x3a, 0xd0, 0x8a,
+	0xc6, 0x47, 0x19, 0xb4, 0x5f, 0x63, 0xc9, 0x11, 0x34, 0x12, 0x8c, 0xb9, 0x1b, 0x38, 0x61, 0x3a,
+	0x9f, 0x62, 0x9c, 0x0d, 0xa2, 0xb2, 0x7a, 0x4e, 0x8e, 0x32, 0x8e, 0x3c, 0x80, 0xe6, 0xb2, 0x0b,
+	0x77, 0x86, 0x4e, 0x18, 0xf9, 0xe8, 0x70, 0x3f, 0xfb, 0xc5, 0xf5, 0xfe, 0xce, 0xe7, 0xeb, 0xc3,
+	0xc2, 0xb7, 0xeb, 0x43, 0x65, 0x14, 0xf9, 0x68, 0x0d, 0x58, 0x63, 0x25, 0xcb, 0xa0, 0x4f, 0xee,
+	0x42, 0x3d, 0x71, 0x05, 0x06, 0x01, 0x17, 0x99, 0xa9, 0x78, 0xa3, 0xa9, 0xb6, 0xd1, 0x58, 0x3e,
+	0xf9, 0x1f, 0x76, 0x5d, 0xcf, 0xc3, 0x85, 0x40, 0xdf, 0x49, 0x43, 0x7e, 0xe9, 0x24, 0xe8, 0xe9,
+	0xa5, 0xb6, 0xd4, 0x29, 0xb2, 0xe6, 0xfa, 0xe0, 0x2c, 0xe4, 0x97, 0x13, 0xf4, 0xc8, 0x3e, 0x94,
+	0x3d, 0x8c, 0x45, 0xa2, 0x97, 0xdb, 0xc5, 0x4e, 0x9d, 0xe5, 0x80, 0x1c, 0x80, 0x9a, 0xf0, 0x59,
+	0xe8, 0x8a, 0x34, 0x46, 0x5d, 0x59, 0xde, 0xc8, 0xb6, 0x84, 0xf1, 0x55, 0x86, 0x7f, 0x36, 0x25,
+	0xbc, 0xc4, 0x98, 0x9f, 0x73, 0xcf, 0x15, 0x3c, 0x0a, 0x97, 0x69, 0xef, 0xdc, 0x80, 0xfb, 0x59,
+	0x03, 0x55, 0x96, 0x03, 0x32, 0x80, 0xea, 0xb9, 0xcb, 0x83, 0x34, 0xc6, 0x44, 0x97, 0xdb, 0xc5,
+	0x4e, 0xad, 0xd7, 0xb9, 0xe9, 0x95, 0x7e, 0x4c, 0xea, 0x9e, 0xe4, 0x06, 0xb6, 0x71, 0xb6, 0x5c,
+	0xa8, 0xac, 0x48, 0xf2, 0x18, 0xca, 0xde, 0x05, 0x7a, 0x6f, 0x57, 0xff, 0x98, 0xff, 0x6e, 0x4d,
+	0x3b, 0x5e, 0xaa, 0x59, 0x6e, 0x22, 0x3a, 0x54, 0xe6, 0x98, 0x24, 0xee, 0x0c, 0xb3, 0x17, 0x50,
+	0xd9, 0x1a, 0x1a, 0x1f, 0xa0, 0x9c, 0x29, 0x49, 0x0d, 0x2a, 0xa7, 0x94, 0x32, 0xc7, 0x1a, 0x68,
+	0x05, 0xa2, 0x41, 0x7d, 0x62, 0xda, 0x74, 0x38, 0xb4, 0x6c, 0xba, 0x64, 0x24, 0xb2, 0x03, 0x40,
+	0x5f, 0x9d, 0x5a, 0xcc, 0xb4, 0xad, 0xf1, 0x48, 0x93, 0xc9, 0x3e, 0x68, 0x8c, 0x8e, 0x6c, 0xca,
+	0x9c, 0x89, 0xf5, 0x64, 0x64, 0xda, 0x67, 0x8c, 0x6a, 0x45, 0xb2, 0x07, 0xcd, 0x53, 0xf3, 0xf5,
+	0x4f, 0x64, 0x89, 0xec, 0x42, 0x63, 0x42, 0x99, 0x65, 0x0e, 0x9d, 0xd1, 0xd9, 0x8b, 0x3e, 0x65,
+	0x5a, 0x99, 0xa8, 0x50, 0xb6, 0xc7, 0xb6, 0x39, 0xd4, 0x94, 0xde, 0x17, 0x09, 0xd4, 0xfe, 0x7a,
+	0x16, 0x32, 0x85, 0xbd, 0x0d, 0xd8, 0x6e, 0x02, 0xb9, 0xd3, 0xdd, 0x6e, 0x56, 0x1c, 0xa5, 0x02,
+	0x93, 0x2e, 0xc3, 0x50, 0x60, 0xbc, 0x15, 0x07, 0x41, 0x94, 0x4f, 0xde, 0x3a, 0xf8, 0xd3, 0x36,
+	0x19, 0x05, 0x32, 0x85, 0x66, 0xd6, 0xd4, 0xd5, 0xe6, 0xf0, 0xef, 0xf2, 0xdb, 0xb7, 0x75, 0x6f,
+	0x14, 0xfa, 0xa5, 0x37, 0xf2, 0x62, 0x3a, 0x55, 0xb2, 0x8f, 0xc0, 0xbd, 0xef, 0x03, 0x00, 0xba,
+	0x43, 0xd4, 0x8f, 0x40, 0x04, 0x00, 0x00,
 }
@@ -0,0 +1,122 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pb_test
+
+import (
+	"encoding/hex"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "write the golden wire fixtures of the current version")
+
+// TestWireCompatibility verifies that messages encoded by previous versions
+// still decode, and that the encoding of signed messages hasn't changed.
+//
+// When a message gets new fields, add a fixture with the next version instead
+// of updating an existing one: old fixtures are the output of old binaries.
+func TestWireCompatibility(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		message proto.Message
+		decoded proto.Message
+	}{
+		{"payer_bandwidth_allocation_v1", goldenPayerAllocation(), &pb.PayerBandwidthAllocation{}},
+		{"renter_bandwidth_allocation_v1", goldenRenterAllocation(), &pb.RenterBandwidthAllocation{}},
+		{"pointer_v1", goldenPointer(), &pb.Pointer{}},
+	} {
+		path := filepath.Join("testdata", tt.name+".golden")
+
+		encoded, err := proto.Marshal(tt.message)
+		require.NoError(t, err, tt.name)
+
+		if *updateGolden {
+			err := ioutil.WriteFile(path, []byte(hex.EncodeToString(encoded)+"\n"), 0644)
+			require.NoError(t, err, tt.name)
+			continue
+		}
+
+		data, err := ioutil.ReadFile(path)
+		require.NoError(t, err, tt.name)
+		golden, err := hex.DecodeString(strings.TrimSpace(string(data)))
+		require.NoError(t, err, tt.name)
+
+		// signatures are computed over the encoding, so it must stay the same
+		assert.Equal(t, golden, encoded, tt.name)
+
+		// the output of older versions must decode without losing fields
+		require.NoError(t, proto.Unmarshal(golden, tt.decoded), tt.name)
+		reencoded, err := proto.Marshal(tt.decoded)
+		require.NoError(t, err, tt.name)
+		assert.Equal(t, golden, reencoded, tt.name)
+	}
+}
+
+func goldenNodeID(b byte) storj.NodeID {
+	var id storj.NodeID
+	for i := range id {
+		id[i] = b
+	}
+	return id
+}
+
+func goldenPayerAllocation() *pb.PayerBandwidthAllocation {
+	return &pb.PayerBandwidthAllocation{
+		SatelliteId:       goldenNodeID(1),
+		UplinkId:          goldenNodeID(2),
+		MaxSize:           1 << 20,
+		ExpirationUnixSec: 1546300800,
+		SerialNumber:      "serial-1",
+		Action:            pb.BandwidthAction_GET_REPAIR,
+		CreatedUnixSec:    1546214400,
+		Certs:             [][]byte{[]byte("satellite-cert-0"), []byte("satellite-cert-1")},
+		Signature:         []byte("satellite-signature"),
+	}
+}
+
+func goldenRenterAllocation() *pb.RenterBandwidthAllocation {
+	return &pb.RenterBandwidthAllocation{
+		PayerAllocation: *goldenPayerAllocation(),
+		Total:           512 << 10,
+		StorageNodeId:   goldenNodeID(3),
+		Certs:           [][]byte{[]byte("uplink-cert")},
+		Signature:       []byte("uplink-signature"),
+	}
+}
+
+func goldenPointer() *pb.Pointer {
+	return &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			Redundancy: &pb.RedundancyScheme{
+				Type:             pb.RedundancyScheme_RS,
+				MinReq:           2,
+				Total:            4,
+				RepairThreshold:  3,
+				SuccessThreshold: 4,
+				ErasureShareSize: 1024,
+			},
+			PieceId: "piece-id",
+			RemotePieces: []*pb.RemotePiece{
+				{PieceNum: 0, NodeId: goldenNodeID(3)},
+				{PieceNum: 2, NodeId: goldenNodeID(4)},
+			},
+		},
+		SegmentSize:    4096,
+		CreationDate:   &timestamp.Timestamp{Seconds: 1546214400},
+		ExpirationDate: &timestamp.Timestamp{Seconds: 1546300800},
+		Metadata:       []byte("metadata"),
+	}
+}
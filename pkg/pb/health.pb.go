@@ -0,0 +1,314 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: health.proto
+
+package pb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// HealthRequest is a request message for the Liveness and Readiness rpc calls
+type HealthRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthRequest) Reset()         { *m = HealthRequest{} }
+func (m *HealthRequest) String() string { return proto.CompactTextString(m) }
+func (*HealthRequest) ProtoMessage()    {}
+func (*HealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_health_a669b378b65de633, []int{0}
+}
+func (m *HealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthRequest.Unmarshal(m, b)
+}
+func (m *HealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthRequest.Marshal(b, m, deterministic)
+}
+func (dst *HealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthRequest.Merge(dst, src)
+}
+func (m *HealthRequest) XXX_Size() int {
+	return xxx_messageInfo_HealthRequest.Size(m)
+}
+func (m *HealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthRequest proto.InternalMessageInfo
+
+// HealthResponse is a response message for the Liveness and Readiness rpc calls
+type HealthResponse struct {
+	Healthy              bool                 `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Subsystems           []*SubsystemHealth   `protobuf:"bytes,2,rep,name=subsystems,proto3" json:"subsystems,omitempty"`
+	CheckedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *HealthResponse) Reset()         { *m = HealthResponse{} }
+func (m *HealthResponse) String() string { return proto.CompactTextString(m) }
+func (*HealthResponse) ProtoMessage()    {}
+func (*HealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_health_a669b378b65de633, []int{1}
+}
+func (m *HealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthResponse.Unmarshal(m, b)
+}
+func (m *HealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthResponse.Marshal(b, m, deterministic)
+}
+func (dst *HealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthResponse.Merge(dst, src)
+}
+func (m *HealthResponse) XXX_Size() int {
+	return xxx_messageInfo_HealthResponse.Size(m)
+}
+func (m *HealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthResponse proto.InternalMessageInfo
+
+func (m *HealthResponse) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *HealthResponse) GetSubsystems() []*SubsystemHealth {
+	if m != nil {
+		return m.Subsystems
+	}
+	return nil
+}
+
+func (m *HealthResponse) GetCheckedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CheckedAt
+	}
+	return nil
+}
+
+// SubsystemHealth is the result of checking a single subsystem
+type SubsystemHealth struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Healthy bool   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// error is empty for healthy subsystems
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs           int64    `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubsystemHealth) Reset()         { *m = SubsystemHealth{} }
+func (m *SubsystemHealth) String() string { return proto.CompactTextString(m) }
+func (*SubsystemHealth) ProtoMessage()    {}
+func (*SubsystemHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_health_a669b378b65de633, []int{2}
+}
+func (m *SubsystemHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubsystemHealth.Unmarshal(m, b)
+}
+func (m *SubsystemHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubsystemHealth.Marshal(b, m, deterministic)
+}
+func (dst *SubsystemHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubsystemHealth.Merge(dst, src)
+}
+func (m *SubsystemHealth) XXX_Size() int {
+	return xxx_messageInfo_SubsystemHealth.Size(m)
+}
+func (m *SubsystemHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubsystemHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubsystemHealth proto.InternalMessageInfo
+
+func (m *SubsystemHealth) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SubsystemHealth) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *SubsystemHealth) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *SubsystemHealth) GetDurationMs() int64 {
+	if m != nil {
+		return m.DurationMs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*HealthRequest)(nil), "health.HealthRequest")
+	proto.RegisterType((*HealthResponse)(nil), "health.HealthResponse")
+	proto.RegisterType((*SubsystemHealth)(nil), "health.SubsystemHealth")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// HealthClient is the client API for Health service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HealthClient interface {
+	// Liveness reports whether the process is responsive, failing it means the process should be restarted
+	Liveness(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	// Readiness checks the subsystems of the process, failing it means no traffic should be sent to the process
+	Readiness(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+}
+
+type healthClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHealthClient(cc *grpc.ClientConn) HealthClient {
+	return &healthClient{cc}
+}
+
+func (c *healthClient) Liveness(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/health.Health/Liveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthClient) Readiness(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/health.Health/Readiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HealthServer is the server API for Health service.
+type HealthServer interface {
+	// Liveness reports whether the process is responsive, failing it means the process should be restarted
+	Liveness(context.Context, *HealthRequest) (*HealthResponse, error)
+	// Readiness checks the subsystems of the process, failing it means no traffic should be sent to the process
+	Readiness(context.Context, *HealthRequest) (*HealthResponse, error)
+}
+
+func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
+	s.RegisterService(&_Health_serviceDesc, srv)
+}
+
+func _Health_Liveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServer).Liveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/health.Health/Liveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).Liveness(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Health_Readiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServer).Readiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/health.Health/Readiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).Readiness(ctx, req.(*HealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Health_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "health.Health",
+	HandlerType: (*HealthServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Liveness",
+			Handler:    _Health_Liveness_Handler,
+		},
+		{
+			MethodName: "Readiness",
+			Handler:    _Health_Readiness_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "health.proto",
+}
+
+func init() { proto.RegisterFile("health.proto", fileDescriptor_health_a669b378b65de633) }
+
+var fileDescriptor_health_a669b378b65de633 = []byte{
+	// 287 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x90, 0xbf, 0x6e, 0xb3, 0x30,
+	0x14, 0xc5, 0x45, 0xe0, 0xe3, 0x0b, 0x97, 0xb6, 0x91, 0xac, 0xfe, 0x41, 0x2c, 0x41, 0x4c, 0x4c,
+	0x44, 0xa2, 0x43, 0x95, 0x6e, 0xed, 0xd4, 0xa1, 0x5d, 0xdc, 0x4e, 0x5d, 0x22, 0x13, 0x6e, 0x03,
+	0x6a, 0xc0, 0x94, 0x6b, 0x22, 0x65, 0xea, 0x9b, 0xf4, 0x59, 0x2b, 0x19, 0x2c, 0x25, 0x19, 0xbb,
+	0xf9, 0x1e, 0x1f, 0x9f, 0xfb, 0xf3, 0x81, 0xb3, 0x12, 0xc5, 0x56, 0x95, 0x69, 0xdb, 0x49, 0x25,
+	0x99, 0x3b, 0x4c, 0xe1, 0x7c, 0x23, 0xe5, 0x66, 0x8b, 0x0b, 0xad, 0xe6, 0xfd, 0xc7, 0x42, 0x55,
+	0x35, 0x92, 0x12, 0x75, 0x3b, 0x18, 0xe3, 0x19, 0x9c, 0x3f, 0x69, 0x2b, 0xc7, 0xaf, 0x1e, 0x49,
+	0xc5, 0x3f, 0x16, 0x5c, 0x18, 0x85, 0x5a, 0xd9, 0x10, 0xb2, 0x00, 0xfe, 0x0f, 0x71, 0xfb, 0xc0,
+	0x8a, 0xac, 0x64, 0xca, 0xcd, 0xc8, 0xee, 0x00, 0xa8, 0xcf, 0x69, 0x4f, 0x0a, 0x6b, 0x0a, 0x26,
+	0x91, 0x9d, 0xf8, 0xd9, 0x4d, 0x3a, 0x92, 0xbc, 0x9a, 0x9b, 0x31, 0xee, 0xc0, 0xca, 0x96, 0x00,
+	0xeb, 0x12, 0xd7, 0x9f, 0x58, 0xac, 0x84, 0x0a, 0xec, 0xc8, 0x4a, 0xfc, 0x2c, 0x4c, 0x07, 0xd8,
+	0xd4, 0xc0, 0xa6, 0x6f, 0x06, 0x96, 0x7b, 0xa3, 0xfb, 0x41, 0xc5, 0x3b, 0x98, 0x9d, 0x24, 0x33,
+	0x06, 0x4e, 0x23, 0x6a, 0xd4, 0x74, 0x1e, 0xd7, 0xe7, 0x43, 0xe8, 0xc9, 0x31, 0xf4, 0x25, 0xfc,
+	0xc3, 0xae, 0x93, 0x9d, 0x5e, 0xeb, 0xf1, 0x61, 0x60, 0x73, 0xf0, 0x8b, 0xbe, 0x13, 0xaa, 0x92,
+	0xcd, 0xaa, 0xa6, 0xc0, 0x89, 0xac, 0xc4, 0xe6, 0x60, 0xa4, 0x17, 0xca, 0xbe, 0xc1, 0x1d, 0xd7,
+	0x2d, 0x61, 0xfa, 0x5c, 0xed, 0xb0, 0x41, 0x22, 0x76, 0x65, 0x7e, 0x7b, 0xd4, 0x62, 0x78, 0x7d,
+	0x2a, 0x8f, 0x55, 0xde, 0x83, 0xc7, 0x51, 0x14, 0xd5, 0x1f, 0xde, 0x3e, 0x3a, 0xef, 0x93, 0x36,
+	0xcf, 0x5d, 0xdd, 0xce, 0xed, 0xef, 0x00, 0xfc, 0x47, 0x33, 0x3d, 0xf0, 0x01, 0x00, 0x00,
+}
@@ -36,7 +36,7 @@ func (m *GetStatsRequest) Reset()         { *m = GetStatsRequest{} }
 func (m *GetStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatsRequest) ProtoMessage()    {}
 func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{0}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{0}
 }
 func (m *GetStatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatsRequest.Unmarshal(m, b)
@@ -70,7 +70,7 @@ func (m *GetStatsResponse) Reset()         { *m = GetStatsResponse{} }
 func (m *GetStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStatsResponse) ProtoMessage()    {}
 func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{1}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{1}
 }
 func (m *GetStatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatsResponse.Unmarshal(m, b)
@@ -134,7 +134,7 @@ func (m *CreateStatsRequest) Reset()         { *m = CreateStatsRequest{} }
 func (m *CreateStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateStatsRequest) ProtoMessage()    {}
 func (*CreateStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{2}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{2}
 }
 func (m *CreateStatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateStatsRequest.Unmarshal(m, b)
@@ -192,7 +192,7 @@ func (m *CreateStatsResponse) Reset()         { *m = CreateStatsResponse{} }
 func (m *CreateStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateStatsResponse) ProtoMessage()    {}
 func (*CreateStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{3}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{3}
 }
 func (m *CreateStatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateStatsResponse.Unmarshal(m, b)
@@ -212,6 +212,397 @@ func (m *CreateStatsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateStatsResponse proto.InternalMessageInfo
 
+// ListDisqualified
+type ListDisqualifiedRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDisqualifiedRequest) Reset()         { *m = ListDisqualifiedRequest{} }
+func (m *ListDisqualifiedRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDisqualifiedRequest) ProtoMessage()    {}
+func (*ListDisqualifiedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{4}
+}
+func (m *ListDisqualifiedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDisqualifiedRequest.Unmarshal(m, b)
+}
+func (m *ListDisqualifiedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDisqualifiedRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDisqualifiedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDisqualifiedRequest.Merge(dst, src)
+}
+func (m *ListDisqualifiedRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDisqualifiedRequest.Size(m)
+}
+func (m *ListDisqualifiedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDisqualifiedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDisqualifiedRequest proto.InternalMessageInfo
+
+func (m *ListDisqualifiedRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type DisqualifiedNode struct {
+	NodeId                NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	DisqualifiedAtUnixSec int64    `protobuf:"varint,2,opt,name=disqualified_at_unix_sec,json=disqualifiedAtUnixSec,proto3" json:"disqualified_at_unix_sec,omitempty"`
+	Reason                string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *DisqualifiedNode) Reset()         { *m = DisqualifiedNode{} }
+func (m *DisqualifiedNode) String() string { return proto.CompactTextString(m) }
+func (*DisqualifiedNode) ProtoMessage()    {}
+func (*DisqualifiedNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{5}
+}
+func (m *DisqualifiedNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisqualifiedNode.Unmarshal(m, b)
+}
+func (m *DisqualifiedNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisqualifiedNode.Marshal(b, m, deterministic)
+}
+func (dst *DisqualifiedNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisqualifiedNode.Merge(dst, src)
+}
+func (m *DisqualifiedNode) XXX_Size() int {
+	return xxx_messageInfo_DisqualifiedNode.Size(m)
+}
+func (m *DisqualifiedNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisqualifiedNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisqualifiedNode proto.InternalMessageInfo
+
+func (m *DisqualifiedNode) GetDisqualifiedAtUnixSec() int64 {
+	if m != nil {
+		return m.DisqualifiedAtUnixSec
+	}
+	return 0
+}
+
+func (m *DisqualifiedNode) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ListDisqualifiedResponse struct {
+	Nodes                []*DisqualifiedNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListDisqualifiedResponse) Reset()         { *m = ListDisqualifiedResponse{} }
+func (m *ListDisqualifiedResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDisqualifiedResponse) ProtoMessage()    {}
+func (*ListDisqualifiedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{6}
+}
+func (m *ListDisqualifiedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDisqualifiedResponse.Unmarshal(m, b)
+}
+func (m *ListDisqualifiedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDisqualifiedResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDisqualifiedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDisqualifiedResponse.Merge(dst, src)
+}
+func (m *ListDisqualifiedResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDisqualifiedResponse.Size(m)
+}
+func (m *ListDisqualifiedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDisqualifiedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDisqualifiedResponse proto.InternalMessageInfo
+
+func (m *ListDisqualifiedResponse) GetNodes() []*DisqualifiedNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// Reinstate
+type ReinstateRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReinstateRequest) Reset()         { *m = ReinstateRequest{} }
+func (m *ReinstateRequest) String() string { return proto.CompactTextString(m) }
+func (*ReinstateRequest) ProtoMessage()    {}
+func (*ReinstateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{7}
+}
+func (m *ReinstateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReinstateRequest.Unmarshal(m, b)
+}
+func (m *ReinstateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReinstateRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReinstateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReinstateRequest.Merge(dst, src)
+}
+func (m *ReinstateRequest) XXX_Size() int {
+	return xxx_messageInfo_ReinstateRequest.Size(m)
+}
+func (m *ReinstateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReinstateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReinstateRequest proto.InternalMessageInfo
+
+type ReinstateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReinstateResponse) Reset()         { *m = ReinstateResponse{} }
+func (m *ReinstateResponse) String() string { return proto.CompactTextString(m) }
+func (*ReinstateResponse) ProtoMessage()    {}
+func (*ReinstateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{8}
+}
+func (m *ReinstateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReinstateResponse.Unmarshal(m, b)
+}
+func (m *ReinstateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReinstateResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReinstateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReinstateResponse.Merge(dst, src)
+}
+func (m *ReinstateResponse) XXX_Size() int {
+	return xxx_messageInfo_ReinstateResponse.Size(m)
+}
+func (m *ReinstateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReinstateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReinstateResponse proto.InternalMessageInfo
+
+// SegmentHealth
+type SegmentHealthRequest struct {
+	ProjectId            string   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Bucket               string   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPath        []byte   `protobuf:"bytes,3,opt,name=encrypted_path,json=encryptedPath,proto3" json:"encrypted_path,omitempty"`
+	SegmentIndex         int64    `protobuf:"varint,4,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentHealthRequest) Reset()         { *m = SegmentHealthRequest{} }
+func (m *SegmentHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*SegmentHealthRequest) ProtoMessage()    {}
+func (*SegmentHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{9}
+}
+func (m *SegmentHealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentHealthRequest.Unmarshal(m, b)
+}
+func (m *SegmentHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentHealthRequest.Marshal(b, m, deterministic)
+}
+func (dst *SegmentHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentHealthRequest.Merge(dst, src)
+}
+func (m *SegmentHealthRequest) XXX_Size() int {
+	return xxx_messageInfo_SegmentHealthRequest.Size(m)
+}
+func (m *SegmentHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentHealthRequest proto.InternalMessageInfo
+
+func (m *SegmentHealthRequest) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *SegmentHealthRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *SegmentHealthRequest) GetEncryptedPath() []byte {
+	if m != nil {
+		return m.EncryptedPath
+	}
+	return nil
+}
+
+func (m *SegmentHealthRequest) GetSegmentIndex() int64 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+type SegmentHealthResponse struct {
+	MinReq           int32          `protobuf:"varint,1,opt,name=min_req,json=minReq,proto3" json:"min_req,omitempty"`
+	RepairThreshold  int32          `protobuf:"varint,2,opt,name=repair_threshold,json=repairThreshold,proto3" json:"repair_threshold,omitempty"`
+	SuccessThreshold int32          `protobuf:"varint,3,opt,name=success_threshold,json=successThreshold,proto3" json:"success_threshold,omitempty"`
+	TotalPieces      int32          `protobuf:"varint,4,opt,name=total_pieces,json=totalPieces,proto3" json:"total_pieces,omitempty"`
+	HealthyPieces    int32          `protobuf:"varint,5,opt,name=healthy_pieces,json=healthyPieces,proto3" json:"healthy_pieces,omitempty"`
+	AtRiskPieces     []*PieceHealth `protobuf:"bytes,6,rep,name=at_risk_pieces,json=atRiskPieces,proto3" json:"at_risk_pieces,omitempty"`
+	// durability is the estimated probability that at least min_req pieces are available
+	Durability           float64  `protobuf:"fixed64,7,opt,name=durability,proto3" json:"durability,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SegmentHealthResponse) Reset()         { *m = SegmentHealthResponse{} }
+func (m *SegmentHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*SegmentHealthResponse) ProtoMessage()    {}
+func (*SegmentHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{10}
+}
+func (m *SegmentHealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SegmentHealthResponse.Unmarshal(m, b)
+}
+func (m *SegmentHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SegmentHealthResponse.Marshal(b, m, deterministic)
+}
+func (dst *SegmentHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SegmentHealthResponse.Merge(dst, src)
+}
+func (m *SegmentHealthResponse) XXX_Size() int {
+	return xxx_messageInfo_SegmentHealthResponse.Size(m)
+}
+func (m *SegmentHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SegmentHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SegmentHealthResponse proto.InternalMessageInfo
+
+func (m *SegmentHealthResponse) GetMinReq() int32 {
+	if m != nil {
+		return m.MinReq
+	}
+	return 0
+}
+
+func (m *SegmentHealthResponse) GetRepairThreshold() int32 {
+	if m != nil {
+		return m.RepairThreshold
+	}
+	return 0
+}
+
+func (m *SegmentHealthResponse) GetSuccessThreshold() int32 {
+	if m != nil {
+		return m.SuccessThreshold
+	}
+	return 0
+}
+
+func (m *SegmentHealthResponse) GetTotalPieces() int32 {
+	if m != nil {
+		return m.TotalPieces
+	}
+	return 0
+}
+
+func (m *SegmentHealthResponse) GetHealthyPieces() int32 {
+	if m != nil {
+		return m.HealthyPieces
+	}
+	return 0
+}
+
+func (m *SegmentHealthResponse) GetAtRiskPieces() []*PieceHealth {
+	if m != nil {
+		return m.AtRiskPieces
+	}
+	return nil
+}
+
+func (m *SegmentHealthResponse) GetDurability() float64 {
+	if m != nil {
+		return m.Durability
+	}
+	return 0
+}
+
+type PieceHealth struct {
+	PieceNum             int32    `protobuf:"varint,1,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	NodeId               NodeID   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Offline              bool     `protobuf:"varint,3,opt,name=offline,proto3" json:"offline,omitempty"`
+	Suspended            bool     `protobuf:"varint,4,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PieceHealth) Reset()         { *m = PieceHealth{} }
+func (m *PieceHealth) String() string { return proto.CompactTextString(m) }
+func (*PieceHealth) ProtoMessage()    {}
+func (*PieceHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{11}
+}
+func (m *PieceHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceHealth.Unmarshal(m, b)
+}
+func (m *PieceHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceHealth.Marshal(b, m, deterministic)
+}
+func (dst *PieceHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceHealth.Merge(dst, src)
+}
+func (m *PieceHealth) XXX_Size() int {
+	return xxx_messageInfo_PieceHealth.Size(m)
+}
+func (m *PieceHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceHealth proto.InternalMessageInfo
+
+func (m *PieceHealth) GetPieceNum() int32 {
+	if m != nil {
+		return m.PieceNum
+	}
+	return 0
+}
+
+func (m *PieceHealth) GetOffline() bool {
+	if m != nil {
+		return m.Offline
+	}
+	return false
+}
+
+func (m *PieceHealth) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
 // CountNodes
 type CountNodesResponse struct {
 	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -224,7 +615,7 @@ func (m *CountNodesResponse) Reset()         { *m = CountNodesResponse{} }
 func (m *CountNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*CountNodesResponse) ProtoMessage()    {}
 func (*CountNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{4}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{12}
 }
 func (m *CountNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesResponse.Unmarshal(m, b)
@@ -261,7 +652,7 @@ func (m *CountNodesRequest) Reset()         { *m = CountNodesRequest{} }
 func (m *CountNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*CountNodesRequest) ProtoMessage()    {}
 func (*CountNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{5}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{13}
 }
 func (m *CountNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesRequest.Unmarshal(m, b)
@@ -281,6 +672,216 @@ func (m *CountNodesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CountNodesRequest proto.InternalMessageInfo
 
+// NodeTags
+type NodeTag struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	SignedAtUnixSec      int64    `protobuf:"varint,4,opt,name=signed_at_unix_sec,json=signedAtUnixSec,proto3" json:"signed_at_unix_sec,omitempty"`
+	SignerId             NodeID   `protobuf:"bytes,5,opt,name=signer_id,json=signerId,proto3,customtype=NodeID" json:"signer_id"`
+	Certs                [][]byte `protobuf:"bytes,6,rep,name=certs,proto3" json:"certs,omitempty"`
+	Signature            []byte   `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeTag) Reset()         { *m = NodeTag{} }
+func (m *NodeTag) String() string { return proto.CompactTextString(m) }
+func (*NodeTag) ProtoMessage()    {}
+func (*NodeTag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{14}
+}
+func (m *NodeTag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeTag.Unmarshal(m, b)
+}
+func (m *NodeTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeTag.Marshal(b, m, deterministic)
+}
+func (dst *NodeTag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTag.Merge(dst, src)
+}
+func (m *NodeTag) XXX_Size() int {
+	return xxx_messageInfo_NodeTag.Size(m)
+}
+func (m *NodeTag) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeTag proto.InternalMessageInfo
+
+func (m *NodeTag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NodeTag) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *NodeTag) GetSignedAtUnixSec() int64 {
+	if m != nil {
+		return m.SignedAtUnixSec
+	}
+	return 0
+}
+
+func (m *NodeTag) GetCerts() [][]byte {
+	if m != nil {
+		return m.Certs
+	}
+	return nil
+}
+
+func (m *NodeTag) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type SetNodeTagsRequest struct {
+	Tags                 []*NodeTag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetNodeTagsRequest) Reset()         { *m = SetNodeTagsRequest{} }
+func (m *SetNodeTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetNodeTagsRequest) ProtoMessage()    {}
+func (*SetNodeTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{15}
+}
+func (m *SetNodeTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetNodeTagsRequest.Unmarshal(m, b)
+}
+func (m *SetNodeTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetNodeTagsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetNodeTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetNodeTagsRequest.Merge(dst, src)
+}
+func (m *SetNodeTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetNodeTagsRequest.Size(m)
+}
+func (m *SetNodeTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetNodeTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetNodeTagsRequest proto.InternalMessageInfo
+
+func (m *SetNodeTagsRequest) GetTags() []*NodeTag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type SetNodeTagsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetNodeTagsResponse) Reset()         { *m = SetNodeTagsResponse{} }
+func (m *SetNodeTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetNodeTagsResponse) ProtoMessage()    {}
+func (*SetNodeTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{16}
+}
+func (m *SetNodeTagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetNodeTagsResponse.Unmarshal(m, b)
+}
+func (m *SetNodeTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetNodeTagsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetNodeTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetNodeTagsResponse.Merge(dst, src)
+}
+func (m *SetNodeTagsResponse) XXX_Size() int {
+	return xxx_messageInfo_SetNodeTagsResponse.Size(m)
+}
+func (m *SetNodeTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetNodeTagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetNodeTagsResponse proto.InternalMessageInfo
+
+type GetNodeTagsRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNodeTagsRequest) Reset()         { *m = GetNodeTagsRequest{} }
+func (m *GetNodeTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNodeTagsRequest) ProtoMessage()    {}
+func (*GetNodeTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{17}
+}
+func (m *GetNodeTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeTagsRequest.Unmarshal(m, b)
+}
+func (m *GetNodeTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeTagsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetNodeTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeTagsRequest.Merge(dst, src)
+}
+func (m *GetNodeTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNodeTagsRequest.Size(m)
+}
+func (m *GetNodeTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeTagsRequest proto.InternalMessageInfo
+
+type GetNodeTagsResponse struct {
+	Tags                 []*NodeTag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetNodeTagsResponse) Reset()         { *m = GetNodeTagsResponse{} }
+func (m *GetNodeTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNodeTagsResponse) ProtoMessage()    {}
+func (*GetNodeTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{18}
+}
+func (m *GetNodeTagsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeTagsResponse.Unmarshal(m, b)
+}
+func (m *GetNodeTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeTagsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetNodeTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeTagsResponse.Merge(dst, src)
+}
+func (m *GetNodeTagsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNodeTagsResponse.Size(m)
+}
+func (m *GetNodeTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeTagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeTagsResponse proto.InternalMessageInfo
+
+func (m *GetNodeTagsResponse) GetTags() []*NodeTag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 // GetBuckets
 type GetBucketsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -292,7 +893,7 @@ func (m *GetBucketsRequest) Reset()         { *m = GetBucketsRequest{} }
 func (m *GetBucketsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsRequest) ProtoMessage()    {}
 func (*GetBucketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{6}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{19}
 }
 func (m *GetBucketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsRequest.Unmarshal(m, b)
@@ -324,7 +925,7 @@ func (m *GetBucketsResponse) Reset()         { *m = GetBucketsResponse{} }
 func (m *GetBucketsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsResponse) ProtoMessage()    {}
 func (*GetBucketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{7}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{20}
 }
 func (m *GetBucketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsResponse.Unmarshal(m, b)
@@ -363,7 +964,7 @@ func (m *GetBucketRequest) Reset()         { *m = GetBucketRequest{} }
 func (m *GetBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketRequest) ProtoMessage()    {}
 func (*GetBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{8}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{21}
 }
 func (m *GetBucketRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketRequest.Unmarshal(m, b)
@@ -395,7 +996,7 @@ func (m *GetBucketResponse) Reset()         { *m = GetBucketResponse{} }
 func (m *GetBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketResponse) ProtoMessage()    {}
 func (*GetBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{9}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{22}
 }
 func (m *GetBucketResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketResponse.Unmarshal(m, b)
@@ -433,7 +1034,7 @@ func (m *Bucket) Reset()         { *m = Bucket{} }
 func (m *Bucket) String() string { return proto.CompactTextString(m) }
 func (*Bucket) ProtoMessage()    {}
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{10}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{23}
 }
 func (m *Bucket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Bucket.Unmarshal(m, b)
@@ -471,7 +1072,7 @@ func (m *BucketList) Reset()         { *m = BucketList{} }
 func (m *BucketList) String() string { return proto.CompactTextString(m) }
 func (*BucketList) ProtoMessage()    {}
 func (*BucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{11}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{24}
 }
 func (m *BucketList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketList.Unmarshal(m, b)
@@ -511,7 +1112,7 @@ func (m *PingNodeRequest) Reset()         { *m = PingNodeRequest{} }
 func (m *PingNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*PingNodeRequest) ProtoMessage()    {}
 func (*PingNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{12}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{25}
 }
 func (m *PingNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeRequest.Unmarshal(m, b)
@@ -549,7 +1150,7 @@ func (m *PingNodeResponse) Reset()         { *m = PingNodeResponse{} }
 func (m *PingNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*PingNodeResponse) ProtoMessage()    {}
 func (*PingNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{13}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{26}
 }
 func (m *PingNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeResponse.Unmarshal(m, b)
@@ -588,7 +1189,7 @@ func (m *LookupNodeRequest) Reset()         { *m = LookupNodeRequest{} }
 func (m *LookupNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeRequest) ProtoMessage()    {}
 func (*LookupNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{14}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{27}
 }
 func (m *LookupNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeRequest.Unmarshal(m, b)
@@ -634,7 +1235,7 @@ func (m *LookupNodeResponse) Reset()         { *m = LookupNodeResponse{} }
 func (m *LookupNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeResponse) ProtoMessage()    {}
 func (*LookupNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{15}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{28}
 }
 func (m *LookupNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeResponse.Unmarshal(m, b)
@@ -681,7 +1282,7 @@ func (m *FindNearRequest) Reset()         { *m = FindNearRequest{} }
 func (m *FindNearRequest) String() string { return proto.CompactTextString(m) }
 func (*FindNearRequest) ProtoMessage()    {}
 func (*FindNearRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{16}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{29}
 }
 func (m *FindNearRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindNearRequest.Unmarshal(m, b)
@@ -719,7 +1320,7 @@ func (m *FindNearResponse) Reset()         { *m = FindNearResponse{} }
 func (m *FindNearResponse) String() string { return proto.CompactTextString(m) }
 func (*FindNearResponse) ProtoMessage()    {}
 func (*FindNearResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_3c76240ddc9521cd, []int{17}
+	return fileDescriptor_inspector_277398a6aff82f9e, []int{30}
 }
 func (m *FindNearResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindNearResponse.Unmarshal(m, b)
@@ -751,8 +1352,21 @@ func init() {
 	proto.RegisterType((*GetStatsResponse)(nil), "inspector.GetStatsResponse")
 	proto.RegisterType((*CreateStatsRequest)(nil), "inspector.CreateStatsRequest")
 	proto.RegisterType((*CreateStatsResponse)(nil), "inspector.CreateStatsResponse")
+	proto.RegisterType((*ListDisqualifiedRequest)(nil), "inspector.ListDisqualifiedRequest")
+	proto.RegisterType((*DisqualifiedNode)(nil), "inspector.DisqualifiedNode")
+	proto.RegisterType((*ListDisqualifiedResponse)(nil), "inspector.ListDisqualifiedResponse")
+	proto.RegisterType((*ReinstateRequest)(nil), "inspector.ReinstateRequest")
+	proto.RegisterType((*ReinstateResponse)(nil), "inspector.ReinstateResponse")
+	proto.RegisterType((*SegmentHealthRequest)(nil), "inspector.SegmentHealthRequest")
+	proto.RegisterType((*SegmentHealthResponse)(nil), "inspector.SegmentHealthResponse")
+	proto.RegisterType((*PieceHealth)(nil), "inspector.PieceHealth")
 	proto.RegisterType((*CountNodesResponse)(nil), "inspector.CountNodesResponse")
 	proto.RegisterType((*CountNodesRequest)(nil), "inspector.CountNodesRequest")
+	proto.RegisterType((*NodeTag)(nil), "inspector.NodeTag")
+	proto.RegisterType((*SetNodeTagsRequest)(nil), "inspector.SetNodeTagsRequest")
+	proto.RegisterType((*SetNodeTagsResponse)(nil), "inspector.SetNodeTagsResponse")
+	proto.RegisterType((*GetNodeTagsRequest)(nil), "inspector.GetNodeTagsRequest")
+	proto.RegisterType((*GetNodeTagsResponse)(nil), "inspector.GetNodeTagsResponse")
 	proto.RegisterType((*GetBucketsRequest)(nil), "inspector.GetBucketsRequest")
 	proto.RegisterType((*GetBucketsResponse)(nil), "inspector.GetBucketsResponse")
 	proto.RegisterType((*GetBucketRequest)(nil), "inspector.GetBucketRequest")
@@ -952,6 +1566,10 @@ var _KadInspector_serviceDesc = grpc.ServiceDesc{
 type OverlayInspectorClient interface {
 	// CountNodes returns the number of nodes in the cache
 	CountNodes(ctx context.Context, in *CountNodesRequest, opts ...grpc.CallOption) (*CountNodesResponse, error)
+	// SetNodeTags stores signed tags of nodes
+	SetNodeTags(ctx context.Context, in *SetNodeTagsRequest, opts ...grpc.CallOption) (*SetNodeTagsResponse, error)
+	// GetNodeTags returns the signed tags of a node
+	GetNodeTags(ctx context.Context, in *GetNodeTagsRequest, opts ...grpc.CallOption) (*GetNodeTagsResponse, error)
 }
 
 type overlayInspectorClient struct {
@@ -971,10 +1589,32 @@ func (c *overlayInspectorClient) CountNodes(ctx context.Context, in *CountNodesR
 	return out, nil
 }
 
+func (c *overlayInspectorClient) SetNodeTags(ctx context.Context, in *SetNodeTagsRequest, opts ...grpc.CallOption) (*SetNodeTagsResponse, error) {
+	out := new(SetNodeTagsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayInspector/SetNodeTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *overlayInspectorClient) GetNodeTags(ctx context.Context, in *GetNodeTagsRequest, opts ...grpc.CallOption) (*GetNodeTagsResponse, error) {
+	out := new(GetNodeTagsResponse)
+	err := c.cc.Invoke(ctx, "/inspector.OverlayInspector/GetNodeTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OverlayInspectorServer is the server API for OverlayInspector service.
 type OverlayInspectorServer interface {
 	// CountNodes returns the number of nodes in the cache
 	CountNodes(context.Context, *CountNodesRequest) (*CountNodesResponse, error)
+	// SetNodeTags stores signed tags of nodes
+	SetNodeTags(context.Context, *SetNodeTagsRequest) (*SetNodeTagsResponse, error)
+	// GetNodeTags returns the signed tags of a node
+	GetNodeTags(context.Context, *GetNodeTagsRequest) (*GetNodeTagsResponse, error)
 }
 
 func RegisterOverlayInspectorServer(s *grpc.Server, srv OverlayInspectorServer) {
@@ -999,6 +1639,42 @@ func _OverlayInspector_CountNodes_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OverlayInspector_SetNodeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayInspectorServer).SetNodeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayInspector/SetNodeTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayInspectorServer).SetNodeTags(ctx, req.(*SetNodeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OverlayInspector_GetNodeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayInspectorServer).GetNodeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.OverlayInspector/GetNodeTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayInspectorServer).GetNodeTags(ctx, req.(*GetNodeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _OverlayInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.OverlayInspector",
 	HandlerType: (*OverlayInspectorServer)(nil),
@@ -1007,6 +1683,14 @@ var _OverlayInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CountNodes",
 			Handler:    _OverlayInspector_CountNodes_Handler,
 		},
+		{
+			MethodName: "SetNodeTags",
+			Handler:    _OverlayInspector_SetNodeTags_Handler,
+		},
+		{
+			MethodName: "GetNodeTags",
+			Handler:    _OverlayInspector_GetNodeTags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
@@ -1020,6 +1704,10 @@ type StatDBInspectorClient interface {
 	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(ctx context.Context, in *CreateStatsRequest, opts ...grpc.CallOption) (*CreateStatsResponse, error)
+	// ListDisqualified returns the disqualified nodes, most recently disqualified first
+	ListDisqualified(ctx context.Context, in *ListDisqualifiedRequest, opts ...grpc.CallOption) (*ListDisqualifiedResponse, error)
+	// Reinstate lifts the disqualification of a node
+	Reinstate(ctx context.Context, in *ReinstateRequest, opts ...grpc.CallOption) (*ReinstateResponse, error)
 }
 
 type statDBInspectorClient struct {
@@ -1048,12 +1736,34 @@ func (c *statDBInspectorClient) CreateStats(ctx context.Context, in *CreateStats
 	return out, nil
 }
 
+func (c *statDBInspectorClient) ListDisqualified(ctx context.Context, in *ListDisqualifiedRequest, opts ...grpc.CallOption) (*ListDisqualifiedResponse, error) {
+	out := new(ListDisqualifiedResponse)
+	err := c.cc.Invoke(ctx, "/inspector.StatDBInspector/ListDisqualified", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statDBInspectorClient) Reinstate(ctx context.Context, in *ReinstateRequest, opts ...grpc.CallOption) (*ReinstateResponse, error) {
+	out := new(ReinstateResponse)
+	err := c.cc.Invoke(ctx, "/inspector.StatDBInspector/Reinstate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StatDBInspectorServer is the server API for StatDBInspector service.
 type StatDBInspectorServer interface {
 	// GetStats returns the stats for a particular node ID
 	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(context.Context, *CreateStatsRequest) (*CreateStatsResponse, error)
+	// ListDisqualified returns the disqualified nodes, most recently disqualified first
+	ListDisqualified(context.Context, *ListDisqualifiedRequest) (*ListDisqualifiedResponse, error)
+	// Reinstate lifts the disqualification of a node
+	Reinstate(context.Context, *ReinstateRequest) (*ReinstateResponse, error)
 }
 
 func RegisterStatDBInspectorServer(s *grpc.Server, srv StatDBInspectorServer) {
@@ -1096,6 +1806,42 @@ func _StatDBInspector_CreateStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatDBInspector_ListDisqualified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisqualifiedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatDBInspectorServer).ListDisqualified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.StatDBInspector/ListDisqualified",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatDBInspectorServer).ListDisqualified(ctx, req.(*ListDisqualifiedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatDBInspector_Reinstate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReinstateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatDBInspectorServer).Reinstate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.StatDBInspector/Reinstate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatDBInspectorServer).Reinstate(ctx, req.(*ReinstateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _StatDBInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.StatDBInspector",
 	HandlerType: (*StatDBInspectorServer)(nil),
@@ -1108,56 +1854,168 @@ var _StatDBInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateStats",
 			Handler:    _StatDBInspector_CreateStats_Handler,
 		},
+		{
+			MethodName: "ListDisqualified",
+			Handler:    _StatDBInspector_ListDisqualified_Handler,
+		},
+		{
+			MethodName: "Reinstate",
+			Handler:    _StatDBInspector_Reinstate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inspector.proto",
+}
+
+// HealthInspectorClient is the client API for HealthInspector service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HealthInspectorClient interface {
+	// SegmentHealth returns the health of a single segment
+	SegmentHealth(ctx context.Context, in *SegmentHealthRequest, opts ...grpc.CallOption) (*SegmentHealthResponse, error)
+}
+
+type healthInspectorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHealthInspectorClient(cc *grpc.ClientConn) HealthInspectorClient {
+	return &healthInspectorClient{cc}
+}
+
+func (c *healthInspectorClient) SegmentHealth(ctx context.Context, in *SegmentHealthRequest, opts ...grpc.CallOption) (*SegmentHealthResponse, error) {
+	out := new(SegmentHealthResponse)
+	err := c.cc.Invoke(ctx, "/inspector.HealthInspector/SegmentHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HealthInspectorServer is the server API for HealthInspector service.
+type HealthInspectorServer interface {
+	// SegmentHealth returns the health of a single segment
+	SegmentHealth(context.Context, *SegmentHealthRequest) (*SegmentHealthResponse, error)
+}
+
+func RegisterHealthInspectorServer(s *grpc.Server, srv HealthInspectorServer) {
+	s.RegisterService(&_HealthInspector_serviceDesc, srv)
+}
+
+func _HealthInspector_SegmentHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthInspectorServer).SegmentHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.HealthInspector/SegmentHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthInspectorServer).SegmentHealth(ctx, req.(*SegmentHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _HealthInspector_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inspector.HealthInspector",
+	HandlerType: (*HealthInspectorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SegmentHealth",
+			Handler:    _HealthInspector_SegmentHealth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
 }
 
-func init() { proto.RegisterFile("inspector.proto", fileDescriptor_inspector_3c76240ddc9521cd) }
-
-var fileDescriptor_inspector_3c76240ddc9521cd = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x6e, 0x13, 0x31,
-	0x14, 0x65, 0x26, 0x8f, 0xa6, 0x37, 0x51, 0x93, 0xba, 0x45, 0x8a, 0x42, 0x69, 0x83, 0x85, 0xa0,
-	0xea, 0x22, 0x42, 0x81, 0x15, 0x12, 0x9b, 0xa4, 0xa2, 0x44, 0x2d, 0x05, 0x4d, 0xc5, 0x06, 0x81,
-	0x2a, 0x37, 0xb6, 0x2a, 0x2b, 0x4d, 0x3c, 0x8c, 0x3d, 0x48, 0xfc, 0x0a, 0x6b, 0xd6, 0x7c, 0x07,
-	0xdf, 0xc0, 0xa2, 0x1b, 0x7e, 0x04, 0xd9, 0x9e, 0x89, 0xe7, 0xd1, 0xa8, 0x15, 0x12, 0xbb, 0xf1,
-	0x3d, 0x67, 0xce, 0x7d, 0x1c, 0x3f, 0xa0, 0xcd, 0x17, 0x32, 0x64, 0x53, 0x25, 0xa2, 0x41, 0x18,
-	0x09, 0x25, 0xd0, 0xfa, 0x32, 0xd0, 0x83, 0x4b, 0x71, 0x29, 0x6c, 0xb8, 0x07, 0x0b, 0x41, 0x99,
-	0xfd, 0xc6, 0x2f, 0xa1, 0x7d, 0xc4, 0xd4, 0x99, 0x22, 0x4a, 0x06, 0xec, 0x4b, 0xcc, 0xa4, 0x42,
-	0x4f, 0x61, 0x4d, 0x13, 0xce, 0x39, 0xed, 0x7a, 0x7d, 0x6f, 0xbf, 0x35, 0xda, 0xf8, 0x75, 0xbd,
-	0x77, 0xef, 0xf7, 0xf5, 0x5e, 0xfd, 0x54, 0x50, 0x36, 0x39, 0x0c, 0xea, 0x1a, 0x9e, 0x50, 0xfc,
-	0xdd, 0x83, 0x8e, 0xfb, 0x59, 0x86, 0x62, 0x21, 0x19, 0xda, 0x83, 0x26, 0x89, 0x29, 0x57, 0xe7,
-	0x53, 0x11, 0x2f, 0x94, 0x51, 0xa8, 0x04, 0x60, 0x42, 0x63, 0x1d, 0x71, 0x84, 0x88, 0x28, 0x2e,
-	0xba, 0x7e, 0xdf, 0xdb, 0xf7, 0x12, 0x42, 0xa0, 0x23, 0xe8, 0x11, 0xb4, 0xe2, 0x50, 0xf1, 0x39,
-	0x4b, 0x24, 0x2a, 0x46, 0xa2, 0x69, 0x63, 0x56, 0xc3, 0x51, 0xac, 0x48, 0xd5, 0x88, 0x24, 0x14,
-	0xa3, 0x82, 0xff, 0x78, 0x80, 0xc6, 0x11, 0x23, 0x8a, 0xfd, 0x53, 0x73, 0xc5, 0x3e, 0xfc, 0x52,
-	0x1f, 0x03, 0xd8, 0xb2, 0x04, 0x19, 0x4f, 0xa7, 0x4c, 0xca, 0x5c, 0xb5, 0x9b, 0x06, 0x3a, 0xb3,
-	0x48, 0xb1, 0x66, 0x4b, 0xac, 0x96, 0xdb, 0x7a, 0x06, 0xdb, 0x09, 0x25, 0xaf, 0x59, 0x33, 0x54,
-	0x64, 0xb1, 0xac, 0x28, 0xbe, 0x0f, 0x5b, 0xb9, 0x26, 0xad, 0x09, 0xf8, 0x00, 0x90, 0xc1, 0x75,
-	0x4f, 0xce, 0x9a, 0x6d, 0xa8, 0x65, 0x4d, 0xb1, 0x0b, 0xbc, 0x05, 0x9b, 0x59, 0xae, 0x19, 0x93,
-	0x0e, 0x1e, 0x31, 0x35, 0x8a, 0xa7, 0x33, 0xb6, 0x9c, 0x1d, 0x7e, 0x03, 0x28, 0x1b, 0x74, 0xaa,
-	0x4a, 0x28, 0x72, 0x95, 0xaa, 0x9a, 0x05, 0xda, 0x81, 0x0a, 0xa7, 0xb2, 0xeb, 0xf7, 0x2b, 0xfb,
-	0xad, 0x11, 0x64, 0xe6, 0xab, 0xc3, 0x78, 0x68, 0x36, 0x8e, 0x55, 0x4a, 0x9d, 0xd9, 0x05, 0x7f,
-	0xa5, 0x29, 0x3e, 0xa7, 0xf8, 0x43, 0xa6, 0xa4, 0x65, 0xf2, 0x5b, 0x7e, 0x42, 0x7d, 0xa8, 0x69,
-	0x3f, 0x6d, 0x21, 0xcd, 0x21, 0x0c, 0xcc, 0xd6, 0xd7, 0x84, 0xc0, 0x02, 0xf8, 0x00, 0xea, 0x56,
-	0xf3, 0x0e, 0xdc, 0x01, 0x80, 0xe5, 0x9e, 0x70, 0x99, 0xe1, 0x7b, 0xab, 0xf8, 0xc7, 0xd0, 0x7e,
-	0xcf, 0x17, 0x97, 0x26, 0x74, 0xb7, 0x2e, 0x51, 0x17, 0xd6, 0x08, 0xa5, 0x11, 0x93, 0xd2, 0x6c,
-	0xb9, 0xf5, 0x20, 0x5d, 0x62, 0x0c, 0x1d, 0x27, 0x96, 0xb4, 0xbf, 0x01, 0xbe, 0x98, 0x19, 0xb5,
-	0x46, 0xe0, 0x8b, 0x19, 0x7e, 0x05, 0x9b, 0x27, 0x42, 0xcc, 0xe2, 0x30, 0x9b, 0x72, 0x63, 0x99,
-	0x72, 0xfd, 0x96, 0x14, 0x9f, 0x00, 0x65, 0x7f, 0x5f, 0xce, 0xb8, 0xaa, 0xdb, 0x31, 0x0a, 0xf9,
-	0x36, 0x4d, 0x1c, 0x3d, 0x81, 0xea, 0x9c, 0x29, 0x62, 0xc4, 0x9a, 0x43, 0xe4, 0xf0, 0xb7, 0x4c,
-	0x11, 0x4a, 0x14, 0x09, 0x0c, 0x8e, 0xe7, 0xd0, 0x7e, 0xcd, 0x17, 0xf4, 0x94, 0x91, 0xe8, 0xae,
-	0xd3, 0x78, 0x0c, 0x35, 0xa9, 0x48, 0x64, 0x8f, 0x5f, 0x99, 0x62, 0x41, 0xbd, 0x03, 0xaf, 0xf8,
-	0x9c, 0xa7, 0x67, 0xcf, 0x2e, 0xf0, 0x0b, 0xe8, 0xb8, 0x74, 0x49, 0x2b, 0xb7, 0x5a, 0x3c, 0xfc,
-	0xe9, 0x43, 0xeb, 0x98, 0xd0, 0x49, 0x7a, 0x71, 0xa2, 0x09, 0x80, 0x3b, 0x1e, 0x68, 0x67, 0xe0,
-	0xee, 0xd8, 0xd2, 0xa9, 0xe9, 0x3d, 0x5c, 0x81, 0x26, 0xd9, 0xc7, 0xd0, 0x48, 0x1d, 0x44, 0xbd,
-	0x0c, 0xb5, 0xb0, 0x47, 0x7a, 0x0f, 0x6e, 0xc4, 0x12, 0x91, 0x09, 0x80, 0xf3, 0x28, 0x57, 0x4f,
-	0xc9, 0xf9, 0x5c, 0x3d, 0x37, 0x18, 0x3b, 0x86, 0x46, 0x3a, 0xa1, 0x5c, 0x3d, 0x05, 0x97, 0x72,
-	0xf5, 0x14, 0x47, 0x3a, 0xfc, 0x0c, 0x9d, 0x77, 0x5f, 0x59, 0x74, 0x45, 0xbe, 0xfd, 0x8f, 0x99,
-	0x0d, 0x7f, 0x78, 0xd0, 0xd6, 0x77, 0xdb, 0xe1, 0xc8, 0xc9, 0x8f, 0xa1, 0x91, 0x3e, 0x3b, 0xb9,
-	0xba, 0x0b, 0x0f, 0x59, 0xae, 0xee, 0xd2, 0x3b, 0x75, 0x02, 0xcd, 0xcc, 0xcd, 0x89, 0x72, 0x65,
-	0x94, 0x9e, 0x8d, 0xde, 0xee, 0x2a, 0xd8, 0xaa, 0x8d, 0xaa, 0x1f, 0xfd, 0xf0, 0xe2, 0xa2, 0x6e,
-	0xde, 0xd4, 0xe7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xca, 0xf7, 0xf6, 0x6e, 0x89, 0x07, 0x00,
-	0x00,
+func init() { proto.RegisterFile("inspector.proto", fileDescriptor_inspector_277398a6aff82f9e) }
+
+var fileDescriptor_inspector_277398a6aff82f9e = []byte{
+	// 1283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdb, 0x6e, 0x1b, 0x45,
+	0x18, 0x66, 0x37, 0xb6, 0x63, 0xff, 0x76, 0x62, 0x67, 0x92, 0xb6, 0xd6, 0x26, 0x4d, 0xd2, 0x2d,
+	0x87, 0xd2, 0x4a, 0x06, 0x02, 0x12, 0x12, 0x34, 0x17, 0x24, 0x51, 0x5d, 0xab, 0x69, 0x88, 0x36,
+	0xed, 0x0d, 0x20, 0xad, 0x26, 0xde, 0x89, 0x3d, 0xd8, 0xde, 0xdd, 0xec, 0xcc, 0x56, 0xc9, 0x33,
+	0xc0, 0x0b, 0xc0, 0x43, 0x20, 0x1e, 0x03, 0x5e, 0x81, 0x8b, 0xde, 0x70, 0xc7, 0x1b, 0x70, 0x87,
+	0xe6, 0xb0, 0xde, 0x5d, 0x1f, 0x12, 0x83, 0xc4, 0x9d, 0xe7, 0xfb, 0xbf, 0xf9, 0xe6, 0x3f, 0xcd,
+	0xef, 0x59, 0xa8, 0x53, 0x9f, 0x85, 0xa4, 0xcb, 0x83, 0xa8, 0x15, 0x46, 0x01, 0x0f, 0x50, 0x65,
+	0x0c, 0x58, 0xd0, 0x0b, 0x7a, 0x81, 0x82, 0x2d, 0xf0, 0x03, 0x8f, 0xa8, 0xdf, 0xf6, 0x17, 0x50,
+	0x6f, 0x13, 0x7e, 0xc6, 0x31, 0x67, 0x0e, 0xb9, 0x8c, 0x09, 0xe3, 0xe8, 0x03, 0x58, 0x16, 0x04,
+	0x97, 0x7a, 0x4d, 0x63, 0xd7, 0x78, 0x54, 0x3b, 0x58, 0xfd, 0xed, 0xed, 0xce, 0x3b, 0x7f, 0xbc,
+	0xdd, 0x29, 0x9d, 0x04, 0x1e, 0xe9, 0x1c, 0x39, 0x25, 0x61, 0xee, 0x78, 0xf6, 0xcf, 0x06, 0x34,
+	0xd2, 0xcd, 0x2c, 0x0c, 0x7c, 0x46, 0xd0, 0x0e, 0x54, 0x71, 0xec, 0x51, 0xee, 0x76, 0x83, 0xd8,
+	0xe7, 0x52, 0x61, 0xc9, 0x01, 0x09, 0x1d, 0x0a, 0x24, 0x25, 0x44, 0x98, 0xd3, 0xa0, 0x69, 0xee,
+	0x1a, 0x8f, 0x0c, 0x4d, 0x70, 0x04, 0x82, 0x1e, 0x40, 0x2d, 0x0e, 0x39, 0x1d, 0x11, 0x2d, 0xb1,
+	0x24, 0x25, 0xaa, 0x0a, 0x53, 0x1a, 0x29, 0x45, 0x89, 0x14, 0xa4, 0x88, 0xa6, 0x48, 0x15, 0xfb,
+	0x4f, 0x03, 0xd0, 0x61, 0x44, 0x30, 0x27, 0xff, 0x29, 0xb8, 0xc9, 0x38, 0xcc, 0xa9, 0x38, 0x5a,
+	0xb0, 0xae, 0x08, 0x2c, 0xee, 0x76, 0x09, 0x63, 0x39, 0x6f, 0xd7, 0xa4, 0xe9, 0x4c, 0x59, 0x26,
+	0x7d, 0x56, 0xc4, 0xc2, 0x74, 0x58, 0x1f, 0xc3, 0x86, 0xa6, 0xe4, 0x35, 0x8b, 0x92, 0x8a, 0x94,
+	0x2d, 0x2b, 0x6a, 0xdf, 0x81, 0xf5, 0x5c, 0x90, 0xaa, 0x08, 0xf6, 0x47, 0x70, 0xef, 0x98, 0x32,
+	0x7e, 0x44, 0xd9, 0x65, 0x8c, 0x87, 0xf4, 0x82, 0x12, 0x2f, 0x49, 0xc0, 0x06, 0x14, 0x87, 0x74,
+	0x44, 0x55, 0x65, 0x8a, 0x8e, 0x5a, 0xd8, 0x3f, 0x1a, 0xd0, 0xc8, 0xb2, 0x45, 0x32, 0x16, 0xcf,
+	0xd5, 0xe7, 0xd0, 0xf4, 0x32, 0x9b, 0x5d, 0xcc, 0xdd, 0xd8, 0xa7, 0x57, 0x2e, 0x23, 0x5d, 0x9d,
+	0xb8, 0x3b, 0x59, 0xfb, 0x57, 0xfc, 0xb5, 0x4f, 0xaf, 0xce, 0x48, 0x17, 0xdd, 0x85, 0x52, 0x44,
+	0x30, 0x0b, 0x7c, 0x99, 0xb6, 0x8a, 0xa3, 0x57, 0xf6, 0x4b, 0x68, 0x4e, 0xfb, 0xaf, 0x1b, 0xec,
+	0x13, 0x28, 0x8a, 0x63, 0x59, 0xd3, 0xd8, 0x5d, 0x7a, 0x54, 0xdd, 0xdb, 0x6c, 0xa5, 0x5d, 0x3f,
+	0x19, 0x81, 0xa3, 0x98, 0xf6, 0x97, 0xd0, 0x70, 0x08, 0xf5, 0x19, 0xc7, 0x9c, 0xfc, 0xeb, 0x2e,
+	0x5f, 0x87, 0xb5, 0xcc, 0x66, 0x9d, 0xe0, 0x9f, 0x0c, 0xd8, 0x38, 0x23, 0xbd, 0x11, 0xf1, 0xf9,
+	0x73, 0x82, 0x87, 0xbc, 0x9f, 0xc8, 0xde, 0x07, 0x08, 0xa3, 0xe0, 0x7b, 0xd2, 0xe5, 0x89, 0x72,
+	0xc5, 0xa9, 0x68, 0xa4, 0xe3, 0x89, 0x80, 0xcf, 0xe3, 0xee, 0x80, 0xa8, 0x86, 0xaa, 0x38, 0x7a,
+	0x85, 0xde, 0x83, 0x55, 0xe2, 0x77, 0xa3, 0xeb, 0x90, 0x13, 0xcf, 0x0d, 0x31, 0xef, 0xcb, 0x84,
+	0xd4, 0x9c, 0x95, 0x31, 0x7a, 0x8a, 0x79, 0x1f, 0x3d, 0x84, 0x15, 0xa6, 0x4e, 0x75, 0xa9, 0xef,
+	0x91, 0x2b, 0xdd, 0x44, 0x35, 0x0d, 0x76, 0x04, 0x66, 0xff, 0x6a, 0xc2, 0x9d, 0x09, 0xdf, 0x74,
+	0xea, 0xee, 0xc1, 0xf2, 0x88, 0xfa, 0x6e, 0x44, 0x2e, 0x75, 0xf5, 0x4b, 0x23, 0xea, 0x3b, 0xe4,
+	0x12, 0x7d, 0x08, 0x8d, 0x88, 0x84, 0x98, 0x46, 0x2e, 0xef, 0x47, 0x84, 0xf5, 0x83, 0xa1, 0x27,
+	0x1d, 0x2c, 0x3a, 0x75, 0x85, 0xbf, 0x4a, 0x60, 0xf4, 0x04, 0xd6, 0x92, 0xe6, 0x4c, 0xb9, 0x4b,
+	0x92, 0xdb, 0xd0, 0x86, 0x94, 0xfc, 0x00, 0x6a, 0x3c, 0xe0, 0x78, 0xe8, 0x86, 0x94, 0x74, 0x09,
+	0x93, 0xee, 0x16, 0x9d, 0xaa, 0xc4, 0x4e, 0x25, 0x24, 0x22, 0xef, 0x4b, 0x2f, 0xaf, 0x13, 0x52,
+	0x51, 0x92, 0x56, 0x34, 0xaa, 0x69, 0x4f, 0x61, 0x15, 0x73, 0x37, 0xa2, 0x6c, 0x90, 0xd0, 0x4a,
+	0xb2, 0xfc, 0x77, 0x33, 0xe5, 0x97, 0x54, 0x1d, 0x72, 0x0d, 0x73, 0x87, 0xb2, 0x81, 0xde, 0xbd,
+	0x0d, 0xe0, 0xc5, 0x11, 0x3e, 0xa7, 0x43, 0xca, 0xaf, 0x9b, 0xcb, 0x6a, 0xe4, 0xa4, 0x88, 0xfd,
+	0x83, 0x01, 0xd5, 0xcc, 0x6e, 0xb4, 0x09, 0x15, 0x79, 0x8a, 0xeb, 0xc7, 0x23, 0x9d, 0xaa, 0xb2,
+	0x04, 0x4e, 0xe2, 0x51, 0xb6, 0x73, 0xcc, 0x1b, 0xaf, 0x45, 0x13, 0x96, 0x83, 0x8b, 0x8b, 0x21,
+	0xf5, 0x89, 0x4c, 0x50, 0xd9, 0x49, 0x96, 0x68, 0x0b, 0x2a, 0x2c, 0x66, 0x21, 0xf1, 0x3d, 0xe2,
+	0xc9, 0xa4, 0x94, 0x9d, 0x14, 0xb0, 0x1f, 0x03, 0x92, 0xb7, 0x5b, 0xc8, 0xa5, 0x83, 0x75, 0x03,
+	0x8a, 0xd9, 0x91, 0xaa, 0x16, 0xa2, 0x3b, 0xb3, 0x5c, 0xd9, 0x84, 0xf6, 0x5f, 0x06, 0x2c, 0x0b,
+	0xe0, 0x15, 0xee, 0x2d, 0x7e, 0x89, 0x11, 0x14, 0x7c, 0x3c, 0x22, 0xba, 0x31, 0xe5, 0x6f, 0x71,
+	0xe6, 0x1b, 0x3c, 0x8c, 0x89, 0xbe, 0x9e, 0x6a, 0x81, 0x9e, 0x00, 0x62, 0xb4, 0xe7, 0x4f, 0x5c,
+	0x74, 0xd5, 0x8a, 0x75, 0x65, 0x49, 0xaf, 0xf8, 0x13, 0xa8, 0x48, 0x28, 0x12, 0x1e, 0x14, 0x67,
+	0x7a, 0x50, 0x56, 0x84, 0x8e, 0x27, 0x63, 0x24, 0x11, 0x57, 0xc5, 0xad, 0x39, 0x6a, 0x21, 0xb3,
+	0x45, 0x7b, 0x3e, 0xe6, 0x71, 0x44, 0x64, 0xf1, 0x6a, 0x4e, 0x0a, 0xd8, 0x4f, 0x01, 0x9d, 0x11,
+	0xae, 0xc3, 0x1d, 0xcf, 0xf9, 0xf7, 0xa1, 0xc0, 0x71, 0x2f, 0x19, 0x12, 0x28, 0xd3, 0x25, 0x9a,
+	0xe9, 0x48, 0xbb, 0x18, 0xa0, 0xb9, 0xdd, 0xfa, 0x7e, 0xef, 0x03, 0x6a, 0x4f, 0x8b, 0x2e, 0x3c,
+	0x33, 0xf6, 0x61, 0xbd, 0x3d, 0xad, 0xba, 0xb0, 0x53, 0xeb, 0xb0, 0xd6, 0x26, 0xfc, 0x40, 0x8e,
+	0x86, 0x71, 0x51, 0x9f, 0x03, 0xca, 0x82, 0x69, 0x57, 0xc8, 0xdb, 0x94, 0x74, 0x85, 0x5c, 0xa0,
+	0x2d, 0x58, 0xa2, 0x1e, 0x6b, 0x9a, 0x22, 0x8b, 0x07, 0x90, 0x71, 0x50, 0xc0, 0xf6, 0x1e, 0x34,
+	0xc6, 0x4a, 0x49, 0x68, 0xdb, 0x60, 0xce, 0x8d, 0xca, 0xa4, 0x9e, 0xfd, 0x3a, 0xe3, 0xd2, 0xf8,
+	0xf0, 0x5b, 0x36, 0xa1, 0xdd, 0x64, 0x54, 0x9b, 0x32, 0x60, 0x68, 0x89, 0x55, 0x2b, 0x3b, 0x99,
+	0x1f, 0x43, 0x49, 0x69, 0x2e, 0xc0, 0x6d, 0x01, 0x28, 0xae, 0xf8, 0x6b, 0x48, 0xf9, 0xc6, 0x3c,
+	0xfe, 0x0b, 0xa8, 0x9f, 0x52, 0xbf, 0x27, 0xa1, 0xc5, 0xa2, 0x14, 0x37, 0x16, 0x7b, 0x5e, 0x44,
+	0x18, 0xd3, 0xd7, 0x20, 0x59, 0xda, 0x36, 0x34, 0x52, 0x31, 0x1d, 0xfe, 0x2a, 0x98, 0xc1, 0x40,
+	0xaa, 0x95, 0x1d, 0x33, 0x18, 0xd8, 0xfb, 0xb0, 0x76, 0x1c, 0x04, 0x83, 0x38, 0xcc, 0x1e, 0xb9,
+	0x3a, 0x3e, 0xb2, 0x72, 0xcb, 0x11, 0xdf, 0x01, 0xca, 0x6e, 0x1f, 0xe7, 0xb8, 0x20, 0xc2, 0x91,
+	0x0a, 0xf9, 0x30, 0x25, 0x2e, 0x7a, 0x6a, 0x44, 0x38, 0x96, 0x62, 0xa2, 0xa7, 0xc6, 0xf6, 0x97,
+	0x84, 0x63, 0x0f, 0x73, 0xec, 0x48, 0xbb, 0x3d, 0x82, 0xfa, 0x33, 0xea, 0x7b, 0x27, 0x04, 0x47,
+	0x8b, 0x66, 0xe3, 0x5d, 0x28, 0x32, 0x8e, 0x23, 0x3e, 0x67, 0xcc, 0x29, 0x63, 0xfa, 0xa0, 0x50,
+	0x2f, 0x1f, 0xb5, 0xb0, 0x3f, 0x83, 0x46, 0x7a, 0x9c, 0x0e, 0xe5, 0xd6, 0x12, 0xef, 0xfd, 0x62,
+	0x42, 0xed, 0x05, 0xf6, 0x3a, 0xc9, 0xbd, 0x40, 0x1d, 0x80, 0x74, 0xbc, 0xa1, 0xad, 0xcc, 0x8d,
+	0x99, 0x9a, 0x7a, 0xd6, 0xfd, 0x39, 0x56, 0x7d, 0xfa, 0x21, 0x94, 0x93, 0x0a, 0x22, 0x2b, 0xf7,
+	0xaf, 0x91, 0xeb, 0x11, 0x6b, 0x73, 0xa6, 0x4d, 0x8b, 0x74, 0x00, 0xd2, 0x1a, 0xe5, 0xfc, 0x99,
+	0xaa, 0xbc, 0x75, 0x7f, 0x8e, 0x35, 0xf5, 0x27, 0xc9, 0x50, 0xce, 0x9f, 0x89, 0x2a, 0x59, 0x9b,
+	0x33, 0x6d, 0x4a, 0x64, 0xef, 0x6f, 0x03, 0x1a, 0x5f, 0xbf, 0x21, 0xd1, 0x10, 0x5f, 0xff, 0x2f,
+	0x49, 0x3b, 0x86, 0x6a, 0x66, 0x3c, 0xa2, 0x2c, 0x7b, 0x7a, 0xe8, 0x5a, 0xdb, 0xf3, 0xcc, 0xa9,
+	0x5a, 0x7b, 0x8e, 0x5a, 0xfb, 0x66, 0xb5, 0x19, 0xd3, 0x74, 0xef, 0x77, 0x13, 0xea, 0xe2, 0xd9,
+	0x7b, 0x74, 0x90, 0x86, 0x7e, 0x08, 0xe5, 0xe4, 0x8b, 0x24, 0x97, 0xd4, 0x89, 0x6f, 0x1c, 0x6b,
+	0x73, 0xa6, 0x2d, 0x75, 0x33, 0xf3, 0xa8, 0xce, 0xb9, 0x39, 0xfd, 0x45, 0x61, 0x6d, 0xcf, 0x33,
+	0x6b, 0xb5, 0x6f, 0xa1, 0x31, 0xf9, 0x96, 0x45, 0x76, 0xb6, 0x35, 0x66, 0x3f, 0xd4, 0xad, 0x87,
+	0x37, 0x72, 0xb4, 0xf8, 0x33, 0xa8, 0x8c, 0x1f, 0xa7, 0x28, 0x1b, 0xd4, 0xe4, 0x7b, 0xd7, 0xda,
+	0x9a, 0x6d, 0xd4, 0xb9, 0x24, 0x50, 0x57, 0x4f, 0x9f, 0x34, 0x95, 0x0e, 0xac, 0xe4, 0x5e, 0x91,
+	0x68, 0x27, 0x57, 0xdd, 0xe9, 0xb7, 0xaf, 0xb5, 0x3b, 0x9f, 0xa0, 0x8e, 0x39, 0x28, 0x7c, 0x63,
+	0x86, 0xe7, 0xe7, 0x25, 0xf9, 0xe9, 0xf9, 0xe9, 0x3f, 0x03, 0x00, 0xb6, 0x3e, 0x7b, 0xb9, 0xb0,
+	0x0e, 0x00, 0x00,
 }
@@ -49,7 +49,7 @@ func (x NodeType) String() string {
 	return proto.EnumName(NodeType_name, int32(x))
 }
 func (NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{0}
+	return fileDescriptor_node_f25b268d8b88f686, []int{0}
 }
 
 // NodeTransport is an enum of possible transports for the overlay network
@@ -70,10 +70,10 @@ func (x NodeTransport) String() string {
 	return proto.EnumName(NodeTransport_name, int32(x))
 }
 func (NodeTransport) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{1}
+	return fileDescriptor_node_f25b268d8b88f686, []int{1}
 }
 
-//  NodeRestrictions contains all relevant data about a nodes ability to store data
+// NodeRestrictions contains all relevant data about a nodes ability to store data
 type NodeRestrictions struct {
 	FreeBandwidth        int64    `protobuf:"varint,1,opt,name=free_bandwidth,json=freeBandwidth,proto3" json:"free_bandwidth,omitempty"`
 	FreeDisk             int64    `protobuf:"varint,2,opt,name=free_disk,json=freeDisk,proto3" json:"free_disk,omitempty"`
@@ -86,7 +86,7 @@ func (m *NodeRestrictions) Reset()         { *m = NodeRestrictions{} }
 func (m *NodeRestrictions) String() string { return proto.CompactTextString(m) }
 func (*NodeRestrictions) ProtoMessage()    {}
 func (*NodeRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{0}
+	return fileDescriptor_node_f25b268d8b88f686, []int{0}
 }
 func (m *NodeRestrictions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeRestrictions.Unmarshal(m, b)
@@ -124,28 +124,30 @@ func (m *NodeRestrictions) GetFreeDisk() int64 {
 // Node represents a node in the overlay network
 // Node is info for a updating a single storagenode, used in the Update rpc calls
 type Node struct {
-	Id                   NodeID            `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
-	Address              *NodeAddress      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Type                 NodeType          `protobuf:"varint,3,opt,name=type,proto3,enum=node.NodeType" json:"type,omitempty"`
-	Restrictions         *NodeRestrictions `protobuf:"bytes,4,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
-	Reputation           *NodeStats        `protobuf:"bytes,5,opt,name=reputation,proto3" json:"reputation,omitempty"`
-	Metadata             *NodeMetadata     `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	LatencyList          []int64           `protobuf:"varint,7,rep,packed,name=latency_list,json=latencyList,proto3" json:"latency_list,omitempty"`
-	AuditSuccess         bool              `protobuf:"varint,8,opt,name=audit_success,json=auditSuccess,proto3" json:"audit_success,omitempty"`
-	IsUp                 bool              `protobuf:"varint,9,opt,name=is_up,json=isUp,proto3" json:"is_up,omitempty"`
-	UpdateLatency        bool              `protobuf:"varint,10,opt,name=update_latency,json=updateLatency,proto3" json:"update_latency,omitempty"`
-	UpdateAuditSuccess   bool              `protobuf:"varint,11,opt,name=update_audit_success,json=updateAuditSuccess,proto3" json:"update_audit_success,omitempty"`
-	UpdateUptime         bool              `protobuf:"varint,12,opt,name=update_uptime,json=updateUptime,proto3" json:"update_uptime,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Id                 NodeID            `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	Address            *NodeAddress      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Type               NodeType          `protobuf:"varint,3,opt,name=type,proto3,enum=node.NodeType" json:"type,omitempty"`
+	Restrictions       *NodeRestrictions `protobuf:"bytes,4,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	Reputation         *NodeStats        `protobuf:"bytes,5,opt,name=reputation,proto3" json:"reputation,omitempty"`
+	Metadata           *NodeMetadata     `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	LatencyList        []int64           `protobuf:"varint,7,rep,packed,name=latency_list,json=latencyList,proto3" json:"latency_list,omitempty"`
+	AuditSuccess       bool              `protobuf:"varint,8,opt,name=audit_success,json=auditSuccess,proto3" json:"audit_success,omitempty"`
+	IsUp               bool              `protobuf:"varint,9,opt,name=is_up,json=isUp,proto3" json:"is_up,omitempty"`
+	UpdateLatency      bool              `protobuf:"varint,10,opt,name=update_latency,json=updateLatency,proto3" json:"update_latency,omitempty"`
+	UpdateAuditSuccess bool              `protobuf:"varint,11,opt,name=update_audit_success,json=updateAuditSuccess,proto3" json:"update_audit_success,omitempty"`
+	UpdateUptime       bool              `protobuf:"varint,12,opt,name=update_uptime,json=updateUptime,proto3" json:"update_uptime,omitempty"`
+	// country_code is the ISO 3166-1 alpha-2 code of the country of the node's address, the satellite resolves it
+	CountryCode          string   `protobuf:"bytes,13,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{1}
+	return fileDescriptor_node_f25b268d8b88f686, []int{1}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Node.Unmarshal(m, b)
@@ -242,6 +244,13 @@ func (m *Node) GetUpdateUptime() bool {
 	return false
 }
 
+func (m *Node) GetCountryCode() string {
+	if m != nil {
+		return m.CountryCode
+	}
+	return ""
+}
+
 // NodeAddress contains the information needed to communicate with a node on the network
 type NodeAddress struct {
 	Transport            NodeTransport `protobuf:"varint,1,opt,name=transport,proto3,enum=node.NodeTransport" json:"transport,omitempty"`
@@ -255,7 +264,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{2}
+	return fileDescriptor_node_f25b268d8b88f686, []int{2}
 }
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
@@ -308,7 +317,7 @@ func (m *NodeStats) Reset()         { *m = NodeStats{} }
 func (m *NodeStats) String() string { return proto.CompactTextString(m) }
 func (*NodeStats) ProtoMessage()    {}
 func (*NodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{3}
+	return fileDescriptor_node_f25b268d8b88f686, []int{3}
 }
 func (m *NodeStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeStats.Unmarshal(m, b)
@@ -378,18 +387,21 @@ func (m *NodeStats) GetUptimeSuccessCount() int64 {
 }
 
 type NodeMetadata struct {
-	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Wallet               string   `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Email  string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Wallet string `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	// wallet_features lists the payout methods the operator opted into, e.g. "zksync"
+	WalletFeatures       []string        `protobuf:"bytes,3,rep,name=wallet_features,json=walletFeatures,proto3" json:"wallet_features,omitempty"`
+	Throughput           *NodeThroughput `protobuf:"bytes,4,opt,name=throughput,proto3" json:"throughput,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *NodeMetadata) Reset()         { *m = NodeMetadata{} }
 func (m *NodeMetadata) String() string { return proto.CompactTextString(m) }
 func (*NodeMetadata) ProtoMessage()    {}
 func (*NodeMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_d0632cceb21d65c0, []int{4}
+	return fileDescriptor_node_f25b268d8b88f686, []int{4}
 }
 func (m *NodeMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeMetadata.Unmarshal(m, b)
@@ -423,59 +435,145 @@ func (m *NodeMetadata) GetWallet() string {
 	return ""
 }
 
+func (m *NodeMetadata) GetWalletFeatures() []string {
+	if m != nil {
+		return m.WalletFeatures
+	}
+	return nil
+}
+
+func (m *NodeMetadata) GetThroughput() *NodeThroughput {
+	if m != nil {
+		return m.Throughput
+	}
+	return nil
+}
+
+// NodeThroughput is the recent traffic of a node as reported by the node itself
+type NodeThroughput struct {
+	IngressRate          int64    `protobuf:"varint,1,opt,name=ingress_rate,json=ingressRate,proto3" json:"ingress_rate,omitempty"`
+	EgressRate           int64    `protobuf:"varint,2,opt,name=egress_rate,json=egressRate,proto3" json:"egress_rate,omitempty"`
+	UploadSuccessRatio   float64  `protobuf:"fixed64,3,opt,name=upload_success_ratio,json=uploadSuccessRatio,proto3" json:"upload_success_ratio,omitempty"`
+	DownloadSuccessRatio float64  `protobuf:"fixed64,4,opt,name=download_success_ratio,json=downloadSuccessRatio,proto3" json:"download_success_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeThroughput) Reset()         { *m = NodeThroughput{} }
+func (m *NodeThroughput) String() string { return proto.CompactTextString(m) }
+func (*NodeThroughput) ProtoMessage()    {}
+func (*NodeThroughput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_node_f25b268d8b88f686, []int{5}
+}
+func (m *NodeThroughput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeThroughput.Unmarshal(m, b)
+}
+func (m *NodeThroughput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeThroughput.Marshal(b, m, deterministic)
+}
+func (dst *NodeThroughput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeThroughput.Merge(dst, src)
+}
+func (m *NodeThroughput) XXX_Size() int {
+	return xxx_messageInfo_NodeThroughput.Size(m)
+}
+func (m *NodeThroughput) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeThroughput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeThroughput proto.InternalMessageInfo
+
+func (m *NodeThroughput) GetIngressRate() int64 {
+	if m != nil {
+		return m.IngressRate
+	}
+	return 0
+}
+
+func (m *NodeThroughput) GetEgressRate() int64 {
+	if m != nil {
+		return m.EgressRate
+	}
+	return 0
+}
+
+func (m *NodeThroughput) GetUploadSuccessRatio() float64 {
+	if m != nil {
+		return m.UploadSuccessRatio
+	}
+	return 0
+}
+
+func (m *NodeThroughput) GetDownloadSuccessRatio() float64 {
+	if m != nil {
+		return m.DownloadSuccessRatio
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*NodeRestrictions)(nil), "node.NodeRestrictions")
 	proto.RegisterType((*Node)(nil), "node.Node")
 	proto.RegisterType((*NodeAddress)(nil), "node.NodeAddress")
 	proto.RegisterType((*NodeStats)(nil), "node.NodeStats")
 	proto.RegisterType((*NodeMetadata)(nil), "node.NodeMetadata")
+	proto.RegisterType((*NodeThroughput)(nil), "node.NodeThroughput")
 	proto.RegisterEnum("node.NodeType", NodeType_name, NodeType_value)
 	proto.RegisterEnum("node.NodeTransport", NodeTransport_name, NodeTransport_value)
 }
 
-func init() { proto.RegisterFile("node.proto", fileDescriptor_node_d0632cceb21d65c0) }
-
-var fileDescriptor_node_d0632cceb21d65c0 = []byte{
-	// 652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xc1, 0x4e, 0xdb, 0x40,
-	0x10, 0x86, 0x49, 0x6c, 0x9c, 0x78, 0xec, 0xa4, 0x66, 0x40, 0xc8, 0x6a, 0xd5, 0x12, 0x82, 0xaa,
-	0x46, 0x54, 0x4a, 0x29, 0x3d, 0x51, 0xf5, 0x92, 0x00, 0x42, 0x51, 0xdd, 0x10, 0x6d, 0x0c, 0x07,
-	0x2e, 0x96, 0x89, 0xb7, 0x74, 0x45, 0x88, 0x2d, 0x7b, 0x2d, 0xc4, 0x1b, 0xf6, 0xd0, 0x27, 0xe8,
-	0x81, 0x57, 0xe8, 0x2b, 0x54, 0xbb, 0xeb, 0x24, 0xb6, 0xaa, 0xde, 0xb2, 0xff, 0xff, 0x79, 0xc6,
-	0x3b, 0xff, 0xc4, 0x00, 0x8b, 0x38, 0xa2, 0xfd, 0x24, 0x8d, 0x79, 0x8c, 0xba, 0xf8, 0xfd, 0x12,
-	0xee, 0xe2, 0xbb, 0x58, 0x29, 0xdd, 0x6b, 0x70, 0xc6, 0x71, 0x44, 0x09, 0xcd, 0x78, 0xca, 0x66,
-	0x9c, 0xc5, 0x8b, 0x0c, 0xdf, 0x42, 0xfb, 0x7b, 0x4a, 0x69, 0x70, 0x1b, 0x2e, 0xa2, 0x47, 0x16,
-	0xf1, 0x1f, 0x6e, 0xad, 0x53, 0xeb, 0x69, 0xa4, 0x25, 0xd4, 0xe1, 0x52, 0xc4, 0x57, 0x60, 0x4a,
-	0x2c, 0x62, 0xd9, 0xbd, 0x5b, 0x97, 0x44, 0x53, 0x08, 0x67, 0x2c, 0xbb, 0xef, 0xfe, 0xd1, 0x40,
-	0x17, 0x85, 0xf1, 0x0d, 0xd4, 0x59, 0x24, 0x0b, 0xd8, 0xc3, 0xf6, 0xcf, 0xe7, 0xbd, 0x8d, 0xdf,
-	0xcf, 0x7b, 0x86, 0x70, 0x46, 0x67, 0xa4, 0xce, 0x22, 0x7c, 0x0f, 0x8d, 0x30, 0x8a, 0x52, 0x9a,
-	0x65, 0xb2, 0x86, 0x75, 0xbc, 0xd5, 0x97, 0x2f, 0x2c, 0x90, 0x81, 0x32, 0xc8, 0x92, 0xc0, 0x2e,
-	0xe8, 0xfc, 0x29, 0xa1, 0xae, 0xd6, 0xa9, 0xf5, 0xda, 0xc7, 0xed, 0x35, 0xe9, 0x3f, 0x25, 0x94,
-	0x48, 0x0f, 0x3f, 0x83, 0x9d, 0x96, 0x6e, 0xe3, 0xea, 0xb2, 0xea, 0xee, 0x9a, 0x2d, 0xdf, 0x95,
-	0x54, 0x58, 0xfc, 0x00, 0x90, 0xd2, 0x24, 0xe7, 0xa1, 0x38, 0xba, 0x9b, 0xf2, 0xc9, 0x17, 0xeb,
-	0x27, 0xa7, 0x3c, 0xe4, 0x19, 0x29, 0x21, 0xd8, 0x87, 0xe6, 0x03, 0xe5, 0x61, 0x14, 0xf2, 0xd0,
-	0x35, 0x24, 0x8e, 0x6b, 0xfc, 0x5b, 0xe1, 0x90, 0x15, 0x83, 0xfb, 0x60, 0xcf, 0x43, 0x4e, 0x17,
-	0xb3, 0xa7, 0x60, 0xce, 0x32, 0xee, 0x36, 0x3a, 0x5a, 0x4f, 0x23, 0x56, 0xa1, 0x79, 0x2c, 0xe3,
-	0x78, 0x00, 0xad, 0x30, 0x8f, 0x18, 0x0f, 0xb2, 0x7c, 0x36, 0x13, 0x63, 0x69, 0x76, 0x6a, 0xbd,
-	0x26, 0xb1, 0xa5, 0x38, 0x55, 0x1a, 0x6e, 0xc3, 0x26, 0xcb, 0x82, 0x3c, 0x71, 0x4d, 0x69, 0xea,
-	0x2c, 0xbb, 0x4a, 0x44, 0x6e, 0x79, 0x12, 0x85, 0x9c, 0x06, 0x45, 0x3d, 0x17, 0xa4, 0xdb, 0x52,
-	0xaa, 0xa7, 0x44, 0x3c, 0x82, 0x9d, 0x02, 0xab, 0xf6, 0xb1, 0x24, 0x8c, 0xca, 0x1b, 0x94, 0xbb,
-	0x1d, 0x40, 0x51, 0x22, 0xc8, 0x13, 0xce, 0x1e, 0xa8, 0x6b, 0xab, 0x57, 0x52, 0xe2, 0x95, 0xd4,
-	0xba, 0x37, 0x60, 0x95, 0x32, 0xc3, 0x8f, 0x60, 0xf2, 0x34, 0x5c, 0x64, 0x49, 0x9c, 0x72, 0x19,
-	0x7f, 0xfb, 0x78, 0xbb, 0x94, 0xd7, 0xd2, 0x22, 0x6b, 0x0a, 0xdd, 0xea, 0x2a, 0x98, 0xab, 0xdc,
-	0xbb, 0xbf, 0xea, 0x60, 0xae, 0x02, 0xc0, 0x77, 0xd0, 0x10, 0x85, 0x82, 0xff, 0xee, 0x95, 0x21,
-	0xec, 0x51, 0x84, 0xaf, 0x01, 0x96, 0xd3, 0x3e, 0x39, 0x2a, 0x56, 0xd4, 0x2c, 0x94, 0x93, 0x23,
-	0xec, 0xc3, 0x76, 0x65, 0x02, 0x41, 0x2a, 0x42, 0x95, 0xcb, 0x55, 0x23, 0x5b, 0xe5, 0x79, 0x13,
-	0x61, 0x88, 0xf0, 0xd4, 0xfd, 0x0b, 0x50, 0x97, 0xa0, 0xa5, 0x34, 0x85, 0xec, 0x81, 0xa5, 0x4a,
-	0xce, 0xe2, 0x7c, 0xc1, 0xe5, 0x06, 0x69, 0x04, 0xa4, 0x74, 0x2a, 0x94, 0x7f, 0x7b, 0x2a, 0xd0,
-	0x90, 0x60, 0xa5, 0xa7, 0xe2, 0xd7, 0x3d, 0x15, 0xd8, 0x90, 0x60, 0xd1, 0x53, 0x21, 0x32, 0x4f,
-	0x89, 0x54, 0x6b, 0x36, 0x25, 0x8a, 0xca, 0x2b, 0x17, 0xed, 0x7e, 0x01, 0xbb, 0xbc, 0x9f, 0xb8,
-	0x03, 0x9b, 0xf4, 0x21, 0x64, 0x73, 0x39, 0x4e, 0x93, 0xa8, 0x03, 0xee, 0x82, 0xf1, 0x18, 0xce,
-	0xe7, 0x94, 0x17, 0x69, 0x14, 0xa7, 0xc3, 0x31, 0x34, 0x97, 0x7f, 0x39, 0xb4, 0xa0, 0x31, 0x1a,
-	0x5f, 0x0f, 0xbc, 0xd1, 0x99, 0xb3, 0x81, 0x2d, 0x30, 0xa7, 0x03, 0xff, 0xdc, 0xf3, 0x46, 0xfe,
-	0xb9, 0x53, 0x13, 0xde, 0xd4, 0xbf, 0x24, 0x83, 0x8b, 0x73, 0xa7, 0x8e, 0x00, 0xc6, 0xd5, 0xc4,
-	0x1b, 0x8d, 0xbf, 0x3a, 0x9a, 0xe0, 0x86, 0x97, 0x97, 0xfe, 0xd4, 0x27, 0x83, 0x89, 0xa3, 0x1f,
-	0xee, 0x43, 0xab, 0xb2, 0x12, 0xe8, 0x80, 0xed, 0x9f, 0x4e, 0x02, 0xdf, 0x9b, 0x06, 0x17, 0x64,
-	0x72, 0xea, 0x6c, 0x0c, 0xf5, 0x9b, 0x7a, 0x72, 0x7b, 0x6b, 0xc8, 0x4f, 0xd6, 0xa7, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xc0, 0x8a, 0x68, 0xa5, 0xd2, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("node.proto", fileDescriptor_node_f25b268d8b88f686) }
+
+var fileDescriptor_node_f25b268d8b88f686 = []byte{
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x4e, 0xeb, 0x46,
+	0x14, 0xc6, 0x71, 0x6c, 0x92, 0xf8, 0x38, 0xc9, 0xf5, 0x1d, 0x22, 0x64, 0xb5, 0x6a, 0xc9, 0x0d,
+	0xaa, 0x88, 0xa8, 0x94, 0x52, 0xca, 0x86, 0xee, 0x92, 0x40, 0x51, 0xd4, 0x34, 0x44, 0x13, 0xc3,
+	0x82, 0x8d, 0x35, 0x64, 0x86, 0x60, 0x11, 0x6c, 0xcb, 0x1e, 0x0b, 0xe5, 0x35, 0xba, 0xed, 0xab,
+	0xf4, 0x01, 0xba, 0xe8, 0x13, 0x74, 0xc1, 0xb3, 0x54, 0xf3, 0x27, 0x89, 0xad, 0xd2, 0x9d, 0xfd,
+	0x7d, 0xbf, 0x39, 0xc7, 0x3e, 0xdf, 0xb1, 0x01, 0xa2, 0x98, 0xb2, 0x7e, 0x92, 0xc6, 0x3c, 0x46,
+	0x96, 0xb8, 0xfe, 0x0a, 0x96, 0xf1, 0x32, 0x56, 0x4a, 0xf7, 0x1e, 0xdc, 0x69, 0x4c, 0x19, 0x66,
+	0x19, 0x4f, 0xc3, 0x05, 0x0f, 0xe3, 0x28, 0x43, 0xdf, 0x41, 0xeb, 0x29, 0x65, 0x2c, 0x78, 0x24,
+	0x11, 0x7d, 0x0b, 0x29, 0x7f, 0xf6, 0x8c, 0x8e, 0xd1, 0x33, 0x71, 0x53, 0xa8, 0xc3, 0x8d, 0x88,
+	0xbe, 0x06, 0x5b, 0x62, 0x34, 0xcc, 0x5e, 0xbc, 0x8a, 0x24, 0xea, 0x42, 0xb8, 0x0a, 0xb3, 0x97,
+	0xee, 0xef, 0x16, 0x58, 0xa2, 0x30, 0xfa, 0x16, 0x2a, 0x21, 0x95, 0x05, 0x1a, 0xc3, 0xd6, 0x5f,
+	0xef, 0x47, 0x7b, 0xff, 0xbc, 0x1f, 0x55, 0x85, 0x33, 0xbe, 0xc2, 0x95, 0x90, 0xa2, 0xef, 0xa1,
+	0x46, 0x28, 0x4d, 0x59, 0x96, 0xc9, 0x1a, 0xce, 0xf9, 0xe7, 0xbe, 0x7c, 0x60, 0x81, 0x0c, 0x94,
+	0x81, 0x37, 0x04, 0xea, 0x82, 0xc5, 0xd7, 0x09, 0xf3, 0xcc, 0x8e, 0xd1, 0x6b, 0x9d, 0xb7, 0x76,
+	0xa4, 0xbf, 0x4e, 0x18, 0x96, 0x1e, 0xfa, 0x19, 0x1a, 0x69, 0xe1, 0x6d, 0x3c, 0x4b, 0x56, 0x3d,
+	0xdc, 0xb1, 0xc5, 0x77, 0xc5, 0x25, 0x16, 0xfd, 0x00, 0x90, 0xb2, 0x24, 0xe7, 0x44, 0xdc, 0x7a,
+	0xfb, 0xf2, 0xe4, 0xa7, 0xdd, 0xc9, 0x39, 0x27, 0x3c, 0xc3, 0x05, 0x04, 0xf5, 0xa1, 0xfe, 0xca,
+	0x38, 0xa1, 0x84, 0x13, 0xaf, 0x2a, 0x71, 0xb4, 0xc3, 0x7f, 0xd3, 0x0e, 0xde, 0x32, 0xe8, 0x0b,
+	0x34, 0x56, 0x84, 0xb3, 0x68, 0xb1, 0x0e, 0x56, 0x61, 0xc6, 0xbd, 0x5a, 0xc7, 0xec, 0x99, 0xd8,
+	0xd1, 0xda, 0x24, 0xcc, 0x38, 0x3a, 0x86, 0x26, 0xc9, 0x69, 0xc8, 0x83, 0x2c, 0x5f, 0x2c, 0xc4,
+	0x58, 0xea, 0x1d, 0xa3, 0x57, 0xc7, 0x0d, 0x29, 0xce, 0x95, 0x86, 0x0e, 0x60, 0x3f, 0xcc, 0x82,
+	0x3c, 0xf1, 0x6c, 0x69, 0x5a, 0x61, 0x76, 0x97, 0x88, 0xdc, 0xf2, 0x84, 0x12, 0xce, 0x02, 0x5d,
+	0xcf, 0x03, 0xe9, 0x36, 0x95, 0x3a, 0x51, 0x22, 0x3a, 0x83, 0xb6, 0xc6, 0xca, 0x7d, 0x1c, 0x09,
+	0x23, 0xe5, 0x0d, 0x8a, 0xdd, 0x8e, 0x41, 0x97, 0x08, 0xf2, 0x84, 0x87, 0xaf, 0xcc, 0x6b, 0xa8,
+	0x47, 0x52, 0xe2, 0x9d, 0xd4, 0xc4, 0xab, 0x2d, 0xe2, 0x3c, 0xe2, 0xe9, 0x3a, 0x58, 0xc4, 0x94,
+	0x79, 0xcd, 0x8e, 0xd1, 0xb3, 0xb1, 0xa3, 0xb5, 0x51, 0x4c, 0x59, 0xf7, 0x01, 0x9c, 0x42, 0xac,
+	0xe8, 0x47, 0xb0, 0x79, 0x4a, 0xa2, 0x2c, 0x89, 0x53, 0x2e, 0x37, 0xa4, 0x75, 0x7e, 0x50, 0x88,
+	0x74, 0x63, 0xe1, 0x1d, 0x85, 0xbc, 0xf2, 0xb6, 0xd8, 0xdb, 0xd5, 0xe8, 0xfe, 0x5d, 0x01, 0x7b,
+	0x9b, 0x11, 0x3a, 0x81, 0x9a, 0x28, 0x14, 0xfc, 0xef, 0xea, 0x55, 0x85, 0x3d, 0xa6, 0xe8, 0x1b,
+	0x80, 0x4d, 0x20, 0x97, 0x67, 0x7a, 0x8b, 0x6d, 0xad, 0x5c, 0x9e, 0xa1, 0x3e, 0x1c, 0x94, 0x86,
+	0x14, 0xa4, 0x22, 0x77, 0xb9, 0x7f, 0x06, 0xfe, 0x5c, 0x8c, 0x04, 0x0b, 0x43, 0x0c, 0x41, 0x8d,
+	0x48, 0x83, 0x96, 0x04, 0x1d, 0xa5, 0x29, 0xe4, 0x08, 0x1c, 0x55, 0x52, 0x4e, 0x46, 0x2e, 0x99,
+	0x89, 0x41, 0x4a, 0x23, 0xa1, 0xfc, 0xb7, 0xa7, 0x02, 0xab, 0x12, 0x2c, 0xf5, 0x54, 0xfc, 0xae,
+	0xa7, 0x02, 0x6b, 0x12, 0xd4, 0x3d, 0x15, 0x22, 0x23, 0x97, 0x48, 0xb9, 0x66, 0x5d, 0xa2, 0x48,
+	0x79, 0xc5, 0xa2, 0xdd, 0x3f, 0x0c, 0x68, 0x14, 0x77, 0x18, 0xb5, 0x61, 0x9f, 0xbd, 0x92, 0x70,
+	0x25, 0xe7, 0x69, 0x63, 0x75, 0x83, 0x0e, 0xa1, 0xfa, 0x46, 0x56, 0x2b, 0xc6, 0x75, 0x1c, 0xfa,
+	0x0e, 0x9d, 0xc0, 0x27, 0x75, 0x15, 0x3c, 0x31, 0xc2, 0xf3, 0x94, 0x65, 0x9e, 0xd9, 0x31, 0x7b,
+	0x36, 0x6e, 0x29, 0xf9, 0x17, 0xad, 0xa2, 0x0b, 0x00, 0xfe, 0x9c, 0xc6, 0xf9, 0xf2, 0x39, 0xc9,
+	0xb9, 0xfe, 0x56, 0xdb, 0x85, 0x25, 0xd8, 0x7a, 0xb8, 0xc0, 0x75, 0xff, 0x34, 0xa0, 0x55, 0xb6,
+	0xc5, 0x14, 0xc2, 0x68, 0x99, 0xea, 0x8c, 0x98, 0xfe, 0x65, 0x39, 0x5a, 0xc3, 0x84, 0x33, 0x31,
+	0x79, 0x56, 0x20, 0x54, 0xd8, 0xc0, 0x76, 0x80, 0x1c, 0xd3, 0x2a, 0x26, 0xf4, 0xc3, 0xb8, 0x91,
+	0xf2, 0x4a, 0x79, 0x5f, 0xc0, 0x21, 0x8d, 0xdf, 0xa2, 0x0f, 0xce, 0xa8, 0xe4, 0xdb, 0x1b, 0xb7,
+	0x78, 0xea, 0x74, 0x0a, 0xf5, 0xcd, 0x4f, 0x0b, 0x39, 0x50, 0x1b, 0x4f, 0xef, 0x07, 0x93, 0xf1,
+	0x95, 0xbb, 0x87, 0x9a, 0x60, 0xcf, 0x07, 0xfe, 0xf5, 0x64, 0x32, 0xf6, 0xaf, 0x5d, 0x43, 0x78,
+	0x73, 0xff, 0x16, 0x0f, 0x6e, 0xae, 0xdd, 0x0a, 0x02, 0xa8, 0xde, 0xcd, 0x26, 0xe3, 0xe9, 0xaf,
+	0xae, 0x29, 0xb8, 0xe1, 0xed, 0xad, 0x3f, 0xf7, 0xf1, 0x60, 0xe6, 0x5a, 0xa7, 0x5f, 0xa0, 0x59,
+	0xfa, 0x62, 0x90, 0x0b, 0x0d, 0x7f, 0x34, 0x0b, 0xfc, 0xc9, 0x3c, 0xb8, 0xc1, 0xb3, 0x91, 0xbb,
+	0x37, 0xb4, 0x1e, 0x2a, 0xc9, 0xe3, 0x63, 0x55, 0xfe, 0xf4, 0x7f, 0xfa, 0x77, 0x00, 0x89, 0x34,
+	0xf8, 0xcd, 0x14, 0x06, 0x00, 0x00,
 }
@@ -0,0 +1,400 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nodestats.proto
+
+package pb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// ReputationRequest is a request message for the Reputation rpc call
+type ReputationRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReputationRequest) Reset()         { *m = ReputationRequest{} }
+func (m *ReputationRequest) String() string { return proto.CompactTextString(m) }
+func (*ReputationRequest) ProtoMessage()    {}
+func (*ReputationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_nodestats_e8d63f2bc7c9f926, []int{0}
+}
+func (m *ReputationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReputationRequest.Unmarshal(m, b)
+}
+func (m *ReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReputationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReputationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputationRequest.Merge(dst, src)
+}
+func (m *ReputationRequest) XXX_Size() int {
+	return xxx_messageInfo_ReputationRequest.Size(m)
+}
+func (m *ReputationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputationRequest proto.InternalMessageInfo
+
+// ReputationResponse is a response message for the Reputation rpc call
+type ReputationResponse struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	AuditCount           int64    `protobuf:"varint,2,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
+	AuditSuccessCount    int64    `protobuf:"varint,3,opt,name=audit_success_count,json=auditSuccessCount,proto3" json:"audit_success_count,omitempty"`
+	AuditRatio           float64  `protobuf:"fixed64,4,opt,name=audit_ratio,json=auditRatio,proto3" json:"audit_ratio,omitempty"`
+	UptimeCount          int64    `protobuf:"varint,5,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
+	UptimeSuccessCount   int64    `protobuf:"varint,6,opt,name=uptime_success_count,json=uptimeSuccessCount,proto3" json:"uptime_success_count,omitempty"`
+	UptimeRatio          float64  `protobuf:"fixed64,7,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReputationResponse) Reset()         { *m = ReputationResponse{} }
+func (m *ReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*ReputationResponse) ProtoMessage()    {}
+func (*ReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_nodestats_e8d63f2bc7c9f926, []int{1}
+}
+func (m *ReputationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReputationResponse.Unmarshal(m, b)
+}
+func (m *ReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReputationResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputationResponse.Merge(dst, src)
+}
+func (m *ReputationResponse) XXX_Size() int {
+	return xxx_messageInfo_ReputationResponse.Size(m)
+}
+func (m *ReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputationResponse proto.InternalMessageInfo
+
+func (m *ReputationResponse) GetAuditCount() int64 {
+	if m != nil {
+		return m.AuditCount
+	}
+	return 0
+}
+
+func (m *ReputationResponse) GetAuditSuccessCount() int64 {
+	if m != nil {
+		return m.AuditSuccessCount
+	}
+	return 0
+}
+
+func (m *ReputationResponse) GetAuditRatio() float64 {
+	if m != nil {
+		return m.AuditRatio
+	}
+	return 0
+}
+
+func (m *ReputationResponse) GetUptimeCount() int64 {
+	if m != nil {
+		return m.UptimeCount
+	}
+	return 0
+}
+
+func (m *ReputationResponse) GetUptimeSuccessCount() int64 {
+	if m != nil {
+		return m.UptimeSuccessCount
+	}
+	return 0
+}
+
+func (m *ReputationResponse) GetUptimeRatio() float64 {
+	if m != nil {
+		return m.UptimeRatio
+	}
+	return 0
+}
+
+// PricingRequest is a request message for the Pricing rpc call
+type PricingRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PricingRequest) Reset()         { *m = PricingRequest{} }
+func (m *PricingRequest) String() string { return proto.CompactTextString(m) }
+func (*PricingRequest) ProtoMessage()    {}
+func (*PricingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_nodestats_e8d63f2bc7c9f926, []int{2}
+}
+func (m *PricingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PricingRequest.Unmarshal(m, b)
+}
+func (m *PricingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PricingRequest.Marshal(b, m, deterministic)
+}
+func (dst *PricingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricingRequest.Merge(dst, src)
+}
+func (m *PricingRequest) XXX_Size() int {
+	return xxx_messageInfo_PricingRequest.Size(m)
+}
+func (m *PricingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricingRequest proto.InternalMessageInfo
+
+// PricingResponse is a response message for the Pricing rpc call, prices are in US cents
+type PricingResponse struct {
+	DiskSpaceTbMonth int64 `protobuf:"varint,1,opt,name=disk_space_tb_month,json=diskSpaceTbMonth,proto3" json:"disk_space_tb_month,omitempty"`
+	EgressTb         int64 `protobuf:"varint,2,opt,name=egress_tb,json=egressTb,proto3" json:"egress_tb,omitempty"`
+	RepairEgressTb   int64 `protobuf:"varint,3,opt,name=repair_egress_tb,json=repairEgressTb,proto3" json:"repair_egress_tb,omitempty"`
+	AuditEgressTb    int64 `protobuf:"varint,4,opt,name=audit_egress_tb,json=auditEgressTb,proto3" json:"audit_egress_tb,omitempty"`
+	// held_percentages are the percentages of the payout held back in each month of the node's age, the last one applies to all later months
+	HeldPercentages []int32 `protobuf:"varint,5,rep,packed,name=held_percentages,json=heldPercentages,proto3" json:"held_percentages,omitempty"`
+	// joined is when the satellite first saw the node, it's missing for unknown nodes
+	Joined               *timestamp.Timestamp `protobuf:"bytes,6,opt,name=joined,proto3" json:"joined,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PricingResponse) Reset()         { *m = PricingResponse{} }
+func (m *PricingResponse) String() string { return proto.CompactTextString(m) }
+func (*PricingResponse) ProtoMessage()    {}
+func (*PricingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_nodestats_e8d63f2bc7c9f926, []int{3}
+}
+func (m *PricingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PricingResponse.Unmarshal(m, b)
+}
+func (m *PricingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PricingResponse.Marshal(b, m, deterministic)
+}
+func (dst *PricingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricingResponse.Merge(dst, src)
+}
+func (m *PricingResponse) XXX_Size() int {
+	return xxx_messageInfo_PricingResponse.Size(m)
+}
+func (m *PricingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricingResponse proto.InternalMessageInfo
+
+func (m *PricingResponse) GetDiskSpaceTbMonth() int64 {
+	if m != nil {
+		return m.DiskSpaceTbMonth
+	}
+	return 0
+}
+
+func (m *PricingResponse) GetEgressTb() int64 {
+	if m != nil {
+		return m.EgressTb
+	}
+	return 0
+}
+
+func (m *PricingResponse) GetRepairEgressTb() int64 {
+	if m != nil {
+		return m.RepairEgressTb
+	}
+	return 0
+}
+
+func (m *PricingResponse) GetAuditEgressTb() int64 {
+	if m != nil {
+		return m.AuditEgressTb
+	}
+	return 0
+}
+
+func (m *PricingResponse) GetHeldPercentages() []int32 {
+	if m != nil {
+		return m.HeldPercentages
+	}
+	return nil
+}
+
+func (m *PricingResponse) GetJoined() *timestamp.Timestamp {
+	if m != nil {
+		return m.Joined
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ReputationRequest)(nil), "nodestats.ReputationRequest")
+	proto.RegisterType((*ReputationResponse)(nil), "nodestats.ReputationResponse")
+	proto.RegisterType((*PricingRequest)(nil), "nodestats.PricingRequest")
+	proto.RegisterType((*PricingResponse)(nil), "nodestats.PricingResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeStatsClient is the client API for NodeStats service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeStatsClient interface {
+	// Reputation returns the audit and uptime history of the calling node
+	Reputation(ctx context.Context, in *ReputationRequest, opts ...grpc.CallOption) (*ReputationResponse, error)
+	// Pricing returns the payout prices of the satellite and the held amount schedule of the calling node
+	Pricing(ctx context.Context, in *PricingRequest, opts ...grpc.CallOption) (*PricingResponse, error)
+}
+
+type nodeStatsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeStatsClient(cc *grpc.ClientConn) NodeStatsClient {
+	return &nodeStatsClient{cc}
+}
+
+func (c *nodeStatsClient) Reputation(ctx context.Context, in *ReputationRequest, opts ...grpc.CallOption) (*ReputationResponse, error) {
+	out := new(ReputationResponse)
+	err := c.cc.Invoke(ctx, "/nodestats.NodeStats/Reputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeStatsClient) Pricing(ctx context.Context, in *PricingRequest, opts ...grpc.CallOption) (*PricingResponse, error) {
+	out := new(PricingResponse)
+	err := c.cc.Invoke(ctx, "/nodestats.NodeStats/Pricing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeStatsServer is the server API for NodeStats service.
+type NodeStatsServer interface {
+	// Reputation returns the audit and uptime history of the calling node
+	Reputation(context.Context, *ReputationRequest) (*ReputationResponse, error)
+	// Pricing returns the payout prices of the satellite and the held amount schedule of the calling node
+	Pricing(context.Context, *PricingRequest) (*PricingResponse, error)
+}
+
+func RegisterNodeStatsServer(s *grpc.Server, srv NodeStatsServer) {
+	s.RegisterService(&_NodeStats_serviceDesc, srv)
+}
+
+func _NodeStats_Reputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatsServer).Reputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodestats.NodeStats/Reputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatsServer).Reputation(ctx, req.(*ReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeStats_Pricing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PricingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatsServer).Pricing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodestats.NodeStats/Pricing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatsServer).Pricing(ctx, req.(*PricingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NodeStats_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nodestats.NodeStats",
+	HandlerType: (*NodeStatsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reputation",
+			Handler:    _NodeStats_Reputation_Handler,
+		},
+		{
+			MethodName: "Pricing",
+			Handler:    _NodeStats_Pricing_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nodestats.proto",
+}
+
+func init() { proto.RegisterFile("nodestats.proto", fileDescriptor_nodestats_e8d63f2bc7c9f926) }
+
+var fileDescriptor_nodestats_e8d63f2bc7c9f926 = []byte{
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcb, 0x92, 0xd2, 0x40,
+	0x18, 0x85, 0x0d, 0x97, 0x20, 0x3f, 0x23, 0x30, 0x8d, 0x0b, 0x8c, 0x5a, 0x20, 0x0b, 0x8d, 0x0b,
+	0x33, 0x16, 0xbe, 0x80, 0x35, 0xea, 0x82, 0x85, 0xd6, 0x54, 0x60, 0xe5, 0x26, 0x95, 0xcb, 0x6f,
+	0xa6, 0x75, 0xe8, 0x6e, 0xd3, 0x9d, 0xd7, 0x70, 0xed, 0x1b, 0xf8, 0x2a, 0x3e, 0x83, 0x8b, 0x79,
+	0x16, 0xab, 0x2f, 0x24, 0x50, 0x3a, 0x4b, 0xce, 0xf9, 0xea, 0xfc, 0xcd, 0xc9, 0x81, 0x09, 0xe3,
+	0x05, 0x4a, 0x95, 0x2a, 0x19, 0x89, 0x8a, 0x2b, 0x4e, 0x86, 0x8d, 0x10, 0x40, 0xc9, 0x4b, 0x6e,
+	0xe5, 0x60, 0x51, 0x72, 0x5e, 0xde, 0xe0, 0x85, 0xf9, 0x95, 0xd5, 0x5f, 0x2e, 0x14, 0xdd, 0x6b,
+	0x6c, 0x2f, 0x2c, 0xb0, 0x9a, 0xc1, 0x79, 0x8c, 0xa2, 0x56, 0xa9, 0xa2, 0x9c, 0xc5, 0xf8, 0xbd,
+	0x46, 0xa9, 0x56, 0xbf, 0x3a, 0x40, 0x8e, 0x55, 0x29, 0x38, 0x93, 0x48, 0x5e, 0xc0, 0x40, 0x5f,
+	0x49, 0x68, 0x31, 0xf7, 0x96, 0x5e, 0x78, 0x76, 0x39, 0xfe, 0x7d, 0xbb, 0xb8, 0xf7, 0xe7, 0x76,
+	0xe1, 0x7f, 0xe2, 0x05, 0x6e, 0xde, 0xc7, 0xbe, 0xb6, 0x37, 0x05, 0x59, 0xc0, 0x28, 0xad, 0x0b,
+	0xaa, 0x92, 0x9c, 0xd7, 0x4c, 0xcd, 0x3b, 0x4b, 0x2f, 0xec, 0xc6, 0x60, 0xa4, 0x77, 0x5a, 0x21,
+	0x11, 0xcc, 0x2c, 0x20, 0xeb, 0x3c, 0x47, 0x29, 0x1d, 0xd8, 0x35, 0xe0, 0xb9, 0xb1, 0xb6, 0xd6,
+	0xb1, 0x7c, 0x13, 0x58, 0xe9, 0x17, 0xcd, 0x7b, 0x4b, 0x2f, 0xf4, 0x5c, 0x60, 0xac, 0x15, 0xf2,
+	0x0c, 0xce, 0x6a, 0xa1, 0xff, 0x9b, 0x4b, 0xea, 0x9b, 0xa4, 0x91, 0xd5, 0x6c, 0xc6, 0x6b, 0x78,
+	0xe8, 0x90, 0xd3, 0xa3, 0xbe, 0x41, 0x89, 0xf5, 0x4e, 0xae, 0xb6, 0xa1, 0xf6, 0xec, 0xc0, 0x9c,
+	0x75, 0xa1, 0xe6, 0xee, 0x6a, 0x0a, 0xe3, 0xab, 0x8a, 0xe6, 0x94, 0x95, 0x87, 0xee, 0x7e, 0x74,
+	0x60, 0xd2, 0x48, 0xae, 0xb8, 0x57, 0x30, 0x2b, 0xa8, 0xfc, 0x96, 0x48, 0x91, 0xe6, 0x98, 0xa8,
+	0x2c, 0xd9, 0x73, 0xa6, 0xae, 0x4d, 0x89, 0xdd, 0x78, 0xaa, 0xad, 0xad, 0x76, 0x76, 0xd9, 0x47,
+	0xad, 0x93, 0xc7, 0x30, 0xc4, 0xb2, 0xd2, 0x2f, 0x54, 0x99, 0x2b, 0xef, 0xbe, 0x15, 0x76, 0x19,
+	0x09, 0x61, 0x5a, 0xa1, 0x48, 0x69, 0x95, 0xb4, 0x8c, 0xed, 0x6d, 0x6c, 0xf5, 0x0f, 0x07, 0xf2,
+	0x39, 0x4c, 0x6c, 0x69, 0x2d, 0xd8, 0x33, 0xe0, 0x03, 0x23, 0x37, 0xdc, 0x4b, 0x98, 0x5e, 0xe3,
+	0x4d, 0x91, 0x08, 0xac, 0x72, 0x64, 0x2a, 0x2d, 0x51, 0xce, 0xfb, 0xcb, 0x6e, 0xd8, 0x8f, 0x27,
+	0x5a, 0xbf, 0x6a, 0x65, 0xb2, 0x06, 0xff, 0x2b, 0xa7, 0x0c, 0x0b, 0xd3, 0xda, 0x68, 0x1d, 0x44,
+	0x76, 0x5f, 0xd1, 0x61, 0x5f, 0xd1, 0xee, 0xb0, 0xaf, 0xd8, 0x91, 0xeb, 0x9f, 0x1e, 0x0c, 0xf5,
+	0x3e, 0xb6, 0x7a, 0x9c, 0x64, 0x03, 0xd0, 0x2e, 0x8b, 0x3c, 0x89, 0xda, 0x1d, 0xff, 0x33, 0xc3,
+	0xe0, 0xe9, 0x1d, 0xae, 0x6b, 0xf5, 0x2d, 0x0c, 0x5c, 0xd1, 0xe4, 0xd1, 0x11, 0x79, 0xfa, 0x3d,
+	0x82, 0xe0, 0x7f, 0x96, 0x4d, 0xb8, 0xec, 0x7d, 0xee, 0x88, 0x2c, 0xf3, 0xcd, 0xe3, 0xdf, 0xfc,
+	0x1d, 0x00, 0x5b, 0x54, 0xb1, 0xac, 0x54, 0x03, 0x00, 0x00,
+}
@@ -8,6 +8,7 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 import duration "github.com/golang/protobuf/ptypes/duration"
+import wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
 import (
 	context "golang.org/x/net/context"
@@ -54,7 +55,7 @@ func (x Restriction_Operator) String() string {
 	return proto.EnumName(Restriction_Operator_name, int32(x))
 }
 func (Restriction_Operator) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{11, 0}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{14, 0}
 }
 
 type Restriction_Operand int32
@@ -77,7 +78,7 @@ func (x Restriction_Operand) String() string {
 	return proto.EnumName(Restriction_Operand_name, int32(x))
 }
 func (Restriction_Operand) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{11, 1}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{14, 1}
 }
 
 // LookupRequest is is request message for the lookup rpc call
@@ -92,7 +93,7 @@ func (m *LookupRequest) Reset()         { *m = LookupRequest{} }
 func (m *LookupRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupRequest) ProtoMessage()    {}
 func (*LookupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{0}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{0}
 }
 func (m *LookupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupRequest.Unmarshal(m, b)
@@ -124,7 +125,7 @@ func (m *LookupResponse) Reset()         { *m = LookupResponse{} }
 func (m *LookupResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupResponse) ProtoMessage()    {}
 func (*LookupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{1}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{1}
 }
 func (m *LookupResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupResponse.Unmarshal(m, b)
@@ -163,7 +164,7 @@ func (m *LookupRequests) Reset()         { *m = LookupRequests{} }
 func (m *LookupRequests) String() string { return proto.CompactTextString(m) }
 func (*LookupRequests) ProtoMessage()    {}
 func (*LookupRequests) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{2}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{2}
 }
 func (m *LookupRequests) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupRequests.Unmarshal(m, b)
@@ -202,7 +203,7 @@ func (m *LookupResponses) Reset()         { *m = LookupResponses{} }
 func (m *LookupResponses) String() string { return proto.CompactTextString(m) }
 func (*LookupResponses) ProtoMessage()    {}
 func (*LookupResponses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{3}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{3}
 }
 func (m *LookupResponses) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupResponses.Unmarshal(m, b)
@@ -241,7 +242,7 @@ func (m *FindStorageNodesResponse) Reset()         { *m = FindStorageNodesRespon
 func (m *FindStorageNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*FindStorageNodesResponse) ProtoMessage()    {}
 func (*FindStorageNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{4}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{4}
 }
 func (m *FindStorageNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindStorageNodesResponse.Unmarshal(m, b)
@@ -284,7 +285,7 @@ func (m *FindStorageNodesRequest) Reset()         { *m = FindStorageNodesRequest
 func (m *FindStorageNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*FindStorageNodesRequest) ProtoMessage()    {}
 func (*FindStorageNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{5}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{5}
 }
 func (m *FindStorageNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindStorageNodesRequest.Unmarshal(m, b)
@@ -334,22 +335,28 @@ func (m *FindStorageNodesRequest) GetMinNodes() int64 {
 
 // OverlayOptions is a set of criteria that a node must meet to be considered for a storage opportunity
 type OverlayOptions struct {
-	MaxLatency           *duration.Duration `protobuf:"bytes,1,opt,name=max_latency,json=maxLatency,proto3" json:"max_latency,omitempty"`
-	MinStats             *NodeStats         `protobuf:"bytes,2,opt,name=min_stats,json=minStats,proto3" json:"min_stats,omitempty"`
-	MinSpeedKbps         int64              `protobuf:"varint,3,opt,name=min_speed_kbps,json=minSpeedKbps,proto3" json:"min_speed_kbps,omitempty"`
-	Amount               int64              `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Restrictions         *NodeRestrictions  `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
-	ExcludedNodes        []NodeID           `protobuf:"bytes,6,rep,name=excluded_nodes,json=excludedNodes,proto3,customtype=NodeID" json:"excluded_nodes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	MaxLatency    *duration.Duration `protobuf:"bytes,1,opt,name=max_latency,json=maxLatency,proto3" json:"max_latency,omitempty"`
+	MinStats      *NodeStats         `protobuf:"bytes,2,opt,name=min_stats,json=minStats,proto3" json:"min_stats,omitempty"`
+	MinSpeedKbps  int64              `protobuf:"varint,3,opt,name=min_speed_kbps,json=minSpeedKbps,proto3" json:"min_speed_kbps,omitempty"`
+	Amount        int64              `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Restrictions  *NodeRestrictions  `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	ExcludedNodes []NodeID           `protobuf:"bytes,6,rep,name=excluded_nodes,json=excludedNodes,proto3,customtype=NodeID" json:"excluded_nodes,omitempty"`
+	// allowed_countries restricts the nodes to these ISO country codes, nodes with an unknown country are left out
+	AllowedCountries  []string `protobuf:"bytes,7,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	ExcludedCountries []string `protobuf:"bytes,8,rep,name=excluded_countries,json=excludedCountries,proto3" json:"excluded_countries,omitempty"`
+	// new_node_ratio is the fraction of new, not yet vetted, nodes requested in addition
+	// to the vetted nodes, it's capped by the satellite and the satellite's ratio is used when unset
+	NewNodeRatio         *wrappers.DoubleValue `protobuf:"bytes,9,opt,name=new_node_ratio,json=newNodeRatio,proto3" json:"new_node_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *OverlayOptions) Reset()         { *m = OverlayOptions{} }
 func (m *OverlayOptions) String() string { return proto.CompactTextString(m) }
 func (*OverlayOptions) ProtoMessage()    {}
 func (*OverlayOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{6}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{6}
 }
 func (m *OverlayOptions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OverlayOptions.Unmarshal(m, b)
@@ -404,6 +411,128 @@ func (m *OverlayOptions) GetRestrictions() *NodeRestrictions {
 	return nil
 }
 
+func (m *OverlayOptions) GetAllowedCountries() []string {
+	if m != nil {
+		return m.AllowedCountries
+	}
+	return nil
+}
+
+func (m *OverlayOptions) GetExcludedCountries() []string {
+	if m != nil {
+		return m.ExcludedCountries
+	}
+	return nil
+}
+
+func (m *OverlayOptions) GetNewNodeRatio() *wrappers.DoubleValue {
+	if m != nil {
+		return m.NewNodeRatio
+	}
+	return nil
+}
+
+// UploadStats counts the outcomes of piece uploads, it doesn't identify nodes or data
+type UploadStats struct {
+	Success              int64    `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Timeout              int64    `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Refused              int64    `protobuf:"varint,3,opt,name=refused,proto3" json:"refused,omitempty"`
+	Failed               int64    `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Canceled             int64    `protobuf:"varint,5,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadStats) Reset()         { *m = UploadStats{} }
+func (m *UploadStats) String() string { return proto.CompactTextString(m) }
+func (*UploadStats) ProtoMessage()    {}
+func (*UploadStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_overlay_f6d103b25331f648, []int{7}
+}
+func (m *UploadStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadStats.Unmarshal(m, b)
+}
+func (m *UploadStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadStats.Marshal(b, m, deterministic)
+}
+func (dst *UploadStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadStats.Merge(dst, src)
+}
+func (m *UploadStats) XXX_Size() int {
+	return xxx_messageInfo_UploadStats.Size(m)
+}
+func (m *UploadStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadStats proto.InternalMessageInfo
+
+func (m *UploadStats) GetSuccess() int64 {
+	if m != nil {
+		return m.Success
+	}
+	return 0
+}
+
+func (m *UploadStats) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *UploadStats) GetRefused() int64 {
+	if m != nil {
+		return m.Refused
+	}
+	return 0
+}
+
+func (m *UploadStats) GetFailed() int64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *UploadStats) GetCanceled() int64 {
+	if m != nil {
+		return m.Canceled
+	}
+	return 0
+}
+
+type UploadStatsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadStatsResponse) Reset()         { *m = UploadStatsResponse{} }
+func (m *UploadStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*UploadStatsResponse) ProtoMessage()    {}
+func (*UploadStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_overlay_f6d103b25331f648, []int{8}
+}
+func (m *UploadStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadStatsResponse.Unmarshal(m, b)
+}
+func (m *UploadStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *UploadStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadStatsResponse.Merge(dst, src)
+}
+func (m *UploadStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_UploadStatsResponse.Size(m)
+}
+func (m *UploadStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadStatsResponse proto.InternalMessageInfo
+
 type QueryRequest struct {
 	Sender               *Node    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Target               *Node    `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
@@ -418,7 +547,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{7}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{9}
 }
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
@@ -467,18 +596,21 @@ func (m *QueryRequest) GetPingback() bool {
 }
 
 type QueryResponse struct {
-	Sender               *Node    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Response             []*Node  `protobuf:"bytes,2,rep,name=response,proto3" json:"response,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Sender   *Node   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Response []*Node `protobuf:"bytes,2,rep,name=response,proto3" json:"response,omitempty"`
+	// metadata_errors are the invalid fields of the sender's operator metadata,
+	// they're only checked by satellites
+	MetadataErrors       []*MetadataError `protobuf:"bytes,3,rep,name=metadata_errors,json=metadataErrors,proto3" json:"metadata_errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *QueryResponse) Reset()         { *m = QueryResponse{} }
 func (m *QueryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryResponse) ProtoMessage()    {}
 func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{8}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{10}
 }
 func (m *QueryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QueryResponse.Unmarshal(m, b)
@@ -512,6 +644,60 @@ func (m *QueryResponse) GetResponse() []*Node {
 	return nil
 }
 
+func (m *QueryResponse) GetMetadataErrors() []*MetadataError {
+	if m != nil {
+		return m.MetadataErrors
+	}
+	return nil
+}
+
+// MetadataError is a field of the operator metadata, which isn't valid
+type MetadataError struct {
+	Field                string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MetadataError) Reset()         { *m = MetadataError{} }
+func (m *MetadataError) String() string { return proto.CompactTextString(m) }
+func (*MetadataError) ProtoMessage()    {}
+func (*MetadataError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_overlay_f6d103b25331f648, []int{11}
+}
+func (m *MetadataError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetadataError.Unmarshal(m, b)
+}
+func (m *MetadataError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetadataError.Marshal(b, m, deterministic)
+}
+func (dst *MetadataError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataError.Merge(dst, src)
+}
+func (m *MetadataError) XXX_Size() int {
+	return xxx_messageInfo_MetadataError.Size(m)
+}
+func (m *MetadataError) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataError proto.InternalMessageInfo
+
+func (m *MetadataError) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *MetadataError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type PingRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -522,7 +708,7 @@ func (m *PingRequest) Reset()         { *m = PingRequest{} }
 func (m *PingRequest) String() string { return proto.CompactTextString(m) }
 func (*PingRequest) ProtoMessage()    {}
 func (*PingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{9}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{12}
 }
 func (m *PingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingRequest.Unmarshal(m, b)
@@ -552,7 +738,7 @@ func (m *PingResponse) Reset()         { *m = PingResponse{} }
 func (m *PingResponse) String() string { return proto.CompactTextString(m) }
 func (*PingResponse) ProtoMessage()    {}
 func (*PingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{10}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{13}
 }
 func (m *PingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingResponse.Unmarshal(m, b)
@@ -585,7 +771,7 @@ func (m *Restriction) Reset()         { *m = Restriction{} }
 func (m *Restriction) String() string { return proto.CompactTextString(m) }
 func (*Restriction) ProtoMessage()    {}
 func (*Restriction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_overlay_b711a2281a37fa0c, []int{11}
+	return fileDescriptor_overlay_f6d103b25331f648, []int{14}
 }
 func (m *Restriction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Restriction.Unmarshal(m, b)
@@ -634,8 +820,11 @@ func init() {
 	proto.RegisterType((*FindStorageNodesResponse)(nil), "overlay.FindStorageNodesResponse")
 	proto.RegisterType((*FindStorageNodesRequest)(nil), "overlay.FindStorageNodesRequest")
 	proto.RegisterType((*OverlayOptions)(nil), "overlay.OverlayOptions")
+	proto.RegisterType((*UploadStats)(nil), "overlay.UploadStats")
+	proto.RegisterType((*UploadStatsResponse)(nil), "overlay.UploadStatsResponse")
 	proto.RegisterType((*QueryRequest)(nil), "overlay.QueryRequest")
 	proto.RegisterType((*QueryResponse)(nil), "overlay.QueryResponse")
+	proto.RegisterType((*MetadataError)(nil), "overlay.MetadataError")
 	proto.RegisterType((*PingRequest)(nil), "overlay.PingRequest")
 	proto.RegisterType((*PingResponse)(nil), "overlay.PingResponse")
 	proto.RegisterType((*Restriction)(nil), "overlay.Restriction")
@@ -661,6 +850,8 @@ type OverlayClient interface {
 	BulkLookup(ctx context.Context, in *LookupRequests, opts ...grpc.CallOption) (*LookupResponses, error)
 	// FindStorageNodes finds a list of nodes in the network that meet the specified request parameters
 	FindStorageNodes(ctx context.Context, in *FindStorageNodesRequest, opts ...grpc.CallOption) (*FindStorageNodesResponse, error)
+	// ReportUploadStats receives anonymized outcome counts of piece uploads from uplinks
+	ReportUploadStats(ctx context.Context, in *UploadStats, opts ...grpc.CallOption) (*UploadStatsResponse, error)
 }
 
 type overlayClient struct {
@@ -698,6 +889,15 @@ func (c *overlayClient) FindStorageNodes(ctx context.Context, in *FindStorageNod
 	return out, nil
 }
 
+func (c *overlayClient) ReportUploadStats(ctx context.Context, in *UploadStats, opts ...grpc.CallOption) (*UploadStatsResponse, error) {
+	out := new(UploadStatsResponse)
+	err := c.cc.Invoke(ctx, "/overlay.Overlay/ReportUploadStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OverlayServer is the server API for Overlay service.
 type OverlayServer interface {
 	// Lookup finds a nodes address from the network
@@ -706,6 +906,8 @@ type OverlayServer interface {
 	BulkLookup(context.Context, *LookupRequests) (*LookupResponses, error)
 	// FindStorageNodes finds a list of nodes in the network that meet the specified request parameters
 	FindStorageNodes(context.Context, *FindStorageNodesRequest) (*FindStorageNodesResponse, error)
+	// ReportUploadStats receives anonymized outcome counts of piece uploads from uplinks
+	ReportUploadStats(context.Context, *UploadStats) (*UploadStatsResponse, error)
 }
 
 func RegisterOverlayServer(s *grpc.Server, srv OverlayServer) {
@@ -766,6 +968,24 @@ func _Overlay_FindStorageNodes_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Overlay_ReportUploadStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadStats)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OverlayServer).ReportUploadStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/overlay.Overlay/ReportUploadStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OverlayServer).ReportUploadStats(ctx, req.(*UploadStats))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Overlay_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "overlay.Overlay",
 	HandlerType: (*OverlayServer)(nil),
@@ -782,6 +1002,10 @@ var _Overlay_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FindStorageNodes",
 			Handler:    _Overlay_FindStorageNodes_Handler,
 		},
+		{
+			MethodName: "ReportUploadStats",
+			Handler:    _Overlay_ReportUploadStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "overlay.proto",
@@ -884,61 +1108,75 @@ var _Nodes_serviceDesc = grpc.ServiceDesc{
 	Metadata: "overlay.proto",
 }
 
-func init() { proto.RegisterFile("overlay.proto", fileDescriptor_overlay_b711a2281a37fa0c) }
-
-var fileDescriptor_overlay_b711a2281a37fa0c = []byte{
-	// 841 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xef, 0x8e, 0xdb, 0x44,
-	0x10, 0x3f, 0xc7, 0x89, 0x93, 0x4e, 0x12, 0x9f, 0xb5, 0x6a, 0xef, 0x82, 0x81, 0x5e, 0xb0, 0x2a,
-	0x38, 0x89, 0x2a, 0x85, 0x14, 0x55, 0xb4, 0x02, 0x01, 0x51, 0xd2, 0x72, 0x6a, 0xd4, 0x50, 0x27,
-	0x52, 0x25, 0xf8, 0x60, 0x39, 0xf1, 0x62, 0x4c, 0x1c, 0xaf, 0xf1, 0xae, 0xab, 0xbb, 0x3e, 0x01,
-	0x6f, 0xc2, 0xab, 0xf0, 0x0c, 0x7c, 0xb8, 0x47, 0xe0, 0x01, 0xf8, 0x84, 0xf6, 0x8f, 0x1d, 0xe7,
-	0xee, 0x72, 0xea, 0xa7, 0xdd, 0x99, 0xf9, 0xfd, 0x66, 0xf7, 0x37, 0x3b, 0x3b, 0xd0, 0x25, 0x6f,
-	0x71, 0x16, 0xfb, 0x17, 0x83, 0x34, 0x23, 0x8c, 0xa0, 0xa6, 0x32, 0xed, 0xfb, 0x21, 0x21, 0x61,
-	0x8c, 0x1f, 0x09, 0xf7, 0x32, 0xff, 0xf5, 0x51, 0x90, 0x67, 0x3e, 0x8b, 0x48, 0x22, 0x81, 0x36,
-	0x84, 0x24, 0x24, 0xc5, 0x3e, 0x21, 0x01, 0x96, 0x7b, 0xe7, 0x6b, 0xe8, 0x4e, 0x09, 0x59, 0xe7,
-	0xa9, 0x8b, 0xff, 0xc8, 0x31, 0x65, 0xe8, 0x33, 0x68, 0xf2, 0xb0, 0x17, 0x05, 0x3d, 0xad, 0xaf,
-	0x9d, 0x76, 0x46, 0xe6, 0xdf, 0x97, 0x27, 0x07, 0xff, 0x5c, 0x9e, 0x18, 0xaf, 0x48, 0x80, 0xcf,
-	0xc6, 0xae, 0xc1, 0xc3, 0x67, 0x81, 0xf3, 0x05, 0x98, 0x05, 0x93, 0xa6, 0x24, 0xa1, 0x18, 0xdd,
-	0x87, 0x3a, 0x8f, 0x09, 0x5e, 0x7b, 0x08, 0x03, 0x71, 0x0c, 0x67, 0xb9, 0xc2, 0xef, 0xcc, 0xb6,
-	0x0c, 0x71, 0x16, 0x45, 0xdf, 0x82, 0x19, 0x0b, 0x8f, 0x97, 0x49, 0x57, 0x4f, 0xeb, 0xeb, 0xa7,
-	0xed, 0xe1, 0xd1, 0xa0, 0x90, 0xb9, 0x43, 0x70, 0xbb, 0x71, 0xd5, 0x74, 0xe6, 0x70, 0xb8, 0x7b,
-	0x05, 0x8a, 0xbe, 0x87, 0xc3, 0x32, 0xa3, 0xf4, 0xa9, 0x94, 0xc7, 0xd7, 0x52, 0xca, 0xb0, 0x6b,
-	0xc6, 0x3b, 0xb6, 0xf3, 0x0d, 0xf4, 0x9e, 0x47, 0x49, 0x30, 0x67, 0x24, 0xf3, 0x43, 0xcc, 0xaf,
-	0x4f, 0x4b, 0x85, 0x7d, 0x68, 0x70, 0x25, 0x54, 0xe5, 0xac, 0x4a, 0x94, 0x01, 0xe7, 0x5f, 0x0d,
-	0x8e, 0xaf, 0xd3, 0x65, 0x69, 0x4f, 0xa0, 0x4d, 0x96, 0xbf, 0xe3, 0x15, 0xf3, 0x68, 0xf4, 0x4e,
-	0x96, 0x49, 0x77, 0x41, 0xba, 0xe6, 0xd1, 0x3b, 0x8c, 0x46, 0x70, 0xb8, 0x22, 0x09, 0xcb, 0xfc,
-	0x15, 0xf3, 0x62, 0x9c, 0x84, 0xec, 0xb7, 0x5e, 0x4d, 0xd4, 0xf2, 0x83, 0x81, 0x7c, 0xde, 0x41,
-	0xf1, 0xbc, 0x83, 0xb1, 0x7a, 0x5e, 0xd7, 0x2c, 0x18, 0x53, 0x41, 0x40, 0x9f, 0x43, 0x9d, 0xa4,
-	0x8c, 0xf6, 0x74, 0x41, 0xdc, 0xaa, 0x9e, 0xc9, 0x75, 0x96, 0x72, 0x16, 0x75, 0x05, 0x08, 0x3d,
-	0x80, 0x06, 0x65, 0x7e, 0xc6, 0x7a, 0xf5, 0x1b, 0x9f, 0x5a, 0x06, 0xd1, 0x87, 0x70, 0x67, 0x13,
-	0x25, 0x9e, 0x54, 0xde, 0x10, 0xb7, 0x6e, 0x6d, 0xa2, 0x44, 0x68, 0x73, 0xfe, 0xaa, 0x81, 0xb9,
-	0x9b, 0x1b, 0x3d, 0x83, 0xf6, 0xc6, 0x3f, 0xf7, 0x62, 0x9f, 0xe1, 0x64, 0x75, 0xa1, 0xda, 0xe1,
-	0x16, 0x09, 0xb0, 0xf1, 0xcf, 0xa7, 0x12, 0x8c, 0x1e, 0xca, 0xb3, 0x28, 0xf3, 0x19, 0x55, 0xe2,
-	0x0f, 0xb7, 0x55, 0x9e, 0x73, 0xb7, 0x38, 0x5c, 0xec, 0xd0, 0x03, 0x30, 0x05, 0x3a, 0xc5, 0x38,
-	0xf0, 0xd6, 0xcb, 0x54, 0xca, 0xd6, 0xdd, 0x0e, 0x47, 0x70, 0xe7, 0xcb, 0x65, 0x4a, 0xd1, 0x11,
-	0x18, 0xfe, 0x86, 0xe4, 0x89, 0x94, 0xa9, 0xbb, 0xca, 0x42, 0xcf, 0xa0, 0x93, 0x61, 0xca, 0xb2,
-	0x68, 0x25, 0xee, 0x2d, 0xa4, 0xf1, 0xde, 0xdb, 0x3e, 0x6a, 0x25, 0xea, 0xee, 0x60, 0xd1, 0x97,
-	0x60, 0xe2, 0xf3, 0x55, 0x9c, 0x07, 0x38, 0x50, 0x85, 0x31, 0xfa, 0xfa, 0x69, 0x67, 0x04, 0x95,
-	0xf2, 0x75, 0x0b, 0x84, 0xac, 0xd4, 0x9f, 0x1a, 0x74, 0x5e, 0xe7, 0x38, 0xbb, 0x28, 0xfa, 0xc1,
-	0x01, 0x83, 0xe2, 0x24, 0xc0, 0xd9, 0x0d, 0x3f, 0x46, 0x45, 0x38, 0x86, 0xf9, 0x59, 0x88, 0x99,
-	0x2a, 0xc6, 0x0e, 0x46, 0x46, 0xd0, 0x5d, 0x68, 0xc4, 0xd1, 0x26, 0x62, 0x4a, 0xbc, 0x34, 0x90,
-	0x0d, 0xad, 0x34, 0x4a, 0xc2, 0xa5, 0xbf, 0x5a, 0x0b, 0xdd, 0x2d, 0xb7, 0xb4, 0x9d, 0x5f, 0xa0,
-	0xab, 0x6e, 0xa2, 0x1a, 0xfb, 0x7d, 0xae, 0xf2, 0x29, 0xb4, 0xca, 0x3f, 0x55, 0xbb, 0xd6, 0xff,
-	0x65, 0xcc, 0xe9, 0x42, 0xfb, 0xa7, 0x28, 0x09, 0x8b, 0x4f, 0x6a, 0x42, 0x47, 0x9a, 0x2a, 0xfc,
-	0x9f, 0x06, 0xed, 0x4a, 0x61, 0xd1, 0x53, 0x68, 0x91, 0x14, 0x67, 0x3e, 0x23, 0xf2, 0x70, 0x73,
-	0xf8, 0x71, 0xd9, 0xb4, 0x15, 0xdc, 0x60, 0xa6, 0x40, 0x6e, 0x09, 0x47, 0x4f, 0xa0, 0x29, 0xf6,
-	0x49, 0x20, 0xaa, 0x63, 0x0e, 0x3f, 0xda, 0xcf, 0x4c, 0x02, 0xb7, 0x00, 0xf3, 0x82, 0xbd, 0xf5,
-	0xe3, 0x1c, 0x17, 0x05, 0x13, 0x86, 0xf3, 0x15, 0xb4, 0x8a, 0x33, 0x90, 0x01, 0xb5, 0xe9, 0xc2,
-	0x3a, 0xe0, 0xeb, 0xe4, 0xb5, 0xa5, 0xf1, 0xf5, 0xc5, 0xc2, 0xaa, 0xa1, 0x26, 0xe8, 0xd3, 0xc5,
-	0xc4, 0xd2, 0xf9, 0xe6, 0xc5, 0x62, 0x62, 0xd5, 0x9d, 0x87, 0xd0, 0x54, 0xf9, 0x11, 0x02, 0xf3,
-	0xb9, 0x3b, 0x99, 0x78, 0xa3, 0x1f, 0x5e, 0x8d, 0xdf, 0x9c, 0x8d, 0x17, 0x3f, 0x5a, 0x07, 0xa8,
-	0x0b, 0x77, 0x84, 0x6f, 0x7c, 0x36, 0x7f, 0x69, 0x69, 0xc3, 0x4b, 0x0d, 0x9a, 0xea, 0xb7, 0xa0,
-	0xa7, 0x60, 0xc8, 0x51, 0x84, 0xf6, 0x8c, 0x3b, 0x7b, 0xdf, 0xcc, 0x42, 0xdf, 0x01, 0x8c, 0xf2,
-	0x78, 0xad, 0xe8, 0xc7, 0x37, 0xd3, 0xa9, 0xdd, 0xdb, 0xc3, 0xa7, 0xe8, 0x0d, 0x58, 0x57, 0xa7,
-	0x14, 0xea, 0x97, 0xe8, 0x3d, 0x03, 0xcc, 0xfe, 0xe4, 0x16, 0x84, 0xcc, 0x3c, 0x64, 0xd0, 0x90,
-	0xd9, 0x9e, 0x40, 0x43, 0xb4, 0x18, 0xba, 0x57, 0x92, 0xaa, 0xcd, 0x6f, 0x1f, 0x5d, 0x75, 0x2b,
-	0x69, 0x8f, 0xa1, 0xce, 0xdb, 0x05, 0xdd, 0x2d, 0xe3, 0x95, 0x66, 0xb2, 0xef, 0x5d, 0xf1, 0x4a,
-	0xd2, 0xa8, 0xfe, 0x73, 0x2d, 0x5d, 0x2e, 0x0d, 0x31, 0x5a, 0x1e, 0xff, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0x7b, 0x95, 0x8a, 0xf4, 0x24, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("overlay.proto", fileDescriptor_overlay_f6d103b25331f648) }
+
+var fileDescriptor_overlay_f6d103b25331f648 = []byte{
+	// 1069 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x72, 0xdb, 0xc4,
+	0x17, 0xaf, 0xfc, 0xed, 0x63, 0x5b, 0x71, 0xf6, 0xdf, 0x24, 0xfa, 0x9b, 0xd0, 0x18, 0x4d, 0x07,
+	0x32, 0xd3, 0xe2, 0x82, 0xcb, 0x74, 0x68, 0x07, 0x26, 0x60, 0xe2, 0x86, 0x4c, 0x43, 0x43, 0x37,
+	0x81, 0xce, 0x70, 0xe3, 0x59, 0x4b, 0x1b, 0x23, 0x22, 0x6b, 0x85, 0x76, 0xd5, 0x24, 0x7d, 0x02,
+	0xee, 0x78, 0x01, 0xee, 0x79, 0x14, 0x78, 0x06, 0x2e, 0xfa, 0x08, 0x3c, 0x00, 0x57, 0xcc, 0x7e,
+	0x48, 0x91, 0xe3, 0x04, 0xb8, 0x92, 0x7e, 0xe7, 0xf7, 0x3b, 0xbb, 0xe7, 0x63, 0xf7, 0x2c, 0x74,
+	0xd8, 0x2b, 0x9a, 0x84, 0xe4, 0x62, 0x10, 0x27, 0x4c, 0x30, 0x54, 0x37, 0xb0, 0x77, 0x67, 0xc6,
+	0xd8, 0x2c, 0xa4, 0x0f, 0x94, 0x79, 0x9a, 0x9e, 0x3c, 0xf0, 0xd3, 0x84, 0x88, 0x80, 0x45, 0x5a,
+	0xb8, 0xcc, 0x9f, 0x25, 0x24, 0x8e, 0x69, 0xc2, 0x0d, 0x0f, 0x33, 0x36, 0x63, 0xd9, 0x7f, 0xc4,
+	0x7c, 0xaa, 0xff, 0xdd, 0x8f, 0xa1, 0x73, 0xc0, 0xd8, 0x69, 0x1a, 0x63, 0xfa, 0x63, 0x4a, 0xb9,
+	0x40, 0xef, 0x41, 0x5d, 0xd2, 0x93, 0xc0, 0x77, 0xac, 0xbe, 0xb5, 0xdd, 0x1e, 0xd9, 0xbf, 0xbf,
+	0xd9, 0xba, 0xf5, 0xc7, 0x9b, 0xad, 0xda, 0x73, 0xe6, 0xd3, 0xfd, 0x5d, 0x5c, 0x93, 0xf4, 0xbe,
+	0xef, 0x7e, 0x00, 0x76, 0xe6, 0xc9, 0x63, 0x16, 0x71, 0x8a, 0xee, 0x40, 0x45, 0x72, 0xca, 0xaf,
+	0x35, 0x84, 0x81, 0xda, 0x46, 0x7a, 0x61, 0x65, 0x77, 0x0f, 0xc1, 0x5e, 0xd8, 0x8b, 0xa3, 0x4f,
+	0xc1, 0x0e, 0x95, 0x65, 0x92, 0x68, 0x93, 0x63, 0xf5, 0xcb, 0xdb, 0xad, 0xe1, 0xfa, 0x20, 0x2b,
+	0xc3, 0x82, 0x03, 0xee, 0x84, 0x45, 0xe8, 0x1e, 0xc1, 0xca, 0x62, 0x08, 0x1c, 0x7d, 0x06, 0x2b,
+	0xf9, 0x8a, 0xda, 0x66, 0x96, 0xdc, 0x58, 0x5a, 0x52, 0xd3, 0xd8, 0x0e, 0x17, 0xb0, 0xfb, 0x09,
+	0x38, 0x4f, 0x83, 0xc8, 0x3f, 0x12, 0x2c, 0x21, 0x33, 0x2a, 0xc3, 0xe7, 0x79, 0x86, 0x7d, 0xa8,
+	0xca, 0x4c, 0xb8, 0x59, 0xb3, 0x98, 0xa2, 0x26, 0xdc, 0x3f, 0x2d, 0xd8, 0x58, 0x76, 0xd7, 0xa5,
+	0xdd, 0x82, 0x16, 0x9b, 0xfe, 0x40, 0x3d, 0x31, 0xe1, 0xc1, 0x6b, 0x5d, 0xa6, 0x32, 0x06, 0x6d,
+	0x3a, 0x0a, 0x5e, 0x53, 0x34, 0x82, 0x15, 0x8f, 0x45, 0x22, 0x21, 0x9e, 0x98, 0x84, 0x34, 0x9a,
+	0x89, 0xef, 0x9d, 0x92, 0xaa, 0xe5, 0xff, 0x07, 0xba, 0xbd, 0x83, 0xac, 0xbd, 0x83, 0x5d, 0xd3,
+	0x7e, 0x6c, 0x67, 0x1e, 0x07, 0xca, 0x01, 0xdd, 0x83, 0x0a, 0x8b, 0x05, 0x77, 0xca, 0x7d, 0x6b,
+	0x21, 0xeb, 0x43, 0xfd, 0x3d, 0x8c, 0xa5, 0x17, 0xc7, 0x4a, 0x84, 0xee, 0x42, 0x95, 0x0b, 0x92,
+	0x08, 0xa7, 0x72, 0x6d, 0xab, 0x35, 0x89, 0xde, 0x82, 0xe6, 0x3c, 0x88, 0x26, 0x3a, 0xf3, 0xaa,
+	0x8a, 0xba, 0x31, 0x0f, 0x22, 0x95, 0x9b, 0xfb, 0x5b, 0x19, 0xec, 0xc5, 0xb5, 0xd1, 0x13, 0x68,
+	0xcd, 0xc9, 0xf9, 0x24, 0x24, 0x82, 0x46, 0xde, 0x85, 0x63, 0xfd, 0x5b, 0x0a, 0x30, 0x27, 0xe7,
+	0x07, 0x5a, 0x8c, 0xee, 0xeb, 0xbd, 0xb8, 0x20, 0x82, 0x9b, 0xe4, 0x57, 0x2e, 0xab, 0x7c, 0x24,
+	0xcd, 0x6a, 0x73, 0xf5, 0x87, 0xee, 0x82, 0xad, 0xd4, 0x31, 0xa5, 0xfe, 0xe4, 0x74, 0x1a, 0xeb,
+	0xb4, 0xcb, 0xb8, 0x2d, 0x15, 0xd2, 0xf8, 0x6c, 0x1a, 0x73, 0xb4, 0x0e, 0x35, 0x32, 0x67, 0x69,
+	0xa4, 0xd3, 0x2c, 0x63, 0x83, 0xd0, 0x13, 0x68, 0x27, 0x94, 0x8b, 0x24, 0xf0, 0x54, 0xdc, 0x2a,
+	0x35, 0x79, 0xf6, 0x2e, 0x9b, 0x5a, 0x60, 0xf1, 0x82, 0x16, 0x7d, 0x08, 0x36, 0x3d, 0xf7, 0xc2,
+	0xd4, 0xa7, 0xbe, 0x29, 0x4c, 0xad, 0x5f, 0xde, 0x6e, 0x8f, 0xa0, 0x50, 0xbe, 0x4e, 0xa6, 0x90,
+	0x98, 0xa3, 0x7b, 0xb0, 0x4a, 0xc2, 0x90, 0x9d, 0x51, 0x7f, 0xe2, 0xc9, 0xfd, 0x93, 0x80, 0x72,
+	0xa7, 0xde, 0x2f, 0x6f, 0x37, 0x71, 0xd7, 0x10, 0x5f, 0x64, 0x76, 0xf4, 0x3e, 0xa0, 0x7c, 0xfd,
+	0x4b, 0x75, 0x43, 0xa9, 0x57, 0x33, 0xe6, 0x52, 0x3e, 0x02, 0x3b, 0xa2, 0x67, 0x2a, 0x92, 0x89,
+	0xaa, 0xaa, 0xd3, 0x54, 0xc9, 0x6c, 0x2e, 0x57, 0x9d, 0xa5, 0xd3, 0x90, 0x7e, 0x4b, 0xc2, 0x94,
+	0xe2, 0x76, 0x44, 0xcf, 0x54, 0x9e, 0xd2, 0xc3, 0xfd, 0xd9, 0x82, 0xd6, 0x37, 0x71, 0xc8, 0x88,
+	0xaf, 0x8b, 0xeb, 0x40, 0x9d, 0xa7, 0x9e, 0x47, 0x39, 0x37, 0x47, 0x35, 0x83, 0x92, 0x11, 0xc1,
+	0x9c, 0xb2, 0x54, 0xa8, 0x16, 0x95, 0x71, 0x06, 0x25, 0x93, 0xd0, 0x93, 0x94, 0x53, 0xdf, 0x74,
+	0x22, 0x83, 0xb2, 0x09, 0x27, 0x24, 0x08, 0xa9, 0x9f, 0x35, 0x41, 0x23, 0xd4, 0x83, 0x86, 0x47,
+	0x22, 0x8f, 0x4a, 0xc6, 0x9c, 0xad, 0x0c, 0xbb, 0x6b, 0xf0, 0xbf, 0x42, 0x40, 0xf9, 0x0d, 0xfd,
+	0xc9, 0x82, 0xf6, 0x8b, 0x94, 0x26, 0x17, 0xd9, 0xc5, 0x72, 0xa1, 0xc6, 0x69, 0xe4, 0xd3, 0xe4,
+	0x9a, 0xd1, 0x63, 0x18, 0xa9, 0x11, 0x24, 0x99, 0x51, 0xe1, 0x94, 0x96, 0x35, 0x9a, 0x41, 0xb7,
+	0xa1, 0x1a, 0x06, 0xf3, 0x40, 0x98, 0xd8, 0x35, 0x90, 0x11, 0xc6, 0x41, 0x34, 0x9b, 0x12, 0xef,
+	0x54, 0xc5, 0xde, 0xc0, 0x39, 0x76, 0x7f, 0xb1, 0xa0, 0x63, 0x42, 0x31, 0x23, 0xe2, 0xbf, 0xc4,
+	0xf2, 0x2e, 0x34, 0xf2, 0xe9, 0x54, 0x5a, 0x9a, 0x24, 0x39, 0x87, 0x76, 0x60, 0x65, 0x4e, 0x05,
+	0xf1, 0x89, 0x20, 0x13, 0x9a, 0x24, 0x2c, 0x91, 0xe7, 0x7b, 0x71, 0x3e, 0x7e, 0x65, 0xf8, 0xb1,
+	0xa4, 0xb1, 0x3d, 0x2f, 0x42, 0xee, 0xee, 0x40, 0x67, 0x41, 0x20, 0x33, 0x3c, 0x09, 0x68, 0xa8,
+	0x67, 0x7b, 0x13, 0x6b, 0x20, 0xbb, 0x36, 0xa7, 0x9c, 0x93, 0x19, 0x55, 0xc5, 0x69, 0xe2, 0x0c,
+	0xba, 0x1d, 0x68, 0x7d, 0x1d, 0x44, 0xb3, 0x6c, 0xe0, 0xda, 0xd0, 0xd6, 0xd0, 0x74, 0xe2, 0x2f,
+	0x0b, 0x5a, 0x85, 0x4b, 0x82, 0x1e, 0x43, 0x83, 0xc5, 0x34, 0x21, 0x82, 0xe9, 0xf4, 0xed, 0xe1,
+	0xdb, 0x79, 0xa4, 0x05, 0xdd, 0xe0, 0xd0, 0x88, 0x70, 0x2e, 0x47, 0x8f, 0xa0, 0xae, 0xfe, 0x23,
+	0x5f, 0xc5, 0x60, 0x0f, 0x37, 0x6f, 0xf6, 0x8c, 0x7c, 0x9c, 0x89, 0x65, 0x46, 0xaf, 0xe4, 0x61,
+	0xce, 0x7a, 0xa6, 0x80, 0xfb, 0x11, 0x34, 0xb2, 0x3d, 0x50, 0x0d, 0x4a, 0x07, 0xc7, 0xdd, 0x5b,
+	0xf2, 0x3b, 0x7e, 0xd1, 0xb5, 0xe4, 0x77, 0xef, 0xb8, 0x5b, 0x42, 0x75, 0x28, 0x1f, 0x1c, 0x8f,
+	0xbb, 0x65, 0xf9, 0xb3, 0x77, 0x3c, 0xee, 0x56, 0xdc, 0xfb, 0x50, 0x37, 0xeb, 0x23, 0x04, 0xf6,
+	0x53, 0x3c, 0x1e, 0x4f, 0x46, 0x9f, 0x3f, 0xdf, 0x7d, 0xb9, 0xbf, 0x7b, 0xfc, 0x65, 0xf7, 0x16,
+	0xea, 0x40, 0x53, 0xd9, 0x76, 0xf7, 0x8f, 0x9e, 0x75, 0xad, 0xe1, 0xaf, 0x25, 0xa8, 0x9b, 0xc9,
+	0x87, 0x1e, 0x43, 0x4d, 0x3f, 0x2b, 0xe8, 0x86, 0xa7, 0xab, 0x77, 0xd3, 0xfb, 0x83, 0x76, 0x00,
+	0x46, 0x69, 0x78, 0x6a, 0xdc, 0x37, 0xae, 0x77, 0xe7, 0x3d, 0xe7, 0x06, 0x7f, 0x8e, 0x5e, 0x42,
+	0xf7, 0xea, 0x8b, 0x83, 0xfa, 0xb9, 0xfa, 0x86, 0xc7, 0xa8, 0xf7, 0xce, 0x3f, 0x28, 0x4c, 0x64,
+	0x7b, 0xb0, 0x8a, 0x69, 0xcc, 0x12, 0x51, 0x9c, 0x0a, 0xb7, 0x73, 0xbf, 0x82, 0xb5, 0xb7, 0x79,
+	0x9d, 0x35, 0x5b, 0x68, 0x28, 0xa0, 0xaa, 0xc3, 0x7a, 0x04, 0x55, 0x75, 0x5b, 0xd0, 0x5a, 0xae,
+	0x2f, 0x5e, 0xe4, 0xde, 0xfa, 0x55, 0xb3, 0x89, 0xe4, 0x21, 0x54, 0xe4, 0xb9, 0x2b, 0x6c, 0x5e,
+	0x38, 0x95, 0xbd, 0xb5, 0x2b, 0x56, 0xed, 0x34, 0xaa, 0x7c, 0x57, 0x8a, 0xa7, 0xd3, 0x9a, 0x9a,
+	0x7c, 0x0f, 0xff, 0x1e, 0x00, 0x5f, 0x27, 0x80, 0x49, 0x59, 0x09, 0x00, 0x00,
 }
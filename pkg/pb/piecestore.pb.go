@@ -8,6 +8,7 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 import duration "github.com/golang/protobuf/ptypes/duration"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 
 import (
 	context "golang.org/x/net/context"
@@ -54,7 +55,35 @@ func (x BandwidthAction) String() string {
 	return proto.EnumName(BandwidthAction_name, int32(x))
 }
 func (BandwidthAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{0}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{0}
+}
+
+// Priority hints how urgently the client needs the data, storage nodes
+// may use it to schedule downloads
+type PieceRetrieval_Priority int32
+
+const (
+	PieceRetrieval_NORMAL      PieceRetrieval_Priority = 0
+	PieceRetrieval_INTERACTIVE PieceRetrieval_Priority = 1
+	PieceRetrieval_BATCH       PieceRetrieval_Priority = 2
+)
+
+var PieceRetrieval_Priority_name = map[int32]string{
+	0: "NORMAL",
+	1: "INTERACTIVE",
+	2: "BATCH",
+}
+var PieceRetrieval_Priority_value = map[string]int32{
+	"NORMAL":      0,
+	"INTERACTIVE": 1,
+	"BATCH":       2,
+}
+
+func (x PieceRetrieval_Priority) String() string {
+	return proto.EnumName(PieceRetrieval_Priority_name, int32(x))
+}
+func (PieceRetrieval_Priority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{5, 0}
 }
 
 type PayerBandwidthAllocation struct {
@@ -76,7 +105,7 @@ func (m *PayerBandwidthAllocation) Reset()         { *m = PayerBandwidthAllocati
 func (m *PayerBandwidthAllocation) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocation) ProtoMessage()    {}
 func (*PayerBandwidthAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{0}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{0}
 }
 func (m *PayerBandwidthAllocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocation.Unmarshal(m, b)
@@ -160,7 +189,7 @@ func (m *RenterBandwidthAllocation) Reset()         { *m = RenterBandwidthAlloca
 func (m *RenterBandwidthAllocation) String() string { return proto.CompactTextString(m) }
 func (*RenterBandwidthAllocation) ProtoMessage()    {}
 func (*RenterBandwidthAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{1}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{1}
 }
 func (m *RenterBandwidthAllocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenterBandwidthAllocation.Unmarshal(m, b)
@@ -221,7 +250,7 @@ func (m *PieceStore) Reset()         { *m = PieceStore{} }
 func (m *PieceStore) String() string { return proto.CompactTextString(m) }
 func (*PieceStore) ProtoMessage()    {}
 func (*PieceStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{2}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{2}
 }
 func (m *PieceStore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStore.Unmarshal(m, b)
@@ -264,9 +293,13 @@ func (m *PieceStore) GetAuthorization() *SignedMessage {
 
 type PieceStore_PieceData struct {
 	// TODO: may want to use customtype and fixed-length byte slice
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ExpirationUnixSec    int64    `protobuf:"varint,2,opt,name=expiration_unix_sec,json=expirationUnixSec,proto3" json:"expiration_unix_sec,omitempty"`
-	Content              []byte   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpirationUnixSec int64  `protobuf:"varint,2,opt,name=expiration_unix_sec,json=expirationUnixSec,proto3" json:"expiration_unix_sec,omitempty"`
+	Content           []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// hash is the SHA-256 of the whole piece, when it's sent with the id and
+	// the storage node already holds the piece with the same hash, the node
+	// closes the stream without receiving the data again
+	Hash                 []byte   `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -276,7 +309,7 @@ func (m *PieceStore_PieceData) Reset()         { *m = PieceStore_PieceData{} }
 func (m *PieceStore_PieceData) String() string { return proto.CompactTextString(m) }
 func (*PieceStore_PieceData) ProtoMessage()    {}
 func (*PieceStore_PieceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{2, 0}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{2, 0}
 }
 func (m *PieceStore_PieceData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStore_PieceData.Unmarshal(m, b)
@@ -317,6 +350,13 @@ func (m *PieceStore_PieceData) GetContent() []byte {
 	return nil
 }
 
+func (m *PieceStore_PieceData) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type PieceId struct {
 	// TODO: may want to use customtype and fixed-length byte slice
 	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -330,7 +370,7 @@ func (m *PieceId) Reset()         { *m = PieceId{} }
 func (m *PieceId) String() string { return proto.CompactTextString(m) }
 func (*PieceId) ProtoMessage()    {}
 func (*PieceId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{3}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{3}
 }
 func (m *PieceId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceId.Unmarshal(m, b)
@@ -365,9 +405,11 @@ func (m *PieceId) GetAuthorization() *SignedMessage {
 }
 
 type PieceSummary struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PieceSize            int64    `protobuf:"varint,2,opt,name=piece_size,json=pieceSize,proto3" json:"piece_size,omitempty"`
-	ExpirationUnixSec    int64    `protobuf:"varint,3,opt,name=expiration_unix_sec,json=expirationUnixSec,proto3" json:"expiration_unix_sec,omitempty"`
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PieceSize         int64  `protobuf:"varint,2,opt,name=piece_size,json=pieceSize,proto3" json:"piece_size,omitempty"`
+	ExpirationUnixSec int64  `protobuf:"varint,3,opt,name=expiration_unix_sec,json=expirationUnixSec,proto3" json:"expiration_unix_sec,omitempty"`
+	// hash is the SHA-256 of the piece, it's empty when the storage node didn't record it
+	Hash                 []byte   `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -377,7 +419,7 @@ func (m *PieceSummary) Reset()         { *m = PieceSummary{} }
 func (m *PieceSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceSummary) ProtoMessage()    {}
 func (*PieceSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{4}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{4}
 }
 func (m *PieceSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceSummary.Unmarshal(m, b)
@@ -418,6 +460,13 @@ func (m *PieceSummary) GetExpirationUnixSec() int64 {
 	return 0
 }
 
+func (m *PieceSummary) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type PieceRetrieval struct {
 	BandwidthAllocation  *RenterBandwidthAllocation `protobuf:"bytes,1,opt,name=bandwidth_allocation,json=bandwidthAllocation,proto3" json:"bandwidth_allocation,omitempty"`
 	PieceData            *PieceRetrieval_PieceData  `protobuf:"bytes,2,opt,name=piece_data,json=pieceData,proto3" json:"piece_data,omitempty"`
@@ -431,7 +480,7 @@ func (m *PieceRetrieval) Reset()         { *m = PieceRetrieval{} }
 func (m *PieceRetrieval) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrieval) ProtoMessage()    {}
 func (*PieceRetrieval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{5}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{5}
 }
 func (m *PieceRetrieval) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrieval.Unmarshal(m, b)
@@ -474,9 +523,17 @@ func (m *PieceRetrieval) GetAuthorization() *SignedMessage {
 
 type PieceRetrieval_PieceData struct {
 	// TODO: may want to use customtype and fixed-length byte slice
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PieceSize            int64    `protobuf:"varint,2,opt,name=piece_size,json=pieceSize,proto3" json:"piece_size,omitempty"`
-	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Id        string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PieceSize int64                   `protobuf:"varint,2,opt,name=piece_size,json=pieceSize,proto3" json:"piece_size,omitempty"`
+	Offset    int64                   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Priority  PieceRetrieval_Priority `protobuf:"varint,4,opt,name=priority,proto3,enum=piecestoreroutes.PieceRetrieval_Priority" json:"priority,omitempty"`
+	// deadline is the unix time in nanoseconds by which the client needs
+	// the data, 0 means no deadline
+	Deadline int64 `protobuf:"varint,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// pipelined keeps the stream open after the range is sent, the end of the
+	// range is marked and the client may request another range of the same
+	// piece, the bandwidth allocations continue over all ranges
+	Pipelined            bool     `protobuf:"varint,6,opt,name=pipelined,proto3" json:"pipelined,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -486,7 +543,7 @@ func (m *PieceRetrieval_PieceData) Reset()         { *m = PieceRetrieval_PieceDa
 func (m *PieceRetrieval_PieceData) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrieval_PieceData) ProtoMessage()    {}
 func (*PieceRetrieval_PieceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{5, 0}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{5, 0}
 }
 func (m *PieceRetrieval_PieceData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrieval_PieceData.Unmarshal(m, b)
@@ -527,9 +584,35 @@ func (m *PieceRetrieval_PieceData) GetOffset() int64 {
 	return 0
 }
 
+func (m *PieceRetrieval_PieceData) GetPriority() PieceRetrieval_Priority {
+	if m != nil {
+		return m.Priority
+	}
+	return PieceRetrieval_NORMAL
+}
+
+func (m *PieceRetrieval_PieceData) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *PieceRetrieval_PieceData) GetPipelined() bool {
+	if m != nil {
+		return m.Pipelined
+	}
+	return false
+}
+
 type PieceRetrievalStream struct {
-	PieceSize            int64    `protobuf:"varint,1,opt,name=piece_size,json=pieceSize,proto3" json:"piece_size,omitempty"`
-	Content              []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	PieceSize int64  `protobuf:"varint,1,opt,name=piece_size,json=pieceSize,proto3" json:"piece_size,omitempty"`
+	Content   []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// end_of_range marks the end of a pipelined range
+	EndOfRange bool `protobuf:"varint,3,opt,name=end_of_range,json=endOfRange,proto3" json:"end_of_range,omitempty"`
+	// hash is the SHA-256 of the piece, it's sent with the first message of a
+	// range covering the whole piece, when the storage node recorded it
+	Hash                 []byte   `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -539,7 +622,7 @@ func (m *PieceRetrievalStream) Reset()         { *m = PieceRetrievalStream{} }
 func (m *PieceRetrievalStream) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrievalStream) ProtoMessage()    {}
 func (*PieceRetrievalStream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{6}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{6}
 }
 func (m *PieceRetrievalStream) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrievalStream.Unmarshal(m, b)
@@ -573,6 +656,20 @@ func (m *PieceRetrievalStream) GetContent() []byte {
 	return nil
 }
 
+func (m *PieceRetrievalStream) GetEndOfRange() bool {
+	if m != nil {
+		return m.EndOfRange
+	}
+	return false
+}
+
+func (m *PieceRetrievalStream) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type PieceDelete struct {
 	// TODO: may want to use customtype and fixed-length byte slice
 	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -586,7 +683,7 @@ func (m *PieceDelete) Reset()         { *m = PieceDelete{} }
 func (m *PieceDelete) String() string { return proto.CompactTextString(m) }
 func (*PieceDelete) ProtoMessage()    {}
 func (*PieceDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{7}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{7}
 }
 func (m *PieceDelete) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceDelete.Unmarshal(m, b)
@@ -631,7 +728,7 @@ func (m *PieceDeleteSummary) Reset()         { *m = PieceDeleteSummary{} }
 func (m *PieceDeleteSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceDeleteSummary) ProtoMessage()    {}
 func (*PieceDeleteSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{8}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{8}
 }
 func (m *PieceDeleteSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceDeleteSummary.Unmarshal(m, b)
@@ -659,18 +756,22 @@ func (m *PieceDeleteSummary) GetMessage() string {
 }
 
 type PieceStoreSummary struct {
-	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	TotalReceived        int64    `protobuf:"varint,2,opt,name=total_received,json=totalReceived,proto3" json:"total_received,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	TotalReceived int64  `protobuf:"varint,2,opt,name=total_received,json=totalReceived,proto3" json:"total_received,omitempty"`
+	// duplicate is set when the storage node already held the piece, so
+	// nothing was received
+	Duplicate            bool          `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	Receipt              *StoreReceipt `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *PieceStoreSummary) Reset()         { *m = PieceStoreSummary{} }
 func (m *PieceStoreSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceStoreSummary) ProtoMessage()    {}
 func (*PieceStoreSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{9}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{9}
 }
 func (m *PieceStoreSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStoreSummary.Unmarshal(m, b)
@@ -704,6 +805,142 @@ func (m *PieceStoreSummary) GetTotalReceived() int64 {
 	return 0
 }
 
+func (m *PieceStoreSummary) GetDuplicate() bool {
+	if m != nil {
+		return m.Duplicate
+	}
+	return false
+}
+
+func (m *PieceStoreSummary) GetReceipt() *StoreReceipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+// StoreReceipt is signed by the storage node for every stored piece
+type StoreReceipt struct {
+	PieceId              string   `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3" json:"piece_id,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Size_                int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	SignedUnixSec        int64    `protobuf:"varint,4,opt,name=signed_unix_sec,json=signedUnixSec,proto3" json:"signed_unix_sec,omitempty"`
+	Signature            []byte   `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StoreReceipt) Reset()         { *m = StoreReceipt{} }
+func (m *StoreReceipt) String() string { return proto.CompactTextString(m) }
+func (*StoreReceipt) ProtoMessage()    {}
+func (*StoreReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{10}
+}
+func (m *StoreReceipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreReceipt.Unmarshal(m, b)
+}
+func (m *StoreReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreReceipt.Marshal(b, m, deterministic)
+}
+func (dst *StoreReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreReceipt.Merge(dst, src)
+}
+func (m *StoreReceipt) XXX_Size() int {
+	return xxx_messageInfo_StoreReceipt.Size(m)
+}
+func (m *StoreReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreReceipt proto.InternalMessageInfo
+
+func (m *StoreReceipt) GetPieceId() string {
+	if m != nil {
+		return m.PieceId
+	}
+	return ""
+}
+
+func (m *StoreReceipt) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *StoreReceipt) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *StoreReceipt) GetSignedUnixSec() int64 {
+	if m != nil {
+		return m.SignedUnixSec
+	}
+	return 0
+}
+
+func (m *StoreReceipt) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// PieceStoreAck acknowledges the data of a resumable upload, which the
+// storage node received and wrote to disk
+type PieceStoreAck struct {
+	// offset is the size of the piece received so far, the first ack of an
+	// upload is the offset the client continues from
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// summary is set in the last ack, after the piece was stored
+	Summary              *PieceStoreSummary `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PieceStoreAck) Reset()         { *m = PieceStoreAck{} }
+func (m *PieceStoreAck) String() string { return proto.CompactTextString(m) }
+func (*PieceStoreAck) ProtoMessage()    {}
+func (*PieceStoreAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{11}
+}
+func (m *PieceStoreAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceStoreAck.Unmarshal(m, b)
+}
+func (m *PieceStoreAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceStoreAck.Marshal(b, m, deterministic)
+}
+func (dst *PieceStoreAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceStoreAck.Merge(dst, src)
+}
+func (m *PieceStoreAck) XXX_Size() int {
+	return xxx_messageInfo_PieceStoreAck.Size(m)
+}
+func (m *PieceStoreAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceStoreAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceStoreAck proto.InternalMessageInfo
+
+func (m *PieceStoreAck) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *PieceStoreAck) GetSummary() *PieceStoreSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
 type StatsReq struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -714,7 +951,7 @@ func (m *StatsReq) Reset()         { *m = StatsReq{} }
 func (m *StatsReq) String() string { return proto.CompactTextString(m) }
 func (*StatsReq) ProtoMessage()    {}
 func (*StatsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{10}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{12}
 }
 func (m *StatsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsReq.Unmarshal(m, b)
@@ -748,7 +985,7 @@ func (m *StatSummary) Reset()         { *m = StatSummary{} }
 func (m *StatSummary) String() string { return proto.CompactTextString(m) }
 func (*StatSummary) ProtoMessage()    {}
 func (*StatSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{11}
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{13}
 }
 func (m *StatSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummary.Unmarshal(m, b)
@@ -796,186 +1033,860 @@ func (m *StatSummary) GetAvailableBandwidth() int64 {
 	return 0
 }
 
-type SignedMessage struct {
-	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	PublicKey            []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+type ThroughputReq struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignedMessage) Reset()         { *m = SignedMessage{} }
-func (m *SignedMessage) String() string { return proto.CompactTextString(m) }
-func (*SignedMessage) ProtoMessage()    {}
-func (*SignedMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{12}
+func (m *ThroughputReq) Reset()         { *m = ThroughputReq{} }
+func (m *ThroughputReq) String() string { return proto.CompactTextString(m) }
+func (*ThroughputReq) ProtoMessage()    {}
+func (*ThroughputReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{14}
 }
-func (m *SignedMessage) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SignedMessage.Unmarshal(m, b)
+func (m *ThroughputReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThroughputReq.Unmarshal(m, b)
 }
-func (m *SignedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SignedMessage.Marshal(b, m, deterministic)
+func (m *ThroughputReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThroughputReq.Marshal(b, m, deterministic)
 }
-func (dst *SignedMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignedMessage.Merge(dst, src)
+func (dst *ThroughputReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThroughputReq.Merge(dst, src)
 }
-func (m *SignedMessage) XXX_Size() int {
-	return xxx_messageInfo_SignedMessage.Size(m)
+func (m *ThroughputReq) XXX_Size() int {
+	return xxx_messageInfo_ThroughputReq.Size(m)
 }
-func (m *SignedMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignedMessage.DiscardUnknown(m)
+func (m *ThroughputReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThroughputReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignedMessage proto.InternalMessageInfo
+var xxx_messageInfo_ThroughputReq proto.InternalMessageInfo
 
-func (m *SignedMessage) GetData() []byte {
+// ThroughputSummary is the self-reported traffic of a storage node over a recent window
+type ThroughputSummary struct {
+	WindowSeconds        int64    `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	IngressBytes         int64    `protobuf:"varint,2,opt,name=ingress_bytes,json=ingressBytes,proto3" json:"ingress_bytes,omitempty"`
+	EgressBytes          int64    `protobuf:"varint,3,opt,name=egress_bytes,json=egressBytes,proto3" json:"egress_bytes,omitempty"`
+	Uploads              int64    `protobuf:"varint,4,opt,name=uploads,proto3" json:"uploads,omitempty"`
+	FailedUploads        int64    `protobuf:"varint,5,opt,name=failed_uploads,json=failedUploads,proto3" json:"failed_uploads,omitempty"`
+	Downloads            int64    `protobuf:"varint,6,opt,name=downloads,proto3" json:"downloads,omitempty"`
+	FailedDownloads      int64    `protobuf:"varint,7,opt,name=failed_downloads,json=failedDownloads,proto3" json:"failed_downloads,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThroughputSummary) Reset()         { *m = ThroughputSummary{} }
+func (m *ThroughputSummary) String() string { return proto.CompactTextString(m) }
+func (*ThroughputSummary) ProtoMessage()    {}
+func (*ThroughputSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{15}
+}
+func (m *ThroughputSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThroughputSummary.Unmarshal(m, b)
+}
+func (m *ThroughputSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThroughputSummary.Marshal(b, m, deterministic)
+}
+func (dst *ThroughputSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThroughputSummary.Merge(dst, src)
+}
+func (m *ThroughputSummary) XXX_Size() int {
+	return xxx_messageInfo_ThroughputSummary.Size(m)
+}
+func (m *ThroughputSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThroughputSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThroughputSummary proto.InternalMessageInfo
+
+func (m *ThroughputSummary) GetWindowSeconds() int64 {
 	if m != nil {
-		return m.Data
+		return m.WindowSeconds
 	}
-	return nil
+	return 0
 }
 
-func (m *SignedMessage) GetSignature() []byte {
+func (m *ThroughputSummary) GetIngressBytes() int64 {
 	if m != nil {
-		return m.Signature
+		return m.IngressBytes
 	}
-	return nil
+	return 0
 }
 
-func (m *SignedMessage) GetPublicKey() []byte {
+func (m *ThroughputSummary) GetEgressBytes() int64 {
 	if m != nil {
-		return m.PublicKey
+		return m.EgressBytes
 	}
-	return nil
+	return 0
 }
 
-type DashboardReq struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ThroughputSummary) GetUploads() int64 {
+	if m != nil {
+		return m.Uploads
+	}
+	return 0
 }
 
-func (m *DashboardReq) Reset()         { *m = DashboardReq{} }
-func (m *DashboardReq) String() string { return proto.CompactTextString(m) }
-func (*DashboardReq) ProtoMessage()    {}
-func (*DashboardReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{13}
-}
-func (m *DashboardReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardReq.Unmarshal(m, b)
-}
-func (m *DashboardReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardReq.Marshal(b, m, deterministic)
-}
-func (dst *DashboardReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardReq.Merge(dst, src)
-}
-func (m *DashboardReq) XXX_Size() int {
-	return xxx_messageInfo_DashboardReq.Size(m)
+func (m *ThroughputSummary) GetFailedUploads() int64 {
+	if m != nil {
+		return m.FailedUploads
+	}
+	return 0
 }
-func (m *DashboardReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardReq.DiscardUnknown(m)
+
+func (m *ThroughputSummary) GetDownloads() int64 {
+	if m != nil {
+		return m.Downloads
+	}
+	return 0
 }
 
-var xxx_messageInfo_DashboardReq proto.InternalMessageInfo
+func (m *ThroughputSummary) GetFailedDownloads() int64 {
+	if m != nil {
+		return m.FailedDownloads
+	}
+	return 0
+}
 
-type DashboardStats struct {
-	NodeId               string             `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	NodeConnections      int64              `protobuf:"varint,2,opt,name=node_connections,json=nodeConnections,proto3" json:"node_connections,omitempty"`
-	BootstrapAddress     string             `protobuf:"bytes,3,opt,name=bootstrap_address,json=bootstrapAddress,proto3" json:"bootstrap_address,omitempty"`
-	InternalAddress      string             `protobuf:"bytes,4,opt,name=internal_address,json=internalAddress,proto3" json:"internal_address,omitempty"`
-	ExternalAddress      string             `protobuf:"bytes,5,opt,name=external_address,json=externalAddress,proto3" json:"external_address,omitempty"`
-	Stats                *StatSummary       `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
-	Connection           bool               `protobuf:"varint,7,opt,name=connection,proto3" json:"connection,omitempty"`
-	Uptime               *duration.Duration `protobuf:"bytes,8,opt,name=uptime,proto3" json:"uptime,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+// NodeTally is the summary a satellite recorded for a storage node over a period
+type NodeTally struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	NodeId               NodeID   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	PeriodStartUnixSec   int64    `protobuf:"varint,3,opt,name=period_start_unix_sec,json=periodStartUnixSec,proto3" json:"period_start_unix_sec,omitempty"`
+	PeriodEndUnixSec     int64    `protobuf:"varint,4,opt,name=period_end_unix_sec,json=periodEndUnixSec,proto3" json:"period_end_unix_sec,omitempty"`
+	AtRestTotal          float64  `protobuf:"fixed64,5,opt,name=at_rest_total,json=atRestTotal,proto3" json:"at_rest_total,omitempty"`
+	PutTotal             int64    `protobuf:"varint,6,opt,name=put_total,json=putTotal,proto3" json:"put_total,omitempty"`
+	GetTotal             int64    `protobuf:"varint,7,opt,name=get_total,json=getTotal,proto3" json:"get_total,omitempty"`
+	GetAuditTotal        int64    `protobuf:"varint,8,opt,name=get_audit_total,json=getAuditTotal,proto3" json:"get_audit_total,omitempty"`
+	GetRepairTotal       int64    `protobuf:"varint,9,opt,name=get_repair_total,json=getRepairTotal,proto3" json:"get_repair_total,omitempty"`
+	PutRepairTotal       int64    `protobuf:"varint,10,opt,name=put_repair_total,json=putRepairTotal,proto3" json:"put_repair_total,omitempty"`
+	Certs                [][]byte `protobuf:"bytes,11,rep,name=certs,proto3" json:"certs,omitempty"`
+	Signature            []byte   `protobuf:"bytes,12,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DashboardStats) Reset()         { *m = DashboardStats{} }
-func (m *DashboardStats) String() string { return proto.CompactTextString(m) }
-func (*DashboardStats) ProtoMessage()    {}
-func (*DashboardStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_ef88e5b6fd61ab5b, []int{14}
+func (m *NodeTally) Reset()         { *m = NodeTally{} }
+func (m *NodeTally) String() string { return proto.CompactTextString(m) }
+func (*NodeTally) ProtoMessage()    {}
+func (*NodeTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{16}
 }
-func (m *DashboardStats) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DashboardStats.Unmarshal(m, b)
+func (m *NodeTally) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeTally.Unmarshal(m, b)
 }
-func (m *DashboardStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DashboardStats.Marshal(b, m, deterministic)
+func (m *NodeTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeTally.Marshal(b, m, deterministic)
 }
-func (dst *DashboardStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DashboardStats.Merge(dst, src)
+func (dst *NodeTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTally.Merge(dst, src)
 }
-func (m *DashboardStats) XXX_Size() int {
-	return xxx_messageInfo_DashboardStats.Size(m)
+func (m *NodeTally) XXX_Size() int {
+	return xxx_messageInfo_NodeTally.Size(m)
 }
-func (m *DashboardStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_DashboardStats.DiscardUnknown(m)
+func (m *NodeTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTally.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DashboardStats proto.InternalMessageInfo
+var xxx_messageInfo_NodeTally proto.InternalMessageInfo
 
-func (m *DashboardStats) GetNodeId() string {
+func (m *NodeTally) GetPeriodStartUnixSec() int64 {
 	if m != nil {
-		return m.NodeId
+		return m.PeriodStartUnixSec
 	}
-	return ""
+	return 0
 }
 
-func (m *DashboardStats) GetNodeConnections() int64 {
+func (m *NodeTally) GetPeriodEndUnixSec() int64 {
 	if m != nil {
-		return m.NodeConnections
+		return m.PeriodEndUnixSec
 	}
 	return 0
 }
 
-func (m *DashboardStats) GetBootstrapAddress() string {
+func (m *NodeTally) GetAtRestTotal() float64 {
 	if m != nil {
-		return m.BootstrapAddress
+		return m.AtRestTotal
 	}
-	return ""
+	return 0
 }
 
-func (m *DashboardStats) GetInternalAddress() string {
+func (m *NodeTally) GetPutTotal() int64 {
 	if m != nil {
-		return m.InternalAddress
+		return m.PutTotal
 	}
-	return ""
+	return 0
 }
 
-func (m *DashboardStats) GetExternalAddress() string {
+func (m *NodeTally) GetGetTotal() int64 {
 	if m != nil {
-		return m.ExternalAddress
+		return m.GetTotal
 	}
-	return ""
+	return 0
 }
 
-func (m *DashboardStats) GetStats() *StatSummary {
+func (m *NodeTally) GetGetAuditTotal() int64 {
 	if m != nil {
-		return m.Stats
+		return m.GetAuditTotal
 	}
-	return nil
+	return 0
 }
 
-func (m *DashboardStats) GetConnection() bool {
+func (m *NodeTally) GetGetRepairTotal() int64 {
 	if m != nil {
-		return m.Connection
+		return m.GetRepairTotal
 	}
-	return false
+	return 0
 }
 
-func (m *DashboardStats) GetUptime() *duration.Duration {
+func (m *NodeTally) GetPutRepairTotal() int64 {
 	if m != nil {
-		return m.Uptime
+		return m.PutRepairTotal
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*PayerBandwidthAllocation)(nil), "piecestoreroutes.PayerBandwidthAllocation")
+func (m *NodeTally) GetCerts() [][]byte {
+	if m != nil {
+		return m.Certs
+	}
+	return nil
+}
+
+func (m *NodeTally) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type NodeTallyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeTallyResponse) Reset()         { *m = NodeTallyResponse{} }
+func (m *NodeTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeTallyResponse) ProtoMessage()    {}
+func (*NodeTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{17}
+}
+func (m *NodeTallyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeTallyResponse.Unmarshal(m, b)
+}
+func (m *NodeTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeTallyResponse.Marshal(b, m, deterministic)
+}
+func (dst *NodeTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeTallyResponse.Merge(dst, src)
+}
+func (m *NodeTallyResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeTallyResponse.Size(m)
+}
+func (m *NodeTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeTallyResponse proto.InternalMessageInfo
+
+// RetainRequest is a bloom filter of the pieces a satellite keeps on a node
+type RetainRequest struct {
+	// creation_unix_sec is the cutoff, only pieces stored before it are
+	// collected, as newer pieces may be missing from the filter
+	CreationUnixSec      int64    `protobuf:"varint,1,opt,name=creation_unix_sec,json=creationUnixSec,proto3" json:"creation_unix_sec,omitempty"`
+	Filter               []byte   `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainRequest) Reset()         { *m = RetainRequest{} }
+func (m *RetainRequest) String() string { return proto.CompactTextString(m) }
+func (*RetainRequest) ProtoMessage()    {}
+func (*RetainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{18}
+}
+func (m *RetainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainRequest.Unmarshal(m, b)
+}
+func (m *RetainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainRequest.Marshal(b, m, deterministic)
+}
+func (dst *RetainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainRequest.Merge(dst, src)
+}
+func (m *RetainRequest) XXX_Size() int {
+	return xxx_messageInfo_RetainRequest.Size(m)
+}
+func (m *RetainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainRequest proto.InternalMessageInfo
+
+func (m *RetainRequest) GetCreationUnixSec() int64 {
+	if m != nil {
+		return m.CreationUnixSec
+	}
+	return 0
+}
+
+func (m *RetainRequest) GetFilter() []byte {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type RetainResponse struct {
+	Trashed              int64    `protobuf:"varint,1,opt,name=trashed,proto3" json:"trashed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainResponse) Reset()         { *m = RetainResponse{} }
+func (m *RetainResponse) String() string { return proto.CompactTextString(m) }
+func (*RetainResponse) ProtoMessage()    {}
+func (*RetainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{19}
+}
+func (m *RetainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainResponse.Unmarshal(m, b)
+}
+func (m *RetainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainResponse.Marshal(b, m, deterministic)
+}
+func (dst *RetainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainResponse.Merge(dst, src)
+}
+func (m *RetainResponse) XXX_Size() int {
+	return xxx_messageInfo_RetainResponse.Size(m)
+}
+func (m *RetainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainResponse proto.InternalMessageInfo
+
+func (m *RetainResponse) GetTrashed() int64 {
+	if m != nil {
+		return m.Trashed
+	}
+	return 0
+}
+
+type SignedMessage struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	PublicKey            []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignedMessage) Reset()         { *m = SignedMessage{} }
+func (m *SignedMessage) String() string { return proto.CompactTextString(m) }
+func (*SignedMessage) ProtoMessage()    {}
+func (*SignedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{20}
+}
+func (m *SignedMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedMessage.Unmarshal(m, b)
+}
+func (m *SignedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedMessage.Marshal(b, m, deterministic)
+}
+func (dst *SignedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedMessage.Merge(dst, src)
+}
+func (m *SignedMessage) XXX_Size() int {
+	return xxx_messageInfo_SignedMessage.Size(m)
+}
+func (m *SignedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedMessage proto.InternalMessageInfo
+
+func (m *SignedMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SignedMessage) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SignedMessage) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+type DashboardReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardReq) Reset()         { *m = DashboardReq{} }
+func (m *DashboardReq) String() string { return proto.CompactTextString(m) }
+func (*DashboardReq) ProtoMessage()    {}
+func (*DashboardReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{21}
+}
+func (m *DashboardReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardReq.Unmarshal(m, b)
+}
+func (m *DashboardReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardReq.Marshal(b, m, deterministic)
+}
+func (dst *DashboardReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardReq.Merge(dst, src)
+}
+func (m *DashboardReq) XXX_Size() int {
+	return xxx_messageInfo_DashboardReq.Size(m)
+}
+func (m *DashboardReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardReq proto.InternalMessageInfo
+
+type DashboardStats struct {
+	NodeId               string              `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeConnections      int64               `protobuf:"varint,2,opt,name=node_connections,json=nodeConnections,proto3" json:"node_connections,omitempty"`
+	BootstrapAddress     string              `protobuf:"bytes,3,opt,name=bootstrap_address,json=bootstrapAddress,proto3" json:"bootstrap_address,omitempty"`
+	InternalAddress      string              `protobuf:"bytes,4,opt,name=internal_address,json=internalAddress,proto3" json:"internal_address,omitempty"`
+	ExternalAddress      string              `protobuf:"bytes,5,opt,name=external_address,json=externalAddress,proto3" json:"external_address,omitempty"`
+	Stats                *StatSummary        `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	Connection           bool                `protobuf:"varint,7,opt,name=connection,proto3" json:"connection,omitempty"`
+	Uptime               *duration.Duration  `protobuf:"bytes,8,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Notifications        []*NodeNotification `protobuf:"bytes,9,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Payouts              []*PayoutEstimate   `protobuf:"bytes,10,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	Quarantined          []*QuarantinedDisk  `protobuf:"bytes,11,rep,name=quarantined,proto3" json:"quarantined,omitempty"`
+	Usage                []*SatelliteUsage   `protobuf:"bytes,12,rep,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DashboardStats) Reset()         { *m = DashboardStats{} }
+func (m *DashboardStats) String() string { return proto.CompactTextString(m) }
+func (*DashboardStats) ProtoMessage()    {}
+func (*DashboardStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{22}
+}
+func (m *DashboardStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardStats.Unmarshal(m, b)
+}
+func (m *DashboardStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardStats.Marshal(b, m, deterministic)
+}
+func (dst *DashboardStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardStats.Merge(dst, src)
+}
+func (m *DashboardStats) XXX_Size() int {
+	return xxx_messageInfo_DashboardStats.Size(m)
+}
+func (m *DashboardStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardStats proto.InternalMessageInfo
+
+func (m *DashboardStats) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *DashboardStats) GetNodeConnections() int64 {
+	if m != nil {
+		return m.NodeConnections
+	}
+	return 0
+}
+
+func (m *DashboardStats) GetBootstrapAddress() string {
+	if m != nil {
+		return m.BootstrapAddress
+	}
+	return ""
+}
+
+func (m *DashboardStats) GetInternalAddress() string {
+	if m != nil {
+		return m.InternalAddress
+	}
+	return ""
+}
+
+func (m *DashboardStats) GetExternalAddress() string {
+	if m != nil {
+		return m.ExternalAddress
+	}
+	return ""
+}
+
+func (m *DashboardStats) GetStats() *StatSummary {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *DashboardStats) GetConnection() bool {
+	if m != nil {
+		return m.Connection
+	}
+	return false
+}
+
+func (m *DashboardStats) GetUptime() *duration.Duration {
+	if m != nil {
+		return m.Uptime
+	}
+	return nil
+}
+
+func (m *DashboardStats) GetNotifications() []*NodeNotification {
+	if m != nil {
+		return m.Notifications
+	}
+	return nil
+}
+
+func (m *DashboardStats) GetPayouts() []*PayoutEstimate {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+func (m *DashboardStats) GetQuarantined() []*QuarantinedDisk {
+	if m != nil {
+		return m.Quarantined
+	}
+	return nil
+}
+
+func (m *DashboardStats) GetUsage() []*SatelliteUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+// SatelliteUsage is the disk space used for a satellite and its bandwidth
+// of the current month per action
+type SatelliteUsage struct {
+	SatelliteId          NodeID   `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	UsedSpace            int64    `protobuf:"varint,2,opt,name=used_space,json=usedSpace,proto3" json:"used_space,omitempty"`
+	Pieces               int64    `protobuf:"varint,3,opt,name=pieces,proto3" json:"pieces,omitempty"`
+	PutBandwidth         int64    `protobuf:"varint,4,opt,name=put_bandwidth,json=putBandwidth,proto3" json:"put_bandwidth,omitempty"`
+	GetBandwidth         int64    `protobuf:"varint,5,opt,name=get_bandwidth,json=getBandwidth,proto3" json:"get_bandwidth,omitempty"`
+	GetAuditBandwidth    int64    `protobuf:"varint,6,opt,name=get_audit_bandwidth,json=getAuditBandwidth,proto3" json:"get_audit_bandwidth,omitempty"`
+	GetRepairBandwidth   int64    `protobuf:"varint,7,opt,name=get_repair_bandwidth,json=getRepairBandwidth,proto3" json:"get_repair_bandwidth,omitempty"`
+	PutRepairBandwidth   int64    `protobuf:"varint,8,opt,name=put_repair_bandwidth,json=putRepairBandwidth,proto3" json:"put_repair_bandwidth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatelliteUsage) Reset()         { *m = SatelliteUsage{} }
+func (m *SatelliteUsage) String() string { return proto.CompactTextString(m) }
+func (*SatelliteUsage) ProtoMessage()    {}
+func (*SatelliteUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{23}
+}
+func (m *SatelliteUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteUsage.Unmarshal(m, b)
+}
+func (m *SatelliteUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteUsage.Marshal(b, m, deterministic)
+}
+func (dst *SatelliteUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteUsage.Merge(dst, src)
+}
+func (m *SatelliteUsage) XXX_Size() int {
+	return xxx_messageInfo_SatelliteUsage.Size(m)
+}
+func (m *SatelliteUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteUsage proto.InternalMessageInfo
+
+func (m *SatelliteUsage) GetUsedSpace() int64 {
+	if m != nil {
+		return m.UsedSpace
+	}
+	return 0
+}
+
+func (m *SatelliteUsage) GetPieces() int64 {
+	if m != nil {
+		return m.Pieces
+	}
+	return 0
+}
+
+func (m *SatelliteUsage) GetPutBandwidth() int64 {
+	if m != nil {
+		return m.PutBandwidth
+	}
+	return 0
+}
+
+func (m *SatelliteUsage) GetGetBandwidth() int64 {
+	if m != nil {
+		return m.GetBandwidth
+	}
+	return 0
+}
+
+func (m *SatelliteUsage) GetGetAuditBandwidth() int64 {
+	if m != nil {
+		return m.GetAuditBandwidth
+	}
+	return 0
+}
+
+func (m *SatelliteUsage) GetGetRepairBandwidth() int64 {
+	if m != nil {
+		return m.GetRepairBandwidth
+	}
+	return 0
+}
+
+func (m *SatelliteUsage) GetPutRepairBandwidth() int64 {
+	if m != nil {
+		return m.PutRepairBandwidth
+	}
+	return 0
+}
+
+// QuarantinedDisk is the number of pieces, which couldn't be read from a disk
+type QuarantinedDisk struct {
+	Disk                 string   `protobuf:"bytes,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	Pieces               int64    `protobuf:"varint,2,opt,name=pieces,proto3" json:"pieces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuarantinedDisk) Reset()         { *m = QuarantinedDisk{} }
+func (m *QuarantinedDisk) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedDisk) ProtoMessage()    {}
+func (*QuarantinedDisk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{24}
+}
+func (m *QuarantinedDisk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuarantinedDisk.Unmarshal(m, b)
+}
+func (m *QuarantinedDisk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuarantinedDisk.Marshal(b, m, deterministic)
+}
+func (dst *QuarantinedDisk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedDisk.Merge(dst, src)
+}
+func (m *QuarantinedDisk) XXX_Size() int {
+	return xxx_messageInfo_QuarantinedDisk.Size(m)
+}
+func (m *QuarantinedDisk) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedDisk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedDisk proto.InternalMessageInfo
+
+func (m *QuarantinedDisk) GetDisk() string {
+	if m != nil {
+		return m.Disk
+	}
+	return ""
+}
+
+func (m *QuarantinedDisk) GetPieces() int64 {
+	if m != nil {
+		return m.Pieces
+	}
+	return 0
+}
+
+// PayoutEstimate is the projected month-end payout of a satellite, amounts are in US cents
+type PayoutEstimate struct {
+	SatelliteId  NodeID  `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	DiskSpace    float64 `protobuf:"fixed64,2,opt,name=disk_space,json=diskSpace,proto3" json:"disk_space,omitempty"`
+	Egress       float64 `protobuf:"fixed64,3,opt,name=egress,proto3" json:"egress,omitempty"`
+	RepairEgress float64 `protobuf:"fixed64,4,opt,name=repair_egress,json=repairEgress,proto3" json:"repair_egress,omitempty"`
+	AuditEgress  float64 `protobuf:"fixed64,5,opt,name=audit_egress,json=auditEgress,proto3" json:"audit_egress,omitempty"`
+	// held is the part of the payout, which the satellite keeps back for now
+	Held                 float64  `protobuf:"fixed64,6,opt,name=held,proto3" json:"held,omitempty"`
+	Payout               float64  `protobuf:"fixed64,7,opt,name=payout,proto3" json:"payout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayoutEstimate) Reset()         { *m = PayoutEstimate{} }
+func (m *PayoutEstimate) String() string { return proto.CompactTextString(m) }
+func (*PayoutEstimate) ProtoMessage()    {}
+func (*PayoutEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{25}
+}
+func (m *PayoutEstimate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutEstimate.Unmarshal(m, b)
+}
+func (m *PayoutEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutEstimate.Marshal(b, m, deterministic)
+}
+func (dst *PayoutEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutEstimate.Merge(dst, src)
+}
+func (m *PayoutEstimate) XXX_Size() int {
+	return xxx_messageInfo_PayoutEstimate.Size(m)
+}
+func (m *PayoutEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutEstimate proto.InternalMessageInfo
+
+func (m *PayoutEstimate) GetDiskSpace() float64 {
+	if m != nil {
+		return m.DiskSpace
+	}
+	return 0
+}
+
+func (m *PayoutEstimate) GetEgress() float64 {
+	if m != nil {
+		return m.Egress
+	}
+	return 0
+}
+
+func (m *PayoutEstimate) GetRepairEgress() float64 {
+	if m != nil {
+		return m.RepairEgress
+	}
+	return 0
+}
+
+func (m *PayoutEstimate) GetAuditEgress() float64 {
+	if m != nil {
+		return m.AuditEgress
+	}
+	return 0
+}
+
+func (m *PayoutEstimate) GetHeld() float64 {
+	if m != nil {
+		return m.Held
+	}
+	return 0
+}
+
+func (m *PayoutEstimate) GetPayout() float64 {
+	if m != nil {
+		return m.Payout
+	}
+	return 0
+}
+
+type NodeNotification struct {
+	Id                   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title                string               `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Message              string               `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Created              *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	Read                 bool                 `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *NodeNotification) Reset()         { *m = NodeNotification{} }
+func (m *NodeNotification) String() string { return proto.CompactTextString(m) }
+func (*NodeNotification) ProtoMessage()    {}
+func (*NodeNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_b5c9647832d2d27d, []int{26}
+}
+func (m *NodeNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeNotification.Unmarshal(m, b)
+}
+func (m *NodeNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeNotification.Marshal(b, m, deterministic)
+}
+func (dst *NodeNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeNotification.Merge(dst, src)
+}
+func (m *NodeNotification) XXX_Size() int {
+	return xxx_messageInfo_NodeNotification.Size(m)
+}
+func (m *NodeNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeNotification proto.InternalMessageInfo
+
+func (m *NodeNotification) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *NodeNotification) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *NodeNotification) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *NodeNotification) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *NodeNotification) GetCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *NodeNotification) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*PayerBandwidthAllocation)(nil), "piecestoreroutes.PayerBandwidthAllocation")
 	proto.RegisterType((*RenterBandwidthAllocation)(nil), "piecestoreroutes.RenterBandwidthAllocation")
 	proto.RegisterType((*PieceStore)(nil), "piecestoreroutes.PieceStore")
 	proto.RegisterType((*PieceStore_PieceData)(nil), "piecestoreroutes.PieceStore.PieceData")
@@ -987,12 +1898,25 @@ func init() {
 	proto.RegisterType((*PieceDelete)(nil), "piecestoreroutes.PieceDelete")
 	proto.RegisterType((*PieceDeleteSummary)(nil), "piecestoreroutes.PieceDeleteSummary")
 	proto.RegisterType((*PieceStoreSummary)(nil), "piecestoreroutes.PieceStoreSummary")
+	proto.RegisterType((*StoreReceipt)(nil), "piecestoreroutes.StoreReceipt")
+	proto.RegisterType((*PieceStoreAck)(nil), "piecestoreroutes.PieceStoreAck")
 	proto.RegisterType((*StatsReq)(nil), "piecestoreroutes.StatsReq")
 	proto.RegisterType((*StatSummary)(nil), "piecestoreroutes.StatSummary")
+	proto.RegisterType((*ThroughputReq)(nil), "piecestoreroutes.ThroughputReq")
+	proto.RegisterType((*ThroughputSummary)(nil), "piecestoreroutes.ThroughputSummary")
+	proto.RegisterType((*NodeTally)(nil), "piecestoreroutes.NodeTally")
+	proto.RegisterType((*NodeTallyResponse)(nil), "piecestoreroutes.NodeTallyResponse")
+	proto.RegisterType((*RetainRequest)(nil), "piecestoreroutes.RetainRequest")
+	proto.RegisterType((*RetainResponse)(nil), "piecestoreroutes.RetainResponse")
 	proto.RegisterType((*SignedMessage)(nil), "piecestoreroutes.SignedMessage")
 	proto.RegisterType((*DashboardReq)(nil), "piecestoreroutes.DashboardReq")
 	proto.RegisterType((*DashboardStats)(nil), "piecestoreroutes.DashboardStats")
+	proto.RegisterType((*SatelliteUsage)(nil), "piecestoreroutes.SatelliteUsage")
+	proto.RegisterType((*QuarantinedDisk)(nil), "piecestoreroutes.QuarantinedDisk")
+	proto.RegisterType((*PayoutEstimate)(nil), "piecestoreroutes.PayoutEstimate")
+	proto.RegisterType((*NodeNotification)(nil), "piecestoreroutes.NodeNotification")
 	proto.RegisterEnum("piecestoreroutes.BandwidthAction", BandwidthAction_name, BandwidthAction_value)
+	proto.RegisterEnum("piecestoreroutes.PieceRetrieval_Priority", PieceRetrieval_Priority_name, PieceRetrieval_Priority_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1010,9 +1934,17 @@ type PieceStoreRoutesClient interface {
 	Piece(ctx context.Context, in *PieceId, opts ...grpc.CallOption) (*PieceSummary, error)
 	Retrieve(ctx context.Context, opts ...grpc.CallOption) (PieceStoreRoutes_RetrieveClient, error)
 	Store(ctx context.Context, opts ...grpc.CallOption) (PieceStoreRoutes_StoreClient, error)
+	// StoreResumable stores a piece like Store, but acknowledges the received
+	// data, so an interrupted upload can continue from the acknowledged offset
+	StoreResumable(ctx context.Context, opts ...grpc.CallOption) (PieceStoreRoutes_StoreResumableClient, error)
 	Delete(ctx context.Context, in *PieceDelete, opts ...grpc.CallOption) (*PieceDeleteSummary, error)
 	Stats(ctx context.Context, in *StatsReq, opts ...grpc.CallOption) (*StatSummary, error)
 	Dashboard(ctx context.Context, in *DashboardReq, opts ...grpc.CallOption) (PieceStoreRoutes_DashboardClient, error)
+	Throughput(ctx context.Context, in *ThroughputReq, opts ...grpc.CallOption) (*ThroughputSummary, error)
+	Tally(ctx context.Context, in *NodeTally, opts ...grpc.CallOption) (*NodeTallyResponse, error)
+	// Retain moves the pieces of the calling satellite, which aren't in its
+	// filter of live pieces, to the trash
+	Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error)
 }
 
 type pieceStoreRoutesClient struct {
@@ -1097,6 +2029,37 @@ func (x *pieceStoreRoutesStoreClient) CloseAndRecv() (*PieceStoreSummary, error)
 	return m, nil
 }
 
+func (c *pieceStoreRoutesClient) StoreResumable(ctx context.Context, opts ...grpc.CallOption) (PieceStoreRoutes_StoreResumableClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PieceStoreRoutes_serviceDesc.Streams[2], "/piecestoreroutes.PieceStoreRoutes/StoreResumable", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pieceStoreRoutesStoreResumableClient{stream}
+	return x, nil
+}
+
+type PieceStoreRoutes_StoreResumableClient interface {
+	Send(*PieceStore) error
+	Recv() (*PieceStoreAck, error)
+	grpc.ClientStream
+}
+
+type pieceStoreRoutesStoreResumableClient struct {
+	grpc.ClientStream
+}
+
+func (x *pieceStoreRoutesStoreResumableClient) Send(m *PieceStore) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pieceStoreRoutesStoreResumableClient) Recv() (*PieceStoreAck, error) {
+	m := new(PieceStoreAck)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *pieceStoreRoutesClient) Delete(ctx context.Context, in *PieceDelete, opts ...grpc.CallOption) (*PieceDeleteSummary, error) {
 	out := new(PieceDeleteSummary)
 	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/Delete", in, out, opts...)
@@ -1116,7 +2079,7 @@ func (c *pieceStoreRoutesClient) Stats(ctx context.Context, in *StatsReq, opts .
 }
 
 func (c *pieceStoreRoutesClient) Dashboard(ctx context.Context, in *DashboardReq, opts ...grpc.CallOption) (PieceStoreRoutes_DashboardClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PieceStoreRoutes_serviceDesc.Streams[2], "/piecestoreroutes.PieceStoreRoutes/Dashboard", opts...)
+	stream, err := c.cc.NewStream(ctx, &_PieceStoreRoutes_serviceDesc.Streams[3], "/piecestoreroutes.PieceStoreRoutes/Dashboard", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1147,14 +2110,49 @@ func (x *pieceStoreRoutesDashboardClient) Recv() (*DashboardStats, error) {
 	return m, nil
 }
 
+func (c *pieceStoreRoutesClient) Throughput(ctx context.Context, in *ThroughputReq, opts ...grpc.CallOption) (*ThroughputSummary, error) {
+	out := new(ThroughputSummary)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/Throughput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreRoutesClient) Tally(ctx context.Context, in *NodeTally, opts ...grpc.CallOption) (*NodeTallyResponse, error) {
+	out := new(NodeTallyResponse)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/Tally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pieceStoreRoutesClient) Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error) {
+	out := new(RetainResponse)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/Retain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PieceStoreRoutesServer is the server API for PieceStoreRoutes service.
 type PieceStoreRoutesServer interface {
 	Piece(context.Context, *PieceId) (*PieceSummary, error)
 	Retrieve(PieceStoreRoutes_RetrieveServer) error
 	Store(PieceStoreRoutes_StoreServer) error
+	// StoreResumable stores a piece like Store, but acknowledges the received
+	// data, so an interrupted upload can continue from the acknowledged offset
+	StoreResumable(PieceStoreRoutes_StoreResumableServer) error
 	Delete(context.Context, *PieceDelete) (*PieceDeleteSummary, error)
 	Stats(context.Context, *StatsReq) (*StatSummary, error)
 	Dashboard(*DashboardReq, PieceStoreRoutes_DashboardServer) error
+	Throughput(context.Context, *ThroughputReq) (*ThroughputSummary, error)
+	Tally(context.Context, *NodeTally) (*NodeTallyResponse, error)
+	// Retain moves the pieces of the calling satellite, which aren't in its
+	// filter of live pieces, to the trash
+	Retain(context.Context, *RetainRequest) (*RetainResponse, error)
 }
 
 func RegisterPieceStoreRoutesServer(s *grpc.Server, srv PieceStoreRoutesServer) {
@@ -1231,6 +2229,32 @@ func (x *pieceStoreRoutesStoreServer) Recv() (*PieceStore, error) {
 	return m, nil
 }
 
+func _PieceStoreRoutes_StoreResumable_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PieceStoreRoutesServer).StoreResumable(&pieceStoreRoutesStoreResumableServer{stream})
+}
+
+type PieceStoreRoutes_StoreResumableServer interface {
+	Send(*PieceStoreAck) error
+	Recv() (*PieceStore, error)
+	grpc.ServerStream
+}
+
+type pieceStoreRoutesStoreResumableServer struct {
+	grpc.ServerStream
+}
+
+func (x *pieceStoreRoutesStoreResumableServer) Send(m *PieceStoreAck) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pieceStoreRoutesStoreResumableServer) Recv() (*PieceStore, error) {
+	m := new(PieceStore)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _PieceStoreRoutes_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PieceDelete)
 	if err := dec(in); err != nil {
@@ -1288,6 +2312,60 @@ func (x *pieceStoreRoutesDashboardServer) Send(m *DashboardStats) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _PieceStoreRoutes_Throughput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThroughputReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).Throughput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/Throughput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).Throughput(ctx, req.(*ThroughputReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreRoutes_Tally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeTally)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).Tally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/Tally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).Tally(ctx, req.(*NodeTally))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PieceStoreRoutes_Retain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).Retain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/Retain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).Retain(ctx, req.(*RetainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "piecestoreroutes.PieceStoreRoutes",
 	HandlerType: (*PieceStoreRoutesServer)(nil),
@@ -1304,6 +2382,18 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Stats",
 			Handler:    _PieceStoreRoutes_Stats_Handler,
 		},
+		{
+			MethodName: "Throughput",
+			Handler:    _PieceStoreRoutes_Throughput_Handler,
+		},
+		{
+			MethodName: "Tally",
+			Handler:    _PieceStoreRoutes_Tally_Handler,
+		},
+		{
+			MethodName: "Retain",
+			Handler:    _PieceStoreRoutes_Retain_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1317,6 +2407,12 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 			Handler:       _PieceStoreRoutes_Store_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StoreResumable",
+			Handler:       _PieceStoreRoutes_StoreResumable_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Dashboard",
 			Handler:       _PieceStoreRoutes_Dashboard_Handler,
@@ -1326,81 +2422,144 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 	Metadata: "piecestore.proto",
 }
 
-func init() { proto.RegisterFile("piecestore.proto", fileDescriptor_piecestore_ef88e5b6fd61ab5b) }
-
-var fileDescriptor_piecestore_ef88e5b6fd61ab5b = []byte{
-	// 1159 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0xa9, 0xff, 0xd1, 0x6f, 0x36, 0x46, 0x2b, 0x0b, 0xb1, 0xad, 0x32, 0x4d, 0xaa, 0xda,
-	0x80, 0x9c, 0x38, 0x40, 0x81, 0x1e, 0xed, 0xca, 0x08, 0x84, 0xa2, 0x8e, 0xbb, 0xb2, 0x2f, 0x29,
-	0x50, 0x66, 0x45, 0x8e, 0x65, 0x22, 0x14, 0xa9, 0x92, 0x4b, 0x57, 0xf6, 0xb5, 0xcf, 0xd3, 0xf7,
-	0xe8, 0x13, 0xf4, 0xd0, 0x43, 0x8a, 0xbe, 0x40, 0x1f, 0xa0, 0xa7, 0x82, 0xbb, 0xfc, 0x91, 0xf5,
-	0x67, 0x20, 0x40, 0x6e, 0x9c, 0x6f, 0x3f, 0xce, 0xce, 0x7c, 0x33, 0x3b, 0xbb, 0xd0, 0x9c, 0x5a,
-	0x68, 0xa0, 0xcf, 0x5d, 0x0f, 0x7b, 0x53, 0xcf, 0xe5, 0x2e, 0x99, 0x43, 0x3c, 0x37, 0xe0, 0xe8,
-	0xb7, 0x61, 0xec, 0x8e, 0x5d, 0xb9, 0xda, 0xde, 0x1d, 0xbb, 0xee, 0xd8, 0xc6, 0x43, 0x61, 0x8d,
-	0x82, 0xab, 0x43, 0x33, 0xf0, 0x18, 0xb7, 0x5c, 0x47, 0xae, 0x6b, 0xbf, 0x65, 0xa1, 0x75, 0xce,
-	0x6e, 0xd1, 0x3b, 0x61, 0x8e, 0xf9, 0xab, 0x65, 0xf2, 0xeb, 0x63, 0xdb, 0x76, 0x0d, 0x41, 0x21,
-	0x2f, 0xa1, 0xea, 0x33, 0x8e, 0xb6, 0x6d, 0x71, 0xd4, 0x2d, 0xb3, 0xa5, 0x74, 0x94, 0x6e, 0xf5,
-	0xa4, 0xfe, 0xc7, 0x87, 0xbd, 0xcc, 0x5f, 0x1f, 0xf6, 0x0a, 0x67, 0xae, 0x89, 0x83, 0x3e, 0xad,
-	0x24, 0x9c, 0x81, 0x49, 0x0e, 0xa0, 0x1c, 0x4c, 0x6d, 0xcb, 0x79, 0x1f, 0xf2, 0xd5, 0x95, 0xfc,
-	0x92, 0x24, 0x0c, 0x4c, 0xb2, 0x0d, 0xa5, 0x09, 0x9b, 0xe9, 0xbe, 0x75, 0x87, 0xad, 0x6c, 0x47,
-	0xe9, 0x66, 0x69, 0x71, 0xc2, 0x66, 0x43, 0xeb, 0x0e, 0x49, 0x0f, 0x1e, 0xe3, 0x6c, 0x6a, 0xc9,
-	0x58, 0xf5, 0xc0, 0xb1, 0x66, 0xba, 0x8f, 0x46, 0x2b, 0x27, 0x58, 0x8f, 0xd2, 0xa5, 0x4b, 0xc7,
-	0x9a, 0x0d, 0xd1, 0x20, 0x4f, 0xa1, 0xe6, 0xa3, 0x67, 0x31, 0x5b, 0x77, 0x82, 0xc9, 0x08, 0xbd,
-	0x56, 0xbe, 0xa3, 0x74, 0xcb, 0xb4, 0x2a, 0xc1, 0x33, 0x81, 0x91, 0x6f, 0xa1, 0xc0, 0x8c, 0xf0,
-	0xaf, 0x56, 0xa1, 0xa3, 0x74, 0xeb, 0x47, 0x5f, 0xf4, 0x16, 0xb5, 0xeb, 0xa5, 0x32, 0x08, 0x22,
-	0x8d, 0x7e, 0x20, 0x5d, 0x68, 0x1a, 0x1e, 0x32, 0x8e, 0x66, 0x1a, 0x4c, 0x51, 0x04, 0x53, 0x8f,
-	0xf0, 0x38, 0x92, 0x2d, 0xc8, 0x1b, 0xe8, 0x71, 0xbf, 0x55, 0xea, 0x64, 0xbb, 0x55, 0x2a, 0x0d,
-	0xf2, 0x04, 0xca, 0xbe, 0x35, 0x76, 0x18, 0x0f, 0x3c, 0x6c, 0x95, 0x43, 0x5d, 0x68, 0x0a, 0x68,
-	0xff, 0x29, 0xb0, 0x4d, 0xd1, 0xe1, 0xab, 0xcb, 0xf0, 0x13, 0x34, 0xa7, 0x61, 0x89, 0x74, 0x96,
-	0x60, 0xa2, 0x14, 0x95, 0xa3, 0xfd, 0xe5, 0x04, 0xd6, 0x15, 0xf3, 0x24, 0x17, 0x96, 0x81, 0x36,
-	0x84, 0xa7, 0x39, 0xe7, 0x5b, 0x90, 0xe7, 0x2e, 0x67, 0xb6, 0x28, 0x56, 0x96, 0x4a, 0x83, 0x7c,
-	0x03, 0x8d, 0xd0, 0x29, 0x1b, 0xa3, 0xee, 0xb8, 0xa6, 0x28, 0x7e, 0x76, 0x65, 0x31, 0x6b, 0x11,
-	0x4d, 0x98, 0x66, 0x9a, 0x7c, 0x6e, 0x6d, 0xf2, 0xf9, 0xc5, 0xe4, 0xff, 0x51, 0x01, 0xce, 0xc3,
-	0x34, 0x86, 0x61, 0x1a, 0xe4, 0x67, 0xd8, 0x1a, 0xc5, 0xe1, 0x2f, 0x67, 0x7c, 0xb0, 0x9c, 0xf1,
-	0x5a, 0xe1, 0xe8, 0xe3, 0xd1, 0x0a, 0x35, 0x4f, 0x01, 0x84, 0x0b, 0xdd, 0x64, 0x9c, 0x89, 0xac,
-	0x2b, 0x47, 0xcf, 0x57, 0xe8, 0x98, 0x44, 0x24, 0x3f, 0xfb, 0x8c, 0x33, 0x5a, 0x9e, 0xc6, 0x9f,
-	0xe4, 0x14, 0x6a, 0x2c, 0xe0, 0xd7, 0xae, 0x67, 0xdd, 0xc9, 0xf8, 0xb2, 0xc2, 0xd3, 0xde, 0xb2,
-	0xa7, 0xa1, 0x35, 0x76, 0xd0, 0xfc, 0x01, 0x7d, 0x9f, 0x8d, 0x91, 0xde, 0xff, 0xab, 0x8d, 0x50,
-	0x4e, 0xdc, 0x93, 0x3a, 0xa8, 0xd1, 0x29, 0x2b, 0x53, 0xd5, 0x32, 0xd7, 0x1d, 0x02, 0x75, 0xdd,
-	0x21, 0x68, 0x41, 0xd1, 0x70, 0x1d, 0x8e, 0x0e, 0x97, 0xd5, 0xa2, 0xb1, 0xa9, 0xbd, 0x83, 0xa2,
-	0xd8, 0x66, 0x60, 0x2e, 0x6d, 0xb2, 0x94, 0x88, 0xfa, 0x31, 0x89, 0x68, 0x13, 0xa8, 0x4a, 0xc9,
-	0x82, 0xc9, 0x84, 0x79, 0xb7, 0x4b, 0xdb, 0xec, 0xc4, 0xb2, 0x8b, 0xd3, 0x2e, 0x53, 0x90, 0x72,
-	0x6e, 0x3a, 0xef, 0xd9, 0x35, 0xa9, 0x6a, 0x7f, 0xaa, 0x50, 0x17, 0xfb, 0x51, 0xe4, 0x9e, 0x85,
-	0x37, 0xcc, 0xfe, 0xe4, 0x8d, 0x33, 0x58, 0xd1, 0x38, 0xfb, 0x6b, 0x1a, 0x27, 0x89, 0xea, 0x93,
-	0x36, 0x0f, 0xdd, 0xd4, 0x3c, 0x0f, 0x08, 0xfe, 0x19, 0x14, 0xdc, 0xab, 0x2b, 0x1f, 0x79, 0xa4,
-	0x71, 0x64, 0x69, 0x6f, 0x60, 0xeb, 0x7e, 0x06, 0x43, 0xee, 0x21, 0x9b, 0x2c, 0xb8, 0x53, 0x16,
-	0xdd, 0xcd, 0xb5, 0x9e, 0x7a, 0xbf, 0xf5, 0x4c, 0xa8, 0xc8, 0x20, 0xd1, 0x46, 0x8e, 0x0f, 0xb7,
-	0xdf, 0x47, 0x49, 0xa1, 0xf5, 0x80, 0xcc, 0xed, 0x12, 0x37, 0x61, 0x0b, 0x8a, 0x13, 0xc9, 0x8f,
-	0x76, 0x8c, 0x4d, 0xed, 0x02, 0x1e, 0xa5, 0x27, 0xfc, 0x41, 0x3a, 0x79, 0x06, 0x75, 0x31, 0x18,
-	0x75, 0x0f, 0x0d, 0xb4, 0x6e, 0xd0, 0x8c, 0x04, 0xad, 0x09, 0x94, 0x46, 0xa0, 0x06, 0x50, 0x1a,
-	0x72, 0xc6, 0x7d, 0x8a, 0xbf, 0x68, 0xbf, 0x2b, 0x50, 0x09, 0x8d, 0xd8, 0xf9, 0x0e, 0x40, 0xe0,
-	0xa3, 0xa9, 0xfb, 0x53, 0x66, 0x24, 0x02, 0x86, 0xc8, 0x30, 0x04, 0xc8, 0x57, 0xd0, 0x60, 0x37,
-	0xcc, 0xb2, 0xd9, 0xc8, 0xc6, 0x88, 0x23, 0xb7, 0xa8, 0x27, 0xb0, 0x24, 0x3e, 0x83, 0xba, 0xf0,
-	0x93, 0xb4, 0x68, 0x54, 0xc0, 0x5a, 0x88, 0x26, 0xcd, 0x4c, 0x0e, 0xe1, 0x71, 0xea, 0x2f, 0xe5,
-	0xca, 0x0b, 0x94, 0x24, 0x4b, 0xc9, 0x0f, 0xda, 0x3b, 0xa8, 0xdd, 0x53, 0x98, 0x10, 0xc8, 0x89,
-	0x4e, 0x17, 0xb7, 0x3e, 0x15, 0xdf, 0xf7, 0x27, 0xb9, 0xba, 0x30, 0xc9, 0x45, 0x8f, 0x04, 0x23,
-	0xdb, 0x32, 0xf4, 0xf7, 0x78, 0x1b, 0x8d, 0xa0, 0xb2, 0x44, 0xbe, 0xc7, 0x5b, 0xad, 0x0e, 0xd5,
-	0x3e, 0xf3, 0xaf, 0x47, 0x2e, 0xf3, 0xcc, 0x50, 0xa1, 0xbf, 0x55, 0xa8, 0x27, 0x80, 0xd0, 0x8d,
-	0x7c, 0x0e, 0xc5, 0xf8, 0xbe, 0x91, 0x15, 0x28, 0x38, 0xf2, 0x62, 0xf9, 0x1a, 0x9a, 0x62, 0xc1,
-	0x70, 0x1d, 0x07, 0xc5, 0x95, 0xec, 0x47, 0xfa, 0x34, 0x42, 0xfc, 0xbb, 0x14, 0x26, 0x07, 0xf0,
-	0x68, 0xe4, 0xba, 0xdc, 0xe7, 0x1e, 0x9b, 0xea, 0xcc, 0x34, 0x3d, 0xf4, 0x7d, 0x11, 0x4c, 0x99,
-	0x36, 0x93, 0x85, 0x63, 0x89, 0x87, 0x7e, 0xad, 0x70, 0x0a, 0x38, 0xcc, 0x4e, 0xb8, 0x39, 0xc1,
-	0x6d, 0xc4, 0xf8, 0x1c, 0x15, 0x67, 0x0b, 0x54, 0xf9, 0xca, 0x68, 0xc4, 0x78, 0x4c, 0x7d, 0x05,
-	0x79, 0x3f, 0xcc, 0x47, 0xbc, 0x33, 0x2a, 0x47, 0x3b, 0x2b, 0x9a, 0x39, 0xed, 0x0c, 0x2a, 0xb9,
-	0x64, 0x17, 0x20, 0xcd, 0x4e, 0x3c, 0x2e, 0x4a, 0x74, 0x0e, 0x21, 0x2f, 0xa1, 0x10, 0x4c, 0xb9,
-	0x35, 0xc1, 0x56, 0x49, 0x78, 0xdd, 0xee, 0xc9, 0xb7, 0x5d, 0x2f, 0x7e, 0xdb, 0xf5, 0xfa, 0xd1,
-	0xdb, 0x8e, 0x46, 0xc4, 0x7d, 0x0a, 0x8d, 0x85, 0x07, 0x0d, 0x29, 0x42, 0xf6, 0xfc, 0xf2, 0xa2,
-	0x99, 0x09, 0x3f, 0x5e, 0x9f, 0x5e, 0x34, 0x15, 0x52, 0x83, 0xf2, 0xeb, 0xd3, 0x0b, 0xfd, 0xf8,
-	0xb2, 0x3f, 0xb8, 0x68, 0xaa, 0xa4, 0x0e, 0x10, 0x9a, 0xf4, 0xf4, 0xfc, 0x78, 0x40, 0x9b, 0xd9,
-	0xd0, 0x3e, 0xbf, 0x4c, 0xec, 0xdc, 0xd1, 0xbf, 0x59, 0x68, 0xa6, 0x47, 0x87, 0x8a, 0x74, 0x48,
-	0x1f, 0xf2, 0x02, 0x23, 0xdb, 0x6b, 0x06, 0xe2, 0xc0, 0x6c, 0xef, 0xae, 0xbb, 0x64, 0xa5, 0x0c,
-	0x5a, 0x86, 0xbc, 0x85, 0x52, 0x34, 0x76, 0x90, 0x74, 0x1e, 0x9a, 0xac, 0xed, 0xe7, 0x0f, 0x31,
-	0xe4, 0xe4, 0xd2, 0x32, 0x5d, 0xe5, 0x85, 0x42, 0xce, 0x20, 0x2f, 0xdf, 0x17, 0x4f, 0x36, 0xdd,
-	0xf5, 0xed, 0xa7, 0x9b, 0x56, 0x93, 0x48, 0xbb, 0x0a, 0x79, 0x03, 0x85, 0x68, 0xa2, 0xed, 0xac,
-	0xf9, 0x45, 0x2e, 0xb7, 0xbf, 0xdc, 0xb8, 0x9c, 0x26, 0xdf, 0x0f, 0x03, 0x0c, 0xfb, 0xa0, 0xbd,
-	0xba, 0x5b, 0xc2, 0xa1, 0xd2, 0xde, 0xdc, 0x49, 0x5a, 0x86, 0xfc, 0x08, 0xe5, 0xe4, 0x48, 0x91,
-	0x15, 0x8a, 0xcf, 0x1f, 0xc0, 0x76, 0x67, 0xc3, 0xba, 0xd8, 0x52, 0xcb, 0xbc, 0x50, 0x4e, 0x72,
-	0x6f, 0xd5, 0xe9, 0x68, 0x54, 0x10, 0x5d, 0xf6, 0xea, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7f,
-	0x14, 0x59, 0xb7, 0x81, 0x0c, 0x00, 0x00,
+func init() { proto.RegisterFile("piecestore.proto", fileDescriptor_piecestore_b5c9647832d2d27d) }
+
+var fileDescriptor_piecestore_b5c9647832d2d27d = []byte{
+	// 2174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x8e, 0x1b, 0xc7,
+	0xd5, 0x9e, 0xe6, 0x9d, 0x87, 0xd7, 0xa9, 0xd1, 0xaf, 0x9f, 0xa2, 0x2d, 0x89, 0x6a, 0x45, 0xf2,
+	0x48, 0x42, 0x46, 0x12, 0x1d, 0x18, 0x49, 0x00, 0x2f, 0x38, 0x1a, 0xc2, 0x26, 0x1c, 0x8d, 0xe4,
+	0x22, 0x27, 0x0b, 0x07, 0x08, 0x5d, 0x64, 0xd7, 0x70, 0x0a, 0xd3, 0xec, 0x6e, 0x75, 0x57, 0x4b,
+	0x43, 0xed, 0x82, 0x20, 0x3b, 0xef, 0xf3, 0x12, 0x09, 0x90, 0x5d, 0x5e, 0x21, 0xfb, 0x2c, 0x12,
+	0x64, 0xe1, 0x45, 0x1e, 0x20, 0xbb, 0x6c, 0xb2, 0x0a, 0xea, 0xd2, 0x17, 0x5e, 0x27, 0x10, 0xe0,
+	0x5d, 0xd5, 0x77, 0xbe, 0xaa, 0xae, 0x73, 0xa9, 0x73, 0x4e, 0x35, 0x34, 0x3d, 0x46, 0xa7, 0x34,
+	0xe0, 0xae, 0x4f, 0x8f, 0x3c, 0xdf, 0xe5, 0x2e, 0x4a, 0x21, 0xbe, 0x1b, 0x72, 0x1a, 0xb4, 0x61,
+	0xe6, 0xce, 0x5c, 0x25, 0x6d, 0xdf, 0x99, 0xb9, 0xee, 0xcc, 0xa6, 0x4f, 0xe5, 0x6c, 0x12, 0x9e,
+	0x3f, 0xb5, 0x42, 0x9f, 0x70, 0xe6, 0x3a, 0x5a, 0x7e, 0x77, 0x55, 0xce, 0xd9, 0x9c, 0x06, 0x9c,
+	0xcc, 0x3d, 0x45, 0x30, 0x7f, 0x9b, 0x85, 0xd6, 0x6b, 0xb2, 0xa0, 0xfe, 0x31, 0x71, 0xac, 0x77,
+	0xcc, 0xe2, 0x17, 0x3d, 0xdb, 0x76, 0xa7, 0x72, 0x0f, 0xf4, 0x1c, 0xaa, 0x01, 0xe1, 0xd4, 0xb6,
+	0x19, 0xa7, 0x63, 0x66, 0xb5, 0x8c, 0x8e, 0x71, 0x58, 0x3d, 0xae, 0xff, 0xe5, 0xfb, 0xbb, 0x7b,
+	0xff, 0xf8, 0xfe, 0x6e, 0xe1, 0xd4, 0xb5, 0xe8, 0xe0, 0x04, 0x57, 0x62, 0xce, 0xc0, 0x42, 0x4f,
+	0xa0, 0x1c, 0x7a, 0x36, 0x73, 0x2e, 0x05, 0x3f, 0xb3, 0x91, 0x5f, 0x52, 0x84, 0x81, 0x85, 0x6e,
+	0x41, 0x69, 0x4e, 0xae, 0xc6, 0x01, 0x7b, 0x4f, 0x5b, 0xd9, 0x8e, 0x71, 0x98, 0xc5, 0xc5, 0x39,
+	0xb9, 0x1a, 0xb2, 0xf7, 0x14, 0x1d, 0xc1, 0x01, 0xbd, 0xf2, 0x98, 0x52, 0x66, 0x1c, 0x3a, 0xec,
+	0x6a, 0x1c, 0xd0, 0x69, 0x2b, 0x27, 0x59, 0xfb, 0x89, 0xe8, 0xcc, 0x61, 0x57, 0x43, 0x3a, 0x45,
+	0xf7, 0xa1, 0x16, 0x50, 0x9f, 0x11, 0x7b, 0xec, 0x84, 0xf3, 0x09, 0xf5, 0x5b, 0xf9, 0x8e, 0x71,
+	0x58, 0xc6, 0x55, 0x05, 0x9e, 0x4a, 0x0c, 0xfd, 0x0c, 0x0a, 0x64, 0x2a, 0x56, 0xb5, 0x0a, 0x1d,
+	0xe3, 0xb0, 0xde, 0xbd, 0x77, 0xb4, 0x6a, 0xdc, 0xa3, 0xc4, 0x0c, 0x92, 0x88, 0xf5, 0x02, 0x74,
+	0x08, 0xcd, 0xa9, 0x4f, 0x09, 0xa7, 0x56, 0x72, 0x98, 0xa2, 0x3c, 0x4c, 0x5d, 0xe3, 0xd1, 0x49,
+	0x6e, 0x40, 0x7e, 0x4a, 0x7d, 0x1e, 0xb4, 0x4a, 0x9d, 0xec, 0x61, 0x15, 0xab, 0x09, 0xfa, 0x18,
+	0xca, 0x01, 0x9b, 0x39, 0x84, 0x87, 0x3e, 0x6d, 0x95, 0x85, 0x5d, 0x70, 0x02, 0x98, 0xff, 0x31,
+	0xe0, 0x16, 0xa6, 0x0e, 0xdf, 0xec, 0x86, 0x5f, 0x41, 0xd3, 0x13, 0x2e, 0x1a, 0x93, 0x18, 0x93,
+	0xae, 0xa8, 0x74, 0x1f, 0xaf, 0x2b, 0xb0, 0xcd, 0x99, 0xc7, 0x39, 0xe1, 0x06, 0xdc, 0x90, 0x3b,
+	0xa5, 0x36, 0xbf, 0x01, 0x79, 0xee, 0x72, 0x62, 0x4b, 0x67, 0x65, 0xb1, 0x9a, 0xa0, 0xcf, 0xa0,
+	0x21, 0x36, 0x25, 0x33, 0x3a, 0x76, 0x5c, 0x4b, 0x3a, 0x3f, 0xbb, 0xd1, 0x99, 0x35, 0x4d, 0x93,
+	0x53, 0x2b, 0x51, 0x3e, 0xb7, 0x55, 0xf9, 0xfc, 0xaa, 0xf2, 0xff, 0xce, 0x00, 0xbc, 0x16, 0x6a,
+	0x0c, 0x85, 0x1a, 0xe8, 0xd7, 0x70, 0x63, 0x12, 0x1d, 0x7f, 0x5d, 0xe3, 0x27, 0xeb, 0x1a, 0x6f,
+	0x35, 0x1c, 0x3e, 0x98, 0xac, 0x83, 0xa8, 0x0f, 0x20, 0xb7, 0x18, 0x5b, 0x84, 0x13, 0xa9, 0x75,
+	0xa5, 0xfb, 0x70, 0x83, 0x1d, 0xe3, 0x13, 0xa9, 0xe1, 0x09, 0xe1, 0x04, 0x97, 0xbd, 0x68, 0x88,
+	0xfa, 0x50, 0x23, 0x21, 0xbf, 0x70, 0x7d, 0xf6, 0x5e, 0x9d, 0x2f, 0x2b, 0x77, 0xba, 0xbb, 0xbe,
+	0xd3, 0x90, 0xcd, 0x1c, 0x6a, 0xbd, 0xa4, 0x41, 0x40, 0x66, 0x14, 0x2f, 0xaf, 0x6a, 0x2f, 0xa0,
+	0x1c, 0x6f, 0x8f, 0xea, 0x90, 0xd1, 0xb7, 0xac, 0x8c, 0x33, 0xcc, 0xda, 0x76, 0x09, 0x32, 0xdb,
+	0x2e, 0x41, 0x0b, 0x8a, 0x53, 0xd7, 0xe1, 0xd4, 0xe1, 0xca, 0x5b, 0x38, 0x9a, 0x22, 0x04, 0xb9,
+	0x0b, 0x12, 0x5c, 0xc8, 0xfb, 0x53, 0xc5, 0x72, 0x6c, 0x7e, 0x0b, 0x45, 0xf9, 0xe9, 0x81, 0xb5,
+	0xf6, 0xe1, 0x35, 0xe5, 0x32, 0x1f, 0xa2, 0x9c, 0xf9, 0x1b, 0x03, 0xaa, 0xca, 0x8e, 0xe1, 0x7c,
+	0x4e, 0xfc, 0xc5, 0xda, 0x77, 0x6e, 0x47, 0xbe, 0x90, 0x29, 0x40, 0xe9, 0xa5, 0x6c, 0xbc, 0x2b,
+	0x09, 0x64, 0xb7, 0xe9, 0xbf, 0x49, 0xcb, 0xef, 0x72, 0x50, 0x97, 0x67, 0xc0, 0x94, 0xfb, 0x8c,
+	0xbe, 0x25, 0xf6, 0x0f, 0x1e, 0x61, 0x83, 0x0d, 0x11, 0xf6, 0x78, 0x4b, 0x84, 0xc5, 0xa7, 0xfa,
+	0x41, 0xa3, 0xec, 0xaf, 0xc6, 0xae, 0x30, 0xbb, 0xc6, 0x0b, 0x37, 0xa1, 0xe0, 0x9e, 0x9f, 0x07,
+	0x94, 0x6b, 0xc3, 0xeb, 0x19, 0xea, 0x43, 0xc9, 0xf3, 0x99, 0xeb, 0x33, 0xbe, 0x90, 0x16, 0xaf,
+	0x77, 0x1f, 0x5d, 0xaf, 0xa4, 0x5e, 0x80, 0xe3, 0xa5, 0xa8, 0x0d, 0x25, 0x8b, 0x12, 0xcb, 0x66,
+	0x8e, 0xca, 0x0d, 0x59, 0x1c, 0xcf, 0x45, 0xe2, 0xf0, 0x98, 0x47, 0xc5, 0xd8, 0x92, 0x39, 0xbb,
+	0x84, 0x13, 0xc0, 0xec, 0x42, 0x29, 0xda, 0x0f, 0x01, 0x14, 0x4e, 0x5f, 0xe1, 0x97, 0xbd, 0x5f,
+	0x34, 0xf7, 0x50, 0x03, 0x2a, 0x83, 0xd3, 0x51, 0x1f, 0xf7, 0x5e, 0x8c, 0x06, 0xbf, 0xec, 0x37,
+	0x0d, 0x54, 0x86, 0xfc, 0x71, 0x6f, 0xf4, 0xe2, 0xcb, 0x66, 0xc6, 0xfc, 0x9d, 0x01, 0x37, 0x96,
+	0xcf, 0x34, 0xe4, 0x3e, 0x25, 0xf3, 0x15, 0x23, 0x18, 0xab, 0x46, 0x48, 0x5d, 0xad, 0xcc, 0xf2,
+	0xd5, 0xea, 0x40, 0x95, 0x3a, 0xd6, 0xd8, 0x3d, 0x1f, 0xfb, 0xc4, 0x99, 0xa9, 0x42, 0x56, 0xc2,
+	0x40, 0x1d, 0xeb, 0xd5, 0x39, 0x16, 0xc8, 0xc6, 0xb0, 0xb4, 0xa0, 0xa2, 0x1c, 0x42, 0x6d, 0xca,
+	0xe9, 0xf5, 0x17, 0xf0, 0x83, 0xfc, 0x6e, 0x1e, 0x01, 0x4a, 0x7d, 0x25, 0xba, 0x85, 0x2d, 0x28,
+	0xce, 0x15, 0x5f, 0x7f, 0x31, 0x9a, 0x9a, 0x7f, 0x30, 0x60, 0x3f, 0x49, 0x7c, 0xd7, 0xf2, 0xd1,
+	0x03, 0xa8, 0xcb, 0x7a, 0x31, 0xf6, 0xe9, 0x94, 0xb2, 0xb7, 0xd4, 0xd2, 0xd1, 0x53, 0x93, 0x28,
+	0xd6, 0xa0, 0x70, 0xa3, 0x25, 0x8a, 0xfe, 0x94, 0xf0, 0xc8, 0x3e, 0x09, 0x80, 0x7e, 0x0a, 0x45,
+	0xb9, 0xdc, 0xe3, 0xd2, 0x42, 0x95, 0xee, 0x9d, 0x0d, 0x5a, 0x8a, 0x31, 0x56, 0x2c, 0x1c, 0xd1,
+	0xcd, 0xdf, 0x1b, 0x50, 0x4d, 0x4b, 0x44, 0x43, 0xa1, 0x9c, 0x18, 0x1b, 0xb3, 0xe8, 0xe9, 0x14,
+	0x17, 0x39, 0x21, 0x93, 0x38, 0x41, 0x60, 0xa9, 0xde, 0x43, 0x8e, 0xd1, 0x43, 0x68, 0x04, 0xd2,
+	0xa4, 0xab, 0x4d, 0x47, 0x4d, 0xc1, 0x51, 0xae, 0xd9, 0x5d, 0xd3, 0xce, 0xa1, 0x96, 0xd8, 0xb1,
+	0x37, 0xbd, 0x4c, 0x5d, 0x22, 0x63, 0xe9, 0x12, 0x7d, 0x0e, 0xc5, 0x40, 0x99, 0x59, 0x27, 0x8a,
+	0xfb, 0xbb, 0x4a, 0x91, 0xf6, 0x08, 0x8e, 0xd6, 0x98, 0x00, 0xa5, 0x21, 0x27, 0x3c, 0xc0, 0xf4,
+	0x8d, 0xf9, 0x47, 0x03, 0x2a, 0x62, 0x12, 0xb9, 0xed, 0x36, 0x40, 0x18, 0x50, 0x6b, 0x1c, 0x78,
+	0x64, 0x1a, 0x47, 0xb4, 0x40, 0x86, 0x02, 0x40, 0x9f, 0x40, 0x83, 0xbc, 0x25, 0xcc, 0x26, 0x13,
+	0x9b, 0x6a, 0x8e, 0x72, 0x5e, 0x3d, 0x86, 0x15, 0xf1, 0x01, 0xd4, 0xe5, 0x3e, 0x71, 0xaa, 0xd3,
+	0xf6, 0xaa, 0x09, 0x34, 0x4e, 0x8a, 0xe8, 0x29, 0x1c, 0x24, 0xfb, 0x25, 0x5c, 0x65, 0x3c, 0x14,
+	0x8b, 0xe2, 0x05, 0x66, 0x03, 0x6a, 0xa3, 0x0b, 0xdf, 0x0d, 0x67, 0x17, 0x5e, 0xc8, 0x85, 0x02,
+	0xdf, 0x65, 0x60, 0x3f, 0x41, 0x22, 0x35, 0x1e, 0x40, 0xfd, 0x1d, 0x73, 0x2c, 0xf7, 0x9d, 0xf0,
+	0x85, 0xeb, 0x58, 0x81, 0x56, 0xa5, 0xa6, 0xd0, 0xa1, 0x02, 0x45, 0x03, 0xc8, 0x9c, 0x99, 0x4f,
+	0x83, 0x60, 0x3c, 0x59, 0x70, 0x1a, 0x68, 0x65, 0xaa, 0x1a, 0x3c, 0x16, 0x18, 0xba, 0x07, 0x55,
+	0x9a, 0xe6, 0x28, 0x45, 0x2a, 0x34, 0x45, 0x69, 0x41, 0x31, 0xf4, 0x6c, 0x97, 0x58, 0x81, 0x3e,
+	0x7a, 0x34, 0x15, 0x07, 0x39, 0x27, 0xcc, 0x16, 0x91, 0xa1, 0x09, 0x2a, 0x5d, 0xd5, 0x14, 0x7a,
+	0xa6, 0x69, 0x22, 0xd8, 0xdd, 0x77, 0x8e, 0x62, 0x14, 0x94, 0xd5, 0x63, 0x00, 0x3d, 0x82, 0xa6,
+	0xde, 0x24, 0x21, 0xa9, 0x3e, 0xb2, 0xa1, 0xf0, 0x93, 0x08, 0x36, 0xff, 0x96, 0x85, 0xb2, 0x68,
+	0xab, 0x46, 0xc4, 0xb6, 0x17, 0x1f, 0xd2, 0x8b, 0x7f, 0x02, 0xc5, 0xa8, 0x79, 0xdb, 0xdc, 0x89,
+	0x17, 0x1c, 0xd5, 0xb5, 0x3d, 0x87, 0xff, 0xf3, 0xa8, 0xcf, 0x5c, 0x6b, 0x1c, 0x70, 0xe2, 0xf3,
+	0xd5, 0x4a, 0x8b, 0x94, 0x70, 0x28, 0x64, 0x51, 0xf8, 0xff, 0x18, 0x0e, 0xf4, 0x12, 0x91, 0xfc,
+	0x56, 0xae, 0x4a, 0x53, 0x89, 0xfa, 0x4e, 0x7c, 0x5b, 0x4c, 0xa8, 0x11, 0x3e, 0xf6, 0x69, 0xc0,
+	0xc7, 0xaa, 0xdb, 0x14, 0xa6, 0x33, 0x70, 0x85, 0x70, 0x4c, 0x03, 0x3e, 0x12, 0x10, 0xfa, 0x08,
+	0xca, 0x5e, 0x18, 0xc9, 0x95, 0xe1, 0x4a, 0x5e, 0x98, 0x08, 0x67, 0x34, 0x12, 0x2a, 0x83, 0x95,
+	0x66, 0x54, 0x0b, 0x1f, 0x42, 0x43, 0x08, 0x49, 0x68, 0xb1, 0x88, 0x52, 0x52, 0xae, 0x99, 0x51,
+	0xde, 0x13, 0xa8, 0xe2, 0x1d, 0x42, 0x53, 0xf0, 0x7c, 0xea, 0x11, 0xe6, 0x6b, 0x62, 0x59, 0xc5,
+	0xfc, 0x8c, 0x72, 0x2c, 0xe1, 0x98, 0xe9, 0x85, 0x2b, 0x4c, 0x50, 0x4c, 0x19, 0xac, 0x09, 0x33,
+	0xee, 0x78, 0x2b, 0x5b, 0x3b, 0xde, 0xea, 0x6a, 0x76, 0x38, 0x80, 0xfd, 0xd8, 0xb1, 0x98, 0x06,
+	0x9e, 0xeb, 0x04, 0xd4, 0x1c, 0x42, 0x0d, 0x53, 0x4e, 0x98, 0x83, 0xe9, 0x9b, 0x90, 0x06, 0x1c,
+	0x3d, 0x86, 0x7d, 0xf9, 0xb4, 0x58, 0xea, 0x7d, 0x54, 0xec, 0x37, 0x22, 0x41, 0x64, 0xdf, 0x9b,
+	0x50, 0x38, 0x67, 0x36, 0xa7, 0xbe, 0xce, 0x6f, 0x7a, 0x66, 0x3e, 0x86, 0x7a, 0xb4, 0xa9, 0xfa,
+	0x8c, 0x88, 0x6f, 0xee, 0x93, 0xe0, 0x82, 0x5a, 0x7a, 0xaf, 0x68, 0x6a, 0x7e, 0x0b, 0xb5, 0xa5,
+	0x62, 0x22, 0xd2, 0xa3, 0xec, 0x60, 0x0c, 0x95, 0x32, 0xc5, 0x78, 0x59, 0xb1, 0xcc, 0x8a, 0x62,
+	0xb2, 0x88, 0x86, 0x13, 0x9b, 0x4d, 0xc7, 0x97, 0x74, 0xa1, 0x7b, 0xd0, 0xb2, 0x42, 0xbe, 0xa2,
+	0x0b, 0xb3, 0x0e, 0xd5, 0x13, 0x12, 0x5c, 0x4c, 0x5c, 0xe2, 0x5b, 0xe2, 0xc2, 0xff, 0x33, 0x07,
+	0xf5, 0x18, 0x90, 0x79, 0x0c, 0xfd, 0x7f, 0x12, 0xb3, 0x2a, 0x81, 0x47, 0x31, 0xfa, 0x08, 0x9a,
+	0x52, 0x30, 0x75, 0x1d, 0x87, 0xca, 0x37, 0x59, 0x74, 0xc5, 0x1b, 0x02, 0x7f, 0x91, 0xc0, 0xe8,
+	0x09, 0xec, 0x4f, 0x5c, 0x97, 0x07, 0xdc, 0x27, 0xde, 0x98, 0x58, 0x96, 0xb8, 0xdc, 0xf2, 0x30,
+	0x65, 0xdc, 0x8c, 0x05, 0x3d, 0x85, 0x8b, 0x7d, 0x99, 0xc3, 0xa9, 0xef, 0x10, 0x3b, 0xe6, 0xe6,
+	0x24, 0xb7, 0x11, 0xe1, 0x29, 0x2a, 0xbd, 0x5a, 0xa1, 0xaa, 0x67, 0x66, 0x83, 0x5e, 0x2d, 0x53,
+	0x3f, 0x85, 0x7c, 0x20, 0xf4, 0x91, 0x71, 0x5c, 0xe9, 0xde, 0xde, 0x54, 0xd1, 0xe2, 0x4c, 0x8d,
+	0x15, 0x17, 0xdd, 0x01, 0x48, 0xb4, 0x93, 0x41, 0x5e, 0xc2, 0x29, 0x04, 0x3d, 0x87, 0x42, 0xe8,
+	0x89, 0x07, 0xbc, 0x8c, 0xee, 0x4a, 0xf7, 0xd6, 0x91, 0x7a, 0xdd, 0x1f, 0x45, 0xaf, 0xfb, 0xa3,
+	0x13, 0xfd, 0xfa, 0xc7, 0x9a, 0x88, 0xbe, 0x84, 0x9a, 0xe3, 0x72, 0x76, 0xce, 0x54, 0x6b, 0x1a,
+	0xb4, 0xca, 0x9d, 0xec, 0x61, 0xa5, 0x6b, 0xae, 0x9f, 0x47, 0x04, 0xe4, 0x69, 0x8a, 0x8a, 0x97,
+	0x17, 0xa2, 0x9f, 0x43, 0xd1, 0x23, 0x0b, 0x37, 0xe4, 0x41, 0x0b, 0xe4, 0x1e, 0x9d, 0x8d, 0x6f,
+	0x4f, 0x37, 0xe4, 0xfd, 0x80, 0xb3, 0x39, 0xe1, 0x14, 0x47, 0x0b, 0xd0, 0x0b, 0xa8, 0xbc, 0x09,
+	0x89, 0x4f, 0x1c, 0x2e, 0x1b, 0xb9, 0x8a, 0x5c, 0xbf, 0xe1, 0xf1, 0xfd, 0x75, 0x42, 0x3a, 0x61,
+	0xc1, 0x25, 0x4e, 0xaf, 0x42, 0x9f, 0x41, 0x3e, 0x94, 0x3d, 0x48, 0x75, 0xdb, 0xe7, 0x87, 0x51,
+	0xee, 0x3b, 0x13, 0x3c, 0xac, 0xe8, 0xe6, 0xdf, 0x33, 0x50, 0x5f, 0x96, 0x7c, 0x48, 0x2e, 0x5d,
+	0x2e, 0xa6, 0x99, 0xd5, 0x62, 0x7a, 0x13, 0x0a, 0xea, 0x38, 0x51, 0x8f, 0xac, 0x66, 0xa2, 0x2a,
+	0x89, 0x3c, 0xb2, 0x5a, 0x0e, 0xab, 0x5e, 0xc8, 0x93, 0xca, 0x79, 0x1f, 0x44, 0x9e, 0x4a, 0x91,
+	0x54, 0x5d, 0xa9, 0xce, 0x68, 0x8a, 0x74, 0x04, 0x07, 0x49, 0x8e, 0x4b, 0xa8, 0x2a, 0x4f, 0xee,
+	0x47, 0x79, 0x2e, 0xe1, 0x3f, 0x83, 0x1b, 0xa9, 0x5c, 0x97, 0x2c, 0x50, 0xb9, 0x13, 0xc5, 0xf9,
+	0x6e, 0x69, 0x85, 0x17, 0x6e, 0x58, 0xa1, 0x52, 0x29, 0xf2, 0xc2, 0xd5, 0x15, 0xe6, 0xe7, 0xd0,
+	0x58, 0x71, 0x99, 0xcc, 0x19, 0x2c, 0xb8, 0xd4, 0x97, 0x57, 0x8e, 0x53, 0xc6, 0xc9, 0xa4, 0x8d,
+	0x63, 0xfe, 0xcb, 0x80, 0xfa, 0x72, 0xc8, 0x7c, 0xa0, 0x67, 0xc4, 0x57, 0x52, 0x9e, 0x31, 0x70,
+	0x59, 0x20, 0xb1, 0x67, 0x54, 0x79, 0x97, 0x9e, 0x31, 0xb0, 0x9e, 0x09, 0xa3, 0x6b, 0x4d, 0xb5,
+	0x38, 0x27, 0xc5, 0x55, 0x05, 0xf6, 0x15, 0xe9, 0x1e, 0x54, 0x95, 0xc1, 0x35, 0x27, 0xaa, 0x5a,
+	0x02, 0xd3, 0x14, 0xd1, 0x57, 0x52, 0x5b, 0xbd, 0x4e, 0x0c, 0x2c, 0xc7, 0x52, 0x61, 0xa9, 0x97,
+	0xb4, 0xb6, 0x81, 0xf5, 0xcc, 0xfc, 0x93, 0x01, 0xcd, 0xd5, 0x7b, 0x96, 0x6a, 0xfd, 0xb3, 0xb2,
+	0xf5, 0x47, 0x90, 0xe3, 0x0b, 0x4f, 0x69, 0x52, 0xc6, 0x72, 0x2c, 0x7f, 0xd2, 0x30, 0x6e, 0x53,
+	0x9d, 0xc5, 0xd4, 0x24, 0xdd, 0x97, 0xe7, 0x96, 0xfb, 0xf2, 0x9f, 0x40, 0x51, 0xff, 0x95, 0x92,
+	0x47, 0xae, 0x74, 0xdb, 0x6b, 0xa9, 0x62, 0x14, 0xfd, 0x08, 0xc4, 0x11, 0x55, 0x7c, 0xd9, 0xa7,
+	0x24, 0x7a, 0x68, 0xc9, 0xf1, 0x63, 0x0c, 0x8d, 0x95, 0x5f, 0x62, 0xa8, 0x08, 0xd9, 0xd7, 0x67,
+	0xa3, 0xe6, 0x9e, 0x18, 0x7c, 0xd1, 0x1f, 0x35, 0x0d, 0x54, 0x83, 0xf2, 0x17, 0xfd, 0xd1, 0xb8,
+	0x77, 0x76, 0x32, 0x18, 0x35, 0x33, 0xa8, 0x0e, 0x20, 0xa6, 0xb8, 0xff, 0xba, 0x37, 0xc0, 0xcd,
+	0xac, 0x98, 0xbf, 0x3e, 0x8b, 0xe7, 0xb9, 0xee, 0x9f, 0x0b, 0xd0, 0x4c, 0x7a, 0x5a, 0x2c, 0x2f,
+	0x2f, 0x3a, 0x81, 0xbc, 0xc4, 0xd0, 0xad, 0x2d, 0x0d, 0xf0, 0xc0, 0x6a, 0xdf, 0xd9, 0x22, 0xd2,
+	0x79, 0xd4, 0xdc, 0x43, 0xdf, 0x40, 0x49, 0x3f, 0xec, 0x28, 0xea, 0x5c, 0xf7, 0x1a, 0x6d, 0x3f,
+	0xbc, 0x8e, 0xa1, 0xde, 0x86, 0xe6, 0xde, 0xa1, 0xf1, 0xcc, 0x40, 0xa7, 0x90, 0x97, 0x07, 0x46,
+	0x1f, 0xef, 0x6a, 0xd1, 0xdb, 0xff, 0x4b, 0x03, 0x2f, 0x76, 0x44, 0x67, 0x50, 0x57, 0x06, 0xa0,
+	0x41, 0x38, 0x17, 0xad, 0xf1, 0x35, 0x1b, 0xdf, 0xdd, 0x25, 0xed, 0x4d, 0x2f, 0xf5, 0x31, 0x5f,
+	0x41, 0x41, 0x3f, 0x2a, 0x6f, 0x6f, 0x59, 0xa0, 0xc4, 0xed, 0x1f, 0xed, 0x14, 0x27, 0x36, 0x3d,
+	0x11, 0x7a, 0x8b, 0xfa, 0xd4, 0xde, 0x5c, 0xc5, 0xc4, 0xe3, 0xa3, 0xbd, 0xbb, 0xc2, 0x99, 0x7b,
+	0xe8, 0x6b, 0x28, 0xc7, 0xa5, 0x1e, 0x6d, 0x70, 0x64, 0xba, 0x31, 0x68, 0x77, 0x76, 0xc8, 0xe5,
+	0x27, 0xcd, 0xbd, 0x67, 0x06, 0x1a, 0x01, 0x24, 0xcf, 0x05, 0xb4, 0xc1, 0x3c, 0x4b, 0xcf, 0x8b,
+	0xf6, 0xfd, 0x5d, 0x84, 0xe4, 0xa0, 0x5f, 0x41, 0x5e, 0x75, 0xdc, 0x1f, 0x6d, 0x2e, 0x92, 0x52,
+	0xd8, 0xbe, 0xbf, 0x43, 0x18, 0xb7, 0x74, 0x7b, 0xe8, 0x25, 0x14, 0x54, 0xff, 0xb5, 0xe9, 0x78,
+	0x4b, 0xed, 0x5e, 0xbb, 0xb3, 0x9d, 0x10, 0x6d, 0x77, 0x9c, 0xfb, 0x26, 0xe3, 0x4d, 0x26, 0x05,
+	0x79, 0x89, 0x3f, 0xfd, 0xef, 0x00, 0x5f, 0xc2, 0x37, 0x20, 0x2d, 0x18, 0x00, 0x00,
 }
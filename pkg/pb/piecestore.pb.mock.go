@@ -80,6 +80,24 @@ func (mr *MockPieceStoreRoutesClientMockRecorder) Piece(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Piece", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).Piece), varargs...)
 }
 
+// Retain mocks base method
+func (m *MockPieceStoreRoutesClient) Retain(arg0 context.Context, arg1 *RetainRequest, arg2 ...grpc.CallOption) (*RetainResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Retain", varargs...)
+	ret0, _ := ret[0].(*RetainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retain indicates an expected call of Retain
+func (mr *MockPieceStoreRoutesClientMockRecorder) Retain(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retain", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).Retain), varargs...)
+}
+
 // Retrieve mocks base method
 func (m *MockPieceStoreRoutesClient) Retrieve(arg0 context.Context, arg1 ...grpc.CallOption) (PieceStoreRoutes_RetrieveClient, error) {
 	varargs := []interface{}{arg0}
@@ -134,6 +152,60 @@ func (mr *MockPieceStoreRoutesClientMockRecorder) Store(arg0 interface{}, arg1 .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).Store), varargs...)
 }
 
+// StoreResumable mocks base method
+func (m *MockPieceStoreRoutesClient) StoreResumable(arg0 context.Context, arg1 ...grpc.CallOption) (PieceStoreRoutes_StoreResumableClient, error) {
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StoreResumable", varargs...)
+	ret0, _ := ret[0].(PieceStoreRoutes_StoreResumableClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreResumable indicates an expected call of StoreResumable
+func (mr *MockPieceStoreRoutesClientMockRecorder) StoreResumable(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreResumable", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).StoreResumable), varargs...)
+}
+
+// Tally mocks base method
+func (m *MockPieceStoreRoutesClient) Tally(arg0 context.Context, arg1 *NodeTally, arg2 ...grpc.CallOption) (*NodeTallyResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Tally", varargs...)
+	ret0, _ := ret[0].(*NodeTallyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Tally indicates an expected call of Tally
+func (mr *MockPieceStoreRoutesClientMockRecorder) Tally(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tally", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).Tally), varargs...)
+}
+
+// Throughput mocks base method
+func (m *MockPieceStoreRoutesClient) Throughput(arg0 context.Context, arg1 *ThroughputReq, arg2 ...grpc.CallOption) (*ThroughputSummary, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Throughput", varargs...)
+	ret0, _ := ret[0].(*ThroughputSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Throughput indicates an expected call of Throughput
+func (mr *MockPieceStoreRoutesClientMockRecorder) Throughput(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Throughput", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).Throughput), varargs...)
+}
+
 // MockPieceStoreRoutes_RetrieveClient is a mock of PieceStoreRoutes_RetrieveClient interface
 type MockPieceStoreRoutes_RetrieveClient struct {
 	ctrl     *gomock.Controller
@@ -42,7 +42,7 @@ func (x RedundancyScheme_SchemeType) String() string {
 	return proto.EnumName(RedundancyScheme_SchemeType_name, int32(x))
 }
 func (RedundancyScheme_SchemeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{0, 0}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{0, 0}
 }
 
 type Pointer_DataType int32
@@ -65,7 +65,7 @@ func (x Pointer_DataType) String() string {
 	return proto.EnumName(Pointer_DataType_name, int32(x))
 }
 func (Pointer_DataType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{3, 0}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{3, 0}
 }
 
 type RedundancyScheme struct {
@@ -85,7 +85,7 @@ func (m *RedundancyScheme) Reset()         { *m = RedundancyScheme{} }
 func (m *RedundancyScheme) String() string { return proto.CompactTextString(m) }
 func (*RedundancyScheme) ProtoMessage()    {}
 func (*RedundancyScheme) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{0}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{0}
 }
 func (m *RedundancyScheme) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RedundancyScheme.Unmarshal(m, b)
@@ -159,7 +159,7 @@ func (m *RemotePiece) Reset()         { *m = RemotePiece{} }
 func (m *RemotePiece) String() string { return proto.CompactTextString(m) }
 func (*RemotePiece) ProtoMessage()    {}
 func (*RemotePiece) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{1}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{1}
 }
 func (m *RemotePiece) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemotePiece.Unmarshal(m, b)
@@ -201,7 +201,7 @@ func (m *RemoteSegment) Reset()         { *m = RemoteSegment{} }
 func (m *RemoteSegment) String() string { return proto.CompactTextString(m) }
 func (*RemoteSegment) ProtoMessage()    {}
 func (*RemoteSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{2}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{2}
 }
 func (m *RemoteSegment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteSegment.Unmarshal(m, b)
@@ -266,7 +266,7 @@ func (m *Pointer) Reset()         { *m = Pointer{} }
 func (m *Pointer) String() string { return proto.CompactTextString(m) }
 func (*Pointer) ProtoMessage()    {}
 func (*Pointer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{3}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{3}
 }
 func (m *Pointer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Pointer.Unmarshal(m, b)
@@ -348,7 +348,7 @@ func (m *PutRequest) Reset()         { *m = PutRequest{} }
 func (m *PutRequest) String() string { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()    {}
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{4}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{4}
 }
 func (m *PutRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutRequest.Unmarshal(m, b)
@@ -394,7 +394,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{5}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{5}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
@@ -423,22 +423,27 @@ func (m *GetRequest) GetPath() string {
 
 // ListRequest is a request message for the List rpc call
 type ListRequest struct {
-	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	StartAfter           string   `protobuf:"bytes,2,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
-	EndBefore            string   `protobuf:"bytes,3,opt,name=end_before,json=endBefore,proto3" json:"end_before,omitempty"`
-	Recursive            bool     `protobuf:"varint,4,opt,name=recursive,proto3" json:"recursive,omitempty"`
-	Limit                int32    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	MetaFlags            uint32   `protobuf:"fixed32,6,opt,name=meta_flags,json=metaFlags,proto3" json:"meta_flags,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Prefix     string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	StartAfter string `protobuf:"bytes,2,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	EndBefore  string `protobuf:"bytes,3,opt,name=end_before,json=endBefore,proto3" json:"end_before,omitempty"`
+	Recursive  bool   `protobuf:"varint,4,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	Limit      int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	MetaFlags  uint32 `protobuf:"fixed32,6,opt,name=meta_flags,json=metaFlags,proto3" json:"meta_flags,omitempty"`
+	// filters evaluated over the unencrypted pointer metadata, zero values are ignored
+	MinSize              int64                `protobuf:"varint,7,opt,name=min_size,json=minSize,proto3" json:"min_size,omitempty"`
+	MaxSize              int64                `protobuf:"varint,8,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	ModifiedAfter        *timestamp.Timestamp `protobuf:"bytes,9,opt,name=modified_after,json=modifiedAfter,proto3" json:"modified_after,omitempty"`
+	ModifiedBefore       *timestamp.Timestamp `protobuf:"bytes,10,opt,name=modified_before,json=modifiedBefore,proto3" json:"modified_before,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *ListRequest) Reset()         { *m = ListRequest{} }
 func (m *ListRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()    {}
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{6}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{6}
 }
 func (m *ListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
@@ -500,6 +505,34 @@ func (m *ListRequest) GetMetaFlags() uint32 {
 	return 0
 }
 
+func (m *ListRequest) GetMinSize() int64 {
+	if m != nil {
+		return m.MinSize
+	}
+	return 0
+}
+
+func (m *ListRequest) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *ListRequest) GetModifiedAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedAfter
+	}
+	return nil
+}
+
+func (m *ListRequest) GetModifiedBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.ModifiedBefore
+	}
+	return nil
+}
+
 // PutResponse is a response message for the Put rpc call
 type PutResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -511,7 +544,7 @@ func (m *PutResponse) Reset()         { *m = PutResponse{} }
 func (m *PutResponse) String() string { return proto.CompactTextString(m) }
 func (*PutResponse) ProtoMessage()    {}
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{7}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{7}
 }
 func (m *PutResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutResponse.Unmarshal(m, b)
@@ -546,7 +579,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{8}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{8}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
@@ -596,18 +629,21 @@ func (m *GetResponse) GetAuthorization() *SignedMessage {
 
 // ListResponse is a response message for the List rpc call
 type ListResponse struct {
-	Items                []*ListResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	More                 bool                 `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Items []*ListResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	More  bool                 `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	// cursor continues a listing, which has more items, as start_after of the
+	// next request or as end_before when listing backwards
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListResponse) Reset()         { *m = ListResponse{} }
 func (m *ListResponse) String() string { return proto.CompactTextString(m) }
 func (*ListResponse) ProtoMessage()    {}
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{9}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{9}
 }
 func (m *ListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
@@ -641,6 +677,13 @@ func (m *ListResponse) GetMore() bool {
 	return false
 }
 
+func (m *ListResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type ListResponse_Item struct {
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	Pointer              *Pointer `protobuf:"bytes,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
@@ -654,7 +697,7 @@ func (m *ListResponse_Item) Reset()         { *m = ListResponse_Item{} }
 func (m *ListResponse_Item) String() string { return proto.CompactTextString(m) }
 func (*ListResponse_Item) ProtoMessage()    {}
 func (*ListResponse_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{9, 0}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{9, 0}
 }
 func (m *ListResponse_Item) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse_Item.Unmarshal(m, b)
@@ -706,7 +749,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{10}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{10}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
@@ -744,7 +787,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{11}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{11}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
@@ -764,6 +807,139 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
+// DeletePrefixRequest is a request message for the DeletePrefix rpc call
+type DeletePrefixRequest struct {
+	// prefix is the bucket followed by the encrypted path prefix, without the segment index
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// whole_bucket has to be set to delete every object of a bucket, when the prefix is only the bucket
+	WholeBucket bool `protobuf:"varint,2,opt,name=whole_bucket,json=wholeBucket,proto3" json:"whole_bucket,omitempty"`
+	// limit is the maximum number of segments deleted by the request, 0 uses the default
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePrefixRequest) Reset()         { *m = DeletePrefixRequest{} }
+func (m *DeletePrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePrefixRequest) ProtoMessage()    {}
+func (*DeletePrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{12}
+}
+func (m *DeletePrefixRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePrefixRequest.Unmarshal(m, b)
+}
+func (m *DeletePrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePrefixRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeletePrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePrefixRequest.Merge(dst, src)
+}
+func (m *DeletePrefixRequest) XXX_Size() int {
+	return xxx_messageInfo_DeletePrefixRequest.Size(m)
+}
+func (m *DeletePrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePrefixRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePrefixRequest proto.InternalMessageInfo
+
+func (m *DeletePrefixRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *DeletePrefixRequest) GetWholeBucket() bool {
+	if m != nil {
+		return m.WholeBucket
+	}
+	return false
+}
+
+func (m *DeletePrefixRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// DeletePrefixResponse is a response message for the DeletePrefix rpc call
+type DeletePrefixResponse struct {
+	DeletedObjects  int64 `protobuf:"varint,1,opt,name=deleted_objects,json=deletedObjects,proto3" json:"deleted_objects,omitempty"`
+	DeletedSegments int64 `protobuf:"varint,2,opt,name=deleted_segments,json=deletedSegments,proto3" json:"deleted_segments,omitempty"`
+	// remote_pointers are the deleted pointers of remote segments, whose pieces
+	// have to be deleted from the storage nodes
+	RemotePointers []*Pointer `protobuf:"bytes,3,rep,name=remote_pointers,json=remotePointers,proto3" json:"remote_pointers,omitempty"`
+	// more is true, when the limit was reached before every segment under the prefix was deleted
+	More bool `protobuf:"varint,4,opt,name=more,proto3" json:"more,omitempty"`
+	// authorization authorizes the deletion of the pieces on the storage nodes
+	Authorization        *SignedMessage `protobuf:"bytes,5,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DeletePrefixResponse) Reset()         { *m = DeletePrefixResponse{} }
+func (m *DeletePrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePrefixResponse) ProtoMessage()    {}
+func (*DeletePrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{13}
+}
+func (m *DeletePrefixResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePrefixResponse.Unmarshal(m, b)
+}
+func (m *DeletePrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePrefixResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeletePrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePrefixResponse.Merge(dst, src)
+}
+func (m *DeletePrefixResponse) XXX_Size() int {
+	return xxx_messageInfo_DeletePrefixResponse.Size(m)
+}
+func (m *DeletePrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePrefixResponse proto.InternalMessageInfo
+
+func (m *DeletePrefixResponse) GetDeletedObjects() int64 {
+	if m != nil {
+		return m.DeletedObjects
+	}
+	return 0
+}
+
+func (m *DeletePrefixResponse) GetDeletedSegments() int64 {
+	if m != nil {
+		return m.DeletedSegments
+	}
+	return 0
+}
+
+func (m *DeletePrefixResponse) GetRemotePointers() []*Pointer {
+	if m != nil {
+		return m.RemotePointers
+	}
+	return nil
+}
+
+func (m *DeletePrefixResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+func (m *DeletePrefixResponse) GetAuthorization() *SignedMessage {
+	if m != nil {
+		return m.Authorization
+	}
+	return nil
+}
+
 // IterateRequest is a request message for the Iterate rpc call
 type IterateRequest struct {
 	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
@@ -779,7 +955,7 @@ func (m *IterateRequest) Reset()         { *m = IterateRequest{} }
 func (m *IterateRequest) String() string { return proto.CompactTextString(m) }
 func (*IterateRequest) ProtoMessage()    {}
 func (*IterateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{12}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{14}
 }
 func (m *IterateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IterateRequest.Unmarshal(m, b)
@@ -838,7 +1014,7 @@ func (m *PayerBandwidthAllocationRequest) Reset()         { *m = PayerBandwidthA
 func (m *PayerBandwidthAllocationRequest) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocationRequest) ProtoMessage()    {}
 func (*PayerBandwidthAllocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{13}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{15}
 }
 func (m *PayerBandwidthAllocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocationRequest.Unmarshal(m, b)
@@ -876,7 +1052,7 @@ func (m *PayerBandwidthAllocationResponse) Reset()         { *m = PayerBandwidth
 func (m *PayerBandwidthAllocationResponse) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocationResponse) ProtoMessage()    {}
 func (*PayerBandwidthAllocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_afdf954a8fe5efcf, []int{14}
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{16}
 }
 func (m *PayerBandwidthAllocationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocationResponse.Unmarshal(m, b)
@@ -903,129 +1079,899 @@ func (m *PayerBandwidthAllocationResponse) GetPba() *PayerBandwidthAllocation {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*RedundancyScheme)(nil), "pointerdb.RedundancyScheme")
-	proto.RegisterType((*RemotePiece)(nil), "pointerdb.RemotePiece")
-	proto.RegisterType((*RemoteSegment)(nil), "pointerdb.RemoteSegment")
-	proto.RegisterType((*Pointer)(nil), "pointerdb.Pointer")
-	proto.RegisterType((*PutRequest)(nil), "pointerdb.PutRequest")
-	proto.RegisterType((*GetRequest)(nil), "pointerdb.GetRequest")
-	proto.RegisterType((*ListRequest)(nil), "pointerdb.ListRequest")
-	proto.RegisterType((*PutResponse)(nil), "pointerdb.PutResponse")
-	proto.RegisterType((*GetResponse)(nil), "pointerdb.GetResponse")
-	proto.RegisterType((*ListResponse)(nil), "pointerdb.ListResponse")
-	proto.RegisterType((*ListResponse_Item)(nil), "pointerdb.ListResponse.Item")
-	proto.RegisterType((*DeleteRequest)(nil), "pointerdb.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "pointerdb.DeleteResponse")
-	proto.RegisterType((*IterateRequest)(nil), "pointerdb.IterateRequest")
-	proto.RegisterType((*PayerBandwidthAllocationRequest)(nil), "pointerdb.PayerBandwidthAllocationRequest")
-	proto.RegisterType((*PayerBandwidthAllocationResponse)(nil), "pointerdb.PayerBandwidthAllocationResponse")
-	proto.RegisterEnum("pointerdb.RedundancyScheme_SchemeType", RedundancyScheme_SchemeType_name, RedundancyScheme_SchemeType_value)
-	proto.RegisterEnum("pointerdb.Pointer_DataType", Pointer_DataType_name, Pointer_DataType_value)
+// ProjectInfoRequest is a request message for the ProjectInfo rpc call
+type ProjectInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ProjectInfoRequest) Reset()         { *m = ProjectInfoRequest{} }
+func (m *ProjectInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectInfoRequest) ProtoMessage()    {}
+func (*ProjectInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{17}
+}
+func (m *ProjectInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProjectInfoRequest.Unmarshal(m, b)
+}
+func (m *ProjectInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProjectInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *ProjectInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectInfoRequest.Merge(dst, src)
+}
+func (m *ProjectInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_ProjectInfoRequest.Size(m)
+}
+func (m *ProjectInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectInfoRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ProjectInfoRequest proto.InternalMessageInfo
 
-// PointerDBClient is the client API for PointerDB service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PointerDBClient interface {
-	// Put formats and hands off a file path to be saved to boltdb
-	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
-	// Get formats and hands off a file path to get a small value from boltdb
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// List calls the bolt client's List function and returns all file paths
-	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
-	// Delete formats and hands off a file path to delete from boltdb
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
-	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
-	PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error)
+// ProjectInfoResponse is a response message for the ProjectInfo rpc call
+type ProjectInfoResponse struct {
+	// project_salt is used for deriving the root encryption key of the project
+	ProjectSalt          []byte   `protobuf:"bytes,1,opt,name=project_salt,json=projectSalt,proto3" json:"project_salt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type pointerDBClient struct {
-	cc *grpc.ClientConn
+func (m *ProjectInfoResponse) Reset()         { *m = ProjectInfoResponse{} }
+func (m *ProjectInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ProjectInfoResponse) ProtoMessage()    {}
+func (*ProjectInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{18}
 }
-
-func NewPointerDBClient(cc *grpc.ClientConn) PointerDBClient {
-	return &pointerDBClient{cc}
+func (m *ProjectInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProjectInfoResponse.Unmarshal(m, b)
+}
+func (m *ProjectInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProjectInfoResponse.Marshal(b, m, deterministic)
+}
+func (dst *ProjectInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectInfoResponse.Merge(dst, src)
+}
+func (m *ProjectInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_ProjectInfoResponse.Size(m)
+}
+func (m *ProjectInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectInfoResponse.DiscardUnknown(m)
 }
 
-func (c *pointerDBClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
-	out := new(PutResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Put", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProjectInfoResponse proto.InternalMessageInfo
+
+func (m *ProjectInfoResponse) GetProjectSalt() []byte {
+	if m != nil {
+		return m.ProjectSalt
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pointerDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+// SelectNodesRequest is a request message for the SelectNodes rpc call
+type SelectNodesRequest struct {
+	Redundancy    *RedundancyScheme `protobuf:"bytes,1,opt,name=redundancy,proto3" json:"redundancy,omitempty"`
+	SegmentSize   int64             `protobuf:"varint,2,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	ExcludedNodes []NodeID          `protobuf:"bytes,3,rep,name=excluded_nodes,json=excludedNodes,proto3,customtype=NodeID" json:"excluded_nodes,omitempty"`
+	// allowed_countries restricts the nodes to these ISO country codes, nodes with an unknown country are left out
+	AllowedCountries     []string `protobuf:"bytes,4,rep,name=allowed_countries,json=allowedCountries,proto3" json:"allowed_countries,omitempty"`
+	ExcludedCountries    []string `protobuf:"bytes,5,rep,name=excluded_countries,json=excludedCountries,proto3" json:"excluded_countries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SelectNodesRequest) Reset()         { *m = SelectNodesRequest{} }
+func (m *SelectNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectNodesRequest) ProtoMessage()    {}
+func (*SelectNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{19}
+}
+func (m *SelectNodesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectNodesRequest.Unmarshal(m, b)
+}
+func (m *SelectNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectNodesRequest.Marshal(b, m, deterministic)
+}
+func (dst *SelectNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectNodesRequest.Merge(dst, src)
+}
+func (m *SelectNodesRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectNodesRequest.Size(m)
+}
+func (m *SelectNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectNodesRequest proto.InternalMessageInfo
+
+func (m *SelectNodesRequest) GetRedundancy() *RedundancyScheme {
+	if m != nil {
+		return m.Redundancy
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pointerDBClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
-	out := new(ListResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SelectNodesRequest) GetSegmentSize() int64 {
+	if m != nil {
+		return m.SegmentSize
 	}
-	return out, nil
+	return 0
 }
 
-func (c *pointerDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SelectNodesRequest) GetAllowedCountries() []string {
+	if m != nil {
+		return m.AllowedCountries
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pointerDBClient) PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error) {
-	out := new(PayerBandwidthAllocationResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/PayerBandwidthAllocation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SelectNodesRequest) GetExcludedCountries() []string {
+	if m != nil {
+		return m.ExcludedCountries
 	}
-	return out, nil
+	return nil
 }
 
-// PointerDBServer is the server API for PointerDB service.
-type PointerDBServer interface {
-	// Put formats and hands off a file path to be saved to boltdb
-	Put(context.Context, *PutRequest) (*PutResponse, error)
-	// Get formats and hands off a file path to get a small value from boltdb
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// List calls the bolt client's List function and returns all file paths
-	List(context.Context, *ListRequest) (*ListResponse, error)
-	// Delete formats and hands off a file path to delete from boltdb
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
-	PayerBandwidthAllocation(context.Context, *PayerBandwidthAllocationRequest) (*PayerBandwidthAllocationResponse, error)
+// SelectNodesResponse is a response message for the SelectNodes rpc call
+type SelectNodesResponse struct {
+	Nodes                []*Node  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterPointerDBServer(s *grpc.Server, srv PointerDBServer) {
-	s.RegisterService(&_PointerDB_serviceDesc, srv)
+func (m *SelectNodesResponse) Reset()         { *m = SelectNodesResponse{} }
+func (m *SelectNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectNodesResponse) ProtoMessage()    {}
+func (*SelectNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{20}
+}
+func (m *SelectNodesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectNodesResponse.Unmarshal(m, b)
+}
+func (m *SelectNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectNodesResponse.Marshal(b, m, deterministic)
+}
+func (dst *SelectNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectNodesResponse.Merge(dst, src)
+}
+func (m *SelectNodesResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectNodesResponse.Size(m)
+}
+func (m *SelectNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectNodesResponse.DiscardUnknown(m)
 }
 
-func _PointerDB_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PointerDBServer).Put(ctx, in)
+var xxx_messageInfo_SelectNodesResponse proto.InternalMessageInfo
+
+func (m *SelectNodesResponse) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// SetPrefixQuotaRequest is a request message for the SetPrefixQuota rpc call
+type SetPrefixQuotaRequest struct {
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// prefix is the encrypted path prefix within the bucket
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// max_bytes and max_objects are unlimited when zero, the quota is removed when both are zero
+	MaxBytes             int64    `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	MaxObjects           int64    `protobuf:"varint,4,opt,name=max_objects,json=maxObjects,proto3" json:"max_objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetPrefixQuotaRequest) Reset()         { *m = SetPrefixQuotaRequest{} }
+func (m *SetPrefixQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPrefixQuotaRequest) ProtoMessage()    {}
+func (*SetPrefixQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{21}
+}
+func (m *SetPrefixQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPrefixQuotaRequest.Unmarshal(m, b)
+}
+func (m *SetPrefixQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPrefixQuotaRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetPrefixQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPrefixQuotaRequest.Merge(dst, src)
+}
+func (m *SetPrefixQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_SetPrefixQuotaRequest.Size(m)
+}
+func (m *SetPrefixQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPrefixQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPrefixQuotaRequest proto.InternalMessageInfo
+
+func (m *SetPrefixQuotaRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *SetPrefixQuotaRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *SetPrefixQuotaRequest) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *SetPrefixQuotaRequest) GetMaxObjects() int64 {
+	if m != nil {
+		return m.MaxObjects
+	}
+	return 0
+}
+
+// SetPrefixQuotaResponse is a response message for the SetPrefixQuota rpc call
+type SetPrefixQuotaResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetPrefixQuotaResponse) Reset()         { *m = SetPrefixQuotaResponse{} }
+func (m *SetPrefixQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*SetPrefixQuotaResponse) ProtoMessage()    {}
+func (*SetPrefixQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{22}
+}
+func (m *SetPrefixQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPrefixQuotaResponse.Unmarshal(m, b)
+}
+func (m *SetPrefixQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPrefixQuotaResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetPrefixQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPrefixQuotaResponse.Merge(dst, src)
+}
+func (m *SetPrefixQuotaResponse) XXX_Size() int {
+	return xxx_messageInfo_SetPrefixQuotaResponse.Size(m)
+}
+func (m *SetPrefixQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPrefixQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPrefixQuotaResponse proto.InternalMessageInfo
+
+// GetPrefixQuotasRequest is a request message for the GetPrefixQuotas rpc call
+type GetPrefixQuotasRequest struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPrefixQuotasRequest) Reset()         { *m = GetPrefixQuotasRequest{} }
+func (m *GetPrefixQuotasRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixQuotasRequest) ProtoMessage()    {}
+func (*GetPrefixQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{23}
+}
+func (m *GetPrefixQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixQuotasRequest.Unmarshal(m, b)
+}
+func (m *GetPrefixQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixQuotasRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetPrefixQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixQuotasRequest.Merge(dst, src)
+}
+func (m *GetPrefixQuotasRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixQuotasRequest.Size(m)
+}
+func (m *GetPrefixQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixQuotasRequest proto.InternalMessageInfo
+
+func (m *GetPrefixQuotasRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+// PrefixQuota is the quota of a prefix with its current usage
+type PrefixQuota struct {
+	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	MaxBytes             int64    `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	MaxObjects           int64    `protobuf:"varint,3,opt,name=max_objects,json=maxObjects,proto3" json:"max_objects,omitempty"`
+	UsedBytes            int64    `protobuf:"varint,4,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	UsedObjects          int64    `protobuf:"varint,5,opt,name=used_objects,json=usedObjects,proto3" json:"used_objects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrefixQuota) Reset()         { *m = PrefixQuota{} }
+func (m *PrefixQuota) String() string { return proto.CompactTextString(m) }
+func (*PrefixQuota) ProtoMessage()    {}
+func (*PrefixQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{24}
+}
+func (m *PrefixQuota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrefixQuota.Unmarshal(m, b)
+}
+func (m *PrefixQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrefixQuota.Marshal(b, m, deterministic)
+}
+func (dst *PrefixQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefixQuota.Merge(dst, src)
+}
+func (m *PrefixQuota) XXX_Size() int {
+	return xxx_messageInfo_PrefixQuota.Size(m)
+}
+func (m *PrefixQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefixQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrefixQuota proto.InternalMessageInfo
+
+func (m *PrefixQuota) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *PrefixQuota) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *PrefixQuota) GetMaxObjects() int64 {
+	if m != nil {
+		return m.MaxObjects
+	}
+	return 0
+}
+
+func (m *PrefixQuota) GetUsedBytes() int64 {
+	if m != nil {
+		return m.UsedBytes
+	}
+	return 0
+}
+
+func (m *PrefixQuota) GetUsedObjects() int64 {
+	if m != nil {
+		return m.UsedObjects
+	}
+	return 0
+}
+
+// GetPrefixQuotasResponse is a response message for the GetPrefixQuotas rpc call
+type GetPrefixQuotasResponse struct {
+	Quotas               []*PrefixQuota `protobuf:"bytes,1,rep,name=quotas,proto3" json:"quotas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetPrefixQuotasResponse) Reset()         { *m = GetPrefixQuotasResponse{} }
+func (m *GetPrefixQuotasResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPrefixQuotasResponse) ProtoMessage()    {}
+func (*GetPrefixQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{25}
+}
+func (m *GetPrefixQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPrefixQuotasResponse.Unmarshal(m, b)
+}
+func (m *GetPrefixQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPrefixQuotasResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetPrefixQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPrefixQuotasResponse.Merge(dst, src)
+}
+func (m *GetPrefixQuotasResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPrefixQuotasResponse.Size(m)
+}
+func (m *GetPrefixQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPrefixQuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPrefixQuotasResponse proto.InternalMessageInfo
+
+func (m *GetPrefixQuotasResponse) GetQuotas() []*PrefixQuota {
+	if m != nil {
+		return m.Quotas
+	}
+	return nil
+}
+
+// BucketLock makes the objects of a bucket immutable
+type BucketLock struct {
+	// retention_period is the number of seconds after their creation, during
+	// which objects can't be deleted or overwritten, it can't be shortened
+	RetentionPeriod int64 `protobuf:"varint,1,opt,name=retention_period,json=retentionPeriod,proto3" json:"retention_period,omitempty"`
+	// legal_hold prevents deleting or overwriting any object until it's released
+	LegalHold            bool     `protobuf:"varint,2,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketLock) Reset()         { *m = BucketLock{} }
+func (m *BucketLock) String() string { return proto.CompactTextString(m) }
+func (*BucketLock) ProtoMessage()    {}
+func (*BucketLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{26}
+}
+func (m *BucketLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketLock.Unmarshal(m, b)
+}
+func (m *BucketLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketLock.Marshal(b, m, deterministic)
+}
+func (dst *BucketLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketLock.Merge(dst, src)
+}
+func (m *BucketLock) XXX_Size() int {
+	return xxx_messageInfo_BucketLock.Size(m)
+}
+func (m *BucketLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketLock proto.InternalMessageInfo
+
+func (m *BucketLock) GetRetentionPeriod() int64 {
+	if m != nil {
+		return m.RetentionPeriod
+	}
+	return 0
+}
+
+func (m *BucketLock) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
+// SetBucketLockRequest is a request message for the SetBucketLock rpc call
+type SetBucketLockRequest struct {
+	Bucket               string      `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Lock                 *BucketLock `protobuf:"bytes,2,opt,name=lock,proto3" json:"lock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SetBucketLockRequest) Reset()         { *m = SetBucketLockRequest{} }
+func (m *SetBucketLockRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLockRequest) ProtoMessage()    {}
+func (*SetBucketLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{27}
+}
+func (m *SetBucketLockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLockRequest.Unmarshal(m, b)
+}
+func (m *SetBucketLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLockRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetBucketLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLockRequest.Merge(dst, src)
+}
+func (m *SetBucketLockRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLockRequest.Size(m)
+}
+func (m *SetBucketLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLockRequest proto.InternalMessageInfo
+
+func (m *SetBucketLockRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *SetBucketLockRequest) GetLock() *BucketLock {
+	if m != nil {
+		return m.Lock
+	}
+	return nil
+}
+
+// SetBucketLockResponse is a response message for the SetBucketLock rpc call
+type SetBucketLockResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketLockResponse) Reset()         { *m = SetBucketLockResponse{} }
+func (m *SetBucketLockResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBucketLockResponse) ProtoMessage()    {}
+func (*SetBucketLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{28}
+}
+func (m *SetBucketLockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketLockResponse.Unmarshal(m, b)
+}
+func (m *SetBucketLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketLockResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetBucketLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketLockResponse.Merge(dst, src)
+}
+func (m *SetBucketLockResponse) XXX_Size() int {
+	return xxx_messageInfo_SetBucketLockResponse.Size(m)
+}
+func (m *SetBucketLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketLockResponse proto.InternalMessageInfo
+
+// GetBucketLockRequest is a request message for the GetBucketLock rpc call
+type GetBucketLockRequest struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBucketLockRequest) Reset()         { *m = GetBucketLockRequest{} }
+func (m *GetBucketLockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLockRequest) ProtoMessage()    {}
+func (*GetBucketLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{29}
+}
+func (m *GetBucketLockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLockRequest.Unmarshal(m, b)
+}
+func (m *GetBucketLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLockRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetBucketLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLockRequest.Merge(dst, src)
+}
+func (m *GetBucketLockRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLockRequest.Size(m)
+}
+func (m *GetBucketLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLockRequest proto.InternalMessageInfo
+
+func (m *GetBucketLockRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+// GetBucketLockResponse is a response message for the GetBucketLock rpc call
+type GetBucketLockResponse struct {
+	// lock is empty when the bucket isn't locked
+	Lock                 *BucketLock `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetBucketLockResponse) Reset()         { *m = GetBucketLockResponse{} }
+func (m *GetBucketLockResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBucketLockResponse) ProtoMessage()    {}
+func (*GetBucketLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_ffd7fa2c97911394, []int{30}
+}
+func (m *GetBucketLockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBucketLockResponse.Unmarshal(m, b)
+}
+func (m *GetBucketLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBucketLockResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBucketLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBucketLockResponse.Merge(dst, src)
+}
+func (m *GetBucketLockResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBucketLockResponse.Size(m)
+}
+func (m *GetBucketLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBucketLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBucketLockResponse proto.InternalMessageInfo
+
+func (m *GetBucketLockResponse) GetLock() *BucketLock {
+	if m != nil {
+		return m.Lock
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RedundancyScheme)(nil), "pointerdb.RedundancyScheme")
+	proto.RegisterType((*RemotePiece)(nil), "pointerdb.RemotePiece")
+	proto.RegisterType((*RemoteSegment)(nil), "pointerdb.RemoteSegment")
+	proto.RegisterType((*Pointer)(nil), "pointerdb.Pointer")
+	proto.RegisterType((*PutRequest)(nil), "pointerdb.PutRequest")
+	proto.RegisterType((*GetRequest)(nil), "pointerdb.GetRequest")
+	proto.RegisterType((*ListRequest)(nil), "pointerdb.ListRequest")
+	proto.RegisterType((*PutResponse)(nil), "pointerdb.PutResponse")
+	proto.RegisterType((*GetResponse)(nil), "pointerdb.GetResponse")
+	proto.RegisterType((*ListResponse)(nil), "pointerdb.ListResponse")
+	proto.RegisterType((*ListResponse_Item)(nil), "pointerdb.ListResponse.Item")
+	proto.RegisterType((*DeleteRequest)(nil), "pointerdb.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "pointerdb.DeleteResponse")
+	proto.RegisterType((*DeletePrefixRequest)(nil), "pointerdb.DeletePrefixRequest")
+	proto.RegisterType((*DeletePrefixResponse)(nil), "pointerdb.DeletePrefixResponse")
+	proto.RegisterType((*IterateRequest)(nil), "pointerdb.IterateRequest")
+	proto.RegisterType((*PayerBandwidthAllocationRequest)(nil), "pointerdb.PayerBandwidthAllocationRequest")
+	proto.RegisterType((*PayerBandwidthAllocationResponse)(nil), "pointerdb.PayerBandwidthAllocationResponse")
+	proto.RegisterType((*ProjectInfoRequest)(nil), "pointerdb.ProjectInfoRequest")
+	proto.RegisterType((*ProjectInfoResponse)(nil), "pointerdb.ProjectInfoResponse")
+	proto.RegisterType((*SelectNodesRequest)(nil), "pointerdb.SelectNodesRequest")
+	proto.RegisterType((*SelectNodesResponse)(nil), "pointerdb.SelectNodesResponse")
+	proto.RegisterType((*SetPrefixQuotaRequest)(nil), "pointerdb.SetPrefixQuotaRequest")
+	proto.RegisterType((*SetPrefixQuotaResponse)(nil), "pointerdb.SetPrefixQuotaResponse")
+	proto.RegisterType((*GetPrefixQuotasRequest)(nil), "pointerdb.GetPrefixQuotasRequest")
+	proto.RegisterType((*PrefixQuota)(nil), "pointerdb.PrefixQuota")
+	proto.RegisterType((*GetPrefixQuotasResponse)(nil), "pointerdb.GetPrefixQuotasResponse")
+	proto.RegisterType((*BucketLock)(nil), "pointerdb.BucketLock")
+	proto.RegisterType((*SetBucketLockRequest)(nil), "pointerdb.SetBucketLockRequest")
+	proto.RegisterType((*SetBucketLockResponse)(nil), "pointerdb.SetBucketLockResponse")
+	proto.RegisterType((*GetBucketLockRequest)(nil), "pointerdb.GetBucketLockRequest")
+	proto.RegisterType((*GetBucketLockResponse)(nil), "pointerdb.GetBucketLockResponse")
+	proto.RegisterEnum("pointerdb.RedundancyScheme_SchemeType", RedundancyScheme_SchemeType_name, RedundancyScheme_SchemeType_value)
+	proto.RegisterEnum("pointerdb.Pointer_DataType", Pointer_DataType_name, Pointer_DataType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PointerDBClient is the client API for PointerDB service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PointerDBClient interface {
+	// Put formats and hands off a file path to be saved to boltdb
+	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
+	// Get formats and hands off a file path to get a small value from boltdb
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// List calls the bolt client's List function and returns all file paths
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// ListStream sends the listing page by page until it is exhausted
+	ListStream(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (PointerDB_ListStreamClient, error)
+	// Delete formats and hands off a file path to delete from boltdb
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// DeletePrefix deletes the pointers of all segments of all objects under a prefix
+	DeletePrefix(ctx context.Context, in *DeletePrefixRequest, opts ...grpc.CallOption) (*DeletePrefixResponse, error)
+	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
+	PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error)
+	// ProjectInfo returns information about the project of the api key
+	ProjectInfo(ctx context.Context, in *ProjectInfoRequest, opts ...grpc.CallOption) (*ProjectInfoResponse, error)
+	// SelectNodes returns the nodes, which would be selected for uploading a segment, without reserving them
+	SelectNodes(ctx context.Context, in *SelectNodesRequest, opts ...grpc.CallOption) (*SelectNodesResponse, error)
+	// SetPrefixQuota sets the byte and object limits of a prefix in a bucket
+	SetPrefixQuota(ctx context.Context, in *SetPrefixQuotaRequest, opts ...grpc.CallOption) (*SetPrefixQuotaResponse, error)
+	// GetPrefixQuotas returns the prefix quotas of a bucket with their current usage
+	GetPrefixQuotas(ctx context.Context, in *GetPrefixQuotasRequest, opts ...grpc.CallOption) (*GetPrefixQuotasResponse, error)
+	// SetBucketLock sets the object lock of a bucket
+	SetBucketLock(ctx context.Context, in *SetBucketLockRequest, opts ...grpc.CallOption) (*SetBucketLockResponse, error)
+	// GetBucketLock returns the object lock of a bucket
+	GetBucketLock(ctx context.Context, in *GetBucketLockRequest, opts ...grpc.CallOption) (*GetBucketLockResponse, error)
+}
+
+type pointerDBClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPointerDBClient(cc *grpc.ClientConn) PointerDBClient {
+	return &pointerDBClient{cc}
+}
+
+func (c *pointerDBClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
+	out := new(PutResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Put", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) ListStream(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (PointerDB_ListStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PointerDB_serviceDesc.Streams[0], "/pointerdb.PointerDB/ListStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pointerDBListStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PointerDB_ListStreamClient interface {
+	Recv() (*ListResponse, error)
+	grpc.ClientStream
+}
+
+type pointerDBListStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *pointerDBListStreamClient) Recv() (*ListResponse, error) {
+	m := new(ListResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pointerDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) DeletePrefix(ctx context.Context, in *DeletePrefixRequest, opts ...grpc.CallOption) (*DeletePrefixResponse, error) {
+	out := new(DeletePrefixResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/DeletePrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error) {
+	out := new(PayerBandwidthAllocationResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/PayerBandwidthAllocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) ProjectInfo(ctx context.Context, in *ProjectInfoRequest, opts ...grpc.CallOption) (*ProjectInfoResponse, error) {
+	out := new(ProjectInfoResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/ProjectInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) SelectNodes(ctx context.Context, in *SelectNodesRequest, opts ...grpc.CallOption) (*SelectNodesResponse, error) {
+	out := new(SelectNodesResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/SelectNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) SetPrefixQuota(ctx context.Context, in *SetPrefixQuotaRequest, opts ...grpc.CallOption) (*SetPrefixQuotaResponse, error) {
+	out := new(SetPrefixQuotaResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/SetPrefixQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) GetPrefixQuotas(ctx context.Context, in *GetPrefixQuotasRequest, opts ...grpc.CallOption) (*GetPrefixQuotasResponse, error) {
+	out := new(GetPrefixQuotasResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/GetPrefixQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) SetBucketLock(ctx context.Context, in *SetBucketLockRequest, opts ...grpc.CallOption) (*SetBucketLockResponse, error) {
+	out := new(SetBucketLockResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/SetBucketLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) GetBucketLock(ctx context.Context, in *GetBucketLockRequest, opts ...grpc.CallOption) (*GetBucketLockResponse, error) {
+	out := new(GetBucketLockResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/GetBucketLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PointerDBServer is the server API for PointerDB service.
+type PointerDBServer interface {
+	// Put formats and hands off a file path to be saved to boltdb
+	Put(context.Context, *PutRequest) (*PutResponse, error)
+	// Get formats and hands off a file path to get a small value from boltdb
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// List calls the bolt client's List function and returns all file paths
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	// ListStream sends the listing page by page until it is exhausted
+	ListStream(*ListRequest, PointerDB_ListStreamServer) error
+	// Delete formats and hands off a file path to delete from boltdb
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// DeletePrefix deletes the pointers of all segments of all objects under a prefix
+	DeletePrefix(context.Context, *DeletePrefixRequest) (*DeletePrefixResponse, error)
+	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
+	PayerBandwidthAllocation(context.Context, *PayerBandwidthAllocationRequest) (*PayerBandwidthAllocationResponse, error)
+	// ProjectInfo returns information about the project of the api key
+	ProjectInfo(context.Context, *ProjectInfoRequest) (*ProjectInfoResponse, error)
+	// SelectNodes returns the nodes, which would be selected for uploading a segment, without reserving them
+	SelectNodes(context.Context, *SelectNodesRequest) (*SelectNodesResponse, error)
+	// SetPrefixQuota sets the byte and object limits of a prefix in a bucket
+	SetPrefixQuota(context.Context, *SetPrefixQuotaRequest) (*SetPrefixQuotaResponse, error)
+	// GetPrefixQuotas returns the prefix quotas of a bucket with their current usage
+	GetPrefixQuotas(context.Context, *GetPrefixQuotasRequest) (*GetPrefixQuotasResponse, error)
+	// SetBucketLock sets the object lock of a bucket
+	SetBucketLock(context.Context, *SetBucketLockRequest) (*SetBucketLockResponse, error)
+	// GetBucketLock returns the object lock of a bucket
+	GetBucketLock(context.Context, *GetBucketLockRequest) (*GetBucketLockResponse, error)
+}
+
+func RegisterPointerDBServer(s *grpc.Server, srv PointerDBServer) {
+	s.RegisterService(&_PointerDB_serviceDesc, srv)
+}
+
+func _PointerDB_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Put(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -1073,6 +2019,27 @@ func _PointerDB_List_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PointerDB_ListStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PointerDBServer).ListStream(m, &pointerDBListStreamServer{stream})
+}
+
+type PointerDB_ListStreamServer interface {
+	Send(*ListResponse) error
+	grpc.ServerStream
+}
+
+type pointerDBListStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *pointerDBListStreamServer) Send(m *ListResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PointerDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
@@ -1091,6 +2058,24 @@ func _PointerDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PointerDB_DeletePrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePrefixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).DeletePrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/DeletePrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).DeletePrefix(ctx, req.(*DeletePrefixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PointerDB_PayerBandwidthAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PayerBandwidthAllocationRequest)
 	if err := dec(in); err != nil {
@@ -1109,6 +2094,114 @@ func _PointerDB_PayerBandwidthAllocation_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PointerDB_ProjectInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).ProjectInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/ProjectInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).ProjectInfo(ctx, req.(*ProjectInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_SelectNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).SelectNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/SelectNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).SelectNodes(ctx, req.(*SelectNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_SetPrefixQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrefixQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).SetPrefixQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/SetPrefixQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).SetPrefixQuota(ctx, req.(*SetPrefixQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_GetPrefixQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrefixQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).GetPrefixQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/GetPrefixQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).GetPrefixQuotas(ctx, req.(*GetPrefixQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_SetBucketLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).SetBucketLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/SetBucketLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).SetBucketLock(ctx, req.(*SetBucketLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_GetBucketLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).GetBucketLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/GetBucketLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).GetBucketLock(ctx, req.(*GetBucketLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PointerDB_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pointerdb.PointerDB",
 	HandlerType: (*PointerDBServer)(nil),
@@ -1129,86 +2222,162 @@ var _PointerDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _PointerDB_Delete_Handler,
 		},
+		{
+			MethodName: "DeletePrefix",
+			Handler:    _PointerDB_DeletePrefix_Handler,
+		},
 		{
 			MethodName: "PayerBandwidthAllocation",
 			Handler:    _PointerDB_PayerBandwidthAllocation_Handler,
 		},
+		{
+			MethodName: "ProjectInfo",
+			Handler:    _PointerDB_ProjectInfo_Handler,
+		},
+		{
+			MethodName: "SelectNodes",
+			Handler:    _PointerDB_SelectNodes_Handler,
+		},
+		{
+			MethodName: "SetPrefixQuota",
+			Handler:    _PointerDB_SetPrefixQuota_Handler,
+		},
+		{
+			MethodName: "GetPrefixQuotas",
+			Handler:    _PointerDB_GetPrefixQuotas_Handler,
+		},
+		{
+			MethodName: "SetBucketLock",
+			Handler:    _PointerDB_SetBucketLock_Handler,
+		},
+		{
+			MethodName: "GetBucketLock",
+			Handler:    _PointerDB_GetBucketLock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListStream",
+			Handler:       _PointerDB_ListStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pointerdb.proto",
 }
 
-func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_pointerdb_afdf954a8fe5efcf) }
-
-var fileDescriptor_pointerdb_afdf954a8fe5efcf = []byte{
-	// 1090 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5f, 0x6f, 0x1b, 0x45,
-	0x10, 0xaf, 0xff, 0xc7, 0x73, 0x76, 0x6a, 0x56, 0x25, 0xbd, 0xba, 0x45, 0x49, 0x0f, 0x01, 0xa5,
-	0xad, 0x5c, 0x64, 0x2a, 0x21, 0x28, 0x08, 0x35, 0x24, 0x54, 0x96, 0xda, 0x10, 0xad, 0xf3, 0x84,
-	0x90, 0x8e, 0xb5, 0x6f, 0x6c, 0xaf, 0xf0, 0xfd, 0xe9, 0xee, 0x5e, 0x69, 0xf2, 0x4d, 0xf8, 0x26,
-	0xbc, 0xf0, 0x88, 0xc4, 0x67, 0xe0, 0xa1, 0x0f, 0x88, 0x8f, 0xc1, 0x03, 0xda, 0x3f, 0x67, 0x9f,
-	0x1b, 0x92, 0x22, 0x78, 0x49, 0x6e, 0x66, 0x7e, 0x33, 0x3b, 0x33, 0xbf, 0xdf, 0xae, 0xe1, 0x6a,
-	0x96, 0xf2, 0x44, 0xa1, 0x88, 0x26, 0x83, 0x4c, 0xa4, 0x2a, 0x25, 0xed, 0x95, 0xa3, 0xbf, 0x3b,
-	0x4f, 0xd3, 0xf9, 0x12, 0x1f, 0x98, 0xc0, 0x24, 0x9f, 0x3d, 0x50, 0x3c, 0x46, 0xa9, 0x58, 0x9c,
-	0x59, 0x6c, 0x1f, 0xe6, 0xe9, 0x3c, 0x2d, 0xbe, 0x93, 0x34, 0x42, 0xf7, 0xdd, 0xcb, 0x38, 0x4e,
-	0x51, 0xaa, 0x54, 0x38, 0x4f, 0xf0, 0x53, 0x15, 0x7a, 0x14, 0xa3, 0x3c, 0x89, 0x58, 0x32, 0x3d,
-	0x1d, 0x4f, 0x17, 0x18, 0x23, 0xf9, 0x0c, 0xea, 0xea, 0x34, 0x43, 0xbf, 0xb2, 0x57, 0xb9, 0xb3,
-	0x3d, 0x7c, 0x7f, 0xb0, 0x6e, 0xe5, 0x75, 0xe8, 0xc0, 0xfe, 0x3b, 0x39, 0xcd, 0x90, 0x9a, 0x1c,
-	0x72, 0x1d, 0x5a, 0x31, 0x4f, 0x42, 0x81, 0xcf, 0xfd, 0xea, 0x5e, 0xe5, 0x4e, 0x83, 0x36, 0x63,
-	0x9e, 0x50, 0x7c, 0x4e, 0xae, 0x41, 0x43, 0xa5, 0x8a, 0x2d, 0xfd, 0x9a, 0x71, 0x5b, 0x83, 0x7c,
-	0x08, 0x3d, 0x81, 0x19, 0xe3, 0x22, 0x54, 0x0b, 0x81, 0x72, 0x91, 0x2e, 0x23, 0xbf, 0x6e, 0x00,
-	0x57, 0xad, 0xff, 0xa4, 0x70, 0x93, 0x7b, 0xf0, 0x96, 0xcc, 0xa7, 0x53, 0x94, 0xb2, 0x84, 0x6d,
-	0x18, 0x6c, 0xcf, 0x05, 0xd6, 0xe0, 0xfb, 0x40, 0x50, 0x30, 0x99, 0x0b, 0x0c, 0xe5, 0x82, 0xe9,
-	0xbf, 0xfc, 0x0c, 0xfd, 0xa6, 0x45, 0xbb, 0xc8, 0x58, 0x07, 0xc6, 0xfc, 0x0c, 0x83, 0x6b, 0x00,
-	0xeb, 0x41, 0x48, 0x13, 0xaa, 0x74, 0xdc, 0xbb, 0x12, 0x8c, 0xc1, 0xa3, 0x18, 0xa7, 0x0a, 0x8f,
-	0xf5, 0xd6, 0xc8, 0x4d, 0x68, 0x9b, 0xf5, 0x85, 0x49, 0x1e, 0x9b, 0xd5, 0x34, 0xe8, 0x96, 0x71,
-	0x1c, 0xe5, 0x31, 0xf9, 0x00, 0x5a, 0x7a, 0xcf, 0x21, 0x8f, 0xcc, 0xd8, 0x9d, 0xfd, 0xed, 0xdf,
-	0x5e, 0xed, 0x5e, 0xf9, 0xfd, 0xd5, 0x6e, 0xf3, 0x28, 0x8d, 0x70, 0x74, 0x40, 0x9b, 0x3a, 0x3c,
-	0x8a, 0x82, 0x5f, 0x2b, 0xd0, 0xb5, 0x55, 0xc7, 0x38, 0x8f, 0x31, 0x51, 0xe4, 0x11, 0x80, 0x58,
-	0xad, 0xd5, 0x14, 0xf6, 0x86, 0x37, 0x2f, 0xd9, 0x39, 0x2d, 0xc1, 0xc9, 0x0d, 0xb0, 0x3d, 0x14,
-	0x07, 0xb7, 0x69, 0xcb, 0xd8, 0xa3, 0x88, 0x3c, 0x82, 0xae, 0x30, 0x07, 0x85, 0x96, 0x75, 0xbf,
-	0xb6, 0x57, 0xbb, 0xe3, 0x0d, 0x77, 0x36, 0x4a, 0xaf, 0xc6, 0xa3, 0x1d, 0xb1, 0x36, 0x24, 0xd9,
-	0x05, 0x2f, 0x46, 0xf1, 0xc3, 0x12, 0x43, 0x91, 0xa6, 0xca, 0x50, 0xd2, 0xa1, 0x60, 0x5d, 0x34,
-	0x4d, 0x55, 0xf0, 0x57, 0x15, 0x5a, 0xc7, 0xb6, 0x10, 0x79, 0xb0, 0xa1, 0x97, 0x72, 0xef, 0x0e,
-	0x31, 0x38, 0x60, 0x8a, 0x95, 0x44, 0xf2, 0x1e, 0x6c, 0xf3, 0x64, 0xc9, 0x13, 0x0c, 0xa5, 0x5d,
-	0x82, 0x11, 0x45, 0x87, 0x76, 0xad, 0xb7, 0xd8, 0xcc, 0x47, 0xd0, 0xb4, 0x4d, 0x99, 0xf3, 0xbd,
-	0xa1, 0x7f, 0xae, 0x75, 0x87, 0xa4, 0x0e, 0x47, 0x6e, 0x43, 0xc7, 0x55, 0xb4, 0x84, 0x6b, 0x79,
-	0xd4, 0xa8, 0xe7, 0x7c, 0x9a, 0x6b, 0xf2, 0x25, 0x74, 0xa7, 0x02, 0x99, 0xe2, 0x69, 0x12, 0x46,
-	0x4c, 0x59, 0x51, 0x78, 0xc3, 0xfe, 0xc0, 0x5e, 0xaa, 0x41, 0x71, 0xa9, 0x06, 0x27, 0xc5, 0xa5,
-	0xa2, 0x9d, 0x22, 0xe1, 0x80, 0x29, 0x24, 0x5f, 0xc1, 0x55, 0x7c, 0x99, 0x71, 0x51, 0x2a, 0xd1,
-	0x7a, 0x63, 0x89, 0xed, 0x75, 0x8a, 0x29, 0xd2, 0x87, 0xad, 0x18, 0x15, 0x8b, 0x98, 0x62, 0xfe,
-	0x96, 0x99, 0x7d, 0x65, 0x07, 0x01, 0x6c, 0x15, 0xfb, 0x22, 0x00, 0xcd, 0xd1, 0xd1, 0xd3, 0xd1,
-	0xd1, 0x61, 0xef, 0x8a, 0xfe, 0xa6, 0x87, 0xcf, 0xbe, 0x39, 0x39, 0xec, 0x55, 0x82, 0x23, 0x80,
-	0xe3, 0x5c, 0x51, 0x7c, 0x9e, 0xa3, 0x54, 0x84, 0x40, 0x3d, 0x63, 0x6a, 0x61, 0x08, 0x68, 0x53,
-	0xf3, 0x4d, 0xee, 0x43, 0xcb, 0x6d, 0xcb, 0x08, 0xc3, 0x1b, 0x92, 0xf3, 0xbc, 0xd0, 0x02, 0x12,
-	0xec, 0x01, 0x3c, 0xc1, 0xcb, 0xea, 0x05, 0x3f, 0x57, 0xc0, 0x7b, 0xca, 0xe5, 0x0a, 0xb3, 0x03,
-	0xcd, 0x4c, 0xe0, 0x8c, 0xbf, 0x74, 0x28, 0x67, 0x69, 0xe5, 0x48, 0xc5, 0x84, 0x0a, 0xd9, 0xac,
-	0x38, 0xbb, 0x4d, 0xc1, 0xb8, 0x1e, 0x6b, 0x0f, 0x79, 0x07, 0x00, 0x93, 0x28, 0x9c, 0xe0, 0x2c,
-	0x15, 0x68, 0x88, 0x6f, 0xd3, 0x36, 0x26, 0xd1, 0xbe, 0x71, 0x90, 0x5b, 0xd0, 0x16, 0x38, 0xcd,
-	0x85, 0xe4, 0x2f, 0x2c, 0xef, 0x5b, 0x74, 0xed, 0xd0, 0xaf, 0xc8, 0x92, 0xc7, 0x5c, 0xb9, 0x8b,
-	0x6f, 0x0d, 0x5d, 0x52, 0x6f, 0x2f, 0x9c, 0x2d, 0xd9, 0x5c, 0x1a, 0x42, 0x5b, 0xb4, 0xad, 0x3d,
-	0x5f, 0x6b, 0x47, 0xd0, 0x05, 0xcf, 0x2c, 0x4b, 0x66, 0x69, 0x22, 0x31, 0xf8, 0xa3, 0x02, 0x9e,
-	0x19, 0xd6, 0xda, 0xe5, 0x4d, 0x55, 0xde, 0xb8, 0x29, 0xb2, 0x07, 0x0d, 0x7d, 0x95, 0xa5, 0x5f,
-	0x35, 0xd7, 0x09, 0x06, 0xe6, 0x7d, 0xd5, 0xb7, 0x9c, 0xda, 0x00, 0xf9, 0x1c, 0x6a, 0xd9, 0x84,
-	0x99, 0xc9, 0xbc, 0xe1, 0xdd, 0xc1, 0xfa, 0xcd, 0x15, 0x69, 0xae, 0x50, 0x0e, 0x8e, 0xd9, 0x29,
-	0x8a, 0x7d, 0x96, 0x44, 0x3f, 0xf2, 0x48, 0x2d, 0x1e, 0x2f, 0x97, 0xe9, 0xd4, 0x08, 0x83, 0xea,
-	0x34, 0x72, 0x08, 0x5d, 0x96, 0xab, 0x45, 0x2a, 0xf8, 0x99, 0xf1, 0x3a, 0xed, 0xef, 0x9e, 0xaf,
-	0x33, 0xe6, 0xf3, 0x04, 0xa3, 0x67, 0x28, 0x25, 0x9b, 0x23, 0xdd, 0xcc, 0x0a, 0x7e, 0xa9, 0x40,
-	0xc7, 0xd2, 0xe5, 0xa6, 0x1c, 0x42, 0x83, 0x2b, 0x8c, 0xa5, 0x5f, 0x31, 0x7d, 0xdf, 0x2a, 0xcd,
-	0x58, 0xc6, 0x0d, 0x46, 0x0a, 0x63, 0x6a, 0xa1, 0x5a, 0x07, 0xb1, 0x26, 0xa9, 0x6a, 0x68, 0x30,
-	0xdf, 0x7d, 0x84, 0xba, 0x86, 0xfc, 0x7f, 0xcd, 0xe9, 0x07, 0x95, 0xcb, 0xd0, 0x89, 0xa8, 0x66,
-	0x8e, 0xd8, 0xe2, 0xf2, 0xd8, 0xd8, 0xc1, 0xbb, 0xd0, 0x3d, 0xc0, 0x25, 0x2a, 0xbc, 0x4c, 0x93,
-	0x3d, 0xd8, 0x2e, 0x40, 0x8e, 0x5b, 0x01, 0xdb, 0x23, 0x85, 0x82, 0xad, 0xf3, 0x2e, 0xd2, 0xe9,
-	0x35, 0x68, 0xcc, 0xb8, 0x90, 0xca, 0x29, 0xd4, 0x1a, 0xc4, 0x87, 0x96, 0x15, 0x1b, 0xba, 0x8e,
-	0x0a, 0xd3, 0x46, 0x5e, 0xa0, 0x8e, 0xd4, 0x8b, 0x88, 0x31, 0x83, 0xef, 0x60, 0xf7, 0x42, 0x4a,
-	0x5d, 0x13, 0x9f, 0x42, 0x93, 0x4d, 0x0d, 0x9b, 0xf6, 0x8d, 0xbc, 0x7d, 0x9e, 0xcd, 0x75, 0xb6,
-	0x01, 0x52, 0x97, 0x10, 0x7c, 0x0f, 0x7b, 0x17, 0x57, 0x77, 0xdc, 0x3a, 0xc5, 0x55, 0xfe, 0x93,
-	0xe2, 0x86, 0x7f, 0x56, 0xa1, 0xed, 0xc8, 0x39, 0xd8, 0x27, 0x0f, 0xa1, 0x76, 0x9c, 0x2b, 0xf2,
-	0x76, 0x99, 0xb9, 0xd5, 0x4b, 0xd3, 0xdf, 0x79, 0xdd, 0xed, 0x3a, 0x78, 0x08, 0xb5, 0x27, 0xb8,
-	0x99, 0xb5, 0x7e, 0x4f, 0x36, 0xb2, 0xca, 0x37, 0xef, 0x13, 0xa8, 0x6b, 0xed, 0x91, 0x9d, 0x73,
-	0x62, 0xb4, 0x79, 0xd7, 0x2f, 0x10, 0x29, 0xf9, 0x02, 0x9a, 0x96, 0x78, 0x52, 0xfe, 0x4d, 0xd8,
-	0x10, 0x4c, 0xff, 0xc6, 0x3f, 0x44, 0x5c, 0xba, 0x04, 0xff, 0xa2, 0x95, 0x90, 0xbb, 0xe5, 0x09,
-	0x2f, 0xa7, 0xb5, 0x7f, 0xef, 0x5f, 0x61, 0xed, 0xa1, 0xfb, 0xf5, 0x6f, 0xab, 0xd9, 0x64, 0xd2,
-	0x34, 0x3f, 0x0e, 0x1f, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x68, 0xba, 0x5b, 0xd0, 0xe0, 0x09,
-	0x00, 0x00,
+func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_pointerdb_ffd7fa2c97911394) }
+
+var fileDescriptor_pointerdb_ffd7fa2c97911394 = []byte{
+	// 1771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x5b, 0x73, 0x1b, 0x49,
+	0xf5, 0x8f, 0xee, 0xd6, 0xd1, 0xc5, 0xda, 0x8e, 0xe3, 0x68, 0x95, 0xdd, 0x58, 0x9e, 0x7f, 0xfd,
+	0xd9, 0xec, 0x05, 0x25, 0x88, 0xad, 0x5a, 0x20, 0x50, 0x5b, 0x51, 0x1c, 0x84, 0xaa, 0xb2, 0x8e,
+	0x69, 0x05, 0x0a, 0x28, 0xaa, 0x86, 0x96, 0xe6, 0x48, 0x1e, 0x32, 0x33, 0xad, 0x4c, 0xb7, 0x36,
+	0x76, 0x1e, 0xa1, 0xf8, 0x10, 0x7c, 0x00, 0x3e, 0x0a, 0x55, 0x7c, 0x01, 0x5e, 0x78, 0xd8, 0x07,
+	0x5e, 0x79, 0xe7, 0x89, 0x07, 0xaa, 0x2f, 0x23, 0xcd, 0x58, 0x96, 0xc5, 0xee, 0xbe, 0xd8, 0xd3,
+	0xbf, 0x73, 0xe9, 0xd3, 0xe7, 0x2e, 0xd8, 0x5f, 0x70, 0x3f, 0x92, 0x18, 0x7b, 0x93, 0xde, 0x22,
+	0xe6, 0x92, 0x93, 0xea, 0x0a, 0xe8, 0x1c, 0xcd, 0x39, 0x9f, 0x07, 0xf8, 0x50, 0x13, 0x26, 0xcb,
+	0xd9, 0x43, 0xe9, 0x87, 0x28, 0x24, 0x0b, 0x17, 0x86, 0xb7, 0x03, 0x73, 0x3e, 0xe7, 0xc9, 0x77,
+	0xc4, 0x3d, 0xb4, 0xdf, 0xad, 0x85, 0x8f, 0x53, 0x14, 0x92, 0xc7, 0x16, 0x71, 0xfe, 0x9c, 0x87,
+	0x16, 0x45, 0x6f, 0x19, 0x79, 0x2c, 0x9a, 0x5e, 0x8e, 0xa7, 0xe7, 0x18, 0x22, 0xf9, 0x11, 0x14,
+	0xe5, 0xe5, 0x02, 0xdb, 0xb9, 0x6e, 0xee, 0x41, 0xb3, 0xff, 0x9d, 0xde, 0xda, 0x94, 0xab, 0xac,
+	0x3d, 0xf3, 0xef, 0xe5, 0xe5, 0x02, 0xa9, 0x96, 0x21, 0x77, 0xa1, 0x12, 0xfa, 0x91, 0x1b, 0xe3,
+	0xeb, 0x76, 0xbe, 0x9b, 0x7b, 0x50, 0xa2, 0xe5, 0xd0, 0x8f, 0x28, 0xbe, 0x26, 0x07, 0x50, 0x92,
+	0x5c, 0xb2, 0xa0, 0x5d, 0xd0, 0xb0, 0x39, 0x90, 0x0f, 0xa1, 0x15, 0xe3, 0x82, 0xf9, 0xb1, 0x2b,
+	0xcf, 0x63, 0x14, 0xe7, 0x3c, 0xf0, 0xda, 0x45, 0xcd, 0xb0, 0x6f, 0xf0, 0x97, 0x09, 0x4c, 0x3e,
+	0x86, 0x77, 0xc4, 0x72, 0x3a, 0x45, 0x21, 0x52, 0xbc, 0x25, 0xcd, 0xdb, 0xb2, 0x84, 0x35, 0xf3,
+	0x27, 0x40, 0x30, 0x66, 0x62, 0x19, 0xa3, 0x2b, 0xce, 0x99, 0xfa, 0xeb, 0xbf, 0xc5, 0x76, 0xd9,
+	0x70, 0x5b, 0xca, 0x58, 0x11, 0xc6, 0xfe, 0x5b, 0x74, 0x0e, 0x00, 0xd6, 0x0f, 0x21, 0x65, 0xc8,
+	0xd3, 0x71, 0xeb, 0x96, 0x33, 0x86, 0x1a, 0xc5, 0x90, 0x4b, 0x3c, 0x53, 0x5e, 0x23, 0xf7, 0xa0,
+	0xaa, 0xdd, 0xe7, 0x46, 0xcb, 0x50, 0xbb, 0xa6, 0x44, 0xf7, 0x34, 0x70, 0xba, 0x0c, 0xc9, 0x07,
+	0x50, 0x51, 0x7e, 0x76, 0x7d, 0x4f, 0x3f, 0xbb, 0x3e, 0x68, 0xfe, 0xed, 0xab, 0xa3, 0x5b, 0xff,
+	0xf8, 0xea, 0xa8, 0x7c, 0xca, 0x3d, 0x1c, 0x9d, 0xd0, 0xb2, 0x22, 0x8f, 0x3c, 0xe7, 0xaf, 0x39,
+	0x68, 0x18, 0xad, 0x63, 0x9c, 0x87, 0x18, 0x49, 0xf2, 0x18, 0x20, 0x5e, 0xb9, 0x55, 0x2b, 0xae,
+	0xf5, 0xef, 0xdd, 0xe0, 0x73, 0x9a, 0x62, 0x27, 0xef, 0x82, 0xb1, 0x21, 0xb9, 0xb8, 0x4a, 0x2b,
+	0xfa, 0x3c, 0xf2, 0xc8, 0x63, 0x68, 0xc4, 0xfa, 0x22, 0xd7, 0x44, 0xbd, 0x5d, 0xe8, 0x16, 0x1e,
+	0xd4, 0xfa, 0x87, 0x19, 0xd5, 0xab, 0xe7, 0xd1, 0x7a, 0xbc, 0x3e, 0x08, 0x72, 0x04, 0xb5, 0x10,
+	0xe3, 0x57, 0x01, 0xba, 0x31, 0xe7, 0x52, 0x87, 0xa4, 0x4e, 0xc1, 0x40, 0x94, 0x73, 0xe9, 0xfc,
+	0x27, 0x0f, 0x95, 0x33, 0xa3, 0x88, 0x3c, 0xcc, 0xe4, 0x4b, 0xda, 0x76, 0xcb, 0xd1, 0x3b, 0x61,
+	0x92, 0xa5, 0x92, 0xe4, 0xff, 0xa1, 0xe9, 0x47, 0x81, 0x1f, 0xa1, 0x2b, 0x8c, 0x13, 0x74, 0x52,
+	0xd4, 0x69, 0xc3, 0xa0, 0x89, 0x67, 0x1e, 0x41, 0xd9, 0x18, 0xa5, 0xef, 0xaf, 0xf5, 0xdb, 0x1b,
+	0xa6, 0x5b, 0x4e, 0x6a, 0xf9, 0xc8, 0x31, 0xd4, 0xad, 0x46, 0x13, 0x70, 0x95, 0x1e, 0x05, 0x5a,
+	0xb3, 0x98, 0x8a, 0x35, 0xf9, 0x1c, 0x1a, 0xd3, 0x18, 0x99, 0xf4, 0x79, 0xe4, 0x7a, 0x4c, 0x9a,
+	0xa4, 0xa8, 0xf5, 0x3b, 0x3d, 0x53, 0x54, 0xbd, 0xa4, 0xa8, 0x7a, 0x2f, 0x93, 0xa2, 0xa2, 0xf5,
+	0x44, 0xe0, 0x84, 0x49, 0x24, 0x4f, 0x61, 0x1f, 0x2f, 0x16, 0x7e, 0x9c, 0x52, 0x51, 0xd9, 0xa9,
+	0xa2, 0xb9, 0x16, 0xd1, 0x4a, 0x3a, 0xb0, 0x17, 0xa2, 0x64, 0x1e, 0x93, 0xac, 0xbd, 0xa7, 0xdf,
+	0xbe, 0x3a, 0x3b, 0x0e, 0xec, 0x25, 0xfe, 0x22, 0x00, 0xe5, 0xd1, 0xe9, 0xf3, 0xd1, 0xe9, 0xb3,
+	0xd6, 0x2d, 0xf5, 0x4d, 0x9f, 0x7d, 0xf1, 0xe2, 0xe5, 0xb3, 0x56, 0xce, 0x39, 0x05, 0x38, 0x5b,
+	0x4a, 0x8a, 0xaf, 0x97, 0x28, 0x24, 0x21, 0x50, 0x5c, 0x30, 0x79, 0xae, 0x03, 0x50, 0xa5, 0xfa,
+	0x9b, 0x7c, 0x02, 0x15, 0xeb, 0x2d, 0x9d, 0x18, 0xb5, 0x3e, 0xd9, 0x8c, 0x0b, 0x4d, 0x58, 0x9c,
+	0x2e, 0xc0, 0x10, 0x6f, 0xd2, 0xe7, 0xfc, 0x3b, 0x0f, 0xb5, 0xe7, 0xbe, 0x58, 0xf1, 0x1c, 0x42,
+	0x79, 0x11, 0xe3, 0xcc, 0xbf, 0xb0, 0x5c, 0xf6, 0xa4, 0x32, 0x47, 0x48, 0x16, 0x4b, 0x97, 0xcd,
+	0x92, 0xbb, 0xab, 0x14, 0x34, 0xf4, 0x44, 0x21, 0xe4, 0x7d, 0x00, 0x8c, 0x3c, 0x77, 0x82, 0x33,
+	0x1e, 0xa3, 0x0e, 0x7c, 0x95, 0x56, 0x31, 0xf2, 0x06, 0x1a, 0x20, 0xef, 0x41, 0x35, 0xc6, 0xe9,
+	0x32, 0x16, 0xfe, 0x97, 0x26, 0xee, 0x7b, 0x74, 0x0d, 0xa8, 0x2e, 0x12, 0xf8, 0xa1, 0x2f, 0x6d,
+	0xe1, 0x9b, 0x83, 0x52, 0xa9, 0xbc, 0xe7, 0xce, 0x02, 0x36, 0x17, 0x3a, 0xa0, 0x15, 0x5a, 0x55,
+	0xc8, 0x4f, 0x15, 0xa0, 0x8a, 0x44, 0xf5, 0x24, 0x9d, 0x11, 0x15, 0x9d, 0x11, 0xaa, 0x47, 0xe9,
+	0x6c, 0x50, 0x24, 0x76, 0x61, 0x48, 0x7b, 0x96, 0xc4, 0x2e, 0x34, 0xe9, 0x09, 0x34, 0x43, 0xee,
+	0xf9, 0x33, 0x1f, 0x3d, 0xfb, 0x96, 0xea, 0xce, 0x30, 0x37, 0x12, 0x09, 0xf3, 0xd4, 0xa7, 0xb0,
+	0xbf, 0x52, 0x61, 0xdf, 0x0b, 0xbb, 0x53, 0x25, 0x11, 0x31, 0x0e, 0x71, 0x1a, 0x50, 0xd3, 0xa1,
+	0x16, 0x0b, 0x1e, 0x09, 0x74, 0xfe, 0x99, 0x83, 0xda, 0x10, 0x57, 0xe7, 0x74, 0x9c, 0x73, 0x3b,
+	0xe3, 0x4c, 0xba, 0x50, 0x52, 0x8d, 0x48, 0xb4, 0xf3, 0xba, 0x19, 0x40, 0x4f, 0x9d, 0x7a, 0xaa,
+	0x47, 0x51, 0x43, 0x20, 0x3f, 0x86, 0xc2, 0x62, 0xc2, 0x74, 0x5c, 0x6a, 0xfd, 0x8f, 0x7a, 0xeb,
+	0x89, 0x11, 0xf3, 0xa5, 0x44, 0xd1, 0x3b, 0x63, 0x97, 0x18, 0x0f, 0x58, 0xe4, 0xbd, 0xf1, 0x3d,
+	0x79, 0xfe, 0x24, 0x08, 0xf8, 0x54, 0xa7, 0x35, 0x55, 0x62, 0xe4, 0x19, 0x34, 0xd8, 0x52, 0x9e,
+	0xf3, 0xd8, 0x7f, 0xab, 0x51, 0x5b, 0xb9, 0x47, 0x9b, 0x7a, 0xc6, 0xfe, 0x3c, 0x42, 0xef, 0x0b,
+	0x14, 0x82, 0xcd, 0x91, 0x66, 0xa5, 0x9c, 0xbf, 0xe7, 0xa0, 0x6e, 0x92, 0xcd, 0xbe, 0xb2, 0x0f,
+	0x25, 0x5f, 0x62, 0x28, 0xda, 0x39, 0x6d, 0xf7, 0x7b, 0xa9, 0x37, 0xa6, 0xf9, 0x7a, 0x23, 0x89,
+	0x21, 0x35, 0xac, 0x2a, 0x8b, 0x43, 0xe5, 0xf2, 0xbc, 0x4e, 0x22, 0xfd, 0xad, 0xb2, 0x56, 0xa5,
+	0x12, 0x8f, 0x6d, 0xe2, 0xd9, 0x53, 0x07, 0xa1, 0xa8, 0x44, 0xbf, 0x7d, 0x25, 0xa9, 0x31, 0xe1,
+	0x0b, 0xd7, 0x96, 0x46, 0x41, 0x5f, 0xbd, 0xe7, 0x8b, 0x33, 0x7d, 0x76, 0xfe, 0x0f, 0x1a, 0x27,
+	0x18, 0xa0, 0xc4, 0x9b, 0x2a, 0xad, 0x05, 0xcd, 0x84, 0xc9, 0xc6, 0x7c, 0x06, 0xb7, 0x0d, 0x62,
+	0xd4, 0xec, 0x2a, 0xc1, 0x63, 0xa8, 0xbf, 0x39, 0xe7, 0x01, 0xba, 0x93, 0xe5, 0xf4, 0x15, 0x4a,
+	0xeb, 0x80, 0x9a, 0xc6, 0x06, 0x1a, 0x5a, 0xd7, 0x51, 0x21, 0x55, 0x47, 0xce, 0x1f, 0xf2, 0x70,
+	0x90, 0xbd, 0xc8, 0xba, 0xff, 0x03, 0xd8, 0xf7, 0x34, 0xee, 0xb9, 0x7c, 0xf2, 0x7b, 0x9c, 0x4a,
+	0xa1, 0xaf, 0x2c, 0xd0, 0xa6, 0x85, 0x5f, 0x18, 0x54, 0xcd, 0xf3, 0x84, 0xd1, 0x36, 0x5d, 0xa1,
+	0xaf, 0x2f, 0xd0, 0x44, 0x81, 0x6d, 0xd9, 0x82, 0x3c, 0x86, 0xfd, 0x64, 0x3e, 0x19, 0xd7, 0x25,
+	0x13, 0xea, 0x3a, 0xf7, 0x36, 0xed, 0x74, 0xb2, 0x9c, 0xab, 0xd8, 0x16, 0x53, 0xb1, 0xdd, 0xc8,
+	0xbd, 0xd2, 0x37, 0xca, 0xbd, 0x18, 0x9a, 0x23, 0x89, 0x31, 0x93, 0xb8, 0xcb, 0xcf, 0x07, 0x50,
+	0x9a, 0xf9, 0xb1, 0x90, 0xb6, 0xc9, 0x99, 0x03, 0x69, 0x43, 0xc5, 0xf4, 0x2b, 0xb4, 0xe1, 0x4f,
+	0x8e, 0x86, 0xf2, 0x25, 0xc6, 0x22, 0xb1, 0x3b, 0x39, 0x3a, 0xbf, 0x85, 0xa3, 0xad, 0x75, 0x65,
+	0x8d, 0xf8, 0x21, 0x94, 0xd9, 0x54, 0x3f, 0xcb, 0x8c, 0xd9, 0xe3, 0xcd, 0x67, 0xad, 0xa5, 0x35,
+	0x23, 0xb5, 0x02, 0xce, 0xef, 0xa0, 0xbb, 0x5d, 0xbb, 0x8d, 0xb0, 0x2d, 0xfb, 0xdc, 0x37, 0x2a,
+	0x7b, 0xe7, 0x00, 0xc8, 0x59, 0xcc, 0x55, 0x0a, 0x8c, 0xa2, 0x19, 0xb7, 0x26, 0x3b, 0x3f, 0x80,
+	0xdb, 0x19, 0xd4, 0x5e, 0x75, 0x0c, 0xf5, 0x85, 0x81, 0x5d, 0xc1, 0x02, 0xa9, 0xef, 0xac, 0xd3,
+	0x9a, 0xc5, 0xc6, 0x2c, 0x90, 0xce, 0x1f, 0xf3, 0x40, 0xc6, 0x18, 0xe0, 0x54, 0xaa, 0xd6, 0x24,
+	0x12, 0x1f, 0x7c, 0xab, 0x55, 0xe9, 0xea, 0x6e, 0x90, 0xdf, 0xdc, 0x0d, 0xbe, 0x07, 0x4d, 0xbc,
+	0x98, 0x06, 0x4b, 0x0f, 0x3d, 0xd7, 0xb4, 0x49, 0x95, 0x91, 0xf5, 0x01, 0xa4, 0x16, 0xb9, 0x46,
+	0xc2, 0xa1, 0x2d, 0x53, 0x5b, 0x29, 0x0b, 0x02, 0xfe, 0x06, 0x3d, 0x77, 0xca, 0x97, 0x91, 0x8c,
+	0x7d, 0x14, 0xed, 0x62, 0xb7, 0xf0, 0xa0, 0x4a, 0x5b, 0x96, 0xf0, 0x34, 0xc1, 0xc9, 0x77, 0x81,
+	0xac, 0xf4, 0xaf, 0xb9, 0x4b, 0x9a, 0xfb, 0x9d, 0x84, 0xb2, 0x62, 0x77, 0x3e, 0x83, 0xdb, 0x19,
+	0x27, 0x58, 0xff, 0xad, 0x7a, 0x78, 0x6e, 0x4b, 0x0f, 0x77, 0xfe, 0x94, 0x83, 0x3b, 0x63, 0x94,
+	0xa6, 0x88, 0x7f, 0xbe, 0xe4, 0x92, 0xa5, 0x52, 0xd9, 0x36, 0x05, 0x9b, 0xca, 0xe6, 0x94, 0x4a,
+	0xf1, 0x7c, 0x26, 0xc5, 0xef, 0x41, 0x55, 0xcd, 0xc7, 0xc9, 0xa5, 0xd4, 0xce, 0x50, 0x1e, 0x53,
+	0x03, 0x73, 0xa0, 0xce, 0x7a, 0x49, 0x64, 0x17, 0xab, 0x8e, 0x50, 0xd4, 0x64, 0x08, 0xd9, 0x85,
+	0xed, 0x06, 0x4e, 0x1b, 0x0e, 0xaf, 0x9a, 0x61, 0x3b, 0xda, 0x23, 0x38, 0x1c, 0x66, 0x28, 0x62,
+	0x87, 0x85, 0xce, 0x5f, 0x72, 0x50, 0x4b, 0xf1, 0x6f, 0x2d, 0xca, 0x8c, 0xc5, 0xf9, 0x9b, 0x2d,
+	0x2e, 0x5c, 0xb5, 0x58, 0x6d, 0x12, 0x4b, 0xa1, 0xa6, 0xb5, 0x16, 0x37, 0x2f, 0xaa, 0x2a, 0xc4,
+	0xc8, 0x1f, 0x43, 0x5d, 0x93, 0x13, 0x05, 0x76, 0xbf, 0x54, 0x58, 0xf2, 0xe6, 0x11, 0xdc, 0xdd,
+	0x78, 0x99, 0x0d, 0x5c, 0x0f, 0xca, 0xaf, 0x35, 0xd2, 0xce, 0x6d, 0xac, 0xe2, 0x69, 0x27, 0x59,
+	0x2e, 0xe7, 0x97, 0x00, 0xa6, 0x5d, 0x3f, 0xe7, 0xd3, 0x57, 0xe6, 0xa7, 0x92, 0xc4, 0x48, 0xaf,
+	0x9d, 0x0b, 0x8c, 0x7d, 0xee, 0xd9, 0x26, 0xbc, 0xbf, 0xc2, 0xcf, 0x34, 0xac, 0x5e, 0x11, 0xe0,
+	0x9c, 0x05, 0xae, 0xfe, 0x8d, 0x64, 0xda, 0x7f, 0x55, 0x23, 0x3f, 0xe3, 0x81, 0xe7, 0xfc, 0x1a,
+	0x0e, 0xc6, 0x28, 0xd7, 0xaa, 0x77, 0x25, 0xc7, 0x87, 0x50, 0x0c, 0xf8, 0xf4, 0x95, 0x9d, 0x7e,
+	0x77, 0x52, 0x56, 0xa7, 0x74, 0x68, 0x16, 0xe7, 0xae, 0x4e, 0xbc, 0xb4, 0x6a, 0x1b, 0xf0, 0x1e,
+	0x1c, 0x0c, 0xbf, 0xc6, 0x9d, 0xce, 0x00, 0xee, 0x0c, 0xaf, 0x53, 0xb4, 0x32, 0x26, 0xb7, 0xd3,
+	0x98, 0xfe, 0xbf, 0x2a, 0x50, 0xb5, 0x13, 0xe3, 0x64, 0x40, 0x3e, 0x85, 0xc2, 0xd9, 0x52, 0x92,
+	0xb4, 0xc4, 0x7a, 0x85, 0xee, 0x1c, 0x5e, 0x85, 0xed, 0x75, 0x9f, 0x42, 0x61, 0x88, 0x59, 0xa9,
+	0x21, 0x5e, 0x2b, 0x95, 0x5e, 0xca, 0x3e, 0x83, 0xa2, 0x5a, 0x4b, 0xc8, 0xe1, 0xc6, 0x9e, 0x62,
+	0xe4, 0xee, 0x6e, 0xd9, 0x5f, 0xc8, 0xe7, 0x00, 0xea, 0x3c, 0x96, 0x31, 0xb2, 0xf0, 0x6b, 0x8b,
+	0x3f, 0xca, 0x91, 0x9f, 0x40, 0xd9, 0x4c, 0x70, 0x92, 0xfe, 0xb5, 0x94, 0x59, 0x3a, 0x3a, 0xef,
+	0x5e, 0x43, 0xb1, 0xf7, 0xbf, 0x80, 0x7a, 0x7a, 0x01, 0x20, 0xf7, 0x37, 0x58, 0x33, 0x2b, 0x48,
+	0xe7, 0x68, 0x2b, 0xdd, 0x2a, 0x14, 0xd0, 0xde, 0x36, 0x3a, 0xc8, 0x47, 0x69, 0x9f, 0xdf, 0x3c,
+	0xfe, 0x3a, 0x1f, 0xff, 0x4f, 0xbc, 0xf6, 0xd2, 0xe7, 0xaa, 0x55, 0xac, 0x06, 0x0f, 0x79, 0x3f,
+	0x53, 0x67, 0x57, 0xc7, 0x54, 0xe7, 0xfe, 0x36, 0xf2, 0x5a, 0x5b, 0xaa, 0x0d, 0x67, 0xb4, 0x6d,
+	0xce, 0xa8, 0xce, 0xfd, 0x6d, 0x64, 0xab, 0xed, 0x17, 0xd0, 0xcc, 0xf6, 0x44, 0xd2, 0xcd, 0x48,
+	0x5c, 0xd3, 0xb5, 0x3b, 0xc7, 0x37, 0x70, 0x58, 0xb5, 0xbf, 0x82, 0xfd, 0x2b, 0x6d, 0x87, 0x1c,
+	0x67, 0x93, 0xf3, 0x9a, 0x66, 0xdb, 0x71, 0x6e, 0x62, 0xb1, 0x9a, 0x29, 0x34, 0x32, 0x25, 0x4d,
+	0x8e, 0xb2, 0xd6, 0x6c, 0xd4, 0x74, 0xa7, 0xbb, 0x9d, 0x61, 0xad, 0x73, 0xb8, 0x55, 0xe7, 0x70,
+	0x97, 0xce, 0x6b, 0x1b, 0xc3, 0xa0, 0xf8, 0x9b, 0xfc, 0x62, 0x32, 0x29, 0xeb, 0x5f, 0x54, 0xdf,
+	0xff, 0xef, 0x00, 0x6e, 0x8e, 0xe1, 0x36, 0x40, 0x13, 0x00, 0x00,
 }
@@ -30,7 +30,7 @@ func (m *SegmentMeta) Reset()         { *m = SegmentMeta{} }
 func (m *SegmentMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentMeta) ProtoMessage()    {}
 func (*SegmentMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streams_bbbe703970d9d652, []int{0}
+	return fileDescriptor_streams_84f2a0dbcab3f338, []int{0}
 }
 func (m *SegmentMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentMeta.Unmarshal(m, b)
@@ -65,10 +65,12 @@ func (m *SegmentMeta) GetKeyNonce() []byte {
 }
 
 type StreamInfo struct {
-	NumberOfSegments     int64    `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
-	SegmentsSize         int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
-	LastSegmentSize      int64    `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
-	Metadata             []byte   `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	NumberOfSegments int64  `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
+	SegmentsSize     int64  `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
+	LastSegmentSize  int64  `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
+	Metadata         []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// checksum is the SHA-256 of the plaintext content
+	Checksum             []byte   `protobuf:"bytes,5,opt,name=checksum,proto3" json:"checksum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -78,7 +80,7 @@ func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
 func (m *StreamInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamInfo) ProtoMessage()    {}
 func (*StreamInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streams_bbbe703970d9d652, []int{1}
+	return fileDescriptor_streams_84f2a0dbcab3f338, []int{1}
 }
 func (m *StreamInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfo.Unmarshal(m, b)
@@ -126,21 +128,31 @@ func (m *StreamInfo) GetMetadata() []byte {
 	return nil
 }
 
+func (m *StreamInfo) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
 type StreamMeta struct {
-	EncryptedStreamInfo  []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
-	EncryptionType       int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
-	EncryptionBlockSize  int32        `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
-	LastSegmentMeta      *SegmentMeta `protobuf:"bytes,4,opt,name=last_segment_meta,json=lastSegmentMeta,proto3" json:"last_segment_meta,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	EncryptedStreamInfo []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
+	EncryptionType      int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
+	EncryptionBlockSize int32        `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
+	LastSegmentMeta     *SegmentMeta `protobuf:"bytes,4,opt,name=last_segment_meta,json=lastSegmentMeta,proto3" json:"last_segment_meta,omitempty"`
+	// stream_size is the plaintext size of the stream, it isn't encrypted, so
+	// that the satellite can filter listings by it
+	StreamSize           int64    `protobuf:"varint,5,opt,name=stream_size,json=streamSize,proto3" json:"stream_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StreamMeta) Reset()         { *m = StreamMeta{} }
 func (m *StreamMeta) String() string { return proto.CompactTextString(m) }
 func (*StreamMeta) ProtoMessage()    {}
 func (*StreamMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streams_bbbe703970d9d652, []int{2}
+	return fileDescriptor_streams_84f2a0dbcab3f338, []int{2}
 }
 func (m *StreamMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamMeta.Unmarshal(m, b)
@@ -188,33 +200,42 @@ func (m *StreamMeta) GetLastSegmentMeta() *SegmentMeta {
 	return nil
 }
 
+func (m *StreamMeta) GetStreamSize() int64 {
+	if m != nil {
+		return m.StreamSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SegmentMeta)(nil), "streams.SegmentMeta")
 	proto.RegisterType((*StreamInfo)(nil), "streams.StreamInfo")
 	proto.RegisterType((*StreamMeta)(nil), "streams.StreamMeta")
 }
 
-func init() { proto.RegisterFile("streams.proto", fileDescriptor_streams_bbbe703970d9d652) }
-
-var fileDescriptor_streams_bbbe703970d9d652 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xcb, 0x4e, 0xc3, 0x30,
-	0x10, 0x54, 0x5f, 0x50, 0xb6, 0x29, 0x05, 0x03, 0x52, 0x04, 0x17, 0x14, 0x0e, 0x20, 0x84, 0x7a,
-	0x28, 0x3f, 0x80, 0x7a, 0x43, 0x08, 0x2a, 0x25, 0x9c, 0xb8, 0x58, 0x4e, 0xba, 0x41, 0x51, 0x1a,
-	0x3b, 0x8a, 0xcd, 0xc1, 0xfd, 0x21, 0x3e, 0x8b, 0x5f, 0x41, 0xb6, 0xf3, 0x82, 0xe3, 0xce, 0x8c,
-	0x66, 0x67, 0x76, 0x61, 0x2e, 0x55, 0x85, 0xac, 0x90, 0xcb, 0xb2, 0x12, 0x4a, 0x90, 0xc3, 0x7a,
-	0x0c, 0x36, 0x30, 0x8b, 0xf0, 0xb3, 0x40, 0xae, 0x5e, 0x51, 0x31, 0x72, 0x03, 0x73, 0xe4, 0x49,
-	0xa5, 0x4b, 0x85, 0x5b, 0x9a, 0xa3, 0xf6, 0x07, 0xd7, 0x83, 0x3b, 0x2f, 0xf4, 0x5a, 0xf0, 0x05,
-	0x35, 0xb9, 0x82, 0xa3, 0x1c, 0x35, 0xe5, 0x82, 0x27, 0xe8, 0x0f, 0xad, 0x60, 0x9a, 0xa3, 0x7e,
-	0x33, 0x73, 0xf0, 0x3d, 0x00, 0x88, 0xac, 0xf9, 0x33, 0x4f, 0x05, 0x79, 0x00, 0xc2, 0xbf, 0x8a,
-	0x18, 0x2b, 0x2a, 0x52, 0x2a, 0xdd, 0x26, 0x69, 0x5d, 0x47, 0xe1, 0x89, 0x63, 0x36, 0x69, 0x9d,
-	0x40, 0x9a, 0xf5, 0x8d, 0x86, 0xca, 0x6c, 0xef, 0xdc, 0x47, 0xa1, 0xd7, 0x80, 0x51, 0xb6, 0x47,
-	0x72, 0x0f, 0xa7, 0x3b, 0x26, 0x55, 0xe3, 0xe6, 0x84, 0x23, 0x2b, 0x5c, 0x18, 0xa2, 0x76, 0xb3,
-	0xda, 0x4b, 0x98, 0x16, 0xa8, 0xd8, 0x96, 0x29, 0xe6, 0x8f, 0x5d, 0xd2, 0x66, 0x0e, 0x7e, 0xda,
-	0xa4, 0xb6, 0xfa, 0x0a, 0x2e, 0xba, 0xea, 0xee, 0x3c, 0x34, 0xe3, 0xa9, 0xa8, 0x4f, 0x70, 0xd6,
-	0x92, 0xbd, 0x76, 0xb7, 0xb0, 0xa8, 0xe1, 0x4c, 0x70, 0xaa, 0x74, 0xe9, 0x12, 0x4f, 0xc2, 0xe3,
-	0x0e, 0x7e, 0xd7, 0x25, 0xf6, 0xcc, 0x8d, 0x30, 0xde, 0x89, 0x24, 0xef, 0x72, 0x4f, 0x5a, 0xf3,
-	0x4c, 0xf0, 0xb5, 0xe1, 0x6c, 0xf6, 0xa7, 0x7f, 0x3d, 0x4d, 0x70, 0x5b, 0x62, 0xb6, 0x3a, 0x5f,
-	0x36, 0xef, 0xec, 0x3d, 0xef, 0x4f, 0x7b, 0x03, 0xac, 0xc7, 0x1f, 0xc3, 0x32, 0x8e, 0x0f, 0xec,
-	0xcb, 0x1f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x5e, 0x32, 0x31, 0x03, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("streams.proto", fileDescriptor_streams_84f2a0dbcab3f338) }
+
+var fileDescriptor_streams_84f2a0dbcab3f338 = []byte{
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0xcd, 0x4e, 0xf3, 0x30,
+	0x10, 0x54, 0x7f, 0xf2, 0x7d, 0x65, 0xdb, 0x52, 0x08, 0x20, 0x45, 0x70, 0x00, 0x95, 0x03, 0x08,
+	0xa1, 0x1e, 0xca, 0x0b, 0xa0, 0xde, 0x10, 0x82, 0x4a, 0x29, 0x27, 0x2e, 0x96, 0x93, 0x6e, 0x20,
+	0x4a, 0x6d, 0x47, 0xb1, 0x7b, 0x70, 0x4f, 0xbc, 0x1b, 0x2f, 0x86, 0xfc, 0xd7, 0x16, 0x8e, 0x3b,
+	0x33, 0x9a, 0xf5, 0xcc, 0x1a, 0x86, 0x52, 0x35, 0x48, 0x99, 0x9c, 0xd4, 0x8d, 0x50, 0x22, 0xfe,
+	0xef, 0xc7, 0xf1, 0x1c, 0xfa, 0x0b, 0xfc, 0x60, 0xc8, 0xd5, 0x0b, 0x2a, 0x1a, 0x5f, 0xc3, 0x10,
+	0x79, 0xde, 0xe8, 0x5a, 0xe1, 0x92, 0x54, 0xa8, 0x93, 0xd6, 0x55, 0xeb, 0x76, 0x90, 0x0e, 0xb6,
+	0xe0, 0x33, 0xea, 0xf8, 0x02, 0x0e, 0x2a, 0xd4, 0x84, 0x0b, 0x9e, 0x63, 0xd2, 0xb6, 0x82, 0x5e,
+	0x85, 0xfa, 0xd5, 0xcc, 0xe3, 0xef, 0x16, 0xc0, 0xc2, 0x9a, 0x3f, 0xf1, 0x42, 0xc4, 0xf7, 0x10,
+	0xf3, 0x35, 0xcb, 0xb0, 0x21, 0xa2, 0x20, 0xd2, 0x6d, 0x92, 0xd6, 0xb5, 0x93, 0x1e, 0x39, 0x66,
+	0x5e, 0xf8, 0x17, 0x48, 0xb3, 0x3e, 0x68, 0x88, 0x2c, 0x37, 0xce, 0xbd, 0x93, 0x0e, 0x02, 0xb8,
+	0x28, 0x37, 0x18, 0xdf, 0xc1, 0xf1, 0x8a, 0x4a, 0x15, 0xdc, 0x9c, 0xb0, 0x63, 0x85, 0x23, 0x43,
+	0x78, 0x37, 0xab, 0x3d, 0x87, 0x1e, 0x43, 0x45, 0x97, 0x54, 0xd1, 0xa4, 0xeb, 0x5e, 0x1a, 0x66,
+	0xc3, 0xe5, 0x9f, 0x98, 0x57, 0x72, 0xcd, 0x92, 0xc8, 0x71, 0x61, 0x1e, 0x7f, 0xb5, 0x43, 0x0a,
+	0x5b, 0xcb, 0x14, 0xce, 0x76, 0xb5, 0xb8, 0xea, 0x48, 0xc9, 0x0b, 0xe1, 0xeb, 0x39, 0xd9, 0x92,
+	0x7b, 0xc9, 0x6f, 0x60, 0xe4, 0xe1, 0x52, 0x70, 0xa2, 0x74, 0xed, 0xd2, 0x44, 0xe9, 0xe1, 0x0e,
+	0x7e, 0xd3, 0x35, 0xee, 0x99, 0x1b, 0x61, 0xb6, 0x12, 0x79, 0xb5, 0xcb, 0x14, 0x6d, 0xcd, 0x4b,
+	0xc1, 0x67, 0x86, 0xb3, 0xb9, 0x1e, 0xff, 0x74, 0xc0, 0xd0, 0x07, 0xec, 0x4f, 0x4f, 0x27, 0xe1,
+	0xd4, 0x7b, 0x87, 0xfd, 0xd5, 0x8c, 0x8d, 0x74, 0x09, 0x7d, 0x1f, 0xc4, 0xee, 0x8a, 0x6c, 0x7f,
+	0xe0, 0x20, 0xb3, 0x62, 0xd6, 0x7d, 0x6f, 0xd7, 0x59, 0xf6, 0xcf, 0xfe, 0x97, 0x87, 0x9f, 0x01,
+	0x00, 0xe1, 0x3c, 0xa1, 0xbf, 0x40, 0x02, 0x00, 0x00,
 }
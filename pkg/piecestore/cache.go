@@ -0,0 +1,164 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"unsafe"
+
+	"github.com/zeebo/errs"
+)
+
+// CacheMode is how piece reads use the page cache of the operating system
+type CacheMode string
+
+const (
+	// CacheNormal reads pieces through the page cache
+	CacheNormal = CacheMode("normal")
+	// CacheDontNeed drops the read data from the page cache with posix_fadvise
+	CacheDontNeed = CacheMode("fadvise")
+	// CacheDirect bypasses the page cache with O_DIRECT, it falls back to
+	// CacheDontNeed where direct I/O isn't supported
+	CacheDirect = CacheMode("direct")
+)
+
+// ParseCacheMode parses a cache mode, an empty string is CacheNormal
+func ParseCacheMode(s string) (CacheMode, error) {
+	switch mode := CacheMode(s); mode {
+	case "":
+		return CacheNormal, nil
+	case CacheNormal, CacheDontNeed, CacheDirect:
+		return mode, nil
+	default:
+		return "", Error.New("invalid cache mode %q", s)
+	}
+}
+
+// errDirectUnsupported is returned when the platform doesn't support direct I/O
+var errDirectUnsupported = errs.Class("direct I/O unsupported")
+
+// ReaderMode returns a reader for the specified piece, which uses the page
+// cache according to mode. Large sequential reads, which won't be repeated
+// soon, shouldn't evict the data that other reads depend on.
+func (storage *Storage) ReaderMode(ctx context.Context, pieceID string, offset int64, length int64, mode CacheMode) (io.ReadCloser, error) {
+	if mode != CacheDontNeed && mode != CacheDirect {
+		return storage.Reader(ctx, pieceID, offset, length)
+	}
+
+	path, offset, length, err := storage.pieceRange(pieceID, offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode == CacheDirect {
+		file, err := openDirect(path)
+		if err == nil {
+			return newDirectReader(file, offset, length), nil
+		}
+		if !errDirectUnsupported.Has(err) && !isInvalid(err) {
+			return nil, Open.Wrap(err)
+		}
+		// e.g. tmpfs doesn't support O_DIRECT
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, Open.Wrap(err)
+	}
+	// the advice is only a hint, reading works without it
+	_ = fadvise(file, offset, length, adviceSequential)
+
+	return &dontNeedReader{
+		SectionReader: io.NewSectionReader(file, offset, length),
+		file:          file,
+		offset:        offset,
+		length:        length,
+	}, nil
+}
+
+// dontNeedReader drops the read range from the page cache when it's closed
+type dontNeedReader struct {
+	*io.SectionReader
+	file   *os.File
+	offset int64
+	length int64
+}
+
+// Close drops the read range from the page cache and closes the file
+func (reader *dontNeedReader) Close() error {
+	_ = fadvise(reader.file, reader.offset, reader.length, adviceDontNeed)
+	return reader.file.Close()
+}
+
+const (
+	// directAlignment is the alignment of offsets and buffers for direct I/O
+	directAlignment = 4096
+	// directBufferSize is the size of a single direct read
+	directBufferSize = 256 * directAlignment
+)
+
+// directReader reads a range of a file opened for direct I/O, which
+// requires aligned offsets and buffers
+type directReader struct {
+	file      *os.File
+	buffer    []byte
+	pending   []byte
+	position  int64
+	skip      int
+	remaining int64
+}
+
+func newDirectReader(file *os.File, offset, length int64) *directReader {
+	aligned := offset - offset%directAlignment
+	return &directReader{
+		file:      file,
+		buffer:    alignedBuffer(directBufferSize),
+		position:  aligned,
+		skip:      int(offset - aligned),
+		remaining: length,
+	}
+}
+
+// Read reads from the range
+func (reader *directReader) Read(p []byte) (n int, err error) {
+	if reader.remaining <= 0 {
+		return 0, io.EOF
+	}
+
+	if len(reader.pending) == 0 {
+		n, err := reader.file.ReadAt(reader.buffer, reader.position)
+		if n <= reader.skip {
+			if err == nil || err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		reader.position += int64(n)
+		reader.pending = reader.buffer[reader.skip:n]
+		reader.skip = 0
+	}
+
+	if int64(len(p)) > reader.remaining {
+		p = p[:reader.remaining]
+	}
+	n = copy(p, reader.pending)
+	reader.pending = reader.pending[n:]
+	reader.remaining -= int64(n)
+	return n, nil
+}
+
+// Close closes the file
+func (reader *directReader) Close() error { return reader.file.Close() }
+
+// alignedBuffer allocates a buffer, which starts at an aligned address
+func alignedBuffer(size int) []byte {
+	buffer := make([]byte, size+directAlignment)
+	offset := int(uintptr(unsafe.Pointer(&buffer[0])) & (directAlignment - 1))
+	if offset != 0 {
+		offset = directAlignment - offset
+	}
+	return buffer[offset : offset+size]
+}
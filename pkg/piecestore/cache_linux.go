@@ -0,0 +1,32 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build linux
+
+package pstore
+
+import (
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	adviceSequential = unix.FADV_SEQUENTIAL
+	adviceDontNeed   = unix.FADV_DONTNEED
+)
+
+func fadvise(file *os.File, offset, length int64, advice int) error {
+	return unix.Fadvise(int(file.Fd()), offset, length, advice)
+}
+
+func openDirect(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_RDONLY|unix.O_DIRECT, 0)
+}
+
+func isInvalid(err error) bool {
+	if pathErr, ok := err.(*os.PathError); ok {
+		err = pathErr.Err
+	}
+	return err == unix.EINVAL
+}
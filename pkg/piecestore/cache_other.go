@@ -0,0 +1,23 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// +build !linux
+
+package pstore
+
+import (
+	"os"
+)
+
+const (
+	adviceSequential = 0
+	adviceDontNeed   = 0
+)
+
+func fadvise(file *os.File, offset, length int64, advice int) error { return nil }
+
+func openDirect(path string) (*os.File, error) {
+	return nil, errDirectUnsupported.New("%s", path)
+}
+
+func isInvalid(err error) bool { return false }
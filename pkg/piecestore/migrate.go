@@ -0,0 +1,187 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/sync2"
+)
+
+// MigrateError is the error class for failed migrations
+var MigrateError = errs.Class("migrate error")
+
+// MigrateStats contains the outcome of a migration
+type MigrateStats struct {
+	Copied      int64
+	Skipped     int64
+	CopiedBytes int64
+}
+
+// Migrate copies all pieces and databases from the directory from into the
+// directory to, limiting the copy to rate bytes per second when rate > 0.
+//
+// Every copied file is verified against its source. Files which were already
+// copied by a previous run and haven't changed since are skipped, so a first
+// run can copy most of the data while the node is still running and a second
+// run with the node stopped only copies what changed in the meantime.
+func Migrate(ctx context.Context, from, to string, rate int64) (stats MigrateStats, err error) {
+	from, to = filepath.Clean(from), filepath.Clean(to)
+	if from == to {
+		return stats, MigrateError.New("source and destination are the same directory")
+	}
+	if rel, err := filepath.Rel(from, to); err == nil && !filepath.IsAbs(rel) && rel != ".." && !hasParentPrefix(rel) {
+		return stats, MigrateError.New("destination %q is inside of source %q", to, from)
+	}
+
+	throttle := newCopyThrottle(rate)
+
+	err = filepath.Walk(from, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		rel, err := filepath.Rel(from, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(to, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, 0700)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		if unchanged(info, target) {
+			stats.Skipped++
+			return nil
+		}
+
+		if err := copyFile(ctx, path, target, info, throttle); err != nil {
+			return MigrateError.New("copying %q: %v", rel, err)
+		}
+		stats.Copied++
+		stats.CopiedBytes += info.Size()
+		return nil
+	})
+	return stats, err
+}
+
+func hasParentPrefix(rel string) bool {
+	return len(rel) >= 3 && rel[:3] == ".."+string(filepath.Separator)
+}
+
+// unchanged returns whether target is a copy of the file described by info
+// made by a previous migration
+func unchanged(info os.FileInfo, target string) bool {
+	targetInfo, err := os.Stat(target)
+	if err != nil {
+		return false
+	}
+	return targetInfo.Size() == info.Size() && targetInfo.ModTime().Equal(info.ModTime())
+}
+
+// copyFile copies source to target through a temporary file, which is only
+// renamed to target after its contents have been verified
+func copyFile(ctx context.Context, source, target string, info os.FileInfo, throttle *copyThrottle) (err error) {
+	src, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, src.Close()) }()
+
+	temp := target + ".migrating"
+	dst, err := os.OpenFile(temp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(temp)
+		}
+	}()
+
+	sourceHash := sha256.New()
+	_, err = sync2.Copy(ctx, &throttledWriter{ctx: ctx, writer: dst, throttle: throttle}, io.TeeReader(src, sourceHash))
+	if err != nil {
+		return errs.Combine(err, dst.Close())
+	}
+	if err := errs.Combine(dst.Sync(), dst.Close()); err != nil {
+		return err
+	}
+
+	// verify what has been written to the disk, not what has been sent to it
+	copied, err := os.Open(temp)
+	if err != nil {
+		return err
+	}
+	targetHash := sha256.New()
+	_, err = io.Copy(targetHash, copied)
+	if err := errs.Combine(err, copied.Close()); err != nil {
+		return err
+	}
+	if !bytes.Equal(sourceHash.Sum(nil), targetHash.Sum(nil)) {
+		return MigrateError.New("verification failed, copy differs from the source")
+	}
+
+	if err := os.Chtimes(temp, info.ModTime(), info.ModTime()); err != nil {
+		return err
+	}
+	return os.Rename(temp, target)
+}
+
+// copyThrottle limits the rate of the copy to a number of bytes per second
+type copyThrottle struct {
+	rate    int64
+	start   time.Time
+	written int64
+}
+
+func newCopyThrottle(rate int64) *copyThrottle {
+	return &copyThrottle{rate: rate, start: time.Now()}
+}
+
+// wait records n written bytes and sleeps until the copy is within the rate
+func (throttle *copyThrottle) wait(ctx context.Context, n int64) error {
+	if throttle.rate <= 0 {
+		return nil
+	}
+	throttle.written += n
+	expected := time.Duration(float64(throttle.written) / float64(throttle.rate) * float64(time.Second))
+	if delay := expected - time.Since(throttle.start); delay > 0 {
+		if !sync2.Sleep(ctx, delay) {
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// throttledWriter writes to writer within the rate of throttle
+type throttledWriter struct {
+	ctx      context.Context
+	writer   io.Writer
+	throttle *copyThrottle
+}
+
+// Write implements io.Writer
+func (writer *throttledWriter) Write(data []byte) (int, error) {
+	n, err := writer.writer.Write(data)
+	if err != nil {
+		return n, err
+	}
+	return n, writer.throttle.wait(writer.ctx, int64(n))
+}
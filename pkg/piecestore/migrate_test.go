@@ -0,0 +1,91 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pstore
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+)
+
+func TestMigrate(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	from, to := ctx.Dir("from"), ctx.Dir("to")
+
+	store := NewStorage(from)
+	defer ctx.Check(store.Close)
+
+	pieces := map[string][]byte{}
+	for i := 0; i < 5; i++ {
+		pieceID := strings.Repeat(string('A'+byte(i))+"B01", 10)
+		data := make([]byte, 1000*(i+1))
+		_, _ = rand.Read(data)
+
+		w, err := store.Writer(pieceID)
+		require.NoError(t, err)
+		_, err = io.Copy(w, bytes.NewReader(data))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		pieces[pieceID] = data
+	}
+	require.NoError(t, ioutil.WriteFile(filepath.Join(from, "piecestore.db"), []byte("database"), 0600))
+
+	_, err := Migrate(ctx, from, filepath.Join(from, "nested"), 0)
+	assert.True(t, MigrateError.Has(err))
+
+	stats, err := Migrate(ctx, from, to, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(pieces)+1), stats.Copied)
+	assert.Equal(t, int64(0), stats.Skipped)
+
+	migrated := NewStorage(to)
+	defer ctx.Check(migrated.Close)
+
+	for pieceID, data := range pieces {
+		r, err := migrated.Reader(ctx, pieceID, 0, -1)
+		require.NoError(t, err)
+		copied, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, data, copied)
+	}
+
+	database, err := ioutil.ReadFile(filepath.Join(to, "piecestore.db"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("database"), database)
+
+	{ // only changed files are copied again
+		require.NoError(t, ioutil.WriteFile(filepath.Join(from, "piecestore.db"), []byte("database changed"), 0600))
+
+		stats, err := Migrate(ctx, from, to, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), stats.Copied)
+		assert.Equal(t, int64(len(pieces)), stats.Skipped)
+
+		database, err := ioutil.ReadFile(filepath.Join(to, "piecestore.db"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("database changed"), database)
+	}
+
+	{ // no temporary files are left behind
+		err := filepath.Walk(to, func(path string, info os.FileInfo, err error) error {
+			assert.False(t, strings.HasSuffix(path, ".migrating"), path)
+			return err
+		})
+		require.NoError(t, err)
+	}
+}
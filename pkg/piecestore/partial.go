@@ -0,0 +1,121 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pstore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// partialSuffix is appended to the path of a piece, which is still being uploaded
+const partialSuffix = ".partial"
+
+// PartialWriter appends to a partially uploaded piece, which becomes the
+// piece once it's committed
+type PartialWriter struct {
+	file   *os.File
+	path   string
+	size   int64
+	closed bool
+}
+
+// PartialWriter opens the partially uploaded piece for appending, the piece
+// is created when there is no upload to continue
+func (storage *Storage) PartialWriter(pieceID string) (*PartialWriter, error) {
+	path, err := storage.PiecePath(pieceID)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, MkDir.Wrap(err)
+	}
+	file, err := os.OpenFile(path+partialSuffix, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, Open.Wrap(err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return nil, Error.Wrap(errs.Combine(err, file.Close()))
+	}
+	return &PartialWriter{file: file, path: path, size: info.Size()}, nil
+}
+
+// Size returns the number of bytes of the piece written so far
+func (writer *PartialWriter) Size() int64 { return writer.size }
+
+// Write appends data to the piece
+func (writer *PartialWriter) Write(data []byte) (int, error) {
+	n, err := writer.file.Write(data)
+	writer.size += int64(n)
+	return n, err
+}
+
+// Sync flushes the written data to disk, so it can be acknowledged
+func (writer *PartialWriter) Sync() error {
+	return Error.Wrap(writer.file.Sync())
+}
+
+// Commit flushes and closes the partial piece and stores it as the piece
+func (writer *PartialWriter) Commit() error {
+	writer.closed = true
+	err := errs.Combine(writer.file.Sync(), writer.file.Close())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if _, err := os.Stat(writer.path); !os.IsNotExist(err) {
+		return Error.New("piece already exists")
+	}
+	return Error.Wrap(os.Rename(writer.file.Name(), writer.path))
+}
+
+// Close closes the partial piece, it's kept until the upload is continued
+func (writer *PartialWriter) Close() error {
+	if writer.closed {
+		return nil
+	}
+	writer.closed = true
+	return Error.Wrap(writer.file.Close())
+}
+
+// HasPiece returns the size of a stored piece and whether it exists
+func (storage *Storage) HasPiece(pieceID string) (int64, bool, error) {
+	path, err := storage.PiecePath(pieceID)
+	if err != nil {
+		return 0, false, err
+	}
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, Error.Wrap(err)
+	}
+	return info.Size(), true, nil
+}
+
+// DeleteStalePartials deletes the partially uploaded pieces, which haven't
+// been written to since before, and returns how many were deleted
+func (storage *Storage) DeleteStalePartials(before time.Time) (deleted int, err error) {
+	err = filepath.Walk(storage.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, partialSuffix) || !info.ModTime().Before(before) {
+			return nil
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		deleted++
+		return nil
+	})
+	return deleted, Error.Wrap(err)
+}
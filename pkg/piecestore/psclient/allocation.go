@@ -0,0 +1,56 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// allocationRenewMargin is how long before its expiration an allocation is
+// renewed, so it doesn't expire while the messages signed with it are in flight
+var allocationRenewMargin = time.Minute
+
+// AllocationSource requests fresh payer bandwidth allocations, e.g. from the satellite
+type AllocationSource interface {
+	PayerBandwidthAllocation(ctx context.Context, action pb.BandwidthAction) (*pb.PayerBandwidthAllocation, error)
+}
+
+// Renewable is a client, which renews the expiring payer bandwidth allocations
+// of its transfers from an allocation source
+type Renewable interface {
+	SetAllocationSource(source AllocationSource)
+}
+
+// SetAllocationSource sets the source of fresh allocations for transfers, whose
+// allocation expires before they finish. Without a source expired allocations
+// are sent as they are and the storage node rejects them.
+func (ps *PieceStore) SetAllocationSource(source AllocationSource) {
+	ps.allocations = source
+}
+
+// renewAllocation returns pba or, when pba is about to expire, a fresh
+// allocation for the same action requested within the context of stream
+func (ps *PieceStore) renewAllocation(stream grpc.ClientStream, pba *pb.PayerBandwidthAllocation) (*pb.PayerBandwidthAllocation, error) {
+	if ps.allocations == nil || !expiresWithin(pba, allocationRenewMargin) {
+		return pba, nil
+	}
+
+	renewed, err := ps.allocations.PayerBandwidthAllocation(stream.Context(), pba.GetAction())
+	if err != nil {
+		return nil, ClientError.New("failed to renew expiring allocation %s: %v", pba.GetSerialNumber(), err)
+	}
+	zap.S().Debugf("Renewed expiring allocation %s with %s for node %s", pba.GetSerialNumber(), renewed.GetSerialNumber(), ps.remoteID)
+	return renewed, nil
+}
+
+// expiresWithin returns whether pba expires within d
+func expiresWithin(pba *pb.PayerBandwidthAllocation, d time.Duration) bool {
+	return !time.Unix(pba.GetExpirationUnixSec(), 0).After(time.Now().Add(d))
+}
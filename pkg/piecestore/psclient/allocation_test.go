@@ -0,0 +1,140 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+)
+
+type testAllocationSource struct {
+	requested []pb.BandwidthAction
+	err       error
+}
+
+func (source *testAllocationSource) PayerBandwidthAllocation(ctx context.Context, action pb.BandwidthAction) (*pb.PayerBandwidthAllocation, error) {
+	source.requested = append(source.requested, action)
+	if source.err != nil {
+		return nil, source.err
+	}
+	return &pb.PayerBandwidthAllocation{
+		SerialNumber:      "renewed",
+		Action:            action,
+		ExpirationUnixSec: time.Now().Add(time.Hour).Unix(),
+	}, nil
+}
+
+func TestRenewAllocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	stream := pb.NewMockPieceStoreRoutes_RetrieveClient(ctrl)
+	stream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	valid := &pb.PayerBandwidthAllocation{
+		SerialNumber:      "valid",
+		Action:            pb.BandwidthAction_GET,
+		ExpirationUnixSec: time.Now().Add(time.Hour).Unix(),
+	}
+	expiring := &pb.PayerBandwidthAllocation{
+		SerialNumber:      "expiring",
+		Action:            pb.BandwidthAction_GET_REPAIR,
+		ExpirationUnixSec: time.Now().Add(allocationRenewMargin / 2).Unix(),
+	}
+
+	ps := &PieceStore{remoteID: teststorj.NodeIDFromString("test-node-id-1234567")}
+
+	{ // without a source the allocations are kept
+		pba, err := ps.renewAllocation(stream, expiring)
+		require.NoError(t, err)
+		assert.Equal(t, expiring, pba)
+	}
+
+	source := &testAllocationSource{}
+	ps.SetAllocationSource(source)
+
+	{ // valid allocations are kept
+		pba, err := ps.renewAllocation(stream, valid)
+		require.NoError(t, err)
+		assert.Equal(t, valid, pba)
+		assert.Empty(t, source.requested)
+	}
+
+	{ // expiring allocations are renewed for the same action
+		pba, err := ps.renewAllocation(stream, expiring)
+		require.NoError(t, err)
+		assert.Equal(t, "renewed", pba.SerialNumber)
+		assert.Equal(t, []pb.BandwidthAction{pb.BandwidthAction_GET_REPAIR}, source.requested)
+	}
+
+	{ // failed renewals fail the transfer
+		source.err = errors.New("satellite unavailable")
+		_, err := ps.renewAllocation(stream, expiring)
+		assert.True(t, ClientError.Has(err))
+	}
+}
+
+func TestStreamReaderRenewsAllocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	// the data is received once the allocation was sent
+	var sent []*pb.PieceRetrieval
+	done := make(chan struct{})
+	stream := pb.NewMockPieceStoreRoutes_RetrieveClient(ctrl)
+	stream.EXPECT().Context().Return(ctx).AnyTimes()
+	stream.EXPECT().Send(gomock.Any()).Do(func(msg *pb.PieceRetrieval) {
+		sent = append(sent, msg)
+		close(done)
+	}).Return(nil)
+	stream.EXPECT().Recv().Do(func() { <-done }).Return(&pb.PieceRetrievalStream{PieceSize: 6, Content: []byte("abcdef")}, nil)
+	stream.EXPECT().Recv().Return(&pb.PieceRetrievalStream{}, io.EOF)
+	stream.EXPECT().CloseSend().Return(nil)
+
+	source := &testAllocationSource{}
+	ps := &PieceStore{
+		selfID:           id,
+		remoteID:         teststorj.NodeIDFromString("test-node-id-1234567"),
+		bandwidthMsgSize: 32 * 1024,
+		allocations:      source,
+	}
+
+	expired := &pb.PayerBandwidthAllocation{
+		SerialNumber:      "expired",
+		Action:            pb.BandwidthAction_GET,
+		ExpirationUnixSec: time.Now().Add(-time.Hour).Unix(),
+	}
+
+	reader := NewStreamReader(ps, stream, expired, 6)
+	data, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("abcdef"), data)
+	require.NoError(t, reader.Close())
+
+	require.Len(t, sent, 1)
+	assert.Equal(t, "renewed", sent[0].BandwidthAllocation.PayerAllocation.SerialNumber)
+	assert.Equal(t, []pb.BandwidthAction{pb.BandwidthAction_GET}, source.requested)
+}
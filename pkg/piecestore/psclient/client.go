@@ -13,6 +13,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
 
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/auth"
@@ -21,6 +22,7 @@ import (
 	"storj.io/storj/pkg/ranger"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/pkg/utils"
 )
 
 // ClientError is any error returned by the client
@@ -56,6 +58,9 @@ type PieceStore struct {
 	selfID           *identity.FullIdentity    // This client's (an uplink) identity
 	bandwidthMsgSize int                       // max bandwidth message size in bytes
 	remoteID         storj.NodeID              // Storage node being connected to
+	allocations      AllocationSource          // Source of fresh allocations, may be nil
+	config           Config                    // Options of the transfers
+	ledger           *Ledger                   // Records the signed allocations, may be nil
 }
 
 // NewPSClient initilizes a piecestore client
@@ -67,20 +72,26 @@ func NewPSClient(ctx context.Context, tc transport.Client, n *pb.Node, bandwidth
 	}
 
 	if bandwidthMsgSize < 0 || bandwidthMsgSize > maxBandwidthMsgSize.Int() {
-		return nil, ClientError.New("invalid Bandwidth Message Size: %v", bandwidthMsgSize)
+		return nil, utils.CombineErrors(ClientError.New("invalid Bandwidth Message Size: %v", bandwidthMsgSize), conn.Close())
 	}
 
+	return newPieceStore(conn, conn.Close, tc, n, bandwidthMsgSize), nil
+}
+
+// newPieceStore creates a PieceStore using the connection to the node,
+// closeFunc is called when the client is closed
+func newPieceStore(conn *grpc.ClientConn, closeFunc func() error, tc transport.Client, n *pb.Node, bandwidthMsgSize int) *PieceStore {
 	if bandwidthMsgSize == 0 {
 		bandwidthMsgSize = defaultBandwidthMsgSize.Int()
 	}
 
 	return &PieceStore{
-		closeFunc:        conn.Close,
+		closeFunc:        closeFunc,
 		client:           pb.NewPieceStoreRoutesClient(conn),
 		bandwidthMsgSize: bandwidthMsgSize,
 		selfID:           tc.Identity(),
 		remoteID:         n.Id,
-	}, nil
+	}
 }
 
 // NewCustomRoute creates new PieceStore with custom client interface
@@ -104,6 +115,12 @@ func NewCustomRoute(client pb.PieceStoreRoutesClient, target *pb.Node, bandwidth
 
 // Close closes the connection with piecestore
 func (ps *PieceStore) Close() error {
+	if ps.ledger != nil {
+		// a failing ledger doesn't fail the transfers, which already finished
+		if err := ps.ledger.Flush(); err != nil {
+			zap.S().Errorf("failed to store the allocations signed for node %s: %v", ps.remoteID, err)
+		}
+	}
 	if ps.closeFunc == nil {
 		return nil
 	}
@@ -118,13 +135,20 @@ func (ps *PieceStore) Meta(ctx context.Context, id PieceID) (*pb.PieceSummary, e
 
 // Put uploads a Piece to a piece store Server
 func (ps *PieceStore) Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error {
+	return ps.PutHashed(ctx, id, data, nil, ttl, ba, authorization)
+}
+
+// PutHashed uploads a Piece with its SHA-256 hash to a piece store Server,
+// a retried upload succeeds without sending the data, when the Server
+// already holds the piece with the same hash
+func (ps *PieceStore) PutHashed(ctx context.Context, id PieceID, data io.Reader, hash []byte, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error {
 	stream, err := ps.client.Store(ctx)
 	if err != nil {
 		return err
 	}
 
 	msg := &pb.PieceStore{
-		PieceData:     &pb.PieceStore_PieceData{Id: id.String(), ExpirationUnixSec: ttl.Unix()},
+		PieceData:     &pb.PieceStore_PieceData{Id: id.String(), ExpirationUnixSec: ttl.Unix(), Hash: hash},
 		Authorization: authorization,
 	}
 	if err = stream.Send(msg); err != nil {
@@ -146,11 +170,13 @@ func (ps *PieceStore) Put(ctx context.Context, id PieceID, data io.Reader, ttl t
 	bufw := bufio.NewWriterSize(writer, 32*1024)
 
 	_, err = io.Copy(bufw, data)
-	if err != nil {
-		return err
+	if err == nil {
+		err = bufw.Flush()
 	}
-
-	return bufw.Flush()
+	if err == errDuplicate {
+		return nil
+	}
+	return err
 }
 
 // Get begins downloading a Piece from a piece store Server
@@ -173,7 +199,13 @@ func (ps *PieceStore) Delete(ctx context.Context, id PieceID, authorization *pb.
 	return nil
 }
 
-// sign a message using the clients private key
+// sign a message using the clients private key and record it in the ledger
 func (ps *PieceStore) sign(rba *pb.RenterBandwidthAllocation) (err error) {
-	return auth.SignMessage(rba, *ps.selfID)
+	if err := auth.SignMessage(rba, *ps.selfID); err != nil {
+		return err
+	}
+	if ps.ledger != nil {
+		ps.ledger.Record(rba)
+	}
+	return nil
 }
@@ -0,0 +1,49 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrIntegrity is returned when a downloaded piece doesn't match the hash
+// recorded by the storage node, the piece should be downloaded from another node
+var ErrIntegrity = errs.Class("piece integrity error")
+
+// verifiedReader reads the whole piece and verifies it against the hash
+// sent by the storage node, before any data is returned
+type verifiedReader struct {
+	id       PieceID
+	stream   *StreamReader
+	verified io.Reader
+}
+
+// Read returns the data of the piece once it's verified
+func (reader *verifiedReader) Read(p []byte) (int, error) {
+	if reader.verified == nil {
+		data, err := ioutil.ReadAll(reader.stream)
+		if err != nil {
+			return 0, err
+		}
+		// pieces stored before the storage nodes recorded hashes can't be verified
+		if hash := reader.stream.hash; hash != nil {
+			actual := sha256.Sum256(data)
+			if !bytes.Equal(actual[:], hash) {
+				return 0, ErrIntegrity.New("piece %s from node %s doesn't match its hash", reader.id, reader.stream.client.remoteID)
+			}
+		}
+		reader.verified = bytes.NewReader(data)
+	}
+	return reader.verified.Read(p)
+}
+
+// Close closes the stream of the piece
+func (reader *verifiedReader) Close() error {
+	return reader.stream.Close()
+}
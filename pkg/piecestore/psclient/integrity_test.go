@@ -0,0 +1,82 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestVerifyHash(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	data := []byte("abcdef")
+	correct := sha256.Sum256(data)
+	corrupted := sha256.Sum256([]byte("abcdeg"))
+
+	for i, tt := range []struct {
+		hash      []byte
+		corrupted bool
+	}{
+		{hash: correct[:]},
+		{hash: nil}, // pieces stored without a hash aren't verified
+		{hash: corrupted[:], corrupted: true},
+	} {
+		func() {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			pid := NewPieceID()
+			route := pb.NewMockPieceStoreRoutesClient(ctrl)
+			stream := pb.NewMockPieceStoreRoutes_RetrieveClient(ctrl)
+			// the deadline of the context is sent as a hint
+			deadline, _ := ctx.Deadline()
+			stream.EXPECT().Send(&pb.PieceRetrieval{
+				PieceData: &pb.PieceRetrieval_PieceData{Id: pid.String(), PieceSize: int64(len(data)), Deadline: deadline.UnixNano()},
+			}).Return(nil)
+			// the bandwidth allocation
+			stream.EXPECT().Send(gomock.Any()).Return(nil).MinTimes(0).MaxTimes(1)
+			stream.EXPECT().Recv().Return(&pb.PieceRetrievalStream{PieceSize: int64(len(data)), Content: data, Hash: tt.hash}, nil)
+			stream.EXPECT().Recv().Return(&pb.PieceRetrievalStream{}, io.EOF)
+			stream.EXPECT().CloseSend().Return(nil)
+
+			target := &pb.Node{Id: teststorj.NodeIDFromString("test-node-id-1234567"), Type: pb.NodeType_STORAGE}
+			c, err := NewCustomRoute(route, target, 32*1024, id)
+			require.NoError(t, err)
+			c.SetConfig(Config{VerifyHash: true})
+
+			pba := &pb.PayerBandwidthAllocation{ExpirationUnixSec: time.Now().Add(time.Hour).Unix()}
+			rr := PieceRangerSize(c, stream, pid, int64(len(data)), pba, nil)
+
+			r, err := rr.Range(ctx, 0, int64(len(data)))
+			require.NoError(t, err)
+			read, err := ioutil.ReadAll(r)
+			require.NoError(t, r.Close())
+
+			if tt.corrupted {
+				assert.True(t, ErrIntegrity.Has(err), "test %d", i)
+				assert.Empty(t, read, "test %d", i)
+				return
+			}
+			require.NoError(t, err, "test %d", i)
+			assert.Equal(t, data, read, "test %d", i)
+		}()
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/boltdb"
+)
+
+// LedgerBucket is the bolt bucket of the ledger entries
+const LedgerBucket = "allocations"
+
+var (
+	// LedgerError is any error returned by the ledger
+	LedgerError = errs.Class("ledger error")
+	// ErrOvercharged is returned for a storage node, which claims more
+	// bandwidth than was allocated to it
+	ErrOvercharged = errs.Class("overcharged")
+)
+
+// LedgerEntry is the largest bandwidth allocation signed for a storage node
+// with a serial number, which is what the storage node can claim from the satellite
+type LedgerEntry struct {
+	SerialNumber string             `json:"serialNumber"`
+	Satellite    storj.NodeID       `json:"satellite"`
+	StorageNode  storj.NodeID       `json:"storageNode"`
+	Action       pb.BandwidthAction `json:"action"`
+	// Total is the number of bytes allocated to the storage node with the serial number
+	Total int64 `json:"total"`
+	// Signed is when the allocation with the total was signed
+	Signed time.Time `json:"signed"`
+}
+
+// LedgerStore persists the entries of a ledger
+type LedgerStore interface {
+	// Put stores entry, it replaces the entry with the same serial number and storage node
+	Put(entry LedgerEntry) error
+	// Get returns the entry of a serial number and storage node, it's nil when there is none
+	Get(serialNumber string, storageNode storj.NodeID) (*LedgerEntry, error)
+	// Iterate calls fn with every entry
+	Iterate(fn func(entry LedgerEntry) error) error
+	// Close closes the store
+	Close() error
+}
+
+// Accountable is a client, which records the bandwidth allocations it signs in a ledger
+type Accountable interface {
+	SetLedger(ledger *Ledger)
+}
+
+// SetLedger sets the ledger, which records every bandwidth allocation signed
+// by the client
+func (ps *PieceStore) SetLedger(ledger *Ledger) {
+	ps.ledger = ledger
+}
+
+// Ledger records the bandwidth allocations signed by the uplink, so the
+// bandwidth the storage nodes claim can be reconciled with what was allocated
+// to them. Allocations are signed for every message of a transfer, hence
+// they are collected in memory and stored when the client is closed.
+type Ledger struct {
+	store LedgerStore
+
+	mu      sync.Mutex
+	pending map[ledgerKey]LedgerEntry
+}
+
+// ledgerKey identifies the entries of a ledger
+type ledgerKey struct {
+	serialNumber string
+	storageNode  storj.NodeID
+}
+
+// NewLedger creates a ledger, which stores its entries in store
+func NewLedger(store LedgerStore) *Ledger {
+	return &Ledger{
+		store:   store,
+		pending: map[ledgerKey]LedgerEntry{},
+	}
+}
+
+// OpenLedger opens the ledger stored in the bolt database at path
+func OpenLedger(path string) (*Ledger, error) {
+	db, err := boltdb.New(path, LedgerBucket)
+	if err != nil {
+		return nil, LedgerError.Wrap(err)
+	}
+	return NewLedger(NewLedgerStore(db)), nil
+}
+
+// Record records a signed bandwidth allocation
+func (ledger *Ledger) Record(rba *pb.RenterBandwidthAllocation) {
+	key := ledgerKey{
+		serialNumber: rba.PayerAllocation.GetSerialNumber(),
+		storageNode:  rba.StorageNodeId,
+	}
+
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	if entry, ok := ledger.pending[key]; ok && entry.Total >= rba.Total {
+		return
+	}
+	ledger.pending[key] = LedgerEntry{
+		SerialNumber: key.serialNumber,
+		Satellite:    rba.PayerAllocation.SatelliteId,
+		StorageNode:  key.storageNode,
+		Action:       rba.PayerAllocation.GetAction(),
+		Total:        rba.Total,
+		Signed:       time.Now().UTC(),
+	}
+}
+
+// Flush stores the recorded allocations, an entry is only replaced by one
+// with a larger total
+func (ledger *Ledger) Flush() error {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	for key, entry := range ledger.pending {
+		stored, err := ledger.store.Get(key.serialNumber, key.storageNode)
+		if err != nil {
+			return LedgerError.Wrap(err)
+		}
+		if stored == nil || stored.Total < entry.Total {
+			if err := ledger.store.Put(entry); err != nil {
+				return LedgerError.Wrap(err)
+			}
+		}
+		delete(ledger.pending, key)
+	}
+	return nil
+}
+
+// Entries returns every entry of the ledger
+func (ledger *Ledger) Entries() (entries []LedgerEntry, err error) {
+	if err := ledger.Flush(); err != nil {
+		return nil, err
+	}
+	err = ledger.store.Iterate(func(entry LedgerEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	return entries, LedgerError.Wrap(err)
+}
+
+// Totals returns the number of bytes allocated to every storage node by
+// action for the allocations signed between since and until
+func (ledger *Ledger) Totals(since, until time.Time) (map[storj.NodeID]map[pb.BandwidthAction]int64, error) {
+	entries, err := ledger.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[storj.NodeID]map[pb.BandwidthAction]int64{}
+	for _, entry := range entries {
+		if entry.Signed.Before(since) || entry.Signed.After(until) {
+			continue
+		}
+		if totals[entry.StorageNode] == nil {
+			totals[entry.StorageNode] = map[pb.BandwidthAction]int64{}
+		}
+		totals[entry.StorageNode][entry.Action] += entry.Total
+	}
+	return totals, nil
+}
+
+// Verify checks a bandwidth allocation claimed by a storage node, e.g. as
+// reported by the satellite, against the ledger. It returns ErrOvercharged,
+// when the allocation wasn't signed by the uplink or exceeds the signed total.
+func (ledger *Ledger) Verify(claimed *pb.RenterBandwidthAllocation) error {
+	if err := ledger.Flush(); err != nil {
+		return err
+	}
+
+	serialNumber := claimed.PayerAllocation.GetSerialNumber()
+	entry, err := ledger.store.Get(serialNumber, claimed.StorageNodeId)
+	if err != nil {
+		return LedgerError.Wrap(err)
+	}
+	if entry == nil {
+		return ErrOvercharged.New("no allocation %s was signed for node %s", serialNumber, claimed.StorageNodeId)
+	}
+	if claimed.Total > entry.Total {
+		return ErrOvercharged.New("node %s claims %d bytes of allocation %s, but %d bytes were allocated",
+			claimed.StorageNodeId, claimed.Total, serialNumber, entry.Total)
+	}
+	return nil
+}
+
+// Close stores the recorded allocations and closes the store
+func (ledger *Ledger) Close() error {
+	return errs.Combine(ledger.Flush(), ledger.store.Close())
+}
+
+// kvLedgerStore stores the entries of a ledger in a key value store
+type kvLedgerStore struct {
+	db storage.KeyValueStore
+}
+
+// NewLedgerStore creates a ledger store, which keeps the entries in db
+func NewLedgerStore(db storage.KeyValueStore) LedgerStore {
+	return &kvLedgerStore{db: db}
+}
+
+// entryKey returns the key of the entry of a serial number and storage node
+func entryKey(serialNumber string, storageNode storj.NodeID) storage.Key {
+	return storage.Key(serialNumber + "/" + storageNode.String())
+}
+
+// Put stores entry, it replaces the entry with the same serial number and storage node
+func (store *kvLedgerStore) Put(entry LedgerEntry) error {
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return store.db.Put(entryKey(entry.SerialNumber, entry.StorageNode), value)
+}
+
+// Get returns the entry of a serial number and storage node, it's nil when there is none
+func (store *kvLedgerStore) Get(serialNumber string, storageNode storj.NodeID) (*LedgerEntry, error) {
+	value, err := store.db.Get(entryKey(serialNumber, storageNode))
+	if storage.ErrKeyNotFound.Has(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &LedgerEntry{}
+	if err := json.Unmarshal(value, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Iterate calls fn with every entry
+func (store *kvLedgerStore) Iterate(fn func(entry LedgerEntry) error) error {
+	return store.db.Iterate(storage.IterateOptions{Recurse: true},
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				var entry LedgerEntry
+				if err := json.Unmarshal(item.Value, &entry); err != nil {
+					return err
+				}
+				if err := fn(entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+}
+
+// Close closes the key value store
+func (store *kvLedgerStore) Close() error {
+	return store.db.Close()
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestLedger(t *testing.T) {
+	ledger := NewLedger(NewLedgerStore(teststore.New()))
+	defer func() { assert.NoError(t, ledger.Close()) }()
+
+	node1 := teststorj.NodeIDFromString("test-node-id-1234567")
+	node2 := teststorj.NodeIDFromString("test-node-id-7654321")
+
+	rba := func(serialNumber string, action pb.BandwidthAction, node pb.NodeID, total int64) *pb.RenterBandwidthAllocation {
+		return &pb.RenterBandwidthAllocation{
+			PayerAllocation: pb.PayerBandwidthAllocation{SerialNumber: serialNumber, Action: action},
+			Total:           total,
+			StorageNodeId:   node,
+		}
+	}
+
+	ledger.Record(rba("put", pb.BandwidthAction_PUT, node1, 100))
+	ledger.Record(rba("put", pb.BandwidthAction_PUT, node1, 200))
+	ledger.Record(rba("put", pb.BandwidthAction_PUT, node2, 150))
+	require.NoError(t, ledger.Flush())
+
+	// a smaller total doesn't replace the stored one
+	ledger.Record(rba("put", pb.BandwidthAction_PUT, node1, 50))
+	ledger.Record(rba("get", pb.BandwidthAction_GET, node1, 300))
+
+	entries, err := ledger.Entries()
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+
+	totals, err := ledger.Totals(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.EqualValues(t, 200, totals[node1][pb.BandwidthAction_PUT])
+	assert.EqualValues(t, 300, totals[node1][pb.BandwidthAction_GET])
+	assert.EqualValues(t, 150, totals[node2][pb.BandwidthAction_PUT])
+
+	// storage nodes can claim up to the signed totals
+	assert.NoError(t, ledger.Verify(rba("put", pb.BandwidthAction_PUT, node1, 200)))
+	assert.NoError(t, ledger.Verify(rba("put", pb.BandwidthAction_PUT, node2, 100)))
+	assert.True(t, ErrOvercharged.Has(ledger.Verify(rba("put", pb.BandwidthAction_PUT, node2, 151))))
+	assert.True(t, ErrOvercharged.Has(ledger.Verify(rba("unknown", pb.BandwidthAction_PUT, node1, 1))))
+}
+
+func TestStreamReaderRecordsAllocations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	// the data is received once the allocation was sent
+	sent := make(chan struct{})
+	stream := pb.NewMockPieceStoreRoutes_RetrieveClient(ctrl)
+	stream.EXPECT().Send(gomock.Any()).Do(func(msg *pb.PieceRetrieval) { close(sent) }).Return(nil)
+	stream.EXPECT().Recv().Do(func() { <-sent }).Return(&pb.PieceRetrievalStream{PieceSize: 6, Content: []byte("abcdef")}, nil)
+	stream.EXPECT().Recv().Return(&pb.PieceRetrievalStream{}, io.EOF)
+	stream.EXPECT().CloseSend().Return(nil)
+
+	ledger := NewLedger(NewLedgerStore(teststore.New()))
+	defer ctx.Check(ledger.Close)
+
+	ps := &PieceStore{
+		selfID:           id,
+		remoteID:         teststorj.NodeIDFromString("test-node-id-1234567"),
+		bandwidthMsgSize: 32 * 1024,
+	}
+	ps.SetLedger(ledger)
+
+	pba := &pb.PayerBandwidthAllocation{
+		SerialNumber:      "serial",
+		Action:            pb.BandwidthAction_GET,
+		ExpirationUnixSec: time.Now().Add(time.Hour).Unix(),
+	}
+
+	reader := NewStreamReader(ps, stream, pba, 6)
+	data, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("abcdef"), data)
+	require.NoError(t, reader.Close())
+
+	entries, err := ledger.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "serial", entries[0].SerialNumber)
+	assert.Equal(t, ps.remoteID, entries[0].StorageNode)
+	assert.Equal(t, pb.BandwidthAction_GET, entries[0].Action)
+	assert.EqualValues(t, 6, entries[0].Total)
+}
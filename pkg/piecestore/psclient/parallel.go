@@ -0,0 +1,151 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/utils"
+)
+
+// Config contains the options of the transfers of a piece store client
+type Config struct {
+	Parallelism int         `help:"how many concurrent streams to the same storage node a large range read of a piece is split into, 1 disables splitting" default:"1"`
+	MinPartSize memory.Size `help:"the minimum size of the parts a range read is split into" default:"256KiB"`
+	VerifyHash  bool        `help:"if true, downloads of whole pieces are verified against the hash recorded by the storage node before the data is used" default:"false"`
+
+	PoolSize        int           `help:"maximum number of connections to storage nodes kept open for reuse by the transfers of later segments, 0 disables pooling" default:"0"`
+	PoolIdleTimeout time.Duration `help:"how long an unused pooled connection to a storage node is kept open" default:"1m0s"`
+}
+
+// Configurable is a client, whose transfers can be configured
+type Configurable interface {
+	SetConfig(config Config)
+}
+
+// SetConfig sets the options of the transfers of the client
+func (ps *PieceStore) SetConfig(config Config) {
+	ps.config = config
+}
+
+// rangeParts returns into how many parts a range read of length is split
+func (ps *PieceStore) rangeParts(length int64) int64 {
+	// every stream needs its own allocation, otherwise the satellite
+	// rejects the agreements of all but one stream as duplicates
+	if ps.config.Parallelism <= 1 || ps.allocations == nil {
+		return 1
+	}
+	parts := int64(ps.config.Parallelism)
+	if minSize := ps.config.MinPartSize.Int64(); minSize > 0 && length/minSize < parts {
+		parts = length / minSize
+	}
+	if parts < 1 {
+		return 1
+	}
+	return parts
+}
+
+// parallelRange reads the range in parts over concurrent streams and
+// returns them in order. The first part is read over the stream of the
+// ranger, the others over new streams with fresh allocations.
+func (r *pieceRanger) parallelRange(ctx context.Context, offset, length, parts int64) (_ io.ReadCloser, err error) {
+	reader := &parallelReader{client: r.c}
+	defer func() {
+		if err != nil {
+			// the stream of the ranger is left as it is, like by a failed Range
+			for _, stream := range reader.streams[1:] {
+				err = utils.CombineErrors(err, stream.CloseSend())
+			}
+		}
+	}()
+
+	partSize := (length + parts - 1) / parts
+	for partOffset := offset; partOffset < offset+length; partOffset += partSize {
+		partLength := partSize
+		if partOffset+partLength > offset+length {
+			partLength = offset + length - partOffset
+		}
+
+		stream, pba := r.stream, r.pba
+		if partOffset != offset {
+			stream, err = r.c.client.Retrieve(ctx)
+			if err != nil {
+				return nil, err
+			}
+			pba, err = r.c.allocations.PayerBandwidthAllocation(ctx, pb.BandwidthAction_GET)
+			if err != nil {
+				return nil, utils.CombineErrors(ClientError.New("failed to request allocation for parallel range: %v", err), stream.CloseSend())
+			}
+		}
+		reader.streams = append(reader.streams, stream)
+
+		pd := &pb.PieceRetrieval_PieceData{Id: r.id.String(), PieceSize: partLength, Offset: partOffset}
+		pd.Priority, pd.Deadline = retrievalHints(ctx)
+		if err := stream.Send(&pb.PieceRetrieval{PieceData: pd, Authorization: r.authorization}); err != nil {
+			return nil, err
+		}
+
+		part := &rangePart{data: make([]byte, partLength), done: make(chan struct{})}
+		reader.parts = append(reader.parts, part)
+		go part.download(NewStreamReader(r.c, stream, pba, partLength))
+	}
+
+	return reader, nil
+}
+
+// rangePart is a part of a range, which is downloaded concurrently with the others
+type rangePart struct {
+	data []byte
+	done chan struct{}
+	err  error
+}
+
+// download reads the whole part from the reader
+func (part *rangePart) download(reader io.Reader) {
+	defer close(part.done)
+	_, part.err = io.ReadFull(reader, part.data)
+}
+
+// parallelReader returns the data of the parts in order
+type parallelReader struct {
+	client  *PieceStore
+	streams []pb.PieceStoreRoutes_RetrieveClient
+	parts   []*rangePart
+	next    int
+	pending []byte
+}
+
+// Read reads the data of the current part, waiting until it's downloaded
+func (reader *parallelReader) Read(p []byte) (int, error) {
+	for len(reader.pending) == 0 {
+		if reader.next >= len(reader.parts) {
+			return 0, io.EOF
+		}
+		part := reader.parts[reader.next]
+		<-part.done
+		if part.err != nil {
+			return 0, part.err
+		}
+		reader.pending = part.data
+		reader.next++
+	}
+	n := copy(p, reader.pending)
+	reader.pending = reader.pending[n:]
+	return n, nil
+}
+
+// Close closes the streams of the parts and the client, which ends the
+// downloads still in progress
+func (reader *parallelReader) Close() error {
+	var errs []error
+	for _, stream := range reader.streams {
+		errs = append(errs, stream.CloseSend())
+	}
+	errs = append(errs, reader.client.Close())
+	return utils.CombineErrors(errs...)
+}
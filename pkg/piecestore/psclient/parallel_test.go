@@ -0,0 +1,96 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+)
+
+type testAllocations struct {
+	requested int
+}
+
+func (source *testAllocations) PayerBandwidthAllocation(ctx context.Context, action pb.BandwidthAction) (*pb.PayerBandwidthAllocation, error) {
+	source.requested++
+	return &pb.PayerBandwidthAllocation{Action: action, ExpirationUnixSec: time.Now().Add(time.Hour).Unix()}, nil
+}
+
+func TestRangeParts(t *testing.T) {
+	ps := &PieceStore{}
+	assert.Equal(t, int64(1), ps.rangeParts(1<<20))
+
+	ps.SetConfig(Config{Parallelism: 4, MinPartSize: 1024})
+	assert.Equal(t, int64(1), ps.rangeParts(1<<20), "parallel ranges need fresh allocations")
+
+	ps.SetAllocationSource(&testAllocations{})
+	assert.Equal(t, int64(4), ps.rangeParts(1<<20))
+	assert.Equal(t, int64(2), ps.rangeParts(2048))
+	assert.Equal(t, int64(1), ps.rangeParts(1500))
+}
+
+func TestParallelRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	pid := NewPieceID()
+	data := []byte("abcdef")
+
+	route := pb.NewMockPieceStoreRoutesClient(ctrl)
+	streams := []*pb.MockPieceStoreRoutes_RetrieveClient{
+		pb.NewMockPieceStoreRoutes_RetrieveClient(ctrl),
+		pb.NewMockPieceStoreRoutes_RetrieveClient(ctrl),
+	}
+	route.EXPECT().Retrieve(gomock.Any()).Return(streams[1], nil)
+
+	// the deadline of the context is sent as a hint
+	deadline, _ := ctx.Deadline()
+	for i, stream := range streams {
+		offset := int64(i * 3)
+		stream.EXPECT().Send(&pb.PieceRetrieval{
+			PieceData: &pb.PieceRetrieval_PieceData{Id: pid.String(), PieceSize: 3, Offset: offset, Deadline: deadline.UnixNano()},
+		}).Return(nil)
+		// the bandwidth allocation
+		stream.EXPECT().Send(gomock.Any()).Return(nil).MinTimes(0).MaxTimes(1)
+		stream.EXPECT().Recv().Return(&pb.PieceRetrievalStream{PieceSize: 3, Content: data[offset : offset+3]}, nil)
+		stream.EXPECT().CloseSend().Return(nil)
+	}
+
+	target := &pb.Node{Id: teststorj.NodeIDFromString("test-node-id-1234567"), Type: pb.NodeType_STORAGE}
+	c, err := NewCustomRoute(route, target, 32*1024, id)
+	require.NoError(t, err)
+
+	allocations := &testAllocations{}
+	c.SetAllocationSource(allocations)
+	c.SetConfig(Config{Parallelism: 2, MinPartSize: 3})
+
+	pba := &pb.PayerBandwidthAllocation{ExpirationUnixSec: time.Now().Add(time.Hour).Unix()}
+	rr := PieceRangerSize(c, streams[0], pid, int64(len(data)), pba, nil)
+
+	r, err := rr.Range(ctx, 0, int64(len(data)))
+	require.NoError(t, err)
+	read, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	assert.Equal(t, data, read)
+	assert.Equal(t, 1, allocations.requested)
+}
@@ -62,11 +62,24 @@ func (r *pieceRanger) Range(ctx context.Context, offset, length int64) (io.ReadC
 	if length == 0 {
 		return ioutil.NopCloser(bytes.NewReader([]byte{})), nil
 	}
+	// the storage node sends the hash only for ranges of the whole piece,
+	// so those aren't split into parallel parts when they're verified
+	verify := r.c.config.VerifyHash && offset == 0 && length == r.size
+	if parts := r.c.rangeParts(length); parts > 1 && !verify {
+		return r.parallelRange(ctx, offset, length, parts)
+	}
+
+	pd := &pb.PieceRetrieval_PieceData{Id: r.id.String(), PieceSize: length, Offset: offset}
+	pd.Priority, pd.Deadline = retrievalHints(ctx)
 
 	// send piece data
-	if err := r.stream.Send(&pb.PieceRetrieval{PieceData: &pb.PieceRetrieval_PieceData{Id: r.id.String(), PieceSize: length, Offset: offset}, Authorization: r.authorization}); err != nil {
+	if err := r.stream.Send(&pb.PieceRetrieval{PieceData: pd, Authorization: r.authorization}); err != nil {
 		return nil, err
 	}
 
-	return NewStreamReader(r.c, r.stream, r.pba, r.size), nil
+	reader := NewStreamReader(r.c, r.stream, r.pba, r.size)
+	if verify {
+		return &verifiedReader{id: r.id, stream: reader}, nil
+	}
+	return reader, nil
 }
@@ -59,9 +59,11 @@ func TestPieceRanger(t *testing.T) {
 		pid := NewPieceID()
 
 		if tt.offset >= 0 && tt.length > 0 && tt.offset+tt.length <= tt.size {
+			// the deadline of the context is sent as a hint
+			deadline, _ := ctx.Deadline()
 			msg1 := &pb.PieceRetrieval{
 				PieceData: &pb.PieceRetrieval_PieceData{
-					Id: pid.String(), PieceSize: tt.length, Offset: tt.offset,
+					Id: pid.String(), PieceSize: tt.length, Offset: tt.offset, Deadline: deadline.UnixNano(),
 				},
 			}
 
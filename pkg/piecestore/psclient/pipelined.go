@@ -0,0 +1,246 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/utils"
+)
+
+// PipelinedRanger is a Ranger, which requests all ranges of a piece over a
+// single Retrieve stream. The ranges are sent one after another, requesting
+// a range discards the unread data of the previous one. It has to be closed
+// after the last range.
+type PipelinedRanger interface {
+	ranger.Ranger
+	io.Closer
+}
+
+// GetPipelined begins downloading several ranges of a Piece from a piece store Server
+func (ps *PieceStore) GetPipelined(ctx context.Context, id PieceID, size int64, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (PipelinedRanger, error) {
+	stream, err := ps.client.Retrieve(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pipelinedRanger{c: ps, id: id, size: size, stream: stream, pba: ba, authorization: authorization}, nil
+}
+
+type pipelinedRanger struct {
+	c             *PieceStore
+	id            PieceID
+	size          int64
+	stream        pb.PieceStoreRoutes_RetrieveClient
+	pba           *pb.PayerBandwidthAllocation
+	authorization *pb.SignedMessage
+
+	mu      sync.Mutex
+	started bool
+	closed  bool
+	// allocated is the total of all bandwidth allocations requested on the stream
+	allocated int64
+	current   *rangeReader
+}
+
+// Size implements Ranger.Size
+func (r *pipelinedRanger) Size() int64 {
+	return r.size
+}
+
+// Range implements Ranger.Range
+func (r *pipelinedRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 {
+		return nil, Error.New("negative offset")
+	}
+	if length < 0 {
+		return nil, Error.New("negative length")
+	}
+	if offset+length > r.size {
+		return nil, Error.New("range beyond end")
+	}
+	if length == 0 {
+		return ioutil.NopCloser(bytes.NewReader([]byte{})), nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return nil, Error.New("ranger closed")
+	}
+
+	// the server sends the ranges in order, so the rest of the previous range has to be read first
+	if r.current != nil {
+		if err := r.current.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	pd := &pb.PieceRetrieval_PieceData{Id: r.id.String(), PieceSize: length, Offset: offset, Pipelined: true}
+	pd.Priority, pd.Deadline = retrievalHints(ctx)
+
+	msg := &pb.PieceRetrieval{PieceData: pd}
+	if !r.started {
+		msg.Authorization = r.authorization
+	}
+
+	// later ranges continue with the renewed allocation
+	pba, err := r.c.renewAllocation(r.stream, r.pba)
+	if err != nil {
+		return nil, err
+	}
+	r.pba = pba
+
+	// send piece data
+	if err := r.stream.Send(msg); err != nil {
+		return nil, err
+	}
+	r.started = true
+
+	r.current = newRangeReader(r.c, r.stream, r.pba, r.allocated, length)
+	r.allocated += length
+	return r.current, nil
+}
+
+// Close discards the rest of the current range and ends the stream
+func (r *pipelinedRanger) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	var drainErr error
+	if r.current != nil {
+		drainErr = r.current.Close()
+	}
+	return utils.CombineErrors(
+		drainErr,
+		r.stream.CloseSend(),
+		r.c.Close(),
+	)
+}
+
+// rangeReader reads a single range of a pipelined stream
+type rangeReader struct {
+	mu            sync.Mutex
+	pendingAllocs *sync2.Throttle
+	src           *utils.ReaderSource
+	closed        bool
+	// allocating is closed when no more allocations are sent for the range
+	allocating chan struct{}
+}
+
+// newRangeReader requests allocations for length bytes after the already allocated ones
+func newRangeReader(client *PieceStore, stream pb.PieceStoreRoutes_RetrieveClient, pba *pb.PayerBandwidthAllocation, allocated, length int64) *rangeReader {
+	rr := &rangeReader{
+		pendingAllocs: sync2.NewThrottle(),
+		allocating:    make(chan struct{}),
+	}
+
+	// TODO: make these flag/config-file configurable
+	trustLimit := int64(client.bandwidthMsgSize * 64)
+	sendThreshold := int64(client.bandwidthMsgSize * 8)
+
+	// Send signed allocations to the piece store server
+	go func() {
+		defer close(rr.allocating)
+		trustedSize := int64(client.bandwidthMsgSize * 8)
+
+		for sent := int64(0); sent < length; {
+			allocate := trustedSize
+			if sent+allocate > length {
+				allocate = length - sent
+			}
+			// a long range may outlive the allocation it started with
+			renewed, err := client.renewAllocation(stream, pba)
+			if err != nil {
+				rr.pendingAllocs.Fail(err)
+				return
+			}
+			pba = renewed
+
+			rba := &pb.RenterBandwidthAllocation{
+				PayerAllocation: *pba,
+				Total:           allocated + sent + allocate,
+				StorageNodeId:   client.remoteID,
+			}
+			if err := client.sign(rba); err != nil {
+				rr.pendingAllocs.Fail(err)
+				return
+			}
+			if err := stream.Send(&pb.PieceRetrieval{BandwidthAllocation: rba}); err != nil {
+				rr.pendingAllocs.Fail(err)
+				return
+			}
+			sent += allocate
+
+			if err := rr.pendingAllocs.ProduceAndWaitUntilBelow(allocate, sendThreshold); err != nil {
+				return
+			}
+
+			// Speed up retrieval as server gives us more data
+			trustedSize *= 2
+			if trustedSize > trustLimit {
+				trustedSize = trustLimit
+			}
+		}
+	}()
+
+	rr.src = utils.NewReaderSource(func() ([]byte, error) {
+		resp, err := stream.Recv()
+		if err != nil {
+			rr.pendingAllocs.Fail(err)
+			return nil, err
+		}
+		if resp.GetEndOfRange() {
+			rr.pendingAllocs.Fail(io.EOF)
+			return nil, io.EOF
+		}
+
+		if err := rr.pendingAllocs.Consume(int64(len(resp.GetContent()))); err != nil {
+			rr.pendingAllocs.Fail(err)
+			return resp.GetContent(), err
+		}
+		return resp.GetContent(), nil
+	})
+
+	return rr
+}
+
+// Read reads the range until the server marks its end
+func (rr *rangeReader) Read(b []byte) (int, error) {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	if rr.closed {
+		return 0, Error.New("range reader closed")
+	}
+	return rr.src.Read(b)
+}
+
+// Close discards the unread data of the range, the stream stays open for the next range
+func (rr *rangeReader) Close() error {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	if rr.closed {
+		return nil
+	}
+	rr.closed = true
+
+	_, err := io.Copy(ioutil.Discard, rr.src)
+	// the stream may only be used for the next range after the allocations stopped
+	rr.pendingAllocs.Fail(io.EOF)
+	<-rr.allocating
+	return err
+}
@@ -0,0 +1,210 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/pkg/utils"
+)
+
+// Pool caches the connections to storage nodes, so the piece transfers of
+// consecutive segments to the same node reuse the connection
+type Pool struct {
+	maxSize     int
+	idleTimeout time.Duration
+
+	mu     sync.Mutex
+	conns  map[storj.NodeID]*pooledConn
+	closed bool
+}
+
+// pooledConn is a cached connection and the number of clients using it
+type pooledConn struct {
+	conn     *grpc.ClientConn
+	users    int
+	lastUsed time.Time
+	timer    *time.Timer
+}
+
+// NewPool creates a pool caching at most maxSize connections, which are
+// closed after they're unused for idleTimeout
+func NewPool(maxSize int, idleTimeout time.Duration) *Pool {
+	return &Pool{
+		maxSize:     maxSize,
+		idleTimeout: idleTimeout,
+		conns:       make(map[storj.NodeID]*pooledConn),
+	}
+}
+
+// NewPSClient returns a piecestore client using the pooled connection to the
+// node, it dials the node when there's none. Closing the client returns the
+// connection to the pool.
+func (pool *Pool) NewPSClient(ctx context.Context, tc transport.Client, n *pb.Node, bandwidthMsgSize int) (Client, error) {
+	n.Type.DPanicOnInvalid("new pooled ps client")
+	if bandwidthMsgSize < 0 || bandwidthMsgSize > maxBandwidthMsgSize.Int() {
+		return nil, ClientError.New("invalid Bandwidth Message Size: %v", bandwidthMsgSize)
+	}
+
+	conn, release, err := pool.acquire(ctx, tc, n)
+	if err != nil {
+		return nil, err
+	}
+
+	var once sync.Once
+	closeFunc := func() (err error) {
+		// clients may be closed by both the ranger and the caller
+		once.Do(func() { err = release() })
+		return err
+	}
+	return newPieceStore(conn, closeFunc, tc, n, bandwidthMsgSize), nil
+}
+
+// acquire returns a connection to the node and the function releasing it
+func (pool *Pool) acquire(ctx context.Context, tc transport.Client, n *pb.Node) (*grpc.ClientConn, func() error, error) {
+	pool.mu.Lock()
+	if pooled, ok := pool.conns[n.Id]; ok && !pool.closed {
+		if usable(pooled.conn) {
+			pool.use(pooled)
+			pool.mu.Unlock()
+			return pooled.conn, func() error { return pool.release(n.Id, pooled) }, nil
+		}
+		// broken connections are closed, once their last user is done
+		delete(pool.conns, n.Id)
+		if pooled.users == 0 {
+			pool.closeConn(pooled)
+		}
+	}
+	pool.mu.Unlock()
+
+	// dialing without holding the lock, so slow nodes don't block the others
+	conn, err := tc.DialNode(ctx, n)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if pooled, ok := pool.conns[n.Id]; ok && !pool.closed {
+		// another client dialed the node concurrently, keeping its connection
+		pool.use(pooled)
+		_ = conn.Close()
+		return pooled.conn, func() error { return pool.release(n.Id, pooled) }, nil
+	}
+	if pool.closed || !pool.makeRoom() {
+		// the connection isn't pooled, when the pool is full with used connections
+		return conn, conn.Close, nil
+	}
+
+	pooled := &pooledConn{conn: conn}
+	pool.conns[n.Id] = pooled
+	pool.use(pooled)
+	return conn, func() error { return pool.release(n.Id, pooled) }, nil
+}
+
+// use marks the connection as used by another client
+func (pool *Pool) use(pooled *pooledConn) {
+	pooled.users++
+	if pooled.timer != nil {
+		pooled.timer.Stop()
+		pooled.timer = nil
+	}
+}
+
+// release returns the connection of a client to the pool and schedules closing
+// it, when it's the last user
+func (pool *Pool) release(id storj.NodeID, pooled *pooledConn) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pooled.users--
+	pooled.lastUsed = time.Now()
+	if pooled.users > 0 {
+		return nil
+	}
+	if pool.conns[id] != pooled {
+		// the connection was removed from the pool, while it was used
+		return pooled.conn.Close()
+	}
+	pooled.timer = time.AfterFunc(pool.idleTimeout, func() { pool.evict(id, pooled) })
+	return nil
+}
+
+// evict closes the connection, when it's still unused
+func (pool *Pool) evict(id storj.NodeID, pooled *pooledConn) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if pooled.users > 0 || pool.conns[id] != pooled {
+		return
+	}
+	delete(pool.conns, id)
+	pool.closeConn(pooled)
+}
+
+// makeRoom evicts the least recently used idle connection, when the pool is
+// full and returns whether there's room for another connection
+func (pool *Pool) makeRoom() bool {
+	if len(pool.conns) < pool.maxSize {
+		return true
+	}
+
+	var oldestID storj.NodeID
+	var oldest *pooledConn
+	for id, pooled := range pool.conns {
+		if pooled.users == 0 && (oldest == nil || pooled.lastUsed.Before(oldest.lastUsed)) {
+			oldestID, oldest = id, pooled
+		}
+	}
+	if oldest == nil {
+		return false
+	}
+	delete(pool.conns, oldestID)
+	pool.closeConn(oldest)
+	return true
+}
+
+// closeConn closes an unused connection, which was removed from the pool
+func (pool *Pool) closeConn(pooled *pooledConn) {
+	if pooled.timer != nil {
+		pooled.timer.Stop()
+	}
+	// closing is asynchronous, so no errors are returned
+	_ = pooled.conn.Close()
+}
+
+// Close closes the unused connections, the used ones are closed once their
+// clients are closed
+func (pool *Pool) Close() error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pool.closed = true
+	var errs []error
+	for id, pooled := range pool.conns {
+		delete(pool.conns, id)
+		if pooled.users == 0 {
+			if pooled.timer != nil {
+				pooled.timer.Stop()
+			}
+			errs = append(errs, pooled.conn.Close())
+		}
+	}
+	return utils.CombineErrors(errs...)
+}
+
+// usable returns whether the connection can be used for new transfers
+func usable(conn *grpc.ClientConn) bool {
+	state := conn.GetState()
+	return state != connectivity.Shutdown && state != connectivity.TransientFailure
+}
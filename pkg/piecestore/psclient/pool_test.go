@@ -0,0 +1,136 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+// dialCounter dials every node at the same address and counts the dials
+type dialCounter struct {
+	address  string
+	identity *identity.FullIdentity
+	dials    int
+}
+
+func (tc *dialCounter) DialNode(ctx context.Context, node *pb.Node, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return tc.DialAddress(ctx, tc.address, opts...)
+}
+
+func (tc *dialCounter) DialAddress(ctx context.Context, address string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	tc.dials++
+	return grpc.Dial(address, grpc.WithInsecure())
+}
+
+func (tc *dialCounter) Identity() *identity.FullIdentity { return tc.identity }
+
+// serve serves the listener until the server is stopped
+func serve(ctx *testcontext.Context, server *grpc.Server, listener net.Listener) {
+	ctx.Go(func() error {
+		// the server may be stopped before it started serving
+		if err := server.Serve(listener); err != grpc.ErrServerStopped {
+			return err
+		}
+		return nil
+	})
+}
+
+func TestPool(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	serve(ctx, server, listener)
+	defer server.Stop()
+
+	tc := &dialCounter{address: listener.Addr().String(), identity: id}
+	node1 := &pb.Node{Id: teststorj.NodeIDFromString("test-node-id-1234567"), Type: pb.NodeType_STORAGE}
+	node2 := &pb.Node{Id: teststorj.NodeIDFromString("test-node-id-7654321"), Type: pb.NodeType_STORAGE}
+
+	pool := NewPool(1, time.Hour)
+
+	first, err := pool.NewPSClient(ctx, tc, node1, 0)
+	require.NoError(t, err)
+	second, err := pool.NewPSClient(ctx, tc, node1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, tc.dials, "clients of the same node share the connection")
+
+	// the pool is full with a used connection, so it isn't pooled
+	other, err := pool.NewPSClient(ctx, tc, node2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, tc.dials)
+	require.NoError(t, other.Close())
+
+	require.NoError(t, first.Close())
+	require.NoError(t, first.Close(), "closing twice releases the connection once")
+	require.NoError(t, second.Close())
+	conn := pool.conns[node1.Id].conn
+	assert.NotEqual(t, connectivity.Shutdown, conn.GetState(), "idle connections are kept")
+
+	third, err := pool.NewPSClient(ctx, tc, node1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, tc.dials, "idle connections are reused")
+	require.NoError(t, third.Close())
+
+	// the idle connection is evicted to make room for another node
+	other, err = pool.NewPSClient(ctx, tc, node2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 3, tc.dials)
+	assert.Equal(t, connectivity.Shutdown, conn.GetState())
+	require.NoError(t, other.Close())
+
+	require.NoError(t, pool.Close())
+	assert.Empty(t, pool.conns)
+}
+
+func TestPoolIdleTimeout(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	id, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	serve(ctx, server, listener)
+	defer server.Stop()
+
+	tc := &dialCounter{address: listener.Addr().String(), identity: id}
+	node := &pb.Node{Id: teststorj.NodeIDFromString("test-node-id-1234567"), Type: pb.NodeType_STORAGE}
+
+	pool := NewPool(10, 10*time.Millisecond)
+	client, err := pool.NewPSClient(ctx, tc, node, 0)
+	require.NoError(t, err)
+	require.NoError(t, client.Close())
+
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		pool.mu.Lock()
+		evicted := len(pool.conns) == 0
+		pool.mu.Unlock()
+		if evicted {
+			return
+		}
+	}
+	t.Fatal("idle connection wasn't evicted")
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/pb"
+)
+
+type priorityKey struct{}
+
+// WithPriority returns a context, which makes the downloads started with it
+// send the priority hint to the storage nodes. Storage nodes may use it to
+// serve interactive downloads before batch downloads, but don't have to.
+func WithPriority(ctx context.Context, priority pb.PieceRetrieval_Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// retrievalHints returns the priority and the deadline in unix nanoseconds,
+// which are sent to the storage node for a download with ctx
+func retrievalHints(ctx context.Context) (priority pb.PieceRetrieval_Priority, deadline int64) {
+	priority, _ = ctx.Value(priorityKey{}).(pb.PieceRetrieval_Priority)
+	if t, ok := ctx.Deadline(); ok {
+		deadline = t.UnixNano()
+	}
+	return priority, deadline
+}
@@ -5,11 +5,11 @@ package psclient
 
 import (
 	"fmt"
+	"io"
 
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/sync2"
-	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/utils"
 )
@@ -20,17 +20,29 @@ type StreamWriter struct {
 	signer       *PieceStore // We need this for signing
 	totalWritten int64
 	pba          *pb.PayerBandwidthAllocation
+	// duplicate is the summary of the storage node, when it already held
+	// the piece and closed the stream early
+	duplicate *pb.PieceStoreSummary
 }
 
+// errDuplicate stops writing a piece, which the storage node already holds
+var errDuplicate = ClientError.New("storage node already holds the piece")
+
 // Write Piece data to a piece store server upload stream
 func (s *StreamWriter) Write(b []byte) (int, error) {
+	pba, err := s.signer.renewAllocation(s.stream, s.pba)
+	if err != nil {
+		return 0, err
+	}
+	s.pba = pba
+
 	updatedAllocation := s.totalWritten + int64(len(b))
 	rba := &pb.RenterBandwidthAllocation{
 		PayerAllocation: *s.pba,
 		Total:           updatedAllocation,
 		StorageNodeId:   s.signer.remoteID,
 	}
-	err := auth.SignMessage(rba, *s.signer.selfID)
+	err = s.signer.sign(rba)
 	if err != nil {
 		return 0, err
 	}
@@ -41,6 +53,17 @@ func (s *StreamWriter) Write(b []byte) (int, error) {
 	s.totalWritten = updatedAllocation
 	// Second we send the actual content
 	if err := s.stream.Send(msg); err != nil {
+		if err == io.EOF {
+			// the storage node closed the stream, its summary tells why
+			reply, recvErr := s.stream.CloseAndRecv()
+			if recvErr != nil {
+				return 0, recvErr
+			}
+			if reply.GetDuplicate() {
+				s.duplicate = reply
+				return 0, errDuplicate
+			}
+		}
 		return 0, fmt.Errorf("%v.Send() = %v", s.stream, err)
 	}
 	return len(b), nil
@@ -48,6 +71,10 @@ func (s *StreamWriter) Write(b []byte) (int, error) {
 
 // Close the piece store Write Stream
 func (s *StreamWriter) Close() error {
+	if s.duplicate != nil {
+		return nil
+	}
+
 	reply, err := s.stream.CloseAndRecv()
 	if err != nil {
 		return err
@@ -67,6 +94,8 @@ type StreamReader struct {
 	downloaded    int64
 	allocated     int64
 	size          int64
+	// hash is the hash of the piece sent by the storage node, when the range covers the whole piece
+	hash []byte
 }
 
 // NewStreamReader creates a StreamReader for reading data from the piece store server
@@ -92,12 +121,20 @@ func NewStreamReader(client *PieceStore, stream pb.PieceStoreRoutes_RetrieveClie
 			if sr.allocated+trustedSize > size {
 				allocate = size - sr.allocated
 			}
+			// the download may outlive the allocation it started with
+			renewed, err := client.renewAllocation(stream, pba)
+			if err != nil {
+				sr.pendingAllocs.Fail(err)
+				return
+			}
+			pba = renewed
+
 			rba := &pb.RenterBandwidthAllocation{
 				PayerAllocation: *pba,
 				Total:           sr.allocated + allocate,
 				StorageNodeId:   sr.client.remoteID,
 			}
-			err := auth.SignMessage(rba, *client.selfID)
+			err = client.sign(rba)
 			if err != nil {
 				sr.pendingAllocs.Fail(err)
 			}
@@ -130,6 +167,9 @@ func NewStreamReader(client *PieceStore, stream pb.PieceStoreRoutes_RetrieveClie
 		}
 
 		sr.downloaded += int64(len(resp.GetContent()))
+		if resp.GetHash() != nil {
+			sr.hash = resp.GetHash()
+		}
 
 		err = sr.pendingAllocs.Consume(int64(len(resp.GetContent())))
 		if err != nil {
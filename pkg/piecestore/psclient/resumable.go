@@ -0,0 +1,192 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psclient
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+)
+
+var (
+	// resumeAttempts is how often an interrupted resumable upload is continued
+	resumeAttempts = 5
+	// resumeDelay is the delay before continuing an upload, it grows with every attempt
+	resumeDelay = 500 * time.Millisecond
+)
+
+// Resumable is a client, which continues interrupted uploads
+type Resumable interface {
+	PutResumable(ctx context.Context, id PieceID, data io.ReadSeeker, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error
+}
+
+// PutResumable uploads a piece like Put, but the storage node acknowledges the
+// data it received. When the upload is interrupted by a transient error, it's
+// continued from the acknowledged offset instead of starting over, so data is
+// read again from that offset and has to be seekable.
+func (ps *PieceStore) PutResumable(ctx context.Context, id PieceID, data io.ReadSeeker, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (err error) {
+	for attempt := 1; ; attempt++ {
+		err = ps.putFromAck(ctx, id, data, ttl, ba, authorization)
+		if err == nil || attempt > resumeAttempts || !isTransient(err) {
+			return err
+		}
+		zap.S().Debugf("Resuming upload of piece %s to node %s after: %v", id, ps.remoteID, err)
+
+		select {
+		case <-time.After(time.Duration(attempt) * resumeDelay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// putFromAck uploads the piece from the offset of the first ack of the storage node
+func (ps *PieceStore) putFromAck(ctx context.Context, id PieceID, data io.ReadSeeker, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error {
+	// canceling ends the stream, when the upload fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client, err := ps.client.StoreResumable(ctx)
+	if err != nil {
+		return err
+	}
+
+	msg := &pb.PieceStore{
+		PieceData:     &pb.PieceStore_PieceData{Id: id.String(), ExpirationUnixSec: ttl.Unix()},
+		Authorization: authorization,
+	}
+	if err = client.Send(msg); err != nil {
+		// the status of an aborted stream is received by Recv
+		if _, recvErr := client.Recv(); recvErr != nil && recvErr != io.EOF {
+			return recvErr
+		}
+		return err
+	}
+
+	ack, err := client.Recv()
+	if err != nil {
+		return err
+	}
+	if ack.GetSummary() != nil {
+		// the piece was stored by an earlier upload, whose last ack got lost
+		return nil
+	}
+	if _, err := data.Seek(ack.GetOffset(), io.SeekStart); err != nil {
+		return ClientError.Wrap(err)
+	}
+
+	stream := newResumableStream(client, ack.GetOffset())
+	// the allocations count from the start of the piece, so the last one
+	// covers the interrupted uploads as well
+	writer := &StreamWriter{signer: ps, stream: stream, pba: ba, totalWritten: ack.GetOffset()}
+
+	bufw := bufio.NewWriterSize(writer, 32*1024)
+	_, err = io.Copy(bufw, data)
+	if err == nil {
+		err = bufw.Flush()
+	}
+	if err == nil && writer.totalWritten == ack.GetOffset() {
+		// the storage node needs an allocation to store the piece
+		_, err = writer.Write(nil)
+	}
+	if err != nil {
+		// the status of an aborted stream is more useful than the failed send
+		cancel()
+		if statusErr := stream.wait(); statusErr != nil && status.Code(statusErr) != codes.Canceled {
+			return statusErr
+		}
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	if stream.summary == nil {
+		return ClientError.New("upload of piece %s ended at %d bytes without being stored", id, stream.Acked())
+	}
+	return nil
+}
+
+// isTransient returns whether a failed upload can be continued
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.Internal:
+		return true
+	default:
+		return false
+	}
+}
+
+// resumableStream adapts a resumable upload to the stream of Store, so it
+// can be written by a StreamWriter. The acks are received in the background,
+// so the storage node doesn't block on sending them.
+type resumableStream struct {
+	pb.PieceStoreRoutes_StoreResumableClient
+
+	acked   int64
+	done    chan struct{}
+	summary *pb.PieceStoreSummary
+	err     error
+}
+
+// newResumableStream starts receiving the acks of client, which continues at offset
+func newResumableStream(client pb.PieceStoreRoutes_StoreResumableClient, offset int64) *resumableStream {
+	stream := &resumableStream{
+		PieceStoreRoutes_StoreResumableClient: client,
+		acked:                                 offset,
+		done:                                  make(chan struct{}),
+	}
+	go stream.receive()
+	return stream
+}
+
+// receive receives the acks until the last one or the stream fails
+func (stream *resumableStream) receive() {
+	defer close(stream.done)
+	for {
+		ack, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			stream.err = err
+			return
+		}
+		atomic.StoreInt64(&stream.acked, ack.GetOffset())
+		if ack.GetSummary() != nil {
+			stream.summary = ack.GetSummary()
+			return
+		}
+	}
+}
+
+// Acked returns the size of the piece acknowledged by the storage node so far
+func (stream *resumableStream) Acked() int64 {
+	return atomic.LoadInt64(&stream.acked)
+}
+
+// wait waits until the acks aren't received anymore and returns the status of the stream
+func (stream *resumableStream) wait() error {
+	<-stream.done
+	return stream.err
+}
+
+// CloseAndRecv ends the upload and waits for the last ack
+func (stream *resumableStream) CloseAndRecv() (*pb.PieceStoreSummary, error) {
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	if err := stream.wait(); err != nil {
+		return nil, err
+	}
+	return stream.summary, nil
+}
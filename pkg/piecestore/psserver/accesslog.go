@@ -0,0 +1,185 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/peer"
+
+	"storj.io/storj/pkg/identity"
+)
+
+// AccessLogError is error class for the access log
+var AccessLogError = errs.Class("piecestore access log")
+
+// AccessLogEntry is a single request written to the access log as a JSON line
+type AccessLogEntry struct {
+	Time     time.Time `json:"time"`
+	PieceID  string    `json:"piece"`
+	Action   string    `json:"action"`
+	PeerID   string    `json:"peer_id,omitempty"`
+	PeerAddr string    `json:"peer_addr,omitempty"`
+	Bytes    int64     `json:"bytes"`
+	Duration float64   `json:"duration_ms"`
+	Status   string    `json:"status"`
+}
+
+// AccessLog writes sampled requests to a file, which is rotated when it
+// exceeds its maximum size. Failed requests are always written, so they can
+// be used as evidence during disputes.
+type AccessLog struct {
+	path       string
+	maxSize    int64
+	maxFiles   int
+	sampleRate float64
+
+	mu   sync.Mutex
+	rand *rand.Rand
+	file *os.File
+	size int64
+}
+
+// NewAccessLog opens the access log at path. sampleRate is the fraction of
+// successful requests, which are written. Rotated files are kept as path.1
+// up to path.<maxFiles>.
+func NewAccessLog(path string, maxSize int64, maxFiles int, sampleRate float64) (*AccessLog, error) {
+	log := &AccessLog{
+		path:       path,
+		maxSize:    maxSize,
+		maxFiles:   maxFiles,
+		sampleRate: sampleRate,
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	if err := log.open(); err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+// hashPieceID hashes a piece id, so the access log doesn't contain the ids,
+// which are needed to retrieve the pieces
+func hashPieceID(id string) string {
+	hash := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(hash[:16])
+}
+
+// Log writes a request of ctx to the access log, when it's sampled
+func (log *AccessLog) Log(ctx context.Context, id, action string, bytes int64, started time.Time, err error) error {
+	if log == nil {
+		return nil
+	}
+
+	status := OK
+	if err != nil {
+		status = err.Error()
+	}
+
+	entry := AccessLogEntry{
+		Time:     started.UTC(),
+		PieceID:  hashPieceID(id),
+		Action:   action,
+		Bytes:    bytes,
+		Duration: float64(time.Since(started)) / float64(time.Millisecond),
+		Status:   status,
+	}
+	if pi, err := identity.PeerIdentityFromContext(ctx); err == nil {
+		entry.PeerID = pi.ID.String()
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		entry.PeerAddr = p.Addr.String()
+	}
+
+	return log.Write(entry, err != nil)
+}
+
+// Write writes an entry to the access log, always is used for entries, which
+// must not be skipped by sampling
+func (log *AccessLog) Write(entry AccessLogEntry, always bool) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return AccessLogError.Wrap(err)
+	}
+	data = append(data, '\n')
+
+	log.mu.Lock()
+	defer log.mu.Unlock()
+
+	if !always && log.rand.Float64() >= log.sampleRate {
+		return nil
+	}
+
+	if log.maxSize > 0 && log.size > 0 && log.size+int64(len(data)) > log.maxSize {
+		if err := log.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := log.file.Write(data)
+	log.size += int64(n)
+	return AccessLogError.Wrap(err)
+}
+
+// open opens the current access log file for appending
+func (log *AccessLog) open() error {
+	file, err := os.OpenFile(log.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return AccessLogError.Wrap(err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return AccessLogError.Wrap(errs.Combine(err, file.Close()))
+	}
+	log.file, log.size = file, info.Size()
+	return nil
+}
+
+// rotate moves the current file to path.1, shifts the older files by one and
+// removes the oldest one
+func (log *AccessLog) rotate() error {
+	if err := log.file.Close(); err != nil {
+		return AccessLogError.Wrap(err)
+	}
+	// the log is reopened even when the files couldn't be moved, so
+	// the following requests are still written
+	return errs.Combine(log.shift(), log.open())
+}
+
+// shift renames the files of the access log
+func (log *AccessLog) shift() error {
+	if log.maxFiles <= 0 {
+		return AccessLogError.Wrap(os.Remove(log.path))
+	}
+
+	err := os.Remove(fmt.Sprintf("%s.%d", log.path, log.maxFiles))
+	if err != nil && !os.IsNotExist(err) {
+		return AccessLogError.Wrap(err)
+	}
+	for i := log.maxFiles - 1; i >= 1; i-- {
+		err := os.Rename(fmt.Sprintf("%s.%d", log.path, i), fmt.Sprintf("%s.%d", log.path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return AccessLogError.Wrap(err)
+		}
+	}
+	return AccessLogError.Wrap(os.Rename(log.path, log.path+".1"))
+}
+
+// Close closes the access log
+func (log *AccessLog) Close() error {
+	if log == nil {
+		return nil
+	}
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	return AccessLogError.Wrap(log.file.Close())
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+)
+
+func readAccessLog(t *testing.T, path string) []AccessLogEntry {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, file.Close()) }()
+
+	var entries []AccessLogEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry AccessLogEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func TestAccessLog(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	path := filepath.Join(ctx.Dir("accesslog"), "access.log")
+
+	// successful requests are never sampled, failed ones always written
+	log, err := NewAccessLog(path, 0, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, log.Log(ctx, "piece", "store", 10, time.Now(), nil))
+	require.NoError(t, log.Log(ctx, "piece", "retrieve", 5, time.Now(), errors.New("failed")))
+	require.NoError(t, log.Close())
+
+	entries := readAccessLog(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "retrieve", entries[0].Action)
+	assert.Equal(t, int64(5), entries[0].Bytes)
+	assert.Equal(t, "failed", entries[0].Status)
+	assert.Equal(t, hashPieceID("piece"), entries[0].PieceID)
+	assert.NotEqual(t, "piece", entries[0].PieceID)
+}
+
+func TestAccessLogRotation(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	path := filepath.Join(ctx.Dir("accesslog"), "access.log")
+
+	log, err := NewAccessLog(path, 1, 2, 1)
+	require.NoError(t, err)
+	// every entry exceeds the maximum size, so each one rotates the log
+	for i := 0; i < 4; i++ {
+		require.NoError(t, log.Log(ctx, "piece", "store", int64(i), time.Now(), nil))
+	}
+	require.NoError(t, log.Close())
+
+	for i, name := range []string{path, path + ".1", path + ".2"} {
+		entries := readAccessLog(t, name)
+		require.Len(t, entries, 1)
+		assert.Equal(t, int64(3-i), entries[0].Bytes)
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
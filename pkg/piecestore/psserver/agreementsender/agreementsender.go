@@ -9,7 +9,10 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
@@ -94,12 +97,19 @@ func (as *AgreementSender) SendAgreementsToSatellite(ctx context.Context, satID
 		} else {
 			// Send agreement to satellite
 			r, err := client.BandwidthAgreements(ctx, &rba)
+			if status.Code(err) == codes.ResourceExhausted {
+				// the remaining agreements would be throttled as well
+				as.log.Warn("Agreementsender was throttled by satellite : will retry", zap.Error(err))
+				return
+			}
 			if err != nil || r.GetStatus() == pb.AgreementsSummary_FAIL {
 				as.log.Warn("Agreementsender failed to send agreement to satellite : will retry", zap.Error(err))
 				continue
 			} else if r.GetStatus() == pb.AgreementsSummary_REJECTED {
 				//todo: something better than a delete here?
 				as.log.Error("Agreementsender had agreement explicitly rejected by satellite : will delete", zap.Error(err))
+			} else if receipt := r.GetReceipt(); receipt != nil {
+				as.saveReceipt(satID, receipt)
 			}
 		}
 		// Delete from PSDB by signature
@@ -108,3 +118,19 @@ func (as *AgreementSender) SendAgreementsToSatellite(ctx context.Context, satID
 		}
 	}
 }
+
+// saveReceipt stores the receipt of an accepted agreement, so it can be used
+// to dispute missing payments
+func (as *AgreementSender) saveReceipt(satID storj.NodeID, receipt *pb.AgreementReceipt) {
+	if receipt.SatelliteId != satID {
+		as.log.Warn("Agreementsender received receipt of another satellite", zap.String("satellite id", receipt.SatelliteId.String()))
+		return
+	}
+	if err := auth.VerifyMsg(receipt, satID); err != nil {
+		as.log.Warn("Agreementsender received invalid receipt", zap.Error(err))
+		return
+	}
+	if err := as.DB.SaveAgreementReceipt(receipt); err != nil {
+		as.log.Error("Agreementsender failed to save receipt", zap.Error(err))
+	}
+}
@@ -60,11 +60,15 @@ func (service *Monitor) process(ctx context.Context) error {
 		return Error.Wrap(err)
 	}
 
+	if err := service.server.checkResources(ctx, stats); err != nil {
+		service.log.Warn("failed to notify operator", zap.Error(err))
+	}
+
 	self := service.rt.Local()
 
 	self.Restrictions = &pb.NodeRestrictions{
 		FreeBandwidth: stats.AvailableBandwidth,
-		FreeDisk:      stats.AvailableSpace,
+		FreeDisk:      service.server.advertisedSpace(stats.AvailableSpace),
 	}
 
 	// Update the routing table with latest restrictions
@@ -17,22 +17,31 @@ import (
 // ErrorCollector is error class for piece collector
 var ErrorCollector = errs.Class("piecestore collector")
 
+// collectBatch is the number of expired pieces deleted at once
+const collectBatch = 1000
+
 // Collector collects expired pieces from database and disk.
 type Collector struct {
 	log     *zap.Logger
 	db      *psdb.DB
 	storage *pstore.Storage
 
-	interval time.Duration
+	interval          time.Duration
+	partialExpiration time.Duration
+	trashRetention    time.Duration
 }
 
-// NewCollector returns a new piece collector
-func NewCollector(log *zap.Logger, db *psdb.DB, storage *pstore.Storage, interval time.Duration) *Collector {
+// NewCollector returns a new piece collector, which also deletes the data of
+// interrupted resumable uploads, which weren't continued within partialExpiration,
+// and empties the trash of pieces, which are in it longer than trashRetention
+func NewCollector(log *zap.Logger, db *psdb.DB, storage *pstore.Storage, interval, partialExpiration, trashRetention time.Duration) *Collector {
 	return &Collector{
-		log:      log,
-		db:       db,
-		storage:  storage,
-		interval: interval,
+		log:               log,
+		db:                db,
+		storage:           storage,
+		interval:          interval,
+		partialExpiration: partialExpiration,
+		trashRetention:    trashRetention,
 	}
 }
 
@@ -55,10 +64,43 @@ func (service *Collector) Run(ctx context.Context) error {
 	}
 }
 
-// Collect collects expired pieces att this moment.
+// Collect deletes the data and the records of the pieces, which expired
+// by this moment.
 func (service *Collector) Collect(ctx context.Context) error {
+	if service.partialExpiration > 0 {
+		deleted, err := service.storage.DeleteStalePartials(time.Now().Add(-service.partialExpiration))
+		if err != nil {
+			return ErrorCollector.Wrap(err)
+		}
+		if deleted > 0 {
+			service.log.Info("deleted interrupted uploads", zap.Int("count", deleted))
+		}
+	}
+
+	deleted, err := service.storage.EmptyTrash(time.Now().Add(-service.trashRetention))
+	if err != nil {
+		return ErrorCollector.Wrap(err)
+	}
+	if deleted > 0 {
+		service.log.Info("deleted pieces from the trash", zap.Int("count", deleted))
+	}
+
+	var pieces, reclaimed int64
+	defer func() {
+		mon.Meter("expired_pieces_deleted").Mark64(pieces)
+		mon.Meter("expired_bytes_reclaimed").Mark64(reclaimed)
+		if pieces > 0 {
+			service.log.Info("deleted expired pieces", zap.Int64("count", pieces), zap.Int64("bytes", reclaimed))
+		}
+	}()
+
+	now := time.Now()
 	for {
-		expired, err := service.db.DeleteExpired(ctx)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		expired, size, err := service.db.DeleteExpired(ctx, now, collectBatch)
 		if err != nil {
 			return ErrorCollector.Wrap(err)
 		}
@@ -70,6 +112,8 @@ func (service *Collector) Collect(ctx context.Context) error {
 		for _, id := range expired {
 			errlist.Add(service.storage.Delete(id))
 		}
+		pieces += int64(len(expired))
+		reclaimed += size
 
 		if err := errlist.Err(); err != nil {
 			return ErrorCollector.Wrap(err)
@@ -20,10 +20,30 @@ type Config struct {
 	Path                    string        `help:"path to store data in" default:"$CONFDIR/storage"`
 	WhitelistedSatelliteIDs string        `help:"a comma-separated list of approved satellite node ids" default:""`
 	SatelliteIDRestriction  bool          `help:"if true, only allow data from approved satellites" default:"false"`
+	DeniedUplinks           string        `user:"true" help:"a comma-separated list of uplink node ids, whose piece requests are refused" default:""`
+	DeniedSatellites        string        `user:"true" help:"a comma-separated list of satellite node ids, whose uplinks' piece requests are refused" default:""`
 	AllocatedDiskSpace      memory.Size   `user:"true" help:"total allocated disk space in bytes" default:"1TB"`
 	AllocatedBandwidth      memory.Size   `user:"true" help:"total allocated bandwidth in bytes" default:"500GiB"`
+	MinFreeSpace            memory.Size   `user:"true" help:"low watermark of the available allocated disk space, below it the node advertises no free space and refuses uploads, while still serving downloads" default:"500MiB"`
 	KBucketRefreshInterval  time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
+	SatelliteIngressLimits  string        `user:"true" help:"a comma-separated list of per satellite ingress limits in bytes per second formatted as <satellite id>:<rate>, * applies to unlisted satellites" default:""`
+	SatelliteEgressLimits   string        `user:"true" help:"a comma-separated list of per satellite egress limits in bytes per second formatted as <satellite id>:<rate>, * applies to unlisted satellites" default:""`
+	NotificationWebhook     string        `user:"true" help:"url receiving new operator notifications as JSON POST requests, e.g. an ntfy topic" default:""`
+	ReadCacheMode           string        `help:"how large piece reads use the page cache: normal, fadvise (drop the read data from the page cache) or direct (bypass the page cache)" default:"fadvise"`
+	UncachedReadSize        memory.Size   `help:"piece reads of at least this size use the read cache mode, 0 reads every piece normally" default:"4MiB"`
+	AccessLogPath           string        `user:"true" help:"path of the access log, which records piece requests as JSON lines, empty disables the access log" default:""`
+	AccessLogSampleRate     float64       `user:"true" help:"fraction of successful requests written to the access log, failed requests are always written" default:"1"`
+	AccessLogMaxSize        memory.Size   `help:"size at which the access log is rotated" default:"100MiB"`
+	AccessLogMaxFiles       int           `help:"number of rotated access logs which are kept" default:"5"`
+	MaxConcurrentRetrievals int           `help:"maximum number of piece downloads served at once, further downloads wait ordered by the priority and deadline hints of the clients, 0 disables the limit" default:"0"`
+	UploadDedupWindow       time.Duration `help:"how long after storing a piece retried uploads of it with the same hash succeed without transferring the data again, 0 disables the deduplication" default:"1h0m0s"`
 
 	AgreementSenderCheckInterval time.Duration `help:"duration between agreement checks" default:"1h0m0s"`
 	CollectorInterval            time.Duration `help:"interval to check for expired pieces" default:"1h0m0s"`
+	PartialUploadExpiration      time.Duration `help:"how long the data of an interrupted resumable upload is kept for continuing it" default:"24h0m0s"`
+	TrashRetention               time.Duration `help:"how long pieces, which were removed by retain requests of satellites, are kept in the trash" default:"168h0m0s"`
+	SatelliteCleanupInterval     time.Duration `help:"interval to check for data of satellites, which aren't trusted anymore, 0 disables the cleanup" default:"1h0m0s"`
+	SatelliteCleanupGracePeriod  time.Duration `help:"how long the data of a satellite is kept after it isn't trusted anymore" default:"720h0m0s"`
+	UsageRecalculationInterval   time.Duration `help:"interval to recalculate the tracked disk usage of satellites from the piece records, 0 disables the recalculation" default:"24h0m0s"`
+	PayoutPricingInterval        time.Duration `help:"interval to retrieve the payout pricing of satellites for the dashboard's payout estimates, 0 disables the estimates" default:"6h0m0s"`
 }
@@ -0,0 +1,65 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
+)
+
+// duplicateUpload returns the size of the piece, when the node already holds
+// the complete piece with the hash of a retried upload, which was stored
+// within the deduplication window
+func (s *Server) duplicateUpload(id string, hash []byte) (size int64, duplicate bool, err error) {
+	if s.dedupWindow <= 0 || len(hash) == 0 {
+		return 0, false, nil
+	}
+
+	piece, err := s.DB.GetStoredPiece(id)
+	if err != nil || piece == nil {
+		return 0, false, err
+	}
+	if !bytes.Equal(piece.Hash, hash) || time.Since(piece.Created) > s.dedupWindow {
+		return 0, false, nil
+	}
+
+	// corrupted pieces are uploaded again to replace them
+	quarantined, err := s.DB.IsQuarantined(id)
+	if err != nil || quarantined {
+		return 0, false, err
+	}
+	stored, exists, err := s.storage.HasPiece(id)
+	if err != nil || !exists || stored != piece.Size {
+		return 0, false, err
+	}
+
+	mon.Meter("upload_duplicates").Mark(1)
+	mon.Meter("upload_duplicate_bytes").Mark64(piece.Size)
+	return piece.Size, true, nil
+}
+
+// storeReceipt returns a receipt for a stored piece signed by the node
+func (s *Server) storeReceipt(pieceID string, hash []byte, size int64) (*pb.StoreReceipt, error) {
+	receipt := &pb.StoreReceipt{
+		PieceId:       pieceID,
+		Hash:          hash,
+		Size_:         size,
+		SignedUnixSec: time.Now().Unix(),
+	}
+
+	data, err := proto.Marshal(receipt)
+	if err != nil {
+		return nil, err
+	}
+	receipt.Signature, err = pkcrypto.HashAndSign(s.pkey, data)
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"crypto/sha256"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/bwagreement/testbwagreement"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestStoreDuplicate(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	snID, upID := newTestID(ctx, t), newTestID(ctx, t)
+	s, c, cleanup := NewTest(ctx, t, snID, upID, []storj.NodeID{})
+	defer cleanup()
+	s.dedupWindow = time.Hour
+
+	content := []byte("xyzwq")
+	sum := sha256.Sum256(content)
+	hash := sum[:]
+
+	upload := func(hash []byte) *pb.PieceStoreSummary {
+		stream, err := c.Store(ctx)
+		require.NoError(t, err)
+
+		err = stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: "99999999999999999999", ExpirationUnixSec: 9999999999, Hash: hash}})
+		require.NoError(t, err)
+
+		pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_PUT, snID, upID, time.Hour)
+		require.NoError(t, err)
+		rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, snID.ID, upID, int64(len(content)))
+		require.NoError(t, err)
+		// the node closes the stream of duplicates before receiving the data
+		err = stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Content: content}, BandwidthAllocation: rba})
+		if err != io.EOF {
+			require.NoError(t, err)
+		}
+
+		resp, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		return resp
+	}
+
+	verifyReceipt := func(receipt *pb.StoreReceipt) {
+		require.NotNil(t, receipt)
+		assert.Equal(t, "99999999999999999999", receipt.PieceId)
+		assert.Equal(t, hash, receipt.Hash)
+		assert.Equal(t, int64(len(content)), receipt.Size_)
+
+		unsigned := *receipt
+		unsigned.Signature = nil
+		data, err := proto.Marshal(&unsigned)
+		require.NoError(t, err)
+		assert.NoError(t, pkcrypto.HashAndVerifySignature(snID.Leaf.PublicKey, data, receipt.Signature))
+	}
+
+	resp := upload(hash)
+	assert.False(t, resp.Duplicate)
+	assert.Equal(t, int64(len(content)), resp.TotalReceived)
+	verifyReceipt(resp.Receipt)
+
+	// a retry with the same hash isn't transferred again
+	resp = upload(hash)
+	assert.True(t, resp.Duplicate)
+	assert.Equal(t, int64(0), resp.TotalReceived)
+	verifyReceipt(resp.Receipt)
+
+	// a different hash or no hash is uploaded again
+	id, err := getNamespacedPieceID([]byte("99999999999999999999"), nil)
+	require.NoError(t, err)
+	for _, other := range [][]byte{make([]byte, sha256.Size), nil} {
+		_, duplicate, err := s.duplicateUpload(id, other)
+		require.NoError(t, err)
+		assert.False(t, duplicate)
+	}
+
+	// the deduplication can be disabled
+	s.dedupWindow = 0
+	_, duplicate, err := s.duplicateUpload(id, hash)
+	require.NoError(t, err)
+	assert.False(t, duplicate)
+}
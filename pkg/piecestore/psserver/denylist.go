@@ -0,0 +1,110 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// ErrDenied is returned for requests of uplinks, which are on the deny list
+// of the operator or use a denied satellite
+var ErrDenied = errs.Class("denied by the storage node operator")
+
+// DenyList refuses the direct traffic of uplinks and of the uplinks of
+// satellites, which the operator doesn't want to serve
+type DenyList struct {
+	mu         sync.Mutex
+	uplinks    map[storj.NodeID]struct{}
+	satellites map[storj.NodeID]struct{}
+}
+
+// NewDenyList creates a deny list from comma-separated lists of uplink and
+// satellite node ids
+func NewDenyList(uplinks, satellites string) (*DenyList, error) {
+	list := &DenyList{}
+	if err := list.SetDenied(uplinks, satellites); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// SetDenied replaces the denied uplinks and satellites, in the same format as
+// NewDenyList. The list is left unchanged when either is invalid.
+func (list *DenyList) SetDenied(uplinks, satellites string) error {
+	uplinkIDs, err := parseNodeIDSet(uplinks)
+	if err != nil {
+		return ServerError.New("invalid denied uplinks: %v", err)
+	}
+	satelliteIDs, err := parseNodeIDSet(satellites)
+	if err != nil {
+		return ServerError.New("invalid denied satellites: %v", err)
+	}
+
+	list.mu.Lock()
+	defer list.mu.Unlock()
+	list.uplinks = uplinkIDs
+	list.satellites = satelliteIDs
+	return nil
+}
+
+// CheckUplink returns an error when the uplink is denied
+func (list *DenyList) CheckUplink(id storj.NodeID) error {
+	if list == nil {
+		return nil
+	}
+	list.mu.Lock()
+	_, denied := list.uplinks[id]
+	list.mu.Unlock()
+	if denied {
+		return ErrDenied.New("uplink %s", id)
+	}
+	return nil
+}
+
+// CheckSatellite returns an error when the traffic of the satellite's uplinks is denied
+func (list *DenyList) CheckSatellite(id storj.NodeID) error {
+	if list == nil {
+		return nil
+	}
+	list.mu.Lock()
+	_, denied := list.satellites[id]
+	list.mu.Unlock()
+	if denied {
+		return ErrDenied.New("satellite %s", id)
+	}
+	return nil
+}
+
+// parseNodeIDSet parses a comma-separated list of node ids
+func parseNodeIDSet(list string) (map[storj.NodeID]struct{}, error) {
+	ids := map[storj.NodeID]struct{}{}
+	for _, value := range strings.Split(list, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		id, err := storj.NodeIDFromString(value)
+		if err != nil {
+			return nil, err
+		}
+		ids[id] = struct{}{}
+	}
+	return ids, nil
+}
+
+// deniedStatus converts denied requests to a permission denied status, so
+// clients can tell them apart from failures of the node
+func deniedStatus(err error) error {
+	if ErrDenied.Has(err) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
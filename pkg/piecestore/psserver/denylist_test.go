@@ -0,0 +1,47 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/storj"
+)
+
+func TestDenyList(t *testing.T) {
+	uplink, satellite, other := storj.NodeID{1}, storj.NodeID{2}, storj.NodeID{3}
+
+	list, err := NewDenyList(uplink.String()+", ", satellite.String())
+	require.NoError(t, err)
+
+	assert.True(t, ErrDenied.Has(list.CheckUplink(uplink)))
+	assert.NoError(t, list.CheckUplink(other))
+	assert.True(t, ErrDenied.Has(list.CheckSatellite(satellite)))
+	assert.NoError(t, list.CheckSatellite(other))
+
+	// denied requests are reported with their own status code
+	st, ok := status.FromError(deniedStatus(RetrieveError.Wrap(list.CheckUplink(uplink))))
+	require.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	// an invalid list leaves the previous one in place
+	assert.Error(t, list.SetDenied("notanid", ""))
+	assert.Error(t, list.CheckUplink(uplink))
+
+	require.NoError(t, list.SetDenied("", other.String()))
+	assert.NoError(t, list.CheckUplink(uplink))
+	assert.Error(t, list.CheckSatellite(other))
+
+	_, err = NewDenyList("", "notanid")
+	assert.Error(t, err)
+
+	var none *DenyList
+	assert.NoError(t, none.CheckUplink(uplink))
+	assert.NoError(t, none.CheckSatellite(satellite))
+}
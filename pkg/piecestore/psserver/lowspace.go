@@ -0,0 +1,41 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrLowSpace is returned for uploads, while the available allocated disk
+// space is below the low watermark of the operator
+var ErrLowSpace = errs.Class("not enough free space")
+
+// checkFreeSpace refuses uploads, when the available allocated disk space is
+// below the low watermark, downloads are served regardless
+func (s *Server) checkFreeSpace(available int64) error {
+	if s.minFreeSpace > 0 && available < s.minFreeSpace {
+		return ErrLowSpace.New("%d bytes available, below the low watermark of %d bytes", available, s.minFreeSpace)
+	}
+	return nil
+}
+
+// advertisedSpace returns the free disk space advertised to the network,
+// nodes below the low watermark advertise none, so they aren't selected for uploads
+func (s *Server) advertisedSpace(available int64) int64 {
+	if available < s.minFreeSpace {
+		return 0
+	}
+	return available
+}
+
+// spaceStatus converts uploads refused for low or no space to a resource
+// exhausted status, so uplinks upload the piece to another node
+func spaceStatus(err error) error {
+	if ErrLowSpace.Has(err) || ErrOutOfSpace.Has(err) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestStoreLowSpace(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	snID, upID := newTestID(ctx, t), newTestID(ctx, t)
+	s, c, cleanup := NewTest(ctx, t, snID, upID, []storj.NodeID{})
+	defer cleanup()
+
+	require.NoError(t, s.DB.AddTTL("11111111111111111111", 0, 600))
+	s.totalAllocated = 1000
+	s.minFreeSpace = 500
+
+	_, err := s.reserveSpace()
+	assert.True(t, ErrLowSpace.Has(err))
+	assert.Equal(t, int64(0), s.advertisedSpace(400))
+	assert.Equal(t, int64(600), s.advertisedSpace(600))
+
+	// uploads are refused with their own status code
+	stream, err := c.Store(ctx)
+	require.NoError(t, err)
+	err = stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: "22222222222222222222"}})
+	if err != io.EOF {
+		require.NoError(t, err)
+	}
+	_, err = stream.CloseAndRecv()
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+
+	// freeing space accepts uploads again
+	require.NoError(t, s.DB.DeleteTTLByID("11111111111111111111"))
+	reservation, err := s.reserveSpace()
+	require.NoError(t, err)
+	reservation.release()
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+const (
+	// NotificationDiskFull is sent when the allocated disk space is nearly used up
+	NotificationDiskFull = "disk_full"
+	// NotificationBandwidthExhausted is sent when the allocated bandwidth is nearly used up
+	NotificationBandwidthExhausted = "bandwidth_exhausted"
+
+	// notifyThreshold is the fraction of remaining resources below which the operator is notified
+	notifyThreshold = 0.1
+	// dashboardNotifications is the number of notifications sent with the dashboard data
+	dashboardNotifications = 10
+)
+
+// notify stores a notification for the operator and pushes it to the webhook when it is new
+func (s *Server) notify(ctx context.Context, notification psdb.Notification) error {
+	added, err := s.DB.AddNotification(notification)
+	if err != nil || !added {
+		return err
+	}
+
+	s.log.Warn(notification.Title, zap.String("type", notification.Type), zap.String("message", notification.Message))
+
+	if s.notificationWebhook == "" {
+		return nil
+	}
+	return pushNotification(ctx, s.notificationWebhook, notification)
+}
+
+// checkResources notifies the operator when disk space or bandwidth are nearly used up
+func (s *Server) checkResources(ctx context.Context, stats *pb.StatSummary) error {
+	var group errs.Group
+	if s.totalAllocated > 0 && float64(stats.AvailableSpace) < notifyThreshold*float64(s.totalAllocated) {
+		group.Add(s.notify(ctx, psdb.Notification{
+			Type:    NotificationDiskFull,
+			Title:   "Disk space nearly used up",
+			Message: "Less than 10% of the allocated disk space is available, the node will soon stop accepting uploads.",
+		}))
+	}
+	if s.totalBwAllocated > 0 && float64(stats.AvailableBandwidth) < notifyThreshold*float64(s.totalBwAllocated) {
+		group.Add(s.notify(ctx, psdb.Notification{
+			Type:    NotificationBandwidthExhausted,
+			Title:   "Bandwidth nearly used up",
+			Message: "Less than 10% of the allocated bandwidth is available for this month.",
+		}))
+	}
+	return group.Err()
+}
+
+// getNotifications returns the latest notifications for the dashboard
+func (s *Server) getNotifications() ([]*pb.NodeNotification, error) {
+	notifications, err := s.DB.GetNotifications(dashboardNotifications)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*pb.NodeNotification
+	for _, notification := range notifications {
+		created, err := ptypes.TimestampProto(notification.Created)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, &pb.NodeNotification{
+			Id:      notification.ID,
+			Type:    notification.Type,
+			Title:   notification.Title,
+			Message: notification.Message,
+			Created: created,
+			Read:    notification.Read,
+		})
+	}
+	return list, nil
+}
+
+// pushNotification posts the notification as JSON to the webhook
+func pushNotification(ctx context.Context, webhook string, notification psdb.Notification) (err error) {
+	body, err := json.Marshal(struct {
+		Type    string    `json:"type"`
+		Title   string    `json:"title"`
+		Message string    `json:"message"`
+		Created time.Time `json:"created"`
+	}{notification.Type, notification.Title, notification.Message, time.Now()})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Title", notification.Title)
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode/100 != 2 {
+		return errs.New("notification webhook responded with %s", resp.Status)
+	}
+	return nil
+}
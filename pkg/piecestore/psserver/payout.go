@@ -0,0 +1,210 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+// ErrorPayout is error class for the payout estimator
+var ErrorPayout = errs.Class("piecestore payout")
+
+// PayoutEstimator retrieves the pricing of the satellites and projects the
+// month-end payout of each satellite from the usage recorded so far
+type PayoutEstimator struct {
+	log       *zap.Logger
+	db        *psdb.DB
+	kad       *kademlia.Kademlia
+	transport transport.Client
+	interval  time.Duration
+
+	mu      sync.Mutex
+	pricing map[storj.NodeID]*pb.PricingResponse
+}
+
+// NewPayoutEstimator returns a new payout estimator
+func NewPayoutEstimator(log *zap.Logger, db *psdb.DB, kad *kademlia.Kademlia, transport transport.Client, interval time.Duration) *PayoutEstimator {
+	return &PayoutEstimator{
+		log:       log,
+		db:        db,
+		kad:       kad,
+		transport: transport,
+		interval:  interval,
+		pricing:   make(map[storj.NodeID]*pb.PricingResponse),
+	}
+}
+
+// Run retrieves the pricing of the satellites at regular intervals
+func (estimator *PayoutEstimator) Run(ctx context.Context) error {
+	if estimator.interval <= 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(estimator.interval)
+	defer ticker.Stop()
+
+	for {
+		err := estimator.Refresh(ctx)
+		if err != nil {
+			estimator.log.Warn("refresh pricing", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the payout estimator is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// Refresh retrieves the pricing of all satellites the node has data of,
+// satellites which can't be reached keep their previous pricing
+func (estimator *PayoutEstimator) Refresh(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellites, err := estimator.db.GetSatellites()
+	if err != nil {
+		return ErrorPayout.Wrap(err)
+	}
+
+	var errlist errs.Group
+	for _, satellite := range satellites {
+		pricing, err := estimator.fetchPricing(ctx, satellite)
+		if err != nil {
+			errlist.Add(errs.New("satellite %s: %v", satellite, err))
+			continue
+		}
+		estimator.SetPricing(satellite, pricing)
+	}
+	return ErrorPayout.Wrap(errlist.Err())
+}
+
+// fetchPricing requests the pricing from a satellite
+func (estimator *PayoutEstimator) fetchPricing(ctx context.Context, satelliteID storj.NodeID) (_ *pb.PricingResponse, err error) {
+	satellite, err := estimator.kad.FindNode(ctx, satelliteID)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := estimator.transport.DialNode(ctx, &satellite)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	return pb.NewNodeStatsClient(conn).Pricing(ctx, &pb.PricingRequest{})
+}
+
+// SetPricing sets the pricing of a satellite
+func (estimator *PayoutEstimator) SetPricing(satellite storj.NodeID, pricing *pb.PricingResponse) {
+	estimator.mu.Lock()
+	defer estimator.mu.Unlock()
+	estimator.pricing[satellite] = pricing
+}
+
+// Estimates projects the month-end payouts of the satellites with known pricing
+func (estimator *PayoutEstimator) Estimates(now time.Time) (estimates []*pb.PayoutEstimate, err error) {
+	estimator.mu.Lock()
+	pricing := make(map[storj.NodeID]*pb.PricingResponse, len(estimator.pricing))
+	satellites := make(storj.NodeIDList, 0, len(estimator.pricing))
+	for satellite, satellitePricing := range estimator.pricing {
+		pricing[satellite] = satellitePricing
+		satellites = append(satellites, satellite)
+	}
+	estimator.mu.Unlock()
+
+	sort.Sort(satellites)
+
+	monthStart, monthEnd := monthBounds(now)
+	for _, satellite := range satellites {
+		stored, err := estimator.db.SumSatellitePieceSizes(satellite)
+		if err != nil {
+			return nil, ErrorPayout.Wrap(err)
+		}
+		bandwidth, err := estimator.db.GetSatelliteBandwidth(satellite, monthStart, monthEnd)
+		if err != nil {
+			return nil, ErrorPayout.Wrap(err)
+		}
+
+		estimate := EstimatePayout(pricing[satellite], stored, bandwidth, now)
+		estimate.SatelliteId = satellite
+		estimates = append(estimates, estimate)
+	}
+	return estimates, nil
+}
+
+// EstimatePayout projects the month-end payout from the bytes stored and the
+// bandwidth used in the month so far. The stored bytes are expected to stay
+// for the whole month and the egress is extrapolated linearly.
+func EstimatePayout(pricing *pb.PricingResponse, stored int64, bandwidth map[pb.BandwidthAction]int64, now time.Time) *pb.PayoutEstimate {
+	monthStart, monthEnd := monthBounds(now)
+
+	// the first hour of a month would extrapolate too much
+	elapsed := now.Sub(monthStart)
+	if elapsed < time.Hour {
+		elapsed = time.Hour
+	}
+	projection := monthEnd.Sub(monthStart).Hours() / elapsed.Hours()
+
+	egress := func(action pb.BandwidthAction, price int64) float64 {
+		return memory.Size(bandwidth[action]).TB() * projection * float64(price)
+	}
+
+	estimate := &pb.PayoutEstimate{
+		DiskSpace:    memory.Size(stored).TB() * float64(pricing.GetDiskSpaceTbMonth()),
+		Egress:       egress(pb.BandwidthAction_GET, pricing.GetEgressTb()),
+		RepairEgress: egress(pb.BandwidthAction_GET_REPAIR, pricing.GetRepairEgressTb()),
+		AuditEgress:  egress(pb.BandwidthAction_GET_AUDIT, pricing.GetAuditEgressTb()),
+	}
+
+	gross := estimate.DiskSpace + estimate.Egress + estimate.RepairEgress + estimate.AuditEgress
+	estimate.Held = gross * float64(heldPercentage(pricing, now)) / 100
+	estimate.Payout = gross - estimate.Held
+	return estimate
+}
+
+// heldPercentage returns the percentage of the payout held back in the month
+// of now, nodes unknown to the satellite are in their first month
+func heldPercentage(pricing *pb.PricingResponse, now time.Time) int32 {
+	schedule := pricing.GetHeldPercentages()
+	if len(schedule) == 0 {
+		return 0
+	}
+
+	month := 0
+	if joined, err := ptypes.Timestamp(pricing.GetJoined()); err == nil {
+		now, joined = now.UTC(), joined.UTC()
+		month = (now.Year()-joined.Year())*12 + int(now.Month()) - int(joined.Month())
+	}
+
+	switch {
+	case month < 0:
+		return schedule[0]
+	case month >= len(schedule):
+		return schedule[len(schedule)-1]
+	default:
+		return schedule[month]
+	}
+}
+
+// monthBounds returns the start of the UTC month of now and the start of the next one
+func monthBounds(now time.Time) (start, end time.Time) {
+	now = now.UTC()
+	start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
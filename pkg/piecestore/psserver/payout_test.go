@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestEstimatePayout(t *testing.T) {
+	pricing := &pb.PricingResponse{
+		DiskSpaceTbMonth: 150,
+		EgressTb:         2000,
+		RepairEgressTb:   1000,
+		AuditEgressTb:    1000,
+		HeldPercentages:  []int32{75, 50, 0},
+	}
+	bandwidth := map[pb.BandwidthAction]int64{
+		pb.BandwidthAction_PUT:        memory.TB.Int64(),
+		pb.BandwidthAction_GET:        memory.TB.Int64() / 2,
+		pb.BandwidthAction_GET_REPAIR: memory.TB.Int64() / 10,
+	}
+
+	// half of april has passed, so the egress doubles until the end of the month
+	now := time.Date(2019, time.April, 16, 0, 0, 0, 0, time.UTC)
+
+	estimate := EstimatePayout(pricing, 2*memory.TB.Int64(), bandwidth, now)
+	assert.InDelta(t, 300, estimate.DiskSpace, 1e-9)
+	assert.InDelta(t, 2000, estimate.Egress, 1e-9)
+	assert.InDelta(t, 200, estimate.RepairEgress, 1e-9)
+	assert.InDelta(t, 0, estimate.AuditEgress, 1e-9)
+	// nodes unknown to the satellite are in their first month
+	assert.InDelta(t, 1875, estimate.Held, 1e-9)
+	assert.InDelta(t, 625, estimate.Payout, 1e-9)
+
+	for _, tt := range []struct {
+		joined time.Time
+		held   float64
+	}{
+		{time.Date(2019, time.April, 2, 0, 0, 0, 0, time.UTC), 1875},
+		{time.Date(2019, time.March, 31, 0, 0, 0, 0, time.UTC), 1250},
+		{time.Date(2018, time.December, 1, 0, 0, 0, 0, time.UTC), 0},
+	} {
+		joined, err := ptypes.TimestampProto(tt.joined)
+		assert.NoError(t, err)
+		pricing.Joined = joined
+
+		estimate := EstimatePayout(pricing, 2*memory.TB.Int64(), bandwidth, now)
+		assert.InDelta(t, tt.held, estimate.Held, 1e-9, tt.joined.String())
+		assert.InDelta(t, 2500-tt.held, estimate.Payout, 1e-9, tt.joined.String())
+	}
+
+	assert.Equal(t, &pb.PayoutEstimate{}, EstimatePayout(&pb.PricingResponse{}, 0, nil, now))
+}
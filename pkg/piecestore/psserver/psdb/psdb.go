@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -32,6 +33,9 @@ var (
 
 // DB is a piece store database
 type DB struct {
+	// used is the sum of the sizes in the ttl table, it's accessed atomically
+	used int64
+
 	mu sync.Mutex
 	DB *sql.DB // TODO: hide
 }
@@ -42,6 +46,16 @@ type Agreement struct {
 	Signature []byte
 }
 
+// Notification is an alert for the storage node operator
+type Notification struct {
+	ID      int64
+	Type    string
+	Title   string
+	Message string
+	Created time.Time
+	Read    bool
+}
+
 // Open opens DB at DBPath
 func Open(DBPath string) (db *DB, err error) {
 	if err = os.MkdirAll(filepath.Dir(DBPath), 0700); err != nil {
@@ -107,6 +121,61 @@ func (db *DB) init() (err error) {
 		return err
 	}
 
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `notifications` (`id` INTEGER PRIMARY KEY AUTOINCREMENT, `type` TEXT, `title` TEXT, `message` TEXT, `created` INT(10), `read` INT(1) DEFAULT 0);")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `satellite_bandwidth` (`satellite` BLOB, `action` INT(10), `daystartdate` INT(10), `size` INT(10), UNIQUE (`satellite`, `action`, `daystartdate`));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `satellite_tallies` (`satellite` BLOB, `periodstart` INT(10), `periodend` INT(10), `tally` BLOB, `received` INT(10), UNIQUE (`satellite`, `periodstart`));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `satellite_pieces` (`id` BLOB UNIQUE, `satellite` BLOB);")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE INDEX IF NOT EXISTS idx_satellite_pieces_satellite ON satellite_pieces (satellite);")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `untrusted_satellites` (`satellite` BLOB UNIQUE, `since` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `agreement_receipts` (`satellite` BLOB, `serialnum` TEXT, `accepted` INT(10), `receipt` BLOB, UNIQUE (`satellite`, `serialnum`));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `quarantined_pieces` (`id` BLOB UNIQUE, `disk` TEXT, `error` TEXT, `quarantined` INT(10));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `piece_hashes` (`id` BLOB UNIQUE, `hash` BLOB);")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `piece_ids` (`id` BLOB UNIQUE, `piece_id` BLOB);")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `satellite_usage` (`satellite` BLOB UNIQUE, `used` INT(10), `pieces` INT(10));")
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -115,7 +184,10 @@ func (db *DB) init() (err error) {
 	// try to enable write-ahead-logging
 	_, _ = db.DB.Exec(`PRAGMA journal_mode = WAL`)
 
-	return nil
+	// the used space is only summed up once, afterwards it's tracked with
+	// every change of the ttl table
+	db.used, err = db.SumTTLSizes()
+	return err
 }
 
 // Close the database
@@ -123,66 +195,213 @@ func (db *DB) Close() error {
 	return db.DB.Close()
 }
 
+// Ping checks whether the database is accessible
+func (db *DB) Ping(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer db.locked()()
+
+	return db.DB.PingContext(ctx)
+}
+
 func (db *DB) locked() func() {
 	db.mu.Lock()
 	return db.mu.Unlock
 }
 
-// DeleteExpired deletes expired pieces
-func (db *DB) DeleteExpired(ctx context.Context) (expired []string, err error) {
+// DeleteExpired deletes the records of up to limit pieces, which expired
+// before now, and returns their ids and the sum of their sizes
+func (db *DB) DeleteExpired(ctx context.Context, now time.Time, limit int) (expired []string, size int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 	defer db.locked()()
 
-	// TODO: add limit
-
 	tx, err := db.DB.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	now := time.Now().Unix()
-
-	rows, err := tx.Query("SELECT id FROM ttl WHERE 0 < expires AND ? < expires", now)
+	rows, err := tx.Query(`SELECT id, size FROM ttl WHERE 0 < expires AND expires < ? ORDER BY expires LIMIT ?`, now.Unix(), limit)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	for rows.Next() {
 		var id string
-		if err := rows.Scan(&id); err != nil {
-			return nil, err
+		var pieceSize int64
+		if err := rows.Scan(&id, &pieceSize); err != nil {
+			_ = rows.Close()
+			return nil, 0, err
 		}
 		expired = append(expired, id)
+		size += pieceSize
 	}
-	if err := rows.Close(); err != nil {
-		return nil, err
+	if err := utils.CombineErrors(rows.Err(), rows.Close()); err != nil {
+		return nil, 0, err
 	}
 
-	_, err = tx.Exec(`DELETE FROM ttl WHERE 0 < expires AND ? < expires`, now)
-	if err != nil {
-		return nil, err
+	for _, id := range expired {
+		if _, err := deletePiece(tx, id); err != nil {
+			return nil, 0, err
+		}
 	}
 
-	return expired, tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	atomic.AddInt64(&db.used, -size)
+	return expired, size, nil
 }
 
-// WriteBandwidthAllocToDB inserts bandwidth agreement into DB
-func (db *DB) WriteBandwidthAllocToDB(rba *pb.RenterBandwidthAllocation) error {
+// WriteBandwidthAllocToDB inserts bandwidth agreement into DB and adds it
+// to the bandwidth used for the satellite
+func (db *DB) WriteBandwidthAllocToDB(rba *pb.RenterBandwidthAllocation) (err error) {
 	rbaBytes, err := proto.Marshal(rba)
 	if err != nil {
 		return err
 	}
 	defer db.locked()()
 
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = errs.Combine(err, tx.Rollback())
+		}
+	}()
+
+	satellite := rba.PayerAllocation.SatelliteId.Bytes()
+
 	// We begin extracting the satellite_id
 	// The satellite id can be used to sort the bandwidth agreements
 	// If the agreements are sorted we can send them in bulk streams to the satellite
-	_, err = db.DB.Exec(`INSERT INTO bandwidth_agreements (satellite, agreement, signature) VALUES (?, ?, ?)`,
-		rba.PayerAllocation.SatelliteId.Bytes(), rbaBytes, rba.GetSignature())
+	_, err = tx.Exec(`INSERT INTO bandwidth_agreements (satellite, agreement, signature) VALUES (?, ?, ?)`,
+		satellite, rbaBytes, rba.GetSignature())
+	if err != nil {
+		return err
+	}
+
+	// agreements are removed once they have been sent, the totals are kept
+	// to compare them with the tallies of the satellite
+	day := time.Now().UTC().Truncate(24 * time.Hour).Unix()
+	action := int(rba.PayerAllocation.Action)
+	result, err := tx.Exec(`UPDATE satellite_bandwidth SET size = size + ? WHERE satellite = ? AND action = ? AND daystartdate = ?`,
+		rba.Total, satellite, action, day)
+	if err != nil {
+		return err
+	}
+	if updated, err := result.RowsAffected(); err != nil || updated > 0 {
+		return err
+	}
+	_, err = tx.Exec(`INSERT INTO satellite_bandwidth (satellite, action, daystartdate, size) VALUES (?, ?, ?, ?)`,
+		satellite, action, day, rba.Total)
 	return err
 }
 
+// GetSatelliteBandwidth returns the bandwidth used for a satellite per action
+// on the UTC days between start and end
+func (db *DB) GetSatelliteBandwidth(satellite storj.NodeID, start, end time.Time) (_ map[pb.BandwidthAction]int64, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT action, SUM(size) FROM satellite_bandwidth
+		WHERE satellite = ? AND daystartdate >= ? AND daystartdate < ?
+		GROUP BY action`, satellite.Bytes(), start.Unix(), end.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	totals := make(map[pb.BandwidthAction]int64)
+	for rows.Next() {
+		var action int
+		var size int64
+		if err := rows.Scan(&action, &size); err != nil {
+			return nil, err
+		}
+		totals[pb.BandwidthAction(action)] = size
+	}
+	return totals, rows.Err()
+}
+
+// SaveNodeTally stores a tally received from a satellite, replacing an
+// earlier tally of the same period
+func (db *DB) SaveNodeTally(tally *pb.NodeTally) error {
+	tallyBytes, err := proto.Marshal(tally)
+	if err != nil {
+		return err
+	}
+	defer db.locked()()
+
+	_, err = db.DB.Exec(`INSERT OR REPLACE INTO satellite_tallies (satellite, periodstart, periodend, tally, received) VALUES (?, ?, ?, ?, ?)`,
+		tally.SatelliteId.Bytes(), tally.PeriodStartUnixSec, tally.PeriodEndUnixSec, tallyBytes, time.Now().Unix())
+	return err
+}
+
+// GetNodeTallies returns up to limit tallies received from satellites, latest period first
+func (db *DB) GetNodeTallies(limit int) (tallies []*pb.NodeTally, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT tally FROM satellite_tallies ORDER BY periodstart DESC, satellite LIMIT ?`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var tallyBytes []byte
+		if err := rows.Scan(&tallyBytes); err != nil {
+			return tallies, err
+		}
+		tally := &pb.NodeTally{}
+		if err := proto.Unmarshal(tallyBytes, tally); err != nil {
+			return tallies, err
+		}
+		tallies = append(tallies, tally)
+	}
+	return tallies, rows.Err()
+}
+
+// SaveAgreementReceipt stores a receipt of an agreement accepted by a satellite
+func (db *DB) SaveAgreementReceipt(receipt *pb.AgreementReceipt) error {
+	receiptBytes, err := proto.Marshal(receipt)
+	if err != nil {
+		return err
+	}
+	defer db.locked()()
+
+	_, err = db.DB.Exec(`INSERT OR REPLACE INTO agreement_receipts (satellite, serialnum, accepted, receipt) VALUES (?, ?, ?, ?)`,
+		receipt.SatelliteId.Bytes(), receipt.SerialNumber, receipt.AcceptedUnixSec, receiptBytes)
+	return err
+}
+
+// GetAgreementReceipts returns the receipts of agreements accepted by a satellite between start and end
+func (db *DB) GetAgreementReceipts(satellite storj.NodeID, start, end time.Time) (receipts []*pb.AgreementReceipt, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT receipt FROM agreement_receipts WHERE satellite = ? AND accepted >= ? AND accepted < ? ORDER BY accepted`,
+		satellite.Bytes(), start.Unix(), end.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var receiptBytes []byte
+		if err := rows.Scan(&receiptBytes); err != nil {
+			return receipts, err
+		}
+		receipt := &pb.AgreementReceipt{}
+		if err := proto.Unmarshal(receiptBytes, receipt); err != nil {
+			return receipts, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, rows.Err()
+}
+
 // DeleteBandwidthAllocationBySignature finds an allocation by signature and deletes it
 func (db *DB) DeleteBandwidthAllocationBySignature(signature []byte) error {
 	defer db.locked()()
@@ -268,7 +487,19 @@ func (db *DB) AddTTL(id string, expiration, size int64) error {
 	defer db.locked()()
 
 	created := time.Now().Unix()
-	_, err := db.DB.Exec("INSERT OR REPLACE INTO ttl (id, created, expires, size) VALUES (?, ?, ?, ?)", id, created, expiration, size)
+	var previous int64
+	err := db.updateUsage(id, func(tx *sql.Tx) error {
+		// a replaced piece doesn't use its previous size anymore
+		err := tx.QueryRow(`SELECT COALESCE(size, 0) FROM ttl WHERE id=?`, id).Scan(&previous)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		_, err = tx.Exec("INSERT OR REPLACE INTO ttl (id, created, expires, size) VALUES (?, ?, ?, ?)", id, created, expiration, size)
+		return err
+	})
+	if err == nil {
+		atomic.AddInt64(&db.used, size-previous)
+	}
 	return err
 }
 
@@ -280,6 +511,12 @@ func (db *DB) GetTTLByID(id string) (expiration int64, err error) {
 	return expiration, err
 }
 
+// UsedSpace returns the sum of the sizes in the ttl table without querying
+// the database, it's tracked with every change of the table
+func (db *DB) UsedSpace() int64 {
+	return atomic.LoadInt64(&db.used)
+}
+
 // SumTTLSizes sums the size column on the ttl table
 func (db *DB) SumTTLSizes() (sum int64, err error) {
 	defer db.locked()()
@@ -300,14 +537,45 @@ func (db *DB) SumTTLSizes() (sum int64, err error) {
 }
 
 // DeleteTTLByID finds the TTL in the database by id and delete it
-func (db *DB) DeleteTTLByID(id string) error {
+func (db *DB) DeleteTTLByID(id string) (err error) {
 	defer db.locked()()
 
-	_, err := db.DB.Exec(`DELETE FROM ttl WHERE id=?`, id)
-	if err == sql.ErrNoRows {
-		err = nil
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
 	}
-	return err
+
+	size, err := deletePiece(tx, id)
+	if err != nil {
+		return errs.Combine(err, tx.Rollback())
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	atomic.AddInt64(&db.used, -size)
+	return nil
+}
+
+// pieceTables are the tables, which reference pieces by their `id` and
+// whose rows are deleted together with the piece
+var pieceTables = []string{"ttl", "satellite_pieces", "quarantined_pieces", "piece_hashes", "piece_ids"}
+
+// deletePiece deletes the rows of a piece from all pieceTables, removes it
+// from the usage of its satellite and returns its size
+func deletePiece(tx *sql.Tx, id string) (size int64, err error) {
+	err = tx.QueryRow(`SELECT COALESCE(size, 0) FROM ttl WHERE id=?`, id).Scan(&size)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, err
+	}
+	if err := adjustUsage(tx, id, -1); err != nil {
+		return 0, err
+	}
+	for _, table := range pieceTables {
+		if _, err := tx.Exec("DELETE FROM `"+table+"` WHERE id=?", id); err != nil {
+			return 0, err
+		}
+	}
+	return size, nil
 }
 
 // AddBandwidthUsed adds bandwidth usage into database by date
@@ -371,3 +639,465 @@ func (db *DB) GetTotalBandwidthBetween(startdate time.Time, enddate time.Time) (
 	err = db.DB.QueryRow(`SELECT SUM(size) FROM bwusagetbl WHERE daystartdate BETWEEN ? AND ?`, startTimeUnix, endTimeUnix).Scan(&totalbwusage)
 	return totalbwusage, err
 }
+
+// AddNotification stores a new notification unless an unread notification of the same type exists
+func (db *DB) AddNotification(notification Notification) (added bool, err error) {
+	defer db.locked()()
+
+	var unread int
+	err = db.DB.QueryRow(`SELECT COUNT(*) FROM notifications WHERE type = ? AND read = 0`, notification.Type).Scan(&unread)
+	if err != nil {
+		return false, err
+	}
+	if unread > 0 {
+		return false, nil
+	}
+
+	_, err = db.DB.Exec(`INSERT INTO notifications (type, title, message, created) VALUES (?, ?, ?, ?)`,
+		notification.Type, notification.Title, notification.Message, time.Now().Unix())
+	return err == nil, err
+}
+
+// GetNotifications returns up to limit notifications, newest first
+func (db *DB) GetNotifications(limit int) (notifications []Notification, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT id, type, title, message, created, read FROM notifications ORDER BY id DESC LIMIT ?`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var notification Notification
+		var created int64
+		err := rows.Scan(&notification.ID, &notification.Type, &notification.Title, &notification.Message, &created, &notification.Read)
+		if err != nil {
+			return notifications, err
+		}
+		notification.Created = time.Unix(created, 0)
+		notifications = append(notifications, notification)
+	}
+	return notifications, rows.Err()
+}
+
+// MarkNotificationsRead marks all notifications as read
+func (db *DB) MarkNotificationsRead() error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`UPDATE notifications SET read = 1 WHERE read = 0`)
+	return err
+}
+
+// AddSatellitePiece records the satellite a piece has been stored for
+func (db *DB) AddSatellitePiece(id string, satellite storj.NodeID) error {
+	defer db.locked()()
+
+	return db.updateUsage(id, func(tx *sql.Tx) error {
+		_, err := tx.Exec(`INSERT OR REPLACE INTO satellite_pieces (id, satellite) VALUES (?, ?)`, id, satellite.Bytes())
+		return err
+	})
+}
+
+// GetSatellitePieces returns up to limit ids of the pieces stored for a satellite
+func (db *DB) GetSatellitePieces(satellite storj.NodeID, limit int) (ids []string, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT id FROM satellite_pieces WHERE satellite = ? LIMIT ?`, satellite.Bytes(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return ids, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// SumSatellitePieceSizes sums the sizes of the pieces stored for a satellite
+func (db *DB) SumSatellitePieceSizes(satellite storj.NodeID) (sum int64, err error) {
+	defer db.locked()()
+
+	err = db.DB.QueryRow(`SELECT used FROM satellite_usage WHERE satellite = ?`, satellite.Bytes()).Scan(&sum)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return sum, err
+}
+
+// GetSatellites returns the satellites, which the node has pieces,
+// agreements or bandwidth records of
+func (db *DB) GetSatellites() (satellites storj.NodeIDList, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`
+		SELECT satellite FROM satellite_pieces
+		UNION SELECT satellite FROM bandwidth_agreements
+		UNION SELECT satellite FROM satellite_bandwidth
+		UNION SELECT satellite FROM satellite_tallies`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var satelliteBytes []byte
+		if err := rows.Scan(&satelliteBytes); err != nil {
+			return satellites, err
+		}
+		satellite, err := storj.NodeIDFromBytes(satelliteBytes)
+		if err != nil {
+			return satellites, err
+		}
+		satellites = append(satellites, satellite)
+	}
+	return satellites, rows.Err()
+}
+
+// MarkUntrusted records that a satellite isn't trusted anymore and returns
+// since when it isn't trusted, added is false when it had already been recorded
+func (db *DB) MarkUntrusted(satellite storj.NodeID, now time.Time) (since time.Time, added bool, err error) {
+	defer db.locked()()
+
+	result, err := db.DB.Exec(`INSERT OR IGNORE INTO untrusted_satellites (satellite, since) VALUES (?, ?)`, satellite.Bytes(), now.Unix())
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	var sinceUnix int64
+	err = db.DB.QueryRow(`SELECT since FROM untrusted_satellites WHERE satellite = ?`, satellite.Bytes()).Scan(&sinceUnix)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return time.Unix(sinceUnix, 0), inserted > 0, nil
+}
+
+// GetUntrusted returns the satellites, which have been marked as untrusted,
+// and since when they aren't trusted
+func (db *DB) GetUntrusted() (untrusted map[storj.NodeID]time.Time, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT satellite, since FROM untrusted_satellites`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	untrusted = make(map[storj.NodeID]time.Time)
+	for rows.Next() {
+		var satelliteBytes []byte
+		var since int64
+		if err := rows.Scan(&satelliteBytes, &since); err != nil {
+			return untrusted, err
+		}
+		satellite, err := storj.NodeIDFromBytes(satelliteBytes)
+		if err != nil {
+			return untrusted, err
+		}
+		untrusted[satellite] = time.Unix(since, 0)
+	}
+	return untrusted, rows.Err()
+}
+
+// ClearUntrusted removes the untrusted mark of a satellite, which is trusted again
+func (db *DB) ClearUntrusted(satellite storj.NodeID) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`DELETE FROM untrusted_satellites WHERE satellite = ?`, satellite.Bytes())
+	return err
+}
+
+// DeleteSatelliteRecords deletes the agreements, bandwidth records and
+// tallies of a satellite together with its untrusted mark
+func (db *DB) DeleteSatelliteRecords(satellite storj.NodeID) (err error) {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = errs.Combine(err, tx.Rollback())
+		}
+	}()
+
+	for _, table := range []string{"bandwidth_agreements", "satellite_bandwidth", "satellite_tallies", "untrusted_satellites"} {
+		_, err = tx.Exec(`DELETE FROM `+table+` WHERE satellite = ?`, satellite.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// QuarantinePiece records that reading a piece from the disk failed, so it
+// isn't served anymore. The first error of a piece is kept.
+func (db *DB) QuarantinePiece(id, disk, readErr string, now time.Time) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`INSERT OR IGNORE INTO quarantined_pieces (id, disk, error, quarantined) VALUES (?, ?, ?, ?)`,
+		id, disk, readErr, now.Unix())
+	return err
+}
+
+// IsQuarantined returns whether a piece has been quarantined
+func (db *DB) IsQuarantined(id string) (quarantined bool, err error) {
+	defer db.locked()()
+
+	var count int
+	err = db.DB.QueryRow(`SELECT COUNT(*) FROM quarantined_pieces WHERE id = ?`, id).Scan(&count)
+	return count > 0, err
+}
+
+// CountQuarantined returns the number of quarantined pieces by disk
+func (db *DB) CountQuarantined() (counts map[string]int64, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT disk, COUNT(*) FROM quarantined_pieces GROUP BY disk`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	counts = make(map[string]int64)
+	for rows.Next() {
+		var disk string
+		var count int64
+		if err := rows.Scan(&disk, &count); err != nil {
+			return counts, err
+		}
+		counts[disk] = count
+	}
+	return counts, rows.Err()
+}
+
+// AddPieceHash records the SHA-256 hash of a stored piece
+func (db *DB) AddPieceHash(id string, hash []byte) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`INSERT OR REPLACE INTO piece_hashes (id, hash) VALUES (?, ?)`, id, hash)
+	return err
+}
+
+// GetPieceHash returns the recorded hash of a piece, it's nil when the hash
+// wasn't recorded, e.g. for pieces stored before hashes were recorded
+func (db *DB) GetPieceHash(id string) (hash []byte, err error) {
+	defer db.locked()()
+
+	err = db.DB.QueryRow(`SELECT hash FROM piece_hashes WHERE id = ?`, id).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return hash, err
+}
+
+// StoredPiece is the record of a completely stored piece
+type StoredPiece struct {
+	Created time.Time
+	Size    int64
+	// Hash is nil for pieces stored before hashes were recorded
+	Hash []byte
+}
+
+// GetStoredPiece returns the record of a stored piece, it's nil when the
+// piece isn't stored
+func (db *DB) GetStoredPiece(id string) (_ *StoredPiece, err error) {
+	defer db.locked()()
+
+	var created int64
+	piece := &StoredPiece{}
+	err = db.DB.QueryRow(`SELECT ttl.created, ttl.size, piece_hashes.hash FROM ttl
+		LEFT JOIN piece_hashes ON piece_hashes.id = ttl.id
+		WHERE ttl.id = ?`, id).Scan(&created, &piece.Size, &piece.Hash)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	piece.Created = time.Unix(created, 0)
+	return piece, nil
+}
+
+// AddPieceID records the piece id sent by the uplink of a stored piece, which
+// the satellite knows the piece by
+func (db *DB) AddPieceID(id, pieceID string) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`INSERT OR REPLACE INTO piece_ids (id, piece_id) VALUES (?, ?)`, id, pieceID)
+	return err
+}
+
+// RetainCandidate is a stored piece, which a satellite may not keep anymore
+type RetainCandidate struct {
+	ID      string
+	PieceID string
+}
+
+// GetRetainCandidates returns up to limit pieces of the satellite ordered by
+// id after the id after, which were stored before createdBefore and whose
+// piece ids are known
+func (db *DB) GetRetainCandidates(satellite storj.NodeID, createdBefore time.Time, after string, limit int) (candidates []RetainCandidate, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT ttl.id, piece_ids.piece_id FROM ttl
+		JOIN satellite_pieces ON satellite_pieces.id = ttl.id
+		JOIN piece_ids ON piece_ids.id = ttl.id
+		WHERE satellite_pieces.satellite = ? AND ttl.created < ? AND ttl.id > ?
+		ORDER BY ttl.id LIMIT ?`, satellite.Bytes(), createdBefore.Unix(), after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var candidate RetainCandidate
+		if err := rows.Scan(&candidate.ID, &candidate.PieceID); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, rows.Err()
+}
+
+// SatelliteUsage is the space used by the pieces of a satellite
+type SatelliteUsage struct {
+	Satellite storj.NodeID
+	Used      int64
+	Pieces    int64
+}
+
+// updateUsage runs update in a transaction, which keeps the usage of the
+// satellite of the piece id up to date with the changed records
+func (db *DB) updateUsage(id string, update func(tx *sql.Tx) error) (err error) {
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = errs.Combine(err, tx.Rollback())
+		}
+	}()
+
+	if err := adjustUsage(tx, id, -1); err != nil {
+		return err
+	}
+	if err := update(tx); err != nil {
+		return err
+	}
+	return adjustUsage(tx, id, 1)
+}
+
+// adjustUsage adds a piece to the usage of its satellite, or removes it with
+// a negative sign. Pieces count once both their ttl and satellite are recorded.
+func adjustUsage(tx *sql.Tx, id string, sign int64) error {
+	var satellite []byte
+	var size int64
+	err := tx.QueryRow(`SELECT satellite_pieces.satellite, ttl.size FROM satellite_pieces
+		JOIN ttl ON ttl.id = satellite_pieces.id
+		WHERE satellite_pieces.id = ?`, id).Scan(&satellite, &size)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(`UPDATE satellite_usage SET used = used + ?, pieces = pieces + ? WHERE satellite = ?`,
+		sign*size, sign, satellite)
+	if err != nil {
+		return err
+	}
+	if updated, err := result.RowsAffected(); err != nil || updated > 0 {
+		return err
+	}
+	_, err = tx.Exec(`INSERT INTO satellite_usage (satellite, used, pieces) VALUES (?, ?, ?)`, satellite, sign*size, sign)
+	return err
+}
+
+// GetSatelliteUsage returns the usage of every satellite, which has pieces on the node
+func (db *DB) GetSatelliteUsage() (usage []SatelliteUsage, err error) {
+	defer db.locked()()
+
+	rows, err := db.DB.Query(`SELECT satellite, used, pieces FROM satellite_usage WHERE pieces > 0 ORDER BY satellite`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var satelliteBytes []byte
+		var satellite SatelliteUsage
+		if err := rows.Scan(&satelliteBytes, &satellite.Used, &satellite.Pieces); err != nil {
+			return nil, err
+		}
+		satellite.Satellite, err = storj.NodeIDFromBytes(satelliteBytes)
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, satellite)
+	}
+	return usage, rows.Err()
+}
+
+// RecalculateUsage recalculates the usage of the satellites from the piece
+// records, and returns the difference of the used space and pieces to the
+// tracked usage
+func (db *DB) RecalculateUsage() (usedDrift, piecesDrift int64, err error) {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = errs.Combine(err, tx.Rollback())
+		}
+	}()
+
+	totals := func() (used, pieces int64, err error) {
+		err = tx.QueryRow(`SELECT COALESCE(SUM(used), 0), COALESCE(SUM(pieces), 0) FROM satellite_usage`).Scan(&used, &pieces)
+		return used, pieces, err
+	}
+
+	trackedUsed, trackedPieces, err := totals()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	_, err = tx.Exec(`DELETE FROM satellite_usage`)
+	if err != nil {
+		return 0, 0, err
+	}
+	_, err = tx.Exec(`INSERT INTO satellite_usage (satellite, used, pieces)
+		SELECT satellite_pieces.satellite, SUM(ttl.size), COUNT(*) FROM satellite_pieces
+			JOIN ttl ON ttl.id = satellite_pieces.id
+			GROUP BY satellite_pieces.satellite`)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	used, pieces, err := totals()
+	if err != nil {
+		return 0, 0, err
+	}
+	return used - trackedUsed, pieces - trackedPieces, nil
+}
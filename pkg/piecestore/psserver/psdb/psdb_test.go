@@ -4,6 +4,8 @@
 package psdb
 
 import (
+	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -274,6 +276,493 @@ func TestBandwidthUsage(t *testing.T) {
 	})
 }
 
+func TestNotifications(t *testing.T) {
+	db, err := OpenInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	add := func(typ string, expected bool) {
+		added, err := db.AddNotification(Notification{Type: typ, Title: typ, Message: "message"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if added != expected {
+			t.Fatalf("%s: expected added %v got %v", typ, expected, added)
+		}
+	}
+
+	add("disk", true)
+	add("disk", false) // unread notification of the same type exists
+	add("bandwidth", true)
+
+	notifications, err := db.GetNotifications(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notifications) != 2 || notifications[0].Type != "bandwidth" || notifications[1].Type != "disk" {
+		t.Fatalf("unexpected notifications %+v", notifications)
+	}
+
+	if err := db.MarkNotificationsRead(); err != nil {
+		t.Fatal(err)
+	}
+	add("disk", true)
+
+	notifications, err = db.GetNotifications(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notifications) != 1 || notifications[0].Type != "disk" || notifications[0].Read {
+		t.Fatalf("unexpected notifications %+v", notifications)
+	}
+}
+
+func TestNodeTallies(t *testing.T) {
+	db, err := OpenInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	satelliteID := teststorj.NodeIDFromString("satellite")
+	for _, total := range []int64{100, 200} {
+		err := db.WriteBandwidthAllocToDB(&pb.RenterBandwidthAllocation{
+			PayerAllocation: pb.PayerBandwidthAllocation{SatelliteId: satelliteID, Action: pb.BandwidthAction_GET},
+			Total:           total,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	start := time.Now().UTC().Truncate(24 * time.Hour)
+	end := start.Add(24 * time.Hour)
+	bandwidth, err := db.GetSatelliteBandwidth(satelliteID, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bandwidth) != 1 || bandwidth[pb.BandwidthAction_GET] != 300 {
+		t.Fatalf("unexpected bandwidth %v", bandwidth)
+	}
+
+	for _, getTotal := range []int64{250, 300} {
+		err := db.SaveNodeTally(&pb.NodeTally{
+			SatelliteId:        satelliteID,
+			PeriodStartUnixSec: start.Unix(),
+			PeriodEndUnixSec:   end.Unix(),
+			GetTotal:           getTotal,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// a tally of the same period replaces the earlier one
+	tallies, err := db.GetNodeTallies(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tallies) != 1 || tallies[0].GetTotal != 300 {
+		t.Fatalf("unexpected tallies %+v", tallies)
+	}
+}
+
+func TestAgreementReceipts(t *testing.T) {
+	db, err := OpenInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	satelliteID := teststorj.NodeIDFromString("satellite")
+	otherID := teststorj.NodeIDFromString("other")
+	now := time.Now()
+	for i, receipt := range []*pb.AgreementReceipt{
+		{SerialNumber: "1", SatelliteId: satelliteID, AcceptedUnixSec: now.Add(-2 * time.Hour).Unix()},
+		{SerialNumber: "2", SatelliteId: satelliteID, AcceptedUnixSec: now.Unix()},
+		{SerialNumber: "2", SatelliteId: satelliteID, AcceptedUnixSec: now.Unix()},
+		{SerialNumber: "3", SatelliteId: otherID, AcceptedUnixSec: now.Unix()},
+	} {
+		if err := db.SaveAgreementReceipt(receipt); err != nil {
+			t.Fatal(i, err)
+		}
+	}
+
+	receipts, err := db.GetAgreementReceipts(satelliteID, now.Add(-time.Hour), now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(receipts) != 1 || receipts[0].SerialNumber != "2" || receipts[0].SatelliteId != satelliteID {
+		t.Fatalf("unexpected receipts %v", receipts)
+	}
+}
+
+func TestUntrustedSatellites(t *testing.T) {
+	db, err := OpenInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	satelliteID := teststorj.NodeIDFromString("satellite")
+	for _, id := range []string{"piece1", "piece2"} {
+		if err := db.AddTTL(id, 0, 10); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.AddSatellitePiece(id, satelliteID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = db.WriteBandwidthAllocToDB(&pb.RenterBandwidthAllocation{
+		PayerAllocation: pb.PayerBandwidthAllocation{SatelliteId: satelliteID, Action: pb.BandwidthAction_PUT},
+		Total:           20,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	satellites, err := db.GetSatellites()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(satellites) != 1 || satellites[0] != satelliteID {
+		t.Fatalf("unexpected satellites %v", satellites)
+	}
+
+	stored, err := db.SumSatellitePieceSizes(satelliteID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored != 20 {
+		t.Fatalf("expected 20 bytes stored got %d", stored)
+	}
+
+	now := time.Unix(time.Now().Unix(), 0)
+	since, added, err := db.MarkUntrusted(satelliteID, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !added || !since.Equal(now) {
+		t.Fatalf("expected to be marked at %v got %v (added %v)", now, since, added)
+	}
+
+	// marking again keeps the start of the grace period
+	since, added, err = db.MarkUntrusted(satelliteID, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added || !since.Equal(now) {
+		t.Fatalf("expected to be marked at %v got %v (added %v)", now, since, added)
+	}
+
+	untrusted, err := db.GetUntrusted()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(untrusted) != 1 || !untrusted[satelliteID].Equal(now) {
+		t.Fatalf("unexpected untrusted satellites %v", untrusted)
+	}
+
+	if err := db.DeleteTTLByID("piece1"); err != nil {
+		t.Fatal(err)
+	}
+	ids, err := db.GetSatellitePieces(satelliteID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 1 || ids[0] != "piece2" {
+		t.Fatalf("unexpected pieces %v", ids)
+	}
+
+	if err := db.DeleteSatelliteRecords(satelliteID); err != nil {
+		t.Fatal(err)
+	}
+	untrusted, err = db.GetUntrusted()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(untrusted) != 0 {
+		t.Fatalf("unexpected untrusted satellites %v", untrusted)
+	}
+	agreements, err := db.GetBandwidthAllocations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(agreements) != 0 {
+		t.Fatalf("unexpected agreements %v", agreements)
+	}
+}
+
+func TestQuarantinedPieces(t *testing.T) {
+	db, err := OpenInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	now := time.Now()
+	for _, id := range []string{"piece1", "piece2"} {
+		if err := db.AddTTL(id, 0, 10); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.QuarantinePiece(id, "/disk1", "input/output error", now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.QuarantinePiece("piece3", "/disk2", "input/output error", now); err != nil {
+		t.Fatal(err)
+	}
+	// quarantining again doesn't count the piece twice
+	if err := db.QuarantinePiece("piece1", "/disk1", "input/output error", now); err != nil {
+		t.Fatal(err)
+	}
+
+	quarantined, err := db.IsQuarantined("piece1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !quarantined {
+		t.Fatal("expected piece1 to be quarantined")
+	}
+	quarantined, err = db.IsQuarantined("piece4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quarantined {
+		t.Fatal("expected piece4 not to be quarantined")
+	}
+
+	counts, err := db.CountQuarantined()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(counts) != 2 || counts["/disk1"] != 2 || counts["/disk2"] != 1 {
+		t.Fatalf("unexpected counts %v", counts)
+	}
+
+	// deleting a piece removes it from the quarantine
+	if err := db.DeleteTTLByID("piece1"); err != nil {
+		t.Fatal(err)
+	}
+	counts, err = db.CountQuarantined()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if counts["/disk1"] != 1 {
+		t.Fatalf("unexpected counts %v", counts)
+	}
+}
+
+func TestPieceHashes(t *testing.T) {
+	db, err := OpenInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	if err := db.AddTTL("piece1", 0, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddPieceHash("piece1", []byte("hash1")); err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := db.GetPieceHash("piece1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(hash) != "hash1" {
+		t.Fatalf("unexpected hash %q", hash)
+	}
+
+	// pieces stored before hashes were recorded have none
+	hash, err = db.GetPieceHash("piece2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != nil {
+		t.Fatalf("unexpected hash %q", hash)
+	}
+
+	// deleting a piece removes its hash
+	if err := db.DeleteTTLByID("piece1"); err != nil {
+		t.Fatal(err)
+	}
+	hash, err = db.GetPieceHash("piece1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != nil {
+		t.Fatalf("unexpected hash %q", hash)
+	}
+}
+
+func TestDeleteExpired(t *testing.T) {
+	ctx := context.Background()
+	db, err := OpenInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	now := time.Now()
+	pieces := []struct {
+		id      string
+		expires int64
+		size    int64
+	}{
+		{"expired1", now.Add(-2 * time.Hour).Unix(), 10},
+		{"expired2", now.Add(-time.Hour).Unix(), 20},
+		{"expired3", now.Add(-time.Minute).Unix(), 30},
+		{"valid", now.Add(time.Hour).Unix(), 40},
+		{"forever", 0, 50},
+	}
+	for _, piece := range pieces {
+		if err := db.AddTTL(piece.id, piece.expires, piece.size); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.AddPieceHash(piece.id, []byte("hash")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the pieces are deleted in batches, the earliest expiration first
+	expired, size, err := db.DeleteExpired(ctx, now, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(expired) != "[expired1 expired2]" || size != 30 {
+		t.Fatalf("unexpected expired pieces %v of size %d", expired, size)
+	}
+
+	expired, size, err = db.DeleteExpired(ctx, now, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(expired) != "[expired3]" || size != 30 {
+		t.Fatalf("unexpected expired pieces %v of size %d", expired, size)
+	}
+
+	expired, _, err = db.DeleteExpired(ctx, now, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(expired) != 0 {
+		t.Fatalf("unexpected expired pieces %v", expired)
+	}
+
+	// the records of the expired pieces are gone, the others are kept
+	for _, piece := range pieces {
+		hash, err := db.GetPieceHash(piece.id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		kept := piece.id == "valid" || piece.id == "forever"
+		if kept != (hash != nil) {
+			t.Fatalf("unexpected hash %q of %s", hash, piece.id)
+		}
+	}
+
+	used, err := db.SumTTLSizes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used != 90 {
+		t.Fatalf("unexpected used space %d", used)
+	}
+}
+
+func TestRetainCandidates(t *testing.T) {
+	db, err := OpenInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	satellite, other := teststorj.NodeIDFromString("satellite"), teststorj.NodeIDFromString("other")
+	for _, piece := range []struct {
+		id        string
+		satellite storj.NodeID
+		pieceID   string
+	}{
+		{"piece1", satellite, "original1"},
+		{"piece2", satellite, "original2"},
+		{"piece3", other, "original3"},
+		{"piece4", satellite, ""}, // stored before piece ids were recorded
+	} {
+		if err := db.AddTTL(piece.id, 0, 10); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.AddSatellitePiece(piece.id, piece.satellite); err != nil {
+			t.Fatal(err)
+		}
+		if piece.pieceID != "" {
+			if err := db.AddPieceID(piece.id, piece.pieceID); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	future := time.Now().Add(time.Hour)
+	candidates, err := db.GetRetainCandidates(satellite, future, "", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) != 1 || candidates[0] != (RetainCandidate{ID: "piece1", PieceID: "original1"}) {
+		t.Fatalf("unexpected candidates %v", candidates)
+	}
+	candidates, err = db.GetRetainCandidates(satellite, future, candidates[0].ID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) != 1 || candidates[0] != (RetainCandidate{ID: "piece2", PieceID: "original2"}) {
+		t.Fatalf("unexpected candidates %v", candidates)
+	}
+
+	// pieces stored after the cutoff aren't candidates
+	candidates, err = db.GetRetainCandidates(satellite, time.Now().Add(-time.Hour), "", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) != 0 {
+		t.Fatalf("unexpected candidates %v", candidates)
+	}
+}
+
 func BenchmarkWriteBandwidthAllocation(b *testing.B) {
 	db, cleanup := newDB(b, "3")
 	defer cleanup()
@@ -290,3 +779,164 @@ func BenchmarkWriteBandwidthAllocation(b *testing.B) {
 		}
 	})
 }
+
+func TestSatelliteUsage(t *testing.T) {
+	ctx := context.Background()
+	db, err := OpenInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	satellite, other := teststorj.NodeIDFromString("satellite"), teststorj.NodeIDFromString("other")
+	now := time.Now()
+
+	// the usage is tracked regardless of the order the records are added
+	if err := db.AddTTL("piece1", 0, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddSatellitePiece("piece1", satellite); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddSatellitePiece("piece2", satellite); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddTTL("piece2", now.Add(-time.Hour).Unix(), 20); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddTTL("piece3", 0, 40); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddSatellitePiece("piece3", other); err != nil {
+		t.Fatal(err)
+	}
+
+	verify := func(expected map[storj.NodeID]SatelliteUsage) {
+		t.Helper()
+		usage, err := db.GetSatelliteUsage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(usage) != len(expected) {
+			t.Fatalf("unexpected usage %+v", usage)
+		}
+		for _, satelliteUsage := range usage {
+			if expected[satelliteUsage.Satellite] != satelliteUsage {
+				t.Fatalf("unexpected usage %+v", satelliteUsage)
+			}
+		}
+	}
+
+	verify(map[storj.NodeID]SatelliteUsage{
+		satellite: {Satellite: satellite, Used: 30, Pieces: 2},
+		other:     {Satellite: other, Used: 40, Pieces: 1},
+	})
+	sum, err := db.SumSatellitePieceSizes(satellite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum != 30 {
+		t.Fatalf("unexpected used space %d", sum)
+	}
+
+	// resizing, expiring and deleting pieces updates the usage
+	if err := db.AddTTL("piece1", 0, 15); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := db.DeleteExpired(ctx, now, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteTTLByID("piece3"); err != nil {
+		t.Fatal(err)
+	}
+	verify(map[storj.NodeID]SatelliteUsage{
+		satellite: {Satellite: satellite, Used: 15, Pieces: 1},
+	})
+
+	usedDrift, piecesDrift, err := db.RecalculateUsage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usedDrift != 0 || piecesDrift != 0 {
+		t.Fatalf("unexpected drift of %d bytes and %d pieces", usedDrift, piecesDrift)
+	}
+
+	// the recalculation corrects drifted usage
+	if _, err := db.DB.Exec(`UPDATE satellite_usage SET used = 100, pieces = 5`); err != nil {
+		t.Fatal(err)
+	}
+	usedDrift, piecesDrift, err = db.RecalculateUsage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usedDrift != -85 || piecesDrift != -4 {
+		t.Fatalf("unexpected drift of %d bytes and %d pieces", usedDrift, piecesDrift)
+	}
+	verify(map[storj.NodeID]SatelliteUsage{
+		satellite: {Satellite: satellite, Used: 15, Pieces: 1},
+	})
+}
+
+func TestUsedSpace(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "storj-psdb-used")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(tmpdir) }()
+	dbpath := filepath.Join(tmpdir, "psdb.db")
+
+	db, err := Open(dbpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, piece := range []struct {
+		id      string
+		expires int64
+		size    int64
+	}{
+		{"piece1", 0, 10},
+		{"piece2", time.Now().Add(-time.Hour).Unix(), 20},
+		{"piece3", 0, 30},
+		// a replaced piece only uses its new size
+		{"piece3", 0, 40},
+	} {
+		if err := db.AddTTL(piece.id, piece.expires, piece.size); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if used := db.UsedSpace(); used != 70 {
+		t.Fatalf("expected 70 bytes used, got %d", used)
+	}
+
+	if err := db.DeleteTTLByID("piece1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := db.DeleteExpired(context.Background(), time.Now(), 10); err != nil {
+		t.Fatal(err)
+	}
+	if used := db.UsedSpace(); used != 40 {
+		t.Fatalf("expected 40 bytes used, got %d", used)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the used space is summed up again, when the database is opened
+	db, err = Open(dbpath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if used := db.UsedSpace(); used != 40 {
+		t.Fatalf("expected 40 bytes used after opening, got %d", used)
+	}
+}
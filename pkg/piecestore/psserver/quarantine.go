@@ -0,0 +1,108 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+// ErrQuarantined is returned for pieces, which couldn't be read from the disk
+// and aren't served anymore
+var ErrQuarantined = errs.Class("piece quarantined")
+
+// NotificationReadErrors is sent when pieces are quarantined after read errors
+const NotificationReadErrors = "read_errors"
+
+// quarantineReader keeps the first error of reading a piece, so read errors
+// of the disk can be told apart from errors of sending the data
+type quarantineReader struct {
+	io.Reader
+	err error
+}
+
+// Read reads from the piece and keeps the first error other than io.EOF
+func (reader *quarantineReader) Read(p []byte) (n int, err error) {
+	n, err = reader.Reader.Read(p)
+	if err != nil && err != io.EOF && reader.err == nil {
+		reader.err = err
+	}
+	return n, err
+}
+
+// checkQuarantined returns an error when the piece has been quarantined
+func (s *Server) checkQuarantined(id string) error {
+	quarantined, err := s.DB.IsQuarantined(id)
+	if err != nil {
+		return RetrieveError.Wrap(err)
+	}
+	if quarantined {
+		return ErrQuarantined.New("%s", id)
+	}
+	return nil
+}
+
+// quarantine records a piece, which couldn't be read from the disk, so it
+// isn't served anymore, and notifies the operator of the failing disk
+func (s *Server) quarantine(ctx context.Context, id string, readErr error) {
+	disk := s.storage.Dir()
+	s.log.Warn("quarantining piece after read error",
+		zap.String("Piece ID", id),
+		zap.String("disk", disk),
+		zap.Error(readErr),
+	)
+	mon.Meter("pieces_quarantined").Mark(1)
+
+	if err := s.DB.QuarantinePiece(id, disk, readErr.Error(), time.Now()); err != nil {
+		s.log.Error("could not quarantine piece", zap.String("Piece ID", id), zap.Error(err))
+		return
+	}
+
+	err := s.notify(ctx, psdb.Notification{
+		Type:    NotificationReadErrors,
+		Title:   "Disk read errors",
+		Message: fmt.Sprintf("Pieces couldn't be read from %s and have been quarantined, the disk may be failing.", disk),
+	})
+	if err != nil {
+		s.log.Warn("could not notify the operator", zap.Error(err))
+	}
+}
+
+// getQuarantined returns the number of quarantined pieces of the disks,
+// which pieces couldn't be read from
+func (s *Server) getQuarantined() ([]*pb.QuarantinedDisk, error) {
+	counts, err := s.DB.CountQuarantined()
+	if err != nil {
+		return nil, err
+	}
+
+	quarantined := make([]*pb.QuarantinedDisk, 0, len(counts))
+	for disk, pieces := range counts {
+		quarantined = append(quarantined, &pb.QuarantinedDisk{Disk: disk, Pieces: pieces})
+	}
+	sort.Slice(quarantined, func(i, k int) bool {
+		return quarantined[i].Disk < quarantined[k].Disk
+	})
+	return quarantined, nil
+}
+
+// quarantinedStatus converts requests of quarantined pieces to a data loss
+// status, so clients fail over to other nodes without retrying
+func quarantinedStatus(err error) error {
+	if ErrQuarantined.Has(err) {
+		return status.Error(codes.DataLoss, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestQuarantineReader(t *testing.T) {
+	reader := &quarantineReader{Reader: strings.NewReader("xyzwq")}
+	_, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	assert.NoError(t, reader.err)
+
+	reader = &quarantineReader{Reader: iotest.TimeoutReader(strings.NewReader("xyzwq"))}
+	_, err = io.Copy(ioutil.Discard, reader)
+	assert.Equal(t, iotest.ErrTimeout, err)
+	assert.Equal(t, iotest.ErrTimeout, reader.err)
+}
+
+func TestRetrieveQuarantined(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	snID, upID := newTestID(ctx, t), newTestID(ctx, t)
+	s, c, cleanup := NewTest(ctx, t, snID, upID, []storj.NodeID{})
+	defer cleanup()
+
+	const id = "11111111111111111111"
+	require.NoError(t, writeFile(s, id))
+	defer func() { _ = s.storage.Delete(id) }()
+
+	s.quarantine(ctx, id, errors.New("input/output error"))
+
+	quarantined, err := s.getQuarantined()
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	assert.Equal(t, s.storage.Dir(), quarantined[0].Disk)
+	assert.EqualValues(t, 1, quarantined[0].Pieces)
+
+	notifications, err := s.DB.GetNotifications(10)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, NotificationReadErrors, notifications[0].Type)
+
+	// quarantined pieces are refused with their own status code
+	stream, err := c.Retrieve(ctx)
+	require.NoError(t, err)
+	err = stream.Send(&pb.PieceRetrieval{PieceData: &pb.PieceRetrieval_PieceData{Id: id, PieceSize: 5}})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.DataLoss, st.Code())
+
+	// deleting the piece lifts the quarantine
+	require.NoError(t, s.deleteByID(id))
+	quarantined, err = s.getQuarantined()
+	require.NoError(t, err)
+	assert.Len(t, quarantined, 0)
+}
@@ -4,6 +4,8 @@
 package psserver
 
 import (
+	"context"
+
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/pb"
@@ -17,6 +19,8 @@ var StreamWriterError = errs.Class("stream writer error")
 type StreamWriter struct {
 	server *Server
 	stream pb.PieceStoreRoutes_RetrieveServer
+	// hash is sent with the first message, when it's set
+	hash []byte
 }
 
 // NewStreamWriter returns a new StreamWriter
@@ -27,13 +31,20 @@ func NewStreamWriter(s *Server, stream pb.PieceStoreRoutes_RetrieveServer) *Stre
 // Write -- Write method for piece upload to stream for Server.Retrieve
 func (s *StreamWriter) Write(b []byte) (int, error) {
 	// Write the buffer to the stream we opened earlier
-	if err := s.stream.Send(&pb.PieceRetrievalStream{PieceSize: int64(len(b)), Content: b}); err != nil {
+	if err := s.stream.Send(&pb.PieceRetrievalStream{PieceSize: int64(len(b)), Content: b, Hash: s.hash}); err != nil {
 		return 0, err
 	}
+	s.hash = nil
 
 	return len(b), nil
 }
 
+// pieceReceiver receives the messages of a piece upload
+type pieceReceiver interface {
+	Context() context.Context
+	Recv() (*pb.PieceStore, error)
+}
+
 // StreamReader is a struct for Retrieving data from server
 type StreamReader struct {
 	src                 *utils.ReaderSource
@@ -42,13 +53,16 @@ type StreamReader struct {
 	bandwidthRemaining  int64
 	spaceRemaining      int64
 	sofar               int64
+	// reservation reserves the disk space of the received data, when it's set
+	reservation *spaceReservation
 }
 
-// NewStreamReader returns a new StreamReader for Server.Store
-func NewStreamReader(s *Server, stream pb.PieceStoreRoutes_StoreServer, bandwidthRemaining, spaceRemaining int64) *StreamReader {
+// NewStreamReader returns a new StreamReader for Server.Store and Server.StoreResumable
+func NewStreamReader(s *Server, stream pieceReceiver, bandwidthRemaining int64, reservation *spaceReservation) *StreamReader {
 	sr := &StreamReader{
 		bandwidthRemaining: bandwidthRemaining,
-		spaceRemaining:     spaceRemaining,
+		spaceRemaining:     reservation.available(),
+		reservation:        reservation,
 	}
 	sr.src = utils.NewReaderSource(func() ([]byte, error) {
 
@@ -73,6 +87,9 @@ func NewStreamReader(s *Server, stream pb.PieceStoreRoutes_StoreServer, bandwidt
 				return nil, StoreError.New("Satellite ID not approved")
 			}
 		}
+		if err = s.shaper.WaitIngress(stream.Context(), pba.SatelliteId, int64(len(pd.GetContent()))); err != nil {
+			return nil, err
+		}
 		// Update bandwidthallocation to be stored
 		if rba.Total > sr.currentTotal {
 			sr.bandwidthAllocation = rba
@@ -90,16 +107,22 @@ func (s *StreamReader) Read(b []byte) (int, error) {
 		return 0, StreamWriterError.New("out of bandwidth")
 	}
 	if s.sofar >= s.spaceRemaining {
-		return 0, StreamWriterError.New("out of space")
+		return 0, StreamWriterError.Wrap(ErrOutOfSpace.New("out of space"))
 	}
 
 	n, err := s.src.Read(b)
+	if s.reservation != nil && n > 0 {
+		// uploads running concurrently share the remaining space
+		if err := s.reservation.reserve(int64(n)); err != nil {
+			return 0, StreamWriterError.Wrap(err)
+		}
+	}
 	s.sofar += int64(n)
 	if err != nil {
 		return n, err
 	}
 	if s.sofar >= s.spaceRemaining {
-		return n, StreamWriterError.New("out of space")
+		return n, StreamWriterError.Wrap(ErrOutOfSpace.New("out of space"))
 	}
 
 	return n, nil
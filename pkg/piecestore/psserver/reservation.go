@@ -0,0 +1,84 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"sync"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrOutOfSpace is returned for uploads, which would exceed the allocated
+// disk space including the space reserved by the uploads in progress
+var ErrOutOfSpace = errs.Class("out of allocated disk space")
+
+// spaceReservations keeps track of the disk space reserved by the uploads in
+// progress, so concurrent uploads can't exceed the allocated disk space
+// together. The space of the stored pieces is tracked by the database.
+type spaceReservations struct {
+	mu sync.Mutex
+	// reserved is the space received by the uploads in progress
+	reserved int64
+}
+
+// spaceReservation is the disk space reserved by a single upload
+type spaceReservation struct {
+	server *Server
+	size   int64
+}
+
+// reserveSpace starts reserving disk space for an upload, it fails when
+// there's no space left for it
+func (s *Server) reserveSpace() (*spaceReservation, error) {
+	tracker := &s.reservations
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	// the pieces are stored before their reservations are released, so the
+	// used space includes every piece of the released reservations
+	used := s.DB.UsedSpace()
+	available := s.totalAllocated - used - tracker.reserved
+	if available <= 0 {
+		return nil, ErrOutOfSpace.New("%d bytes allocated, %d bytes used and %d bytes reserved", s.totalAllocated, used, tracker.reserved)
+	}
+	if err := s.checkFreeSpace(available); err != nil {
+		return nil, err
+	}
+	return &spaceReservation{server: s}, nil
+}
+
+// available returns the space, which the upload can still reserve
+func (reservation *spaceReservation) available() int64 {
+	tracker := &reservation.server.reservations
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	return reservation.server.totalAllocated - reservation.server.DB.UsedSpace() - tracker.reserved
+}
+
+// reserve reserves size more bytes for the upload
+func (reservation *spaceReservation) reserve(size int64) error {
+	s := reservation.server
+	tracker := &s.reservations
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	used := s.DB.UsedSpace()
+	if used+tracker.reserved+size > s.totalAllocated {
+		return ErrOutOfSpace.New("%d bytes allocated, %d bytes used and %d bytes reserved", s.totalAllocated, used, tracker.reserved)
+	}
+	tracker.reserved += size
+	reservation.size += size
+	return nil
+}
+
+// release releases the reserved space, once the piece is stored or the
+// upload failed
+func (reservation *spaceReservation) release() {
+	tracker := &reservation.server.reservations
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	tracker.reserved -= reservation.size
+	reservation.size = 0
+}
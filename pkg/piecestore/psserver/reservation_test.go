@@ -0,0 +1,47 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+func TestSpaceReservations(t *testing.T) {
+	db, err := psdb.OpenInMemory()
+	require.NoError(t, err)
+	defer func() { require.NoError(t, db.Close()) }()
+
+	s := &Server{log: zaptest.NewLogger(t), DB: db, totalAllocated: 100}
+
+	first, err := s.reserveSpace()
+	require.NoError(t, err)
+	second, err := s.reserveSpace()
+	require.NoError(t, err)
+
+	require.NoError(t, first.reserve(60))
+	assert.Equal(t, int64(40), second.available())
+	assert.True(t, ErrOutOfSpace.Has(second.reserve(50)), "concurrent uploads share the allocated space")
+	require.NoError(t, second.reserve(40))
+
+	_, err = s.reserveSpace()
+	assert.True(t, ErrOutOfSpace.Has(err), "no uploads start without space")
+
+	// the first piece is stored, so its space moves from reserved to used
+	require.NoError(t, db.AddTTL("11111111111111111111", 0, 60))
+	first.release()
+	assert.True(t, ErrOutOfSpace.Has(second.reserve(1)))
+
+	// the second upload failed, so its space is available again
+	second.release()
+	third, err := s.reserveSpace()
+	require.NoError(t, err)
+	assert.Equal(t, int64(40), third.available())
+	third.release()
+}
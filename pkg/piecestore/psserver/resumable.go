@@ -0,0 +1,251 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/utils"
+)
+
+// resumableAckSize is the amount of data a resumable upload receives between acks
+var resumableAckSize = 256 * memory.KiB.Int64()
+
+// resumableUploads are the pieces, whose resumable uploads are in progress
+type resumableUploads struct {
+	mu     sync.Mutex
+	active map[string]struct{}
+}
+
+// start marks the upload of a piece as in progress, it returns false when
+// the piece is uploaded by another stream already
+func (uploads *resumableUploads) start(id string) bool {
+	uploads.mu.Lock()
+	defer uploads.mu.Unlock()
+
+	if _, ok := uploads.active[id]; ok {
+		return false
+	}
+	if uploads.active == nil {
+		uploads.active = make(map[string]struct{})
+	}
+	uploads.active[id] = struct{}{}
+	return true
+}
+
+// finish marks the upload of a piece as done
+func (uploads *resumableUploads) finish(id string) {
+	uploads.mu.Lock()
+	defer uploads.mu.Unlock()
+	delete(uploads.active, id)
+}
+
+// StoreResumable stores a piece like Store, but acknowledges the data
+// written to disk. The received data of an interrupted upload is kept, and
+// the client continues from the offset of the first ack of the next upload.
+// The upload ends, when the client closes its side of the stream.
+func (s *Server) StoreResumable(stream pb.PieceStoreRoutes_StoreResumableServer) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+	defer func() { err = spaceStatus(deniedStatus(err)) }()
+	started := time.Now()
+	if err := s.checkUplink(ctx); err != nil {
+		return err
+	}
+	reservation, err := s.reserveSpace()
+	if err != nil {
+		return err
+	}
+	defer reservation.release()
+
+	// Receive id/ttl
+	recv, err := stream.Recv()
+	if err != nil {
+		return StoreError.Wrap(err)
+	}
+
+	authorization := recv.GetAuthorization()
+	if err := s.verifier(authorization); err != nil {
+		return ServerError.Wrap(err)
+	}
+
+	pd := recv.GetPieceData()
+	if pd == nil {
+		return StoreError.New("PieceStore message is nil")
+	}
+	if pd.GetId() == "" {
+		return StoreError.New("piece ID not specified")
+	}
+
+	id, err := getNamespacedPieceID([]byte(pd.GetId()), getNamespace(authorization))
+	if err != nil {
+		return err
+	}
+
+	if !s.uploads.start(id) {
+		// the client may reconnect before the interrupted stream noticed
+		return status.Error(codes.Aborted, "piece is being uploaded by another stream")
+	}
+	defer s.uploads.finish(id)
+
+	// the piece was stored before, the client just missed the last ack
+	size, stored, err := s.storage.HasPiece(id)
+	if err != nil {
+		return StoreError.Wrap(err)
+	}
+	if stored {
+		return stream.Send(&pb.PieceStoreAck{
+			Offset:  size,
+			Summary: &pb.PieceStoreSummary{Message: OK, TotalReceived: size},
+		})
+	}
+
+	s.log.Debug("Storing resumable", zap.String("Piece ID", fmt.Sprint(pd.GetId())))
+
+	var received int64
+	defer func() { s.logAccess(ctx, id, "store", received, started, err) }()
+
+	partial, err := s.storage.PartialWriter(id)
+	if err != nil {
+		return StoreError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, partial.Close()) }()
+
+	// the data received by interrupted uploads isn't part of the used space yet
+	if err := reservation.reserve(partial.Size()); err != nil {
+		return err
+	}
+
+	if err := stream.Send(&pb.PieceStoreAck{Offset: partial.Size()}); err != nil {
+		return StoreError.Wrap(err)
+	}
+
+	received, allocation, err := s.storeResumableData(ctx, stream, partial, reservation)
+	s.throughput.upload(received, err)
+	if received > 0 {
+		if err := s.DB.AddBandwidthUsed(received); err != nil {
+			s.log.Error("failed to write bandwidth info to database", zap.Error(err))
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if allocation == nil {
+		return StoreError.New("no bandwidth allocation received")
+	}
+
+	if err := partial.Commit(); err != nil {
+		return StoreError.Wrap(err)
+	}
+	size = partial.Size()
+
+	if err = s.DB.AddTTL(id, pd.GetExpirationUnixSec(), size); err != nil {
+		deleteErr := s.deleteByID(id)
+		return StoreError.New("failed to write piece meta data to database: %v", utils.CombineErrors(err, deleteErr))
+	}
+	// the allocations of a resumable upload count from the start of the
+	// piece, so the last one covers the interrupted streams as well
+	if err = s.DB.WriteBandwidthAllocToDB(allocation); err != nil {
+		return StoreError.Wrap(err)
+	}
+	if err = s.DB.AddSatellitePiece(id, allocation.PayerAllocation.SatelliteId); err != nil {
+		return StoreError.Wrap(err)
+	}
+	if err = s.DB.AddPieceID(id, pd.GetId()); err != nil {
+		return StoreError.Wrap(err)
+	}
+	// the piece was written by several streams, so it's hashed once it's complete
+	hash, err := s.hashPiece(ctx, id, size)
+	if err != nil {
+		return StoreError.Wrap(err)
+	}
+	if err = s.DB.AddPieceHash(id, hash); err != nil {
+		return StoreError.Wrap(err)
+	}
+	s.log.Info("Successfully stored", zap.String("Piece ID", fmt.Sprint(pd.GetId())))
+
+	return stream.Send(&pb.PieceStoreAck{
+		Offset:  size,
+		Summary: &pb.PieceStoreSummary{Message: OK, TotalReceived: size},
+	})
+}
+
+// storeResumableData appends the received data to the partial piece until
+// the client closes the stream, the data is acknowledged every resumableAckSize
+func (s *Server) storeResumableData(ctx context.Context, stream pb.PieceStoreRoutes_StoreResumableServer, partial *pstore.PartialWriter, reservation *spaceReservation) (received int64, allocation *pb.RenterBandwidthAllocation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bwUsed, err := s.DB.GetTotalBandwidthBetween(getBeginningOfMonth(), time.Now())
+	if err != nil {
+		return 0, nil, err
+	}
+	bwLeft := s.totalBwAllocated - bwUsed
+	reader := NewStreamReader(s, stream, bwLeft, reservation)
+
+	received, err = io.Copy(&ackWriter{partial: partial, stream: stream}, reader)
+	if err != nil && err != io.EOF {
+		return received, nil, err
+	}
+	return received, reader.bandwidthAllocation, nil
+}
+
+// ackWriter writes the data of a resumable upload to the partial piece and
+// acknowledges it, whenever resumableAckSize bytes were written since the last ack
+type ackWriter struct {
+	partial *pstore.PartialWriter
+	stream  pb.PieceStoreRoutes_StoreResumableServer
+	unacked int64
+}
+
+// Write appends data to the partial piece
+func (writer *ackWriter) Write(data []byte) (int, error) {
+	n, err := writer.partial.Write(data)
+	writer.unacked += int64(n)
+	if err != nil || writer.unacked < resumableAckSize {
+		return n, err
+	}
+
+	if err := writer.partial.Sync(); err != nil {
+		return n, err
+	}
+	if err := writer.stream.Send(&pb.PieceStoreAck{Offset: writer.partial.Size()}); err != nil {
+		return n, err
+	}
+	writer.unacked = 0
+	return n, nil
+}
+
+// hashPiece returns the SHA-256 hash of the stored piece of size bytes
+func (s *Server) hashPiece(ctx context.Context, id string, size int64) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	hash := sha256.New()
+	if size == 0 {
+		return hash.Sum(nil), nil
+	}
+
+	file, err := s.storage.Reader(ctx, id, 0, size)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/bwagreement/testbwagreement"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestStoreResumable(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	snID, upID := newTestID(ctx, t), newTestID(ctx, t)
+	s, c, cleanup := NewTest(ctx, t, snID, upID, []storj.NodeID{})
+	defer cleanup()
+
+	pieceID := "11111111111111111111"
+
+	{ // an interrupted upload left a partial piece
+		partial, err := s.storage.PartialWriter(pieceID)
+		require.NoError(t, err)
+		_, err = partial.Write([]byte("xyzwq"))
+		require.NoError(t, err)
+		require.NoError(t, partial.Close())
+	}
+
+	pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_PUT, snID, upID, time.Hour)
+	require.NoError(t, err)
+
+	{ // the upload continues after the partial piece
+		stream, err := c.StoreResumable(ctx)
+		require.NoError(t, err)
+
+		err = stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: pieceID, ExpirationUnixSec: 9999999999}})
+		require.NoError(t, err)
+
+		ack, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), ack.Offset)
+		assert.Nil(t, ack.Summary)
+
+		rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, snID.ID, upID, 8)
+		require.NoError(t, err)
+		err = stream.Send(&pb.PieceStore{
+			PieceData:           &pb.PieceStore_PieceData{Content: []byte("abc")},
+			BandwidthAllocation: rba,
+		})
+		require.NoError(t, err)
+		require.NoError(t, stream.CloseSend())
+
+		ack, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), ack.Offset)
+		require.NotNil(t, ack.Summary)
+		assert.Equal(t, OK, ack.Summary.Message)
+		assert.Equal(t, int64(8), ack.Summary.TotalReceived)
+
+		_, err = stream.Recv()
+		assert.Equal(t, io.EOF, err)
+	}
+
+	{ // the piece is stored without the partial piece
+		reader, err := s.storage.Reader(ctx, pieceID, 0, -1)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, []byte("xyzwqabc"), data)
+
+		deleted, err := s.storage.DeleteStalePartials(time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, deleted)
+	}
+
+	{ // uploading the stored piece again only returns the summary
+		stream, err := c.StoreResumable(ctx)
+		require.NoError(t, err)
+
+		err = stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: pieceID, ExpirationUnixSec: 9999999999}})
+		require.NoError(t, err)
+
+		ack, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), ack.Offset)
+		require.NotNil(t, ack.Summary)
+		assert.Equal(t, int64(8), ack.Summary.TotalReceived)
+	}
+}
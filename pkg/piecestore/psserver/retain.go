@@ -0,0 +1,80 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+// retainBatch is the number of pieces checked against a filter at once
+const retainBatch = 1000
+
+// Retain moves the pieces of the calling satellite to the trash, which were
+// stored before the cutoff of the request and aren't in its filter of live
+// pieces. Pieces stored before their piece ids were recorded are kept.
+func (s *Server) Retain(ctx context.Context, req *pb.RetainRequest) (_ *pb.RetainResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pi, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+	satellite := pi.ID
+	if !s.isWhitelisted(satellite) {
+		return nil, ServerError.New("satellite %s isn't whitelisted", satellite)
+	}
+
+	filter, err := bloomfilter.NewFromBytes(req.GetFilter())
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+	cutoff := time.Unix(req.GetCreationUnixSec(), 0)
+
+	var trashed int64
+	var after string
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		candidates, err := s.DB.GetRetainCandidates(satellite, cutoff, after, retainBatch)
+		if err != nil {
+			return nil, ServerError.Wrap(err)
+		}
+		if len(candidates) == 0 {
+			break
+		}
+		after = candidates[len(candidates)-1].ID
+
+		for _, candidate := range candidates {
+			if filter.Contains([]byte(candidate.PieceID)) {
+				continue
+			}
+			if err := s.trashPiece(candidate.ID); err != nil {
+				return nil, ServerError.Wrap(err)
+			}
+			trashed++
+		}
+	}
+
+	mon.IntVal("retain_trashed").Observe(trashed)
+	s.log.Info("moved pieces to the trash", zap.Stringer("satellite", satellite), zap.Int64("count", trashed))
+	return &pb.RetainResponse{Trashed: trashed}, nil
+}
+
+// trashPiece moves a piece to the trash and removes its records, the trash
+// is emptied by the collector once the trash retention passed
+func (s *Server) trashPiece(id string) error {
+	if err := s.storage.Trash(id); err != nil {
+		return err
+	}
+	return s.DB.DeleteTTLByID(id)
+}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 	"sync/atomic"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -16,6 +17,8 @@ import (
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/pb"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/storj"
 )
 
 // RetrieveError is a type of error for failures in Server.Retrieve()
@@ -25,6 +28,11 @@ var RetrieveError = errs.Class("retrieve error")
 func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error) {
 	ctx := stream.Context()
 	defer mon.Task()(&ctx)(&err)
+	defer func() { err = quarantinedStatus(deniedStatus(err)) }()
+	started := time.Now()
+	if err := s.checkUplink(ctx); err != nil {
+		return err
+	}
 
 	// Receive Signature
 	recv, err := stream.Recv()
@@ -50,11 +58,12 @@ func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error)
 		return err
 	}
 
-	s.log.Debug("Retrieving",
-		zap.String("Piece ID", id),
-		zap.Int64("Offset", pd.GetOffset()),
-		zap.Int64("Size", pd.GetPieceSize()),
-	)
+	var retrieved int64
+	defer func() { s.logAccess(ctx, id, "retrieve", retrieved, started, err) }()
+
+	if err := s.checkQuarantined(id); err != nil {
+		return err
+	}
 
 	// Get path to data being retrieved
 	path, err := s.storage.PiecePath(id)
@@ -67,19 +76,73 @@ func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error)
 	if err != nil {
 		return RetrieveError.Wrap(err)
 	}
+	fileSize := fileInfo.Size()
+
+	allocations := s.receiveAllocations(stream)
+	for {
+		var n int64
+		n, err = s.retrieveRange(ctx, stream, allocations, id, pd, fileSize)
+		retrieved += n
+		if err != nil || !pd.GetPipelined() {
+			return err
+		}
+
+		if err = stream.Send(&pb.PieceRetrievalStream{EndOfRange: true}); err != nil {
+			return RetrieveError.Wrap(err)
+		}
+
+		next, ok := <-allocations.ranges
+		if !ok {
+			// the client has closed the stream after the last range
+			if allocations.err == io.EOF {
+				return nil
+			}
+			return allocations.err
+		}
+		if next.GetId() != "" && next.GetId() != pd.GetId() {
+			return RetrieveError.New("pipelined ranges have to be of the same piece")
+		}
+		next.Id = pd.GetId()
+		pd = next
+	}
+}
+
+// retrieveRange sends a range of the piece to the client
+func (s *Server) retrieveRange(ctx context.Context, stream pb.PieceStoreRoutes_RetrieveServer, allocations *allocationReceiver, id string, pd *pb.PieceRetrieval_PieceData, fileSize int64) (retrieved int64, err error) {
+	s.log.Debug("Retrieving",
+		zap.String("Piece ID", id),
+		zap.Int64("Offset", pd.GetOffset()),
+		zap.Int64("Size", pd.GetPieceSize()),
+	)
 
 	// Read the size specified
 	totalToRead := pd.GetPieceSize()
-	fileSize := fileInfo.Size()
 
 	// Read the entire file if specified -1 but make sure we do it from the correct offset
 	if pd.GetPieceSize() <= -1 || totalToRead+pd.GetOffset() > fileSize {
 		totalToRead = fileSize - pd.GetOffset()
 	}
 
-	retrieved, allocated, err := s.retrieveData(ctx, stream, id, pd.GetOffset(), totalToRead)
+	release, err := s.scheduler.Acquire(ctx, pd.GetPriority(), pd.GetDeadline())
 	if err != nil {
-		return err
+		return 0, RetrieveError.Wrap(err)
+	}
+	defer release()
+
+	// uplinks can verify the downloads of the whole piece
+	var hash []byte
+	if pd.GetOffset() == 0 && totalToRead == fileSize {
+		hash, err = s.DB.GetPieceHash(id)
+		if err != nil {
+			return 0, RetrieveError.Wrap(err)
+		}
+	}
+
+	var allocated int64
+	retrieved, allocated, err = s.retrieveData(ctx, stream, allocations, id, pd.GetOffset(), totalToRead, hash)
+	s.throughput.download(retrieved, err)
+	if err != nil {
+		return retrieved, err
 	}
 
 	s.log.Info("Successfully retrieved",
@@ -87,30 +150,37 @@ func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error)
 		zap.Int64("Allocated", allocated),
 		zap.Int64("Retrieved", retrieved),
 	)
-	return nil
+	return retrieved, nil
 }
 
-func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_RetrieveServer, id string, offset, length int64) (retrieved, allocated int64, err error) {
-	defer mon.Task()(&ctx)(&err)
+// allocationReceiver receives the bandwidth allocations of a Retrieve stream,
+// which continue over all pipelined ranges
+type allocationReceiver struct {
+	tracking *sync2.Throttle
+	// total is the total of the last allocation
+	total int64
+	// satelliteID is set before the first allocation is produced
+	satelliteID storj.NodeID
+	// ranges receives the next pipelined ranges, it's closed when the stream ends
+	ranges chan *pb.PieceRetrieval_PieceData
+	// err is the error which ended the stream, it's set before ranges is closed
+	err error
+}
 
-	storeFile, err := s.storage.Reader(ctx, id, offset, length)
-	if err != nil {
-		return 0, 0, RetrieveError.Wrap(err)
+// receiveAllocations starts receiving the messages of the stream
+func (s *Server) receiveAllocations(stream pb.PieceStoreRoutes_RetrieveServer) *allocationReceiver {
+	allocations := &allocationReceiver{
+		tracking: sync2.NewThrottle(),
+		// only one range may be requested while another is sent
+		ranges: make(chan *pb.PieceRetrieval_PieceData, 1),
 	}
 
-	defer func() {
-		err = errs.Combine(err, storeFile.Close())
-	}()
-
-	writer := NewStreamWriter(s, stream)
-	allocationTracking := sync2.NewThrottle()
-	totalAllocated := int64(0)
-
 	// Bandwidth Allocation recv loop
 	go func() {
 		var lastTotal int64
 		var lastAllocation *pb.RenterBandwidthAllocation
 		defer func() {
+			close(allocations.ranges)
 			if lastAllocation == nil {
 				return
 			}
@@ -124,17 +194,32 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 		for {
 			recv, err := stream.Recv()
 			if err != nil {
-				allocationTracking.Fail(RetrieveError.Wrap(err))
+				allocations.err = err
+				allocations.tracking.Fail(RetrieveError.Wrap(err))
 				return
 			}
+			if pd := recv.GetPieceData(); pd != nil {
+				select {
+				case allocations.ranges <- pd:
+				default:
+					allocations.err = RetrieveError.New("range requested before the previous range was sent")
+					allocations.tracking.Fail(allocations.err)
+					return
+				}
+				if recv.BandwidthAllocation == nil {
+					continue
+				}
+			}
 			rba := recv.BandwidthAllocation
 			if err = s.verifySignature(stream.Context(), rba); err != nil {
-				allocationTracking.Fail(RetrieveError.Wrap(err))
+				allocations.err = RetrieveError.Wrap(err)
+				allocations.tracking.Fail(allocations.err)
 				return
 			}
 			pba := rba.PayerAllocation
 			if err = s.verifyPayerAllocation(&pba, "GET"); err != nil {
-				allocationTracking.Fail(RetrieveError.Wrap(err))
+				allocations.err = RetrieveError.Wrap(err)
+				allocations.tracking.Fail(allocations.err)
 				return
 			}
 			//todo: figure out why this fails tests
@@ -143,11 +228,16 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 			// 	return
 			// }
 			if lastTotal > rba.Total {
-				allocationTracking.Fail(fmt.Errorf("got lower allocation was %v got %v", lastTotal, rba.Total))
+				allocations.err = fmt.Errorf("got lower allocation was %v got %v", lastTotal, rba.Total)
+				allocations.tracking.Fail(allocations.err)
 				return
 			}
-			atomic.StoreInt64(&totalAllocated, rba.Total)
-			if err = allocationTracking.Produce(rba.Total - lastTotal); err != nil {
+			if lastAllocation == nil {
+				allocations.satelliteID = pba.SatelliteId
+			}
+			atomic.StoreInt64(&allocations.total, rba.Total)
+			if err = allocations.tracking.Produce(rba.Total - lastTotal); err != nil {
+				allocations.err = err
 				return
 			}
 
@@ -156,6 +246,34 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 		}
 	}()
 
+	return allocations
+}
+
+func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_RetrieveServer, allocations *allocationReceiver, id string, offset, length int64, hash []byte) (retrieved, allocated int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// large reads are usually audits, repairs or whole piece downloads, which
+	// aren't repeated soon, so they shouldn't evict the cached small pieces
+	mode := pstore.CacheNormal
+	if s.uncachedReadSize > 0 && length >= s.uncachedReadSize {
+		mode = s.readCacheMode
+	}
+
+	file, err := s.storage.ReaderMode(ctx, id, offset, length, mode)
+	if err != nil {
+		return 0, 0, RetrieveError.Wrap(err)
+	}
+
+	defer func() {
+		err = errs.Combine(err, file.Close())
+	}()
+
+	storeFile := &quarantineReader{Reader: file}
+
+	writer := NewStreamWriter(s, stream)
+	writer.hash = hash
+	allocationTracking := allocations.tracking
+
 	// Data send loop
 	messageSize := int64(32 * memory.KiB)
 	used := int64(0)
@@ -174,7 +292,17 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 
 		used += nextMessageSize
 
+		if err := s.shaper.WaitEgress(ctx, allocations.satelliteID, toCopy); err != nil {
+			allocationTracking.Fail(RetrieveError.Wrap(err))
+			break
+		}
+
 		n, err := io.CopyN(writer, storeFile, toCopy)
+		if storeFile.err != nil {
+			s.quarantine(ctx, id, storeFile.err)
+			allocationTracking.Fail(ErrQuarantined.Wrap(storeFile.err))
+			break
+		}
 		if err != nil {
 			// break on error
 			allocationTracking.Fail(RetrieveError.Wrap(err))
@@ -197,5 +325,5 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 	// TODO: handle errors
 	// _ = stream.Close()
 
-	return used, atomic.LoadInt64(&totalAllocated), allocationTracking.Err()
+	return used, atomic.LoadInt64(&allocations.total), allocationTracking.Err()
 }
@@ -0,0 +1,177 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
+)
+
+// NotificationSatelliteUntrusted is sent when the node has data of a
+// satellite, which isn't trusted anymore
+const NotificationSatelliteUntrusted = "satellite_untrusted"
+
+// satelliteCleanupBatch is the number of pieces deleted at once
+const satelliteCleanupBatch = 1000
+
+// ErrorSatelliteCleaner is error class for the satellite cleaner
+var ErrorSatelliteCleaner = errs.Class("piecestore satellite cleaner")
+
+// SatelliteCleaner deletes the pieces, agreements and bandwidth records of
+// satellites, which haven't been trusted for longer than the grace period.
+type SatelliteCleaner struct {
+	log    *zap.Logger
+	server *Server
+
+	interval    time.Duration
+	gracePeriod time.Duration
+}
+
+// NewSatelliteCleaner returns a new satellite cleaner
+func NewSatelliteCleaner(log *zap.Logger, server *Server, interval, gracePeriod time.Duration) *SatelliteCleaner {
+	return &SatelliteCleaner{
+		log:         log,
+		server:      server,
+		interval:    interval,
+		gracePeriod: gracePeriod,
+	}
+}
+
+// Run runs the satellite cleaner at regular intervals
+func (service *SatelliteCleaner) Run(ctx context.Context) error {
+	if service.interval <= 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(service.interval)
+	defer ticker.Stop()
+
+	for {
+		err := service.Clean(ctx, time.Now())
+		if err != nil {
+			service.log.Error("clean", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the satellite cleaner is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// Clean marks the satellites, which aren't trusted anymore, and deletes the
+// data of those, which haven't been trusted for longer than the grace period.
+func (service *SatelliteCleaner) Clean(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	db := service.server.DB
+
+	// satellites which are trusted again keep their data
+	untrusted, err := db.GetUntrusted()
+	if err != nil {
+		return ErrorSatelliteCleaner.Wrap(err)
+	}
+	for satellite := range untrusted {
+		if service.server.isWhitelisted(satellite) {
+			service.log.Info("satellite is trusted again", zap.String("Satellite ID", satellite.String()))
+			if err := db.ClearUntrusted(satellite); err != nil {
+				return ErrorSatelliteCleaner.Wrap(err)
+			}
+		}
+	}
+
+	satellites, err := db.GetSatellites()
+	if err != nil {
+		return ErrorSatelliteCleaner.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, satellite := range satellites {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if service.server.isWhitelisted(satellite) {
+			continue
+		}
+		group.Add(service.MarkUntrusted(ctx, satellite, now))
+	}
+	if err := group.Err(); err != nil {
+		return ErrorSatelliteCleaner.Wrap(err)
+	}
+
+	untrusted, err = db.GetUntrusted()
+	if err != nil {
+		return ErrorSatelliteCleaner.Wrap(err)
+	}
+	for satellite, since := range untrusted {
+		if now.Sub(since) < service.gracePeriod {
+			continue
+		}
+		if err := service.deleteSatelliteData(ctx, satellite); err != nil {
+			return ErrorSatelliteCleaner.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// MarkUntrusted starts the grace period of a satellite, whose data will be
+// deleted afterwards, and notifies the operator about it
+func (service *SatelliteCleaner) MarkUntrusted(ctx context.Context, satellite storj.NodeID, now time.Time) error {
+	since, added, err := service.server.DB.MarkUntrusted(satellite, now)
+	if err != nil || !added {
+		return err
+	}
+
+	return service.server.notify(ctx, psdb.Notification{
+		Type:  NotificationSatelliteUntrusted,
+		Title: "Satellite isn't trusted anymore",
+		Message: fmt.Sprintf("Satellite %s isn't trusted anymore, its pieces and bandwidth records will be deleted after %s.",
+			satellite, since.Add(service.gracePeriod).UTC().Format(time.RFC3339)),
+	})
+}
+
+// deleteSatelliteData deletes the pieces of a satellite in batches and
+// afterwards its agreements and bandwidth records
+func (service *SatelliteCleaner) deleteSatelliteData(ctx context.Context, satellite storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var deleted int64
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		ids, err := service.server.DB.GetSatellitePieces(satellite, satelliteCleanupBatch)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		for _, id := range ids {
+			if err := service.server.deleteByID(id); err != nil {
+				return err
+			}
+		}
+		deleted += int64(len(ids))
+	}
+
+	if err := service.server.DB.DeleteSatelliteRecords(satellite); err != nil {
+		return err
+	}
+
+	mon.Meter("satellite_cleanup_pieces_deleted").Mark64(deleted)
+	service.log.Info("deleted data of untrusted satellite",
+		zap.String("Satellite ID", satellite.String()), zap.Int64("pieces", deleted))
+	return nil
+}
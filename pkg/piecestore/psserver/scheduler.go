@@ -0,0 +1,159 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// RetrievalScheduler limits the number of downloads served at once. Waiting
+// downloads are started by the priority and deadline hints of the clients:
+// interactive before normal before batch downloads and earlier deadlines
+// first. The hints are best-effort, downloads without hints are normal.
+//
+// A nil scheduler doesn't limit downloads.
+type RetrievalScheduler struct {
+	limit int
+
+	mu      sync.Mutex
+	active  int
+	waiting retrievalQueue
+	next    int64
+}
+
+// NewRetrievalScheduler creates a scheduler serving at most limit downloads
+// at once, it returns nil when limit isn't positive
+func NewRetrievalScheduler(limit int) *RetrievalScheduler {
+	if limit <= 0 {
+		return nil
+	}
+	return &RetrievalScheduler{limit: limit}
+}
+
+// retrievalWaiter is a download waiting to be started
+type retrievalWaiter struct {
+	rank     int
+	deadline int64
+	seq      int64
+	index    int
+	ready    chan struct{}
+}
+
+// Acquire waits until the download may be served. The returned release has
+// to be called once the download finishes.
+func (scheduler *RetrievalScheduler) Acquire(ctx context.Context, priority pb.PieceRetrieval_Priority, deadline int64) (release func(), err error) {
+	if scheduler == nil {
+		return func() {}, nil
+	}
+
+	scheduler.mu.Lock()
+	if scheduler.active < scheduler.limit {
+		scheduler.active++
+		scheduler.mu.Unlock()
+		return scheduler.release, nil
+	}
+
+	waiter := &retrievalWaiter{
+		rank:     retrievalRank(priority, deadline, time.Now()),
+		deadline: deadline,
+		seq:      scheduler.next,
+		ready:    make(chan struct{}),
+	}
+	scheduler.next++
+	heap.Push(&scheduler.waiting, waiter)
+	scheduler.mu.Unlock()
+
+	select {
+	case <-waiter.ready:
+		return scheduler.release, nil
+	case <-ctx.Done():
+		scheduler.mu.Lock()
+		defer scheduler.mu.Unlock()
+		select {
+		case <-waiter.ready:
+			// the slot was handed over concurrently, pass it on
+			scheduler.releaseLocked()
+		default:
+			heap.Remove(&scheduler.waiting, waiter.index)
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// release hands the slot of a finished download to the next waiting download
+func (scheduler *RetrievalScheduler) release() {
+	scheduler.mu.Lock()
+	defer scheduler.mu.Unlock()
+	scheduler.releaseLocked()
+}
+
+func (scheduler *RetrievalScheduler) releaseLocked() {
+	if scheduler.waiting.Len() == 0 {
+		scheduler.active--
+		return
+	}
+	waiter := heap.Pop(&scheduler.waiting).(*retrievalWaiter)
+	close(waiter.ready)
+}
+
+// retrievalRank orders the priorities, lower ranks are served first. A
+// download whose deadline has already passed is most likely abandoned by the
+// client, so it's served last.
+func retrievalRank(priority pb.PieceRetrieval_Priority, deadline int64, now time.Time) int {
+	if deadline != 0 && deadline < now.UnixNano() {
+		return 3
+	}
+	switch priority {
+	case pb.PieceRetrieval_INTERACTIVE:
+		return 0
+	case pb.PieceRetrieval_BATCH:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// retrievalQueue is a heap of waiting downloads
+type retrievalQueue []*retrievalWaiter
+
+func (queue retrievalQueue) Len() int { return len(queue) }
+
+func (queue retrievalQueue) Less(i, k int) bool {
+	a, b := queue[i], queue[k]
+	if a.rank != b.rank {
+		return a.rank < b.rank
+	}
+	// downloads with a deadline come before downloads without one
+	if a.deadline != b.deadline {
+		if a.deadline == 0 || b.deadline == 0 {
+			return b.deadline == 0
+		}
+		return a.deadline < b.deadline
+	}
+	return a.seq < b.seq
+}
+
+func (queue retrievalQueue) Swap(i, k int) {
+	queue[i], queue[k] = queue[k], queue[i]
+	queue[i].index = i
+	queue[k].index = k
+}
+
+func (queue *retrievalQueue) Push(x interface{}) {
+	waiter := x.(*retrievalWaiter)
+	waiter.index = len(*queue)
+	*queue = append(*queue, waiter)
+}
+
+func (queue *retrievalQueue) Pop() interface{} {
+	old := *queue
+	waiter := old[len(old)-1]
+	*queue = old[:len(old)-1]
+	return waiter
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/pb"
+)
+
+func TestRetrievalScheduler(t *testing.T) {
+	ctx := context.Background()
+	scheduler := NewRetrievalScheduler(1)
+
+	waiting := func() int {
+		scheduler.mu.Lock()
+		defer scheduler.mu.Unlock()
+		return scheduler.waiting.Len()
+	}
+
+	// the first download is served immediately
+	release, err := scheduler.Acquire(ctx, pb.PieceRetrieval_BATCH, 0)
+	require.NoError(t, err)
+
+	soon := time.Now().Add(time.Hour).UnixNano()
+	later := time.Now().Add(2 * time.Hour).UnixNano()
+	expired := time.Now().Add(-time.Hour).UnixNano()
+
+	requests := []struct {
+		name     string
+		priority pb.PieceRetrieval_Priority
+		deadline int64
+	}{
+		{"expired", pb.PieceRetrieval_INTERACTIVE, expired},
+		{"batch", pb.PieceRetrieval_BATCH, 0},
+		{"normal", pb.PieceRetrieval_NORMAL, 0},
+		{"interactive", pb.PieceRetrieval_INTERACTIVE, 0},
+		{"interactive later", pb.PieceRetrieval_INTERACTIVE, later},
+		{"interactive soon", pb.PieceRetrieval_INTERACTIVE, soon},
+	}
+
+	served := make(chan string, len(requests))
+	for i, request := range requests {
+		go func(name string, priority pb.PieceRetrieval_Priority, deadline int64) {
+			release, err := scheduler.Acquire(ctx, priority, deadline)
+			if err != nil {
+				served <- err.Error()
+				return
+			}
+			served <- name
+			release()
+		}(request.name, request.priority, request.deadline)
+
+		for waiting() != i+1 {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	// a canceled download leaves the queue
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = scheduler.Acquire(canceled, pb.PieceRetrieval_INTERACTIVE, 0)
+	assert.Error(t, err)
+	assert.Equal(t, len(requests), waiting())
+
+	release()
+
+	var order []string
+	for range requests {
+		order = append(order, <-served)
+	}
+	assert.Equal(t, []string{"interactive soon", "interactive later", "interactive", "normal", "batch", "expired"}, order)
+
+	// all slots are free again
+	scheduler.mu.Lock()
+	assert.Equal(t, 0, scheduler.active)
+	scheduler.mu.Unlock()
+
+	// nil scheduler doesn't limit downloads
+	var none *RetrievalScheduler
+	release, err = none.Acquire(ctx, pb.PieceRetrieval_NORMAL, 0)
+	require.NoError(t, err)
+	release()
+	assert.Nil(t, NewRetrievalScheduler(0))
+}
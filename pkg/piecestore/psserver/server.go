@@ -63,9 +63,27 @@ type Server struct {
 	pkey             crypto.PrivateKey
 	totalAllocated   int64 // TODO: use memory.Size
 	totalBwAllocated int64 // TODO: use memory.Size
+	minFreeSpace     int64
+	dedupWindow      time.Duration
 	whitelist        map[storj.NodeID]crypto.PublicKey
+	denylist         *DenyList
 	verifier         auth.SignedMessageVerifier
 	kad              *kademlia.Kademlia
+	shaper           *BandwidthShaper
+	scheduler        *RetrievalScheduler
+	throughput       throughput
+	uploads          resumableUploads
+	reservations     spaceReservations
+
+	notificationWebhook string
+
+	readCacheMode    pstore.CacheMode
+	uncachedReadSize int64
+
+	accessLog *AccessLog
+
+	// Payouts estimates the payouts shown on the dashboard, they are left out when it's nil
+	Payouts *PayoutEstimator
 }
 
 // NewEndpoint creates a new endpoint
@@ -134,6 +152,29 @@ func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *ps
 		}
 	}
 
+	denylist, err := NewDenyList(config.DeniedUplinks, config.DeniedSatellites)
+	if err != nil {
+		return nil, err
+	}
+
+	shaper, err := NewBandwidthShaper(config.SatelliteIngressLimits, config.SatelliteEgressLimits)
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	readCacheMode, err := pstore.ParseCacheMode(config.ReadCacheMode)
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	var accessLog *AccessLog
+	if config.AccessLogPath != "" {
+		accessLog, err = NewAccessLog(config.AccessLogPath, config.AccessLogMaxSize.Int64(), config.AccessLogMaxFiles, config.AccessLogSampleRate)
+		if err != nil {
+			return nil, ServerError.Wrap(err)
+		}
+	}
+
 	return &Server{
 		startTime:        time.Now(),
 		log:              log,
@@ -142,14 +183,36 @@ func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *ps
 		pkey:             pkey,
 		totalAllocated:   allocatedDiskSpace,
 		totalBwAllocated: allocatedBandwidth,
+		minFreeSpace:     config.MinFreeSpace.Int64(),
+		dedupWindow:      config.UploadDedupWindow,
 		whitelist:        whitelist,
+		denylist:         denylist,
 		verifier:         auth.NewSignedMessageVerifier(),
 		kad:              k,
+		shaper:           shaper,
+		scheduler:        NewRetrievalScheduler(config.MaxConcurrentRetrievals),
+
+		notificationWebhook: config.NotificationWebhook,
+
+		readCacheMode:    readCacheMode,
+		uncachedReadSize: config.UncachedReadSize.Int64(),
+
+		accessLog: accessLog,
 	}, nil
 }
 
 // Close stops the server
-func (s *Server) Close() error { return nil }
+func (s *Server) Close() error { return s.accessLog.Close() }
+
+// SetBandwidthLimits replaces the per satellite ingress and egress limits
+func (s *Server) SetBandwidthLimits(ingress, egress string) error {
+	return s.shaper.SetLimits(ingress, egress)
+}
+
+// SetDenyList replaces the denied uplinks and satellites
+func (s *Server) SetDenyList(uplinks, satellites string) error {
+	return s.denylist.SetDenied(uplinks, satellites)
+}
 
 // Stop the piececstore node
 func (s *Server) Stop(ctx context.Context) error {
@@ -198,8 +261,13 @@ func (s *Server) Piece(ctx context.Context, in *pb.PieceId) (*pb.PieceSummary, e
 		return nil, err
 	}
 
+	hash, err := s.DB.GetPieceHash(id)
+	if err != nil {
+		return nil, err
+	}
+
 	s.log.Info("Successfully retrieved meta", zap.String("Piece ID", in.GetId()))
-	return &pb.PieceSummary{Id: in.GetId(), PieceSize: fileInfo.Size(), ExpirationUnixSec: ttl}, nil
+	return &pb.PieceSummary{Id: in.GetId(), PieceSize: fileInfo.Size(), ExpirationUnixSec: ttl, Hash: hash}, nil
 }
 
 // Stats will return statistics about the Server
@@ -259,8 +327,13 @@ func (s *Server) Dashboard(in *pb.DashboardReq, stream pb.PieceStoreRoutes_Dashb
 }
 
 // Delete -- Delete data by Id from piecestore
-func (s *Server) Delete(ctx context.Context, in *pb.PieceDelete) (*pb.PieceDeleteSummary, error) {
+func (s *Server) Delete(ctx context.Context, in *pb.PieceDelete) (_ *pb.PieceDeleteSummary, err error) {
+	defer func() { err = deniedStatus(err) }()
+	started := time.Now()
 	s.log.Debug("Deleting", zap.String("Piece ID", fmt.Sprint(in.GetId())))
+	if err := s.checkUplink(ctx); err != nil {
+		return nil, err
+	}
 	authorization := in.GetAuthorization()
 	if err := s.verifier(authorization); err != nil {
 		return nil, ServerError.Wrap(err)
@@ -269,6 +342,8 @@ func (s *Server) Delete(ctx context.Context, in *pb.PieceDelete) (*pb.PieceDelet
 	if err != nil {
 		return nil, err
 	}
+	defer func() { s.logAccess(ctx, id, "delete", 0, started, err) }()
+
 	if err := s.deleteByID(id); err != nil {
 		return nil, err
 	}
@@ -278,6 +353,13 @@ func (s *Server) Delete(ctx context.Context, in *pb.PieceDelete) (*pb.PieceDelet
 	return &pb.PieceDeleteSummary{Message: OK}, nil
 }
 
+// logAccess writes a request to the access log, when it's enabled
+func (s *Server) logAccess(ctx context.Context, id, action string, bytes int64, started time.Time, err error) {
+	if logErr := s.accessLog.Log(ctx, id, action, bytes, started, err); logErr != nil {
+		s.log.Warn("could not write access log", zap.Error(logErr))
+	}
+}
+
 func (s *Server) deleteByID(id string) error {
 	if err := s.storage.Delete(id); err != nil {
 		return err
@@ -310,6 +392,12 @@ func (s *Server) verifySignature(ctx context.Context, rba *pb.RenterBandwidthAll
 	if exp.Before(time.Now().UTC()) {
 		return pb.ErrPayer.Wrap(auth.ErrExpired.New("%v vs %v", exp, time.Now().UTC()))
 	}
+	if err := s.denylist.CheckUplink(pba.UplinkId); err != nil {
+		return err
+	}
+	if err := s.denylist.CheckSatellite(pba.SatelliteId); err != nil {
+		return err
+	}
 	//verify message crypto
 	if err := auth.VerifyMsg(rba, pba.UplinkId); err != nil {
 		return pb.ErrRenter.Wrap(err)
@@ -336,6 +424,16 @@ func (s *Server) verifyPayerAllocation(pba *pb.PayerBandwidthAllocation, actionP
 	return nil
 }
 
+// checkUplink refuses requests of denied uplinks before anything is read,
+// requests without a peer identity are checked with their bandwidth allocations
+func (s *Server) checkUplink(ctx context.Context) error {
+	pi, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+	return s.denylist.CheckUplink(pi.ID)
+}
+
 //isWhitelisted returns true if a node ID exists in a list of approved node IDs
 func (s *Server) isWhitelisted(id storj.NodeID) bool {
 	if len(s.whitelist) == 0 {
@@ -398,6 +496,29 @@ func (s *Server) getDashboardData(ctx context.Context) (*pb.DashboardStats, erro
 		bsNodes[i] = node.Address.Address
 	}
 
+	notifications, err := s.getNotifications()
+	if err != nil {
+		return &pb.DashboardStats{}, ServerError.Wrap(err)
+	}
+
+	quarantined, err := s.getQuarantined()
+	if err != nil {
+		return &pb.DashboardStats{}, ServerError.Wrap(err)
+	}
+
+	usage, err := s.getUsage(time.Now())
+	if err != nil {
+		return &pb.DashboardStats{}, ServerError.Wrap(err)
+	}
+
+	var payouts []*pb.PayoutEstimate
+	if s.Payouts != nil {
+		payouts, err = s.Payouts.Estimates(time.Now())
+		if err != nil {
+			return &pb.DashboardStats{}, ServerError.Wrap(err)
+		}
+	}
+
 	return &pb.DashboardStats{
 		NodeId:           rt.Local().Id.String(),
 		NodeConnections:  int64(len(nodes)),
@@ -407,5 +528,9 @@ func (s *Server) getDashboardData(ctx context.Context) (*pb.DashboardStats, erro
 		Connection:       true,
 		Uptime:           ptypes.DurationProto(time.Since(s.startTime)),
 		Stats:            statsSummary,
+		Notifications:    notifications,
+		Payouts:          payouts,
+		Quarantined:      quarantined,
+		Usage:            usage,
 	}, nil
 }
@@ -275,6 +275,86 @@ func TestRetrieve(t *testing.T) {
 	}
 }
 
+func TestRetrievePipelined(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	snID, upID := newTestID(ctx, t), newTestID(ctx, t)
+	s, c, cleanup := NewTest(ctx, t, snID, upID, []storj.NodeID{})
+	defer cleanup()
+
+	require.NoError(t, writeFile(s, "11111111111111111111"))
+	defer func() { _ = s.storage.Delete("11111111111111111111") }()
+
+	pba, err := testbwagreement.GeneratePayerBandwidthAllocation(pb.BandwidthAction_GET, snID, upID, time.Hour)
+	require.NoError(t, err)
+
+	stream, err := c.Retrieve(ctx)
+	require.NoError(t, err)
+
+	ranges := []struct {
+		offset, size int64
+		content      string
+	}{
+		{0, 2, "xy"},
+		{3, 2, "wq"},
+		{1, 3, "yzw"},
+	}
+
+	// allocations continue over all ranges of the stream
+	totalAllocated := int64(0)
+	for i, r := range ranges {
+		pd := &pb.PieceRetrieval_PieceData{Offset: r.offset, PieceSize: r.size, Pipelined: true}
+		if i == 0 {
+			pd.Id = "11111111111111111111"
+		}
+		require.NoError(t, stream.Send(&pb.PieceRetrieval{PieceData: pd}))
+
+		totalAllocated += r.size
+		rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, snID.ID, upID, totalAllocated)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&pb.PieceRetrieval{BandwidthAllocation: rba}))
+
+		var data string
+		for {
+			resp, err := stream.Recv()
+			require.NoError(t, err)
+			if resp.GetEndOfRange() {
+				break
+			}
+			data += string(resp.GetContent())
+		}
+		assert.Equal(t, r.content, data)
+	}
+
+	// closing the stream after the last range ends the retrieval
+	require.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+
+	{ // pipelined ranges have to be of the same piece
+		stream, err := c.Retrieve(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, stream.Send(&pb.PieceRetrieval{PieceData: &pb.PieceRetrieval_PieceData{Id: "11111111111111111111", PieceSize: 1, Pipelined: true}}))
+		rba, err := testbwagreement.GenerateRenterBandwidthAllocation(pba, snID.ID, upID, 1)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&pb.PieceRetrieval{BandwidthAllocation: rba}))
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "x", string(resp.GetContent()))
+		resp, err = stream.Recv()
+		require.NoError(t, err)
+		assert.True(t, resp.GetEndOfRange())
+
+		require.NoError(t, stream.Send(&pb.PieceRetrieval{PieceData: &pb.PieceRetrieval_PieceData{Id: "22222222222222222222", PieceSize: 1, Pipelined: true}}))
+		_, err = stream.Recv()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "pipelined ranges have to be of the same piece")
+	}
+}
+
 func TestStore(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -570,6 +650,7 @@ func NewTest(ctx context.Context, t *testing.T, snID, upID *identity.FullIdentit
 		log:              zaptest.NewLogger(t),
 		storage:          storage,
 		DB:               psDB,
+		pkey:             snID.Key,
 		verifier:         verifier,
 		totalAllocated:   math.MaxInt64,
 		totalBwAllocated: math.MaxInt64,
@@ -581,7 +662,7 @@ func NewTest(ctx context.Context, t *testing.T, snID, upID *identity.FullIdentit
 	publicConfig := server.Config{Address: "127.0.0.1:0"}
 	publicOptions, err := server.NewOptions(snID, publicConfig)
 	require.NoError(t, err)
-	grpcServer, err := server.New(publicOptions, listener, nil)
+	grpcServer, err := server.New(publicOptions, listener, nil, nil)
 	require.NoError(t, err)
 	pb.RegisterPieceStoreRoutesServer(grpcServer.GRPC(), psServer)
 	go func() { require.NoError(t, grpcServer.Run(ctx)) }()
@@ -0,0 +1,203 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/sync2"
+	"storj.io/storj/pkg/storj"
+)
+
+// ShaperError is a type of error for failures in BandwidthShaper
+var ShaperError = errs.Class("bandwidth shaper error")
+
+// BandwidthShaper limits the ingress and egress rate of traffic per satellite
+type BandwidthShaper struct {
+	mu      sync.Mutex
+	ingress *rateLimits
+	egress  *rateLimits
+}
+
+// NewBandwidthShaper creates a shaper from comma-separated lists of
+// <satellite id>:<bytes per second> limits, where * sets the limit for
+// satellites which are not listed explicitly.
+func NewBandwidthShaper(ingress, egress string) (*BandwidthShaper, error) {
+	ingressLimits, err := parseRateLimits(ingress)
+	if err != nil {
+		return nil, ShaperError.New("invalid ingress limits: %v", err)
+	}
+	egressLimits, err := parseRateLimits(egress)
+	if err != nil {
+		return nil, ShaperError.New("invalid egress limits: %v", err)
+	}
+	return &BandwidthShaper{
+		ingress: ingressLimits,
+		egress:  egressLimits,
+	}, nil
+}
+
+// SetLimits replaces the ingress and egress limits, in the same format as
+// NewBandwidthShaper. The limits are left unchanged when either is invalid.
+func (shaper *BandwidthShaper) SetLimits(ingress, egress string) error {
+	ingressLimits, err := parseRateLimits(ingress)
+	if err != nil {
+		return ShaperError.New("invalid ingress limits: %v", err)
+	}
+	egressLimits, err := parseRateLimits(egress)
+	if err != nil {
+		return ShaperError.New("invalid egress limits: %v", err)
+	}
+
+	shaper.mu.Lock()
+	defer shaper.mu.Unlock()
+	shaper.ingress = ingressLimits
+	shaper.egress = egressLimits
+	return nil
+}
+
+// WaitIngress blocks until n bytes from satelliteID can be received
+func (shaper *BandwidthShaper) WaitIngress(ctx context.Context, satelliteID storj.NodeID, n int64) error {
+	if shaper == nil {
+		return nil
+	}
+	shaper.mu.Lock()
+	ingress := shaper.ingress
+	shaper.mu.Unlock()
+	return ingress.wait(ctx, satelliteID, n)
+}
+
+// WaitEgress blocks until n bytes for satelliteID can be sent
+func (shaper *BandwidthShaper) WaitEgress(ctx context.Context, satelliteID storj.NodeID, n int64) error {
+	if shaper == nil {
+		return nil
+	}
+	shaper.mu.Lock()
+	egress := shaper.egress
+	shaper.mu.Unlock()
+	return egress.wait(ctx, satelliteID, n)
+}
+
+// rateLimits keeps token buckets for each satellite
+type rateLimits struct {
+	defaultRate int64
+	rates       map[storj.NodeID]int64
+
+	mu      sync.Mutex
+	buckets map[storj.NodeID]*tokenBucket
+}
+
+func parseRateLimits(s string) (*rateLimits, error) {
+	limits := &rateLimits{
+		rates:   make(map[storj.NodeID]int64),
+		buckets: make(map[storj.NodeID]*tokenBucket),
+	}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			return nil, errs.New("expected <satellite id>:<rate>, got %q", entry)
+		}
+
+		var rate memory.Size
+		if err := rate.Set(strings.TrimSpace(parts[1])); err != nil {
+			return nil, err
+		}
+		if rate <= 0 {
+			return nil, errs.New("rate must be positive, got %q", entry)
+		}
+
+		if strings.TrimSpace(parts[0]) == "*" {
+			limits.defaultRate = rate.Int64()
+			continue
+		}
+
+		satelliteID, err := storj.NodeIDFromString(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, err
+		}
+		limits.rates[satelliteID] = rate.Int64()
+	}
+	return limits, nil
+}
+
+func (limits *rateLimits) wait(ctx context.Context, satelliteID storj.NodeID, n int64) error {
+	bucket := limits.bucket(satelliteID)
+	if bucket == nil {
+		return nil
+	}
+	return bucket.wait(ctx, n)
+}
+
+// bucket returns the token bucket for satelliteID or nil when it isn't limited
+func (limits *rateLimits) bucket(satelliteID storj.NodeID) *tokenBucket {
+	limits.mu.Lock()
+	defer limits.mu.Unlock()
+
+	if bucket, ok := limits.buckets[satelliteID]; ok {
+		return bucket
+	}
+
+	rate, ok := limits.rates[satelliteID]
+	if !ok {
+		rate = limits.defaultRate
+	}
+	if rate <= 0 {
+		return nil
+	}
+
+	bucket := newTokenBucket(rate)
+	limits.buckets[satelliteID] = bucket
+	return bucket
+}
+
+// tokenBucket allows bursts of up to one second worth of traffic
+type tokenBucket struct {
+	rate int64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate int64) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		tokens: float64(rate),
+		last:   time.Now(),
+	}
+}
+
+// wait takes n tokens from the bucket and sleeps until the bucket is no longer in debt
+func (bucket *tokenBucket) wait(ctx context.Context, n int64) error {
+	bucket.mu.Lock()
+	now := time.Now()
+	bucket.tokens += now.Sub(bucket.last).Seconds() * float64(bucket.rate)
+	if bucket.tokens > float64(bucket.rate) {
+		bucket.tokens = float64(bucket.rate)
+	}
+	bucket.last = now
+	bucket.tokens -= float64(n)
+
+	var delay time.Duration
+	if bucket.tokens < 0 {
+		delay = time.Duration(-bucket.tokens / float64(bucket.rate) * float64(time.Second))
+	}
+	bucket.mu.Unlock()
+
+	if delay > 0 && !sync2.Sleep(ctx, delay) {
+		return ctx.Err()
+	}
+	return nil
+}
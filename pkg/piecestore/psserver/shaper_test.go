@@ -0,0 +1,81 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/storj"
+)
+
+func TestBandwidthShaperParse(t *testing.T) {
+	satelliteID := storj.NodeID{1}
+
+	for _, tt := range []struct {
+		limits string
+		valid  bool
+	}{
+		{"", true},
+		{"*:1MB", true},
+		{satelliteID.String() + ":1MiB, *:10MB", true},
+		{"*", false},
+		{"*:0", false},
+		{"*:abc", false},
+		{"notanid:1MB", false},
+	} {
+		_, err := NewBandwidthShaper(tt.limits, tt.limits)
+		if tt.valid {
+			assert.NoError(t, err, tt.limits)
+		} else {
+			assert.Error(t, err, tt.limits)
+		}
+	}
+}
+
+func TestBandwidthShaperLimits(t *testing.T) {
+	limited := storj.NodeID{1}
+	unlimited := storj.NodeID{2}
+
+	shaper, err := NewBandwidthShaper("", limited.String()+":1KB")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// ingress isn't limited at all
+	start := time.Now()
+	require.NoError(t, shaper.WaitIngress(ctx, limited, 10000))
+	assert.True(t, time.Since(start) < 500*time.Millisecond)
+
+	// egress of an unlisted satellite isn't limited
+	start = time.Now()
+	require.NoError(t, shaper.WaitEgress(ctx, unlimited, 10000))
+	assert.True(t, time.Since(start) < 500*time.Millisecond)
+
+	// the first second is available as a burst, the rest has to wait
+	start = time.Now()
+	require.NoError(t, shaper.WaitEgress(ctx, limited, 1000))
+	require.NoError(t, shaper.WaitEgress(ctx, limited, 500))
+	assert.True(t, time.Since(start) >= 400*time.Millisecond)
+
+	// waiting is cancelled with the context
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Error(t, shaper.WaitEgress(canceled, limited, 100000))
+
+	// removing the limits takes effect immediately
+	require.NoError(t, shaper.SetLimits("", ""))
+	start = time.Now()
+	require.NoError(t, shaper.WaitEgress(ctx, limited, 100000))
+	assert.True(t, time.Since(start) < 500*time.Millisecond)
+	assert.Error(t, shaper.SetLimits("", "*"))
+
+	// nil shaper doesn't limit anything
+	var none *BandwidthShaper
+	assert.NoError(t, none.WaitEgress(ctx, storj.NodeID{}, 100000))
+}
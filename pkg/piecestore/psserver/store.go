@@ -5,6 +5,7 @@ package psserver
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"time"
@@ -26,6 +27,17 @@ var StoreError = errs.Class("store error")
 func (s *Server) Store(reqStream pb.PieceStoreRoutes_StoreServer) (err error) {
 	ctx := reqStream.Context()
 	defer mon.Task()(&ctx)(&err)
+	defer func() { err = spaceStatus(deniedStatus(err)) }()
+	started := time.Now()
+	if err := s.checkUplink(ctx); err != nil {
+		return err
+	}
+	reservation, err := s.reserveSpace()
+	if err != nil {
+		return err
+	}
+	defer reservation.release()
+
 	// Receive id/ttl
 	recv, err := reqStream.Recv()
 	if err != nil {
@@ -55,7 +67,26 @@ func (s *Server) Store(reqStream pb.PieceStoreRoutes_StoreServer) (err error) {
 	if err != nil {
 		return err
 	}
-	total, err := s.storeData(ctx, reqStream, id)
+	var total int64
+	defer func() { s.logAccess(ctx, id, "store", total, started, err) }()
+
+	// a retried upload of a piece, which was stored completely, isn't
+	// transferred again
+	size, duplicate, err := s.duplicateUpload(id, pd.GetHash())
+	if err != nil {
+		return StoreError.Wrap(err)
+	}
+	if duplicate {
+		s.log.Debug("Skipping duplicate upload", zap.String("Piece ID", fmt.Sprint(pd.GetId())))
+		receipt, err := s.storeReceipt(pd.GetId(), pd.GetHash(), size)
+		if err != nil {
+			return StoreError.Wrap(err)
+		}
+		return reqStream.SendAndClose(&pb.PieceStoreSummary{Message: OK, Duplicate: true, Receipt: receipt})
+	}
+
+	total, hash, err := s.storeData(ctx, reqStream, id, reservation)
+	s.throughput.upload(total, err)
 	if err != nil {
 		return err
 	}
@@ -65,15 +96,26 @@ func (s *Server) Store(reqStream pb.PieceStoreRoutes_StoreServer) (err error) {
 		return StoreError.New("failed to write piece meta data to database: %v", utils.CombineErrors(err, deleteErr))
 	}
 
+	// the satellite only knows the piece id sent by the uplink, which is
+	// needed to check the piece against the filters of retain requests
+	if err = s.DB.AddPieceID(id, pd.GetId()); err != nil {
+		deleteErr := s.deleteByID(id)
+		return StoreError.New("failed to write piece id to database: %v", utils.CombineErrors(err, deleteErr))
+	}
+
 	if err = s.DB.AddBandwidthUsed(total); err != nil {
 		return StoreError.New("failed to write bandwidth info to database: %v", err)
 	}
 	s.log.Info("Successfully stored", zap.String("Piece ID", fmt.Sprint(pd.GetId())))
 
-	return reqStream.SendAndClose(&pb.PieceStoreSummary{Message: OK, TotalReceived: total})
+	receipt, err := s.storeReceipt(pd.GetId(), hash, total)
+	if err != nil {
+		return StoreError.Wrap(err)
+	}
+	return reqStream.SendAndClose(&pb.PieceStoreSummary{Message: OK, TotalReceived: total, Receipt: receipt})
 }
 
-func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_StoreServer, id string) (total int64, err error) {
+func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_StoreServer, id string, reservation *spaceReservation) (total int64, hash []byte, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	// Delete data if we error
@@ -88,7 +130,7 @@ func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_Store
 	// Initialize file for storing data
 	storeFile, err := s.storage.Writer(id)
 	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 
 	defer func() {
@@ -97,23 +139,33 @@ func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_Store
 
 	bwUsed, err := s.DB.GetTotalBandwidthBetween(getBeginningOfMonth(), time.Now())
 	if err != nil {
-		return 0, err
-	}
-	spaceUsed, err := s.DB.SumTTLSizes()
-	if err != nil {
-		return 0, err
+		return 0, nil, err
 	}
 	bwLeft := s.totalBwAllocated - bwUsed
-	spaceLeft := s.totalAllocated - spaceUsed
-	reader := NewStreamReader(s, stream, bwLeft, spaceLeft)
+	reader := NewStreamReader(s, stream, bwLeft, reservation)
 
-	total, err = io.Copy(storeFile, reader)
+	hasher := sha256.New()
+	total, err = io.Copy(io.MultiWriter(storeFile, hasher), reader)
 
 	if err != nil && err != io.EOF {
-		return 0, err
+		return 0, nil, err
 	}
 
 	err = s.DB.WriteBandwidthAllocToDB(reader.bandwidthAllocation)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// remember the satellite of the piece, so its data can be cleaned up
+	// once the satellite isn't trusted anymore
+	err = s.DB.AddSatellitePiece(id, reader.bandwidthAllocation.PayerAllocation.SatelliteId)
+	if err != nil {
+		return total, nil, err
+	}
+
+	// uplinks verify the downloads of the whole piece with the hash
+	hash = hasher.Sum(nil)
+	err = s.DB.AddPieceHash(id, hash)
 
-	return total, err
+	return total, hash, err
 }
@@ -0,0 +1,130 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+// NotificationTallyDiscrepancy is sent when a satellite recorded different
+// bandwidth totals than the node
+const NotificationTallyDiscrepancy = "tally_discrepancy"
+
+// tallyTolerance is the relative difference between the bandwidth totals of
+// a satellite and the node, which isn't reported as a discrepancy
+const tallyTolerance = 0.05
+
+// TallyDiscrepancy is a difference between the bandwidth a satellite
+// recorded for the node and the bandwidth recorded by the node itself
+type TallyDiscrepancy struct {
+	Action    pb.BandwidthAction
+	Satellite int64
+	Node      int64
+}
+
+// String returns a description of the discrepancy
+func (discrepancy TallyDiscrepancy) String() string {
+	return fmt.Sprintf("%s: satellite %s, node %s", discrepancy.Action,
+		memory.Size(discrepancy.Satellite).Base10String(),
+		memory.Size(discrepancy.Node).Base10String())
+}
+
+// CompareTally compares the bandwidth totals of a satellite tally with the
+// bandwidth the node recorded for the satellite, differences within the
+// tolerance are ignored
+func CompareTally(tally *pb.NodeTally, own map[pb.BandwidthAction]int64) []TallyDiscrepancy {
+	recorded := map[pb.BandwidthAction]int64{
+		pb.BandwidthAction_PUT:        tally.PutTotal,
+		pb.BandwidthAction_GET:        tally.GetTotal,
+		pb.BandwidthAction_GET_AUDIT:  tally.GetAuditTotal,
+		pb.BandwidthAction_GET_REPAIR: tally.GetRepairTotal,
+		pb.BandwidthAction_PUT_REPAIR: tally.PutRepairTotal,
+	}
+
+	var discrepancies []TallyDiscrepancy
+	for action := pb.BandwidthAction_PUT; action <= pb.BandwidthAction_PUT_REPAIR; action++ {
+		satellite, node := recorded[action], own[action]
+
+		larger := satellite
+		if node > larger {
+			larger = node
+		}
+		difference := satellite - node
+		if difference < 0 {
+			difference = -difference
+		}
+		if float64(difference) <= tallyTolerance*float64(larger) {
+			continue
+		}
+
+		discrepancies = append(discrepancies, TallyDiscrepancy{
+			Action:    action,
+			Satellite: satellite,
+			Node:      node,
+		})
+	}
+	return discrepancies
+}
+
+// Tally stores the signed summary of what a satellite recorded for this node
+// and notifies the operator when it doesn't match the node's own records
+func (s *Server) Tally(ctx context.Context, tally *pb.NodeTally) (_ *pb.NodeTallyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pi, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+	if pi.ID != tally.SatelliteId {
+		return nil, ServerError.New("tally of satellite %s sent by %s", tally.SatelliteId, pi.ID)
+	}
+	if !s.isWhitelisted(tally.SatelliteId) {
+		return nil, ServerError.New("satellite %s isn't whitelisted", tally.SatelliteId)
+	}
+	if err := auth.VerifyMsg(tally, tally.SatelliteId); err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	if err := s.DB.SaveNodeTally(tally); err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	start, end := time.Unix(tally.PeriodStartUnixSec, 0), time.Unix(tally.PeriodEndUnixSec, 0)
+	own, err := s.DB.GetSatelliteBandwidth(tally.SatelliteId, start, end)
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	discrepancies := CompareTally(tally, own)
+	if len(discrepancies) > 0 {
+		var descriptions []string
+		for _, discrepancy := range discrepancies {
+			descriptions = append(descriptions, discrepancy.String())
+		}
+
+		err := s.notify(ctx, psdb.Notification{
+			Type:  NotificationTallyDiscrepancy,
+			Title: "Satellite recorded different bandwidth",
+			Message: fmt.Sprintf("Satellite %s recorded different bandwidth from %s to %s than this node: %s.",
+				tally.SatelliteId, start.UTC().Format("2006-01-02"), end.UTC().Format("2006-01-02"),
+				strings.Join(descriptions, "; ")),
+		})
+		if err != nil {
+			s.log.Error("failed to notify about tally discrepancy", zap.Error(err))
+		}
+	}
+
+	return &pb.NodeTallyResponse{}, nil
+}
@@ -0,0 +1,32 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/pkg/pb"
+)
+
+func TestCompareTally(t *testing.T) {
+	tally := &pb.NodeTally{
+		PutTotal:      1000,
+		GetTotal:      1000,
+		GetAuditTotal: 10,
+	}
+	own := map[pb.BandwidthAction]int64{
+		pb.BandwidthAction_PUT:        1000,
+		pb.BandwidthAction_GET:        980,
+		pb.BandwidthAction_PUT_REPAIR: 500,
+	}
+
+	assert.Equal(t, []TallyDiscrepancy{
+		{Action: pb.BandwidthAction_GET_AUDIT, Satellite: 10, Node: 0},
+		{Action: pb.BandwidthAction_PUT_REPAIR, Satellite: 0, Node: 500},
+	}, CompareTally(tally, own))
+
+	assert.Empty(t, CompareTally(&pb.NodeTally{}, nil))
+}
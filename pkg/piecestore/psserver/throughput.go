@@ -0,0 +1,98 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/pb"
+)
+
+const (
+	// throughputWindow is how far back the reported throughput goes
+	throughputWindow = time.Hour
+	// throughputBuckets is the number of buckets the window is split into
+	throughputBuckets = 60
+)
+
+// throughputBucket contains the transfers which started in a part of the window
+type throughputBucket struct {
+	start time.Time
+
+	ingress         int64
+	egress          int64
+	uploads         int64
+	failedUploads   int64
+	downloads       int64
+	failedDownloads int64
+}
+
+// throughput keeps track of the recent transfers of the storage node
+type throughput struct {
+	mu      sync.Mutex
+	buckets [throughputBuckets]throughputBucket
+}
+
+// upload records an upload of size bytes
+func (tp *throughput) upload(size int64, err error) {
+	tp.record(time.Now(), func(bucket *throughputBucket) {
+		bucket.ingress += size
+		bucket.uploads++
+		if err != nil {
+			bucket.failedUploads++
+		}
+	})
+}
+
+// download records a download of size bytes
+func (tp *throughput) download(size int64, err error) {
+	tp.record(time.Now(), func(bucket *throughputBucket) {
+		bucket.egress += size
+		bucket.downloads++
+		if err != nil {
+			bucket.failedDownloads++
+		}
+	})
+}
+
+func (tp *throughput) record(now time.Time, update func(bucket *throughputBucket)) {
+	const bucketSize = throughputWindow / throughputBuckets
+	start := now.Truncate(bucketSize)
+
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	bucket := &tp.buckets[(start.UnixNano()/int64(bucketSize))%throughputBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = throughputBucket{start: start}
+	}
+	update(bucket)
+}
+
+// summary sums the transfers of the window ending at now
+func (tp *throughput) summary(now time.Time) *pb.ThroughputSummary {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	summary := &pb.ThroughputSummary{WindowSeconds: int64(throughputWindow / time.Second)}
+	for _, bucket := range tp.buckets {
+		if !bucket.start.After(now.Add(-throughputWindow)) {
+			continue
+		}
+		summary.IngressBytes += bucket.ingress
+		summary.EgressBytes += bucket.egress
+		summary.Uploads += bucket.uploads
+		summary.FailedUploads += bucket.failedUploads
+		summary.Downloads += bucket.downloads
+		summary.FailedDownloads += bucket.failedDownloads
+	}
+	return summary
+}
+
+// Throughput returns the transfers of the storage node over the last hour
+func (s *Server) Throughput(ctx context.Context, in *pb.ThroughputReq) (*pb.ThroughputSummary, error) {
+	return s.throughput.summary(time.Now()), nil
+}
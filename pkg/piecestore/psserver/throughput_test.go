@@ -0,0 +1,47 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThroughputWindow(t *testing.T) {
+	var tp throughput
+	now := time.Date(2019, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	upload := func(size int64, failed bool) func(bucket *throughputBucket) {
+		return func(bucket *throughputBucket) {
+			bucket.ingress += size
+			bucket.uploads++
+			if failed {
+				bucket.failedUploads++
+			}
+		}
+	}
+
+	tp.record(now.Add(-2*throughputWindow), upload(1000, false))
+	tp.record(now.Add(-30*time.Minute), upload(100, false))
+	tp.record(now.Add(-time.Minute), upload(10, true))
+	tp.record(now, func(bucket *throughputBucket) {
+		bucket.egress += 50
+		bucket.downloads++
+	})
+
+	summary := tp.summary(now)
+	assert.Equal(t, int64(throughputWindow/time.Second), summary.WindowSeconds)
+	assert.Equal(t, int64(110), summary.IngressBytes)
+	assert.Equal(t, int64(2), summary.Uploads)
+	assert.Equal(t, int64(1), summary.FailedUploads)
+	assert.Equal(t, int64(50), summary.EgressBytes)
+	assert.Equal(t, int64(1), summary.Downloads)
+	assert.Equal(t, int64(0), summary.FailedDownloads)
+
+	// transfers leave the window as time passes
+	summary = tp.summary(now.Add(45 * time.Minute))
+	assert.Equal(t, int64(10), summary.IngressBytes)
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+)
+
+// ErrorUsage is error class for the usage recalculation
+var ErrorUsage = errs.Class("piecestore usage")
+
+// UsageRecalculator recalculates the tracked disk usage of the satellites
+// from the piece records, fixing drift e.g. from pieces recorded by
+// earlier versions or from interrupted writes
+type UsageRecalculator struct {
+	log      *zap.Logger
+	db       *psdb.DB
+	interval time.Duration
+}
+
+// NewUsageRecalculator returns a new usage recalculator
+func NewUsageRecalculator(log *zap.Logger, db *psdb.DB, interval time.Duration) *UsageRecalculator {
+	return &UsageRecalculator{
+		log:      log,
+		db:       db,
+		interval: interval,
+	}
+}
+
+// Run recalculates the usage at startup and at regular intervals
+func (service *UsageRecalculator) Run(ctx context.Context) error {
+	if service.interval <= 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(service.interval)
+	defer ticker.Stop()
+
+	for {
+		err := service.Recalculate(ctx)
+		if err != nil {
+			service.log.Error("recalculate", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the usage recalculator is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// Recalculate recalculates the usage of the satellites
+func (service *UsageRecalculator) Recalculate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	usedDrift, piecesDrift, err := service.db.RecalculateUsage()
+	if err != nil {
+		return ErrorUsage.Wrap(err)
+	}
+
+	mon.IntVal("usage_drift_bytes").Observe(usedDrift)
+	if usedDrift != 0 || piecesDrift != 0 {
+		service.log.Info("corrected drifted usage", zap.Int64("bytes", usedDrift), zap.Int64("pieces", piecesDrift))
+	}
+	return nil
+}
+
+// getUsage returns the disk usage of the satellites and their bandwidth of
+// the current month
+func (s *Server) getUsage(now time.Time) ([]*pb.SatelliteUsage, error) {
+	usage, err := s.DB.GetSatelliteUsage()
+	if err != nil {
+		return nil, err
+	}
+
+	monthStart, monthEnd := monthBounds(now)
+	satellites := make([]*pb.SatelliteUsage, 0, len(usage))
+	for _, satellite := range usage {
+		bandwidth, err := s.DB.GetSatelliteBandwidth(satellite.Satellite, monthStart, monthEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		satellites = append(satellites, &pb.SatelliteUsage{
+			SatelliteId:        satellite.Satellite,
+			UsedSpace:          satellite.Used,
+			Pieces:             satellite.Pieces,
+			PutBandwidth:       bandwidth[pb.BandwidthAction_PUT],
+			GetBandwidth:       bandwidth[pb.BandwidthAction_GET],
+			GetAuditBandwidth:  bandwidth[pb.BandwidthAction_GET_AUDIT],
+			GetRepairBandwidth: bandwidth[pb.BandwidthAction_GET_REPAIR],
+			PutRepairBandwidth: bandwidth[pb.BandwidthAction_PUT_REPAIR],
+		})
+	}
+	return satellites, nil
+}
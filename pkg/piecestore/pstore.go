@@ -6,6 +6,7 @@ package pstore
 import (
 	"context"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -25,6 +26,9 @@ func NewStorage(dir string) *Storage {
 	return &Storage{dir}
 }
 
+// Dir returns the directory, which the pieces are stored in
+func (storage *Storage) Dir() string { return storage.dir }
+
 // Close closes resources
 func (storage *Storage) Close() error { return nil }
 
@@ -45,6 +49,31 @@ func (storage *Storage) Info() (DiskInfo, error) {
 	}, nil
 }
 
+// Check verifies that pieces can be written to the disk and its free space
+// can be determined
+func (storage *Storage) Check(ctx context.Context) error {
+	if err := os.MkdirAll(storage.dir, 0700); err != nil {
+		return MkDir.Wrap(err)
+	}
+
+	// the name is shorter than piece ids, so it can't collide with a piece
+	file, err := ioutil.TempFile(storage.dir, "check-")
+	if err != nil {
+		return Open.Wrap(err)
+	}
+	_, err = file.Write([]byte{1})
+	if err == nil {
+		err = file.Sync()
+	}
+	err = errs.Combine(err, file.Close(), os.Remove(file.Name()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = storage.Info()
+	return Error.Wrap(err)
+}
+
 // IDLength -- Minimum ID length
 const IDLength = 20
 
@@ -82,16 +111,30 @@ func (storage *Storage) Writer(pieceID string) (io.WriteCloser, error) {
 
 // Reader returns a reader for the specified piece at the location
 func (storage *Storage) Reader(ctx context.Context, pieceID string, offset int64, length int64) (io.ReadCloser, error) {
-	path, err := storage.PiecePath(pieceID)
+	path, offset, length, err := storage.pieceRange(pieceID, offset, length)
 	if err != nil {
 		return nil, err
 	}
-	info, err := os.Stat(path)
+	rr, err := ranger.FileRanger(path)
 	if err != nil {
 		return nil, err
 	}
+	return rr.Range(ctx, offset, length)
+}
+
+// pieceRange returns the path of the piece and the range to read, which is
+// limited to the size of the piece
+func (storage *Storage) pieceRange(pieceID string, offset int64, length int64) (string, int64, int64, error) {
+	path, err := storage.PiecePath(pieceID)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", 0, 0, err
+	}
 	if offset >= info.Size() || offset < 0 {
-		return nil, Error.New("invalid offset: %v", offset)
+		return "", 0, 0, Error.New("invalid offset: %v", offset)
 	}
 	if length <= -1 {
 		length = info.Size()
@@ -100,11 +143,7 @@ func (storage *Storage) Reader(ctx context.Context, pieceID string, offset int64
 	if info.Size() < offset+length {
 		length = info.Size() - offset
 	}
-	rr, err := ranger.FileRanger(path)
-	if err != nil {
-		return nil, err
-	}
-	return rr.Range(ctx, offset, length)
+	return path, offset, length, nil
 }
 
 // Delete deletes piece from storage
@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -79,3 +80,149 @@ func TestStore(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestReaderMode(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := NewStorage(ctx.Dir("example"))
+	defer ctx.Check(store.Close)
+
+	pieceID := strings.Repeat("AB01", 10)
+
+	source := make([]byte, 3*directBufferSize+100)
+	_, _ = rand.Read(source[:])
+
+	w, err := store.Writer(pieceID)
+	require.NoError(t, err)
+	_, err = w.Write(source)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	for _, mode := range []CacheMode{CacheNormal, CacheDontNeed, CacheDirect} {
+		read := func(offset, length int64) []byte {
+			reader, err := store.ReaderMode(ctx, pieceID, offset, length, mode)
+			if assert.NoError(t, err, mode) {
+				data, err := ioutil.ReadAll(reader)
+				assert.NoError(t, err, mode)
+				assert.NoError(t, reader.Close(), mode)
+				return data
+			}
+			return nil
+		}
+
+		assert.Equal(t, source, read(0, -1), mode)
+		assert.Equal(t, source[10:1010], read(10, 1000), mode)
+		assert.Equal(t, source[directAlignment+1:], read(directAlignment+1, -1), mode)
+		assert.Equal(t, source[directBufferSize-10:directBufferSize+10], read(directBufferSize-10, 20), mode)
+	}
+
+	_, err = ParseCacheMode("invalid")
+	assert.Error(t, err)
+	mode, err := ParseCacheMode("")
+	assert.NoError(t, err)
+	assert.Equal(t, CacheNormal, mode)
+}
+
+func TestCheck(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := NewStorage(ctx.Dir("example"))
+	defer ctx.Check(store.Close)
+
+	require.NoError(t, store.Check(ctx))
+
+	// the check doesn't leave anything behind
+	files, err := ioutil.ReadDir(ctx.Dir("example"))
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestPartialWriter(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := NewStorage(ctx.Dir("example"))
+	defer ctx.Check(store.Close)
+
+	pieceID := strings.Repeat("AB01", 10)
+
+	{ // the partial piece is kept when it's closed
+		w, err := store.PartialWriter(pieceID)
+		require.NoError(t, err)
+		_, err = w.Write([]byte("xyzwq"))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		_, exists, err := store.HasPiece(pieceID)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	}
+
+	{ // the partial piece is continued and committed
+		w, err := store.PartialWriter(pieceID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), w.Size())
+		_, err = w.Write([]byte("abc"))
+		require.NoError(t, err)
+		require.NoError(t, w.Commit())
+		require.NoError(t, w.Close())
+
+		size, exists, err := store.HasPiece(pieceID)
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assert.Equal(t, int64(8), size)
+	}
+
+	{ // stale partial pieces are deleted
+		otherID := strings.Repeat("CD23", 10)
+		w, err := store.PartialWriter(otherID)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		deleted, err := store.DeleteStalePartials(time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, deleted)
+
+		deleted, err = store.DeleteStalePartials(time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+
+		_, exists, err := store.HasPiece(pieceID)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	}
+}
+
+func TestTrash(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store := NewStorage(ctx.Dir("example"))
+	defer ctx.Check(store.Close)
+
+	pieceID := strings.Repeat("AB01", 10)
+	w, err := store.Writer(pieceID)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("xyzwq"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	require.NoError(t, store.Trash(pieceID))
+	_, exists, err := store.HasPiece(pieceID)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// trashing a missing piece is a no-op
+	require.NoError(t, store.Trash(pieceID))
+
+	// recently trashed pieces are kept
+	deleted, err := store.EmptyTrash(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+
+	deleted, err = store.EmptyTrash(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pstore
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// trashDir is the directory in the storage directory, which garbage collected
+// pieces are moved to
+const trashDir = "trash"
+
+// trashPath returns the path of a trashed piece
+func (storage *Storage) trashPath(pieceID string) (string, error) {
+	path, err := storage.PiecePath(pieceID)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(storage.dir, path)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return filepath.Join(storage.dir, trashDir, rel), nil
+}
+
+// Trash moves a piece to the trash, where it's kept until the trash is emptied
+func (storage *Storage) Trash(pieceID string) error {
+	path, err := storage.PiecePath(pieceID)
+	if err != nil {
+		return err
+	}
+	trashPath, err := storage.trashPath(pieceID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(trashPath), 0700); err != nil {
+		return MkDir.Wrap(err)
+	}
+
+	err = os.Rename(path, trashPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	// the modification time is when the piece was trashed
+	now := time.Now()
+	return Error.Wrap(os.Chtimes(trashPath, now, now))
+}
+
+// EmptyTrash deletes the pieces, which were trashed before before, and
+// returns how many were deleted
+func (storage *Storage) EmptyTrash(before time.Time) (deleted int, err error) {
+	err = filepath.Walk(filepath.Join(storage.dir, trashDir), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || !info.ModTime().Before(before) {
+			return nil
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		deleted++
+		return nil
+	})
+	return deleted, Error.Wrap(err)
+}
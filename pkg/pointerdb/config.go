@@ -4,6 +4,8 @@
 package pointerdb
 
 import (
+	"time"
+
 	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/utils"
 	"storj.io/storj/storage"
@@ -19,11 +21,14 @@ const (
 // Config is a configuration struct that is everything you need to start a
 // PointerDB responsibility
 type Config struct {
-	DatabaseURL          string      `help:"the database connection string to use" default:"bolt://$CONFDIR/pointerdb.db"`
-	MinRemoteSegmentSize memory.Size `default:"1240" help:"minimum remote segment size"`
-	MaxInlineSegmentSize memory.Size `default:"8000" help:"maximum inline segment size"`
-	Overlay              bool        `default:"true" help:"toggle flag if overlay is enabled"`
-	BwExpiration         int         `default:"45"   help:"lifespan of bandwidth agreements in days"`
+	DatabaseURL          string        `help:"the database connection string to use" default:"bolt://$CONFDIR/pointerdb.db"`
+	MinRemoteSegmentSize memory.Size   `default:"1240" help:"minimum remote segment size"`
+	MaxInlineSegmentSize memory.Size   `default:"8000" help:"maximum inline segment size"`
+	Overlay              bool          `default:"true" help:"toggle flag if overlay is enabled"`
+	BwExpiration         int           `default:"45"   help:"lifespan of bandwidth agreements in days"`
+	DistinctIP           bool          `default:"false" help:"reject segments placing several pieces on nodes in the same /24 network"`
+	ObjectLimits         string        `default:"" help:"a comma-separated list of per project object limits formatted as <project id>:<count>, * applies to unlisted projects, 0 is unlimited"`
+	ObjectCountRefresh   time.Duration `default:"1h0m0s" help:"how often the object counts of projects are recounted from the pointers in the background"`
 }
 
 // NewStore returns database for storing pointer data
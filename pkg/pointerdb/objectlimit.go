@@ -0,0 +1,210 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/storage"
+)
+
+// ErrObjectLimit is returned when committing an object would exceed the object limit of its project
+var ErrObjectLimit = errs.Class("project object limit exceeded")
+
+// ObjectLimits limits the number of objects of projects. The objects of all
+// projects are counted from the pointers by a chore, so that commits never
+// wait for a count, and are tracked on commits and deletes in between. The
+// recounts correct objects removed otherwise, e.g. by expiration or purging.
+// Projects aren't limited until the objects have been counted once.
+type ObjectLimits struct {
+	service  *Service
+	limits   map[uuid.UUID]int64
+	fallback int64
+
+	mu      sync.Mutex
+	counted bool
+	counts  map[uuid.UUID]int64
+
+	Chore *chore.Chore
+}
+
+// NewObjectLimits creates object limits from a comma-separated list of
+// <project id>:<count>, * applies to unlisted projects and 0 is unlimited.
+// The objects are recounted every refresh interval.
+func NewObjectLimits(log *zap.Logger, service *Service, limits string, refresh time.Duration) (*ObjectLimits, error) {
+	objectLimits := &ObjectLimits{
+		service: service,
+		limits:  map[uuid.UUID]int64{},
+		counts:  map[uuid.UUID]int64{},
+	}
+	objectLimits.Chore = chore.New(log, "pointerdb:objects", refresh, objectLimits.Recount)
+
+	for _, entry := range strings.Split(limits, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			return nil, Error.New("expected <project id>:<count>, got %q", entry)
+		}
+
+		count, err := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+		if err != nil || count < 0 {
+			return nil, Error.New("invalid object count in %q", entry)
+		}
+
+		if strings.TrimSpace(parts[0]) == "*" {
+			objectLimits.fallback = count
+			continue
+		}
+
+		projectID, err := uuid.Parse(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, Error.New("invalid project id in %q: %v", entry, err)
+		}
+		objectLimits.limits[*projectID] = count
+	}
+	return objectLimits, nil
+}
+
+// Limit returns the maximum number of objects of a project, 0 is unlimited
+func (limits *ObjectLimits) Limit(projectID uuid.UUID) int64 {
+	if limit, ok := limits.limits[projectID]; ok {
+		return limit
+	}
+	return limits.fallback
+}
+
+// Run recounts the objects of the projects every refresh interval
+func (limits *ObjectLimits) Run(ctx context.Context) error {
+	return limits.Chore.Run(ctx)
+}
+
+// Recount counts the objects of all projects from the pointers. Commits and
+// deletes during the recount may be missed until the next one.
+func (limits *ObjectLimits) Recount(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	counts, err := limits.service.ObjectCounts()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	limits.mu.Lock()
+	defer limits.mu.Unlock()
+	limits.counts = counts
+	limits.counted = true
+	return nil
+}
+
+// Count returns the number of objects of a project, false when the objects
+// haven't been counted yet
+func (limits *ObjectLimits) Count(projectID uuid.UUID) (int64, bool) {
+	limits.mu.Lock()
+	defer limits.mu.Unlock()
+	return limits.counts[projectID], limits.counted
+}
+
+// Reserve counts a new object of a project, unless the project already
+// holds its limit of objects
+func (limits *ObjectLimits) Reserve(projectID uuid.UUID) error {
+	limit := limits.Limit(projectID)
+	if limit <= 0 {
+		return nil
+	}
+
+	limits.mu.Lock()
+	defer limits.mu.Unlock()
+	if !limits.counted {
+		return nil
+	}
+
+	objects := limits.counts[projectID]
+	if objects >= limit {
+		return ErrObjectLimit.New("project %s holds %d of %d objects", projectID, objects, limit)
+	}
+	limits.counts[projectID] = objects + 1
+	return nil
+}
+
+// Release uncounts removed objects of a project
+func (limits *ObjectLimits) Release(projectID uuid.UUID, objects int64) {
+	limits.mu.Lock()
+	defer limits.mu.Unlock()
+
+	if count, ok := limits.counts[projectID]; ok {
+		count -= objects
+		if count < 0 {
+			count = 0
+		}
+		limits.counts[projectID] = count
+	}
+}
+
+// ObjectCounts returns the number of objects of every project
+func (s *Service) ObjectCounts() (counts map[uuid.UUID]int64, err error) {
+	counts = map[uuid.UUID]int64{}
+	err = s.DB.Iterate(storage.IterateOptions{Recurse: true},
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				// the paths of objects are <project id>/l/<bucket>/<path>
+				parts := strings.SplitN(item.Key.String(), "/", 3)
+				if len(parts) < 3 || parts[1] != "l" {
+					continue
+				}
+				projectID, err := uuid.Parse(parts[0])
+				if err != nil {
+					continue
+				}
+				counts[*projectID]++
+			}
+			return nil
+		})
+	return counts, err
+}
+
+// reserveObject counts a committed segment against the object limit of the
+// project, when it's the last segment of a new object. The returned func
+// releases the reservation, when the segment isn't committed after all.
+func (s *Server) reserveObject(ctx context.Context, projectID uuid.UUID, path string) (release func(), err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	release = func() {}
+	if s.Objects == nil || !strings.HasPrefix(path, "l/") {
+		return release, nil
+	}
+
+	// overwriting an object doesn't add one
+	_, err = s.service.Get(projectID.String() + "/" + path)
+	switch {
+	case err == nil:
+		return release, nil
+	case !storage.ErrKeyNotFound.Has(err):
+		return release, Error.Wrap(err)
+	}
+
+	if err := s.Objects.Reserve(projectID); err != nil {
+		return release, err
+	}
+	return func() { s.Objects.Release(projectID, 1) }, nil
+}
+
+// releaseObjects uncounts deleted objects of a project
+func (s *Server) releaseObjects(projectID uuid.UUID, objects int64) {
+	if s.Objects != nil && objects > 0 {
+		s.Objects.Release(projectID, objects)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+// BucketLock makes the objects of a bucket immutable (write once, read many)
+type BucketLock struct {
+	ProjectID uuid.UUID
+	Bucket    string
+	// RetentionPeriod is how long after their creation objects can't be
+	// deleted or overwritten, it can be extended but not shortened
+	RetentionPeriod time.Duration
+	// LegalHold prevents deleting or overwriting any object of the bucket until it's released
+	LegalHold bool
+}
+
+// BucketLocks stores the object locks of buckets
+type BucketLocks interface {
+	// Set adds or replaces the lock of a bucket
+	Set(ctx context.Context, lock BucketLock) error
+	// Get returns the lock of a bucket, it's nil when the bucket has none
+	Get(ctx context.Context, projectID uuid.UUID, bucket string) (*BucketLock, error)
+}
+
+// Active returns whether the lock protects any object
+func (lock *BucketLock) Active() bool {
+	return lock != nil && (lock.LegalHold || lock.RetentionPeriod > 0)
+}
+
+// checkLock returns an ErrObjectLocked error when the pointer stored at path
+// can't be deleted or overwritten because of the lock of its bucket.
+func (s *Server) checkLock(ctx context.Context, projectID uuid.UUID, path string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.Locks == nil {
+		return nil
+	}
+
+	// path is <segment index>/<bucket>/<encrypted path>
+	parts := strings.SplitN(path, "/", 3)
+	if len(parts) < 3 {
+		return nil
+	}
+	bucket := parts[1]
+
+	lock, err := s.Locks.Get(ctx, projectID, bucket)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !lock.Active() {
+		return nil
+	}
+
+	pointer, err := s.service.Get(storj.JoinPaths(projectID.String(), path))
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	if lock.LegalHold {
+		return storj.ErrObjectLocked.New("bucket %q is under legal hold", bucket)
+	}
+
+	// the creation date is set by the satellite, pointers without one are kept
+	created, err := ptypes.Timestamp(pointer.GetCreationDate())
+	if err != nil {
+		return storj.ErrObjectLocked.New("creation date unknown: %v", err)
+	}
+	if retainUntil := created.Add(lock.RetentionPeriod); time.Now().Before(retainUntil) {
+		return storj.ErrObjectLocked.New("retained until %v", retainUntil.UTC())
+	}
+	return nil
+}
+
+// checkBucketLock returns an ErrObjectLocked error when the objects of a
+// bucket can't be deleted in bulk
+func (s *Server) checkBucketLock(ctx context.Context, projectID uuid.UUID, bucket string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.Locks == nil {
+		return nil
+	}
+
+	lock, err := s.Locks.Get(ctx, projectID, bucket)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if lock.Active() {
+		return storj.ErrObjectLocked.New("bucket %q is locked", bucket)
+	}
+	return nil
+}
@@ -5,9 +5,11 @@ package pdbclient
 
 import (
 	"context"
+	"io"
 	"sync/atomic"
 	"unsafe"
 
+	"github.com/golang/protobuf/ptypes"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -51,10 +53,19 @@ type Client interface {
 	Put(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
 	Get(ctx context.Context, path storj.Path) (*pb.Pointer, []*pb.Node, *pb.PayerBandwidthAllocation, error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	ListFiltered(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32, filter storj.ListFilter) (items []ListItem, more bool, err error)
+	ListStream(ctx context.Context, prefix storj.Path, recursive bool, pageSize int, metaFlags uint32, fn func(items []ListItem) error) error
 	Delete(ctx context.Context, path storj.Path) error
+	DeletePrefix(ctx context.Context, prefix storj.Path, wholeBucket bool) (objects int64, remote []*pb.Pointer, more bool, err error)
 
 	SignedMessage() *pb.SignedMessage
 	PayerBandwidthAllocation(context.Context, pb.BandwidthAction) (*pb.PayerBandwidthAllocation, error)
+	ProjectInfo(ctx context.Context) (*pb.ProjectInfoResponse, error)
+	SelectNodes(ctx context.Context, redundancy *pb.RedundancyScheme, segmentSize int64, excluded storj.NodeIDList) ([]*pb.Node, error)
+	SetPrefixQuota(ctx context.Context, bucket, prefix string, maxBytes, maxObjects int64) error
+	GetPrefixQuotas(ctx context.Context, bucket string) ([]*pb.PrefixQuota, error)
+	SetBucketLock(ctx context.Context, bucket string, lock *pb.BucketLock) error
+	GetBucketLock(ctx context.Context, bucket string) (*pb.BucketLock, error)
 
 	// Disconnect() error // TODO: implement
 }
@@ -67,11 +78,13 @@ func NewClient(identity *identity.FullIdentity, address string, APIKey string) (
 // NewClientContext initializes a new pointerdb client
 func NewClientContext(ctx context.Context, identity *identity.FullIdentity, address string, APIKey string) (*PointerDB, error) {
 	apiKeyInjector := grpcauth.NewAPIKeyInjector(APIKey)
+	apiKeyStreamInjector := grpcauth.NewAPIKeyStreamInjector(APIKey)
 	tc := transport.NewClient(identity)
 	conn, err := tc.DialAddress(
 		ctx,
 		address,
 		grpc.WithUnaryInterceptor(apiKeyInjector),
+		grpc.WithStreamInterceptor(apiKeyStreamInjector),
 	)
 	if err != nil {
 		return nil, err
@@ -89,7 +102,7 @@ func (pdb *PointerDB) Put(ctx context.Context, path storj.Path, pointer *pb.Poin
 
 	_, err = pdb.client.Put(ctx, &pb.PutRequest{Path: path, Pointer: pointer})
 
-	return err
+	return convertLockError(err)
 }
 
 // Get is the interface to make a GET request, needs PATH and APIKey
@@ -132,20 +145,58 @@ func (pdb *PointerDB) Get(ctx context.Context, path storj.Path) (pointer *pb.Poi
 func (pdb *PointerDB) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	res, err := pdb.client.List(ctx, &pb.ListRequest{
+	return pdb.ListFiltered(ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags, storj.ListFilter{})
+}
+
+// ListFiltered is like List, but the satellite only returns items matching the filter.
+// A page may contain fewer items than the limit, even when there are more.
+func (pdb *PointerDB) ListFiltered(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32, filter storj.ListFilter) (items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req := &pb.ListRequest{
 		Prefix:     prefix,
 		StartAfter: startAfter,
 		EndBefore:  endBefore,
 		Recursive:  recursive,
 		Limit:      int32(limit),
 		MetaFlags:  metaFlags,
-	})
-	if err != nil {
-		return nil, false, err
+		MinSize:    filter.MinSize,
+		MaxSize:    filter.MaxSize,
+	}
+	if !filter.ModifiedAfter.IsZero() {
+		req.ModifiedAfter, err = ptypes.TimestampProto(filter.ModifiedAfter)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	if !filter.ModifiedBefore.IsZero() {
+		req.ModifiedBefore, err = ptypes.TimestampProto(filter.ModifiedBefore)
+		if err != nil {
+			return nil, false, err
+		}
 	}
 
-	list := res.GetItems()
-	items = make([]ListItem, len(list))
+	// the satellite limits the keys scanned for a page, so the listing
+	// continues at the cursor until a page isn't empty
+	for {
+		res, err := pdb.client.List(ctx, req)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(res.GetItems()) > 0 || !res.GetMore() || res.GetCursor() == "" {
+			return convertListItems(res.GetItems()), res.GetMore(), nil
+		}
+
+		if req.EndBefore != "" {
+			req.EndBefore = res.GetCursor()
+		} else {
+			req.StartAfter = res.GetCursor()
+		}
+	}
+}
+
+func convertListItems(list []*pb.ListResponse_Item) []ListItem {
+	items := make([]ListItem, len(list))
 	for i, itm := range list {
 		items[i] = ListItem{
 			Path:     itm.GetPath(),
@@ -153,8 +204,42 @@ func (pdb *PointerDB) List(ctx context.Context, prefix, startAfter, endBefore st
 			IsPrefix: itm.IsPrefix,
 		}
 	}
+	return items
+}
+
+// ListStream lists everything under prefix in a single request, calling fn for each page sent by the satellite
+func (pdb *PointerDB) ListStream(ctx context.Context, prefix storj.Path, recursive bool, pageSize int, metaFlags uint32, fn func(items []ListItem) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := pdb.client.ListStream(ctx, &pb.ListRequest{
+		Prefix:    prefix,
+		Recursive: recursive,
+		Limit:     int32(pageSize),
+		MetaFlags: metaFlags,
+	})
+	if err != nil {
+		return err
+	}
 
-	return items, res.GetMore(), nil
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(convertListItems(res.GetItems())); err != nil {
+			return err
+		}
+		if !res.GetMore() {
+			return nil
+		}
+	}
 }
 
 // Delete is the interface to make a Delete request, needs Path and APIKey
@@ -163,7 +248,24 @@ func (pdb *PointerDB) Delete(ctx context.Context, path storj.Path) (err error) {
 
 	_, err = pdb.client.Delete(ctx, &pb.DeleteRequest{Path: path})
 
-	return err
+	return convertLockError(err)
+}
+
+// DeletePrefix deletes the pointers of objects under prefix on the satellite.
+// It returns the deleted pointers of remote segments, whose pieces have to be
+// deleted from the storage nodes, and whether objects are left under prefix.
+// wholeBucket has to be set, when prefix is only the bucket.
+func (pdb *PointerDB) DeletePrefix(ctx context.Context, prefix storj.Path, wholeBucket bool) (objects int64, remote []*pb.Pointer, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.client.DeletePrefix(ctx, &pb.DeletePrefixRequest{Prefix: prefix, WholeBucket: wholeBucket})
+	if err != nil {
+		return 0, nil, false, convertLockError(err)
+	}
+
+	atomic.StorePointer(&pdb.authorization, unsafe.Pointer(res.GetAuthorization()))
+
+	return res.GetDeletedObjects(), res.GetRemotePointers(), res.GetMore(), nil
 }
 
 // PayerBandwidthAllocation gets payer bandwidth allocation message
@@ -177,6 +279,85 @@ func (pdb *PointerDB) PayerBandwidthAllocation(ctx context.Context, action pb.Ba
 	return response.GetPba(), nil
 }
 
+// ProjectInfo gets information about the project of the api key
+func (pdb *PointerDB) ProjectInfo(ctx context.Context) (resp *pb.ProjectInfoResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return pdb.client.ProjectInfo(ctx, &pb.ProjectInfoRequest{})
+}
+
+// SelectNodes returns the nodes the satellite would select for uploading a segment, without reserving them
+func (pdb *PointerDB) SelectNodes(ctx context.Context, redundancy *pb.RedundancyScheme, segmentSize int64, excluded storj.NodeIDList) (nodes []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.client.SelectNodes(ctx, &pb.SelectNodesRequest{
+		Redundancy:    redundancy,
+		SegmentSize:   segmentSize,
+		ExcludedNodes: excluded,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetNodes(), nil
+}
+
+// SetPrefixQuota sets the byte and object limits of a prefix in a bucket, zero limits remove the quota
+func (pdb *PointerDB) SetPrefixQuota(ctx context.Context, bucket, prefix string, maxBytes, maxObjects int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pdb.client.SetPrefixQuota(ctx, &pb.SetPrefixQuotaRequest{
+		Bucket:     bucket,
+		Prefix:     prefix,
+		MaxBytes:   maxBytes,
+		MaxObjects: maxObjects,
+	})
+	return err
+}
+
+// GetPrefixQuotas returns the prefix quotas of a bucket with their current usage
+func (pdb *PointerDB) GetPrefixQuotas(ctx context.Context, bucket string) (quotas []*pb.PrefixQuota, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.client.GetPrefixQuotas(ctx, &pb.GetPrefixQuotasRequest{Bucket: bucket})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetQuotas(), nil
+}
+
+// SetBucketLock sets the object lock of a bucket, the retention period can't be shortened
+func (pdb *PointerDB) SetBucketLock(ctx context.Context, bucket string, lock *pb.BucketLock) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pdb.client.SetBucketLock(ctx, &pb.SetBucketLockRequest{
+		Bucket: bucket,
+		Lock:   lock,
+	})
+	return err
+}
+
+// GetBucketLock returns the object lock of a bucket, it's nil when the bucket isn't locked
+func (pdb *PointerDB) GetBucketLock(ctx context.Context, bucket string) (lock *pb.BucketLock, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.client.GetBucketLock(ctx, &pb.GetBucketLockRequest{Bucket: bucket})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetLock(), nil
+}
+
+// convertLockError converts the rejections of locked objects to storj.ErrObjectLocked
+func convertLockError(err error) error {
+	if status.Code(err) == codes.FailedPrecondition {
+		return storj.ErrObjectLocked.Wrap(err)
+	}
+	return err
+}
+
 // SignedMessage gets signed message from last request
 func (pdb *PointerDB) SignedMessage() *pb.SignedMessage {
 	return (*pb.SignedMessage)(atomic.LoadPointer(&pdb.authorization))
@@ -262,6 +262,30 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListFilteredCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := auth.WithAPIKey(context.Background(), []byte("some key"))
+	gc := NewMockPointerDBClient(ctrl)
+	pdb := PointerDB{client: gc}
+
+	// an empty page with more items continues at the cursor
+	gomock.InOrder(
+		gc.EXPECT().List(gomock.Any(), &pb.ListRequest{Prefix: "prefix", Limit: 10, MinSize: 100}).
+			Return(&pb.ListResponse{More: true, Cursor: "a"}, nil),
+		gc.EXPECT().List(gomock.Any(), &pb.ListRequest{Prefix: "prefix", StartAfter: "a", Limit: 10, MinSize: 100}).
+			Return(&pb.ListResponse{Items: []*pb.ListResponse_Item{{Path: "b"}}, More: true, Cursor: "c"}, nil),
+	)
+
+	items, more, err := pdb.ListFiltered(ctx, "prefix", "", "", false, 10, meta.None, storj.ListFilter{MinSize: 100})
+	assert.NoError(t, err)
+	assert.True(t, more)
+	if assert.Len(t, items, 1) {
+		assert.Equal(t, "b", items[0].Path)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
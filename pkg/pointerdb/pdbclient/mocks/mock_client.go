@@ -12,6 +12,7 @@ import (
 
 	pb "storj.io/storj/pkg/pb"
 	pdbclient "storj.io/storj/pkg/pointerdb/pdbclient"
+	storj "storj.io/storj/pkg/storj"
 )
 
 // MockClient is a mock of Client interface
@@ -49,6 +50,21 @@ func (mr *MockClientMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClient)(nil).Delete), arg0, arg1)
 }
 
+// DeletePrefix mocks base method
+func (m *MockClient) DeletePrefix(arg0 context.Context, arg1 string, arg2 bool) (int64, []*pb.Pointer, bool, error) {
+	ret := m.ctrl.Call(m, "DeletePrefix", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].([]*pb.Pointer)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// DeletePrefix indicates an expected call of DeletePrefix
+func (mr *MockClientMockRecorder) DeletePrefix(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrefix", reflect.TypeOf((*MockClient)(nil).DeletePrefix), arg0, arg1, arg2)
+}
+
 // Get mocks base method
 func (m *MockClient) Get(arg0 context.Context, arg1 string) (*pb.Pointer, []*pb.Node, *pb.PayerBandwidthAllocation, error) {
 	ret := m.ctrl.Call(m, "Get", arg0, arg1)
@@ -64,6 +80,32 @@ func (mr *MockClientMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockClient)(nil).Get), arg0, arg1)
 }
 
+// GetBucketLock mocks base method
+func (m *MockClient) GetBucketLock(arg0 context.Context, arg1 string) (*pb.BucketLock, error) {
+	ret := m.ctrl.Call(m, "GetBucketLock", arg0, arg1)
+	ret0, _ := ret[0].(*pb.BucketLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketLock indicates an expected call of GetBucketLock
+func (mr *MockClientMockRecorder) GetBucketLock(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketLock", reflect.TypeOf((*MockClient)(nil).GetBucketLock), arg0, arg1)
+}
+
+// GetPrefixQuotas mocks base method
+func (m *MockClient) GetPrefixQuotas(arg0 context.Context, arg1 string) ([]*pb.PrefixQuota, error) {
+	ret := m.ctrl.Call(m, "GetPrefixQuotas", arg0, arg1)
+	ret0, _ := ret[0].([]*pb.PrefixQuota)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrefixQuotas indicates an expected call of GetPrefixQuotas
+func (mr *MockClientMockRecorder) GetPrefixQuotas(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrefixQuotas", reflect.TypeOf((*MockClient)(nil).GetPrefixQuotas), arg0, arg1)
+}
+
 // List mocks base method
 func (m *MockClient) List(arg0 context.Context, arg1, arg2, arg3 string, arg4 bool, arg5 int, arg6 uint32) ([]pdbclient.ListItem, bool, error) {
 	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
@@ -78,6 +120,32 @@ func (mr *MockClientMockRecorder) List(arg0, arg1, arg2, arg3, arg4, arg5, arg6
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClient)(nil).List), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// ListFiltered mocks base method
+func (m *MockClient) ListFiltered(arg0 context.Context, arg1, arg2, arg3 string, arg4 bool, arg5 int, arg6 uint32, arg7 storj.ListFilter) ([]pdbclient.ListItem, bool, error) {
+	ret := m.ctrl.Call(m, "ListFiltered", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	ret0, _ := ret[0].([]pdbclient.ListItem)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFiltered indicates an expected call of ListFiltered
+func (mr *MockClientMockRecorder) ListFiltered(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiltered", reflect.TypeOf((*MockClient)(nil).ListFiltered), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+}
+
+// ListStream mocks base method
+func (m *MockClient) ListStream(arg0 context.Context, arg1 string, arg2 bool, arg3 int, arg4 uint32, arg5 func([]pdbclient.ListItem) error) error {
+	ret := m.ctrl.Call(m, "ListStream", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListStream indicates an expected call of ListStream
+func (mr *MockClientMockRecorder) ListStream(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStream", reflect.TypeOf((*MockClient)(nil).ListStream), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // PayerBandwidthAllocation mocks base method
 func (m *MockClient) PayerBandwidthAllocation(arg0 context.Context, arg1 pb.BandwidthAction) (*pb.PayerBandwidthAllocation, error) {
 	ret := m.ctrl.Call(m, "PayerBandwidthAllocation", arg0, arg1)
@@ -91,6 +159,19 @@ func (mr *MockClientMockRecorder) PayerBandwidthAllocation(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayerBandwidthAllocation", reflect.TypeOf((*MockClient)(nil).PayerBandwidthAllocation), arg0, arg1)
 }
 
+// ProjectInfo mocks base method
+func (m *MockClient) ProjectInfo(arg0 context.Context) (*pb.ProjectInfoResponse, error) {
+	ret := m.ctrl.Call(m, "ProjectInfo", arg0)
+	ret0, _ := ret[0].(*pb.ProjectInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectInfo indicates an expected call of ProjectInfo
+func (mr *MockClientMockRecorder) ProjectInfo(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectInfo", reflect.TypeOf((*MockClient)(nil).ProjectInfo), arg0)
+}
+
 // Put mocks base method
 func (m *MockClient) Put(arg0 context.Context, arg1 string, arg2 *pb.Pointer) error {
 	ret := m.ctrl.Call(m, "Put", arg0, arg1, arg2)
@@ -103,6 +184,43 @@ func (mr *MockClientMockRecorder) Put(arg0, arg1, arg2 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockClient)(nil).Put), arg0, arg1, arg2)
 }
 
+// SelectNodes mocks base method
+func (m *MockClient) SelectNodes(arg0 context.Context, arg1 *pb.RedundancyScheme, arg2 int64, arg3 storj.NodeIDList) ([]*pb.Node, error) {
+	ret := m.ctrl.Call(m, "SelectNodes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*pb.Node)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectNodes indicates an expected call of SelectNodes
+func (mr *MockClientMockRecorder) SelectNodes(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectNodes", reflect.TypeOf((*MockClient)(nil).SelectNodes), arg0, arg1, arg2, arg3)
+}
+
+// SetBucketLock mocks base method
+func (m *MockClient) SetBucketLock(arg0 context.Context, arg1 string, arg2 *pb.BucketLock) error {
+	ret := m.ctrl.Call(m, "SetBucketLock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBucketLock indicates an expected call of SetBucketLock
+func (mr *MockClientMockRecorder) SetBucketLock(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketLock", reflect.TypeOf((*MockClient)(nil).SetBucketLock), arg0, arg1, arg2)
+}
+
+// SetPrefixQuota mocks base method
+func (m *MockClient) SetPrefixQuota(arg0 context.Context, arg1, arg2 string, arg3, arg4 int64) error {
+	ret := m.ctrl.Call(m, "SetPrefixQuota", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPrefixQuota indicates an expected call of SetPrefixQuota
+func (mr *MockClientMockRecorder) SetPrefixQuota(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrefixQuota", reflect.TypeOf((*MockClient)(nil).SetPrefixQuota), arg0, arg1, arg2, arg3, arg4)
+}
+
 // SignedMessage mocks base method
 func (m *MockClient) SignedMessage() *pb.SignedMessage {
 	ret := m.ctrl.Call(m, "SignedMessage")
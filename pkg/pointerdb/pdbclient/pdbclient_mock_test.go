@@ -58,6 +58,24 @@ func (mr *MockPointerDBClientMockRecorder) Delete(arg0, arg1 interface{}, arg2 .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPointerDBClient)(nil).Delete), varargs...)
 }
 
+// DeletePrefix mocks base method
+func (m *MockPointerDBClient) DeletePrefix(arg0 context.Context, arg1 *pb.DeletePrefixRequest, arg2 ...grpc.CallOption) (*pb.DeletePrefixResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePrefix", varargs...)
+	ret0, _ := ret[0].(*pb.DeletePrefixResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePrefix indicates an expected call of DeletePrefix
+func (mr *MockPointerDBClientMockRecorder) DeletePrefix(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrefix", reflect.TypeOf((*MockPointerDBClient)(nil).DeletePrefix), varargs...)
+}
+
 // Get mocks base method
 func (m *MockPointerDBClient) Get(arg0 context.Context, arg1 *pb.GetRequest, arg2 ...grpc.CallOption) (*pb.GetResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -76,6 +94,42 @@ func (mr *MockPointerDBClientMockRecorder) Get(arg0, arg1 interface{}, arg2 ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPointerDBClient)(nil).Get), varargs...)
 }
 
+// GetBucketLock mocks base method
+func (m *MockPointerDBClient) GetBucketLock(arg0 context.Context, arg1 *pb.GetBucketLockRequest, arg2 ...grpc.CallOption) (*pb.GetBucketLockResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketLock", varargs...)
+	ret0, _ := ret[0].(*pb.GetBucketLockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucketLock indicates an expected call of GetBucketLock
+func (mr *MockPointerDBClientMockRecorder) GetBucketLock(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketLock", reflect.TypeOf((*MockPointerDBClient)(nil).GetBucketLock), varargs...)
+}
+
+// GetPrefixQuotas mocks base method
+func (m *MockPointerDBClient) GetPrefixQuotas(arg0 context.Context, arg1 *pb.GetPrefixQuotasRequest, arg2 ...grpc.CallOption) (*pb.GetPrefixQuotasResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPrefixQuotas", varargs...)
+	ret0, _ := ret[0].(*pb.GetPrefixQuotasResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrefixQuotas indicates an expected call of GetPrefixQuotas
+func (mr *MockPointerDBClientMockRecorder) GetPrefixQuotas(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrefixQuotas", reflect.TypeOf((*MockPointerDBClient)(nil).GetPrefixQuotas), varargs...)
+}
+
 // List mocks base method
 func (m *MockPointerDBClient) List(arg0 context.Context, arg1 *pb.ListRequest, arg2 ...grpc.CallOption) (*pb.ListResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -94,6 +148,24 @@ func (mr *MockPointerDBClientMockRecorder) List(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPointerDBClient)(nil).List), varargs...)
 }
 
+// ListStream mocks base method
+func (m *MockPointerDBClient) ListStream(arg0 context.Context, arg1 *pb.ListRequest, arg2 ...grpc.CallOption) (pb.PointerDB_ListStreamClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStream", varargs...)
+	ret0, _ := ret[0].(pb.PointerDB_ListStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStream indicates an expected call of ListStream
+func (mr *MockPointerDBClientMockRecorder) ListStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStream", reflect.TypeOf((*MockPointerDBClient)(nil).ListStream), varargs...)
+}
+
 // PayerBandwidthAllocation mocks base method
 func (m *MockPointerDBClient) PayerBandwidthAllocation(arg0 context.Context, arg1 *pb.PayerBandwidthAllocationRequest, arg2 ...grpc.CallOption) (*pb.PayerBandwidthAllocationResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -112,6 +184,24 @@ func (mr *MockPointerDBClientMockRecorder) PayerBandwidthAllocation(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayerBandwidthAllocation", reflect.TypeOf((*MockPointerDBClient)(nil).PayerBandwidthAllocation), varargs...)
 }
 
+// ProjectInfo mocks base method
+func (m *MockPointerDBClient) ProjectInfo(arg0 context.Context, arg1 *pb.ProjectInfoRequest, arg2 ...grpc.CallOption) (*pb.ProjectInfoResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProjectInfo", varargs...)
+	ret0, _ := ret[0].(*pb.ProjectInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectInfo indicates an expected call of ProjectInfo
+func (mr *MockPointerDBClientMockRecorder) ProjectInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectInfo", reflect.TypeOf((*MockPointerDBClient)(nil).ProjectInfo), varargs...)
+}
+
 // Put mocks base method
 func (m *MockPointerDBClient) Put(arg0 context.Context, arg1 *pb.PutRequest, arg2 ...grpc.CallOption) (*pb.PutResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -129,3 +219,57 @@ func (mr *MockPointerDBClientMockRecorder) Put(arg0, arg1 interface{}, arg2 ...i
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockPointerDBClient)(nil).Put), varargs...)
 }
+
+// SelectNodes mocks base method
+func (m *MockPointerDBClient) SelectNodes(arg0 context.Context, arg1 *pb.SelectNodesRequest, arg2 ...grpc.CallOption) (*pb.SelectNodesResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelectNodes", varargs...)
+	ret0, _ := ret[0].(*pb.SelectNodesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectNodes indicates an expected call of SelectNodes
+func (mr *MockPointerDBClientMockRecorder) SelectNodes(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectNodes", reflect.TypeOf((*MockPointerDBClient)(nil).SelectNodes), varargs...)
+}
+
+// SetBucketLock mocks base method
+func (m *MockPointerDBClient) SetBucketLock(arg0 context.Context, arg1 *pb.SetBucketLockRequest, arg2 ...grpc.CallOption) (*pb.SetBucketLockResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetBucketLock", varargs...)
+	ret0, _ := ret[0].(*pb.SetBucketLockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBucketLock indicates an expected call of SetBucketLock
+func (mr *MockPointerDBClientMockRecorder) SetBucketLock(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBucketLock", reflect.TypeOf((*MockPointerDBClient)(nil).SetBucketLock), varargs...)
+}
+
+// SetPrefixQuota mocks base method
+func (m *MockPointerDBClient) SetPrefixQuota(arg0 context.Context, arg1 *pb.SetPrefixQuotaRequest, arg2 ...grpc.CallOption) (*pb.SetPrefixQuotaResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetPrefixQuota", varargs...)
+	ret0, _ := ret[0].(*pb.SetPrefixQuotaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPrefixQuota indicates an expected call of SetPrefixQuota
+func (mr *MockPointerDBClientMockRecorder) SetPrefixQuota(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrefixQuota", reflect.TypeOf((*MockPointerDBClient)(nil).SetPrefixQuota), varargs...)
+}
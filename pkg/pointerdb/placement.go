@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"net"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+)
+
+// DuplicatePieces returns the indices of the remote pieces which are stored
+// on a node that already holds an earlier piece of the same segment
+func DuplicatePieces(pieces []*pb.RemotePiece) (duplicates []int32) {
+	seen := make(map[storj.NodeID]bool, len(pieces))
+	for i, piece := range pieces {
+		if seen[piece.NodeId] {
+			duplicates = append(duplicates, int32(i))
+			continue
+		}
+		seen[piece.NodeId] = true
+	}
+	return duplicates
+}
+
+// DuplicateNetworks returns the indices of the nodes which share a network
+// with an earlier node, nodes without a known address are ignored
+func DuplicateNetworks(nodes []*pb.Node) (duplicates []int32) {
+	seen := make(map[string]bool, len(nodes))
+	for i, node := range nodes {
+		network := NetworkPrefix(node.GetAddress().GetAddress())
+		if network == "" {
+			continue
+		}
+		if seen[network] {
+			duplicates = append(duplicates, int32(i))
+			continue
+		}
+		seen[network] = true
+	}
+	return duplicates
+}
+
+// NetworkPrefix returns the /24 network of an IPv4 address or the /64
+// network of an IPv6 address. Host names are returned as they are.
+func NetworkPrefix(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return host
+	}
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return ipv4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return ip.Mask(net.CIDRMask(64, 128)).String()
+}
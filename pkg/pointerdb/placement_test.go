@@ -0,0 +1,58 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestDuplicatePieces(t *testing.T) {
+	a, b := storj.NodeID{1}, storj.NodeID{2}
+
+	assert.Empty(t, pointerdb.DuplicatePieces(nil))
+	assert.Empty(t, pointerdb.DuplicatePieces([]*pb.RemotePiece{
+		{PieceNum: 0, NodeId: a},
+		{PieceNum: 1, NodeId: b},
+	}))
+	assert.Equal(t, []int32{2, 3}, pointerdb.DuplicatePieces([]*pb.RemotePiece{
+		{PieceNum: 0, NodeId: a},
+		{PieceNum: 1, NodeId: b},
+		{PieceNum: 2, NodeId: a},
+		{PieceNum: 3, NodeId: a},
+	}))
+}
+
+func TestDuplicateNetworks(t *testing.T) {
+	node := func(address string) *pb.Node {
+		return &pb.Node{Address: &pb.NodeAddress{Address: address}}
+	}
+
+	assert.Equal(t, []int32{1, 4}, pointerdb.DuplicateNetworks([]*pb.Node{
+		node("10.0.0.1:7777"),
+		node("10.0.0.200:7777"),
+		node("10.0.1.1:7777"),
+		nil,
+		node("10.0.1.2:7778"),
+		node(""),
+		node(""),
+	}))
+}
+
+func TestNetworkPrefix(t *testing.T) {
+	for address, expected := range map[string]string{
+		"10.1.2.3:7777":          "10.1.2.0",
+		"10.1.2.3":               "10.1.2.0",
+		"[2001:db8:1:2::5]:7777": "2001:db8:1:2::",
+		"example.com:7777":       "example.com",
+		"":                       "",
+	} {
+		assert.Equal(t, expected, pointerdb.NetworkPrefix(address), address)
+	}
+}
@@ -5,7 +5,11 @@ package pointerdb
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -32,6 +36,17 @@ type APIKeys interface {
 	GetByKey(ctx context.Context, key console.APIKey) (*console.APIKeyInfo, error)
 }
 
+// NodeSelection selects the storage nodes for uploads
+type NodeSelection interface {
+	FindStorageNodes(ctx context.Context, req *pb.FindStorageNodesRequest) (*pb.FindStorageNodesResponse, error)
+}
+
+// EgressAttribution records the objects of download allocations, so their
+// egress can be attributed to them
+type EgressAttribution interface {
+	Attribute(ctx context.Context, projectID uuid.UUID, path storj.Path, pba *pb.PayerBandwidthAllocation) error
+}
+
 // Server implements the network state RPC service
 type Server struct {
 	logger     *zap.Logger
@@ -41,6 +56,19 @@ type Server struct {
 	config     Config
 	identity   *identity.FullIdentity
 	apiKeys    APIKeys
+
+	// Selection, when set, is used by SelectNodes to select the nodes the same way as for uploads
+	Selection NodeSelection
+	// Quotas, when set, limits the bytes and objects stored under prefixes of buckets
+	Quotas PrefixQuotas
+	// Locks, when set, prevents deleting and overwriting objects of locked buckets
+	Locks BucketLocks
+	// Egress, when set, attributes the egress of downloads to their objects
+	Egress EgressAttribution
+	// Objects, when set, limits the number of objects of projects
+	Objects *ObjectLimits
+	// Salts, when set, stores the salts returned by ProjectInfo
+	Salts ProjectSalts
 }
 
 // NewServer creates instance of Server
@@ -81,7 +109,7 @@ func (s *Server) validateAuth(ctx context.Context) (*console.APIKeyInfo, error)
 	return keyInfo, nil
 }
 
-func (s *Server) validateSegment(req *pb.PutRequest) error {
+func (s *Server) validateSegment(ctx context.Context, req *pb.PutRequest) error {
 	min := s.config.MinRemoteSegmentSize
 	remote := req.GetPointer().Remote
 	remoteSize := req.GetPointer().GetSegmentSize()
@@ -97,6 +125,31 @@ func (s *Server) validateSegment(req *pb.PutRequest) error {
 		return segmentError.New("inline segment size %d greater than maximum allowed %d", inlineSize, max)
 	}
 
+	return s.validatePlacement(ctx, remote.GetRemotePieces())
+}
+
+// validatePlacement rejects segments storing several pieces on the same node
+// or, with DistinctIP enabled, on the same network
+func (s *Server) validatePlacement(ctx context.Context, pieces []*pb.RemotePiece) error {
+	if duplicates := DuplicatePieces(pieces); len(duplicates) > 0 {
+		return segmentError.New("multiple pieces on node %s", pieces[duplicates[0]].NodeId)
+	}
+
+	if !s.config.DistinctIP || s.cache == nil || len(pieces) == 0 {
+		return nil
+	}
+
+	var nodeIDs storj.NodeIDList
+	for _, piece := range pieces {
+		nodeIDs = append(nodeIDs, piece.NodeId)
+	}
+	nodes, err := s.cache.GetAll(ctx, nodeIDs)
+	if err != nil {
+		return segmentError.Wrap(err)
+	}
+	if duplicates := DuplicateNetworks(nodes); len(duplicates) > 0 {
+		return segmentError.New("multiple pieces in the network of node %s", pieces[duplicates[0]].NodeId)
+	}
 	return nil
 }
 
@@ -104,7 +157,7 @@ func (s *Server) validateSegment(req *pb.PutRequest) error {
 func (s *Server) Put(ctx context.Context, req *pb.PutRequest) (resp *pb.PutResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	err = s.validateSegment(req)
+	err = s.validateSegment(ctx, req)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
@@ -114,8 +167,30 @@ func (s *Server) Put(ctx context.Context, req *pb.PutRequest) (resp *pb.PutRespo
 		return nil, err
 	}
 
+	if err = s.checkQuotas(ctx, keyInfo.ProjectID, req.GetPath(), req.GetPointer()); err != nil {
+		if ErrQuotaExceeded.Has(err) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		s.logger.Error("err checking prefix quotas", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err = s.checkLock(ctx, keyInfo.ProjectID, req.GetPath()); err != nil {
+		return nil, lockStatus(err)
+	}
+
+	release, err := s.reserveObject(ctx, keyInfo.ProjectID, req.GetPath())
+	if err != nil {
+		if ErrObjectLimit.Has(err) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		s.logger.Error("err checking object limit", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	path := storj.JoinPaths(keyInfo.ProjectID.String(), req.GetPath())
 	if err = s.service.Put(path, req.GetPointer()); err != nil {
+		release()
 		s.logger.Error("err putting pointer", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -154,6 +229,13 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	// inline segments are downloaded from the satellite without agreements
+	if s.Egress != nil && pointer.Remote != nil {
+		if err := s.Egress.Attribute(ctx, keyInfo.ProjectID, req.GetPath(), pba.GetPba()); err != nil {
+			s.logger.Warn("err attributing egress", zap.Error(err))
+		}
+	}
+
 	nodes := []*pb.Node{}
 
 	var r = &pb.GetResponse{
@@ -200,13 +282,63 @@ func (s *Server) List(ctx context.Context, req *pb.ListRequest) (resp *pb.ListRe
 		return nil, err
 	}
 
+	filter, err := listFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
 	prefix := storj.JoinPaths(keyInfo.ProjectID.String(), req.Prefix)
-	items, more, err := s.service.List(prefix, req.StartAfter, req.EndBefore, req.Recursive, req.Limit, req.MetaFlags)
+	items, more, cursor, err := s.service.ListFiltered(prefix, req.StartAfter, req.EndBefore, req.Recursive, req.Limit, req.MetaFlags, filter)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "ListV2: %v", err)
 	}
 
-	return &pb.ListResponse{Items: items, More: more}, nil
+	return &pb.ListResponse{Items: items, More: more, Cursor: cursor}, nil
+}
+
+// ListStream sends the listing page by page until it is exhausted
+func (s *Server) ListStream(req *pb.ListRequest, stream pb.PointerDB_ListStreamServer) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	filter, err := listFilter(req)
+	if err != nil {
+		return err
+	}
+
+	prefix := storj.JoinPaths(keyInfo.ProjectID.String(), req.Prefix)
+	err = s.service.ListPages(prefix, req.StartAfter, req.EndBefore, req.Recursive, req.Limit, req.MetaFlags, filter,
+		func(items []*pb.ListResponse_Item, more bool) error {
+			return stream.Send(&pb.ListResponse{Items: items, More: more})
+		})
+	if err != nil {
+		return status.Errorf(codes.Internal, "ListV2: %v", err)
+	}
+	return nil
+}
+
+// listFilter converts the filters of the list request
+func listFilter(req *pb.ListRequest) (filter ListFilter, err error) {
+	filter.MinSize = req.MinSize
+	filter.MaxSize = req.MaxSize
+	if req.ModifiedAfter != nil {
+		filter.ModifiedAfter, err = ptypes.Timestamp(req.ModifiedAfter)
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if req.ModifiedBefore != nil {
+		filter.ModifiedBefore, err = ptypes.Timestamp(req.ModifiedBefore)
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return filter, nil
 }
 
 // Delete formats and hands off a file path to delete from boltdb
@@ -218,16 +350,71 @@ func (s *Server) Delete(ctx context.Context, req *pb.DeleteRequest) (resp *pb.De
 		return nil, err
 	}
 
+	if err = s.checkLock(ctx, keyInfo.ProjectID, req.GetPath()); err != nil {
+		return nil, lockStatus(err)
+	}
+
 	path := storj.JoinPaths(keyInfo.ProjectID.String(), req.GetPath())
 	err = s.service.Delete(path)
 	if err != nil {
 		s.logger.Error("err deleting path and pointer", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	if strings.HasPrefix(req.GetPath(), "l/") {
+		s.releaseObjects(keyInfo.ProjectID, 1)
+	}
 
 	return &pb.DeleteResponse{}, nil
 }
 
+// DeletePrefix deletes the pointers of all objects under a prefix
+func (s *Server) DeletePrefix(ctx context.Context, req *pb.DeletePrefixRequest) (resp *pb.DeletePrefixResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.Trim(req.GetPrefix(), "/") == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "prefix must include a bucket")
+	}
+
+	parts := strings.SplitN(strings.Trim(req.GetPrefix(), "/"), "/", 2)
+	if len(parts) == 1 && !req.GetWholeBucket() {
+		return nil, status.Errorf(codes.InvalidArgument, "deleting every object of a bucket requires whole_bucket")
+	}
+
+	bucket := parts[0]
+	if err = s.checkBucketLock(ctx, keyInfo.ProjectID, bucket); err != nil {
+		return nil, lockStatus(err)
+	}
+
+	deletion, err := s.service.DeletePrefix(keyInfo.ProjectID.String(), req.GetPrefix(), int(req.GetLimit()))
+	s.releaseObjects(keyInfo.ProjectID, deletion.Objects)
+	if err != nil {
+		s.logger.Error("err deleting prefix", zap.Int64("deleted segments", deletion.Segments), zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp = &pb.DeletePrefixResponse{
+		DeletedObjects:  deletion.Objects,
+		DeletedSegments: deletion.Segments,
+		RemotePointers:  deletion.Remote,
+		More:            deletion.More,
+	}
+
+	// the uplink deletes the pieces of the remote segments from the storage nodes
+	if len(deletion.Remote) > 0 {
+		resp.Authorization, err = s.getSignedMessage()
+		if err != nil {
+			s.logger.Error("err getting signed message", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return resp, nil
+}
+
 // Iterate iterates over items based on IterateRequest
 func (s *Server) Iterate(ctx context.Context, req *pb.IterateRequest, f func(it storage.Iterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -265,6 +452,221 @@ func (s *Server) PayerBandwidthAllocation(ctx context.Context, req *pb.PayerBand
 	return &pb.PayerBandwidthAllocationResponse{Pba: pba}, nil
 }
 
+// ProjectInfo returns information about the project of the api key
+func (s *Server) ProjectInfo(ctx context.Context, req *pb.ProjectInfoRequest) (_ *pb.ProjectInfoResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Salts == nil {
+		return nil, status.Errorf(codes.Unimplemented, "project salts are not available")
+	}
+
+	salt, err := s.projectSalt(ctx, keyInfo.ProjectID)
+	if err != nil {
+		s.logger.Error("err getting project salt", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ProjectInfoResponse{ProjectSalt: salt}, nil
+}
+
+// SelectNodes returns the nodes, which would be selected for uploading a
+// segment with the given redundancy, without reserving them
+func (s *Server) SelectNodes(ctx context.Context, req *pb.SelectNodesRequest) (_ *pb.SelectNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.validateAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Selection == nil {
+		return nil, status.Errorf(codes.Unimplemented, "node selection is not available")
+	}
+
+	total := req.GetRedundancy().GetTotal()
+	if total <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "redundancy total must be positive")
+	}
+	if req.GetSegmentSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "segment size must not be negative")
+	}
+
+	// the restrictions match the ones uplinks use for uploading a segment
+	pieceSize := req.GetSegmentSize() / int64(total)
+	resp, err := s.Selection.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+		Opts: &pb.OverlayOptions{
+			Amount:            int64(total),
+			Restrictions:      &pb.NodeRestrictions{FreeDisk: pieceSize, FreeBandwidth: pieceSize},
+			ExcludedNodes:     req.ExcludedNodes,
+			AllowedCountries:  req.GetAllowedCountries(),
+			ExcludedCountries: req.GetExcludedCountries(),
+		},
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SelectNodesResponse{Nodes: resp.GetNodes()}, nil
+}
+
+// SetPrefixQuota sets the byte and object limits of a prefix in a bucket of the project
+func (s *Server) SetPrefixQuota(ctx context.Context, req *pb.SetPrefixQuotaRequest) (_ *pb.SetPrefixQuotaResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Quotas == nil {
+		return nil, status.Errorf(codes.Unimplemented, "prefix quotas are not available")
+	}
+
+	bucket, prefix := req.GetBucket(), cleanPrefix(req.GetPrefix())
+	if bucket == "" || strings.Contains(bucket, "/") || prefix == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "quota requires a bucket and a prefix")
+	}
+	if req.GetMaxBytes() < 0 || req.GetMaxObjects() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "quota limits must not be negative")
+	}
+
+	if req.GetMaxBytes() == 0 && req.GetMaxObjects() == 0 {
+		err = s.Quotas.Delete(ctx, keyInfo.ProjectID, bucket, prefix)
+	} else {
+		err = s.Quotas.Set(ctx, PrefixQuota{
+			ProjectID:  keyInfo.ProjectID,
+			Bucket:     bucket,
+			Prefix:     prefix,
+			MaxBytes:   req.GetMaxBytes(),
+			MaxObjects: req.GetMaxObjects(),
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SetPrefixQuotaResponse{}, nil
+}
+
+// GetPrefixQuotas returns the prefix quotas of a bucket of the project with their current usage
+func (s *Server) GetPrefixQuotas(ctx context.Context, req *pb.GetPrefixQuotasRequest) (_ *pb.GetPrefixQuotasResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Quotas == nil {
+		return nil, status.Errorf(codes.Unimplemented, "prefix quotas are not available")
+	}
+
+	quotas, err := s.Quotas.GetAll(ctx, keyInfo.ProjectID, req.GetBucket())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.GetPrefixQuotasResponse{}
+	for _, quota := range quotas {
+		usedBytes, usedObjects, err := s.service.PrefixUsage(keyInfo.ProjectID.String(), req.GetBucket(), quota.Prefix)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Quotas = append(resp.Quotas, &pb.PrefixQuota{
+			Prefix:      quota.Prefix,
+			MaxBytes:    quota.MaxBytes,
+			MaxObjects:  quota.MaxObjects,
+			UsedBytes:   usedBytes,
+			UsedObjects: usedObjects,
+		})
+	}
+	return resp, nil
+}
+
+// SetBucketLock sets the object lock of a bucket of the project
+func (s *Server) SetBucketLock(ctx context.Context, req *pb.SetBucketLockRequest) (_ *pb.SetBucketLockResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Locks == nil {
+		return nil, status.Errorf(codes.Unimplemented, "object locks are not available")
+	}
+
+	bucket := req.GetBucket()
+	if bucket == "" || strings.Contains(bucket, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "lock requires a bucket")
+	}
+	if req.GetLock().GetRetentionPeriod() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "retention period must not be negative")
+	}
+
+	current, err := s.Locks.Get(ctx, keyInfo.ProjectID, bucket)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	lock := BucketLock{
+		ProjectID:       keyInfo.ProjectID,
+		Bucket:          bucket,
+		RetentionPeriod: time.Duration(req.GetLock().GetRetentionPeriod()) * time.Second,
+		LegalHold:       req.GetLock().GetLegalHold(),
+	}
+	if current != nil && lock.RetentionPeriod < current.RetentionPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "retention period can't be shortened from %v", current.RetentionPeriod)
+	}
+
+	if err = s.Locks.Set(ctx, lock); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SetBucketLockResponse{}, nil
+}
+
+// GetBucketLock returns the object lock of a bucket of the project
+func (s *Server) GetBucketLock(ctx context.Context, req *pb.GetBucketLockRequest) (_ *pb.GetBucketLockResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	keyInfo, err := s.validateAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Locks == nil {
+		return nil, status.Errorf(codes.Unimplemented, "object locks are not available")
+	}
+
+	lock, err := s.Locks.Get(ctx, keyInfo.ProjectID, req.GetBucket())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.GetBucketLockResponse{}
+	if lock != nil {
+		resp.Lock = &pb.BucketLock{
+			RetentionPeriod: int64(lock.RetentionPeriod / time.Second),
+			LegalHold:       lock.LegalHold,
+		}
+	}
+	return resp, nil
+}
+
+// lockStatus converts the error of a lock check to a status
+func lockStatus(err error) error {
+	if storj.ErrObjectLocked.Has(err) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
 func (s *Server) getSignedMessage() (*pb.SignedMessage, error) {
 	signature, err := auth.GenerateSignature(s.identity.ID.Bytes(), s.identity)
 	if err != nil {
@@ -5,26 +5,33 @@ package pointerdb_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
+	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/pointerdb/pdbclient"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/console"
@@ -403,3 +410,644 @@ func TestServiceList(t *testing.T) {
 		}
 	}
 }
+
+// mockSalts stores project salts in memory
+type mockSalts struct {
+	salts map[uuid.UUID][]byte
+}
+
+// GetOrCreate returns the salt of a project, the given salt is stored
+// when the project has none yet
+func (salts *mockSalts) GetOrCreate(ctx context.Context, projectID uuid.UUID, salt []byte) ([]byte, error) {
+	if stored, ok := salts.salts[projectID]; ok {
+		return stored, nil
+	}
+	salts.salts[projectID] = salt
+	return salt, nil
+}
+
+func TestServiceProjectInfo(t *testing.T) {
+	apiKeys := &mockAPIKeys{}
+	apiKeys.info.ProjectID[0] = 1
+
+	db := teststore.New()
+	service := pointerdb.NewService(zap.NewNop(), db)
+	s := pointerdb.NewServer(zap.NewNop(), service, nil, nil, pointerdb.Config{}, nil, apiKeys)
+
+	ctx := auth.WithAPIKey(context.Background(), []byte(console.APIKey{}.String()))
+	_, err := s.ProjectInfo(ctx, &pb.ProjectInfoRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	salts := &mockSalts{salts: map[uuid.UUID][]byte{}}
+	s.Salts = salts
+
+	resp, err := s.ProjectInfo(ctx, &pb.ProjectInfoRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.GetProjectSalt(), 32)
+	assert.Equal(t, salts.salts[apiKeys.info.ProjectID], resp.GetProjectSalt())
+
+	// the salt is random, not derived from the project id
+	derived := sha256.Sum256(apiKeys.info.ProjectID[:])
+	assert.NotEqual(t, derived[:], resp.GetProjectSalt())
+
+	// the stored salt is returned again
+	again, err := s.ProjectInfo(ctx, &pb.ProjectInfoRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, resp.GetProjectSalt(), again.GetProjectSalt())
+
+	// other projects get other salts
+	apiKeys.info.ProjectID[0] = 2
+	other, err := s.ProjectInfo(ctx, &pb.ProjectInfoRequest{})
+	require.NoError(t, err)
+	assert.NotEqual(t, resp.GetProjectSalt(), other.GetProjectSalt())
+
+	ctx = auth.WithAPIKey(context.Background(), []byte("wrong key"))
+	_, err = s.ProjectInfo(ctx, &pb.ProjectInfoRequest{})
+	assert.EqualError(t, err, status.Errorf(codes.Unauthenticated, "Invalid API credential").Error())
+}
+
+func TestServiceListFiltered(t *testing.T) {
+	apiKeys := &mockAPIKeys{}
+
+	db := teststore.New()
+	service := pointerdb.NewService(zap.NewNop(), db)
+	server := pointerdb.NewServer(zap.NewNop(), service, nil, nil, pointerdb.Config{}, nil, apiKeys)
+
+	now := time.Now()
+	put := func(path string, size int64, created time.Time) {
+		creationDate, err := ptypes.TimestampProto(created)
+		assert.NoError(t, err)
+
+		streamMeta, err := proto.Marshal(&pb.StreamMeta{StreamSize: size})
+		assert.NoError(t, err)
+
+		// sizes are filtered by the object size, not by the size of the last segment
+		pointerBytes, err := proto.Marshal(&pb.Pointer{SegmentSize: 1, CreationDate: creationDate, Metadata: streamMeta})
+		assert.NoError(t, err)
+
+		key := storage.Key(storj.JoinPaths(apiKeys.info.ProjectID.String(), path))
+		assert.NoError(t, db.Put(key, pointerBytes))
+	}
+
+	put("dir/nested", 10, now)
+	put("old-small", 10, now.Add(-48*time.Hour))
+	put("old-large", 1000, now.Add(-48*time.Hour))
+	put("new-small", 10, now)
+	put("new-large", 1000, now)
+
+	dayAgo, err := ptypes.TimestampProto(now.Add(-24 * time.Hour))
+	assert.NoError(t, err)
+
+	for i, tt := range []struct {
+		request  pb.ListRequest
+		expected []string
+	}{
+		{pb.ListRequest{}, []string{"dir/", "new-large", "new-small", "old-large", "old-small"}},
+		{pb.ListRequest{MinSize: 100}, []string{"dir/", "new-large", "old-large"}},
+		{pb.ListRequest{MaxSize: 100}, []string{"dir/", "new-small", "old-small"}},
+		{pb.ListRequest{ModifiedAfter: dayAgo}, []string{"dir/", "new-large", "new-small"}},
+		{pb.ListRequest{ModifiedBefore: dayAgo, MinSize: 100}, []string{"dir/", "old-large"}},
+		{pb.ListRequest{Recursive: true, ModifiedBefore: dayAgo}, []string{"old-large", "old-small"}},
+	} {
+		ctx := auth.WithAPIKey(context.Background(), []byte(console.APIKey{}.String()))
+
+		resp, err := server.List(ctx, &tt.request)
+		assert.NoError(t, err, i)
+
+		var paths []string
+		for _, item := range resp.GetItems() {
+			paths = append(paths, item.Path)
+		}
+		assert.Equal(t, tt.expected, paths, i)
+	}
+
+	// the filter is applied before the limit, so only the last page is short
+	for i, tt := range []struct {
+		request  pb.ListRequest
+		expected []string
+		more     bool
+	}{
+		{pb.ListRequest{Recursive: true, MaxSize: 100, Limit: 2}, []string{"dir/nested", "new-small"}, true},
+		{pb.ListRequest{Recursive: true, MaxSize: 100, Limit: 2, StartAfter: "new-small"}, []string{"old-small"}, false},
+		{pb.ListRequest{Recursive: true, MaxSize: 100, Limit: 2, EndBefore: "old-small"}, []string{"dir/nested", "new-small"}, false},
+	} {
+		ctx := auth.WithAPIKey(context.Background(), []byte(console.APIKey{}.String()))
+
+		resp, err := server.List(ctx, &tt.request)
+		assert.NoError(t, err, i)
+
+		var paths []string
+		for _, item := range resp.GetItems() {
+			paths = append(paths, item.Path)
+		}
+		assert.Equal(t, tt.expected, paths, i)
+		assert.Equal(t, tt.more, resp.GetMore(), i)
+	}
+
+	// the keys scanned for a page are limited, so the pages may be short or
+	// empty and the listing continues at the cursor
+	service.ScanLimit = 2
+	for i, tt := range []struct {
+		request  pb.ListRequest
+		expected []string
+		more     bool
+		cursor   string
+	}{
+		{pb.ListRequest{Recursive: true, MinSize: 100}, []string{"new-large"}, true, "new-large"},
+		{pb.ListRequest{Recursive: true, MinSize: 100, StartAfter: "new-large"}, []string{"old-large"}, true, "old-large"},
+		{pb.ListRequest{Recursive: true, MinSize: 100, StartAfter: "old-large"}, nil, false, ""},
+		{pb.ListRequest{Recursive: true, MinSize: 100, ModifiedAfter: dayAgo, StartAfter: "new-large"}, nil, true, "old-large"},
+		{pb.ListRequest{Recursive: true, MinSize: 100, EndBefore: "old-small"}, []string{"old-large"}, true, "new-small"},
+	} {
+		ctx := auth.WithAPIKey(context.Background(), []byte(console.APIKey{}.String()))
+
+		resp, err := server.List(ctx, &tt.request)
+		assert.NoError(t, err, i)
+
+		var paths []string
+		for _, item := range resp.GetItems() {
+			paths = append(paths, item.Path)
+		}
+		assert.Equal(t, tt.expected, paths, i)
+		assert.Equal(t, tt.more, resp.GetMore(), i)
+		assert.Equal(t, tt.cursor, resp.GetCursor(), i)
+	}
+}
+
+func TestServiceListPages(t *testing.T) {
+	db := teststore.New()
+	service := pointerdb.NewService(zap.NewNop(), db)
+
+	pointerBytes, err := proto.Marshal(&pb.Pointer{SegmentSize: 10})
+	assert.NoError(t, err)
+
+	var expected []string
+	for i := 0; i < 10; i++ {
+		path := fmt.Sprintf("object%d", i)
+		assert.NoError(t, db.Put(storage.Key(storj.JoinPaths("project", path)), pointerBytes))
+		expected = append(expected, path)
+	}
+
+	var forward []string
+	pages := 0
+	err = service.ListPages("project", "", "", true, 3, meta.None, pointerdb.ListFilter{},
+		func(items []*pb.ListResponse_Item, more bool) error {
+			pages++
+			for _, item := range items {
+				forward = append(forward, item.Path)
+			}
+			return nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, expected, forward)
+	assert.Equal(t, 4, pages)
+
+	var backward []string
+	err = service.ListPages("project", "", "object9", true, 3, meta.None, pointerdb.ListFilter{},
+		func(items []*pb.ListResponse_Item, more bool) error {
+			var page []string
+			for _, item := range items {
+				page = append(page, item.Path)
+			}
+			backward = append(page, backward...)
+			return nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, expected[:9], backward)
+
+	// pages without matching items still advance the listing
+	var filtered []string
+	err = service.ListPages("project", "", "", true, 3, meta.None, pointerdb.ListFilter{MinSize: 100},
+		func(items []*pb.ListResponse_Item, more bool) error {
+			for _, item := range items {
+				filtered = append(filtered, item.Path)
+			}
+			return nil
+		})
+	assert.NoError(t, err)
+	assert.Empty(t, filtered)
+
+	failure := errors.New("failure")
+	err = service.ListPages("project", "", "", true, 3, meta.None, pointerdb.ListFilter{},
+		func(items []*pb.ListResponse_Item, more bool) error {
+			return failure
+		})
+	assert.Equal(t, failure, err)
+}
+
+func TestServiceDeletePrefix(t *testing.T) {
+	db := teststore.New()
+	service := pointerdb.NewService(zap.NewNop(), db)
+
+	inline := &pb.Pointer{Type: pb.Pointer_INLINE}
+	remote := &pb.Pointer{Type: pb.Pointer_REMOTE, Remote: &pb.RemoteSegment{PieceId: "piece"}}
+	for path, pointer := range map[string]*pb.Pointer{
+		"project/l/bucket/dir/a":      inline,
+		"project/l/bucket/dir/sub/b":  remote,
+		"project/s0/bucket/dir/sub/b": remote,
+		"project/s1/bucket/dir/sub/b": remote,
+		"project/l/bucket/dirty":      inline,
+		"project/l/bucket/other/c":    inline,
+		"project/l/other/dir/d":       inline,
+		"another/l/bucket/dir/e":      inline,
+	} {
+		assert.NoError(t, service.Put(path, pointer))
+	}
+
+	_, err := service.DeletePrefix("project", "", 0)
+	assert.Error(t, err)
+
+	// the segments are deleted in pages of the limit
+	deletion, err := service.DeletePrefix("project", "bucket/dir/", 3)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, deletion.Objects)
+	assert.EqualValues(t, 3, deletion.Segments)
+	assert.Len(t, deletion.Remote, 2)
+	assert.True(t, deletion.More)
+
+	deletion, err = service.DeletePrefix("project", "bucket/dir/", 3)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, deletion.Objects)
+	assert.EqualValues(t, 1, deletion.Segments)
+	require.Len(t, deletion.Remote, 1)
+	assert.Equal(t, "piece", deletion.Remote[0].GetRemote().GetPieceId())
+	assert.False(t, deletion.More)
+
+	keys, err := storage.ListKeys(db, nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.Keys{
+		storage.Key("another/l/bucket/dir/e"),
+		storage.Key("project/l/bucket/dirty"),
+		storage.Key("project/l/bucket/other/c"),
+		storage.Key("project/l/other/dir/d"),
+	}, keys)
+}
+
+func TestServerDeletePrefix(t *testing.T) {
+	apiKeys := &mockAPIKeys{}
+
+	db := teststore.New()
+	service := pointerdb.NewService(zap.NewNop(), db)
+	s := pointerdb.NewServer(zap.NewNop(), service, nil, nil, pointerdb.Config{}, nil, apiKeys)
+
+	ctx := auth.WithAPIKey(context.Background(), []byte(console.APIKey{}.String()))
+	for _, path := range []string{"l/bucket/a", "l/bucket/dir/b"} {
+		_, err := s.Put(ctx, &pb.PutRequest{Path: path, Pointer: &pb.Pointer{Type: pb.Pointer_INLINE}})
+		require.NoError(t, err)
+	}
+
+	// deleting every object of a bucket has to be requested explicitly
+	_, err := s.DeletePrefix(ctx, &pb.DeletePrefixRequest{Prefix: "bucket"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := s.DeletePrefix(ctx, &pb.DeletePrefixRequest{Prefix: "bucket/dir"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, resp.GetDeletedObjects())
+	assert.Empty(t, resp.GetRemotePointers())
+	assert.Nil(t, resp.GetAuthorization())
+
+	resp, err = s.DeletePrefix(ctx, &pb.DeletePrefixRequest{Prefix: "bucket", WholeBucket: true})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, resp.GetDeletedObjects())
+	assert.False(t, resp.GetMore())
+}
+
+// mockSelection records the requests for selecting nodes
+type mockSelection struct {
+	requests []*pb.FindStorageNodesRequest
+	nodes    []*pb.Node
+}
+
+// FindStorageNodes returns the configured nodes
+func (selection *mockSelection) FindStorageNodes(ctx context.Context, req *pb.FindStorageNodesRequest) (*pb.FindStorageNodesResponse, error) {
+	selection.requests = append(selection.requests, req)
+	return &pb.FindStorageNodesResponse{Nodes: selection.nodes}, nil
+}
+
+func TestServiceSelectNodes(t *testing.T) {
+	apiKeys := &mockAPIKeys{}
+
+	db := teststore.New()
+	service := pointerdb.NewService(zap.NewNop(), db)
+	s := pointerdb.NewServer(zap.NewNop(), service, nil, nil, pointerdb.Config{}, nil, apiKeys)
+
+	ctx := auth.WithAPIKey(context.Background(), []byte(console.APIKey{}.String()))
+	req := &pb.SelectNodesRequest{
+		Redundancy:    &pb.RedundancyScheme{MinReq: 2, Total: 4},
+		SegmentSize:   4096,
+		ExcludedNodes: []storj.NodeID{{3}},
+	}
+
+	_, err := s.SelectNodes(ctx, req)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	selection := &mockSelection{nodes: []*pb.Node{{Id: storj.NodeID{1}}, {Id: storj.NodeID{2}}}}
+	s.Selection = selection
+
+	resp, err := s.SelectNodes(ctx, req)
+	if assert.NoError(t, err) {
+		assert.Equal(t, selection.nodes, resp.GetNodes())
+	}
+	if assert.Len(t, selection.requests, 1) {
+		opts := selection.requests[0].GetOpts()
+		assert.EqualValues(t, 4, opts.GetAmount())
+		assert.EqualValues(t, 1024, opts.GetRestrictions().GetFreeDisk())
+		assert.EqualValues(t, 1024, opts.GetRestrictions().GetFreeBandwidth())
+		assert.Equal(t, req.ExcludedNodes, opts.ExcludedNodes)
+	}
+
+	_, err = s.SelectNodes(ctx, &pb.SelectNodesRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ctx = auth.WithAPIKey(context.Background(), []byte("wrong key"))
+	_, err = s.SelectNodes(ctx, req)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// mockQuotas stores prefix quotas in memory
+type mockQuotas struct {
+	quotas []pointerdb.PrefixQuota
+}
+
+// Set adds or replaces the quota of a prefix
+func (quotas *mockQuotas) Set(ctx context.Context, quota pointerdb.PrefixQuota) error {
+	if err := quotas.Delete(ctx, quota.ProjectID, quota.Bucket, quota.Prefix); err != nil {
+		return err
+	}
+	quotas.quotas = append(quotas.quotas, quota)
+	return nil
+}
+
+// Delete removes the quota of a prefix
+func (quotas *mockQuotas) Delete(ctx context.Context, projectID uuid.UUID, bucket string, prefix string) error {
+	var kept []pointerdb.PrefixQuota
+	for _, quota := range quotas.quotas {
+		if quota.ProjectID != projectID || quota.Bucket != bucket || quota.Prefix != prefix {
+			kept = append(kept, quota)
+		}
+	}
+	quotas.quotas = kept
+	return nil
+}
+
+// GetAll returns the quotas of all prefixes of a bucket
+func (quotas *mockQuotas) GetAll(ctx context.Context, projectID uuid.UUID, bucket string) ([]pointerdb.PrefixQuota, error) {
+	var all []pointerdb.PrefixQuota
+	for _, quota := range quotas.quotas {
+		if quota.ProjectID == projectID && quota.Bucket == bucket {
+			all = append(all, quota)
+		}
+	}
+	return all, nil
+}
+
+func TestServicePrefixQuotas(t *testing.T) {
+	apiKeys := &mockAPIKeys{}
+
+	db := teststore.New()
+	service := pointerdb.NewService(zap.NewNop(), db)
+	s := pointerdb.NewServer(zap.NewNop(), service, nil, nil, pointerdb.Config{}, nil, apiKeys)
+	s.Quotas = &mockQuotas{}
+
+	ctx := auth.WithAPIKey(context.Background(), []byte(console.APIKey{}.String()))
+
+	_, err := s.SetPrefixQuota(ctx, &pb.SetPrefixQuotaRequest{Bucket: "bucket", Prefix: "/", MaxBytes: 100})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.SetPrefixQuota(ctx, &pb.SetPrefixQuotaRequest{Bucket: "bucket", Prefix: "/tenant/", MaxBytes: 100, MaxObjects: 2})
+	assert.NoError(t, err)
+
+	for i, tt := range []struct {
+		path string
+		size int64
+		code codes.Code
+	}{
+		{"l/bucket/tenant/a", 40, codes.OK},
+		{"s0/bucket/tenant/b", 40, codes.OK},
+		{"l/bucket/tenant/b", 40, codes.ResourceExhausted}, // 120 bytes
+		{"l/bucket/tenant/a", 50, codes.OK},                // overwriting replaces the 40 bytes
+		{"l/bucket/tenant/c", 5, codes.OK},
+		{"l/bucket/tenant/d", 1, codes.ResourceExhausted}, // 3 objects
+		{"l/bucket/tenants/e", 1000, codes.OK},
+		{"l/other/tenant/f", 1000, codes.OK},
+	} {
+		_, err := s.Put(ctx, &pb.PutRequest{Path: tt.path, Pointer: &pb.Pointer{SegmentSize: tt.size}})
+		assert.Equal(t, tt.code, status.Code(err), fmt.Sprintf("Test case #%d", i))
+	}
+
+	resp, err := s.GetPrefixQuotas(ctx, &pb.GetPrefixQuotasRequest{Bucket: "bucket"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.PrefixQuota{{
+		Prefix:      "tenant",
+		MaxBytes:    100,
+		MaxObjects:  2,
+		UsedBytes:   95,
+		UsedObjects: 2,
+	}}, resp.GetQuotas())
+
+	// removing the limits removes the quota
+	_, err = s.SetPrefixQuota(ctx, &pb.SetPrefixQuotaRequest{Bucket: "bucket", Prefix: "tenant"})
+	assert.NoError(t, err)
+	resp, err = s.GetPrefixQuotas(ctx, &pb.GetPrefixQuotasRequest{Bucket: "bucket"})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetQuotas())
+}
+
+// mockLocks stores bucket locks in memory
+type mockLocks struct {
+	locks []pointerdb.BucketLock
+}
+
+// Set adds or replaces the lock of a bucket
+func (locks *mockLocks) Set(ctx context.Context, lock pointerdb.BucketLock) error {
+	for i := range locks.locks {
+		if locks.locks[i].ProjectID == lock.ProjectID && locks.locks[i].Bucket == lock.Bucket {
+			locks.locks[i] = lock
+			return nil
+		}
+	}
+	locks.locks = append(locks.locks, lock)
+	return nil
+}
+
+// Get returns the lock of a bucket, it's nil when the bucket has none
+func (locks *mockLocks) Get(ctx context.Context, projectID uuid.UUID, bucket string) (*pointerdb.BucketLock, error) {
+	for _, lock := range locks.locks {
+		if lock.ProjectID == projectID && lock.Bucket == bucket {
+			return &lock, nil
+		}
+	}
+	return nil, nil
+}
+
+func TestServiceBucketLocks(t *testing.T) {
+	apiKeys := &mockAPIKeys{}
+
+	db := teststore.New()
+	service := pointerdb.NewService(zap.NewNop(), db)
+	s := pointerdb.NewServer(zap.NewNop(), service, nil, nil, pointerdb.Config{}, nil, apiKeys)
+	s.Locks = &mockLocks{}
+
+	ctx := auth.WithAPIKey(context.Background(), []byte(console.APIKey{}.String()))
+
+	put := func(path string) error {
+		_, err := s.Put(ctx, &pb.PutRequest{Path: path, Pointer: &pb.Pointer{}})
+		return err
+	}
+	del := func(path string) error {
+		_, err := s.Delete(ctx, &pb.DeleteRequest{Path: path})
+		return err
+	}
+
+	assert.NoError(t, put("l/bucket/a"))
+	assert.NoError(t, put("l/bucket/b"))
+
+	_, err := s.SetBucketLock(ctx, &pb.SetBucketLockRequest{Bucket: "bucket", Lock: &pb.BucketLock{RetentionPeriod: -1}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.SetBucketLock(ctx, &pb.SetBucketLockRequest{Bucket: "bucket", Lock: &pb.BucketLock{RetentionPeriod: 3600}})
+	assert.NoError(t, err)
+
+	resp, err := s.GetBucketLock(ctx, &pb.GetBucketLockRequest{Bucket: "bucket"})
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.BucketLock{RetentionPeriod: 3600}, resp.GetLock())
+
+	// retained objects can't be overwritten or deleted, new ones can be added
+	assert.Equal(t, codes.FailedPrecondition, status.Code(put("l/bucket/a")))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(del("l/bucket/a")))
+	assert.NoError(t, put("l/bucket/c"))
+	assert.NoError(t, put("l/other/a"))
+	assert.NoError(t, del("l/other/a"))
+
+	_, err = s.DeletePrefix(ctx, &pb.DeletePrefixRequest{Prefix: "bucket/", WholeBucket: true})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// the retention period can't be shortened
+	_, err = s.SetBucketLock(ctx, &pb.SetBucketLockRequest{Bucket: "bucket", Lock: &pb.BucketLock{RetentionPeriod: 60}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// a legal hold keeps objects regardless of their age
+	_, err = s.SetBucketLock(ctx, &pb.SetBucketLockRequest{Bucket: "other", Lock: &pb.BucketLock{LegalHold: true}})
+	assert.NoError(t, err)
+	assert.NoError(t, put("l/other/b"))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(del("l/other/b")))
+
+	_, err = s.SetBucketLock(ctx, &pb.SetBucketLockRequest{Bucket: "other", Lock: &pb.BucketLock{}})
+	assert.NoError(t, err)
+	assert.NoError(t, del("l/other/b"))
+
+	resp, err = s.GetBucketLock(ctx, &pb.GetBucketLockRequest{Bucket: "missing"})
+	assert.NoError(t, err)
+	assert.Nil(t, resp.GetLock())
+}
+
+func TestServiceObjectLimits(t *testing.T) {
+	apiKeys := &mockAPIKeys{}
+
+	db := teststore.New()
+	service := pointerdb.NewService(zap.NewNop(), db)
+	s := pointerdb.NewServer(zap.NewNop(), service, nil, nil, pointerdb.Config{}, nil, apiKeys)
+
+	for _, invalid := range []string{"2", "*:-1", "*:many", "project:2"} {
+		_, err := pointerdb.NewObjectLimits(zap.NewNop(), service, invalid, time.Hour)
+		assert.Error(t, err, invalid)
+	}
+
+	// the limit of the project overrides the default
+	objects, err := pointerdb.NewObjectLimits(zap.NewNop(), service, "*:5, 00000000-0000-0000-0000-000000000000:2", time.Hour)
+	require.NoError(t, err)
+	s.Objects = objects
+
+	ctx := auth.WithAPIKey(context.Background(), []byte(console.APIKey{}.String()))
+
+	put := func(path string) error {
+		_, err := s.Put(ctx, &pb.PutRequest{Path: path, Pointer: &pb.Pointer{}})
+		return err
+	}
+
+	// projects aren't limited until their objects have been counted
+	assert.NoError(t, put("l/bucket/x"))
+	assert.NoError(t, put("l/bucket/y"))
+	assert.NoError(t, put("l/bucket/z"))
+	_, counted := objects.Count(uuid.UUID{})
+	assert.False(t, counted)
+
+	_, err = s.DeletePrefix(ctx, &pb.DeletePrefixRequest{Prefix: "bucket/", WholeBucket: true})
+	require.NoError(t, err)
+	require.NoError(t, objects.Recount(ctx))
+
+	assert.NoError(t, put("l/bucket/a"))
+	assert.NoError(t, put("s0/bucket/b")) // segments aren't objects
+	assert.NoError(t, put("l/bucket/a"))  // overwriting doesn't add an object
+	assert.NoError(t, put("l/bucket/b"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(put("l/bucket/c")))
+
+	// deleting objects frees their counts
+	_, err = s.Delete(ctx, &pb.DeleteRequest{Path: "l/bucket/a"})
+	require.NoError(t, err)
+	assert.NoError(t, put("l/bucket/c"))
+
+	_, err = s.DeletePrefix(ctx, &pb.DeletePrefixRequest{Prefix: "bucket/", WholeBucket: true})
+	require.NoError(t, err)
+	assert.NoError(t, put("l/other/a"))
+	assert.NoError(t, put("l/other/b"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(put("l/other/c")))
+
+	count, counted := objects.Count(uuid.UUID{})
+	assert.True(t, counted)
+	assert.Equal(t, int64(2), count)
+
+	// recounting finds the same objects
+	require.NoError(t, objects.Recount(ctx))
+	count, _ = objects.Count(uuid.UUID{})
+	assert.Equal(t, int64(2), count)
+}
+
+func TestServerListStream(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 0, 1)
+	require.NoError(t, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	satellite := planet.Satellites[0]
+	project, err := satellite.DB.Console().Projects().Insert(ctx, &console.Project{Name: "testProject"})
+	require.NoError(t, err)
+
+	apiKey, err := console.CreateAPIKey()
+	require.NoError(t, err)
+	_, err = satellite.DB.Console().APIKeys().Create(ctx, *apiKey, console.APIKeyInfo{ProjectID: project.ID, Name: "testKey"})
+	require.NoError(t, err)
+
+	pdb, err := planet.Uplinks[0].DialPointerDB(satellite, apiKey.String())
+	require.NoError(t, err)
+
+	for _, path := range []string{"l/bucket/a", "l/bucket/b", "l/bucket/c"} {
+		err := pdb.Put(ctx, path, &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte(path)})
+		require.NoError(t, err)
+	}
+
+	// the api key is sent with the stream over grpc
+	var paths []string
+	err = pdb.ListStream(ctx, "l/bucket", true, 2, meta.None, func(items []pdbclient.ListItem) error {
+		for _, item := range items {
+			paths = append(paths, item.Path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, paths)
+
+	wrongKey, err := console.CreateAPIKey()
+	require.NoError(t, err)
+	wrong, err := planet.Uplinks[0].DialPointerDB(satellite, wrongKey.String())
+	require.NoError(t, err)
+
+	err = wrong.ListStream(ctx, "l/bucket", true, 2, meta.None, func(items []pdbclient.ListItem) error {
+		return nil
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+)
+
+// ErrQuotaExceeded is returned when committing a segment would exceed a prefix quota
+var ErrQuotaExceeded = errs.Class("prefix quota exceeded")
+
+// PrefixQuota limits the bytes and objects stored under a prefix of a
+// bucket. Zero limits are unlimited.
+//
+// The prefix is matched against the stored paths, so for buckets with path
+// encryption it has to be the encrypted prefix.
+type PrefixQuota struct {
+	ProjectID  uuid.UUID
+	Bucket     string
+	Prefix     string
+	MaxBytes   int64
+	MaxObjects int64
+}
+
+// PrefixQuotas stores the prefix quotas of buckets
+type PrefixQuotas interface {
+	// Set adds or replaces the quota of a prefix
+	Set(ctx context.Context, quota PrefixQuota) error
+	// Delete removes the quota of a prefix
+	Delete(ctx context.Context, projectID uuid.UUID, bucket string, prefix string) error
+	// GetAll returns the quotas of all prefixes of a bucket
+	GetAll(ctx context.Context, projectID uuid.UUID, bucket string) ([]PrefixQuota, error)
+}
+
+// cleanPrefix removes the leading and trailing delimiters of a prefix
+func cleanPrefix(prefix string) string {
+	return strings.Trim(prefix, string(storage.Delimiter))
+}
+
+// hasPathPrefix returns true when path is prefix or under prefix
+func hasPathPrefix(path, prefix string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+string(storage.Delimiter))
+}
+
+// PrefixUsage returns the bytes of all segments and the number of objects
+// stored under prefix in a bucket of the project.
+func (s *Service) PrefixUsage(project string, bucket string, prefix string) (bytes int64, objects int64, err error) {
+	indexes, err := s.segmentIndexes(project)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, index := range indexes {
+		err := s.DB.Iterate(storage.IterateOptions{Prefix: storage.Key(index.String() + bucket + "/" + prefix + "/"), Recurse: true},
+			func(it storage.Iterator) error {
+				var item storage.ListItem
+				for it.Next(&item) {
+					pointer := &pb.Pointer{}
+					if err := proto.Unmarshal(item.Value, pointer); err != nil {
+						return err
+					}
+					bytes += pointer.GetSegmentSize()
+					if index.String() == project+"/l/" {
+						objects++
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return bytes, objects, nil
+}
+
+// segmentIndexes returns the keys of the segment indexes of a project,
+// e.g. "<project>/l/" or "<project>/s0/"
+func (s *Service) segmentIndexes(project string) (indexes []storage.Key, err error) {
+	err = s.DB.Iterate(storage.IterateOptions{Prefix: storage.Key(project + "/")},
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				if item.IsPrefix {
+					indexes = append(indexes, storage.CloneKey(item.Key))
+				}
+			}
+			return nil
+		})
+	return indexes, err
+}
+
+// checkQuotas returns an error when storing pointer at path would exceed a
+// quota of the prefixes containing the path. Usage is counted from the
+// stored pointers on every commit, so quotas are meant for prefixes holding
+// a moderate number of segments.
+func (s *Server) checkQuotas(ctx context.Context, projectID uuid.UUID, path string, pointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if s.Quotas == nil {
+		return nil
+	}
+
+	// path is <segment index>/<bucket>/<encrypted path>
+	parts := strings.SplitN(path, "/", 3)
+	if len(parts) < 3 {
+		return nil
+	}
+	index, bucket, objectPath := parts[0], parts[1], parts[2]
+
+	quotas, err := s.Quotas.GetAll(ctx, projectID, bucket)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	project := projectID.String()
+	for _, quota := range quotas {
+		prefix := cleanPrefix(quota.Prefix)
+		if !hasPathPrefix(objectPath, prefix) {
+			continue
+		}
+
+		usedBytes, usedObjects, err := s.service.PrefixUsage(project, bucket, prefix)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		addedBytes, addedObjects := pointer.GetSegmentSize(), int64(0)
+		if index == "l" {
+			addedObjects = 1
+		}
+
+		// overwriting a segment replaces its usage
+		existing, err := s.service.Get(project + "/" + path)
+		switch {
+		case err == nil:
+			addedBytes -= existing.GetSegmentSize()
+			addedObjects = 0
+		case !storage.ErrKeyNotFound.Has(err):
+			return Error.Wrap(err)
+		}
+
+		if quota.MaxBytes > 0 && addedBytes > 0 && usedBytes+addedBytes > quota.MaxBytes {
+			return ErrQuotaExceeded.New("prefix %q would store %d of %d bytes", prefix, usedBytes+addedBytes, quota.MaxBytes)
+		}
+		if quota.MaxObjects > 0 && addedObjects > 0 && usedObjects+addedObjects > quota.MaxObjects {
+			return ErrQuotaExceeded.New("prefix %q would store %d of %d objects", prefix, usedObjects+addedObjects, quota.MaxObjects)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"crypto/rand"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// saltSize is the size of the generated project salts
+const saltSize = 32
+
+// ProjectSalts stores the salts, which the root encryption keys of projects
+// are derived with
+type ProjectSalts interface {
+	// GetOrCreate returns the salt of a project, the given salt is stored
+	// when the project has none yet
+	GetOrCreate(ctx context.Context, projectID uuid.UUID, salt []byte) ([]byte, error)
+}
+
+// projectSalt returns the salt of the project, a random salt is generated for
+// projects without one
+func (s *Server) projectSalt(ctx context.Context, projectID uuid.UUID) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	salt, err = s.Salts.GetOrCreate(ctx, projectID, salt)
+	return salt, Error.Wrap(err)
+}
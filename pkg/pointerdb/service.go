@@ -4,6 +4,9 @@
 package pointerdb
 
 import (
+	"strings"
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/zeebo/errs"
@@ -14,15 +17,21 @@ import (
 	"storj.io/storj/storage"
 )
 
+// defaultScanLimit is the default number of keys scanned for a page of a
+// filtered listing
+const defaultScanLimit = 10 * storage.LookupLimit
+
 // Service structure
 type Service struct {
 	logger *zap.Logger
 	DB     storage.KeyValueStore
+	// ScanLimit is the maximum number of keys scanned for a page of a filtered listing
+	ScanLimit int
 }
 
 // NewService creates new pointerdb service
 func NewService(logger *zap.Logger, db storage.KeyValueStore) *Service {
-	return &Service{logger: logger, DB: db}
+	return &Service{logger: logger, DB: db, ScanLimit: defaultScanLimit}
 }
 
 // Put puts pointer to db under specific path
@@ -61,9 +70,121 @@ func (s *Service) Get(path string) (pointer *pb.Pointer, err error) {
 	return pointer, nil
 }
 
+// ListFilter restricts listed items by their unencrypted metadata, zero values are ignored
+type ListFilter struct {
+	MinSize        int64
+	MaxSize        int64
+	ModifiedAfter  time.Time
+	ModifiedBefore time.Time
+}
+
+// IsZero returns true when the filter doesn't restrict anything
+func (filter ListFilter) IsZero() bool {
+	return filter == ListFilter{}
+}
+
+// Match returns true when the pointer of the last segment of an object
+// satisfies the filter
+func (filter ListFilter) Match(pointer *pb.Pointer) bool {
+	if filter.MinSize > 0 || filter.MaxSize > 0 {
+		size := objectSize(pointer)
+		if filter.MinSize > 0 && size < filter.MinSize {
+			return false
+		}
+		if filter.MaxSize > 0 && size > filter.MaxSize {
+			return false
+		}
+	}
+	if !filter.ModifiedAfter.IsZero() || !filter.ModifiedBefore.IsZero() {
+		modified, err := ptypes.Timestamp(pointer.GetCreationDate())
+		if err != nil {
+			return false
+		}
+		if !filter.ModifiedAfter.IsZero() && !modified.After(filter.ModifiedAfter) {
+			return false
+		}
+		if !filter.ModifiedBefore.IsZero() && !modified.Before(filter.ModifiedBefore) {
+			return false
+		}
+	}
+	return true
+}
+
+// objectSize returns the size of the object, which the uplink stores
+// unencrypted in the stream metadata of the last segment. Objects uploaded
+// before the size was stored have a size of zero.
+func objectSize(pointer *pb.Pointer) int64 {
+	streamMeta := pb.StreamMeta{}
+	if err := proto.Unmarshal(pointer.GetMetadata(), &streamMeta); err != nil {
+		return 0
+	}
+	return streamMeta.GetStreamSize()
+}
+
 // List returns all Path keys in the pointers bucket
 func (s *Service) List(prefix string, startAfter string, endBefore string, recursive bool, limit int32,
 	metaFlags uint32) (items []*pb.ListResponse_Item, more bool, err error) {
+	items, more, _, err = s.ListFiltered(prefix, startAfter, endBefore, recursive, limit, metaFlags, ListFilter{})
+	return items, more, err
+}
+
+// ListFiltered returns Path keys in the pointers bucket which match the filter.
+// The filter is applied before limit, but at most ScanLimit keys are scanned,
+// so a page, which isn't the last one, may contain fewer items than the limit
+// or none at all. The listing continues at the returned cursor.
+func (s *Service) ListFiltered(prefix string, startAfter string, endBefore string, recursive bool, limit int32,
+	metaFlags uint32, filter ListFilter) (items []*pb.ListResponse_Item, more bool, cursor string, err error) {
+	items, rawItems, more, err := s.listPage(prefix, startAfter, endBefore, recursive, limit, metaFlags, filter)
+	if err != nil {
+		return nil, false, "", err
+	}
+	if more {
+		cursor = listCursor(rawItems, endBefore != "")
+	}
+	return items, more, cursor, nil
+}
+
+// ListPages calls fn with every page of the listing until it is exhausted or fn fails.
+// When endBefore is set, the pages are listed backwards.
+func (s *Service) ListPages(prefix string, startAfter string, endBefore string, recursive bool, limit int32,
+	metaFlags uint32, filter ListFilter, fn func(items []*pb.ListResponse_Item, more bool) error) error {
+	reverse := endBefore != ""
+	for {
+		items, rawItems, more, err := s.listPage(prefix, startAfter, endBefore, recursive, limit, metaFlags, filter)
+		if err != nil {
+			return err
+		}
+		if err := fn(items, more); err != nil {
+			return err
+		}
+		if !more || len(rawItems) == 0 {
+			return nil
+		}
+
+		if reverse {
+			endBefore = listCursor(rawItems, reverse)
+		} else {
+			startAfter = listCursor(rawItems, reverse)
+		}
+	}
+}
+
+// listCursor returns the key at which the listing continues after the
+// scanned items, which are in ascending order
+func listCursor(rawItems storage.Items, reverse bool) string {
+	if len(rawItems) == 0 {
+		return ""
+	}
+	if reverse {
+		return rawItems[0].Key.String()
+	}
+	return rawItems[len(rawItems)-1].Key.String()
+}
+
+// listPage returns the filtered items together with all the items which were
+// scanned for them, both in ascending order
+func (s *Service) listPage(prefix string, startAfter string, endBefore string, recursive bool, limit int32,
+	metaFlags uint32, filter ListFilter) (items []*pb.ListResponse_Item, rawItems storage.Items, more bool, err error) {
 
 	var prefixKey storage.Key
 	if prefix != "" {
@@ -73,22 +194,93 @@ func (s *Service) List(prefix string, startAfter string, endBefore string, recur
 		}
 	}
 
-	rawItems, more, err := storage.ListV2(s.DB, storage.ListOptions{
-		Prefix:       prefixKey,
-		StartAfter:   storage.Key(startAfter),
-		EndBefore:    storage.Key(endBefore),
-		Recursive:    recursive,
-		Limit:        int(limit),
-		IncludeValue: metaFlags != meta.None,
-	})
-	if err != nil {
-		return nil, false, err
+	if filter.IsZero() {
+		rawItems, more, err = storage.ListV2(s.DB, storage.ListOptions{
+			Prefix:       prefixKey,
+			StartAfter:   storage.Key(startAfter),
+			EndBefore:    storage.Key(endBefore),
+			Recursive:    recursive,
+			Limit:        int(limit),
+			IncludeValue: metaFlags != meta.None,
+		})
+		if err != nil {
+			return nil, nil, false, err
+		}
+		for _, rawItem := range rawItems {
+			items = append(items, s.createListItem(rawItem, metaFlags))
+		}
+		return items, rawItems, more, nil
 	}
 
-	for _, rawItem := range rawItems {
-		items = append(items, s.createListItem(rawItem, metaFlags))
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
 	}
-	return items, more, nil
+
+	// the keys are scanned in listing order until the page is full of
+	// matching items or ScanLimit keys have been scanned
+	reverse := endBefore != ""
+	for {
+		batch := int(limit)
+		if remaining := s.ScanLimit - len(rawItems); s.ScanLimit > 0 && remaining < batch {
+			batch = remaining
+		}
+
+		scanned, scannedMore, err := storage.ListV2(s.DB, storage.ListOptions{
+			Prefix:       prefixKey,
+			StartAfter:   storage.Key(startAfter),
+			EndBefore:    storage.Key(endBefore),
+			Recursive:    recursive,
+			Limit:        batch,
+			IncludeValue: true,
+		})
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if reverse {
+			scanned = storage.ReverseItems(scanned)
+		}
+
+		for i, rawItem := range scanned {
+			rawItems = append(rawItems, rawItem)
+
+			if !rawItem.IsPrefix {
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(rawItem.Value, pointer); err != nil {
+					return nil, nil, false, err
+				}
+				if !filter.Match(pointer) {
+					continue
+				}
+			}
+			items = append(items, s.createListItem(rawItem, metaFlags))
+
+			if len(items) >= int(limit) {
+				more = scannedMore || i < len(scanned)-1
+				break
+			}
+		}
+
+		if len(items) >= int(limit) || !scannedMore || len(scanned) == 0 {
+			break
+		}
+		if s.ScanLimit > 0 && len(rawItems) >= s.ScanLimit {
+			more = true
+			break
+		}
+		if reverse {
+			endBefore = scanned[len(scanned)-1].Key.String()
+		} else {
+			startAfter = scanned[len(scanned)-1].Key.String()
+		}
+	}
+
+	if reverse {
+		rawItems = storage.ReverseItems(rawItems)
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+	return items, rawItems, more, nil
 }
 
 // createListItem creates a new list item with the given path. It also adds
@@ -146,6 +338,95 @@ func (s *Service) Delete(path string) (err error) {
 	return s.DB.Delete([]byte(path))
 }
 
+// PrefixDeletion is the result of deleting the pointers under a prefix
+type PrefixDeletion struct {
+	Objects  int64
+	Segments int64
+	// Remote are the deleted pointers of remote segments, whose pieces are
+	// still stored on the storage nodes
+	Remote []*pb.Pointer
+	// More is true, when the limit was reached before every segment was deleted
+	More bool
+}
+
+// DeletePrefix deletes the pointers of up to limit segments stored under prefix
+// in the project. A limit of 0 or above storage.LookupLimit deletes up to
+// storage.LookupLimit segments.
+func (s *Service) DeletePrefix(project string, prefix string, limit int) (deletion PrefixDeletion, err error) {
+	prefix = strings.Trim(prefix, string(storage.Delimiter))
+	if prefix == "" {
+		return deletion, Error.New("empty prefix")
+	}
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+
+	// the first path component after the project is the segment index, e.g. "l" or "s0"
+	indexes, err := s.segmentIndexes(project)
+	if err != nil {
+		return deletion, err
+	}
+
+	for _, index := range indexes {
+		items, more, err := s.deleteAll(storage.Key(index.String()+prefix+"/"), limit-int(deletion.Segments))
+		for _, item := range items {
+			deletion.Segments++
+			if index.String() == project+"/l/" {
+				deletion.Objects++
+			}
+
+			pointer := &pb.Pointer{}
+			if err := proto.Unmarshal(item.Value, pointer); err != nil {
+				s.logger.Warn("err unmarshaling deleted pointer", zap.Stringer("path", item.Key), zap.Error(err))
+				continue
+			}
+			if pointer.GetType() == pb.Pointer_REMOTE {
+				deletion.Remote = append(deletion.Remote, pointer)
+			}
+		}
+		if err != nil {
+			return deletion, err
+		}
+		if more {
+			deletion.More = true
+			return deletion, nil
+		}
+	}
+	return deletion, nil
+}
+
+// deleteAll deletes up to limit items under prefix and returns them. more is
+// true, when there are items left under prefix.
+func (s *Service) deleteAll(prefix storage.Key, limit int) (deleted storage.Items, more bool, err error) {
+	var items storage.Items
+	err = s.DB.Iterate(storage.IterateOptions{Prefix: prefix, Recurse: true},
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				if len(items) >= limit {
+					more = true
+					return nil
+				}
+				items = append(items, storage.ListItem{
+					Key:   storage.CloneKey(item.Key),
+					Value: storage.CloneValue(item.Value),
+				})
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, item := range items {
+		if err := s.DB.Delete(item.Key); err != nil {
+			return deleted, false, err
+		}
+		deleted = append(deleted, item)
+	}
+	return deleted, more, nil
+}
+
 // Iterate iterates over items in db
 func (s *Service) Iterate(prefix string, first string, recurse bool, reverse bool, f func(it storage.Iterator) error) (err error) {
 	opts := storage.IterateOptions{
@@ -0,0 +1,137 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pricing
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// ratesRequest is the body of a request for changing the rates of a tier
+type ratesRequest struct {
+	Storage int64 `json:"storage"`
+	Egress  int64 `json:"egress"`
+}
+
+// projectTier is the tier of a project
+type projectTier struct {
+	ProjectID uuid.UUID `json:"projectId"`
+	Tier      Tier      `json:"tier"`
+}
+
+// ServeHTTP implements the pricing admin api:
+//
+//	GET /tiers                                      lists the rates of the tiers
+//	PUT /tiers/<tier>                               changes the rates of a tier, see ratesRequest
+//	GET /projects/<id>                              returns the tier of a project
+//	PUT /projects/<id>?tier=<tier>                  assigns a tier to a project
+//	GET /projects/<id>/invoice?storage=&egress=     charges the usage in bytes with the project's rates
+func (service *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "tiers":
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		rates, err := service.ListRates(ctx)
+		if err != nil {
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+		writeJSON(w, http.StatusOK, rates)
+
+	case len(parts) == 2 && parts[0] == "tiers":
+		if r.Method != http.MethodPut {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var request ratesRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rates := Rates{Tier: Tier(parts[1]), Storage: request.Storage, Egress: request.Egress}
+		if err := service.SetRates(ctx, rates); err != nil {
+			http.Error(w, err.Error(), errorStatus(err))
+			return
+		}
+		writeJSON(w, http.StatusOK, rates)
+
+	case (len(parts) == 2 || len(parts) == 3 && parts[2] == "invoice") && parts[0] == "projects":
+		projectID, err := uuid.Parse(parts[1])
+		if err != nil {
+			http.Error(w, "invalid project id: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		switch {
+		case len(parts) == 3 && r.Method == http.MethodGet:
+			service.serveInvoice(w, r, *projectID)
+		case len(parts) == 2 && r.Method == http.MethodGet:
+			tier, err := service.ProjectTier(ctx, *projectID)
+			if err != nil {
+				http.Error(w, err.Error(), errorStatus(err))
+				return
+			}
+			writeJSON(w, http.StatusOK, projectTier{ProjectID: *projectID, Tier: tier})
+		case len(parts) == 2 && r.Method == http.MethodPut:
+			tier := Tier(r.URL.Query().Get("tier"))
+			if err := service.AssignProject(ctx, *projectID, tier); err != nil {
+				http.Error(w, err.Error(), errorStatus(err))
+				return
+			}
+			writeJSON(w, http.StatusOK, projectTier{ProjectID: *projectID, Tier: tier})
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// serveInvoice answers with the invoice of the usage given in the query
+func (service *Service) serveInvoice(w http.ResponseWriter, r *http.Request, projectID uuid.UUID) {
+	var usage Usage
+	for name, value := range map[string]*int64{"storage": &usage.Storage, "egress": &usage.Egress} {
+		param := r.URL.Query().Get(name)
+		if param == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(param, 10, 64)
+		if err != nil || parsed < 0 {
+			http.Error(w, "invalid "+name+": "+param, http.StatusBadRequest)
+			return
+		}
+		*value = parsed
+	}
+
+	invoice, err := service.Invoice(r.Context(), projectID, usage)
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+	writeJSON(w, http.StatusOK, invoice)
+}
+
+// errorStatus returns the http status code for err
+func errorStatus(err error) int {
+	if ErrInvalid.Has(err) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, code int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(value)
+}
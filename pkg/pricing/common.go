@@ -0,0 +1,18 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pricing
+
+import (
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is the default error class for the pricing package
+	Error = errs.Class("pricing error")
+	// ErrInvalid is returned for unknown tiers and negative prices
+	ErrInvalid = errs.Class("invalid pricing")
+)
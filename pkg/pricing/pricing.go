@@ -0,0 +1,227 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pricing
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+)
+
+// Config contains the default prices of the tiers, they apply until a tier's
+// rates are changed through the admin api
+type Config struct {
+	StandardStorage   int64 `help:"price of storing a TB for a month in US cents for standard projects" default:"1000"`
+	StandardEgress    int64 `help:"price of a TB of egress in US cents for standard projects" default:"4500"`
+	EnterpriseStorage int64 `help:"price of storing a TB for a month in US cents for enterprise projects" default:"800"`
+	EnterpriseEgress  int64 `help:"price of a TB of egress in US cents for enterprise projects" default:"3500"`
+	PartnerStorage    int64 `help:"price of storing a TB for a month in US cents for partner projects" default:"500"`
+	PartnerEgress     int64 `help:"price of a TB of egress in US cents for partner projects" default:"2000"`
+}
+
+// Defaults returns the configured rates of every tier
+func (config Config) Defaults() map[Tier]Rates {
+	return map[Tier]Rates{
+		TierStandard:   {Tier: TierStandard, Storage: config.StandardStorage, Egress: config.StandardEgress},
+		TierEnterprise: {Tier: TierEnterprise, Storage: config.EnterpriseStorage, Egress: config.EnterpriseEgress},
+		TierPartner:    {Tier: TierPartner, Storage: config.PartnerStorage, Egress: config.PartnerEgress},
+	}
+}
+
+// Tier is the pricing tier of a project
+type Tier string
+
+const (
+	// TierStandard is the tier of projects, which haven't been assigned one
+	TierStandard Tier = "standard"
+	// TierEnterprise is the tier of projects with an enterprise agreement
+	TierEnterprise Tier = "enterprise"
+	// TierPartner is the tier of projects of partners
+	TierPartner Tier = "partner"
+)
+
+// Tiers are all pricing tiers
+var Tiers = []Tier{TierStandard, TierEnterprise, TierPartner}
+
+// Valid returns whether tier is a known tier
+func (tier Tier) Valid() bool {
+	for _, known := range Tiers {
+		if tier == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Rates are the prices of a tier
+type Rates struct {
+	Tier Tier `json:"tier"`
+	// Storage is the price of storing a TB for a month in US cents
+	Storage int64 `json:"storage"`
+	// Egress is the price of a TB of egress in US cents
+	Egress int64 `json:"egress"`
+}
+
+// Usage is the usage of a project during a month
+type Usage struct {
+	// Storage is the average number of bytes stored during the month
+	Storage int64 `json:"storage"`
+	// Egress is the number of bytes downloaded during the month
+	Egress int64 `json:"egress"`
+}
+
+// Invoice is the charge of a project for its usage during a month, the
+// charges are in US cents
+type Invoice struct {
+	ProjectID uuid.UUID `json:"projectId"`
+	Rates     Rates     `json:"rates"`
+	Usage     Usage     `json:"usage"`
+
+	Storage float64 `json:"storage"`
+	Egress  float64 `json:"egress"`
+	Total   float64 `json:"total"`
+}
+
+// DB stores the rates of the tiers and the tiers of the projects
+type DB interface {
+	// SetRates adds or replaces the rates of a tier
+	SetRates(ctx context.Context, rates Rates) error
+	// GetRates returns the rates of a tier, it's nil when they haven't been set
+	GetRates(ctx context.Context, tier Tier) (*Rates, error)
+	// SetProjectTier assigns a tier to a project
+	SetProjectTier(ctx context.Context, projectID uuid.UUID, tier Tier) error
+	// GetProjectTier returns the tier of a project, it's empty when the project hasn't been assigned one
+	GetProjectTier(ctx context.Context, projectID uuid.UUID) (Tier, error)
+}
+
+// Service manages the pricing tiers and invoices projects with the rates of
+// their tier
+type Service struct {
+	log      *zap.Logger
+	db       DB
+	defaults map[Tier]Rates
+}
+
+// NewService creates a new pricing service
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log:      log,
+		db:       db,
+		defaults: config.Defaults(),
+	}
+}
+
+// Rates returns the rates of a tier, the configured ones when they haven't
+// been changed
+func (service *Service) Rates(ctx context.Context, tier Tier) (_ Rates, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !tier.Valid() {
+		return Rates{}, ErrInvalid.New("unknown tier %q", tier)
+	}
+
+	rates, err := service.db.GetRates(ctx, tier)
+	if err != nil {
+		return Rates{}, Error.Wrap(err)
+	}
+	if rates == nil {
+		return service.defaults[tier], nil
+	}
+	return *rates, nil
+}
+
+// ListRates returns the rates of all tiers
+func (service *Service) ListRates(ctx context.Context) (_ []Rates, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list := make([]Rates, 0, len(Tiers))
+	for _, tier := range Tiers {
+		rates, err := service.Rates(ctx, tier)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, rates)
+	}
+	return list, nil
+}
+
+// SetRates changes the rates of a tier, they apply to all following invoices
+func (service *Service) SetRates(ctx context.Context, rates Rates) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !rates.Tier.Valid() {
+		return ErrInvalid.New("unknown tier %q", rates.Tier)
+	}
+	if rates.Storage < 0 || rates.Egress < 0 {
+		return ErrInvalid.New("prices must not be negative")
+	}
+
+	if err := service.db.SetRates(ctx, rates); err != nil {
+		return Error.Wrap(err)
+	}
+	service.log.Info("changed rates",
+		zap.String("tier", string(rates.Tier)),
+		zap.Int64("storage", rates.Storage),
+		zap.Int64("egress", rates.Egress),
+	)
+	return nil
+}
+
+// ProjectTier returns the tier of a project, projects which haven't been
+// assigned one are in the standard tier
+func (service *Service) ProjectTier(ctx context.Context, projectID uuid.UUID) (_ Tier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tier, err := service.db.GetProjectTier(ctx, projectID)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	if tier == "" {
+		return TierStandard, nil
+	}
+	return tier, nil
+}
+
+// AssignProject assigns a tier to a project, it applies to all following invoices
+func (service *Service) AssignProject(ctx context.Context, projectID uuid.UUID, tier Tier) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !tier.Valid() {
+		return ErrInvalid.New("unknown tier %q", tier)
+	}
+
+	if err := service.db.SetProjectTier(ctx, projectID, tier); err != nil {
+		return Error.Wrap(err)
+	}
+	service.log.Info("assigned tier", zap.String("project", projectID.String()), zap.String("tier", string(tier)))
+	return nil
+}
+
+// Invoice charges the usage of a project during a month with the rates of
+// the project's tier
+func (service *Service) Invoice(ctx context.Context, projectID uuid.UUID, usage Usage) (_ Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tier, err := service.ProjectTier(ctx, projectID)
+	if err != nil {
+		return Invoice{}, err
+	}
+	rates, err := service.Rates(ctx, tier)
+	if err != nil {
+		return Invoice{}, err
+	}
+
+	invoice := Invoice{
+		ProjectID: projectID,
+		Rates:     rates,
+		Usage:     usage,
+		Storage:   memory.Size(usage.Storage).TB() * float64(rates.Storage),
+		Egress:    memory.Size(usage.Egress).TB() * float64(rates.Egress),
+	}
+	invoice.Total = invoice.Storage + invoice.Egress
+	return invoice, nil
+}
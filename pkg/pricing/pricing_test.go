@@ -0,0 +1,98 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pricing_test
+
+import (
+	"testing"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/pricing"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestPricing(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		service := pricing.NewService(zap.NewNop(), db.Pricing(), pricing.Config{
+			StandardStorage:   1000,
+			StandardEgress:    4500,
+			EnterpriseStorage: 800,
+			EnterpriseEgress:  3500,
+			PartnerStorage:    500,
+			PartnerEgress:     2000,
+		})
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+
+		usage := pricing.Usage{Storage: 2 * memory.TB.Int64(), Egress: memory.TB.Int64()}
+
+		{ // projects without a tier are invoiced with the configured standard rates
+			tier, err := service.ProjectTier(ctx, *projectID)
+			require.NoError(t, err)
+			assert.Equal(t, pricing.TierStandard, tier)
+
+			invoice, err := service.Invoice(ctx, *projectID, usage)
+			require.NoError(t, err)
+			assert.Equal(t, pricing.TierStandard, invoice.Rates.Tier)
+			assert.Equal(t, 2000.0, invoice.Storage)
+			assert.Equal(t, 4500.0, invoice.Egress)
+			assert.Equal(t, 6500.0, invoice.Total)
+		}
+
+		{ // unknown tiers and negative prices are rejected
+			err := service.AssignProject(ctx, *projectID, "gold")
+			assert.True(t, pricing.ErrInvalid.Has(err))
+
+			err = service.SetRates(ctx, pricing.Rates{Tier: "gold", Storage: 1, Egress: 1})
+			assert.True(t, pricing.ErrInvalid.Has(err))
+
+			err = service.SetRates(ctx, pricing.Rates{Tier: pricing.TierPartner, Storage: -1, Egress: 1})
+			assert.True(t, pricing.ErrInvalid.Has(err))
+		}
+
+		{ // the rates of the assigned tier apply
+			require.NoError(t, service.AssignProject(ctx, *projectID, pricing.TierEnterprise))
+
+			invoice, err := service.Invoice(ctx, *projectID, usage)
+			require.NoError(t, err)
+			assert.Equal(t, pricing.TierEnterprise, invoice.Rates.Tier)
+			assert.Equal(t, 5100.0, invoice.Total)
+		}
+
+		{ // changed rates replace the configured ones
+			require.NoError(t, service.SetRates(ctx, pricing.Rates{Tier: pricing.TierEnterprise, Storage: 700, Egress: 3000}))
+			require.NoError(t, service.SetRates(ctx, pricing.Rates{Tier: pricing.TierEnterprise, Storage: 600, Egress: 3000}))
+
+			rates, err := service.ListRates(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, []pricing.Rates{
+				{Tier: pricing.TierStandard, Storage: 1000, Egress: 4500},
+				{Tier: pricing.TierEnterprise, Storage: 600, Egress: 3000},
+				{Tier: pricing.TierPartner, Storage: 500, Egress: 2000},
+			}, rates)
+
+			invoice, err := service.Invoice(ctx, *projectID, usage)
+			require.NoError(t, err)
+			assert.Equal(t, 4200.0, invoice.Total)
+		}
+
+		{ // projects can be moved to another tier
+			require.NoError(t, service.AssignProject(ctx, *projectID, pricing.TierPartner))
+
+			tier, err := service.ProjectTier(ctx, *projectID)
+			require.NoError(t, err)
+			assert.Equal(t, pricing.TierPartner, tier)
+		}
+	})
+}
@@ -140,6 +140,32 @@ func Ctx(cmd *cobra.Command) context.Context {
 	return ctx
 }
 
+// loadConfig reads the configuration of cmd from the environment and the
+// config file in config-dir
+func loadConfig(cmd *cobra.Command) (*viper.Viper, error) {
+	vip := viper.New()
+	err := vip.BindPFlags(cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+	vip.SetEnvPrefix("storj")
+	vip.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	vip.AutomaticEnv()
+
+	cfgFlag := cmd.Flags().Lookup("config-dir")
+	if cfgFlag != nil && cfgFlag.Value.String() != "" {
+		path := filepath.Join(os.ExpandEnv(cfgFlag.Value.String()), "config.yaml")
+		if cmd.Annotations["type"] != "setup" || fileExists(path) {
+			vip.SetConfigFile(path)
+			err = vip.ReadInConfig()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return vip, nil
+}
+
 func cleanup(cmd *cobra.Command) {
 	for _, ccmd := range cmd.Commands() {
 		cleanup(ccmd)
@@ -155,26 +181,10 @@ func cleanup(cmd *cobra.Command) {
 		ctx := context.Background()
 		defer mon.TaskNamed("root")(&ctx)(&err)
 
-		vip := viper.New()
-		err = vip.BindPFlags(cmd.Flags())
+		vip, err := loadConfig(cmd)
 		if err != nil {
 			return err
 		}
-		vip.SetEnvPrefix("storj")
-		vip.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
-		vip.AutomaticEnv()
-
-		cfgFlag := cmd.Flags().Lookup("config-dir")
-		if cfgFlag != nil && cfgFlag.Value.String() != "" {
-			path := filepath.Join(os.ExpandEnv(cfgFlag.Value.String()), "config.yaml")
-			if cmd.Annotations["type"] != "setup" || fileExists(path) {
-				vip.SetConfigFile(path)
-				err = vip.ReadInConfig()
-				if err != nil {
-					return err
-				}
-			}
-		}
 
 		// go back and propagate changed config values to appropriate flags
 		var brokenKeys []string
@@ -236,6 +246,8 @@ func cleanup(cmd *cobra.Command) {
 			contextMtx.Unlock()
 		}()
 
+		defer watchReload(cmd, logger)()
+
 		err = internalRun(cmd, args)
 		if err != nil {
 			_, _ = fmt.Fprintf(os.Stderr, "%v\n", err)
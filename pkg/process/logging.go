@@ -23,6 +23,9 @@ var (
 	logStack    = flag.Bool("log.stack", false, "if true, log stack traces")
 	logEncoding = flag.String("log.encoding", "console", "configures log encoding. can either be 'console' or 'json'")
 	logOutput   = flag.String("log.output", "stderr", "can be stdout, stderr, or a filename")
+
+	// atomicLevel allows changing the log level while running
+	atomicLevel = zap.NewAtomicLevel()
 )
 
 func newLogger() (*zap.Logger, error) {
@@ -37,8 +40,10 @@ func newLogger() (*zap.Logger, error) {
 		timeKey = ""
 	}
 
+	atomicLevel.SetLevel(*logLevel)
+
 	return zap.Config{
-		Level:             zap.NewAtomicLevelAt(*logLevel),
+		Level:             atomicLevel,
 		Development:       *logDev,
 		DisableCaller:     !*logCaller,
 		DisableStacktrace: !*logStack,
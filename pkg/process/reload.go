@@ -0,0 +1,132 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package process
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+)
+
+// ReloadFunc applies reloaded configuration values, keyed by flag name
+type ReloadFunc func(values map[string]string) error
+
+type reloadable struct {
+	keys    []string
+	fn      ReloadFunc
+	applied map[string]string
+}
+
+var reloader struct {
+	mu          sync.Mutex
+	cmd         *cobra.Command
+	reloadables []*reloadable
+}
+
+// OnReload registers fn to be called with the values of keys whenever any of
+// them has changed after the configuration was reloaded. Settings that aren't
+// registered can't be reloaded and require a restart.
+func OnReload(fn ReloadFunc, keys ...string) {
+	reloader.mu.Lock()
+	defer reloader.mu.Unlock()
+
+	applied := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if reloader.cmd == nil {
+			continue
+		}
+		if flag := reloader.cmd.Flags().Lookup(key); flag != nil {
+			applied[key] = flag.Value.String()
+		}
+	}
+
+	reloader.reloadables = append(reloader.reloadables, &reloadable{
+		keys:    keys,
+		fn:      fn,
+		applied: applied,
+	})
+}
+
+// Reload reads the configuration of the running command again and applies
+// the changed values of reloadable settings.
+func Reload() error {
+	reloader.mu.Lock()
+	defer reloader.mu.Unlock()
+
+	if reloader.cmd == nil {
+		return Error.New("no command is running")
+	}
+
+	vip, err := loadConfig(reloader.cmd)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, r := range reloader.reloadables {
+		values := make(map[string]string, len(r.keys))
+		changed := false
+		for _, key := range r.keys {
+			values[key] = vip.GetString(key)
+			if values[key] != r.applied[key] {
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+
+		if err := r.fn(values); err != nil {
+			group.Add(err)
+			continue
+		}
+		r.applied = values
+	}
+	return Error.Wrap(group.Err())
+}
+
+// watchReload reloads the configuration of cmd on SIGHUP, until the returned
+// function is called
+func watchReload(cmd *cobra.Command, logger *zap.Logger) (stop func()) {
+	reloader.mu.Lock()
+	reloader.cmd = cmd
+	reloader.mu.Unlock()
+
+	OnReload(func(values map[string]string) error {
+		return atomicLevel.UnmarshalText([]byte(values["log.level"]))
+	}, "log.level")
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-signals:
+				logger.Info("Reloading configuration")
+				if err := Reload(); err != nil {
+					logger.Error("Failed to reload configuration", zap.Error(err))
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(signals)
+		close(done)
+
+		reloader.mu.Lock()
+		reloader.cmd = nil
+		reloader.reloadables = nil
+		reloader.mu.Unlock()
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package purge
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/pointerdb"
+	ecclient "storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+// Config contains configurable values for purging deleted projects
+type Config struct {
+	Interval  time.Duration `help:"how often to purge the data of deleted projects, 0 disables purging" default:"1m"`
+	BatchSize int           `help:"maximum number of segments deleted per project and run" default:"100"`
+}
+
+// Service deletes the segments and pieces of deleted projects in batches
+// and records the progress, so a purge continues where it stopped.
+type Service struct {
+	log       *zap.Logger
+	deletions console.ProjectDeletions
+	pointerdb *pointerdb.Service
+	cache     *overlay.Cache
+	ec        ecclient.Client
+	identity  *identity.FullIdentity
+	config    Config
+
+	Chore *chore.Chore
+}
+
+// New creates a new purge service
+func New(log *zap.Logger, deletions console.ProjectDeletions, pointerdb *pointerdb.Service, cache *overlay.Cache, ec ecclient.Client, identity *identity.FullIdentity, config Config) *Service {
+	service := &Service{
+		log:       log,
+		deletions: deletions,
+		pointerdb: pointerdb,
+		cache:     cache,
+		ec:        ec,
+		identity:  identity,
+		config:    config,
+	}
+	service.Chore = chore.New(log, "purge", config.Interval, service.purge)
+	return service
+}
+
+// Run purges the data of deleted projects every interval
+func (service *Service) Run(ctx context.Context) error {
+	if service.config.Interval <= 0 {
+		return nil
+	}
+	return service.Chore.Run(ctx)
+}
+
+// purge deletes a batch of segments of every deleted project
+func (service *Service) purge(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deletions, err := service.deletions.GetUnfinished(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for i := range deletions {
+		if err := service.PurgeProject(ctx, &deletions[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PurgeProject deletes the next batch of segments of a deleted project
+// and marks the deletion finished, when nothing is left.
+func (service *Service) PurgeProject(ctx context.Context, deletion *console.ProjectDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if deletion.Finished() {
+		return nil
+	}
+
+	batch, err := service.nextBatch(deletion.ProjectID.String())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if len(batch) == 0 {
+		deletion.FinishedAt = time.Now().UTC()
+		if err := service.deletions.Update(ctx, deletion); err != nil {
+			return Error.Wrap(err)
+		}
+
+		mon.Meter("purged_projects").Mark(1)
+		mon.IntVal("purged_project_segments").Observe(deletion.DeletedSegments)
+		mon.IntVal("purged_project_bytes").Observe(deletion.DeletedBytes)
+		service.log.Info("purged deleted project",
+			zap.String("Project ID", deletion.ProjectID.String()),
+			zap.Int64("segments", deletion.DeletedSegments),
+			zap.Int64("bytes", deletion.DeletedBytes),
+			zap.Duration("took", deletion.FinishedAt.Sub(deletion.RequestedAt)))
+		return nil
+	}
+
+	authorization, err := service.authorization()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, item := range batch {
+		pointer := &pb.Pointer{}
+		if err := proto.Unmarshal(item.Value, pointer); err != nil {
+			return Error.New("error unmarshaling pointer %q: %v", item.Key, err)
+		}
+
+		service.deletePieces(ctx, pointer, authorization)
+
+		if err := service.pointerdb.Delete(item.Key.String()); err != nil {
+			return Error.Wrap(err)
+		}
+		deletion.DeletedSegments++
+		deletion.DeletedBytes += pointer.GetSegmentSize()
+	}
+	mon.Meter("purged_segments").Mark(len(batch))
+
+	return Error.Wrap(service.deletions.Update(ctx, deletion))
+}
+
+// nextBatch returns the next segments of the project to delete
+func (service *Service) nextBatch(projectID string) (batch storage.Items, err error) {
+	err = service.pointerdb.Iterate(projectID+"/", "", true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for len(batch) < service.config.BatchSize && it.Next(&item) {
+				batch = append(batch, storage.CloneItem(item))
+			}
+			return nil
+		})
+	return batch, err
+}
+
+// deletePieces deletes the pieces of a remote segment from the storage nodes,
+// pieces on nodes which can't be reached are left for the nodes to expire
+func (service *Service) deletePieces(ctx context.Context, pointer *pb.Pointer, authorization *pb.SignedMessage) {
+	remote := pointer.GetRemote()
+	if pointer.GetType() != pb.Pointer_REMOTE || remote == nil || len(remote.GetRemotePieces()) == 0 {
+		return
+	}
+
+	var nodeIDs storj.NodeIDList
+	for _, piece := range remote.GetRemotePieces() {
+		nodeIDs = append(nodeIDs, piece.NodeId)
+	}
+
+	nodes, err := service.cache.GetAll(ctx, nodeIDs)
+	if err != nil {
+		service.log.Debug("could not look up nodes of purged segment", zap.Error(err))
+		return
+	}
+
+	err = service.ec.Delete(ctx, nodes, psclient.PieceID(remote.GetPieceId()), authorization)
+	if err != nil {
+		service.log.Debug("could not delete pieces of purged segment", zap.Error(err))
+	}
+}
+
+// authorization returns the authorization the satellite uses for piece requests
+func (service *Service) authorization() (*pb.SignedMessage, error) {
+	signature, err := auth.GenerateSignature(service.identity.ID.Bytes(), service.identity)
+	if err != nil {
+		return nil, err
+	}
+	return auth.NewSignedMessage(signature, service.identity)
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package purge_test
+
+import (
+	"testing"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+)
+
+func TestPurgeProject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		pointers := satellite.Metainfo.Service
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		project := projectID.String()
+
+		var pieces []*pb.RemotePiece
+		for i, storagenode := range planet.StorageNodes {
+			pieces = append(pieces, &pb.RemotePiece{
+				PieceNum: int32(i),
+				NodeId:   storagenode.ID(),
+			})
+		}
+
+		const segments = 3
+		require.NoError(t, pointers.Put(project+"/l/bucket/inline", &pb.Pointer{
+			Type:          pb.Pointer_INLINE,
+			InlineSegment: []byte("inline"),
+			SegmentSize:   6,
+		}))
+		require.NoError(t, pointers.Put(project+"/s0/bucket/remote", &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				PieceId:      "non-existing-piece-id",
+				RemotePieces: pieces,
+			},
+			SegmentSize: 1024,
+		}))
+		require.NoError(t, pointers.Put(project+"/l/bucket/remote", &pb.Pointer{
+			Type:          pb.Pointer_INLINE,
+			InlineSegment: []byte("last"),
+			SegmentSize:   4,
+		}))
+		require.NoError(t, pointers.Put("other/l/bucket/object", &pb.Pointer{
+			Type:          pb.Pointer_INLINE,
+			InlineSegment: []byte("other"),
+			SegmentSize:   5,
+		}))
+
+		deletions := satellite.DB.Console().ProjectDeletions()
+		deletion, err := deletions.Insert(ctx, *projectID)
+		require.NoError(t, err)
+		assert.False(t, deletion.Finished())
+
+		for i := 0; i < segments+1 && !deletion.Finished(); i++ {
+			require.NoError(t, satellite.Purge.Service.PurgeProject(ctx, deletion))
+		}
+		require.True(t, deletion.Finished())
+
+		stored, err := deletions.Get(ctx, *projectID)
+		require.NoError(t, err)
+		assert.True(t, stored.Finished())
+		assert.EqualValues(t, segments, stored.DeletedSegments)
+		assert.EqualValues(t, 6+1024+4, stored.DeletedBytes)
+
+		unfinished, err := deletions.GetUnfinished(ctx)
+		require.NoError(t, err)
+		assert.Len(t, unfinished, 0)
+
+		_, err = pointers.Get(project + "/s0/bucket/remote")
+		assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+		_, err = pointers.Get("other/l/bucket/object")
+		assert.NoError(t, err)
+	})
+}
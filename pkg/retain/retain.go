@@ -0,0 +1,186 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package retain
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storage"
+)
+
+// Config contains configurable values for the garbage collection of pieces
+type Config struct {
+	Interval          time.Duration `help:"how often the storage nodes are sent the pieces to retain, 0 disables the garbage collection" default:"0"`
+	FalsePositiveRate float64       `help:"fraction of the garbage pieces, which are kept by the storage nodes" default:"0.1"`
+	GracePeriod       time.Duration `help:"how long before the start of the garbage collection pieces have to be stored to be collected, uploads may not be committed yet" default:"24h0m0s"`
+}
+
+// Service periodically sends every storage node a bloom filter of the
+// pieces it should store, the nodes move older pieces, which aren't in
+// the filter, to their trash
+type Service struct {
+	log       *zap.Logger
+	pointerdb *pointerdb.Service
+	cache     *overlay.Cache
+	transport transport.Client
+	config    Config
+
+	Chore *chore.Chore
+}
+
+// New creates a new garbage collection service
+func New(log *zap.Logger, pointerdb *pointerdb.Service, cache *overlay.Cache, transport transport.Client, config Config) *Service {
+	service := &Service{
+		log:       log,
+		pointerdb: pointerdb,
+		cache:     cache,
+		transport: transport,
+		config:    config,
+	}
+	service.Chore = chore.New(log, "retain", config.Interval, service.Collect)
+	return service
+}
+
+// Run sends the pieces to retain at every interval
+func (service *Service) Run(ctx context.Context) error {
+	if service.config.Interval <= 0 {
+		return nil
+	}
+	return service.Chore.Run(ctx)
+}
+
+// Collect builds the filters of all nodes and sends them
+func (service *Service) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// pieces uploaded while iterating aren't in the filters, so only older
+	// pieces are collected
+	cutoff := time.Now().Add(-service.config.GracePeriod)
+
+	filters, err := service.filters(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var failed int
+	for nodeID, filter := range filters {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		request := &pb.RetainRequest{
+			CreationUnixSec: cutoff.Unix(),
+			Filter:          filter.Bytes(),
+		}
+		trashed, err := service.send(ctx, nodeID, request)
+		if err != nil {
+			failed++
+			service.log.Debug("could not send pieces to retain", zap.String("Node ID", nodeID.String()), zap.Error(err))
+			continue
+		}
+		mon.IntVal("retain_trashed").Observe(trashed)
+	}
+	mon.IntVal("retain_failed").Observe(int64(failed))
+	return nil
+}
+
+// filters returns the filters of the pieces of every node. The pointers are
+// iterated twice, first to count the pieces, so each filter can be sized
+// for its node.
+func (service *Service) filters(ctx context.Context) (map[storj.NodeID]*bloomfilter.Filter, error) {
+	counts := map[storj.NodeID]int{}
+	err := service.iterate(ctx, func(nodeID storj.NodeID, pieceID psclient.PieceID) error {
+		counts[nodeID]++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make(map[storj.NodeID]*bloomfilter.Filter, len(counts))
+	for nodeID, count := range counts {
+		filters[nodeID] = bloomfilter.NewOptimal(count, service.config.FalsePositiveRate)
+	}
+
+	err = service.iterate(ctx, func(nodeID storj.NodeID, pieceID psclient.PieceID) error {
+		// nodes of pieces uploaded since counting are left out, their
+		// pieces are newer than the cutoff anyway
+		if filter, ok := filters[nodeID]; ok {
+			derived, err := pieceID.Derive(nodeID.Bytes())
+			if err != nil {
+				return err
+			}
+			filter.Add([]byte(derived))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+// iterate calls fn with every remote piece in the pointerdb
+func (service *Service) iterate(ctx context.Context, fn func(nodeID storj.NodeID, pieceID psclient.PieceID) error) error {
+	return service.pointerdb.Iterate("", "", true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return Error.Wrap(err)
+				}
+
+				remote := pointer.GetRemote()
+				if remote == nil {
+					continue
+				}
+				pieceID := psclient.PieceID(remote.GetPieceId())
+				for _, piece := range remote.GetRemotePieces() {
+					if err := fn(piece.NodeId, pieceID); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	)
+}
+
+// send sends the pieces to retain to a node
+func (service *Service) send(ctx context.Context, nodeID storj.NodeID, request *pb.RetainRequest) (_ int64, err error) {
+	node, err := service.cache.Get(ctx, nodeID)
+	if err != nil {
+		return 0, err
+	}
+
+	conn, err := service.transport.DialNode(ctx, node)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	response, err := pb.NewPieceStoreRoutesClient(conn).Retain(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+	return response.GetTrashed(), nil
+}
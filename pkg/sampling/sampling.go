@@ -0,0 +1,240 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sampling
+
+import (
+	"context"
+	"math/bits"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/storage"
+)
+
+// Config contains configurable values for sampling the pointers
+type Config struct {
+	Interval time.Duration `help:"how often the pointers are sampled, 0 disables sampling" default:"24h"`
+	Rate     float64       `help:"fraction of the pointers included in a sample" default:"0.01"`
+}
+
+// DB stores the snapshots of the samples
+type DB interface {
+	// Save stores a snapshot
+	Save(ctx context.Context, snapshot *Snapshot) error
+	// List returns up to limit of the latest snapshots, the newest first
+	List(ctx context.Context, limit int) ([]*Snapshot, error)
+}
+
+// Histogram counts values by the next power of two, e.g. 3 and 4 are
+// counted at 4 and 5 at 8
+type Histogram map[int64]int64
+
+// Add counts a value
+func (histogram Histogram) Add(value int64) {
+	bucket := int64(0)
+	if value > 0 {
+		bucket = 1 << uint(bits.Len64(uint64(value-1)))
+	}
+	histogram[bucket]++
+}
+
+// Snapshot is the distribution of the sampled pointers. The counts aren't
+// scaled by the rate, so they describe the sample and not the whole pointerdb.
+type Snapshot struct {
+	CreatedAt time.Time `json:"created_at"`
+	Rate      float64   `json:"rate"`
+
+	Segments       int64 `json:"segments"`
+	InlineSegments int64 `json:"inline_segments"`
+	InlineBytes    int64 `json:"inline_bytes"`
+	RemoteSegments int64 `json:"remote_segments"`
+	RemoteBytes    int64 `json:"remote_bytes"`
+
+	// SegmentSizes are the sizes of all sampled segments
+	SegmentSizes Histogram `json:"segment_sizes"`
+
+	// Objects are the sampled last segments, the sizes and segment counts
+	// are of their whole objects
+	Objects       int64     `json:"objects"`
+	ObjectSizes   Histogram `json:"object_sizes"`
+	SegmentCounts Histogram `json:"segment_counts"`
+
+	// PieceMargins counts the remote segments by the number of pieces above
+	// the minimum required for reconstruction
+	PieceMargins Histogram `json:"piece_margins"`
+	// BelowRepair is the number of remote segments at or below their repair threshold
+	BelowRepair int64 `json:"below_repair"`
+}
+
+// NewSnapshot returns an empty snapshot
+func NewSnapshot(createdAt time.Time, rate float64) *Snapshot {
+	return &Snapshot{
+		CreatedAt:     createdAt,
+		Rate:          rate,
+		SegmentSizes:  Histogram{},
+		ObjectSizes:   Histogram{},
+		SegmentCounts: Histogram{},
+		PieceMargins:  Histogram{},
+	}
+}
+
+// AddSegment adds a sampled segment to the snapshot
+func (snapshot *Snapshot) AddSegment(pointer *pb.Pointer) {
+	size := pointer.GetSegmentSize()
+
+	snapshot.Segments++
+	snapshot.SegmentSizes.Add(size)
+
+	if pointer.GetType() == pb.Pointer_INLINE {
+		snapshot.InlineSegments++
+		snapshot.InlineBytes += int64(len(pointer.GetInlineSegment()))
+		return
+	}
+
+	snapshot.RemoteSegments++
+	snapshot.RemoteBytes += size
+
+	redundancy := pointer.GetRemote().GetRedundancy()
+	pieces := int32(len(pointer.GetRemote().GetRemotePieces()))
+	snapshot.PieceMargins.Add(int64(pieces - redundancy.GetMinReq()))
+	if pieces <= redundancy.GetRepairThreshold() {
+		snapshot.BelowRepair++
+	}
+}
+
+// AddObject adds an object with its size and number of segments to the snapshot
+func (snapshot *Snapshot) AddObject(size, segments int64) {
+	snapshot.Objects++
+	snapshot.ObjectSizes.Add(size)
+	snapshot.SegmentCounts.Add(segments)
+}
+
+// Service periodically samples the pointers and stores the distributions
+// as snapshots, which inform the pricing and the default settings
+type Service struct {
+	log       *zap.Logger
+	db        DB
+	pointerdb *pointerdb.Service
+	config    Config
+
+	Chore *chore.Chore
+}
+
+// NewService creates a new sampling service
+func NewService(log *zap.Logger, db DB, pointerdb *pointerdb.Service, config Config) *Service {
+	service := &Service{
+		log:       log,
+		db:        db,
+		pointerdb: pointerdb,
+		config:    config,
+	}
+	service.Chore = chore.New(log, "sampling", config.Interval, func(ctx context.Context) error {
+		_, err := service.Sample(ctx)
+		return err
+	})
+	return service
+}
+
+// Run samples the pointers at every interval
+func (service *Service) Run(ctx context.Context) error {
+	if service.config.Interval <= 0 {
+		return nil
+	}
+	return service.Chore.Run(ctx)
+}
+
+// List returns up to limit of the latest snapshots, the newest first
+func (service *Service) List(ctx context.Context, limit int) (_ []*Snapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+	snapshots, err := service.db.List(ctx, limit)
+	return snapshots, Error.Wrap(err)
+}
+
+// Sample samples the pointers and stores the snapshot
+func (service *Service) Sample(ctx context.Context) (_ *Snapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	snapshot := NewSnapshot(time.Now().UTC(), service.config.Rate)
+	random := rand.New(rand.NewSource(snapshot.CreatedAt.UnixNano()))
+
+	// the objects are looked up after iterating, so the iterator isn't held
+	// open while reading the other segments
+	var objects []string
+	err = service.pointerdb.Iterate("", "", true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for it.Next(&item) {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				if random.Float64() >= service.config.Rate {
+					continue
+				}
+
+				pointer := &pb.Pointer{}
+				if err := proto.Unmarshal(item.Value, pointer); err != nil {
+					return Error.Wrap(err)
+				}
+				snapshot.AddSegment(pointer)
+
+				// path is <project>/<segment index>/<bucket>/<encrypted path>
+				path := item.Key.String()
+				if parts := strings.SplitN(path, "/", 3); len(parts) == 3 && parts[1] == "l" {
+					objects = append(objects, path)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, path := range objects {
+		size, segments, err := service.objectSize(path)
+		if err != nil {
+			// the object might have been deleted in the meantime
+			service.log.Debug("unable to sample object", zap.String("path", path), zap.Error(err))
+			continue
+		}
+		snapshot.AddObject(size, segments)
+	}
+
+	if err := service.db.Save(ctx, snapshot); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return snapshot, nil
+}
+
+// objectSize returns the size and the number of segments of the object of
+// a last segment by reading the segments s0, s1, ... preceding it
+func (service *Service) objectSize(lastSegment string) (size, segments int64, err error) {
+	parts := strings.SplitN(lastSegment, "/", 3)
+
+	last, err := service.pointerdb.Get(lastSegment)
+	if err != nil {
+		return 0, 0, err
+	}
+	size, segments = last.GetSegmentSize(), 1
+
+	for index := 0; ; index++ {
+		pointer, err := service.pointerdb.Get(parts[0] + "/s" + strconv.Itoa(index) + "/" + parts[2])
+		if storage.ErrKeyNotFound.Has(err) {
+			return size, segments, nil
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		size += pointer.GetSegmentSize()
+		segments++
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sampling_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/sampling"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestHistogram(t *testing.T) {
+	histogram := sampling.Histogram{}
+	for _, value := range []int64{-1, 0, 1, 2, 3, 4, 5, 1000} {
+		histogram.Add(value)
+	}
+	assert.Equal(t, sampling.Histogram{0: 2, 1: 1, 2: 1, 4: 2, 8: 1, 1024: 1}, histogram)
+}
+
+func TestSample(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		pointers := satellite.Metainfo.Service
+		service := satellite.Sampling.Service
+
+		// the chore samples when the satellite starts, it's paused so it
+		// doesn't store snapshots while the test runs
+		service.Chore.Pause()
+		for service.Chore.Status().Running {
+			time.Sleep(10 * time.Millisecond)
+		}
+		before, err := satellite.DB.Samples().List(ctx, 10)
+		require.NoError(t, err)
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		project := projectID.String()
+
+		inline := func(size int64) *pb.Pointer {
+			return &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: make([]byte, size),
+				SegmentSize:   size,
+			}
+		}
+		remote := func(size int64, pieces int) *pb.Pointer {
+			pointer := &pb.Pointer{
+				Type:        pb.Pointer_REMOTE,
+				SegmentSize: size,
+				Remote: &pb.RemoteSegment{
+					Redundancy: &pb.RedundancyScheme{MinReq: 2, RepairThreshold: 3, SuccessThreshold: 4, Total: 5},
+				},
+			}
+			for i := 0; i < pieces; i++ {
+				pointer.Remote.RemotePieces = append(pointer.Remote.RemotePieces, &pb.RemotePiece{
+					PieceNum: int32(i),
+					NodeId:   storj.NodeID{byte(i + 1)},
+				})
+			}
+			return pointer
+		}
+
+		// an inline object and a remote object with three segments
+		require.NoError(t, pointers.Put(project+"/l/bucket/small", inline(10)))
+		require.NoError(t, pointers.Put(project+"/s0/bucket/large", remote(1000, 5)))
+		require.NoError(t, pointers.Put(project+"/s1/bucket/large", remote(1000, 3)))
+		require.NoError(t, pointers.Put(project+"/l/bucket/large", remote(500, 4)))
+
+		snapshot, err := service.Sample(ctx)
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 4, snapshot.Segments)
+		assert.EqualValues(t, 1, snapshot.InlineSegments)
+		assert.EqualValues(t, 10, snapshot.InlineBytes)
+		assert.EqualValues(t, 3, snapshot.RemoteSegments)
+		assert.EqualValues(t, 2500, snapshot.RemoteBytes)
+		assert.Equal(t, sampling.Histogram{16: 1, 512: 1, 1024: 2}, snapshot.SegmentSizes)
+
+		assert.EqualValues(t, 2, snapshot.Objects)
+		assert.Equal(t, sampling.Histogram{16: 1, 4096: 1}, snapshot.ObjectSizes)
+		assert.Equal(t, sampling.Histogram{1: 1, 4: 1}, snapshot.SegmentCounts)
+
+		assert.Equal(t, sampling.Histogram{1: 1, 2: 1, 4: 1}, snapshot.PieceMargins)
+		assert.EqualValues(t, 1, snapshot.BelowRepair)
+
+		// the snapshot is stored
+		snapshots, err := satellite.DB.Samples().List(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, snapshots, len(before)+1)
+		assert.Equal(t, snapshot.Objects, snapshots[0].Objects)
+		assert.Equal(t, snapshot.ObjectSizes, snapshots[0].ObjectSizes)
+		assert.True(t, snapshot.CreatedAt.Equal(snapshots[0].CreatedAt))
+
+		// and listed by the admin api
+		recorder := httptest.NewRecorder()
+		service.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/snapshots?limit=1", nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var listed []*sampling.Snapshot
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&listed))
+		require.Len(t, listed, 1)
+		assert.Equal(t, snapshot.SegmentCounts, listed[0].SegmentCounts)
+
+		recorder = httptest.NewRecorder()
+		service.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/snapshots?limit=x", nil))
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConcurrencyConfig limits how many requests of a method are handled at once
+type ConcurrencyConfig struct {
+	Limits       string        `help:"comma separated maximum concurrent requests per method (e.g. pointerdb.PointerDB/Put=100), methods without a limit aren't limited" default:"pointerdb.PointerDB/Put=200,pointerdb.PointerDB/List=200,pointerdb.PointerDB/ListStream=200,bandwidth.Bandwidth/BandwidthAgreements=100"`
+	QueueSize    int           `help:"maximum number of requests waiting for a limited method, further requests are rejected" default:"100"`
+	QueueTimeout time.Duration `help:"how long a request waits for a limited method before it's rejected" default:"5s"`
+}
+
+// ConcurrencyLimiter limits the concurrent requests of every configured
+// method. Requests exceeding the limit wait in a bounded queue, requests
+// which don't fit into the queue or wait too long are rejected with
+// ResourceExhausted, so clients can retry later.
+//
+// Streams hold their slot until they end.
+type ConcurrencyLimiter struct {
+	log     *zap.Logger
+	config  ConcurrencyConfig
+	methods map[string]*methodLimit
+}
+
+// methodLimit contains the slots of a single method
+type methodLimit struct {
+	slots   chan struct{}
+	waiting int64 // atomic
+}
+
+// NewConcurrencyLimiter creates a limiter for the configured methods
+func NewConcurrencyLimiter(log *zap.Logger, config ConcurrencyConfig) (*ConcurrencyLimiter, error) {
+	limiter := &ConcurrencyLimiter{
+		log:     log,
+		config:  config,
+		methods: map[string]*methodLimit{},
+	}
+
+	for _, entry := range strings.Split(config.Limits, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, Error.New("expected <service>/<method>=<limit>, got %q", entry)
+		}
+
+		method := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(method, "/") {
+			method = "/" + method
+		}
+		if strings.Count(method, "/") != 2 {
+			return nil, Error.New("expected <service>/<method>=<limit>, got %q", entry)
+		}
+
+		limit, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if limit <= 0 {
+			return nil, Error.New("limit must be positive, got %q", entry)
+		}
+
+		limiter.methods[method] = &methodLimit{slots: make(chan struct{}, limit)}
+	}
+
+	return limiter, nil
+}
+
+// UnaryInterceptor returns an interceptor, which limits the concurrent
+// requests of the configured methods
+func (limiter *ConcurrencyLimiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		method, ok := limiter.methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if err := limiter.acquire(ctx, method); err != nil {
+			if status.Code(err) == codes.ResourceExhausted {
+				mon.Meter("concurrency_shed").Mark(1)
+				limiter.log.Debug("shed request", zap.String("method", info.FullMethod))
+			}
+			return nil, err
+		}
+		defer func() { <-method.slots }()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns an interceptor, which limits the concurrent
+// streams of the configured methods
+func (limiter *ConcurrencyLimiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		method, ok := limiter.methods[info.FullMethod]
+		if !ok {
+			return handler(srv, ss)
+		}
+
+		if err := limiter.acquire(ss.Context(), method); err != nil {
+			if status.Code(err) == codes.ResourceExhausted {
+				mon.Meter("concurrency_shed").Mark(1)
+				limiter.log.Debug("shed stream", zap.String("method", info.FullMethod))
+			}
+			return err
+		}
+		defer func() { <-method.slots }()
+
+		return handler(srv, ss)
+	}
+}
+
+// acquire takes a slot of the method, waiting in the queue when all slots are in use
+func (limiter *ConcurrencyLimiter) acquire(ctx context.Context, method *methodLimit) error {
+	select {
+	case method.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if atomic.AddInt64(&method.waiting, 1) > int64(limiter.config.QueueSize) {
+		atomic.AddInt64(&method.waiting, -1)
+		return status.Error(codes.ResourceExhausted, "too many concurrent requests, retry later")
+	}
+	defer atomic.AddInt64(&method.waiting, -1)
+
+	timer := time.NewTimer(limiter.config.QueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case method.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return status.Error(codes.ResourceExhausted, "too many concurrent requests, retry later")
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/server"
+)
+
+func TestConcurrencyLimiter(t *testing.T) {
+	limiter, err := server.NewConcurrencyLimiter(zap.NewNop(), server.ConcurrencyConfig{
+		Limits:       "pointerdb.PointerDB/Put=1",
+		QueueSize:    1,
+		QueueTimeout: 100 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	interceptor := limiter.UnaryInterceptor()
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 3)
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				started <- struct{}{}
+				<-release
+				return "ok", nil
+			})
+		return err
+	}
+	callNow := func(method string) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			})
+		return err
+	}
+
+	// the first request takes the only slot
+	first := make(chan error, 1)
+	go func() { first <- call("/pointerdb.PointerDB/Put") }()
+	<-started
+
+	// the second request waits in the queue until it times out
+	queued := make(chan error, 1)
+	go func() { queued <- call("/pointerdb.PointerDB/Put") }()
+	time.Sleep(10 * time.Millisecond)
+
+	// the queue is full, so the third request is shed immediately
+	assert.Equal(t, codes.ResourceExhausted, status.Code(callNow("/pointerdb.PointerDB/Put")))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(<-queued))
+
+	// other methods aren't limited
+	assert.NoError(t, callNow("/pointerdb.PointerDB/Get"))
+
+	close(release)
+	assert.NoError(t, <-first)
+
+	// the slot is released after the request
+	assert.NoError(t, callNow("/pointerdb.PointerDB/Put"))
+
+	_, err = server.NewConcurrencyLimiter(zap.NewNop(), server.ConcurrencyConfig{Limits: "pointerdb.PointerDB/Put"})
+	assert.Error(t, err)
+	_, err = server.NewConcurrencyLimiter(zap.NewNop(), server.ConcurrencyConfig{Limits: "Put=1"})
+	assert.Error(t, err)
+}
+
+// mockServerStream is a server stream, which only has a context
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *mockServerStream) Context() context.Context { return stream.ctx }
+
+func TestConcurrencyLimiterStream(t *testing.T) {
+	limiter, err := server.NewConcurrencyLimiter(zap.NewNop(), server.ConcurrencyConfig{
+		Limits:       "pointerdb.PointerDB/ListStream=1",
+		QueueSize:    0,
+		QueueTimeout: 100 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	interceptor := limiter.StreamInterceptor()
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	stream := &mockServerStream{ctx: context.Background()}
+	call := func(method string, handler grpc.StreamHandler) error {
+		return interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: method}, handler)
+	}
+	wait := func(srv interface{}, ss grpc.ServerStream) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	}
+	done := func(srv interface{}, ss grpc.ServerStream) error { return nil }
+
+	// the first stream holds the only slot until it ends
+	first := make(chan error, 1)
+	go func() { first <- call("/pointerdb.PointerDB/ListStream", wait) }()
+	<-started
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call("/pointerdb.PointerDB/ListStream", done)))
+	assert.NoError(t, call("/piecestore.PieceStoreRoutes/StoreResumable", done))
+
+	close(release)
+	assert.NoError(t, <-first)
+	assert.NoError(t, call("/pointerdb.PointerDB/ListStream", done))
+}
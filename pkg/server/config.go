@@ -40,7 +40,7 @@ func (sc Config) Run(ctx context.Context, identity *identity.FullIdentity, inter
 	}
 	defer func() { err = utils.CombineErrors(err, opts.RevDB.Close()) }()
 
-	server, err := New(opts, lis, interceptor, services...)
+	server, err := New(opts, lis, interceptor, nil, services...)
 	if err != nil {
 		return err
 	}
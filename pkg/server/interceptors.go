@@ -43,7 +43,8 @@ func unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServ
 	return resp, err
 }
 
-func combineInterceptors(a, b grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+// CombineInterceptors returns an interceptor which runs a and then b before the handler
+func CombineInterceptors(a, b grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		return a(ctx, req, info, func(actx context.Context, areq interface{}) (interface{}, error) {
 			return b(actx, areq, info, func(bctx context.Context, breq interface{}) (interface{}, error) {
@@ -52,3 +53,14 @@ func combineInterceptors(a, b grpc.UnaryServerInterceptor) grpc.UnaryServerInter
 		})
 	}
 }
+
+// CombineStreamInterceptors returns a stream interceptor which runs a and then b before the handler
+func CombineStreamInterceptors(a, b grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return a(srv, ss, info, func(asrv interface{}, ass grpc.ServerStream) error {
+			return b(asrv, ass, info, func(bsrv interface{}, bss grpc.ServerStream) error {
+				return handler(bsrv, bss)
+			})
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// Audience is the class of peers an endpoint is meant for
+type Audience int
+
+const (
+	// AudienceUplink is for endpoints called by uplinks
+	AudienceUplink = Audience(iota)
+	// AudienceNode is for endpoints called by other nodes
+	AudienceNode
+	// AudienceAdmin is for endpoints called by operators and their tools
+	AudienceAdmin
+)
+
+// String returns the name of the audience
+func (audience Audience) String() string {
+	switch audience {
+	case AudienceUplink:
+		return "uplink"
+	case AudienceNode:
+		return "node"
+	case AudienceAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+// Router runs a different interceptor chain for every audience, so that
+// e.g. uplink-facing endpoints can require api keys while node-facing
+// endpoints don't.
+//
+// Services are assigned to an audience by their full gRPC service name,
+// e.g. "pointerdb.PointerDB". Services which haven't been assigned belong
+// to the fallback audience. The router must be configured before the server
+// starts serving.
+type Router struct {
+	fallback Audience
+	chains   map[Audience]grpc.UnaryServerInterceptor
+	streams  map[Audience]grpc.StreamServerInterceptor
+	services map[string]Audience
+}
+
+// NewRouter creates a router, which routes unassigned services to fallback
+func NewRouter(fallback Audience) *Router {
+	return &Router{
+		fallback: fallback,
+		chains:   map[Audience]grpc.UnaryServerInterceptor{},
+		streams:  map[Audience]grpc.StreamServerInterceptor{},
+		services: map[string]Audience{},
+	}
+}
+
+// Chain appends interceptors to the chain of audience, they run in the
+// order they have been added
+func (router *Router) Chain(audience Audience, interceptors ...grpc.UnaryServerInterceptor) {
+	for _, interceptor := range interceptors {
+		if interceptor == nil {
+			continue
+		}
+		if chain, ok := router.chains[audience]; ok {
+			router.chains[audience] = CombineInterceptors(chain, interceptor)
+		} else {
+			router.chains[audience] = interceptor
+		}
+	}
+}
+
+// ChainStream appends stream interceptors to the chain of audience, they
+// run in the order they have been added
+func (router *Router) ChainStream(audience Audience, interceptors ...grpc.StreamServerInterceptor) {
+	for _, interceptor := range interceptors {
+		if interceptor == nil {
+			continue
+		}
+		if chain, ok := router.streams[audience]; ok {
+			router.streams[audience] = CombineStreamInterceptors(chain, interceptor)
+		} else {
+			router.streams[audience] = interceptor
+		}
+	}
+}
+
+// Assign assigns services to audience
+func (router *Router) Assign(audience Audience, services ...string) {
+	for _, service := range services {
+		router.services[service] = audience
+	}
+}
+
+// Audience returns the audience of the method, e.g. "/pointerdb.PointerDB/Get"
+func (router *Router) Audience(fullMethod string) Audience {
+	service := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(service, "/"); i >= 0 {
+		service = service[:i]
+	}
+	if audience, ok := router.services[service]; ok {
+		return audience
+	}
+	return router.fallback
+}
+
+// UnaryInterceptor returns an interceptor, which runs the chain of the
+// audience of the called method
+func (router *Router) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		chain, ok := router.chains[router.Audience(info.FullMethod)]
+		if !ok {
+			return handler(ctx, req)
+		}
+		return chain(ctx, req, info, handler)
+	}
+}
+
+// StreamInterceptor returns an interceptor, which runs the stream chain of
+// the audience of the called method
+func (router *Router) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chain, ok := router.streams[router.Audience(info.FullMethod)]
+		if !ok {
+			return handler(srv, ss)
+		}
+		return chain(srv, ss, info, handler)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"storj.io/storj/pkg/server"
+)
+
+func TestRouter(t *testing.T) {
+	router := server.NewRouter(server.AudienceUplink)
+	router.Assign(server.AudienceNode, "node.Nodes")
+	router.Assign(server.AudienceAdmin, "inspector.KadInspector")
+
+	var calls []string
+	record := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			calls = append(calls, name)
+			return handler(ctx, req)
+		}
+	}
+	router.Chain(server.AudienceUplink, record("apikey"), record("ratelimit"))
+	router.Chain(server.AudienceNode, record("node"))
+
+	interceptor := router.UnaryInterceptor()
+	call := func(method string) []string {
+		calls = nil
+		resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				calls = append(calls, "handler")
+				return "ok", nil
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+		return calls
+	}
+
+	assert.Equal(t, []string{"apikey", "ratelimit", "handler"}, call("/pointerdb.PointerDB/Get"))
+	assert.Equal(t, []string{"node", "handler"}, call("/node.Nodes/Query"))
+	assert.Equal(t, []string{"handler"}, call("/inspector.KadInspector/CountNodes"))
+
+	assert.Equal(t, server.AudienceNode, router.Audience("/node.Nodes/Ping"))
+	assert.Equal(t, server.AudienceUplink, router.Audience("/unknown.Service/Method"))
+}
+
+func TestRouterStream(t *testing.T) {
+	router := server.NewRouter(server.AudienceUplink)
+	router.Assign(server.AudienceNode, "node.Nodes")
+	router.Assign(server.AudienceAdmin, "inspector.KadInspector")
+
+	var calls []string
+	record := func(name string) grpc.StreamServerInterceptor {
+		return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			calls = append(calls, name)
+			return handler(srv, ss)
+		}
+	}
+	router.ChainStream(server.AudienceUplink, record("apikey"), nil, record("ratelimit"))
+	router.ChainStream(server.AudienceNode, record("node"))
+	// unary interceptors don't run for streams
+	router.Chain(server.AudienceAdmin, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		calls = append(calls, "unary")
+		return handler(ctx, req)
+	})
+
+	interceptor := router.StreamInterceptor()
+	call := func(method string) []string {
+		calls = nil
+		err := interceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: method},
+			func(srv interface{}, ss grpc.ServerStream) error {
+				calls = append(calls, "handler")
+				return nil
+			})
+		assert.NoError(t, err)
+		return calls
+	}
+
+	assert.Equal(t, []string{"apikey", "ratelimit", "handler"}, call("/pointerdb.PointerDB/ListStream"))
+	assert.Equal(t, []string{"node", "handler"}, call("/node.Nodes/Query"))
+	assert.Equal(t, []string{"handler"}, call("/inspector.KadInspector/CountNodes"))
+}
@@ -30,9 +30,9 @@ type Server struct {
 }
 
 // New creates a Server out of an Identity, a net.Listener,
-// a UnaryServerInterceptor, and a set of services.
+// a UnaryServerInterceptor, a StreamServerInterceptor, and a set of services.
 func New(opts *Options, lis net.Listener,
-	interceptor grpc.UnaryServerInterceptor, services ...Service) (
+	interceptor grpc.UnaryServerInterceptor, stream grpc.StreamServerInterceptor, services ...Service) (
 	*Server, error) {
 	grpcOpts, err := opts.grpcOpts()
 	if err != nil {
@@ -41,7 +41,11 @@ func New(opts *Options, lis net.Listener,
 
 	unaryInterceptor := unaryInterceptor
 	if interceptor != nil {
-		unaryInterceptor = combineInterceptors(unaryInterceptor, interceptor)
+		unaryInterceptor = CombineInterceptors(unaryInterceptor, interceptor)
+	}
+	streamInterceptor := streamInterceptor
+	if stream != nil {
+		streamInterceptor = CombineStreamInterceptors(streamInterceptor, stream)
 	}
 
 	return &Server{
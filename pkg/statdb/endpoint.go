@@ -0,0 +1,95 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+)
+
+// Endpoint lets storage nodes inspect their own statistics
+type Endpoint struct {
+	log     *zap.Logger
+	statdb  DB
+	pricing PricingConfig
+}
+
+// NewEndpoint creates a new statdb endpoint
+func NewEndpoint(log *zap.Logger, sdb DB, pricing PricingConfig) *Endpoint {
+	return &Endpoint{log: log, statdb: sdb, pricing: pricing}
+}
+
+// Reputation returns the audit and uptime statistics of the calling node
+func (endpoint *Endpoint) Reputation(ctx context.Context, req *pb.ReputationRequest) (*pb.ReputationResponse, error) {
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	stats, err := endpoint.statdb.Get(ctx, peer.ID)
+	if ErrNodeNotFound.Has(err) {
+		return nil, status.Error(codes.NotFound, "no statistics for node")
+	}
+	if err != nil {
+		endpoint.log.Error("reputation lookup failed", zap.String("node", peer.ID.String()), zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ReputationResponse{
+		NodeId:             stats.NodeID,
+		AuditCount:         stats.AuditCount,
+		AuditSuccessCount:  stats.AuditSuccessCount,
+		AuditRatio:         stats.AuditSuccessRatio,
+		UptimeCount:        stats.UptimeCount,
+		UptimeSuccessCount: stats.UptimeSuccessCount,
+		UptimeRatio:        stats.UptimeRatio,
+	}, nil
+}
+
+// Pricing returns the payout prices and the held amount schedule, the
+// calling node uses them with its own usage to estimate its payout
+func (endpoint *Endpoint) Pricing(ctx context.Context, req *pb.PricingRequest) (*pb.PricingResponse, error) {
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	held, err := ParseHeldSchedule(endpoint.pricing.HeldSchedule)
+	if err != nil {
+		endpoint.log.Error("invalid held schedule", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &pb.PricingResponse{
+		DiskSpaceTbMonth: endpoint.pricing.DiskSpace,
+		EgressTb:         endpoint.pricing.Egress,
+		RepairEgressTb:   endpoint.pricing.RepairEgress,
+		AuditEgressTb:    endpoint.pricing.AuditEgress,
+		HeldPercentages:  held,
+	}
+
+	// nodes without statistics haven't been seen yet and are in their first month
+	stats, err := endpoint.statdb.Get(ctx, peer.ID)
+	if ErrNodeNotFound.Has(err) {
+		return resp, nil
+	}
+	if err != nil {
+		endpoint.log.Error("pricing lookup failed", zap.String("node", peer.ID.String()), zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !stats.CreatedAt.IsZero() {
+		resp.Joined, err = ptypes.TimestampProto(stats.CreatedAt)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return resp, nil
+}
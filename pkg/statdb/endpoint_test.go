@@ -0,0 +1,105 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+// failingDB fails every lookup of node statistics
+type failingDB struct {
+	statdb.DB
+}
+
+func (failingDB) Get(ctx context.Context, nodeID storj.NodeID) (*statdb.NodeStats, error) {
+	return nil, statdb.Error.Wrap(errors.New("connection refused"))
+}
+
+func TestEndpoint(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		ident, err := testidentity.NewTestIdentity(ctx)
+		require.NoError(t, err)
+		peerCtx := peer.NewContext(ctx, &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP("1.2.3.4"), Port: 5},
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{ident.Leaf, ident.CA},
+				},
+			},
+		})
+
+		pricing := statdb.PricingConfig{DiskSpace: 150, HeldSchedule: "75,0"}
+		endpoint := statdb.NewEndpoint(zap.NewNop(), db.StatDB(), pricing)
+
+		{ // calls without a peer identity are rejected
+			_, err := endpoint.Reputation(ctx, &pb.ReputationRequest{})
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		}
+
+		{ // unknown nodes have no reputation, but are paid as new nodes
+			_, err := endpoint.Reputation(peerCtx, &pb.ReputationRequest{})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+
+			resp, err := endpoint.Pricing(peerCtx, &pb.PricingRequest{})
+			require.NoError(t, err)
+			assert.EqualValues(t, 150, resp.DiskSpaceTbMonth)
+			assert.Nil(t, resp.Joined)
+		}
+
+		{ // known nodes get their statistics
+			_, err := db.StatDB().Create(ctx, ident.ID, &statdb.NodeStats{
+				AuditCount:         10,
+				AuditSuccessCount:  8,
+				AuditSuccessRatio:  0.8,
+				UptimeCount:        4,
+				UptimeSuccessCount: 4,
+				UptimeRatio:        1,
+			})
+			require.NoError(t, err)
+
+			resp, err := endpoint.Reputation(peerCtx, &pb.ReputationRequest{})
+			require.NoError(t, err)
+			assert.Equal(t, ident.ID, resp.NodeId)
+			assert.EqualValues(t, 10, resp.AuditCount)
+			assert.EqualValues(t, 8, resp.AuditSuccessCount)
+			assert.EqualValues(t, 0.8, resp.AuditRatio)
+			assert.EqualValues(t, 4, resp.UptimeSuccessCount)
+			assert.EqualValues(t, 1, resp.UptimeRatio)
+		}
+
+		{ // failed lookups aren't reported as missing statistics
+			endpoint := statdb.NewEndpoint(zap.NewNop(), failingDB{db.StatDB()}, pricing)
+
+			_, err := endpoint.Reputation(peerCtx, &pb.ReputationRequest{})
+			assert.Equal(t, codes.Internal, status.Code(err))
+
+			_, err = endpoint.Pricing(peerCtx, &pb.PricingRequest{})
+			assert.Equal(t, codes.Internal, status.Code(err))
+		}
+	})
+}
@@ -50,3 +50,36 @@ func (srv *Inspector) CreateStats(ctx context.Context, req *pb.CreateStatsReques
 
 	return &pb.CreateStatsResponse{}, nil
 }
+
+// ListDisqualified returns the disqualified nodes, most recently disqualified first
+func (srv *Inspector) ListDisqualified(ctx context.Context, req *pb.ListDisqualifiedRequest) (*pb.ListDisqualifiedResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+
+	statslist, err := srv.statdb.ListDisqualified(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListDisqualifiedResponse{}
+	for _, stats := range statslist {
+		resp.Nodes = append(resp.Nodes, &pb.DisqualifiedNode{
+			NodeId:                stats.NodeID,
+			DisqualifiedAtUnixSec: stats.DisqualifiedAt.Unix(),
+			Reason:                stats.DisqualificationReason,
+		})
+	}
+	return resp, nil
+}
+
+// Reinstate lifts the disqualification of a node
+func (srv *Inspector) Reinstate(ctx context.Context, req *pb.ReinstateRequest) (*pb.ReinstateResponse, error) {
+	_, err := srv.statdb.Reinstate(ctx, req.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReinstateResponse{}, nil
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PricingConfig is the payout pricing of the satellite, storage nodes
+// retrieve it to estimate their payouts
+type PricingConfig struct {
+	DiskSpace    int64  `help:"payout for storing a TB for a month in US cents" default:"150"`
+	Egress       int64  `help:"payout for a TB of egress in US cents" default:"2000"`
+	RepairEgress int64  `help:"payout for a TB of repair egress in US cents" default:"1000"`
+	AuditEgress  int64  `help:"payout for a TB of audit egress in US cents" default:"1000"`
+	HeldSchedule string `help:"comma-separated percentages of the payout held back in each month of a node's age, the last one applies to all later months" default:"75,75,75,50,50,50,25,25,25,0"`
+}
+
+// Verify verifies whether the pricing is acceptable
+func (config PricingConfig) Verify() error {
+	if config.DiskSpace < 0 || config.Egress < 0 || config.RepairEgress < 0 || config.AuditEgress < 0 {
+		return Error.New("prices must not be negative")
+	}
+	_, err := ParseHeldSchedule(config.HeldSchedule)
+	return err
+}
+
+// ParseHeldSchedule parses comma-separated percentages of the held amount
+func ParseHeldSchedule(schedule string) (percentages []int32, err error) {
+	if strings.TrimSpace(schedule) == "" {
+		return nil, nil
+	}
+
+	for _, field := range strings.Split(schedule, ",") {
+		percentage, err := strconv.ParseInt(strings.TrimSpace(field), 10, 32)
+		if err != nil {
+			return nil, Error.New("invalid held percentage %q: %v", field, err)
+		}
+		if percentage < 0 || percentage > 100 {
+			return nil, Error.New("held percentage %d isn't between 0 and 100", percentage)
+		}
+		percentages = append(percentages, int32(percentage))
+	}
+	return percentages, nil
+}
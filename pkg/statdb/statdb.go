@@ -5,6 +5,8 @@ package statdb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -14,6 +16,8 @@ import (
 var (
 	// Error is the default errs class
 	Error = errs.Class("statdb error")
+	// ErrNodeNotFound is returned when there are no statistics for a node
+	ErrNodeNotFound = errs.Class("node not found")
 )
 
 // DB stores node statistics
@@ -30,10 +34,19 @@ type DB interface {
 	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error)
 	// UpdateAuditSuccess updates a single storagenode's audit stats.
 	UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool) (stats *NodeStats, err error)
-	// UpdateBatch for updating multiple storage nodes' stats.
+	// UpdateBatch updates multiple storage nodes' stats in a single transaction,
+	// the requests of unknown nodes are returned as failed.
 	UpdateBatch(ctx context.Context, requests []*UpdateRequest) (statslist []*NodeStats, failed []*UpdateRequest, err error)
 	// CreateEntryIfNotExists creates a node stats entry if it didn't already exist.
 	CreateEntryIfNotExists(ctx context.Context, nodeID storj.NodeID) (stats *NodeStats, err error)
+	// Vet marks the node as vetted when its stats meet the criteria, vetted nodes stay vetted.
+	Vet(ctx context.Context, nodeID storj.NodeID, criteria *VettingCriteria) (stats *NodeStats, err error)
+	// Disqualify marks the node as disqualified, the time and reason of an earlier disqualification are kept.
+	Disqualify(ctx context.Context, nodeID storj.NodeID, reason string) (stats *NodeStats, err error)
+	// Reinstate lifts the disqualification of the node.
+	Reinstate(ctx context.Context, nodeID storj.NodeID) (stats *NodeStats, err error)
+	// ListDisqualified returns up to limit disqualified nodes, most recently disqualified first.
+	ListDisqualified(ctx context.Context, limit int) (statslist []*NodeStats, err error)
 }
 
 // UpdateRequest is used to update a node status.
@@ -41,6 +54,49 @@ type UpdateRequest struct {
 	NodeID       storj.NodeID
 	AuditSuccess bool
 	IsUp         bool
+	// UptimeOnly leaves the audit stats unchanged, e.g. for nodes which were
+	// offline during an audit
+	UptimeOnly bool
+	// AuditOnly leaves the uptime stats unchanged
+	AuditOnly bool
+
+	// AuditLambda and UptimeLambda are the forgetting factors of the
+	// reputations, see ReputationConfig
+	AuditLambda  float64
+	UptimeLambda float64
+}
+
+// ReputationConfig configures how fast old audit and uptime results lose
+// their weight. Every update multiplies the weight of the earlier results by
+// the lambda of the metric, so 1 weighs all results equally and smaller
+// values let the recent behavior dominate.
+type ReputationConfig struct {
+	AuditLambda  float64 `help:"the forgetting factor of the audit reputation, 1 weighs all audits equally" default:"0.95"`
+	UptimeLambda float64 `help:"the forgetting factor of the uptime reputation, 1 weighs all uptime checks equally" default:"0.99"`
+}
+
+// UpdateReputation returns the reputation after a result, alpha and beta are
+// the weighted counts of the successes and failures. Lambdas outside of (0, 1]
+// weigh all results equally.
+func UpdateReputation(alpha, beta, lambda float64, success bool) (newAlpha, newBeta float64) {
+	if lambda <= 0 || lambda > 1 {
+		lambda = 1
+	}
+	newAlpha, newBeta = lambda*alpha, lambda*beta
+	if success {
+		newAlpha++
+	} else {
+		newBeta++
+	}
+	return newAlpha, newBeta
+}
+
+// ReputationRatio returns the ratio of the weighted successes, it's 0 without any result
+func ReputationRatio(alpha, beta float64) float64 {
+	if alpha+beta <= 0 {
+		return 0
+	}
+	return alpha / (alpha + beta)
 }
 
 // NodeStats contains statistics abot a node.
@@ -52,4 +108,89 @@ type NodeStats struct {
 	UptimeRatio        float64
 	UptimeSuccessCount int64
 	UptimeCount        int64
+	// the reputations are the exponentially weighted counts of successes
+	// (alpha) and failures (beta), the ratios are derived from them
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+	// LastContactSuccess and LastContactFailure are the times of the last
+	// successful and failed uptime checks, they're zero without any check
+	LastContactSuccess time.Time
+	LastContactFailure time.Time
+	// Vetted is whether the node passed vetting, VettedAt is when it passed
+	// or zero for new nodes
+	Vetted   bool
+	VettedAt time.Time
+	// Disqualified is whether the node has been disqualified, it isn't
+	// selected for uploads and its agreements are rejected until it's
+	// reinstated
+	Disqualified           bool
+	DisqualifiedAt         time.Time
+	DisqualificationReason string
+	// CreatedAt is when the node was first seen
+	CreatedAt time.Time
+}
+
+// VettingCriteria are the minimum stats a new node needs to be vetted, new
+// nodes receive only a limited fraction of the traffic until they're vetted.
+type VettingCriteria struct {
+	AuditCount        int64
+	AuditSuccessRatio float64
+	UptimeCount       int64
+	UptimeRatio       float64
+}
+
+// Met returns whether the stats meet the criteria
+func (criteria *VettingCriteria) Met(stats *NodeStats) bool {
+	return stats.AuditCount >= criteria.AuditCount &&
+		stats.AuditSuccessRatio >= criteria.AuditSuccessRatio &&
+		stats.UptimeCount >= criteria.UptimeCount &&
+		stats.UptimeRatio >= criteria.UptimeRatio
+}
+
+// DisqualificationConfig configures the stats nodes have to keep, nodes
+// falling below them are disqualified
+type DisqualificationConfig struct {
+	Enabled           bool    `help:"disqualify nodes, whose stats fall below the thresholds" default:"false"`
+	AuditCount        int64   `help:"the number of audits after which the audit success ratio of a node is checked" default:"100"`
+	AuditSuccessRatio float64 `help:"nodes with a lower ratio of successful audits are disqualified" default:"0.6"`
+	UptimeCount       int64   `help:"the number of uptime checks after which the uptime ratio of a node is checked" default:"100"`
+	UptimeRatio       float64 `help:"nodes with a lower ratio of successful uptime checks are disqualified" default:"0.6"`
+}
+
+// Criteria returns the disqualification criteria, they're nil when
+// disqualification is disabled
+func (c DisqualificationConfig) Criteria() *DisqualificationCriteria {
+	if !c.Enabled {
+		return nil
+	}
+	return &DisqualificationCriteria{
+		AuditCount:        c.AuditCount,
+		AuditSuccessRatio: c.AuditSuccessRatio,
+		UptimeCount:       c.UptimeCount,
+		UptimeRatio:       c.UptimeRatio,
+	}
+}
+
+// DisqualificationCriteria are the minimum ratios nodes have to keep. The
+// ratios are only checked after the counts, so a few early failures don't
+// disqualify new nodes.
+type DisqualificationCriteria struct {
+	AuditCount        int64
+	AuditSuccessRatio float64
+	UptimeCount       int64
+	UptimeRatio       float64
+}
+
+// Violated returns the reason the stats fall below the criteria, it's empty
+// when they don't
+func (criteria *DisqualificationCriteria) Violated(stats *NodeStats) string {
+	if stats.AuditCount >= criteria.AuditCount && stats.AuditSuccessRatio < criteria.AuditSuccessRatio {
+		return fmt.Sprintf("audit success ratio %.3f below %.3f", stats.AuditSuccessRatio, criteria.AuditSuccessRatio)
+	}
+	if stats.UptimeCount >= criteria.UptimeCount && stats.UptimeRatio < criteria.UptimeRatio {
+		return fmt.Sprintf("uptime ratio %.3f below %.3f", stats.UptimeRatio, criteria.UptimeRatio)
+	}
+	return ""
 }
@@ -196,6 +196,14 @@ func testDatabase(ctx context.Context, t *testing.T, sdb statdb.DB) {
 		assert.EqualValues(t, auditSuccessRatio, stats.AuditSuccessRatio)
 		assert.EqualValues(t, currAuditCount, stats.AuditCount)
 		assert.EqualValues(t, newUptimeRatio, stats.UptimeRatio)
+		assert.False(t, stats.LastContactFailure.IsZero())
+
+		stats, err = sdb.UpdateUptime(ctx, nodeID, true)
+		assert.NoError(t, err)
+
+		currUptimeCount++
+		currUptimeSuccess++
+		assert.False(t, stats.LastContactSuccess.Before(stats.LastContactFailure))
 	}
 
 	{ // TestUpdateAuditSuccessExists
@@ -296,5 +304,133 @@ func testDatabase(ctx context.Context, t *testing.T, sdb statdb.DB) {
 		assert.EqualValues(t, newUptimeRatio1, stats1.UptimeRatio)
 		assert.EqualValues(t, newAuditRatio2, stats2.AuditSuccessRatio)
 		assert.EqualValues(t, newUptimeRatio2, stats2.UptimeRatio)
+
+		// requests of unknown nodes fail without affecting the other requests
+		unknownReq := &statdb.UpdateRequest{NodeID: storj.NodeID{255, 3}, AuditSuccess: true, IsUp: true}
+		statsList, failed, err := sdb.UpdateBatch(ctx, []*statdb.UpdateRequest{
+			{NodeID: nodeID1, IsUp: false, UptimeOnly: true},
+			unknownReq,
+		})
+		assert.Error(t, err)
+		assert.Equal(t, []*statdb.UpdateRequest{unknownReq}, failed)
+		if assert.Len(t, statsList, 1) {
+			assert.EqualValues(t, auditCount1+1, statsList[0].AuditCount)
+			assert.EqualValues(t, newAuditRatio1, statsList[0].AuditSuccessRatio)
+			assert.EqualValues(t, uptimeCount1+2, statsList[0].UptimeCount)
+			assert.EqualValues(t, getRatio(uptimeSuccessCount1, uptimeCount1+2), statsList[0].UptimeRatio)
+		}
+	}
+
+	{ // TestVet
+		vetID := storj.NodeID{10}
+		stats, err := sdb.Create(ctx, vetID, &statdb.NodeStats{
+			AuditCount:         10,
+			AuditSuccessCount:  9,
+			UptimeCount:        10,
+			UptimeSuccessCount: 10,
+		})
+		assert.NoError(t, err)
+		assert.False(t, stats.Vetted)
+		assert.True(t, stats.VettedAt.IsZero())
+
+		criteria := &statdb.VettingCriteria{
+			AuditCount:        10,
+			AuditSuccessRatio: 0.95,
+			UptimeCount:       10,
+			UptimeRatio:       0.95,
+		}
+		assert.False(t, criteria.Met(stats))
+
+		// the node doesn't meet the audit success ratio
+		stats, err = sdb.Vet(ctx, vetID, criteria)
+		assert.NoError(t, err)
+		assert.False(t, stats.Vetted)
+
+		criteria.AuditSuccessRatio = 0.9
+		assert.True(t, criteria.Met(stats))
+		stats, err = sdb.Vet(ctx, vetID, criteria)
+		assert.NoError(t, err)
+		assert.True(t, stats.Vetted)
+		assert.False(t, stats.VettedAt.IsZero())
+		vettedAt := stats.VettedAt
+
+		// vetted nodes stay vetted, even when they don't meet the criteria anymore
+		_, err = sdb.UpdateAuditSuccess(ctx, vetID, false)
+		assert.NoError(t, err)
+		stats, err = sdb.Vet(ctx, vetID, criteria)
+		assert.NoError(t, err)
+		assert.True(t, stats.Vetted)
+		assert.True(t, vettedAt.Equal(stats.VettedAt))
+	}
+
+	{ // TestReputationDecay
+		decayID := storj.NodeID{8}
+		stats, err := sdb.Create(ctx, decayID, &statdb.NodeStats{
+			AuditCount:         1,
+			AuditSuccessCount:  0,
+			UptimeCount:        1,
+			UptimeSuccessCount: 1,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 0, stats.AuditReputationAlpha)
+		assert.EqualValues(t, 1, stats.AuditReputationBeta)
+
+		// the earlier failure weighs half as much as the recent success
+		stats, err = sdb.Update(ctx, &statdb.UpdateRequest{
+			NodeID:       decayID,
+			AuditSuccess: true,
+			IsUp:         true,
+			AuditLambda:  0.5,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, stats.AuditReputationAlpha)
+		assert.EqualValues(t, 0.5, stats.AuditReputationBeta)
+		assert.InDelta(t, 2.0/3.0, stats.AuditSuccessRatio, 1e-9)
+		assert.EqualValues(t, 2, stats.AuditCount)
+		assert.EqualValues(t, 1, stats.AuditSuccessCount)
+
+		// without a lambda all results weigh equally
+		stats, err = sdb.UpdateUptime(ctx, decayID, false)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, stats.UptimeReputationAlpha)
+		assert.EqualValues(t, 1, stats.UptimeReputationBeta)
+		assert.InDelta(t, 2.0/3.0, stats.UptimeRatio, 1e-9)
+		// uptime checks leave the audit reputation unchanged
+		assert.EqualValues(t, 1, stats.AuditReputationAlpha)
+		assert.EqualValues(t, 2, stats.AuditCount)
+	}
+	{ // TestDisqualify
+		disqualifyID := storj.NodeID{9}
+		stats, err := sdb.Create(ctx, disqualifyID, nil)
+		assert.NoError(t, err)
+		assert.False(t, stats.Disqualified)
+
+		stats, err = sdb.Disqualify(ctx, disqualifyID, "audit success ratio too low")
+		assert.NoError(t, err)
+		assert.True(t, stats.Disqualified)
+		assert.False(t, stats.DisqualifiedAt.IsZero())
+		assert.Equal(t, "audit success ratio too low", stats.DisqualificationReason)
+		disqualifiedAt := stats.DisqualifiedAt
+
+		// disqualifying again keeps the first disqualification
+		stats, err = sdb.Disqualify(ctx, disqualifyID, "uptime ratio too low")
+		assert.NoError(t, err)
+		assert.True(t, disqualifiedAt.Equal(stats.DisqualifiedAt))
+		assert.Equal(t, "audit success ratio too low", stats.DisqualificationReason)
+
+		disqualified, err := sdb.ListDisqualified(ctx, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, disqualified, 1) {
+			assert.Equal(t, disqualifyID, disqualified[0].NodeID)
+		}
+
+		stats, err = sdb.Reinstate(ctx, disqualifyID)
+		assert.NoError(t, err)
+		assert.False(t, stats.Disqualified)
+		assert.Equal(t, "", stats.DisqualificationReason)
+
+		disqualified, err = sdb.ListDisqualified(ctx, 10)
+		assert.NoError(t, err)
+		assert.Len(t, disqualified, 0)
 	}
 }
@@ -64,3 +64,9 @@ func (o *prefixedObjStore) List(ctx context.Context, prefix, startAfter, endBefo
 
 	return o.store.List(ctx, storj.JoinPaths(o.prefix, prefix), startAfter, endBefore, recursive, limit, metaFlags)
 }
+
+func (o *prefixedObjStore) ListFiltered(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32, filter storj.ListFilter) (items []objects.ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return o.store.ListFiltered(ctx, storj.JoinPaths(o.prefix, prefix), startAfter, endBefore, recursive, limit, metaFlags, filter)
+}
@@ -32,6 +32,7 @@ type Client interface {
 	Put(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, err error)
 	Get(ctx context.Context, nodes []*pb.Node, es eestream.ErasureScheme, pieceID psclient.PieceID, size int64, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (ranger.Ranger, error)
 	Delete(ctx context.Context, nodes []*pb.Node, pieceID psclient.PieceID, authorization *pb.SignedMessage) error
+	UploadStats() UploadStats
 }
 
 type psClientFunc func(context.Context, transport.Client, *pb.Node, int) (psclient.Client, error)
@@ -41,21 +42,72 @@ type ecClient struct {
 	transport       transport.Client
 	memoryLimit     int
 	newPSClientFunc psClientFunc
+	stats           UploadStats
+	observer        PieceObserver
+	allocations     psclient.AllocationSource
+	psConfig        psclient.Config
+	ledger          *psclient.Ledger
 }
 
 // NewClient from the given identity and max buffer memory
 func NewClient(identity *identity.FullIdentity, memoryLimit int) Client {
+	return NewObservedClient(identity, memoryLimit, nil)
+}
+
+// NewObservedClient returns a client, which notifies observer about every
+// piece upload
+func NewObservedClient(identity *identity.FullIdentity, memoryLimit int, observer PieceObserver) Client {
+	return NewRenewingClient(identity, memoryLimit, observer, nil)
+}
+
+// NewRenewingClient returns an observed client, which renews the payer
+// bandwidth allocations of piece transfers from allocations when they expire
+// before the transfer finishes
+func NewRenewingClient(identity *identity.FullIdentity, memoryLimit int, observer PieceObserver, allocations psclient.AllocationSource) Client {
+	return NewConfiguredClient(identity, memoryLimit, observer, allocations, psclient.Config{})
+}
+
+// NewConfiguredClient returns a renewing client, whose piece store clients
+// transfer the pieces with psConfig
+func NewConfiguredClient(identity *identity.FullIdentity, memoryLimit int, observer PieceObserver, allocations psclient.AllocationSource, psConfig psclient.Config) Client {
+	return NewAccountedClient(identity, memoryLimit, observer, allocations, psConfig, nil)
+}
+
+// NewAccountedClient returns a configured client, whose piece store clients
+// record the bandwidth allocations they sign in ledger, it may be nil
+func NewAccountedClient(identity *identity.FullIdentity, memoryLimit int, observer PieceObserver, allocations psclient.AllocationSource, psConfig psclient.Config, ledger *psclient.Ledger) Client {
 	tc := transport.NewClient(identity)
+	newPSClientFunc := psclient.NewPSClient
+	if psConfig.PoolSize > 0 {
+		newPSClientFunc = psclient.NewPool(psConfig.PoolSize, psConfig.PoolIdleTimeout).NewPSClient
+	}
 	return &ecClient{
 		transport:       tc,
 		memoryLimit:     memoryLimit,
-		newPSClientFunc: psclient.NewPSClient,
+		newPSClientFunc: newPSClientFunc,
+		observer:        observer,
+		allocations:     allocations,
+		psConfig:        psConfig,
+		ledger:          ledger,
 	}
 }
 
 func (ec *ecClient) newPSClient(ctx context.Context, n *pb.Node) (psclient.Client, error) {
 	n.Type.DPanicOnInvalid("new ps client")
-	return ec.newPSClientFunc(ctx, ec.transport, n, 0)
+	ps, err := ec.newPSClientFunc(ctx, ec.transport, n, 0)
+	if err != nil {
+		return nil, err
+	}
+	if renewable, ok := ps.(psclient.Renewable); ok && ec.allocations != nil {
+		renewable.SetAllocationSource(ec.allocations)
+	}
+	if configurable, ok := ps.(psclient.Configurable); ok {
+		configurable.SetConfig(ec.psConfig)
+	}
+	if accountable, ok := ps.(psclient.Accountable); ok && ec.ledger != nil {
+		accountable.SetLedger(ec.ledger)
+	}
+	return ps, nil
 }
 
 func (ec *ecClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, err error) {
@@ -95,7 +147,16 @@ func (ec *ecClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.Redun
 		}
 
 		go func(i int, node *pb.Node) {
+			pieceStart := time.Now()
 			err := ec.putPiece(psCtx, ctx, node, pieceID, readers[i], expiration, pba, authorization)
+			if node != nil {
+				outcome := classifyPut(err)
+				ec.stats.add(outcome)
+				mon.Meter("piece_upload_" + outcome.String()).Mark(1)
+				if ec.observer != nil {
+					ec.observer.ObservePut(node.Id, outcome, time.Since(pieceStart))
+				}
+			}
 			infos <- info{i: i, err: err}
 		}(i, node)
 	}
@@ -129,6 +190,10 @@ func (ec *ecClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.Redun
 		}
 	}
 
+	if attempted := nonNilCount(nodes); attempted > 0 {
+		mon.FloatVal("segment_upload_success_rate").Observe(float64(successfulCount) / float64(attempted))
+	}
+
 	/* clean up the partially uploaded segment's pieces */
 	defer func() {
 		select {
@@ -165,7 +230,7 @@ func (ec *ecClient) putPiece(ctx, parent context.Context, node *pb.Node, pieceID
 	if err != nil {
 		zap.S().Errorf("Failed dialing for putting piece %s -> %s to node %s: %v",
 			pieceID, derivedPieceID, node.Id, err)
-		return err
+		return errDial.Wrap(err)
 	}
 	err = ps.Put(ctx, derivedPieceID, data, expiration, pba, authorization)
 	defer func() { err = errs.Combine(err, ps.Close()) }()
@@ -190,6 +255,11 @@ func (ec *ecClient) putPiece(ctx, parent context.Context, node *pb.Node, pieceID
 	return err
 }
 
+// UploadStats returns the outcomes of all piece uploads of the client
+func (ec *ecClient) UploadStats() UploadStats {
+	return ec.stats.load()
+}
+
 func (ec *ecClient) Get(ctx context.Context, nodes []*pb.Node, es eestream.ErasureScheme,
 	pieceID psclient.PieceID, size int64, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (rr ranger.Ranger, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -16,6 +16,7 @@ import (
 	pb "storj.io/storj/pkg/pb"
 	client "storj.io/storj/pkg/piecestore/psclient"
 	ranger "storj.io/storj/pkg/ranger"
+	ecclient "storj.io/storj/pkg/storage/ec"
 )
 
 // MockClient is a mock of Client interface
@@ -78,3 +79,15 @@ func (m *MockClient) Put(arg0 context.Context, arg1 []*pb.Node, arg2 eestream.Re
 func (mr *MockClientMockRecorder) Put(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockClient)(nil).Put), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 }
+
+// UploadStats mocks base method
+func (m *MockClient) UploadStats() ecclient.UploadStats {
+	ret := m.ctrl.Call(m, "UploadStats")
+	ret0, _ := ret[0].(ecclient.UploadStats)
+	return ret0
+}
+
+// UploadStats indicates an expected call of UploadStats
+func (mr *MockClientMockRecorder) UploadStats() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadStats", reflect.TypeOf((*MockClient)(nil).UploadStats))
+}
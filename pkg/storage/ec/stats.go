@@ -0,0 +1,228 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/storj"
+)
+
+// PutOutcome is the outcome of uploading a piece to a node
+type PutOutcome int
+
+const (
+	// PutSuccess means the node stored the piece
+	PutSuccess PutOutcome = iota
+	// PutTimeout means the node didn't respond in time
+	PutTimeout
+	// PutRefused means the node couldn't be dialed or declined the piece
+	PutRefused
+	// PutFailed means the upload failed for any other reason
+	PutFailed
+	// PutCanceled means the upload was canceled, because enough pieces
+	// were uploaded or the user canceled it
+	PutCanceled
+)
+
+// String returns the name of the outcome
+func (outcome PutOutcome) String() string {
+	switch outcome {
+	case PutSuccess:
+		return "success"
+	case PutTimeout:
+		return "timeout"
+	case PutRefused:
+		return "refused"
+	case PutFailed:
+		return "failed"
+	case PutCanceled:
+		return "canceled"
+	default:
+		return "unknown"
+	}
+}
+
+// errDial marks errors of dialing a node
+var errDial = errs.Class("dial error")
+
+// classifyPut returns the outcome of a piece upload, which returned err
+func classifyPut(err error) PutOutcome {
+	if err == nil {
+		return PutSuccess
+	}
+
+	dialFailed := errDial.Has(err)
+	err = errs.Unwrap(err)
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return PutTimeout
+	}
+
+	code := status.Code(err)
+	switch {
+	case err == context.Canceled || code == codes.Canceled:
+		return PutCanceled
+	case err == context.DeadlineExceeded || code == codes.DeadlineExceeded:
+		return PutTimeout
+	case dialFailed:
+		return PutRefused
+	}
+
+	if _, ok := err.(*net.OpError); ok {
+		return PutRefused
+	}
+	switch code {
+	case codes.Unavailable, codes.PermissionDenied, codes.ResourceExhausted, codes.Unauthenticated:
+		return PutRefused
+	default:
+		return PutFailed
+	}
+}
+
+// UploadStats counts the outcomes of piece uploads
+type UploadStats struct {
+	Success  int64
+	Timeout  int64
+	Refused  int64
+	Failed   int64
+	Canceled int64
+}
+
+// add counts an outcome, it's safe to call concurrently
+func (stats *UploadStats) add(outcome PutOutcome) {
+	switch outcome {
+	case PutSuccess:
+		atomic.AddInt64(&stats.Success, 1)
+	case PutTimeout:
+		atomic.AddInt64(&stats.Timeout, 1)
+	case PutRefused:
+		atomic.AddInt64(&stats.Refused, 1)
+	case PutFailed:
+		atomic.AddInt64(&stats.Failed, 1)
+	case PutCanceled:
+		atomic.AddInt64(&stats.Canceled, 1)
+	}
+}
+
+// load returns a copy of stats, it's safe to call concurrently with add
+func (stats *UploadStats) load() UploadStats {
+	return UploadStats{
+		Success:  atomic.LoadInt64(&stats.Success),
+		Timeout:  atomic.LoadInt64(&stats.Timeout),
+		Refused:  atomic.LoadInt64(&stats.Refused),
+		Failed:   atomic.LoadInt64(&stats.Failed),
+		Canceled: atomic.LoadInt64(&stats.Canceled),
+	}
+}
+
+// Sub returns the outcomes counted since earlier
+func (stats UploadStats) Sub(earlier UploadStats) UploadStats {
+	return UploadStats{
+		Success:  stats.Success - earlier.Success,
+		Timeout:  stats.Timeout - earlier.Timeout,
+		Refused:  stats.Refused - earlier.Refused,
+		Failed:   stats.Failed - earlier.Failed,
+		Canceled: stats.Canceled - earlier.Canceled,
+	}
+}
+
+// Attempts returns the number of finished uploads, canceled uploads aren't
+// counted, because they didn't fail
+func (stats UploadStats) Attempts() int64 {
+	return stats.Success + stats.Timeout + stats.Refused + stats.Failed
+}
+
+// SuccessRate returns the ratio of successful uploads to finished uploads
+func (stats UploadStats) SuccessRate() float64 {
+	attempts := stats.Attempts()
+	if attempts == 0 {
+		return 0
+	}
+	return float64(stats.Success) / float64(attempts)
+}
+
+// PieceObserver is notified about the piece uploads of a client, e.g. to
+// measure the performance of nodes. It's called concurrently.
+type PieceObserver interface {
+	ObservePut(node storj.NodeID, outcome PutOutcome, duration time.Duration)
+}
+
+// Reporter receives anonymized upload statistics
+type Reporter interface {
+	ReportUploadStats(ctx context.Context, stats *pb.UploadStats) error
+}
+
+// reportingClient reports the upload statistics of a client
+type reportingClient struct {
+	Client
+	reporter Reporter
+	interval time.Duration
+
+	mu         sync.Mutex
+	reported   UploadStats
+	lastReport time.Time
+}
+
+// NewReportingClient returns a client, which reports the upload statistics of
+// client to reporter at most once per interval. Reports only contain outcome
+// counts, they don't identify nodes or data. Reporting piggybacks on uploads,
+// so a client which doesn't upload doesn't report.
+func NewReportingClient(client Client, reporter Reporter, interval time.Duration) Client {
+	return &reportingClient{
+		Client:   client,
+		reporter: reporter,
+		interval: interval,
+	}
+}
+
+// Put uploads the pieces and reports the upload statistics when they are due
+func (client *reportingClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (successfulNodes []*pb.Node, err error) {
+	successfulNodes, err = client.Client.Put(ctx, nodes, rs, pieceID, data, expiration, pba, authorization)
+	client.report(ctx)
+	return successfulNodes, err
+}
+
+// report sends the outcomes since the last report, when the interval passed
+func (client *reportingClient) report(ctx context.Context) {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	if time.Since(client.lastReport) < client.interval {
+		return
+	}
+
+	current := client.Client.UploadStats()
+	delta := current.Sub(client.reported)
+	if delta.Attempts() == 0 && delta.Canceled == 0 {
+		return
+	}
+
+	err := client.reporter.ReportUploadStats(ctx, &pb.UploadStats{
+		Success:  delta.Success,
+		Timeout:  delta.Timeout,
+		Refused:  delta.Refused,
+		Failed:   delta.Failed,
+		Canceled: delta.Canceled,
+	})
+	client.lastReport = time.Now()
+	if err != nil {
+		// the outcomes are sent with the next report
+		zap.S().Debugf("Failed reporting upload statistics: %v", err)
+		return
+	}
+	client.reported = current
+}
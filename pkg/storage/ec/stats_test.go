@@ -0,0 +1,114 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ecclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psclient"
+)
+
+func TestClassifyPut(t *testing.T) {
+	for i, tt := range []struct {
+		err     error
+		outcome PutOutcome
+	}{
+		{nil, PutSuccess},
+		{context.Canceled, PutCanceled},
+		{context.DeadlineExceeded, PutTimeout},
+		{Error.Wrap(context.DeadlineExceeded), PutTimeout},
+		{errDial.Wrap(status.Error(codes.DeadlineExceeded, "timeout")), PutTimeout},
+		{errDial.New("connection refused"), PutRefused},
+		{&net.OpError{Op: "dial", Err: errors.New("connection refused")}, PutRefused},
+		{status.Error(codes.Unavailable, "unavailable"), PutRefused},
+		{status.Error(codes.Canceled, "canceled"), PutCanceled},
+		{errors.New("disk full"), PutFailed},
+	} {
+		assert.Equal(t, tt.outcome, classifyPut(tt.err), i)
+	}
+}
+
+func TestUploadStats(t *testing.T) {
+	var stats UploadStats
+	for _, outcome := range []PutOutcome{PutSuccess, PutSuccess, PutSuccess, PutTimeout, PutCanceled} {
+		stats.add(outcome)
+	}
+
+	current := stats.load()
+	assert.Equal(t, UploadStats{Success: 3, Timeout: 1, Canceled: 1}, current)
+	assert.EqualValues(t, 4, current.Attempts())
+	assert.Equal(t, 0.75, current.SuccessRate())
+	assert.Equal(t, 0.0, UploadStats{}.SuccessRate())
+
+	stats.add(PutRefused)
+	assert.Equal(t, UploadStats{Refused: 1}, stats.load().Sub(current))
+}
+
+type countingClient struct {
+	Client
+	stats UploadStats
+}
+
+func (client *countingClient) Put(ctx context.Context, nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, data io.Reader, expiration time.Time, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) ([]*pb.Node, error) {
+	client.stats.add(PutSuccess)
+	client.stats.add(PutRefused)
+	return nil, nil
+}
+
+func (client *countingClient) UploadStats() UploadStats { return client.stats.load() }
+
+type recordingReporter struct {
+	reports []pb.UploadStats
+	err     error
+}
+
+func (reporter *recordingReporter) ReportUploadStats(ctx context.Context, stats *pb.UploadStats) error {
+	if reporter.err != nil {
+		return reporter.err
+	}
+	reporter.reports = append(reporter.reports, *stats)
+	return nil
+}
+
+func TestReportingClient(t *testing.T) {
+	ctx := context.Background()
+
+	reporter := &recordingReporter{err: errors.New("unavailable")}
+	client := NewReportingClient(&countingClient{}, reporter, 0)
+
+	// failed reports are sent with the next report
+	_, err := client.Put(ctx, nil, eestream.RedundancyStrategy{}, "", nil, time.Time{}, nil, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, reporter.reports)
+
+	reporter.err = nil
+	_, err = client.Put(ctx, nil, eestream.RedundancyStrategy{}, "", nil, time.Time{}, nil, nil)
+	assert.NoError(t, err)
+	_, err = client.Put(ctx, nil, eestream.RedundancyStrategy{}, "", nil, time.Time{}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []pb.UploadStats{
+		{Success: 2, Refused: 2},
+		{Success: 1, Refused: 1},
+	}, reporter.reports)
+
+	// reports aren't sent more often than the interval
+	reporter = &recordingReporter{}
+	client = NewReportingClient(&countingClient{}, reporter, time.Hour)
+	for i := 0; i < 3; i++ {
+		_, err = client.Put(ctx, nil, eestream.RedundancyStrategy{}, "", nil, time.Time{}, nil, nil)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, []pb.UploadStats{{Success: 1, Refused: 1}}, reporter.reports)
+}
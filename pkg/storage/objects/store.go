@@ -27,7 +27,7 @@ type Meta struct {
 	Modified   time.Time
 	Expiration time.Time
 	Size       int64
-	Checksum   string
+	Checksum   []byte
 }
 
 // ListItem is a single item in a listing
@@ -44,6 +44,7 @@ type Store interface {
 	Put(ctx context.Context, path storj.Path, data io.Reader, metadata pb.SerializableMeta, expiration time.Time) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	ListFiltered(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32, filter storj.ListFilter) (items []ListItem, more bool, err error)
 }
 
 type objStore struct {
@@ -127,7 +128,14 @@ func (o *objStore) List(ctx context.Context, prefix, startAfter, endBefore storj
 	items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	strItems, more, err := o.store.List(ctx, prefix, startAfter, endBefore, o.pathCipher, recursive, limit, metaFlags)
+	return o.ListFiltered(ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags, storj.ListFilter{})
+}
+
+func (o *objStore) ListFiltered(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32, filter storj.ListFilter) (
+	items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	strItems, more, err := o.store.ListFiltered(ctx, prefix, startAfter, endBefore, o.pathCipher, recursive, limit, metaFlags, filter)
 	if err != nil {
 		return nil, false, err
 	}
@@ -155,6 +163,7 @@ func convertMeta(m streams.Meta) Meta {
 		Modified:         m.Modified,
 		Expiration:       m.Expiration,
 		Size:             m.Size,
+		Checksum:         m.Checksum,
 		SerializableMeta: ser,
 	}
 }
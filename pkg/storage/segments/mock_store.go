@@ -12,6 +12,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 
+	pb "storj.io/storj/pkg/pb"
 	ranger "storj.io/storj/pkg/ranger"
 	storj "storj.io/storj/pkg/storj"
 )
@@ -103,6 +104,18 @@ func (mr *MockStoreMockRecorder) Delete(ctx, path interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), ctx, path)
 }
 
+// DeletePieces mocks base method
+func (m *MockStore) DeletePieces(ctx context.Context, pointer *pb.Pointer) error {
+	ret := m.ctrl.Call(m, "DeletePieces", ctx, pointer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePieces indicates an expected call of DeletePieces
+func (mr *MockStoreMockRecorder) DeletePieces(ctx, pointer interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePieces", reflect.TypeOf((*MockStore)(nil).DeletePieces), ctx, pointer)
+}
+
 // List mocks base method
 func (m *MockStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) ([]ListItem, bool, error) {
 	ret := m.ctrl.Call(m, "List", ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags)
@@ -116,3 +129,17 @@ func (m *MockStore) List(ctx context.Context, prefix, startAfter, endBefore stor
 func (mr *MockStoreMockRecorder) List(ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStore)(nil).List), ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags)
 }
+
+// ListFiltered mocks base method
+func (m *MockStore) ListFiltered(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32, filter storj.ListFilter) ([]ListItem, bool, error) {
+	ret := m.ctrl.Call(m, "ListFiltered", ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags, filter)
+	ret0, _ := ret[0].([]ListItem)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFiltered indicates an expected call of ListFiltered
+func (mr *MockStoreMockRecorder) ListFiltered(ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags, filter interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiltered", reflect.TypeOf((*MockStore)(nil).ListFiltered), ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags, filter)
+}
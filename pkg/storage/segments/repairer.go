@@ -5,34 +5,59 @@ package segments
 
 import (
 	"context"
+	"time"
 
 	"github.com/zeebo/errs"
+	"go.uber.org/zap"
 
+	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/piecestore/psclient"
 	"storj.io/storj/pkg/pointerdb/pdbclient"
+	"storj.io/storj/pkg/statdb"
 	ecclient "storj.io/storj/pkg/storage/ec"
 	"storj.io/storj/pkg/storj"
 )
 
+// the causes of failed repairs, which are counted separately
+const (
+	failedPointer  = "pointer"
+	failedOverlay  = "overlay"
+	failedDownload = "download"
+	failedUpload   = "upload"
+)
+
 // Repairer for segments
 type Repairer struct {
 	oc        overlay.Client
 	ec        ecclient.Client
 	pdb       pdbclient.Client
 	nodeStats *pb.NodeStats
+	statdb    statdb.DB
 }
 
-// NewSegmentRepairer creates a new instance of SegmentRepairer
-func NewSegmentRepairer(oc overlay.Client, ec ecclient.Client, pdb pdbclient.Client) *Repairer {
-	return &Repairer{oc: oc, ec: ec, pdb: pdb}
+// NewSegmentRepairer creates a new instance of SegmentRepairer. Nodes which
+// return corrupted erasure shares during repair are recorded as failed
+// audits in sdb, when it is not nil.
+func NewSegmentRepairer(oc overlay.Client, ec ecclient.Client, pdb pdbclient.Client, sdb statdb.DB) *Repairer {
+	return &Repairer{oc: oc, ec: ec, pdb: pdb, statdb: sdb}
 }
 
 // Repair retrieves an at-risk segment and repairs and stores lost pieces on new nodes
 func (s *Repairer) Repair(ctx context.Context, path storj.Path, lostPieces []int32) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	start := time.Now()
+	cause := failedPointer
+	defer func() {
+		if err != nil {
+			mon.Meter("repair_failed_" + cause).Mark(1)
+			return
+		}
+		mon.FloatVal("repair_segment_seconds").Observe(time.Since(start).Seconds())
+	}()
+
 	// Read the segment's pointer's info from the PointerDB
 	pr, originalNodes, _, err := s.pdb.Get(ctx, path)
 	if err != nil {
@@ -46,6 +71,7 @@ func (s *Repairer) Repair(ctx context.Context, path storj.Path, lostPieces []int
 	seg := pr.GetRemote()
 	pid := psclient.PieceID(seg.GetPieceId())
 
+	cause = failedOverlay
 	originalNodes, err = lookupAndAlignNodes(ctx, s.oc, originalNodes, seg)
 	if err != nil {
 		return Error.Wrap(err)
@@ -120,23 +146,28 @@ func (s *Repairer) Repair(ctx context.Context, path storj.Path, lostPieces []int
 		return Error.Wrap(err)
 	}
 
+	cause = failedDownload
 	signedMessage := s.pdb.SignedMessage()
 	pbaGet, err := s.pdb.PayerBandwidthAllocation(ctx, pb.BandwidthAction_GET_REPAIR)
 	if err != nil {
 		return Error.Wrap(err)
 	}
-	// Download the segment using just the healthyNodes
-	rr, err := s.ec.Get(ctx, healthyNodes, rs, pid, pr.GetSegmentSize(), pbaGet, signedMessage)
+	// Download the segment using just the healthyNodes, verifying every share.
+	// Nobody waits for the repair, so the nodes may serve other downloads first.
+	downloadCtx := psclient.WithPriority(ctx, pb.PieceRetrieval_BATCH)
+	verifier := eestream.NewShareVerifier(rs)
+	rr, err := s.ec.Get(downloadCtx, healthyNodes, verifier, pid, pr.GetSegmentSize(), pbaGet, signedMessage)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
-	r, err := rr.Range(ctx, 0, rr.Size())
+	r, err := rr.Range(downloadCtx, 0, rr.Size())
 	if err != nil {
 		return Error.Wrap(err)
 	}
 	defer func() { err = errs.Combine(err, r.Close()) }()
 
+	cause = failedUpload
 	pbaPut, err := s.pdb.PayerBandwidthAllocation(ctx, pb.BandwidthAction_PUT_REPAIR)
 	if err != nil {
 		return Error.Wrap(err)
@@ -148,13 +179,27 @@ func (s *Repairer) Repair(ctx context.Context, path storj.Path, lostPieces []int
 	}
 
 	// Merge the successful nodes list into the healthy nodes list
+	recreated := 0
 	for i, v := range healthyNodes {
 		if v == nil {
 			// copy the successfuNode info
 			healthyNodes[i] = successfulNodes[i]
+			if successfulNodes[i] != nil {
+				recreated++
+			}
 		}
 	}
 
+	// at least the required pieces were downloaded to decode the segment
+	pieceSize := calcPieceSize(rr.Size(), rs)
+	mon.IntVal("repair_bytes_downloaded").Observe(pieceSize * int64(rs.RequiredCount()))
+	mon.IntVal("repair_bytes_uploaded").Observe(pieceSize * int64(recreated))
+	mon.IntVal("repair_shares_recreated").Observe(int64(recreated))
+
+	// Drop the pieces of nodes that returned corrupted shares, so the segment
+	// gets repaired again once the checker notices them missing
+	s.dropCorrupted(ctx, healthyNodes, verifier.CorruptedPieces())
+
 	metadata := pr.GetMetadata()
 	pointer, err := makeRemotePointer(healthyNodes, rs, pid, rr.Size(), pr.GetExpirationDate(), metadata)
 	if err != nil {
@@ -162,5 +207,40 @@ func (s *Repairer) Repair(ctx context.Context, path storj.Path, lostPieces []int
 	}
 
 	// update the segment info in the pointerDB
+	cause = failedPointer
 	return s.pdb.Put(ctx, path, pointer)
 }
+
+// calcPieceSize returns the size of the pieces of a segment of size bytes
+func calcPieceSize(size int64, rs eestream.RedundancyStrategy) int64 {
+	// pointers without an erasure share size can't be padded to stripes
+	if stripeSize := int64(rs.StripeSize()); stripeSize > 0 {
+		if mod := size % stripeSize; mod != 0 {
+			size += stripeSize - mod
+		}
+	}
+	return size / int64(rs.RequiredCount())
+}
+
+// dropCorrupted removes the nodes of the corrupted pieces and records a failed
+// audit for each of them
+func (s *Repairer) dropCorrupted(ctx context.Context, nodes []*pb.Node, pieces []int) {
+	for _, num := range pieces {
+		node := nodes[num]
+		if node == nil {
+			continue
+		}
+		nodes[num] = nil
+
+		mon.Meter("repair_corrupted_pieces").Mark(1)
+		zap.L().Warn("node returned corrupted shares during repair",
+			zap.Stringer("node", node.Id), zap.Int("piece", num))
+
+		if s.statdb == nil {
+			continue
+		}
+		if _, err := s.statdb.UpdateAuditSuccess(ctx, node.Id, false); err != nil {
+			zap.L().Error("failed to record failed audit", zap.Stringer("node", node.Id), zap.Error(err))
+		}
+	}
+}
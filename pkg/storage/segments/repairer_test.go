@@ -27,7 +27,7 @@ func TestNewSegmentRepairer(t *testing.T) {
 	mockEC := mock_ecclient.NewMockClient(ctrl)
 	mockPDB := mock_pointerdb.NewMockClient(ctrl)
 
-	ss := NewSegmentRepairer(mockOC, mockEC, mockPDB)
+	ss := NewSegmentRepairer(mockOC, mockEC, mockPDB, nil)
 	assert.NotNil(t, ss)
 }
 
@@ -75,7 +75,7 @@ func TestSegmentStoreRepairRemote(t *testing.T) {
 		mockEC := mock_ecclient.NewMockClient(ctrl)
 		mockPDB := mock_pointerdb.NewMockClient(ctrl)
 
-		sr := Repairer{mockOC, mockEC, mockPDB, &pb.NodeStats{}}
+		sr := Repairer{mockOC, mockEC, mockPDB, &pb.NodeStats{}, nil}
 		assert.NotNil(t, sr)
 
 		calls := []*gomock.Call{
@@ -50,7 +50,9 @@ type Store interface {
 	Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error)
 	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
+	DeletePieces(ctx context.Context, pointer *pb.Pointer) (err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	ListFiltered(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32, filter storj.ListFilter) (items []ListItem, more bool, err error)
 }
 
 type segmentStore struct {
@@ -59,16 +61,27 @@ type segmentStore struct {
 	pdb           pdbclient.Client
 	rs            eestream.RedundancyStrategy
 	thresholdSize int
+	// newNodeRatio is the fraction of new nodes requested for uploads, the
+	// satellite's ratio is used when it's negative
+	newNodeRatio float64
 }
 
 // NewSegmentStore creates a new instance of segmentStore
 func NewSegmentStore(oc overlay.Client, ec ecclient.Client, pdb pdbclient.Client, rs eestream.RedundancyStrategy, threshold int) Store {
+	return NewSegmentStoreWithNewNodeRatio(oc, ec, pdb, rs, threshold, -1)
+}
+
+// NewSegmentStoreWithNewNodeRatio creates a new instance of segmentStore,
+// which requests the ratio of new nodes for uploads, a negative ratio uses
+// the satellite's ratio
+func NewSegmentStoreWithNewNodeRatio(oc overlay.Client, ec ecclient.Client, pdb pdbclient.Client, rs eestream.RedundancyStrategy, threshold int, newNodeRatio float64) Store {
 	return &segmentStore{
 		oc:            oc,
 		ec:            ec,
 		pdb:           pdb,
 		rs:            rs,
 		thresholdSize: threshold,
+		newNodeRatio:  newNodeRatio,
 	}
 }
 
@@ -119,13 +132,17 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 		sizedReader := SizeReader(peekReader)
 
 		// uses overlay client to request a list of nodes according to configured standards
-		nodes, err := s.oc.Choose(ctx,
-			overlay.Options{
-				Amount:    s.rs.TotalCount(),
-				Bandwidth: sizedReader.Size() / int64(s.rs.TotalCount()),
-				Space:     sizedReader.Size() / int64(s.rs.TotalCount()),
-				Excluded:  nil,
-			})
+		options := overlay.Options{
+			Amount:    s.rs.TotalCount(),
+			Bandwidth: sizedReader.Size() / int64(s.rs.TotalCount()),
+			Space:     sizedReader.Size() / int64(s.rs.TotalCount()),
+			Excluded:  nil,
+		}
+		if s.newNodeRatio >= 0 {
+			newNodeRatio := s.newNodeRatio
+			options.NewNodeRatio = &newNodeRatio
+		}
+		nodes, err := s.oc.Choose(ctx, options)
 		if err != nil {
 			return Meta{}, Error.Wrap(err)
 		}
@@ -275,24 +292,8 @@ func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error)
 	}
 
 	if pr.GetType() == pb.Pointer_REMOTE {
-		seg := pr.GetRemote()
-		pid := psclient.PieceID(seg.PieceId)
-
-		nodes, err = lookupAndAlignNodes(ctx, s.oc, nodes, seg)
-		if err != nil {
-			return Error.Wrap(err)
-		}
-		for _, v := range nodes {
-			if v != nil {
-				v.Type.DPanicOnInvalid("ss delete")
-			}
-		}
-
-		authorization := s.pdb.SignedMessage()
-		// ecclient sends delete request
-		err = s.ec.Delete(ctx, nodes, pid, authorization)
-		if err != nil {
-			return Error.Wrap(err)
+		if err = s.deletePieces(ctx, pr, nodes); err != nil {
+			return err
 		}
 	}
 
@@ -300,11 +301,54 @@ func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error)
 	return s.pdb.Delete(ctx, path)
 }
 
+// DeletePieces deletes the pieces of a remote segment from the storage nodes,
+// e.g. after its pointer was deleted by the pointerdb
+func (s *segmentStore) DeletePieces(ctx context.Context, pointer *pb.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if pointer.GetType() != pb.Pointer_REMOTE {
+		return nil
+	}
+	return s.deletePieces(ctx, pointer, nil)
+}
+
+// deletePieces deletes the pieces of a remote segment from the nodes, which
+// are looked up when they aren't known
+func (s *segmentStore) deletePieces(ctx context.Context, pointer *pb.Pointer, nodes []*pb.Node) (err error) {
+	seg := pointer.GetRemote()
+	pid := psclient.PieceID(seg.PieceId)
+
+	nodes, err = lookupAndAlignNodes(ctx, s.oc, nodes, seg)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, v := range nodes {
+		if v != nil {
+			v.Type.DPanicOnInvalid("ss delete")
+		}
+	}
+
+	authorization := s.pdb.SignedMessage()
+	// ecclient sends delete request
+	err = s.ec.Delete(ctx, nodes, pid, authorization)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return nil
+}
+
 // List retrieves paths to segments and their metadata stored in the pointerdb
 func (s *segmentStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	pdbItems, more, err := s.pdb.List(ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags)
+	return s.ListFiltered(ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags, storj.ListFilter{})
+}
+
+// ListFiltered is like List, but the pointerdb only returns the segments matching the filter
+func (s *segmentStore) ListFiltered(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32, filter storj.ListFilter) (items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pdbItems, more, err := s.pdb.ListFiltered(ctx, prefix, startAfter, endBefore, recursive, limit, metaFlags, filter)
 	if err != nil {
 		return nil, false, err
 	}
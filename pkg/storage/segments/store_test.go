@@ -57,7 +57,7 @@ func TestSegmentStoreMeta(t *testing.T) {
 		ErasureScheme: mock_eestream.NewMockErasureScheme(ctrl),
 	}
 
-	ss := segmentStore{mockOC, mockEC, mockPDB, rs, 10}
+	ss := segmentStore{mockOC, mockEC, mockPDB, rs, 10, -1}
 	assert.NotNil(t, ss)
 
 	var mExp time.Time
@@ -106,7 +106,7 @@ func TestSegmentStorePutRemote(t *testing.T) {
 			ErasureScheme: mockES,
 		}
 
-		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize}
+		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize, -1}
 		assert.NotNil(t, ss)
 
 		calls := []*gomock.Call{
@@ -164,7 +164,7 @@ func TestSegmentStorePutInline(t *testing.T) {
 			ErasureScheme: mockES,
 		}
 
-		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize}
+		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize, -1}
 		assert.NotNil(t, ss)
 
 		calls := []*gomock.Call{
@@ -210,7 +210,7 @@ func TestSegmentStoreGetInline(t *testing.T) {
 			ErasureScheme: mockES,
 		}
 
-		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize}
+		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize, -1}
 		assert.NotNil(t, ss)
 
 		calls := []*gomock.Call{
@@ -257,7 +257,7 @@ func TestSegmentStoreGetRemote(t *testing.T) {
 			ErasureScheme: mockES,
 		}
 
-		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize}
+		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize, -1}
 		assert.NotNil(t, ss)
 
 		calls := []*gomock.Call{
@@ -320,7 +320,7 @@ func TestSegmentStoreDeleteInline(t *testing.T) {
 			ErasureScheme: mockES,
 		}
 
-		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize}
+		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize, -1}
 		assert.NotNil(t, ss)
 
 		calls := []*gomock.Call{
@@ -370,7 +370,7 @@ func TestSegmentStoreDeleteRemote(t *testing.T) {
 			ErasureScheme: mockES,
 		}
 
-		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize}
+		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize, -1}
 		assert.NotNil(t, ss)
 
 		calls := []*gomock.Call{
@@ -432,7 +432,7 @@ func TestSegmentStoreList(t *testing.T) {
 			ErasureScheme: mockES,
 		}
 
-		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize}
+		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize, -1}
 		assert.NotNil(t, ss)
 
 		ti := time.Unix(0, 0).UTC()
@@ -440,9 +440,9 @@ func TestSegmentStoreList(t *testing.T) {
 		assert.NoError(t, err)
 
 		calls := []*gomock.Call{
-			mockPDB.EXPECT().List(
+			mockPDB.EXPECT().ListFiltered(
 				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-				gomock.Any(), gomock.Any(), gomock.Any(),
+				gomock.Any(), gomock.Any(), gomock.Any(), storj.ListFilter{},
 			).Return([]pdb.ListItem{
 				{
 					Path: tt.itemPath,
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -36,6 +37,8 @@ type Meta struct {
 	Expiration time.Time
 	Size       int64
 	Data       []byte
+	// Checksum is the SHA-256 of the plaintext content
+	Checksum []byte
 }
 
 // convertMeta converts segment metadata to stream metadata
@@ -51,6 +54,7 @@ func convertMeta(lastSegmentMeta segments.Meta) (Meta, error) {
 		Expiration: lastSegmentMeta.Expiration,
 		Size:       ((stream.NumberOfSegments - 1) * stream.SegmentsSize) + stream.LastSegmentSize,
 		Data:       stream.Metadata,
+		Checksum:   stream.Checksum,
 	}, nil
 }
 
@@ -61,6 +65,7 @@ type Store interface {
 	Put(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
 	Delete(ctx context.Context, path storj.Path, pathCipher storj.Cipher) error
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	ListFiltered(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32, filter storj.ListFilter) (items []ListItem, more bool, err error)
 }
 
 // streamStore is a store for streams
@@ -70,6 +75,10 @@ type streamStore struct {
 	rootKey      *storj.Key
 	encBlockSize int
 	cipher       storj.Cipher
+
+	// deterministic derives the content keys and key nonces instead of
+	// generating random ones
+	deterministic bool
 }
 
 // NewStreamStore stuff
@@ -93,6 +102,47 @@ func NewStreamStore(segments segments.Store, segmentSize int64, rootKey *storj.K
 	}, nil
 }
 
+// NewDeterministicStreamStore returns a stream store, which derives the
+// content key and key nonce of every segment from the root key, the path and
+// the segment index instead of generating random ones, so uploading the same
+// content to the same path results in byte-identical segments.
+//
+// Uploading different content to the same path reuses the keys and nonces of
+// the earlier upload, which lets anyone who has both versions of the
+// encrypted content learn about their plaintexts. It's only meant for tests
+// and deduplication experiments.
+func NewDeterministicStreamStore(segments segments.Store, segmentSize int64, rootKey *storj.Key, encBlockSize int, cipher storj.Cipher) (Store, error) {
+	store, err := NewStreamStore(segments, segmentSize, rootKey, encBlockSize, cipher)
+	if err != nil {
+		return nil, err
+	}
+	store.(*streamStore).deterministic = true
+	return store, nil
+}
+
+// segmentKey returns the key for encrypting the content of a segment and
+// the nonce for encrypting that key with the derived key of the path
+func (s *streamStore) segmentKey(derivedKey *storj.Key, segment int64) (contentKey storj.Key, keyNonce storj.Nonce, err error) {
+	if !s.deterministic {
+		if _, err := rand.Read(contentKey[:]); err != nil {
+			return contentKey, keyNonce, err
+		}
+		_, err = rand.Read(keyNonce[:])
+		return contentKey, keyNonce, err
+	}
+
+	key, err := encryption.DeriveKey(derivedKey, fmt.Sprintf("content-key:%d", segment))
+	if err != nil {
+		return contentKey, keyNonce, err
+	}
+	nonce, err := encryption.DeriveKey(derivedKey, fmt.Sprintf("key-nonce:%d", segment))
+	if err != nil {
+		return contentKey, keyNonce, err
+	}
+	copy(keyNonce[:], nonce[:])
+	return *key, keyNonce, nil
+}
+
 // Put breaks up data as it comes in into s.segmentSize length pieces, then
 // store the first piece at s0/<path>, second piece at s1/<path>, and the
 // *last* piece at l/<path>. Store the given metadata, along with the number
@@ -135,12 +185,15 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 		return Meta{}, currentSegment, err
 	}
 
-	eofReader := NewEOFReader(data)
+	// the checksum covers the plaintext, the last segment is stored after
+	// all of the content has been read, so it's complete by then
+	checksum := sha256.New()
+	eofReader := NewEOFReader(io.TeeReader(data, checksum))
 
 	for !eofReader.isEOF() && !eofReader.hasError() {
-		// generate random key for encrypting the segment's content
-		var contentKey storj.Key
-		_, err = rand.Read(contentKey[:])
+		// generate the key for encrypting the segment's content and the
+		// nonce for encrypting that key
+		contentKey, keyNonce, err := s.segmentKey(derivedKey, currentSegment)
 		if err != nil {
 			return Meta{}, currentSegment, err
 		}
@@ -149,7 +202,7 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 		// The increment by 1 is to avoid nonce reuse with the metadata encryption,
 		// which is encrypted with the zero nonce.
 		var contentNonce storj.Nonce
-		_, err := encryption.Increment(&contentNonce, currentSegment+1)
+		_, err = encryption.Increment(&contentNonce, currentSegment+1)
 		if err != nil {
 			return Meta{}, currentSegment, err
 		}
@@ -159,13 +212,6 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 			return Meta{}, currentSegment, err
 		}
 
-		// generate random nonce for encrypting the content key
-		var keyNonce storj.Nonce
-		_, err = rand.Read(keyNonce[:])
-		if err != nil {
-			return Meta{}, currentSegment, err
-		}
-
 		encryptedKey, err := encryption.EncryptKey(&contentKey, s.cipher, derivedKey, &keyNonce)
 		if err != nil {
 			return Meta{}, currentSegment, err
@@ -225,6 +271,7 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 				SegmentsSize:     s.segmentSize,
 				LastSegmentSize:  sizeReader.Size(),
 				Metadata:         metadata,
+				Checksum:         checksum.Sum(nil),
 			})
 			if err != nil {
 				return "", nil, err
@@ -240,6 +287,7 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 				EncryptedStreamInfo: encryptedStreamInfo,
 				EncryptionType:      int32(s.cipher),
 				EncryptionBlockSize: int32(s.encBlockSize),
+				StreamSize:          streamSize + sizeReader.Size(),
 			}
 
 			if s.cipher != storj.Unencrypted {
@@ -273,6 +321,7 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 		Expiration: expiration,
 		Size:       streamSize,
 		Data:       metadata,
+		Checksum:   checksum.Sum(nil),
 	}
 
 	return resultMeta, currentSegment, nil
@@ -453,9 +502,16 @@ type ListItem struct {
 func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if metaFlags&meta.Size != 0 {
-		// Calculating the stream's size require also the user-defined metadata,
-		// where stream store keeps info about the number of segments and their size.
+	return s.ListFiltered(ctx, prefix, startAfter, endBefore, pathCipher, recursive, limit, metaFlags, storj.ListFilter{})
+}
+
+// ListFiltered is like List, but only the streams matching the filter are listed
+func (s *streamStore) ListFiltered(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32, filter storj.ListFilter) (items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if metaFlags&(meta.Size|meta.Checksum) != 0 {
+		// Calculating the stream's size and checksum require also the user-defined metadata,
+		// where stream store keeps info about the number of segments, their size and the checksum.
 		metaFlags |= meta.UserDefined
 	}
 
@@ -481,7 +537,7 @@ func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore st
 		return nil, false, err
 	}
 
-	segments, more, err := s.segments.List(ctx, storj.JoinPaths("l", encPrefix), encStartAfter, encEndBefore, recursive, limit, metaFlags)
+	segments, more, err := s.segments.ListFiltered(ctx, storj.JoinPaths("l", encPrefix), encStartAfter, encEndBefore, recursive, limit, metaFlags, filter)
 	if err != nil {
 		return nil, false, err
 	}
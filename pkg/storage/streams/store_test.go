@@ -5,6 +5,7 @@ package streams
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/ranger"
@@ -119,11 +121,13 @@ func TestStreamStorePut(t *testing.T) {
 		Data:       []byte{},
 	}
 
+	checksum := sha256.Sum256([]byte("data"))
 	streamMeta := Meta{
 		Modified:   segmentMeta.Modified,
 		Expiration: segmentMeta.Expiration,
 		Size:       4,
 		Data:       []byte("metadata"),
+		Checksum:   checksum[:],
 	}
 
 	for i, test := range []struct {
@@ -353,7 +357,7 @@ func TestStreamStoreList(t *testing.T) {
 		errTag := fmt.Sprintf("Test case #%d", i)
 
 		mockSegmentStore.EXPECT().
-			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			ListFiltered(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), storj.ListFilter{}).
 			Return(test.segments, test.segmentMore, test.segmentError)
 
 		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, 0)
@@ -370,3 +374,29 @@ func TestStreamStoreList(t *testing.T) {
 		assert.Equal(t, test.streamMore, more, errTag)
 	}
 }
+
+func TestSegmentKey(t *testing.T) {
+	derivedKey := storj.Key{1, 2, 3}
+
+	random := &streamStore{}
+	key1, nonce1, err := random.segmentKey(&derivedKey, 0)
+	require.NoError(t, err)
+	key2, nonce2, err := random.segmentKey(&derivedKey, 0)
+	require.NoError(t, err)
+	assert.NotEqual(t, key1, key2)
+	assert.NotEqual(t, nonce1, nonce2)
+
+	deterministic := &streamStore{deterministic: true}
+	key1, nonce1, err = deterministic.segmentKey(&derivedKey, 0)
+	require.NoError(t, err)
+	key2, nonce2, err = deterministic.segmentKey(&derivedKey, 0)
+	require.NoError(t, err)
+	assert.Equal(t, key1, key2)
+	assert.Equal(t, nonce1, nonce2)
+
+	// every segment uses different keys
+	key3, nonce3, err := deterministic.segmentKey(&derivedKey, 1)
+	require.NoError(t, err)
+	assert.NotEqual(t, key1, key3)
+	assert.NotEqual(t, nonce1, nonce3)
+}
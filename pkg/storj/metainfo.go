@@ -34,6 +34,9 @@ type Metainfo interface {
 	ModifyObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
 	// DeleteObject deletes an object from database
 	DeleteObject(ctx context.Context, bucket string, path Path) error
+	// DeleteObjects deletes all objects under prefix and returns the number of deleted objects,
+	// wholeBucket has to be set to delete every object of the bucket with an empty prefix
+	DeleteObjects(ctx context.Context, bucket string, prefix Path, wholeBucket bool) (deleted int64, err error)
 	// ListObjects lists objects in bucket based on the ListOptions
 	ListObjects(ctx context.Context, bucket string, options ListOptions) (ObjectList, error)
 
@@ -95,6 +98,22 @@ type ListOptions struct {
 	Recursive bool
 	Direction ListDirection
 	Limit     int
+	// Filter is evaluated by the satellite, prefixes are always listed
+	Filter ListFilter
+}
+
+// ListFilter restricts listed objects by their size and modification time,
+// zero values are ignored
+type ListFilter struct {
+	MinSize        int64
+	MaxSize        int64
+	ModifiedAfter  time.Time
+	ModifiedBefore time.Time
+}
+
+// IsZero returns true when the filter doesn't restrict anything
+func (filter ListFilter) IsZero() bool {
+	return filter == ListFilter{}
 }
 
 // ObjectList is a list of objects
@@ -121,6 +140,7 @@ func (opts ListOptions) NextPage(list ObjectList) ListOptions {
 			Cursor:    list.Items[0].Path,
 			Direction: Before,
 			Limit:     opts.Limit,
+			Filter:    opts.Filter,
 		}
 	case After, Forward:
 		return ListOptions{
@@ -128,6 +148,7 @@ func (opts ListOptions) NextPage(list ObjectList) ListOptions {
 			Cursor:    list.Items[len(list.Items)-1].Path,
 			Direction: After,
 			Limit:     opts.Limit,
+			Filter:    opts.Filter,
 		}
 	}
 
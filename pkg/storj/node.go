@@ -5,6 +5,7 @@ package storj
 
 import (
 	"crypto/sha256"
+	"encoding/json"
 	"math/bits"
 
 	"github.com/btcsuite/btcutil/base58"
@@ -144,8 +145,13 @@ func (id NodeID) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON deserializes a json string (as bytes) to a node ID
 func (id *NodeID) UnmarshalJSON(data []byte) error {
+	var unquoted string
+	if err := json.Unmarshal(data, &unquoted); err != nil {
+		return ErrNodeID.Wrap(err)
+	}
+
 	var err error
-	*id, err = NodeIDFromString(string(data))
+	*id, err = NodeIDFromString(unquoted)
 	if err != nil {
 		return err
 	}
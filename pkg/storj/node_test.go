@@ -5,9 +5,11 @@ package storj_test
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"storj.io/storj/pkg/storj"
 )
@@ -61,3 +63,18 @@ func TestNodeID_Difficulty(t *testing.T) {
 		assert.Equal(t, testcase.difficulty, difficulty)
 	}
 }
+
+func TestNodeID_JSON(t *testing.T) {
+	id := storj.NodeID{1, 2, 3}
+
+	data, err := json.Marshal(id)
+	require.NoError(t, err)
+	assert.Equal(t, `"`+id.String()+`"`, string(data))
+
+	var decoded storj.NodeID
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, id, decoded)
+
+	assert.Error(t, json.Unmarshal([]byte(`"invalid"`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`1`), &decoded))
+}
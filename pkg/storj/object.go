@@ -21,6 +21,9 @@ var (
 
 	// ErrObjectNotFound is an error class for non-existing object
 	ErrObjectNotFound = errs.Class("object not found")
+
+	// ErrObjectLocked is an error class for objects, which can't be deleted or overwritten
+	ErrObjectLocked = errs.Class("object locked")
 )
 
 // Bucket contains information about a specific bucket
@@ -51,7 +54,7 @@ type Object struct {
 type Stream struct {
 	// Size is the total size of the stream in bytes
 	Size int64
-	// Checksum is the checksum of the segment checksums
+	// Checksum is the SHA-256 checksum of the content
 	Checksum []byte
 
 	// SegmentCount is the number of segments
@@ -0,0 +1,106 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package stream
+
+import (
+	"context"
+	"io"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+// DownloadAt downloads the whole stream into w, downloading up to parallelism
+// segments concurrently and writing each of them at its offset.
+//
+// A segment which fails to download is retried up to retries times before
+// the download fails.
+func DownloadAt(ctx context.Context, stream storj.ReadOnlyStream, streams streams.Store, w io.WriterAt, parallelism, retries int) (err error) {
+	obj := stream.Info()
+
+	rr, _, err := streams.Get(ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher)
+	if err != nil {
+		return err
+	}
+
+	// segments are the unit of retrying, streams with segments of different
+	// size are downloaded in one piece
+	chunkSize := obj.FixedSegmentSize
+	if chunkSize <= 0 {
+		chunkSize = obj.Size
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	limiter := make(chan struct{}, parallelism)
+
+	for offset := int64(0); offset < obj.Size; offset += chunkSize {
+		offset, length := offset, chunkSize
+		if offset+length > obj.Size {
+			length = obj.Size - offset
+		}
+
+		select {
+		case limiter <- struct{}{}:
+		case <-ctx.Done():
+			// the error of the failed segment is more useful than the cancellation
+			if err := group.Wait(); err != nil {
+				return err
+			}
+			return ctx.Err()
+		}
+
+		group.Go(func() (err error) {
+			defer func() { <-limiter }()
+
+			for attempt := 0; ; attempt++ {
+				err = downloadRange(ctx, rr, w, offset, length)
+				if err == nil || attempt >= retries || ctx.Err() != nil {
+					return err
+				}
+				zap.S().Debugf("retrying download of %d bytes at offset %d: %v", length, offset, err)
+			}
+		})
+	}
+
+	return group.Wait()
+}
+
+// downloadRange downloads length bytes at offset of rr into w at the same offset
+func downloadRange(ctx context.Context, rr ranger.Ranger, w io.WriterAt, offset, length int64) (err error) {
+	reader, err := rr.Range(ctx, offset, length)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	n, err := io.Copy(&offsetWriter{w: w, offset: offset}, reader)
+	if err != nil {
+		return err
+	}
+	if n != length {
+		return Error.New("downloaded %d bytes at offset %d, expected %d", n, offset, length)
+	}
+	return nil
+}
+
+// offsetWriter writes sequentially into a WriterAt starting at offset
+type offsetWriter struct {
+	w      io.WriterAt
+	offset int64
+}
+
+// Write implements io.Writer
+func (writer *offsetWriter) Write(data []byte) (int, error) {
+	n, err := writer.w.WriteAt(data, writer.offset)
+	writer.offset += int64(n)
+	return n, err
+}
@@ -21,7 +21,7 @@ type Upload struct {
 	ctx      context.Context
 	stream   storj.MutableStream
 	streams  streams.Store
-	writer   io.WriteCloser
+	writer   *io.PipeWriter
 	closed   bool
 	errgroup errgroup.Group
 }
@@ -84,3 +84,22 @@ func (upload *Upload) Close() error {
 	// Wait for streams.Put to commit the upload to the PointerDB
 	return utils.CombineErrors(err, upload.errgroup.Wait())
 }
+
+// CloseWithError aborts the upload, the segments which have already been
+// uploaded are deleted instead of being committed as a truncated object.
+func (upload *Upload) CloseWithError(cause error) error {
+	if upload.closed {
+		return Error.New("already closed")
+	}
+
+	upload.closed = true
+
+	err := upload.writer.CloseWithError(cause)
+
+	// Wait for streams.Put to clean up the uploaded segments
+	putErr := upload.errgroup.Wait()
+	if putErr == cause {
+		putErr = nil
+	}
+	return utils.CombineErrors(err, putErr)
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package takeout
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// ServeHTTP implements the takeout admin api:
+//
+//	POST /exports/<project id>   requests the export of a project, a running export is returned as it is
+//	GET  /exports/<project id>   returns the progress and the manifest of the export of a project
+func (service *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) != 2 || parts[0] != "exports" {
+		http.NotFound(w, r)
+		return
+	}
+
+	projectID, err := uuid.Parse(parts[1])
+	if err != nil {
+		http.Error(w, "invalid project id: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		export, err := service.Request(ctx, *projectID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusAccepted, export)
+
+	case http.MethodGet:
+		export, err := service.exports.Get(ctx, *projectID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if export == nil {
+			http.Error(w, "project wasn't exported", http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, export)
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// writeJSON answers with value encoded as json
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(value)
+}
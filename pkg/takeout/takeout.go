@@ -0,0 +1,284 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package takeout
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pkcrypto"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/storage"
+)
+
+// Config contains configurable values for exporting projects
+type Config struct {
+	Interval       time.Duration `help:"how often to continue the exports of projects, 0 disables exporting" default:"1m"`
+	BatchSize      int           `help:"maximum number of objects exported per project and run" default:"1000"`
+	LinkBaseURL    string        `help:"base url of the link sharing service, which serves the download links of the manifests" default:""`
+	LinkExpiration time.Duration `help:"how long the download links of a manifest are valid" default:"168h0m0s"`
+}
+
+// Service exports the objects of projects into manifests of signed download
+// links in batches and records the progress, so an export continues where
+// it stopped. Paths stay encrypted, only the uplink can decrypt them.
+type Service struct {
+	log       *zap.Logger
+	exports   console.ProjectExports
+	pointerdb *pointerdb.Service
+	identity  *identity.FullIdentity
+	config    Config
+
+	Chore *chore.Chore
+}
+
+// New creates a new takeout service
+func New(log *zap.Logger, exports console.ProjectExports, pointerdb *pointerdb.Service, identity *identity.FullIdentity, config Config) *Service {
+	service := &Service{
+		log:       log,
+		exports:   exports,
+		pointerdb: pointerdb,
+		identity:  identity,
+		config:    config,
+	}
+	service.Chore = chore.New(log, "takeout", config.Interval, service.export)
+	return service
+}
+
+// Run continues the exports of projects every interval
+func (service *Service) Run(ctx context.Context) error {
+	if service.config.Interval <= 0 {
+		return nil
+	}
+	return service.Chore.Run(ctx)
+}
+
+// Request requests the export of a project by an admin. A running export is
+// returned as it is, a finished one is replaced by a new export.
+func (service *Service) Request(ctx context.Context, projectID uuid.UUID) (export *console.ProjectExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	export, err = service.exports.Get(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if export != nil && !export.Finished() {
+		return export, nil
+	}
+
+	// exports requested by an admin have no requesting user
+	export, err = service.exports.Insert(ctx, projectID, uuid.UUID{})
+	return export, Error.Wrap(err)
+}
+
+// export exports a batch of objects of every unfinished export
+func (service *Service) export(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exports, err := service.exports.GetUnfinished(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for i := range exports {
+		if err := service.ExportProject(ctx, &exports[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportProject adds the next batch of objects of a project to the manifest
+// of the export and marks the export finished, when nothing is left.
+func (service *Service) ExportProject(ctx context.Context, export *console.ProjectExport) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if export.Finished() {
+		return nil
+	}
+
+	project := export.ProjectID.String()
+	prefix := project + "/l/"
+
+	batch, err := service.nextBatch(prefix, export.LastPath)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if len(batch) == 0 {
+		export.FinishedAt = time.Now().UTC()
+		if err := service.exports.Update(ctx, export); err != nil {
+			return Error.Wrap(err)
+		}
+
+		mon.Meter("exported_projects").Mark(1)
+		mon.IntVal("exported_project_objects").Observe(export.ExportedObjects)
+		mon.IntVal("exported_project_bytes").Observe(export.ExportedBytes)
+		service.log.Info("exported project",
+			zap.String("Project ID", project),
+			zap.Int64("objects", export.ExportedObjects),
+			zap.Int64("bytes", export.ExportedBytes),
+			zap.Duration("took", export.FinishedAt.Sub(export.RequestedAt)))
+		return nil
+	}
+
+	// the links of a batch expire together, so a manifest expires in the order it was exported
+	expires := time.Now().Add(service.config.LinkExpiration).UTC().Truncate(time.Second)
+
+	for _, item := range batch {
+		pointer := &pb.Pointer{}
+		if err := proto.Unmarshal(item.Value, pointer); err != nil {
+			return Error.New("error unmarshaling pointer %q: %v", item.Key, err)
+		}
+
+		// the last segment of an object is stored at <project>/l/<bucket>/<path>
+		bucketPath := strings.SplitN(strings.TrimPrefix(item.Key.String(), prefix), "/", 2)
+		if len(bucketPath) != 2 {
+			service.log.Debug("skipping object without bucket", zap.String("key", item.Key.String()))
+			export.LastPath = item.Key.String()
+			continue
+		}
+		bucket, path := bucketPath[0], bucketPath[1]
+
+		segments, size, err := service.objectSize(project, bucket, path, pointer)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		link, err := service.Link(export.ProjectID, bucket, path, expires)
+		if err != nil {
+			return err
+		}
+
+		export.Manifest = append(export.Manifest, console.ExportedObject{
+			Bucket:   bucket,
+			Path:     path,
+			Segments: segments,
+			Size:     size,
+			URL:      link,
+			Expires:  expires,
+		})
+		export.ExportedObjects++
+		export.ExportedBytes += size
+		export.LastPath = item.Key.String()
+	}
+	mon.Meter("exported_objects").Mark(len(batch))
+
+	return Error.Wrap(service.exports.Update(ctx, export))
+}
+
+// nextBatch returns the last segments of the next objects to export
+func (service *Service) nextBatch(prefix, last string) (batch storage.Items, err error) {
+	err = service.pointerdb.Iterate(prefix, last, true, false,
+		func(it storage.Iterator) error {
+			var item storage.ListItem
+			for len(batch) < service.config.BatchSize && it.Next(&item) {
+				// the iteration starts at the last exported object
+				if item.Key.String() == last {
+					continue
+				}
+				batch = append(batch, storage.CloneItem(item))
+			}
+			return nil
+		})
+	return batch, err
+}
+
+// objectSize returns the number of segments and the size of an object, the
+// segments before the last one are stored at <project>/s<index>/<bucket>/<path>
+func (service *Service) objectSize(project, bucket, path string, last *pb.Pointer) (segments, size int64, err error) {
+	segments, size = 1, last.GetSegmentSize()
+	for index := 0; ; index++ {
+		pointer, err := service.pointerdb.Get(fmt.Sprintf("%s/s%d/%s/%s", project, index, bucket, path))
+		if storage.ErrKeyNotFound.Has(err) {
+			return segments, size, nil
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		segments++
+		size += pointer.GetSegmentSize()
+	}
+}
+
+// Link returns a link, which downloads an object of a project until it
+// expires. The link is signed by the satellite and grants access to this
+// object only.
+func (service *Service) Link(projectID uuid.UUID, bucket, path string, expires time.Time) (string, error) {
+	signature, err := pkcrypto.HashAndSign(service.identity.Key, linkData(projectID, bucket, path, expires))
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	segments := []string{projectID.String(), url.PathEscape(bucket)}
+	for _, segment := range strings.Split(path, "/") {
+		segments = append(segments, url.PathEscape(segment))
+	}
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	query.Set("signature", base64.RawURLEncoding.EncodeToString(signature))
+
+	return strings.TrimSuffix(service.config.LinkBaseURL, "/") + "/" + strings.Join(segments, "/") + "?" + query.Encode(), nil
+}
+
+// VerifyLink checks the signature and the expiration of a download link and
+// returns the object it grants access to
+func (service *Service) VerifyLink(link string, now time.Time) (projectID uuid.UUID, bucket, path string, err error) {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return projectID, "", "", Error.Wrap(err)
+	}
+	base, err := url.Parse(service.config.LinkBaseURL)
+	if err != nil {
+		return projectID, "", "", Error.Wrap(err)
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(parsed.Path, strings.TrimSuffix(base.Path, "/")+"/"), "/", 3)
+	if len(parts) != 3 {
+		return projectID, "", "", Error.New("link %q has no object", link)
+	}
+	id, err := uuid.Parse(parts[0])
+	if err != nil {
+		return projectID, "", "", Error.New("invalid project id: %v", err)
+	}
+
+	unix, err := strconv.ParseInt(parsed.Query().Get("expires"), 10, 64)
+	if err != nil {
+		return projectID, "", "", Error.New("invalid expiration: %v", err)
+	}
+	expires := time.Unix(unix, 0)
+	if now.After(expires) {
+		return projectID, "", "", Error.New("link expired at %s", expires.UTC())
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parsed.Query().Get("signature"))
+	if err != nil {
+		return projectID, "", "", Error.New("invalid signature: %v", err)
+	}
+	err = pkcrypto.HashAndVerifySignature(service.identity.Leaf.PublicKey, linkData(*id, parts[1], parts[2], expires), signature)
+	if err != nil {
+		return projectID, "", "", Error.Wrap(err)
+	}
+
+	return *id, parts[1], parts[2], nil
+}
+
+// linkData returns the signed data of a download link
+func linkData(projectID uuid.UUID, bucket, path string, expires time.Time) []byte {
+	return []byte(fmt.Sprintf("takeout:%s/%s/%s:%d", projectID, bucket, path, expires.Unix()))
+}
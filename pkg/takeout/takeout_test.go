@@ -0,0 +1,102 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package takeout_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestExportProject(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		pointers := satellite.Metainfo.Service
+		service := satellite.Takeout.Service
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		project := projectID.String()
+
+		segment := func(size int64) *pb.Pointer {
+			return &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: make([]byte, size),
+				SegmentSize:   size,
+			}
+		}
+
+		// a multi-segment object, a single segment object and an object of another project
+		require.NoError(t, pointers.Put(project+"/s0/bucket/enc/large", segment(100)))
+		require.NoError(t, pointers.Put(project+"/s1/bucket/enc/large", segment(100)))
+		require.NoError(t, pointers.Put(project+"/l/bucket/enc/large", segment(50)))
+		require.NoError(t, pointers.Put(project+"/l/other/small", segment(10)))
+		require.NoError(t, pointers.Put("other/l/bucket/object", segment(5)))
+
+		export, err := service.Request(ctx, *projectID)
+		require.NoError(t, err)
+		assert.False(t, export.Finished())
+
+		// a running export isn't restarted
+		again, err := service.Request(ctx, *projectID)
+		require.NoError(t, err)
+		assert.Equal(t, export.RequestedAt.Unix(), again.RequestedAt.Unix())
+
+		for i := 0; i < 3 && !export.Finished(); i++ {
+			require.NoError(t, service.ExportProject(ctx, export))
+		}
+		require.True(t, export.Finished())
+
+		stored, err := satellite.DB.Console().ProjectExports().Get(ctx, *projectID)
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		assert.True(t, stored.Finished())
+		assert.EqualValues(t, 2, stored.ExportedObjects)
+		assert.EqualValues(t, 100+100+50+10, stored.ExportedBytes)
+		require.Len(t, stored.Manifest, 2)
+
+		large, small := stored.Manifest[0], stored.Manifest[1]
+		assert.Equal(t, "bucket", large.Bucket)
+		assert.Equal(t, "enc/large", large.Path)
+		assert.EqualValues(t, 3, large.Segments)
+		assert.EqualValues(t, 250, large.Size)
+		assert.Equal(t, "other", small.Bucket)
+		assert.Equal(t, "small", small.Path)
+		assert.EqualValues(t, 1, small.Segments)
+
+		unfinished, err := satellite.DB.Console().ProjectExports().GetUnfinished(ctx)
+		require.NoError(t, err)
+		assert.Len(t, unfinished, 0)
+
+		// the links grant access to their object until they expire
+		linkProject, bucket, path, err := service.VerifyLink(large.URL, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, *projectID, linkProject)
+		assert.Equal(t, "bucket", bucket)
+		assert.Equal(t, "enc/large", path)
+
+		_, _, _, err = service.VerifyLink(large.URL, large.Expires.Add(time.Second))
+		assert.Error(t, err)
+
+		tampered := strings.Replace(large.URL, "/bucket/", "/other/", 1)
+		_, _, _, err = service.VerifyLink(tampered, time.Now())
+		assert.Error(t, err)
+
+		// a finished export is replaced by a new one
+		export, err = service.Request(ctx, *projectID)
+		require.NoError(t, err)
+		assert.False(t, export.Finished())
+		assert.Len(t, export.Manifest, 0)
+	})
+}
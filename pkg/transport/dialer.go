@@ -0,0 +1,235 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transport
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+var (
+	// how long resolved addresses of a host are reused
+	dnsCacheTTL = 5 * time.Minute
+	// number of cached hosts above which expired entries are dropped
+	dnsCachePruneSize = 10000
+	// how long to wait for a connection before racing the next address
+	fallbackDelay = 300 * time.Millisecond
+)
+
+// dnsCache caches the resolved addresses of host names, so contacting
+// thousands of nodes doesn't query the resolver for every connection
+type dnsCache struct {
+	lookupIPAddr func(ctx context.Context, host string) ([]net.IPAddr, error)
+	ttl          time.Duration
+
+	mu      sync.Mutex
+	entries map[string]dnsEntry
+}
+
+type dnsEntry struct {
+	addrs   []net.IPAddr
+	expires time.Time
+}
+
+func newDNSCache(ttl time.Duration) *dnsCache {
+	return &dnsCache{
+		lookupIPAddr: net.DefaultResolver.LookupIPAddr,
+		ttl:          ttl,
+		entries:      map[string]dnsEntry{},
+	}
+}
+
+// lookup returns the addresses of host, resolving it when it isn't cached
+func (cache *dnsCache) lookup(ctx context.Context, host string) ([]net.IPAddr, error) {
+	now := time.Now()
+
+	cache.mu.Lock()
+	entry, ok := cache.entries[host]
+	cache.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		mon.Meter("dns_cache_hit").Mark(1)
+		return entry.addrs, nil
+	}
+	mon.Meter("dns_cache_miss").Mark(1)
+
+	addrs, err := cache.lookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, Error.New("no addresses for host %q", host)
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	if len(cache.entries) >= dnsCachePruneSize {
+		for key, entry := range cache.entries {
+			if !now.Before(entry.expires) {
+				delete(cache.entries, key)
+			}
+		}
+	}
+	cache.entries[host] = dnsEntry{addrs: addrs, expires: now.Add(cache.ttl)}
+	return addrs, nil
+}
+
+// forget drops the cached addresses of host
+func (cache *dnsCache) forget(host string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	delete(cache.entries, host)
+}
+
+// dialer dials the connections of the transport. Host names are resolved
+// with the cache and their addresses are raced, starting the next address
+// whenever an attempt fails or doesn't connect within the fallback delay
+// (happy eyeballs, RFC 8305).
+type dialer struct {
+	cache         *dnsCache
+	fallbackDelay time.Duration
+	dial          func(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+func newDialer() *dialer {
+	return &dialer{
+		cache:         newDNSCache(dnsCacheTTL),
+		fallbackDelay: fallbackDelay,
+		dial:          (&net.Dialer{}).DialContext,
+	}
+}
+
+// dialTimeout is the dialer used by grpc
+func (dialer *dialer) dialTimeout(address string, timeout time.Duration) (net.Conn, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return dialer.DialContext(ctx, address)
+}
+
+// DialContext connects to address
+func (dialer *dialer) DialContext(ctx context.Context, address string) (conn net.Conn, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if net.ParseIP(host) != nil {
+		return dialer.dial(ctx, "tcp", address)
+	}
+
+	addrs, err := dialer.cache.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []string
+	for _, addr := range interleave(addrs) {
+		targets = append(targets, net.JoinHostPort(addr.String(), port))
+	}
+
+	conn, err = dialer.race(ctx, targets)
+	if err != nil && ctx.Err() == nil {
+		// the host might have moved, so it's resolved again on the next dial
+		dialer.cache.forget(host)
+	}
+	return conn, err
+}
+
+type dialResult struct {
+	conn net.Conn
+	err  error
+}
+
+// race connects to the first reachable address, preferring the earlier ones
+func (dialer *dialer) race(ctx context.Context, addresses []string) (net.Conn, error) {
+	if len(addresses) == 1 {
+		return dialer.dial(ctx, "tcp", addresses[0])
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan dialResult, len(addresses))
+	started := 0
+	var fallback <-chan time.Time
+	startNext := func() {
+		address := addresses[started]
+		started++
+		go func() {
+			conn, err := dialer.dial(ctx, "tcp", address)
+			results <- dialResult{conn: conn, err: err}
+		}()
+
+		fallback = nil
+		if started < len(addresses) {
+			fallback = time.After(dialer.fallbackDelay)
+		}
+	}
+
+	var errlist errs.Group
+	startNext()
+	for finished := 0; finished < len(addresses); {
+		select {
+		case <-fallback:
+			startNext()
+		case result := <-results:
+			finished++
+			if result.err == nil {
+				// the attempts losing the race are canceled, but might
+				// still have connected in the meantime
+				go closeResults(results, started-finished)
+				return result.conn, nil
+			}
+			errlist.Add(result.err)
+			if started < len(addresses) {
+				startNext()
+			}
+		}
+	}
+	return nil, errlist.Err()
+}
+
+// closeResults closes the connections of the next count results
+func closeResults(results <-chan dialResult, count int) {
+	for i := 0; i < count; i++ {
+		if result := <-results; result.conn != nil {
+			_ = result.conn.Close()
+		}
+	}
+}
+
+// interleave orders the addresses by alternating between the address
+// families, starting with the family of the first address
+func interleave(addrs []net.IPAddr) []net.IPAddr {
+	var primary, secondary []net.IPAddr
+	isIPv4 := addrs[0].IP.To4() != nil
+	for _, addr := range addrs {
+		if (addr.IP.To4() != nil) == isIPv4 {
+			primary = append(primary, addr)
+		} else {
+			secondary = append(secondary, addr)
+		}
+	}
+
+	ordered := make([]net.IPAddr, 0, len(addrs))
+	for len(primary) > 0 || len(secondary) > 0 {
+		if len(primary) > 0 {
+			ordered = append(ordered, primary[0])
+			primary = primary[1:]
+		}
+		if len(secondary) > 0 {
+			ordered = append(ordered, secondary[0])
+			secondary = secondary[1:]
+		}
+	}
+	return ordered
+}
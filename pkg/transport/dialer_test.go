@@ -0,0 +1,120 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+)
+
+func TestDNSCache(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	lookups := 0
+	cache := newDNSCache(time.Hour)
+	cache.lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		lookups++
+		return []net.IPAddr{{IP: net.ParseIP("127.0.0.1")}}, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		addrs, err := cache.lookup(ctx, "node.example")
+		require.NoError(t, err)
+		require.Len(t, addrs, 1)
+	}
+	assert.Equal(t, 1, lookups)
+
+	cache.forget("node.example")
+	_, err := cache.lookup(ctx, "node.example")
+	require.NoError(t, err)
+	assert.Equal(t, 2, lookups)
+
+	cache.ttl = 0
+	cache.forget("node.example")
+	for i := 0; i < 2; i++ {
+		_, err := cache.lookup(ctx, "node.example")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 4, lookups)
+}
+
+func TestInterleave(t *testing.T) {
+	v4a, v4b := net.IPAddr{IP: net.ParseIP("10.0.0.1")}, net.IPAddr{IP: net.ParseIP("10.0.0.2")}
+	v6a, v6b := net.IPAddr{IP: net.ParseIP("2001:db8::1")}, net.IPAddr{IP: net.ParseIP("2001:db8::2")}
+
+	assert.Equal(t, []net.IPAddr{v6a, v4a, v6b, v4b}, interleave([]net.IPAddr{v6a, v6b, v4a, v4b}))
+	assert.Equal(t, []net.IPAddr{v4a, v6a, v4b}, interleave([]net.IPAddr{v4a, v4b, v6a}))
+}
+
+func TestDialerRace(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ctx.Check(listener.Close)
+	ctx.Go(func() error {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return nil
+			}
+			_ = conn.Close()
+		}
+	})
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var dialed []string
+	dialer := newDialer()
+	dialer.fallbackDelay = 10 * time.Millisecond
+	dialer.cache.lookupIPAddr = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+		return []net.IPAddr{{IP: net.ParseIP("2001:db8::1")}, {IP: net.ParseIP("127.0.0.1")}}, nil
+	}
+	dialer.dial = func(ctx context.Context, network, address string) (net.Conn, error) {
+		mu.Lock()
+		dialed = append(dialed, address)
+		mu.Unlock()
+
+		host, _, _ := net.SplitHostPort(address)
+		if host != "127.0.0.1" {
+			// unreachable address, which never connects
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return (&net.Dialer{}).DialContext(ctx, network, address)
+	}
+
+	conn, err := dialer.DialContext(ctx, net.JoinHostPort("node.example", port))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	mu.Lock()
+	assert.Equal(t, []string{"[2001:db8::1]:" + port, "127.0.0.1:" + port}, dialed)
+	mu.Unlock()
+
+	{ // failing every address forgets the host
+		dialer.dial = func(ctx context.Context, network, address string) (net.Conn, error) {
+			return nil, errors.New("unreachable")
+		}
+		_, err := dialer.DialContext(ctx, net.JoinHostPort("node.example", port))
+		require.Error(t, err)
+
+		dialer.cache.mu.Lock()
+		_, cached := dialer.cache.entries["node.example"]
+		dialer.cache.mu.Unlock()
+		assert.False(t, cached)
+	}
+}
@@ -42,6 +42,7 @@ type Client interface {
 type Transport struct {
 	identity  *identity.FullIdentity
 	observers []Observer
+	dialer    *dialer
 }
 
 // NewClient returns a newly instantiated Transport Client
@@ -49,6 +50,7 @@ func NewClient(identity *identity.FullIdentity, obs ...Observer) Client {
 	return &Transport{
 		identity:  identity,
 		observers: obs,
+		dialer:    newDialer(),
 	}
 }
 
@@ -68,7 +70,7 @@ func (transport *Transport) DialNode(ctx context.Context, node *pb.Node, opts ..
 		return nil, Error.Wrap(err)
 	}
 
-	options := append([]grpc.DialOption{dialOpt, grpc.WithBlock()}, opts...)
+	options := append([]grpc.DialOption{dialOpt, grpc.WithBlock(), grpc.WithDialer(transport.dialer.dialTimeout)}, opts...)
 
 	ctx, cf := context.WithTimeout(ctx, timeout)
 	defer cf()
@@ -96,7 +98,7 @@ func (transport *Transport) DialAddress(ctx context.Context, address string, opt
 		return nil, Error.Wrap(err)
 	}
 
-	options := append([]grpc.DialOption{dialOpt, grpc.WithBlock()}, opts...)
+	options := append([]grpc.DialOption{dialOpt, grpc.WithBlock(), grpc.WithDialer(transport.dialer.dialTimeout)}, opts...)
 	conn, err = grpc.DialContext(ctx, address, options...)
 	if err == context.Canceled {
 		return nil, err
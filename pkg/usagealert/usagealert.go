@@ -0,0 +1,200 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usagealert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/chore"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/satellite/console"
+)
+
+// Config contains configurable values for the usage alerts of projects
+type Config struct {
+	Interval       time.Duration `help:"how often the usage alerts of projects are evaluated, 0 disables usage alerts" default:"10m"`
+	WebhookTimeout time.Duration `help:"how long delivering an alert webhook may take" default:"10s"`
+}
+
+// Notification is sent as JSON to the webhook of a triggered alert
+type Notification struct {
+	AlertID   string  `json:"alertId"`
+	ProjectID string  `json:"projectId"`
+	Bucket    string  `json:"bucket"`
+	Prefix    string  `json:"prefix"`
+	Threshold float64 `json:"threshold"`
+
+	UsedBytes   int64 `json:"usedBytes"`
+	MaxBytes    int64 `json:"maxBytes"`
+	UsedObjects int64 `json:"usedObjects"`
+	MaxObjects  int64 `json:"maxObjects"`
+
+	TriggeredAt time.Time `json:"triggeredAt"`
+}
+
+// Service evaluates the usage alerts of projects against the prefix quotas
+// and the live usage in the pointerdb. An alert is delivered once when the
+// usage crosses its threshold and is rearmed when the usage drops below it.
+type Service struct {
+	log       *zap.Logger
+	console   console.DB
+	quotas    pointerdb.PrefixQuotas
+	pointerdb *pointerdb.Service
+	client    *http.Client
+	config    Config
+
+	Chore *chore.Chore
+}
+
+// New creates a new usage alert service
+func New(log *zap.Logger, db console.DB, quotas pointerdb.PrefixQuotas, pointerdb *pointerdb.Service, config Config) *Service {
+	service := &Service{
+		log:       log,
+		console:   db,
+		quotas:    quotas,
+		pointerdb: pointerdb,
+		client:    &http.Client{Timeout: config.WebhookTimeout},
+		config:    config,
+	}
+	service.Chore = chore.New(log, "usage alerts", config.Interval, service.evaluate)
+	return service
+}
+
+// Run evaluates the alerts every interval
+func (service *Service) Run(ctx context.Context) error {
+	if service.config.Interval <= 0 {
+		return nil
+	}
+	return service.Chore.Run(ctx)
+}
+
+// evaluate evaluates every alert, a failing alert doesn't stop the others
+func (service *Service) evaluate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	alerts, err := service.console.ProjectAlerts().GetAll(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for i := range alerts {
+		if err := service.Evaluate(ctx, &alerts[i]); err != nil {
+			service.log.Warn("could not evaluate alert",
+				zap.String("project", alerts[i].ProjectID.String()),
+				zap.String("alert", alerts[i].ID.String()),
+				zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// Evaluate compares the usage of the prefix of the alert with its quota and
+// delivers the alert when the usage has crossed the threshold
+func (service *Service) Evaluate(ctx context.Context, alert *console.ProjectAlert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the alerts of deleted projects are removed with the project's data
+	if _, err := service.console.ProjectDeletions().Get(ctx, alert.ProjectID); err == nil {
+		return Error.Wrap(service.console.ProjectAlerts().Delete(ctx, alert.ID))
+	}
+
+	quotas, err := service.quotas.GetAll(ctx, alert.ProjectID, alert.Bucket)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	prefix := strings.Trim(alert.Prefix, "/")
+	var quota *pointerdb.PrefixQuota
+	for i := range quotas {
+		if strings.Trim(quotas[i].Prefix, "/") == prefix {
+			quota = &quotas[i]
+			break
+		}
+	}
+	// without a quota there is nothing to compare the usage with
+	if quota == nil {
+		return nil
+	}
+
+	usedBytes, usedObjects, err := service.pointerdb.PrefixUsage(alert.ProjectID.String(), alert.Bucket, prefix)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	usage := 0.0
+	if quota.MaxBytes > 0 {
+		usage = float64(usedBytes) / float64(quota.MaxBytes)
+	}
+	if quota.MaxObjects > 0 {
+		if objects := float64(usedObjects) / float64(quota.MaxObjects); objects > usage {
+			usage = objects
+		}
+	}
+
+	if usage < alert.Threshold {
+		if alert.Triggered() {
+			// rearm the alert, so it's delivered when the threshold is crossed again
+			alert.TriggeredAt = time.Time{}
+			return Error.Wrap(service.console.ProjectAlerts().UpdateTriggered(ctx, alert.ID, time.Time{}))
+		}
+		return nil
+	}
+	if alert.Triggered() {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	err = service.deliver(ctx, alert.WebhookURL, Notification{
+		AlertID:     alert.ID.String(),
+		ProjectID:   alert.ProjectID.String(),
+		Bucket:      alert.Bucket,
+		Prefix:      alert.Prefix,
+		Threshold:   alert.Threshold,
+		UsedBytes:   usedBytes,
+		MaxBytes:    quota.MaxBytes,
+		UsedObjects: usedObjects,
+		MaxObjects:  quota.MaxObjects,
+		TriggeredAt: now,
+	})
+	// undelivered alerts are tried again on the next evaluation
+	if err != nil {
+		return err
+	}
+	mon.Meter("usage_alerts_delivered").Mark(1)
+
+	alert.TriggeredAt = now
+	return Error.Wrap(service.console.ProjectAlerts().UpdateTriggered(ctx, alert.ID, now))
+}
+
+// deliver posts the notification to the webhook
+func (service *Service) deliver(ctx context.Context, url string, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := service.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Error.New("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
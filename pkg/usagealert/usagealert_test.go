@@ -0,0 +1,111 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package usagealert_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/usagealert"
+	"storj.io/storj/satellite/console"
+)
+
+func TestEvaluate(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		pointers := satellite.Metainfo.Service
+		service := satellite.UsageAlert.Service
+
+		notifications := make(chan usagealert.Notification, 10)
+		webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var notification usagealert.Notification
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&notification))
+			notifications <- notification
+		}))
+		defer webhook.Close()
+
+		projectID, err := uuid.New()
+		require.NoError(t, err)
+		project := projectID.String()
+
+		require.NoError(t, satellite.DB.PrefixQuotas().Set(ctx, pointerdb.PrefixQuota{
+			ProjectID: *projectID,
+			Bucket:    "bucket",
+			Prefix:    "docs",
+			MaxBytes:  100,
+		}))
+
+		alerts := satellite.DB.Console().ProjectAlerts()
+		alert, err := alerts.Insert(ctx, &console.ProjectAlert{
+			ProjectID:  *projectID,
+			Bucket:     "bucket",
+			Prefix:     "docs",
+			Threshold:  0.8,
+			WebhookURL: webhook.URL,
+		})
+		require.NoError(t, err)
+		assert.False(t, alert.Triggered())
+
+		put := func(path string, size int64) {
+			require.NoError(t, pointers.Put(project+path, &pb.Pointer{
+				Type:          pb.Pointer_INLINE,
+				InlineSegment: make([]byte, size),
+				SegmentSize:   size,
+			}))
+		}
+
+		// below the threshold nothing is delivered
+		put("/l/bucket/docs/a", 50)
+		require.NoError(t, service.Evaluate(ctx, alert))
+		assert.False(t, alert.Triggered())
+		assert.Len(t, notifications, 0)
+
+		// crossing the threshold delivers the alert once
+		put("/s0/bucket/docs/b", 40)
+		require.NoError(t, service.Evaluate(ctx, alert))
+		require.True(t, alert.Triggered())
+		require.Len(t, notifications, 1)
+		notification := <-notifications
+		assert.Equal(t, alert.ID.String(), notification.AlertID)
+		assert.EqualValues(t, 90, notification.UsedBytes)
+		assert.EqualValues(t, 100, notification.MaxBytes)
+
+		require.NoError(t, service.Evaluate(ctx, alert))
+		assert.Len(t, notifications, 0)
+
+		stored, err := alerts.Get(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.True(t, stored.Triggered())
+
+		// dropping below the threshold rearms the alert
+		require.NoError(t, pointers.Delete(project+"/s0/bucket/docs/b"))
+		require.NoError(t, service.Evaluate(ctx, alert))
+		assert.False(t, alert.Triggered())
+
+		stored, err = alerts.Get(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.False(t, stored.Triggered())
+
+		// the alerts of deleted projects are removed
+		_, err = satellite.DB.Console().ProjectDeletions().Insert(ctx, *projectID)
+		require.NoError(t, err)
+		require.NoError(t, service.Evaluate(ctx, alert))
+
+		remaining, err := alerts.GetByProjectID(ctx, *projectID)
+		require.NoError(t, err)
+		assert.Len(t, remaining, 0)
+	})
+}
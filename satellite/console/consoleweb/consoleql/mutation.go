@@ -41,6 +41,14 @@ const (
 	// DeleteAPIKeyMutation is a mutation name for api key deleting
 	DeleteAPIKeyMutation = "deleteAPIKey"
 
+	// CreateProjectAlertMutation is a mutation name for project alert creation
+	CreateProjectAlertMutation = "createProjectAlert"
+	// DeleteProjectAlertMutation is a mutation name for project alert deleting
+	DeleteProjectAlertMutation = "deleteProjectAlert"
+
+	// ExportProjectMutation is a mutation name for requesting a project export
+	ExportProjectMutation = "exportProject"
+
 	// InputArg is argument name for all input types
 	InputArg = "input"
 	// FieldProjectID is field name for projectID
@@ -357,6 +365,77 @@ func rootMutation(service *console.Service, types Types) *graphql.Object {
 					return key, nil
 				},
 			},
+			// creates new project alert
+			CreateProjectAlertMutation: &graphql.Field{
+				Type: types.ProjectAlert(),
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					InputArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(types.ProjectAlertInput()),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					projectID, _ := p.Args[FieldProjectID].(string)
+					input, _ := p.Args[InputArg].(map[string]interface{})
+
+					pID, err := uuid.Parse(projectID)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.CreateProjectAlert(p.Context, *pID, fromMapProjectAlertInfo(input))
+				},
+			},
+			// deletes project alert
+			DeleteProjectAlertMutation: &graphql.Field{
+				Type: types.ProjectAlert(),
+				Args: graphql.FieldConfigArgument{
+					FieldID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					alertID, _ := p.Args[FieldID].(string)
+
+					id, err := uuid.Parse(alertID)
+					if err != nil {
+						return nil, err
+					}
+
+					alert, err := service.GetProjectAlert(p.Context, *id)
+					if err != nil {
+						return nil, err
+					}
+
+					err = service.DeleteProjectAlert(p.Context, *id)
+					if err != nil {
+						return nil, err
+					}
+
+					return alert, nil
+				},
+			},
+			// requests the export of the objects of a project
+			ExportProjectMutation: &graphql.Field{
+				Type: types.ProjectExport(),
+				Args: graphql.FieldConfigArgument{
+					FieldProjectID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					projectID, _ := p.Args[FieldProjectID].(string)
+
+					pID, err := uuid.Parse(projectID)
+					if err != nil {
+						return nil, err
+					}
+
+					return service.ExportProject(p.Context, *pID)
+				},
+			},
 		},
 	})
 }
@@ -0,0 +1,36 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	// ObjectEgressType is a graphql type name for object egress
+	ObjectEgressType = "objectEgress"
+	// FieldObjectPath is a field name for object path
+	FieldObjectPath = "objectPath"
+	// FieldEgress is a field name for egress
+	FieldEgress = "egress"
+)
+
+// graphqlObjectEgress creates *graphql.Object type representation of egress.ObjectEgress
+func graphqlObjectEgress() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ObjectEgressType,
+		Fields: graphql.Fields{
+			FieldBucket: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldObjectPath: &graphql.Field{
+				Type: graphql.String,
+			},
+			// egress exceeds the range of graphql ints
+			FieldEgress: &graphql.Field{
+				Type: graphql.Float,
+			},
+		},
+	})
+}
@@ -4,6 +4,8 @@
 package consoleql
 
 import (
+	"time"
+
 	"github.com/graphql-go/graphql"
 
 	"storj.io/storj/satellite/console"
@@ -22,6 +24,12 @@ const (
 	FieldMembers = "members"
 	// FieldAPIKeys is a field name for api keys
 	FieldAPIKeys = "apiKeys"
+	// FieldAlerts is a field name for alerts
+	FieldAlerts = "alerts"
+	// FieldTopObjects is a field name for the objects with the most egress
+	FieldTopObjects = "topObjects"
+	// FieldExport is a field name for the export of a project
+	FieldExport = "export"
 
 	// LimitArg is argument name for limit
 	LimitArg = "limit"
@@ -31,6 +39,10 @@ const (
 	SearchArg = "search"
 	// OrderArg is argument name for order
 	OrderArg = "order"
+	// SinceArg is argument name for the start of a period
+	SinceArg = "since"
+	// BeforeArg is argument name for the end of a period
+	BeforeArg = "before"
 )
 
 // graphqlProject creates *graphql.Object type representation of satellite.ProjectInfo
@@ -110,6 +122,50 @@ func graphqlProject(service *console.Service, types Types) *graphql.Object {
 					return service.GetAPIKeysInfoByProjectID(p.Context, project.ID)
 				},
 			},
+			FieldAlerts: &graphql.Field{
+				Type: graphql.NewList(types.ProjectAlert()),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					return service.GetProjectAlerts(p.Context, project.ID)
+				},
+			},
+			FieldTopObjects: &graphql.Field{
+				Type: graphql.NewList(types.ObjectEgress()),
+				Args: graphql.FieldConfigArgument{
+					SinceArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.DateTime),
+					},
+					BeforeArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.DateTime),
+					},
+					LimitArg: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Int),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					since, _ := p.Args[SinceArg].(time.Time)
+					before, _ := p.Args[BeforeArg].(time.Time)
+					limit, _ := p.Args[LimitArg].(int)
+
+					return service.GetTopObjectsByEgress(p.Context, project.ID, since, before, limit)
+				},
+			},
+			FieldExport: &graphql.Field{
+				Type: types.ProjectExport(),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					export, err := service.GetProjectExport(p.Context, project.ID)
+					if err != nil || export == nil {
+						// a nil *console.ProjectExport would be resolved as an empty export
+						return nil, err
+					}
+					return export, nil
+				},
+			},
 		},
 	})
 }
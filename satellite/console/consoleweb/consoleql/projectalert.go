@@ -0,0 +1,106 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// ProjectAlertType is a graphql type name for project alert
+	ProjectAlertType = "projectAlert"
+	// ProjectAlertInputType is a graphql type name for project alert input
+	ProjectAlertInputType = "projectAlertInput"
+	// FieldBucket is a field name for bucket
+	FieldBucket = "bucket"
+	// FieldPrefix is a field name for prefix
+	FieldPrefix = "prefix"
+	// FieldThreshold is a field name for threshold
+	FieldThreshold = "threshold"
+	// FieldWebhookURL is a field name for webhook url
+	FieldWebhookURL = "webhookUrl"
+	// FieldTriggeredAt is a field name for triggered at timestamp
+	FieldTriggeredAt = "triggeredAt"
+)
+
+// graphqlProjectAlert creates *graphql.Object type representation of console.ProjectAlert
+func graphqlProjectAlert() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ProjectAlertType,
+		Fields: graphql.Fields{
+			FieldID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldProjectID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldBucket: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldPrefix: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldThreshold: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldWebhookURL: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldCreatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			FieldTriggeredAt: &graphql.Field{
+				Type: graphql.DateTime,
+				// alerts below the threshold aren't triggered
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var alert console.ProjectAlert
+					switch source := p.Source.(type) {
+					case console.ProjectAlert:
+						alert = source
+					case *console.ProjectAlert:
+						alert = *source
+					}
+
+					if !alert.Triggered() {
+						return nil, nil
+					}
+					return alert.TriggeredAt, nil
+				},
+			},
+		},
+	})
+}
+
+// graphqlProjectAlertInput creates graphql.InputObject type needed to create console.ProjectAlert
+func graphqlProjectAlertInput() *graphql.InputObject {
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: ProjectAlertInputType,
+		Fields: graphql.InputObjectConfigFieldMap{
+			FieldBucket: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+			FieldPrefix: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+			FieldThreshold: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.Float),
+			},
+			FieldWebhookURL: &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.String),
+			},
+		},
+	})
+}
+
+// fromMapProjectAlertInfo creates console.ProjectAlertInfo from input args
+func fromMapProjectAlertInfo(args map[string]interface{}) (info console.ProjectAlertInfo) {
+	info.Bucket, _ = args[FieldBucket].(string)
+	info.Prefix, _ = args[FieldPrefix].(string)
+	info.Threshold, _ = args[FieldThreshold].(float64)
+	info.WebhookURL, _ = args[FieldWebhookURL].(string)
+
+	return
+}
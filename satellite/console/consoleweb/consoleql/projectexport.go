@@ -0,0 +1,107 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"storj.io/storj/satellite/console"
+)
+
+const (
+	// ProjectExportType is a graphql type name for project export
+	ProjectExportType = "projectExport"
+	// ExportedObjectType is a graphql type name for an object of a project export
+	ExportedObjectType = "exportedObject"
+	// FieldRequestedAt is a field name for requested at timestamp
+	FieldRequestedAt = "requestedAt"
+	// FieldFinishedAt is a field name for finished at timestamp
+	FieldFinishedAt = "finishedAt"
+	// FieldExportedObjects is a field name for the number of exported objects
+	FieldExportedObjects = "exportedObjects"
+	// FieldExportedBytes is a field name for the size of the exported objects
+	FieldExportedBytes = "exportedBytes"
+	// FieldManifest is a field name for manifest
+	FieldManifest = "manifest"
+	// FieldPath is a field name for path
+	FieldPath = "path"
+	// FieldSegments is a field name for the number of segments
+	FieldSegments = "segments"
+	// FieldSize is a field name for size
+	FieldSize = "size"
+	// FieldURL is a field name for url
+	FieldURL = "url"
+	// FieldExpires is a field name for expiration timestamp
+	FieldExpires = "expires"
+)
+
+// graphqlProjectExport creates *graphql.Object type representation of console.ProjectExport
+func graphqlProjectExport(types Types) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ProjectExportType,
+		Fields: graphql.Fields{
+			FieldProjectID: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldExportedObjects: &graphql.Field{
+				Type: graphql.Int,
+			},
+			// sizes exceed the range of graphql ints
+			FieldExportedBytes: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldManifest: &graphql.Field{
+				Type: graphql.NewList(types.ExportedObject()),
+			},
+			FieldRequestedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			FieldFinishedAt: &graphql.Field{
+				Type: graphql.DateTime,
+				// running exports aren't finished
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					var export console.ProjectExport
+					switch source := p.Source.(type) {
+					case console.ProjectExport:
+						export = source
+					case *console.ProjectExport:
+						export = *source
+					}
+
+					if !export.Finished() {
+						return nil, nil
+					}
+					return export.FinishedAt, nil
+				},
+			},
+		},
+	})
+}
+
+// graphqlExportedObject creates *graphql.Object type representation of console.ExportedObject
+func graphqlExportedObject() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: ExportedObjectType,
+		Fields: graphql.Fields{
+			FieldBucket: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldPath: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldSegments: &graphql.Field{
+				Type: graphql.Int,
+			},
+			FieldSize: &graphql.Field{
+				Type: graphql.Float,
+			},
+			FieldURL: &graphql.Field{
+				Type: graphql.String,
+			},
+			FieldExpires: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
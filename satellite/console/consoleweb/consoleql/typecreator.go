@@ -21,9 +21,14 @@ type Types interface {
 	ProjectMember() *graphql.Object
 	APIKeyInfo() *graphql.Object
 	CreateAPIKey() *graphql.Object
+	ProjectAlert() *graphql.Object
+	ObjectEgress() *graphql.Object
+	ProjectExport() *graphql.Object
+	ExportedObject() *graphql.Object
 
 	UserInput() *graphql.InputObject
 	ProjectInput() *graphql.InputObject
+	ProjectAlertInput() *graphql.InputObject
 }
 
 // TypeCreator handles graphql type creation and error checking
@@ -38,9 +43,14 @@ type TypeCreator struct {
 	projectMember *graphql.Object
 	apiKeyInfo    *graphql.Object
 	createAPIKey  *graphql.Object
-
-	userInput    *graphql.InputObject
-	projectInput *graphql.InputObject
+	projectAlert  *graphql.Object
+	objectEgress  *graphql.Object
+	projectExport *graphql.Object
+	exportedObj   *graphql.Object
+
+	userInput         *graphql.InputObject
+	projectInput      *graphql.InputObject
+	projectAlertInput *graphql.InputObject
 }
 
 // Create create types and check for error
@@ -56,6 +66,11 @@ func (c *TypeCreator) Create(service *console.Service) error {
 		return err
 	}
 
+	c.projectAlertInput = graphqlProjectAlertInput()
+	if err := c.projectAlertInput.Error(); err != nil {
+		return err
+	}
+
 	// entities
 	c.user = graphqlUser()
 	if err := c.user.Error(); err != nil {
@@ -72,6 +87,26 @@ func (c *TypeCreator) Create(service *console.Service) error {
 		return err
 	}
 
+	c.projectAlert = graphqlProjectAlert()
+	if err := c.projectAlert.Error(); err != nil {
+		return err
+	}
+
+	c.objectEgress = graphqlObjectEgress()
+	if err := c.objectEgress.Error(); err != nil {
+		return err
+	}
+
+	c.exportedObj = graphqlExportedObject()
+	if err := c.exportedObj.Error(); err != nil {
+		return err
+	}
+
+	c.projectExport = graphqlProjectExport(c)
+	if err := c.projectExport.Error(); err != nil {
+		return err
+	}
+
 	c.projectMember = graphqlProjectMember(service, c)
 	if err := c.projectMember.Error(); err != nil {
 		return err
@@ -132,6 +167,26 @@ func (c *TypeCreator) CreateAPIKey() *graphql.Object {
 	return c.createAPIKey
 }
 
+// ProjectAlert returns instance of console.ProjectAlert *graphql.Object
+func (c *TypeCreator) ProjectAlert() *graphql.Object {
+	return c.projectAlert
+}
+
+// ObjectEgress returns instance of egress.ObjectEgress *graphql.Object
+func (c *TypeCreator) ObjectEgress() *graphql.Object {
+	return c.objectEgress
+}
+
+// ProjectExport returns instance of console.ProjectExport *graphql.Object
+func (c *TypeCreator) ProjectExport() *graphql.Object {
+	return c.projectExport
+}
+
+// ExportedObject returns instance of console.ExportedObject *graphql.Object
+func (c *TypeCreator) ExportedObject() *graphql.Object {
+	return c.exportedObj
+}
+
 // Project returns instance of satellite.Project *graphql.Object
 func (c *TypeCreator) Project() *graphql.Object {
 	return c.project
@@ -151,3 +206,8 @@ func (c *TypeCreator) UserInput() *graphql.InputObject {
 func (c *TypeCreator) ProjectInput() *graphql.InputObject {
 	return c.projectInput
 }
+
+// ProjectAlertInput returns instance of ProjectAlertInfo *graphql.Object
+func (c *TypeCreator) ProjectAlertInput() *graphql.InputObject {
+	return c.projectAlertInput
+}
@@ -15,7 +15,9 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"storj.io/storj/pkg/auditlog"
 	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/maintenance"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 )
@@ -51,6 +53,10 @@ type Server struct {
 
 	schema graphql.Schema
 	server http.Server
+
+	// Maintenance refuses mutations while it's enabled, mutations are always
+	// executed when it's nil
+	Maintenance *maintenance.Mode
 }
 
 // NewServer creates new instance of console server
@@ -95,7 +101,14 @@ func (s *Server) grapqlHandler(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	// account changes are refused during maintenance, queries still work
+	if s.Maintenance != nil && s.Maintenance.Enabled() && isMutation(query) {
+		s.Maintenance.Refuse(w)
+		return
+	}
+
 	ctx := auth.WithAPIKey(context.Background(), []byte(token))
+	ctx = auditlog.WithRemoteAddr(ctx, req.RemoteAddr)
 	auth, err := s.service.Authorize(ctx)
 	if err != nil {
 		ctx = console.WithAuthFailure(ctx, err)
@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/utils"
@@ -63,3 +65,26 @@ func queryPOST(req *http.Request) (query graphqlJSON, err error) {
 		return query, errs.New("can't parse request body of type %s", typ)
 	}
 }
+
+// isMutation returns whether the executed operation of the query is a
+// mutation, queries which can't be parsed aren't executed anyway
+func isMutation(query graphqlJSON) bool {
+	document, err := parser.Parse(parser.ParseParams{Source: query.Query})
+	if err != nil {
+		return false
+	}
+
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if query.OperationName != "" && (operation.Name == nil || operation.Name.Value != query.OperationName) {
+			continue
+		}
+		if operation.Operation == ast.OperationTypeMutation {
+			return true
+		}
+	}
+	return false
+}
@@ -15,6 +15,12 @@ type DB interface {
 	ProjectMembers() ProjectMembers
 	// APIKeys is a getter for APIKeys repository
 	APIKeys() APIKeys
+	// ProjectAlerts is a getter for ProjectAlerts repository
+	ProjectAlerts() ProjectAlerts
+	// ProjectDeletions is a getter for ProjectDeletions repository
+	ProjectDeletions() ProjectDeletions
+	// ProjectExports is a getter for ProjectExports repository
+	ProjectExports() ProjectExports
 
 	// CreateTables is a method for creating all tables for satellitedb
 	CreateTables() error
@@ -0,0 +1,19 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/accounting/egress"
+)
+
+// ObjectEgress exposes the download egress attributed to the objects of projects.
+type ObjectEgress interface {
+	// TopObjects returns up to limit objects of a project with the most egress between from and to.
+	TopObjects(ctx context.Context, projectID uuid.UUID, from, to time.Time, limit int) ([]egress.ObjectEgress, error)
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// ProjectAlerts exposes methods to manage the usage alerts of projects.
+type ProjectAlerts interface {
+	// GetAll is a method for querying all alerts from the database.
+	GetAll(ctx context.Context) ([]ProjectAlert, error)
+	// GetByProjectID is a method for querying the alerts of a project from the database.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]ProjectAlert, error)
+	// Get is a method for querying an alert from the database by id.
+	Get(ctx context.Context, id uuid.UUID) (*ProjectAlert, error)
+	// Insert is a method for inserting an alert into the database.
+	Insert(ctx context.Context, alert *ProjectAlert) (*ProjectAlert, error)
+	// UpdateTriggered is a method for recording when an alert was triggered, zero time resets it.
+	UpdateTriggered(ctx context.Context, id uuid.UUID, triggeredAt time.Time) error
+	// Delete is a method for deleting an alert by id from the database.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ProjectAlert is a database object that describes an alert, which is
+// triggered when the usage of a prefix quota reaches a fraction of the quota
+type ProjectAlert struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"projectId"`
+
+	Bucket string `json:"bucket"`
+	Prefix string `json:"prefix"`
+	// Threshold is the fraction of the quota, at which the alert is triggered
+	Threshold float64 `json:"threshold"`
+	// WebhookURL receives the alert as JSON POST request
+	WebhookURL string `json:"webhookUrl"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	// TriggeredAt is zero while the usage is below the threshold
+	TriggeredAt time.Time `json:"triggeredAt"`
+}
+
+// ProjectAlertInfo holds data needed to create a ProjectAlert
+type ProjectAlertInfo struct {
+	Bucket     string  `json:"bucket"`
+	Prefix     string  `json:"prefix"`
+	Threshold  float64 `json:"threshold"`
+	WebhookURL string  `json:"webhookUrl"`
+}
+
+// Triggered returns whether the usage has crossed the threshold
+func (alert *ProjectAlert) Triggered() bool {
+	return !alert.TriggeredAt.IsZero()
+}
+
+// IsValid checks ProjectAlertInfo validity and returns error describing whats wrong.
+func (info *ProjectAlertInfo) IsValid() error {
+	var errs validationErrors
+
+	if info.Bucket == "" {
+		errs.Add("bucket can't be empty")
+	}
+
+	if info.Prefix == "" {
+		errs.Add("prefix can't be empty")
+	}
+
+	if info.Threshold <= 0 || info.Threshold > 1 {
+		errs.Add("threshold has to be greater than 0 and at most 1")
+	}
+
+	webhook, err := url.Parse(info.WebhookURL)
+	if err != nil {
+		errs.AddWrap(err)
+	} else if webhook.Scheme != "http" && webhook.Scheme != "https" || webhook.Host == "" {
+		errs.Add("webhook has to be an http or https url")
+	}
+
+	return errs.Combine()
+}
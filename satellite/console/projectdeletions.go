@@ -0,0 +1,40 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// ProjectDeletions exposes methods to track purging the data of deleted projects.
+type ProjectDeletions interface {
+	// Insert is a method for marking a project as deleted.
+	Insert(ctx context.Context, projectID uuid.UUID) (*ProjectDeletion, error)
+	// Get is a method for querying the deletion of a project by project id.
+	Get(ctx context.Context, projectID uuid.UUID) (*ProjectDeletion, error)
+	// GetUnfinished is a method for querying deletions, which still have data to purge, oldest first.
+	GetUnfinished(ctx context.Context) ([]ProjectDeletion, error)
+	// Update is a method for updating the progress of a deletion.
+	Update(ctx context.Context, deletion *ProjectDeletion) error
+}
+
+// ProjectDeletion is a database object that describes purging the data of a deleted project
+type ProjectDeletion struct {
+	ProjectID uuid.UUID `json:"projectId"`
+
+	DeletedSegments int64 `json:"deletedSegments"`
+	DeletedBytes    int64 `json:"deletedBytes"`
+
+	RequestedAt time.Time `json:"requestedAt"`
+	// FinishedAt is zero while there is still data to purge
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+// Finished returns whether all of the data of the project has been purged
+func (deletion *ProjectDeletion) Finished() bool {
+	return !deletion.FinishedAt.IsZero()
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// ProjectExports exposes methods to track exporting the data of projects.
+type ProjectExports interface {
+	// Insert is a method for requesting the export of a project, it replaces a previous export.
+	Insert(ctx context.Context, projectID, requestedBy uuid.UUID) (*ProjectExport, error)
+	// Get is a method for querying the export of a project by project id, it's nil when there is none.
+	Get(ctx context.Context, projectID uuid.UUID) (*ProjectExport, error)
+	// GetUnfinished is a method for querying exports, which still have objects to export, oldest first.
+	GetUnfinished(ctx context.Context) ([]ProjectExport, error)
+	// Update is a method for updating the progress and the manifest of an export.
+	Update(ctx context.Context, export *ProjectExport) error
+}
+
+// ProjectExport is a database object that describes exporting the objects of
+// a project into a manifest of download links, e.g. for data portability requests
+type ProjectExport struct {
+	ProjectID uuid.UUID `json:"projectId"`
+	// RequestedBy is the user, who requested the export, it's zero for exports requested by an admin
+	RequestedBy uuid.UUID `json:"requestedBy"`
+
+	ExportedObjects int64 `json:"exportedObjects"`
+	ExportedBytes   int64 `json:"exportedBytes"`
+	// LastPath is the key of the last exported object, the export continues after it
+	LastPath string `json:"-"`
+
+	Manifest []ExportedObject `json:"manifest"`
+
+	RequestedAt time.Time `json:"requestedAt"`
+	// FinishedAt is zero while there are still objects to export
+	FinishedAt time.Time `json:"finishedAt"`
+}
+
+// ExportedObject is an object in the manifest of a project export
+type ExportedObject struct {
+	Bucket string `json:"bucket"`
+	// Path is the encrypted path of the object, only the uplink can decrypt it
+	Path     string `json:"path"`
+	Segments int64  `json:"segments"`
+	Size     int64  `json:"size"`
+	// URL downloads the object until the link expires
+	URL     string    `json:"url"`
+	Expires time.Time `json:"expires"`
+}
+
+// Finished returns whether all of the objects of the project have been exported
+func (export *ProjectExport) Finished() bool {
+	return !export.FinishedAt.IsZero()
+}
@@ -15,6 +15,8 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/accounting/egress"
+	"storj.io/storj/pkg/auditlog"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/satellite/console/consoleauth"
 )
@@ -40,12 +42,16 @@ type Service struct {
 
 	store DB
 	log   *zap.Logger
+	audit *auditlog.Log
 
 	passwordCost int
+
+	// Egress, when set, provides the egress attributed to the objects of projects
+	Egress ObjectEgress
 }
 
 // NewService returns new instance of Service
-func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int) (*Service, error) {
+func NewService(log *zap.Logger, signer Signer, store DB, audit *auditlog.Log, passwordCost int) (*Service, error) {
 	if signer == nil {
 		return nil, errs.New("signer can't be nil")
 	}
@@ -58,11 +64,15 @@ func NewService(log *zap.Logger, signer Signer, store DB, passwordCost int) (*Se
 		return nil, errs.New("log can't be nil")
 	}
 
+	if audit == nil {
+		return nil, errs.New("audit log can't be nil")
+	}
+
 	if passwordCost == 0 {
 		passwordCost = bcrypt.DefaultCost
 	}
 
-	return &Service{Signer: signer, store: store, log: log, passwordCost: passwordCost}, nil
+	return &Service{Signer: signer, store: store, log: log, audit: audit, passwordCost: passwordCost}, nil
 }
 
 // CreateUser gets password hash value and creates new inactive User
@@ -92,6 +102,10 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser) (u *User, err
 		LastName:     user.LastName,
 		PasswordHash: hash,
 	})
+	if err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, u.ID.String(), "console:create-user", email)
 
 	// TODO: send "finish registration email" when email service will be ready
 	//activationToken, err := s.GenerateActivationToken(ctx, u.ID, email, u.CreatedAt.Add(tokenExpirationTime))
@@ -99,7 +113,7 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser) (u *User, err
 	//	return nil, err
 	//}
 
-	return u, err
+	return u, nil
 }
 
 // GenerateActivationToken - is a method for generating activation token
@@ -149,6 +163,7 @@ func (s *Service) ActivateAccount(ctx context.Context, activationToken string) (
 	if err != nil {
 		return "", err
 	}
+	s.audit.Record(ctx, user.ID.String(), "console:activate-account", user.Email)
 
 	claims = &consoleauth.Claims{
 		ID:         user.ID,
@@ -176,6 +191,7 @@ func (s *Service) Token(ctx context.Context, email, password string) (token stri
 
 	err = bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password))
 	if err != nil {
+		s.audit.Record(ctx, user.ID.String(), "console:login-failed", email)
 		return "", ErrUnauthorized.New("password is incorrect: %s", err.Error())
 	}
 
@@ -188,6 +204,7 @@ func (s *Service) Token(ctx context.Context, email, password string) (token stri
 	if err != nil {
 		return "", err
 	}
+	s.audit.Record(ctx, user.ID.String(), "console:login", email)
 
 	return token, nil
 }
@@ -220,13 +237,19 @@ func (s *Service) UpdateAccount(ctx context.Context, info UserInfo) (err error)
 	// add normalization
 	email := normalizeEmail(info.Email)
 
-	return s.store.Users().Update(ctx, &User{
+	err = s.store.Users().Update(ctx, &User{
 		ID:           auth.User.ID,
 		FirstName:    info.FirstName,
 		LastName:     info.LastName,
 		Email:        email,
 		PasswordHash: nil,
 	})
+	if err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, auth.User.ID.String(), "console:update-account", auth.User.ID.String())
+	return nil
 }
 
 // ChangePassword updates password for a given user
@@ -252,7 +275,13 @@ func (s *Service) ChangePassword(ctx context.Context, pass, newPass string) (err
 	}
 
 	auth.User.PasswordHash = hash
-	return s.store.Users().Update(ctx, &auth.User)
+	err = s.store.Users().Update(ctx, &auth.User)
+	if err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, auth.User.ID.String(), "console:change-password", auth.User.ID.String())
+	return nil
 }
 
 // DeleteAccount deletes User
@@ -268,7 +297,13 @@ func (s *Service) DeleteAccount(ctx context.Context, password string) (err error
 		return ErrUnauthorized.New("origin password is incorrect")
 	}
 
-	return s.store.Users().Delete(ctx, auth.User.ID)
+	err = s.store.Users().Delete(ctx, auth.User.ID)
+	if err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, auth.User.ID.String(), "console:delete-account", auth.User.ID.String())
+	return nil
 }
 
 // GetProject is a method for querying project by id
@@ -318,6 +353,11 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 		}
 
 		err = transaction.Commit()
+		if err == nil {
+			// the audit log isn't written in the transaction, which holds
+			// the database until it's committed
+			s.audit.Record(ctx, auth.User.ID.String(), "console:create-project", p.ID.String())
+		}
 	}()
 
 	prj, err := transaction.Projects().Insert(ctx, project)
@@ -333,22 +373,59 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 	return prj, nil
 }
 
-// DeleteProject is a method for deleting project by id
+// DeleteProject is a method for deleting project by id. The project and its
+// api keys are removed immediately, its data is purged asynchronously.
 func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	_, err = GetAuth(ctx)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.isProjectMember(ctx, auth.User.ID, projectID); err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	transaction, err := s.store.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, transaction.Rollback())
+			return
+		}
+
+		err = transaction.Commit()
+		if err == nil {
+			s.audit.Record(ctx, auth.User.ID.String(), "console:delete-project", projectID.String())
+		}
+	}()
+
+	_, err = transaction.ProjectDeletions().Insert(ctx, projectID)
 	if err != nil {
 		return err
 	}
 
-	// TODO: before deletion we should check if user is a project member
-	return s.store.Projects().Delete(ctx, projectID)
+	// revoke the api keys explicitly, sqlite doesn't cascade deletes
+	keys, err := transaction.APIKeys().GetByProjectID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err = transaction.APIKeys().Delete(ctx, key.ID); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Projects().Delete(ctx, projectID)
 }
 
 // UpdateProject is a method for updating project description by id
 func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, description string) (p *Project, err error) {
 	defer mon.Task()(&ctx)(&err)
-	_, err = GetAuth(ctx)
+	auth, err := GetAuth(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -365,6 +442,7 @@ func (s *Service) UpdateProject(ctx context.Context, projectID uuid.UUID, descri
 		return nil, err
 	}
 
+	s.audit.Record(ctx, auth.User.ID.String(), "console:update-project", projectID.String())
 	return project, nil
 }
 
@@ -412,6 +490,11 @@ func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, em
 		}
 
 		err = tx.Commit()
+		if err == nil {
+			for _, uID := range userIDs {
+				s.audit.Record(ctx, auth.User.ID.String(), "console:add-project-member", projectID.String()+"/"+uID.String())
+			}
+		}
 	}()
 
 	for _, uID := range userIDs {
@@ -469,6 +552,11 @@ func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID,
 		}
 
 		err = tx.Commit()
+		if err == nil {
+			for _, uID := range userIDs {
+				s.audit.Record(ctx, auth.User.ID.String(), "console:delete-project-member", projectID.String()+"/"+uID.String())
+			}
+		}
 	}()
 
 	for _, uID := range userIDs {
@@ -521,7 +609,12 @@ func (s *Service) CreateAPIKey(ctx context.Context, projectID uuid.UUID, name st
 		Name:      name,
 		ProjectID: projectID,
 	})
-	return info, key, err
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.audit.Record(ctx, auth.User.ID.String(), "console:create-api-key", projectID.String()+"/"+info.ID.String())
+	return info, key, nil
 }
 
 // GetAPIKeyInfo retrieves api key by id
@@ -565,7 +658,13 @@ func (s *Service) DeleteAPIKey(ctx context.Context, id uuid.UUID) (err error) {
 		return ErrUnauthorized.Wrap(err)
 	}
 
-	return s.store.APIKeys().Delete(ctx, id)
+	err = s.store.APIKeys().Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, auth.User.ID.String(), "console:delete-api-key", key.ProjectID.String()+"/"+id.String())
+	return nil
 }
 
 // GetAPIKeysInfoByProjectID retrieves all api keys for a given project
@@ -584,6 +683,168 @@ func (s *Service) GetAPIKeysInfoByProjectID(ctx context.Context, projectID uuid.
 	return s.store.APIKeys().GetByProjectID(ctx, projectID)
 }
 
+// CreateProjectAlert adds an alert, which is triggered when the usage of a
+// prefix quota of the project reaches the threshold
+func (s *Service) CreateProjectAlert(ctx context.Context, projectID uuid.UUID, info ProjectAlertInfo) (alert *ProjectAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if err := info.IsValid(); err != nil {
+		return nil, err
+	}
+
+	alert, err = s.store.ProjectAlerts().Insert(ctx, &ProjectAlert{
+		ProjectID:  projectID,
+		Bucket:     info.Bucket,
+		Prefix:     info.Prefix,
+		Threshold:  info.Threshold,
+		WebhookURL: info.WebhookURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit.Record(ctx, auth.User.ID.String(), "console:create-project-alert", projectID.String()+"/"+alert.ID.String())
+	return alert, nil
+}
+
+// GetProjectAlert retrieves alert by id
+func (s *Service) GetProjectAlert(ctx context.Context, id uuid.UUID) (alert *ProjectAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err = s.store.ProjectAlerts().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, alert.ProjectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return alert, nil
+}
+
+// GetProjectAlerts retrieves all alerts of a given project
+func (s *Service) GetProjectAlerts(ctx context.Context, projectID uuid.UUID) (alerts []ProjectAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return s.store.ProjectAlerts().GetByProjectID(ctx, projectID)
+}
+
+// DeleteProjectAlert deletes alert by id
+func (s *Service) DeleteProjectAlert(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	alert, err := s.store.ProjectAlerts().Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, alert.ProjectID)
+	if err != nil {
+		return ErrUnauthorized.Wrap(err)
+	}
+
+	err = s.store.ProjectAlerts().Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.audit.Record(ctx, auth.User.ID.String(), "console:delete-project-alert", alert.ProjectID.String()+"/"+id.String())
+	return nil
+}
+
+// GetTopObjectsByEgress retrieves up to limit objects of a given project with the most egress between from and to
+func (s *Service) GetTopObjectsByEgress(ctx context.Context, projectID uuid.UUID, from, to time.Time, limit int) (objects []egress.ObjectEgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if s.Egress == nil {
+		return nil, errs.New("egress attribution is disabled")
+	}
+
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return s.Egress.TopObjects(ctx, projectID, from, to, limit)
+}
+
+// ExportProject requests the export of the objects of a project into a manifest of download
+// links. A running export is returned as it is, a finished one is replaced by a new export.
+func (s *Service) ExportProject(ctx context.Context, projectID uuid.UUID) (export *ProjectExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	export, err = s.store.ProjectExports().Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if export != nil && !export.Finished() {
+		return export, nil
+	}
+
+	return s.store.ProjectExports().Insert(ctx, projectID, auth.User.ID)
+}
+
+// GetProjectExport retrieves the export of a given project, it's nil when the project wasn't exported
+func (s *Service) GetProjectExport(ctx context.Context, projectID uuid.UUID) (export *ProjectExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return s.store.ProjectExports().Get(ctx, projectID)
+}
+
 // Authorize validates token from context and returns authorized Authorization
 func (s *Service) Authorize(ctx context.Context) (a Authorization, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -16,27 +16,45 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 
+	"storj.io/storj/pkg/abuse"
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/egress"
+	"storj.io/storj/pkg/accounting/export"
+	"storj.io/storj/pkg/accounting/nodetally"
 	"storj.io/storj/pkg/accounting/rollup"
 	"storj.io/storj/pkg/accounting/tally"
+	"storj.io/storj/pkg/admin"
 	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/auditlog"
 	"storj.io/storj/pkg/auth/grpcauth"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/certdb"
+	"storj.io/storj/pkg/chore"
 	"storj.io/storj/pkg/datarepair/checker"
 	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/datarepair/repairer"
+	"storj.io/storj/pkg/datarepair/slo"
 	"storj.io/storj/pkg/discovery"
+	"storj.io/storj/pkg/health"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/maintenance"
+	"storj.io/storj/pkg/nodestate"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/pricing"
+	"storj.io/storj/pkg/purge"
+	"storj.io/storj/pkg/retain"
+	"storj.io/storj/pkg/sampling"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/statdb"
+	ecclient "storj.io/storj/pkg/storage/ec"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/takeout"
 	"storj.io/storj/pkg/transport"
+	"storj.io/storj/pkg/usagealert"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
@@ -56,19 +74,43 @@ type DB interface {
 	CreateSchema(schema string) error
 	// DropSchema drops the schema
 	DropSchema(schema string) error
+	// Ping checks whether the database is reachable
+	Ping(ctx context.Context) error
 
+	// Abuse returns database for blocked uplinks, api keys and ip ranges
+	Abuse() abuse.DB
+	// AuditLog returns the audit log of console and admin api operations
+	AuditLog() auditlog.DB
 	// BandwidthAgreement returns database for storing bandwidth agreements
 	BandwidthAgreement() bwagreement.DB
+	// BucketLocks returns database for the object locks of buckets
+	BucketLocks() pointerdb.BucketLocks
 	// CertDB returns database for storing uplink's public key & ID
 	CertDB() certdb.DB
+	// Containment returns database for the pending audits of contained nodes
+	Containment() audit.Containment
+	// EgressAttributions returns database for the egress attributed to objects
+	EgressAttributions() egress.DB
 	// StatDB returns database for storing node statistics
 	StatDB() statdb.DB
+	// NodeEvents returns the log of node state changes
+	NodeEvents() nodestate.DB
 	// OverlayCache returns database for caching overlay information
 	OverlayCache() overlay.DB
 	// Accounting returns database for storing information about data use
 	Accounting() accounting.DB
+	// PrefixQuotas returns database for the prefix quotas of buckets
+	PrefixQuotas() pointerdb.PrefixQuotas
+	// Pricing returns database for the pricing tiers and the tiers of the projects
+	Pricing() pricing.DB
+	// ProjectSalts returns database for the salts of the projects
+	ProjectSalts() pointerdb.ProjectSalts
 	// RepairQueue returns queue for segments that need repairing
 	RepairQueue() queue.RepairQueue
+	// RepairSLO returns database for the daily repair counts of the slo report
+	RepairSLO() slo.DB
+	// Samples returns database for the snapshots of the pointer samples
+	Samples() sampling.DB
 	// Irreparable returns database for failed repairs
 	Irreparable() irreparable.DB
 	// Console returns database for satellite console
@@ -80,21 +122,43 @@ type Config struct {
 	Identity identity.Config
 
 	// TODO: switch to using server.Config when Identity has been removed from it
-	Server server.Config
+	Server      server.Config
+	Concurrency server.ConcurrencyConfig
 
 	Kademlia  kademlia.Config
 	Overlay   overlay.Config
+	NodeState nodestate.Config
 	Discovery discovery.Config
+	Pricing   statdb.PricingConfig
 
 	PointerDB   pointerdb.Config
-	BwAgreement bwagreement.Config // TODO: decide whether to keep empty configs for consistency
+	BwAgreement bwagreement.Config
 
-	Checker  checker.Config
-	Repairer repairer.Config
-	Audit    audit.Config
+	Checker   checker.Config
+	Repairer  repairer.Config
+	RepairSLO slo.Config
+	Audit     audit.Config
 
-	Tally  tally.Config
-	Rollup rollup.Config
+	Tally     tally.Config
+	Rollup    rollup.Config
+	NodeTally nodetally.Config
+	Export    export.Config
+	Egress    egress.Config
+
+	Chore      chore.Config
+	Abuse      abuse.Config
+	Purge      purge.Config
+	Retain     retain.Config
+	UsageAlert usagealert.Config
+	Sampling   sampling.Config
+	Takeout    takeout.Config
+	Health     health.Config
+
+	Maintenance maintenance.Config
+
+	Admin admin.Config
+
+	ProjectPricing pricing.Config
 
 	Console consoleweb.Config
 }
@@ -107,13 +171,28 @@ type Peer struct {
 	DB       DB
 
 	Transport transport.Client
+	AuditLog  *auditlog.Log
 
 	// servers
 	Public struct {
 		Listener net.Listener
+		Router   *server.Router
 		Server   *server.Server
 	}
 
+	Admin struct {
+		Listener net.Listener
+		Server   *admin.Server
+	}
+
+	Maintenance struct {
+		Mode *maintenance.Mode
+	}
+
+	Abuse struct {
+		Service *abuse.Service
+	}
+
 	// services and endpoints
 	Kademlia struct {
 		kdb, ndb storage.KeyValueStore // TODO: move these into DB
@@ -124,10 +203,16 @@ type Peer struct {
 		Inspector    *kademlia.Inspector
 	}
 
+	NodeState struct {
+		Service *nodestate.Service
+	}
+
 	Overlay struct {
 		Service   *overlay.Cache
 		Endpoint  *overlay.Server
 		Inspector *overlay.Inspector
+		Stray     *overlay.StrayCleaner
+		Addresses *overlay.AddressVerifier
 	}
 
 	Discovery struct {
@@ -136,6 +221,7 @@ type Peer struct {
 
 	Reputation struct {
 		Inspector *statdb.Inspector
+		Endpoint  *statdb.Endpoint
 	}
 
 	Metainfo struct {
@@ -146,20 +232,58 @@ type Peer struct {
 	}
 
 	Agreements struct {
+		Queue    *bwagreement.Queue
 		Endpoint *bwagreement.Server
+		Cleaner  *bwagreement.Cleaner
+		Rollup   *bwagreement.Rollup
 	}
 
 	Repair struct {
-		Checker  checker.Checker // TODO: convert to actual struct
-		Repairer *repairer.Service
+		Checker   checker.Checker // TODO: convert to actual struct
+		Inspector *checker.Inspector
+		Repairer  *repairer.Service
+	}
+	RepairSLO struct {
+		Service *slo.Service
 	}
 	Audit struct {
 		Service *audit.Service
 	}
 
 	Accounting struct {
-		Tally  *tally.Tally
-		Rollup *rollup.Rollup
+		Tally     *tally.Tally
+		Rollup    *rollup.Rollup
+		NodeTally *nodetally.Service
+		Export    *export.Exporter
+		Egress    *egress.Service
+	}
+
+	Purge struct {
+		Service *purge.Service
+	}
+
+	Retain struct {
+		Service *retain.Service
+	}
+
+	UsageAlert struct {
+		Service *usagealert.Service
+	}
+
+	Sampling struct {
+		Service *sampling.Service
+	}
+
+	Pricing struct {
+		Service *pricing.Service
+	}
+
+	Takeout struct {
+		Service *takeout.Service
+	}
+
+	Chores struct {
+		Group *chore.Group
 	}
 
 	Console struct {
@@ -167,6 +291,13 @@ type Peer struct {
 		Service  *console.Service
 		Endpoint *consoleweb.Server
 	}
+
+	Health struct {
+		Service  *health.Service
+		Endpoint *health.Endpoint
+		Listener net.Listener
+		Server   *health.Server
+	}
 }
 
 // New creates a new satellite
@@ -176,10 +307,36 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 		Identity:  full,
 		DB:        db,
 		Transport: transport.NewClient(full),
+		AuditLog:  auditlog.New(log.Named("auditlog"), db.AuditLog()),
 	}
 
 	var err error
 
+	{ // setup admin api
+		if config.Admin.Address != "" {
+			peer.Admin.Listener, err = net.Listen("tcp", config.Admin.Address)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+		peer.Admin.Server = admin.NewServer(peer.Admin.Listener)
+	}
+
+	{ // setup abuse
+		peer.Abuse.Service = abuse.NewService(peer.Log.Named("abuse"), peer.DB.Abuse(), config.Abuse)
+		peer.Admin.Server.Handle("/blocks", peer.AuditLog.Handler("abuse", peer.Abuse.Service))
+	}
+
+	{ // setup maintenance mode
+		config := config.Maintenance
+
+		peer.Maintenance.Mode, err = maintenance.NewMode(peer.Log.Named("maintenance"), config)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Admin.Server.Handle("/maintenance", peer.AuditLog.Handler("maintenance", peer.Maintenance.Mode))
+	}
+
 	{ // setup listener and server
 		peer.Public.Listener, err = net.Listen("tcp", config.Server.Address)
 		if err != nil {
@@ -192,7 +349,34 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Public.Server, err = server.New(publicOptions, peer.Public.Listener, grpcauth.NewAPIKeyInterceptor())
+		peer.Public.Router = server.NewRouter(server.AudienceUplink)
+		peer.Public.Router.Assign(server.AudienceNode,
+			"node.Nodes", "bandwidth.Bandwidth", "nodestats.NodeStats")
+		peer.Public.Router.Assign(server.AudienceAdmin,
+			"inspector.KadInspector", "inspector.OverlayInspector", "inspector.StatDBInspector",
+			"inspector.HealthInspector", "health.Health")
+
+		// the abuse interceptor runs after the api key interceptor, so it can check the api key,
+		// writes of uplinks are refused in maintenance mode, nodes and admins aren't affected
+		peer.Public.Router.Chain(server.AudienceUplink, grpcauth.NewAPIKeyInterceptor(), peer.Abuse.Service.UnaryInterceptor(),
+			peer.Maintenance.Mode.UnaryInterceptor())
+		peer.Public.Router.Chain(server.AudienceNode, peer.Abuse.Service.UnaryInterceptor())
+		peer.Public.Router.Chain(server.AudienceAdmin, peer.Abuse.Service.UnaryInterceptor())
+		peer.Public.Router.ChainStream(server.AudienceUplink, grpcauth.NewAPIKeyStreamInterceptor(), peer.Abuse.Service.StreamInterceptor(),
+			peer.Maintenance.Mode.StreamInterceptor())
+		peer.Public.Router.ChainStream(server.AudienceNode, peer.Abuse.Service.StreamInterceptor())
+		peer.Public.Router.ChainStream(server.AudienceAdmin, peer.Abuse.Service.StreamInterceptor())
+
+		// the concurrency limits apply after authentication, so unauthorized
+		// requests can't take the slots of legitimate ones
+		limiter, err := server.NewConcurrencyLimiter(peer.Log.Named("concurrency"), config.Concurrency)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		interceptor := server.CombineInterceptors(peer.Public.Router.UnaryInterceptor(), limiter.UnaryInterceptor())
+		stream := server.CombineStreamInterceptors(peer.Public.Router.StreamInterceptor(), limiter.StreamInterceptor())
+
+		peer.Public.Server, err = server.New(publicOptions, peer.Public.Listener, interceptor, stream)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -212,8 +396,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 				Address: config.ExternalAddress,
 			},
 			Metadata: &pb.NodeMetadata{
-				Email:  config.Operator.Email,
-				Wallet: config.Operator.Wallet,
+				Email:          config.Operator.Email,
+				Wallet:         config.Operator.Wallet,
+				WalletFeatures: config.Operator.Features(),
 			},
 		}
 
@@ -251,9 +436,32 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 		pb.RegisterKadInspectorServer(peer.Public.Server.GRPC(), peer.Kademlia.Inspector)
 	}
 
+	{ // setup node state
+		// nodes are vetted either by the vetting criteria or by the audit threshold
+		vettedAuditCount := config.Overlay.Node.NewNodeAuditThreshold
+		if config.Overlay.Vetting.Enabled {
+			vettedAuditCount = 0
+		}
+
+		// every statistics update goes through the node state service, so it can record the state changes
+		peer.NodeState.Service = nodestate.NewService(peer.Log.Named("nodestate"),
+			peer.DB.OverlayCache(), peer.DB.StatDB(), peer.DB.NodeEvents(),
+			vettedAuditCount, config.NodeState)
+		peer.Admin.Server.Handle("/nodes", peer.AuditLog.Handler("nodestate", peer.NodeState.Service))
+	}
+
 	{ // setup overlay
 		config := config.Overlay
-		peer.Overlay.Service = overlay.NewCache(peer.DB.OverlayCache(), peer.DB.StatDB())
+		peer.Overlay.Service = overlay.NewCache(peer.DB.OverlayCache(), peer.NodeState.Service)
+		peer.Overlay.Service.Vetting = config.Vetting.Criteria()
+		// nodes checking in are told which fields of their operator metadata are invalid
+		peer.Kademlia.Endpoint.Metadata = peer.Overlay.Service
+		if config.GeoIPPath != "" {
+			peer.Overlay.Service.GeoIP, err = overlay.LoadNetworkCountries(config.GeoIPPath)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
 
 		nodeSelectionConfig := &overlay.NodeSelectionConfig{
 			UptimeCount:           config.Node.UptimeCount,
@@ -262,24 +470,47 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 			AuditCount:            config.Node.AuditCount,
 			NewNodeAuditThreshold: config.Node.NewNodeAuditThreshold,
 			NewNodePercentage:     config.Node.NewNodePercentage,
+			UploadSuccessRatio:    config.Node.UploadSuccessRatio,
+			RequiredTags:          config.Node.RequiredTags,
+			DistinctIP:            config.Node.DistinctIP,
+			OnlineWindow:          config.Node.OnlineWindow,
 		}
 
 		peer.Overlay.Endpoint = overlay.NewServer(peer.Log.Named("overlay:endpoint"), peer.Overlay.Service, nodeSelectionConfig)
 		pb.RegisterOverlayServer(peer.Public.Server.GRPC(), peer.Overlay.Endpoint)
 
-		peer.Overlay.Inspector = overlay.NewInspector(peer.Overlay.Service)
+		tagSigners, err := config.ParseTagSigners()
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Overlay.Inspector = overlay.NewInspector(peer.Overlay.Service, tagSigners)
 		pb.RegisterOverlayInspectorServer(peer.Public.Server.GRPC(), peer.Overlay.Inspector)
+
+		peer.Overlay.Stray = overlay.NewStrayCleaner(peer.Log.Named("overlay:stray"),
+			peer.DB.OverlayCache(), peer.DB.Accounting(),
+			config.Node.NewNodeAuditThreshold, config.Stray)
+
+		peer.Overlay.Addresses = overlay.NewAddressVerifier(peer.Log.Named("overlay:addresses"),
+			peer.DB.OverlayCache(), peer.Transport, config.Addresses)
 	}
 
 	{ // setup reputation
 		// TODO: find better structure with overlay
-		peer.Reputation.Inspector = statdb.NewInspector(peer.DB.StatDB())
+		peer.Reputation.Inspector = statdb.NewInspector(peer.NodeState.Service)
 		pb.RegisterStatDBInspectorServer(peer.Public.Server.GRPC(), peer.Reputation.Inspector)
+
+		if err := config.Pricing.Verify(); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Reputation.Endpoint = statdb.NewEndpoint(peer.Log.Named("statdb:endpoint"), peer.NodeState.Service, config.Pricing)
+		pb.RegisterNodeStatsServer(peer.Public.Server.GRPC(), peer.Reputation.Endpoint)
 	}
 
 	{ // setup discovery
 		config := config.Discovery
-		peer.Discovery.Service = discovery.New(peer.Log.Named("discovery"), peer.Overlay.Service, peer.Kademlia.Service, peer.DB.StatDB(), config)
+		peer.Discovery.Service = discovery.New(peer.Log.Named("discovery"), peer.Overlay.Service, peer.Kademlia.Service, peer.Transport, peer.NodeState.Service, config)
 	}
 
 	{ // setup metainfo
@@ -297,26 +528,72 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 			peer.Overlay.Service,
 			config.PointerDB,
 			peer.Identity, peer.DB.Console().APIKeys())
+		peer.Metainfo.Endpoint.Selection = peer.Overlay.Endpoint
+		peer.Metainfo.Endpoint.Quotas = peer.DB.PrefixQuotas()
+		peer.Metainfo.Endpoint.Locks = peer.DB.BucketLocks()
+		peer.Metainfo.Endpoint.Salts = peer.DB.ProjectSalts()
+
+		if config.PointerDB.ObjectLimits != "" {
+			peer.Metainfo.Endpoint.Objects, err = pointerdb.NewObjectLimits(peer.Log.Named("pointerdb:objects"), peer.Metainfo.Service, config.PointerDB.ObjectLimits, config.PointerDB.ObjectCountRefresh)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+
+		peer.Accounting.Egress = egress.New(peer.Log.Named("accounting:egress"), peer.DB.EgressAttributions(), config.Egress)
+		peer.Metainfo.Endpoint.Egress = peer.Accounting.Egress
 
 		pb.RegisterPointerDBServer(peer.Public.Server.GRPC(), peer.Metainfo.Endpoint)
 	}
 
 	{ // setup agreements
 		bwServer := bwagreement.NewServer(peer.DB.BandwidthAgreement(), peer.DB.CertDB(), peer.Identity.Leaf.PublicKey, peer.Log.Named("agreements"), peer.Identity.ID)
+		bwServer.Identity = peer.Identity
+		bwServer.Anomaly = config.BwAgreement.Anomaly
+		bwServer.Stats = peer.NodeState.Service
+		if config.BwAgreement.RateLimit.Rate > 0 {
+			bwServer.Limiter = bwagreement.NewRateLimiter(config.BwAgreement.RateLimit)
+		}
+		if config.Discovery.AuditLiveness {
+			bwServer.Uptime = peer.NodeState.Service
+			// the agreements count as often as the queue counts them
+			bwServer.UptimeInterval = config.BwAgreement.FlushInterval
+		}
+		if config.BwAgreement.QueueSize > 0 {
+			peer.Agreements.Queue = bwagreement.NewQueue(peer.Log.Named("agreements:queue"), peer.DB.BandwidthAgreement(), bwServer.Uptime, config.BwAgreement)
+			bwServer.Queue = peer.Agreements.Queue
+		}
 		peer.Agreements.Endpoint = bwServer
 		pb.RegisterBandwidthServer(peer.Public.Server.GRPC(), peer.Agreements.Endpoint)
+
+		peer.Agreements.Cleaner = bwagreement.NewCleaner(peer.Log.Named("agreements:cleaner"), peer.DB.BandwidthAgreement(), peer.DB.Accounting(), config.BwAgreement.Cleaner)
+		peer.Agreements.Rollup = bwagreement.NewRollup(peer.Log.Named("agreements:rollup"), peer.DB.BandwidthAgreement(), config.BwAgreement.Rollup)
+	}
+
+	{ // setup repair slo
+		config := config.RepairSLO
+
+		peer.RepairSLO.Service = slo.NewService(peer.Log.Named("repair:slo"), peer.DB.RepairSLO(), config)
+		peer.Admin.Server.Handle("/report", peer.RepairSLO.Service)
 	}
 
 	{ // setup datarepair
 		// TODO: simplify argument list somehow
 		peer.Repair.Checker = checker.NewChecker(
 			peer.Metainfo.Service,
-			peer.DB.StatDB(), peer.DB.RepairQueue(),
+			peer.NodeState.Service, peer.DB.RepairQueue(),
 			peer.Overlay.Endpoint, peer.DB.Irreparable(),
 			0, peer.Log.Named("checker"),
-			config.Checker.Interval)
+			config.Checker.Interval, peer.RepairSLO.Service)
+
+		peer.Repair.Inspector = checker.NewInspector(peer.Metainfo.Service, peer.Overlay.Service, peer.NodeState.Service,
+			&overlay.NodeSelectionConfig{
+				UptimeRatio:       config.Overlay.Node.UptimeRatio,
+				AuditSuccessRatio: config.Overlay.Node.AuditSuccessRatio,
+			})
+		pb.RegisterHealthInspectorServer(peer.Public.Server.GRPC(), peer.Repair.Inspector)
 
-		peer.Repair.Repairer = repairer.NewService(peer.DB.RepairQueue(), &config.Repairer, peer.Identity, config.Repairer.Interval, config.Repairer.MaxRepair)
+		peer.Repair.Repairer = repairer.NewService(peer.DB.RepairQueue(), peer.NodeState.Service, &config.Repairer, peer.Identity, config.Repairer.Interval, config.Repairer.MaxRepair, peer.RepairSLO.Service)
 	}
 
 	{ // setup audit
@@ -326,8 +603,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 		transportClient := transport.NewClient(peer.Identity)
 
 		peer.Audit.Service, err = audit.NewService(peer.Log.Named("audit"),
-			peer.DB.StatDB(),
-			config.Interval, config.MaxRetriesStatDB,
+			peer.NodeState.Service, peer.DB.Containment(),
+			config,
 			peer.Metainfo.Service, peer.Metainfo.Allocation,
 			transportClient, peer.Overlay.Service,
 			peer.Identity,
@@ -340,6 +617,91 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 	{ // setup accounting
 		peer.Accounting.Tally = tally.New(peer.Log.Named("tally"), peer.DB.Accounting(), peer.DB.BandwidthAgreement(), peer.Metainfo.Service, peer.Overlay.Endpoint, 0, config.Tally.Interval)
 		peer.Accounting.Rollup = rollup.New(peer.Log.Named("rollup"), peer.DB.Accounting(), config.Rollup.Interval)
+		peer.Accounting.NodeTally = nodetally.New(peer.Log.Named("nodetally"), peer.DB.Accounting(), peer.Overlay.Service, peer.Transport, peer.Identity, config.NodeTally)
+
+		var sink export.Sink
+		if config.Export.Sink != "" {
+			sink, err = export.OpenSink(config.Export.Sink)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+		}
+		peer.Accounting.Export = export.New(peer.Log.Named("accounting:export"), peer.DB.Accounting(), sink, config.Export)
+	}
+
+	{ // setup purge
+		// deletes don't buffer any data, so the ecclient doesn't need a memory limit
+		ec := ecclient.NewClient(peer.Identity, 0)
+		peer.Purge.Service = purge.New(peer.Log.Named("purge"), peer.DB.Console().ProjectDeletions(),
+			peer.Metainfo.Service, peer.Overlay.Service, ec, peer.Identity, config.Purge)
+	}
+
+	{ // setup garbage collection
+		peer.Retain.Service = retain.New(peer.Log.Named("retain"), peer.Metainfo.Service, peer.Overlay.Service, peer.Transport, config.Retain)
+	}
+
+	{ // setup usage alerts
+		peer.UsageAlert.Service = usagealert.New(peer.Log.Named("usagealert"), peer.DB.Console(),
+			peer.DB.PrefixQuotas(), peer.Metainfo.Service, config.UsageAlert)
+	}
+
+	{ // setup sampling
+		config := config.Sampling
+
+		peer.Sampling.Service = sampling.NewService(peer.Log.Named("sampling"), peer.DB.Samples(), peer.Metainfo.Service, config)
+		peer.Admin.Server.Handle("/snapshots", peer.Sampling.Service)
+	}
+
+	{ // setup pricing
+		config := config.ProjectPricing
+
+		peer.Pricing.Service = pricing.NewService(peer.Log.Named("pricing"), peer.DB.Pricing(), config)
+
+		handler := peer.AuditLog.Handler("pricing", peer.Pricing.Service)
+		peer.Admin.Server.Handle("/tiers", handler)
+		peer.Admin.Server.Handle("/projects", handler)
+	}
+
+	{ // setup takeout
+		config := config.Takeout
+
+		peer.Takeout.Service = takeout.New(peer.Log.Named("takeout"), peer.DB.Console().ProjectExports(),
+			peer.Metainfo.Service, peer.Identity, config)
+		peer.Admin.Server.Handle("/exports", peer.AuditLog.Handler("takeout", peer.Takeout.Service))
+	}
+
+	{ // setup chores
+		config := config.Chore
+
+		peer.Chores.Group = chore.NewGroup(config)
+		peer.Chores.Group.Add(
+			peer.Discovery.Service.Refresh,
+			peer.Discovery.Service.Graveyard,
+			peer.Discovery.Service.Discover,
+			peer.Discovery.Service.Throughput,
+			peer.Repair.Checker.Chore(),
+			peer.Accounting.Tally.Chore,
+			peer.Accounting.Rollup.Chore,
+			peer.Accounting.NodeTally.Chore,
+			peer.Accounting.Export.Chore,
+			peer.Accounting.Egress.Chore,
+			peer.Abuse.Service.Refresh,
+			peer.Overlay.Stray.Chore,
+			peer.Overlay.Addresses.Chore,
+			peer.Agreements.Cleaner.Chore,
+			peer.Agreements.Rollup.Chore,
+			peer.Purge.Service.Chore,
+			peer.Retain.Service.Chore,
+			peer.UsageAlert.Service.Chore,
+			peer.Sampling.Service.Chore,
+			peer.Takeout.Service.Chore,
+			peer.NodeState.Service.Webhook.Chore,
+		)
+		if peer.Metainfo.Endpoint.Objects != nil {
+			peer.Chores.Group.Add(peer.Metainfo.Endpoint.Objects.Chore)
+		}
+
+		peer.Admin.Server.Handle("/chores", peer.AuditLog.Handler("chore", peer.Chores.Group))
 	}
 
 	{ // setup console
@@ -354,17 +716,43 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config *Config) (*
 			// TODO: use satellite key
 			&consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")},
 			peer.DB.Console(),
+			peer.AuditLog,
 			config.PasswordCost,
 		)
 
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peer.Console.Service.Egress = peer.Accounting.Egress
 
 		peer.Console.Endpoint = consoleweb.NewServer(peer.Log.Named("console:endpoint"),
 			config,
 			peer.Console.Service,
 			peer.Console.Listener)
+		peer.Console.Endpoint.Maintenance = peer.Maintenance.Mode
+	}
+
+	{ // setup health
+		config := config.Health
+
+		peer.Health.Service = health.NewService(config)
+		peer.Health.Service.Add("database", peer.DB.Ping)
+		peer.Health.Service.Add("pointerdb", func(ctx context.Context) error {
+			_, err := peer.Metainfo.Database.List(nil, 1)
+			return err
+		})
+		peer.Health.Service.Add("chores", peer.Chores.Group.Check)
+
+		peer.Health.Endpoint = health.NewEndpoint(peer.Health.Service)
+		pb.RegisterHealthServer(peer.Public.Server.GRPC(), peer.Health.Endpoint)
+
+		if config.Address != "" {
+			peer.Health.Listener, err = net.Listen("tcp", config.Address)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Health.Server = health.NewServer(peer.Health.Service, peer.Health.Listener)
+		}
 	}
 
 	return peer, nil
@@ -390,6 +778,26 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return ignoreCancel(peer.Discovery.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.NodeState.Service.Webhook.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Overlay.Stray.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Overlay.Addresses.Run(ctx))
+	})
+	if peer.Agreements.Queue != nil {
+		group.Go(func() error {
+			return ignoreCancel(peer.Agreements.Queue.Run(ctx))
+		})
+	}
+	group.Go(func() error {
+		return ignoreCancel(peer.Agreements.Cleaner.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Agreements.Rollup.Run(ctx))
+	})
 	group.Go(func() error {
 		return ignoreCancel(peer.Repair.Checker.Run(ctx))
 	})
@@ -402,9 +810,41 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return ignoreCancel(peer.Accounting.Rollup.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Accounting.NodeTally.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Accounting.Export.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Accounting.Egress.Run(ctx))
+	})
+	if peer.Metainfo.Endpoint.Objects != nil {
+		group.Go(func() error {
+			return ignoreCancel(peer.Metainfo.Endpoint.Objects.Run(ctx))
+		})
+	}
 	group.Go(func() error {
 		return ignoreCancel(peer.Audit.Service.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Abuse.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Purge.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Retain.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.UsageAlert.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Sampling.Service.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Takeout.Service.Run(ctx))
+	})
 	group.Go(func() error {
 		// TODO: move the message into Server instead
 		peer.Log.Sugar().Infof("Node %s started on %s", peer.Identity.ID, peer.Public.Server.Addr().String())
@@ -413,6 +853,14 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return ignoreCancel(peer.Console.Endpoint.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Admin.Server.Run(ctx))
+	})
+	if peer.Health.Server != nil {
+		group.Go(func() error {
+			return ignoreCancel(peer.Health.Server.Run(ctx))
+		})
+	}
 
 	return group.Wait()
 }
@@ -441,6 +889,18 @@ func (peer *Peer) Close() error {
 		}
 	}
 
+	if peer.Admin.Server != nil {
+		errlist.Add(peer.Admin.Server.Close())
+	} else if peer.Admin.Listener != nil {
+		errlist.Add(peer.Admin.Listener.Close())
+	}
+
+	if peer.Health.Server != nil {
+		errlist.Add(peer.Health.Server.Close())
+	} else if peer.Health.Listener != nil {
+		errlist.Add(peer.Health.Listener.Close())
+	}
+
 	// close services in reverse initialization order
 	if peer.Repair.Repairer != nil {
 		errlist.Add(peer.Repair.Repairer.Close())
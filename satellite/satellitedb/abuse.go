@@ -0,0 +1,76 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/abuse"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type abuseDB struct {
+	db *dbx.DB
+}
+
+// Create adds a block and returns it with its ID set
+func (db *abuseDB) Create(ctx context.Context, block abuse.Block) (_ abuse.Block, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxBlock, err := db.db.Create_AbuseBlock(ctx,
+		dbx.AbuseBlock_Kind(int(block.Kind)),
+		dbx.AbuseBlock_Value(block.Value),
+		dbx.AbuseBlock_Reason(block.Reason),
+		dbx.AbuseBlock_CreatedBy(block.CreatedBy),
+		dbx.AbuseBlock_CreatedAt(block.CreatedAt),
+		dbx.AbuseBlock_ExpiresAt(block.ExpiresAt),
+	)
+	if err != nil {
+		return abuse.Block{}, Error.Wrap(err)
+	}
+	return convertAbuseBlock(dbxBlock), nil
+}
+
+// Delete removes a block
+func (db *abuseDB) Delete(ctx context.Context, id int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := db.db.Delete_AbuseBlock_By_Id(ctx, dbx.AbuseBlock_Id(id))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !deleted {
+		return abuse.ErrNotFound.New("%d", id)
+	}
+	return nil
+}
+
+// ListActive returns the blocks which expire after now
+func (db *abuseDB) ListActive(ctx context.Context, now time.Time) (_ []abuse.Block, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.All_AbuseBlock_By_ExpiresAt_Greater(ctx, dbx.AbuseBlock_ExpiresAt(now))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	blocks := make([]abuse.Block, 0, len(rows))
+	for _, row := range rows {
+		blocks = append(blocks, convertAbuseBlock(row))
+	}
+	return blocks, nil
+}
+
+func convertAbuseBlock(row *dbx.AbuseBlock) abuse.Block {
+	return abuse.Block{
+		ID:        row.Id,
+		Kind:      abuse.Kind(row.Kind),
+		Value:     row.Value,
+		Reason:    row.Reason,
+		CreatedBy: row.CreatedBy,
+		CreatedAt: row.CreatedAt,
+		ExpiresAt: row.ExpiresAt,
+	}
+}
@@ -5,6 +5,7 @@ package satellitedb
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -17,7 +18,8 @@ import (
 
 //database implements DB
 type accountingDB struct {
-	db *dbx.DB
+	db       *dbx.DB
+	replicas *replicaSet
 }
 
 // LastTimestamp records the greatest last tallied time
@@ -193,10 +195,94 @@ func (db *accountingDB) SaveRollup(ctx context.Context, latestRollup time.Time,
 	return Error.Wrap(err)
 }
 
+// DeleteRawForNodes removes the raw tallies of nodes which haven't been rolled up yet
+func (db *accountingDB) DeleteRawForNodes(ctx context.Context, nodeIDs storj.NodeIDList) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(nodeIDs))
+	for _, id := range nodeIDs {
+		args = append(args, id.Bytes())
+	}
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`DELETE FROM accounting_raws
+		WHERE node_id IN (?`+strings.Repeat(", ?", len(nodeIDs)-1)+`)`), args...)
+	return Error.Wrap(err)
+}
+
+// GetRollupsAfter returns up to limit rollups with an id greater than id, ordered by id
+func (db *accountingDB) GetRollupsAfter(ctx context.Context, id int64, limit int) (_ []*accounting.Rollup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader := db.replicas.Read(ctx)
+	rows, err := reader.DB.QueryContext(ctx, reader.Rebind(`SELECT id, node_id, start_time, put_total, get_total,
+		get_audit_total, get_repair_total, put_repair_total, at_rest_total
+		FROM accounting_rollups
+		WHERE id > ?
+		ORDER BY id
+		LIMIT ?`), id, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var rollups []*accounting.Rollup
+	for rows.Next() {
+		var nodeID []byte
+		r := &accounting.Rollup{}
+		err := rows.Scan(&r.ID, &nodeID, &r.StartTime, &r.PutTotal, &r.GetTotal,
+			&r.GetAuditTotal, &r.GetRepairTotal, &r.PutRepairTotal, &r.AtRestTotal)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		r.NodeID, err = storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		rollups = append(rollups, r)
+	}
+	return rollups, Error.Wrap(rows.Err())
+}
+
+// GetRawAfter returns up to limit raw tallies with an id greater than id, ordered by id
+func (db *accountingDB) GetRawAfter(ctx context.Context, id int64, limit int) (_ []*accounting.Raw, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader := db.replicas.Read(ctx)
+	rows, err := reader.DB.QueryContext(ctx, reader.Rebind(`SELECT id, node_id, interval_end_time, data_total, data_type, created_at
+		FROM accounting_raws
+		WHERE id > ?
+		ORDER BY id
+		LIMIT ?`), id, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var raws []*accounting.Raw
+	for rows.Next() {
+		var nodeID []byte
+		r := &accounting.Raw{}
+		err := rows.Scan(&r.ID, &nodeID, &r.IntervalEndTime, &r.DataTotal, &r.DataType, &r.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		r.NodeID, err = storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		raws = append(raws, r)
+	}
+	return raws, Error.Wrap(rows.Err())
+}
+
 // QueryPaymentInfo queries StatDB, Accounting Rollup on nodeID
 func (db *accountingDB) QueryPaymentInfo(ctx context.Context, start time.Time, end time.Time) ([]*accounting.CSVRow, error) {
 	var sql = `SELECT n.id, n.created_at, n.audit_success_ratio, r.at_rest_total, r.get_repair_total,
-	    r.put_repair_total, r.get_audit_total, r.put_total, r.get_total, o.operator_wallet
+	    r.put_repair_total, r.get_audit_total, r.put_total, r.get_total, o.operator_wallet,
+	    o.operator_wallet_features
 	    FROM (
 			SELECT node_id, SUM(at_rest_total) AS at_rest_total, SUM(get_repair_total) AS get_repair_total, 
 			SUM(put_repair_total) AS put_repair_total, SUM(get_audit_total) AS get_audit_total, 
@@ -208,7 +294,8 @@ func (db *accountingDB) QueryPaymentInfo(ctx context.Context, start time.Time, e
 		LEFT JOIN nodes n ON n.id = r.node_id
 		LEFT JOIN overlay_cache_nodes o ON n.id = o.node_id
 	    ORDER BY n.id`
-	rows, err := db.db.DB.Query(db.db.Rebind(sql), start.UTC(), end.UTC())
+	reader := db.replicas.Read(ctx)
+	rows, err := reader.DB.QueryContext(ctx, reader.Rebind(sql), start.UTC(), end.UTC())
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -216,12 +303,14 @@ func (db *accountingDB) QueryPaymentInfo(ctx context.Context, start time.Time, e
 	csv := make([]*accounting.CSVRow, 0, 0)
 	for rows.Next() {
 		var nodeID []byte
+		var walletFeatures string
 		r := &accounting.CSVRow{}
 		err := rows.Scan(&nodeID, &r.NodeCreationDate, &r.AuditSuccessRatio, &r.AtRestTotal, &r.GetRepairTotal,
-			&r.PutRepairTotal, &r.GetAuditTotal, &r.PutTotal, &r.GetTotal, &r.Wallet)
+			&r.PutRepairTotal, &r.GetAuditTotal, &r.PutTotal, &r.GetTotal, &r.Wallet, &walletFeatures)
 		if err != nil {
 			return csv, Error.Wrap(err)
 		}
+		r.PayoutMethod = accounting.PayoutMethod(decodeWalletFeatures(walletFeatures))
 		id, err := storj.NodeIDFromBytes(nodeID)
 		if err != nil {
 			return csv, Error.Wrap(err)
@@ -0,0 +1,63 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/auditlog"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type auditLogDB struct {
+	db *dbx.DB
+}
+
+// Append adds an entry and returns it with its ID and creation time set
+func (db *auditLogDB) Append(ctx context.Context, entry auditlog.Entry) (_ auditlog.Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxEntry, err := db.db.Create_AuditLog(ctx,
+		dbx.AuditLog_Actor(entry.Actor),
+		dbx.AuditLog_Action(entry.Action),
+		dbx.AuditLog_Target(entry.Target),
+		dbx.AuditLog_RemoteAddr(entry.RemoteAddr),
+	)
+	if err != nil {
+		return auditlog.Entry{}, Error.Wrap(err)
+	}
+	return convertAuditLog(dbxEntry), nil
+}
+
+// List returns up to limit entries created in [since, until), oldest first
+func (db *auditLogDB) List(ctx context.Context, since, until time.Time, limit int, offset int64) (_ []auditlog.Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Limited_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_Id(ctx,
+		dbx.AuditLog_CreatedAt(since.UTC()),
+		dbx.AuditLog_CreatedAt(until.UTC()),
+		limit, offset,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	entries := make([]auditlog.Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, convertAuditLog(row))
+	}
+	return entries, nil
+}
+
+func convertAuditLog(row *dbx.AuditLog) auditlog.Entry {
+	return auditlog.Entry{
+		ID:         row.Id,
+		Actor:      row.Actor,
+		Action:     row.Action,
+		Target:     row.Target,
+		RemoteAddr: row.RemoteAddr,
+		CreatedAt:  row.CreatedAt,
+	}
+}
@@ -5,7 +5,10 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -17,7 +20,8 @@ import (
 )
 
 type bandwidthagreement struct {
-	db *dbx.DB
+	db       *dbx.DB
+	replicas *replicaSet
 }
 
 func (b *bandwidthagreement) CreateAgreement(ctx context.Context, rba *pb.RenterBandwidthAllocation) (err error) {
@@ -34,6 +38,55 @@ func (b *bandwidthagreement) CreateAgreement(ctx context.Context, rba *pb.Renter
 	return err
 }
 
+// CreateAgreements adds new bandwidth agreements in a single transaction,
+// none of them are stored when one of them fails
+func (b *bandwidthagreement) CreateAgreements(ctx context.Context, rbas []*pb.RenterBandwidthAllocation) (err error) {
+	tx, err := b.db.Open(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rba := range rbas {
+		expiration := time.Unix(rba.PayerAllocation.ExpirationUnixSec, 0)
+		_, err = tx.Create_Bwagreement(
+			ctx,
+			dbx.Bwagreement_Serialnum(rba.PayerAllocation.SerialNumber+rba.StorageNodeId.String()),
+			dbx.Bwagreement_StorageNodeId(rba.StorageNodeId.Bytes()),
+			dbx.Bwagreement_UplinkId(rba.PayerAllocation.UplinkId.Bytes()),
+			dbx.Bwagreement_Action(int64(rba.PayerAllocation.Action)),
+			dbx.Bwagreement_Total(rba.Total),
+			dbx.Bwagreement_ExpiresAt(expiration),
+		)
+		if err != nil {
+			return errs.Combine(err, tx.Rollback())
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ReplayAgreement adds a bandwidth agreement with the creation time of its payer allocation
+func (b *bandwidthagreement) ReplayAgreement(ctx context.Context, rba *pb.RenterBandwidthAllocation) (err error) {
+	created := time.Unix(rba.PayerAllocation.CreatedUnixSec, 0)
+	if rba.PayerAllocation.CreatedUnixSec == 0 {
+		created = time.Now()
+	}
+	expiration := time.Unix(rba.PayerAllocation.ExpirationUnixSec, 0)
+
+	_, err = b.db.ExecContext(ctx, b.db.Rebind(`INSERT INTO bwagreements
+		( serialnum, storage_node_id, uplink_id, action, total, created_at, expires_at )
+		VALUES ( ?, ?, ?, ?, ?, ?, ? )`),
+		rba.PayerAllocation.SerialNumber+rba.StorageNodeId.String(),
+		rba.StorageNodeId.Bytes(),
+		rba.PayerAllocation.UplinkId.Bytes(),
+		int64(rba.PayerAllocation.Action),
+		rba.Total,
+		created.UTC(),
+		expiration.UTC(),
+	)
+	return err
+}
+
 //GetTotals returns stats about an uplink
 func (b *bandwidthagreement) GetUplinkStats(ctx context.Context, from, to time.Time) (stats []bwagreement.UplinkStat, err error) {
 
@@ -43,7 +96,8 @@ func (b *bandwidthagreement) GetUplinkStats(ctx context.Context, from, to time.T
 		FROM bwagreements WHERE created_at > ? 
 		AND created_at <= ? GROUP BY uplink_id ORDER BY uplink_id`,
 		pb.BandwidthAction_PUT, pb.BandwidthAction_GET)
-	rows, err := b.db.DB.Query(b.db.Rebind(uplinkSQL), from.UTC(), to.UTC())
+	db := b.replicas.Read(ctx)
+	rows, err := db.DB.QueryContext(ctx, db.Rebind(uplinkSQL), from.UTC(), to.UTC())
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +119,95 @@ func (b *bandwidthagreement) GetUplinkStats(ctx context.Context, from, to time.T
 	return stats, nil
 }
 
+// HasAgreement returns whether the storage node has already submitted the agreement with the serial number
+func (b *bandwidthagreement) HasAgreement(ctx context.Context, serialNumber string, storageNodeID storj.NodeID) (bool, error) {
+	var count int64
+	err := b.db.QueryRowContext(ctx, b.db.Rebind(`SELECT COUNT(*) FROM bwagreements WHERE serialnum = ?`),
+		serialNumber+storageNodeID.String()).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetUplinkStatsPage returns a page of the stats of the uplinks selected by opts
+func (b *bandwidthagreement) GetUplinkStatsPage(ctx context.Context, opts bwagreement.UplinkStatsOptions) (page bwagreement.UplinkStatsPage, err error) {
+	if opts.Limit <= 0 {
+		return page, bwagreement.Error.New("limit must be positive, got %d", opts.Limit)
+	}
+
+	var orderExpr string
+	switch opts.OrderBy {
+	case bwagreement.OrderByUplinkID:
+	case bwagreement.OrderByTotalBytes:
+		orderExpr = "SUM(total)"
+	case bwagreement.OrderByTotalTransactions:
+		orderExpr = "COUNT(*)"
+	default:
+		return page, bwagreement.Error.New("unknown order %d", opts.OrderBy)
+	}
+
+	uplinkSQL := fmt.Sprintf(`SELECT uplink_id, SUM(total),
+		COUNT(CASE WHEN action = %d THEN total ELSE null END),
+		COUNT(CASE WHEN action = %d THEN total ELSE null END), COUNT(*)
+		FROM bwagreements WHERE created_at > ? AND created_at <= ?`,
+		pb.BandwidthAction_PUT, pb.BandwidthAction_GET)
+	args := []interface{}{opts.From.UTC(), opts.To.UTC()}
+
+	if len(opts.Actions) > 0 {
+		uplinkSQL += ` AND action IN (?` + strings.Repeat(`, ?`, len(opts.Actions)-1) + `)`
+		for _, action := range opts.Actions {
+			args = append(args, int64(action))
+		}
+	}
+
+	// keyset pagination, so pages stay consistent while agreements are added
+	if orderExpr == "" {
+		if opts.Cursor != nil {
+			uplinkSQL += ` AND uplink_id > ?`
+			args = append(args, opts.Cursor.UplinkID.Bytes())
+		}
+		uplinkSQL += ` GROUP BY uplink_id ORDER BY uplink_id`
+	} else {
+		uplinkSQL += ` GROUP BY uplink_id`
+		if opts.Cursor != nil {
+			uplinkSQL += fmt.Sprintf(` HAVING %[1]s < ? OR (%[1]s = ? AND uplink_id > ?)`, orderExpr)
+			args = append(args, opts.Cursor.Value, opts.Cursor.Value, opts.Cursor.UplinkID.Bytes())
+		}
+		uplinkSQL += fmt.Sprintf(` ORDER BY %s DESC, uplink_id`, orderExpr)
+	}
+
+	// one more than the limit tells whether there is a next page
+	uplinkSQL += ` LIMIT ?`
+	args = append(args, opts.Limit+1)
+
+	db := b.replicas.Read(ctx)
+	rows, err := db.DB.QueryContext(ctx, db.Rebind(uplinkSQL), args...)
+	if err != nil {
+		return page, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var stats []bwagreement.UplinkStat
+	for rows.Next() {
+		var uplinkID []byte
+		stat := bwagreement.UplinkStat{}
+		err := rows.Scan(&uplinkID, &stat.TotalBytes, &stat.PutActionCount, &stat.GetActionCount, &stat.TotalTransactions)
+		if err != nil {
+			return page, err
+		}
+		stat.NodeID, err = storj.NodeIDFromBytes(uplinkID)
+		if err != nil {
+			return page, err
+		}
+		stats = append(stats, stat)
+	}
+	if err := rows.Err(); err != nil {
+		return page, err
+	}
+	return bwagreement.NewUplinkStatsPage(stats, opts), nil
+}
+
 //GetTotals returns the sum of each bandwidth type after (exluding) a given date range
 func (b *bandwidthagreement) GetTotals(ctx context.Context, from, to time.Time) (bwa map[storj.NodeID][]int64, err error) {
 	var getTotalsSQL = fmt.Sprintf(`SELECT storage_node_id, 
@@ -77,7 +220,8 @@ func (b *bandwidthagreement) GetTotals(ctx context.Context, from, to time.Time)
 		GROUP BY storage_node_id ORDER BY storage_node_id`, pb.BandwidthAction_PUT,
 		pb.BandwidthAction_GET, pb.BandwidthAction_GET_AUDIT,
 		pb.BandwidthAction_GET_REPAIR, pb.BandwidthAction_PUT_REPAIR)
-	rows, err := b.db.DB.Query(b.db.Rebind(getTotalsSQL), from.UTC(), to.UTC())
+	db := b.replicas.Read(ctx)
+	rows, err := db.DB.QueryContext(ctx, db.Rebind(getTotalsSQL), from.UTC(), to.UTC())
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +245,215 @@ func (b *bandwidthagreement) GetTotals(ctx context.Context, from, to time.Time)
 	return totals, nil
 }
 
-func (b *bandwidthagreement) DeletePaidAndExpired(ctx context.Context) error {
-	// TODO: implement deletion of paid and expired BWAs
-	return Error.New("DeletePaidAndExpired not implemented")
+// DeleteExpired deletes the agreements, which were created and expired before the given time
+func (b *bandwidthagreement) DeleteExpired(ctx context.Context, before time.Time) (deleted int64, err error) {
+	result, err := b.db.ExecContext(ctx, b.db.Rebind(`DELETE FROM bwagreements
+		WHERE created_at < ? AND expires_at < ?`), before.UTC(), before.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// ArchiveExpired moves the agreements, which were created and expired before the given time,
+// to the archive
+func (b *bandwidthagreement) ArchiveExpired(ctx context.Context, before time.Time) (archived int64, err error) {
+	tx, err := b.db.Open(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Tx.ExecContext(ctx, b.db.Rebind(`INSERT INTO bwagreement_archives
+		( serialnum, storage_node_id, uplink_id, action, total, created_at, expires_at, archived_at )
+		SELECT serialnum, storage_node_id, uplink_id, action, total, created_at, expires_at, ?
+		FROM bwagreements WHERE created_at < ? AND expires_at < ?`),
+		time.Now().UTC(), before.UTC(), before.UTC())
+	if err != nil {
+		return 0, errs.Combine(err, tx.Rollback())
+	}
+
+	result, err := tx.Tx.ExecContext(ctx, b.db.Rebind(`DELETE FROM bwagreements
+		WHERE created_at < ? AND expires_at < ?`), before.UTC(), before.UTC())
+	if err != nil {
+		return 0, errs.Combine(err, tx.Rollback())
+	}
+	archived, err = result.RowsAffected()
+	if err != nil {
+		return 0, errs.Combine(err, tx.Rollback())
+	}
+
+	return archived, tx.Commit()
+}
+
+// SaveRollup stores the totals of each storage node during the hour starting at the given time
+func (b *bandwidthagreement) SaveRollup(ctx context.Context, intervalStart time.Time, totals map[storj.NodeID][]int64) (err error) {
+	tx, err := b.db.Open(ctx)
+	if err != nil {
+		return err
+	}
+
+	for nodeID, actions := range totals {
+		for action, total := range actions {
+			if total == 0 {
+				continue
+			}
+			_, err = tx.Tx.ExecContext(ctx, b.db.Rebind(`INSERT INTO bwagreement_rollups
+				( storage_node_id, interval_start, action, total )
+				VALUES ( ?, ?, ?, ? )`),
+				nodeID.Bytes(), intervalStart.UTC(), int64(action), total)
+			if err != nil {
+				return errs.Combine(err, tx.Rollback())
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+// LastRollup returns the end of the last hour, which has been rolled up, or the zero time
+func (b *bandwidthagreement) LastRollup(ctx context.Context) (last time.Time, err error) {
+	db := b.replicas.Read(ctx)
+	err = db.DB.QueryRowContext(ctx, db.Rebind(`SELECT interval_start FROM bwagreement_rollups
+		ORDER BY interval_start DESC LIMIT 1`)).Scan(&last)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	return last.Add(time.Hour), nil
+}
+
+// GetTotalsBucketed returns the rolled up totals of each storage node between from (inclusive) and to
+// (exclusive), summed into buckets of the given interval
+func (b *bandwidthagreement) GetTotalsBucketed(ctx context.Context, from, to time.Time, interval time.Duration) (buckets []bwagreement.TotalsBucket, err error) {
+	if interval < time.Hour || interval%time.Hour != 0 {
+		return nil, Error.New("interval must be a multiple of an hour: %v", interval)
+	}
+	from = from.UTC().Truncate(time.Hour)
+
+	db := b.replicas.Read(ctx)
+	rows, err := db.DB.QueryContext(ctx, db.Rebind(`SELECT storage_node_id, interval_start, action, total
+		FROM bwagreement_rollups WHERE interval_start >= ? AND interval_start < ?`), from, to.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	type bucketKey struct {
+		nodeID storj.NodeID
+		start  time.Time
+	}
+	byKey := make(map[bucketKey]*bwagreement.TotalsBucket)
+	for rows.Next() {
+		var nodeID []byte
+		var intervalStart time.Time
+		var action, total int64
+		if err := rows.Scan(&nodeID, &intervalStart, &action, &total); err != nil {
+			return nil, err
+		}
+		id, err := storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, err
+		}
+		if action < 0 || action >= int64(len(pb.BandwidthAction_value)) {
+			continue
+		}
+
+		key := bucketKey{nodeID: id, start: from.Add(intervalStart.UTC().Sub(from) / interval * interval)}
+		bucket, ok := byKey[key]
+		if !ok {
+			bucket = &bwagreement.TotalsBucket{
+				NodeID:        id,
+				IntervalStart: key.start,
+				Totals:        make([]int64, len(pb.BandwidthAction_value)),
+			}
+			byKey[key] = bucket
+		}
+		bucket.Totals[action] += total
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, bucket := range byKey {
+		buckets = append(buckets, *bucket)
+	}
+	sort.Slice(buckets, func(i, k int) bool {
+		if !buckets[i].IntervalStart.Equal(buckets[k].IntervalStart) {
+			return buckets[i].IntervalStart.Before(buckets[k].IntervalStart)
+		}
+		return buckets[i].NodeID.Less(buckets[k].NodeID)
+	})
+	return buckets, nil
+}
+
+// SaveAnomaly flags an agreement, which claims a total near or above the max size of its allocation
+func (b *bandwidthagreement) SaveAnomaly(ctx context.Context, rba *pb.RenterBandwidthAllocation) (err error) {
+	_, err = b.db.ExecContext(ctx, b.db.Rebind(`INSERT INTO bwagreement_anomalies
+		( serialnum, storage_node_id, action, total, max_size, created_at )
+		VALUES ( ?, ?, ?, ?, ?, ? )`),
+		rba.PayerAllocation.SerialNumber+rba.StorageNodeId.String(),
+		rba.StorageNodeId.Bytes(),
+		int64(rba.PayerAllocation.Action),
+		rba.Total,
+		rba.PayerAllocation.MaxSize,
+		time.Now().UTC(),
+	)
+	return err
+}
+
+// GetSuspiciousNodes returns the storage nodes with at least minAnomalies agreements flagged since the
+// given time, most flagged first
+func (b *bandwidthagreement) GetSuspiciousNodes(ctx context.Context, since time.Time, minAnomalies int) (nodes []bwagreement.SuspiciousNode, err error) {
+	db := b.replicas.Read(ctx)
+	rows, err := db.DB.QueryContext(ctx, db.Rebind(`SELECT storage_node_id, total, max_size, created_at
+		FROM bwagreement_anomalies WHERE created_at >= ?`), since.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	byNode := make(map[storj.NodeID]*bwagreement.SuspiciousNode)
+	for rows.Next() {
+		var nodeID []byte
+		var total, maxSize int64
+		var createdAt time.Time
+		if err := rows.Scan(&nodeID, &total, &maxSize, &createdAt); err != nil {
+			return nil, err
+		}
+		id, err := storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		node, ok := byNode[id]
+		if !ok {
+			node = &bwagreement.SuspiciousNode{NodeID: id}
+			byNode[id] = node
+		}
+		node.Anomalies++
+		if total > maxSize {
+			node.Exceeded++
+		}
+		if createdAt.After(node.LastFlagged) {
+			node.LastFlagged = createdAt
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, node := range byNode {
+		if node.Anomalies >= int64(minAnomalies) {
+			nodes = append(nodes, *node)
+		}
+	}
+	sort.Slice(nodes, func(i, k int) bool {
+		if nodes[i].Anomalies != nodes[k].Anomalies {
+			return nodes[i].Anomalies > nodes[k].Anomalies
+		}
+		return nodes[i].NodeID.Less(nodes[k].NodeID)
+	})
+	return nodes, nil
 }
@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/pointerdb"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// bucketLocks is an implementation of pointerdb.BucketLocks
+type bucketLocks struct {
+	db *dbx.DB
+}
+
+// Set adds or replaces the lock of a bucket
+func (locks *bucketLocks) Set(ctx context.Context, lock pointerdb.BucketLock) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now().UTC()
+	_, err = locks.db.ExecContext(ctx, locks.db.Rebind(`INSERT INTO bucket_locks
+		( project_id, bucket_name, retention_period, legal_hold, created_at, updated_at )
+		VALUES ( ?, ?, ?, ?, ?, ? )
+		ON CONFLICT ( project_id, bucket_name ) DO UPDATE SET
+			retention_period = excluded.retention_period,
+			legal_hold = excluded.legal_hold,
+			updated_at = excluded.updated_at`),
+		lock.ProjectID[:], lock.Bucket, int64(lock.RetentionPeriod/time.Second), lock.LegalHold, now, now)
+	return Error.Wrap(err)
+}
+
+// Get returns the lock of a bucket, it's nil when the bucket has none
+func (locks *bucketLocks) Get(ctx context.Context, projectID uuid.UUID, bucket string) (_ *pointerdb.BucketLock, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := locks.db.QueryRowContext(ctx, locks.db.Rebind(`SELECT retention_period, legal_hold
+		FROM bucket_locks WHERE project_id = ? AND bucket_name = ?`), projectID[:], bucket)
+
+	lock := &dbx.BucketLock{}
+	err = row.Scan(&lock.RetentionPeriod, &lock.LegalHold)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &pointerdb.BucketLock{
+		ProjectID:       projectID,
+		Bucket:          bucket,
+		RetentionPeriod: time.Duration(lock.RetentionPeriod) * time.Second,
+		LegalHold:       lock.LegalHold,
+	}, nil
+}
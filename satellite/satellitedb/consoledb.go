@@ -57,6 +57,21 @@ func (db *ConsoleDB) APIKeys() console.APIKeys {
 	return &apikeys{db.methods}
 }
 
+// ProjectAlerts is a getter for ProjectAlerts repository
+func (db *ConsoleDB) ProjectAlerts() console.ProjectAlerts {
+	return &projectAlerts{db.db}
+}
+
+// ProjectDeletions is a getter for ProjectDeletions repository
+func (db *ConsoleDB) ProjectDeletions() console.ProjectDeletions {
+	return &projectDeletions{db.methods}
+}
+
+// ProjectExports is a getter for ProjectExports repository
+func (db *ConsoleDB) ProjectExports() console.ProjectExports {
+	return &projectExports{db.db}
+}
+
 // CreateTables is a method for creating all tables for satellitedb
 func (db *ConsoleDB) CreateTables() error {
 	if db.db == nil {
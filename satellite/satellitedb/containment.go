@@ -0,0 +1,92 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/storj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// containment is an implementation of audit.Containment
+type containment struct {
+	db *dbx.DB
+}
+
+// Get returns the pending audit of a node, ErrContainedNotFound when it isn't contained
+func (containment *containment) Get(ctx context.Context, nodeID storj.NodeID) (_ *audit.PendingAudit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pending := &audit.PendingAudit{NodeID: nodeID}
+	err = containment.db.QueryRowContext(ctx, containment.db.Rebind(`SELECT
+		path, piece_id, piece_num, stripe_index, share_size, expected_share_hash, reverify_count
+		FROM pending_audits WHERE node_id = ?`), nodeID.Bytes()).Scan(
+		&pending.Path, &pending.PieceID, &pending.PieceNumber, &pending.StripeIndex,
+		&pending.ShareSize, &pending.ExpectedShareHash, &pending.ReverifyCount)
+	if err == sql.ErrNoRows {
+		return nil, audit.ErrContainedNotFound.New("%s", nodeID)
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return pending, nil
+}
+
+// IncrementPending contains a node for the pending audit, or increments the
+// reverify count when the node is contained for the same audit already,
+// ErrAlreadyExists when it's contained for another one
+func (containment *containment) IncrementPending(ctx context.Context, pending *audit.PendingAudit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := containment.db.ExecContext(ctx, containment.db.Rebind(`UPDATE pending_audits
+		SET reverify_count = reverify_count + 1
+		WHERE node_id = ? AND piece_id = ? AND piece_num = ? AND stripe_index = ? AND expected_share_hash = ?`),
+		pending.NodeID.Bytes(), pending.PieceID, pending.PieceNumber, pending.StripeIndex, pending.ExpectedShareHash)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if updated > 0 {
+		return nil
+	}
+
+	result, err = containment.db.ExecContext(ctx, containment.db.Rebind(`INSERT INTO pending_audits
+		( node_id, path, piece_id, piece_num, stripe_index, share_size, expected_share_hash, reverify_count )
+		VALUES ( ?, ?, ?, ?, ?, ?, ?, 0 )
+		ON CONFLICT ( node_id ) DO NOTHING`),
+		pending.NodeID.Bytes(), pending.Path, pending.PieceID, pending.PieceNumber, pending.StripeIndex,
+		pending.ShareSize, pending.ExpectedShareHash)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if inserted == 0 {
+		return audit.ErrAlreadyExists.New("%s", pending.NodeID)
+	}
+	return nil
+}
+
+// Delete releases a node from containment, it returns whether the node was contained
+func (containment *containment) Delete(ctx context.Context, nodeID storj.NodeID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := containment.db.ExecContext(ctx, containment.db.Rebind(`DELETE FROM pending_audits WHERE node_id = ?`), nodeID.Bytes())
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return deleted > 0, nil
+}
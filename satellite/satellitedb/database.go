@@ -4,17 +4,27 @@
 package satellitedb
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/internal/migrate"
+	"storj.io/storj/pkg/abuse"
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/egress"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/auditlog"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/certdb"
 	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/datarepair/slo"
+	"storj.io/storj/pkg/nodestate"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/pricing"
+	"storj.io/storj/pkg/sampling"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/utils"
 	"storj.io/storj/satellite"
@@ -31,12 +41,19 @@ var (
 
 // DB contains access to different database tables
 type DB struct {
-	db     *dbx.DB
-	driver string
+	db       *dbx.DB
+	driver   string
+	replicas *replicaSet
 }
 
 // New creates instance of database (supports: postgres, sqlite3)
 func New(databaseURL string) (satellite.DB, error) {
+	return NewWithReplicas(databaseURL, ReplicaConfig{})
+}
+
+// NewWithReplicas creates instance of database, which sends heavy read-only
+// queries to the read replicas in config
+func NewWithReplicas(databaseURL string, config ReplicaConfig) (satellite.DB, error) {
 	driver, source, err := utils.SplitDBURL(databaseURL)
 	if err != nil {
 		return nil, err
@@ -48,7 +65,12 @@ func New(databaseURL string) (satellite.DB, error) {
 			driver, source, err)
 	}
 
-	core := &DB{db: db, driver: driver}
+	replicas, err := openReplicas(db, driver, config)
+	if err != nil {
+		return nil, errs.Combine(err, db.Close())
+	}
+
+	core := &DB{db: db, driver: driver, replicas: replicas}
 	if driver == "sqlite3" {
 		return newLocked(core), nil
 	}
@@ -85,9 +107,24 @@ func quoteSchema(schema string) string {
 	return strconv.QuoteToASCII(schema)
 }
 
+// Abuse is a getter for the blocked uplinks, api keys and ip ranges
+func (db *DB) Abuse() abuse.DB {
+	return &abuseDB{db: db.db}
+}
+
+// AuditLog is a getter for the audit log of console and admin api operations
+func (db *DB) AuditLog() auditlog.DB {
+	return &auditLogDB{db: db.db}
+}
+
 // BandwidthAgreement is a getter for bandwidth agreement repository
 func (db *DB) BandwidthAgreement() bwagreement.DB {
-	return &bandwidthagreement{db: db.db}
+	return &bandwidthagreement{db: db.db, replicas: db.replicas}
+}
+
+// BucketLocks is a getter for the object locks of buckets
+func (db *DB) BucketLocks() pointerdb.BucketLocks {
+	return &bucketLocks{db: db.db}
 }
 
 // CertDB is a getter for uplink's specific info like public key, id, etc...
@@ -95,6 +132,16 @@ func (db *DB) CertDB() certdb.DB {
 	return &certDB{db: db.db}
 }
 
+// Containment is a getter for the pending audits of contained nodes
+func (db *DB) Containment() audit.Containment {
+	return &containment{db: db.db}
+}
+
+// EgressAttributions is a getter for the egress attributed to objects
+func (db *DB) EgressAttributions() egress.DB {
+	return &egressAttributions{db: db.db}
+}
+
 // // PointerDB is a getter for PointerDB repository
 // func (db *DB) PointerDB() pointerdb.DB {
 // 	return &pointerDB{db: db.db}
@@ -105,9 +152,34 @@ func (db *DB) StatDB() statdb.DB {
 	return &statDB{db: db.db}
 }
 
+// NodeEvents is a getter for the log of node state changes
+func (db *DB) NodeEvents() nodestate.DB {
+	return &nodeEvents{db: db.db}
+}
+
 // OverlayCache is a getter for overlay cache repository
 func (db *DB) OverlayCache() overlay.DB {
-	return &overlaycache{db: db.db}
+	return &overlaycache{db: db.db, replicas: db.replicas}
+}
+
+// PrefixQuotas is a getter for the prefix quotas of buckets
+func (db *DB) PrefixQuotas() pointerdb.PrefixQuotas {
+	return &prefixQuotas{db: db.db}
+}
+
+// Pricing is a getter for the pricing tiers and the tiers of the projects
+func (db *DB) Pricing() pricing.DB {
+	return &pricingDB{db: db.db}
+}
+
+// ProjectSalts is a getter for the salts of the projects
+func (db *DB) ProjectSalts() pointerdb.ProjectSalts {
+	return &projectSalts{db: db.db}
+}
+
+// Samples is a getter for the snapshots of the pointer samples
+func (db *DB) Samples() sampling.DB {
+	return &samples{db: db.db}
 }
 
 // RepairQueue is a getter for RepairQueue repository
@@ -115,9 +187,14 @@ func (db *DB) RepairQueue() queue.RepairQueue {
 	return &repairQueue{db: db.db}
 }
 
+// RepairSLO is a getter for the daily repair counts of the slo report
+func (db *DB) RepairSLO() slo.DB {
+	return &repairSLO{db: db.db}
+}
+
 // Accounting returns database for tracking bandwidth agreements over time
 func (db *DB) Accounting() accounting.DB {
-	return &accountingDB{db: db.db}
+	return &accountingDB{db: db.db, replicas: db.replicas}
 }
 
 // Irreparable returns database for storing segments that failed repair
@@ -135,10 +212,18 @@ func (db *DB) Console() console.DB {
 
 // CreateTables is a method for creating all tables for database
 func (db *DB) CreateTables() error {
-	return migrate.Create("database", db.db)
+	if err := db.migrate(); err != nil {
+		return err
+	}
+	return migrate.Create(schemaIdentifier, db.db)
+}
+
+// Ping checks whether the database is reachable
+func (db *DB) Ping(ctx context.Context) error {
+	return Error.Wrap(db.db.DB.PingContext(ctx))
 }
 
 // Close is used to close db connection
 func (db *DB) Close() error {
-	return db.db.Close()
+	return errs.Combine(db.replicas.Close(), db.db.Close())
 }
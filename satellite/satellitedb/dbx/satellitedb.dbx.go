@@ -273,7 +273,17 @@ func newpostgres(db *DB) *postgresDB {
 }
 
 func (obj *postgresDB) Schema() string {
-	return `CREATE TABLE accounting_raws (
+	return `CREATE TABLE abuse_blocks (
+	id bigserial NOT NULL,
+	kind integer NOT NULL,
+	value text NOT NULL,
+	reason text NOT NULL,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE accounting_raws (
 	id bigserial NOT NULL,
 	node_id bytea NOT NULL,
 	interval_end_time timestamp with time zone NOT NULL,
@@ -299,6 +309,58 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE address_mismatches (
+	node_id bytea NOT NULL,
+	address text NOT NULL,
+	answered_id bytea NOT NULL,
+	detected_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE audit_logs (
+	id bigserial NOT NULL,
+	actor text NOT NULL,
+	action text NOT NULL,
+	target text NOT NULL,
+	remote_addr text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_locks (
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	retention_period bigint NOT NULL,
+	legal_hold boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bwagreement_anomalies (
+	serialnum text NOT NULL,
+	storage_node_id bytea NOT NULL,
+	action bigint NOT NULL,
+	total bigint NOT NULL,
+	max_size bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( serialnum )
+);
+CREATE TABLE bwagreement_archives (
+	serialnum text NOT NULL,
+	storage_node_id bytea NOT NULL,
+	uplink_id bytea NOT NULL,
+	action bigint NOT NULL,
+	total bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	archived_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( serialnum )
+);
+CREATE TABLE bwagreement_rollups (
+	storage_node_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	action bigint NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( storage_node_id, interval_start, action )
+);
 CREATE TABLE bwagreements (
 	serialnum text NOT NULL,
 	storage_node_id bytea NOT NULL,
@@ -315,9 +377,20 @@ CREATE TABLE certRecords (
 	update_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE egress_attributions (
+	serial_number text NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	object_path text NOT NULL,
+	sample_rate double precision NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
 CREATE TABLE injuredsegments (
 	id bigserial NOT NULL,
 	info bytea NOT NULL,
+	leased_until timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE irreparabledbs (
@@ -328,25 +401,66 @@ CREATE TABLE irreparabledbs (
 	repair_attempt_count bigint NOT NULL,
 	PRIMARY KEY ( segmentpath )
 );
+CREATE TABLE node_events (
+	id bigserial NOT NULL,
+	node_id bytea NOT NULL,
+	kind integer NOT NULL,
+	reason text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL,
+	name text NOT NULL,
+	value text NOT NULL,
+	signer_id bytea NOT NULL,
+	tag bytea NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	audit_success_count bigint NOT NULL,
 	total_audit_count bigint NOT NULL,
 	audit_success_ratio double precision NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
 	uptime_success_count bigint NOT NULL,
 	total_uptime_count bigint NOT NULL,
 	uptime_ratio double precision NOT NULL,
+	uptime_reputation_alpha double precision NOT NULL,
+	uptime_reputation_beta double precision NOT NULL,
+	last_contact_success timestamp with time zone NOT NULL,
+	last_contact_failure timestamp with time zone NOT NULL,
+	vetted_at timestamp with time zone NOT NULL,
+	disqualified_at timestamp with time zone NOT NULL,
+	disqualification_reason text NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE object_egress_rollups (
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	object_path text NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	egress bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, object_path, interval_start )
+);
 CREATE TABLE overlay_cache_nodes (
 	node_id bytea NOT NULL,
 	node_type integer NOT NULL,
 	address text NOT NULL,
 	protocol integer NOT NULL,
+	country_code text NOT NULL,
 	operator_email text NOT NULL,
 	operator_wallet text NOT NULL,
+	operator_wallet_features text NOT NULL,
+	operator_email_validated_at timestamp with time zone NOT NULL,
+	operator_wallet_validated_at timestamp with time zone NOT NULL,
+	ingress_rate bigint NOT NULL,
+	egress_rate bigint NOT NULL,
+	upload_success_ratio double precision NOT NULL,
+	download_success_ratio double precision NOT NULL,
 	free_bandwidth bigint NOT NULL,
 	free_disk bigint NOT NULL,
 	latency_90 bigint NOT NULL,
@@ -356,9 +470,78 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count bigint NOT NULL,
 	uptime_count bigint NOT NULL,
 	uptime_success_count bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
+CREATE TABLE pending_audits (
+	node_id bytea NOT NULL,
+	path text NOT NULL,
+	piece_id text NOT NULL,
+	piece_num integer NOT NULL,
+	stripe_index integer NOT NULL,
+	share_size integer NOT NULL,
+	expected_share_hash bytea NOT NULL,
+	reverify_count integer NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE prefix_quotas (
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	prefix text NOT NULL,
+	max_bytes bigint NOT NULL,
+	max_objects bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, prefix )
+);
+CREATE TABLE pricing_tiers (
+	name text NOT NULL,
+	storage bigint NOT NULL,
+	egress bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE project_alerts (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	prefix text NOT NULL,
+	threshold double precision NOT NULL,
+	webhook_url text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	triggered_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_deletions (
+	project_id bytea NOT NULL,
+	deleted_segments bigint NOT NULL,
+	deleted_bytes bigint NOT NULL,
+	requested_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_exports (
+	project_id bytea NOT NULL,
+	requested_by bytea NOT NULL,
+	exported_objects bigint NOT NULL,
+	exported_bytes bigint NOT NULL,
+	last_path text NOT NULL,
+	manifest bytea NOT NULL,
+	requested_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_salts (
+	project_id bytea NOT NULL,
+	salt bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_tiers (
+	project_id bytea NOT NULL,
+	tier text NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -366,6 +549,19 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE repair_slo_days (
+	interval_start timestamp with time zone NOT NULL,
+	injured bigint NOT NULL,
+	irreparable bigint NOT NULL,
+	repaired bigint NOT NULL,
+	failed bigint NOT NULL,
+	PRIMARY KEY ( interval_start )
+);
+CREATE TABLE sample_snapshots (
+	created_at timestamp with time zone NOT NULL,
+	data bytea NOT NULL,
+	PRIMARY KEY ( created_at )
+);
 CREATE TABLE users (
 	id bytea NOT NULL,
 	first_name text NOT NULL,
@@ -455,7 +651,17 @@ func newsqlite3(db *DB) *sqlite3DB {
 }
 
 func (obj *sqlite3DB) Schema() string {
-	return `CREATE TABLE accounting_raws (
+	return `CREATE TABLE abuse_blocks (
+	id INTEGER NOT NULL,
+	kind INTEGER NOT NULL,
+	value TEXT NOT NULL,
+	reason TEXT NOT NULL,
+	created_by TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE accounting_raws (
 	id INTEGER NOT NULL,
 	node_id BLOB NOT NULL,
 	interval_end_time TIMESTAMP NOT NULL,
@@ -481,6 +687,58 @@ CREATE TABLE accounting_timestamps (
 	value TIMESTAMP NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE address_mismatches (
+	node_id BLOB NOT NULL,
+	address TEXT NOT NULL,
+	answered_id BLOB NOT NULL,
+	detected_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE audit_logs (
+	id INTEGER NOT NULL,
+	actor TEXT NOT NULL,
+	action TEXT NOT NULL,
+	target TEXT NOT NULL,
+	remote_addr TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_locks (
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	retention_period INTEGER NOT NULL,
+	legal_hold INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bwagreement_anomalies (
+	serialnum TEXT NOT NULL,
+	storage_node_id BLOB NOT NULL,
+	action INTEGER NOT NULL,
+	total INTEGER NOT NULL,
+	max_size INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( serialnum )
+);
+CREATE TABLE bwagreement_archives (
+	serialnum TEXT NOT NULL,
+	storage_node_id BLOB NOT NULL,
+	uplink_id BLOB NOT NULL,
+	action INTEGER NOT NULL,
+	total INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	archived_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( serialnum )
+);
+CREATE TABLE bwagreement_rollups (
+	storage_node_id BLOB NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	action INTEGER NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( storage_node_id, interval_start, action )
+);
 CREATE TABLE bwagreements (
 	serialnum TEXT NOT NULL,
 	storage_node_id BLOB NOT NULL,
@@ -497,9 +755,20 @@ CREATE TABLE certRecords (
 	update_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE egress_attributions (
+	serial_number TEXT NOT NULL,
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	object_path TEXT NOT NULL,
+	sample_rate REAL NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
 CREATE TABLE injuredsegments (
 	id INTEGER NOT NULL,
 	info BLOB NOT NULL,
+	leased_until TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE irreparabledbs (
@@ -510,25 +779,66 @@ CREATE TABLE irreparabledbs (
 	repair_attempt_count INTEGER NOT NULL,
 	PRIMARY KEY ( segmentpath )
 );
+CREATE TABLE node_events (
+	id INTEGER NOT NULL,
+	node_id BLOB NOT NULL,
+	kind INTEGER NOT NULL,
+	reason TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_tags (
+	node_id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	value TEXT NOT NULL,
+	signer_id BLOB NOT NULL,
+	tag BLOB NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
 CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	audit_success_count INTEGER NOT NULL,
 	total_audit_count INTEGER NOT NULL,
 	audit_success_ratio REAL NOT NULL,
+	audit_reputation_alpha REAL NOT NULL,
+	audit_reputation_beta REAL NOT NULL,
 	uptime_success_count INTEGER NOT NULL,
 	total_uptime_count INTEGER NOT NULL,
 	uptime_ratio REAL NOT NULL,
+	uptime_reputation_alpha REAL NOT NULL,
+	uptime_reputation_beta REAL NOT NULL,
+	last_contact_success TIMESTAMP NOT NULL,
+	last_contact_failure TIMESTAMP NOT NULL,
+	vetted_at TIMESTAMP NOT NULL,
+	disqualified_at TIMESTAMP NOT NULL,
+	disqualification_reason TEXT NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	updated_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE object_egress_rollups (
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	object_path TEXT NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	egress INTEGER NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, object_path, interval_start )
+);
 CREATE TABLE overlay_cache_nodes (
 	node_id BLOB NOT NULL,
 	node_type INTEGER NOT NULL,
 	address TEXT NOT NULL,
 	protocol INTEGER NOT NULL,
+	country_code TEXT NOT NULL,
 	operator_email TEXT NOT NULL,
 	operator_wallet TEXT NOT NULL,
+	operator_wallet_features TEXT NOT NULL,
+	operator_email_validated_at TIMESTAMP NOT NULL,
+	operator_wallet_validated_at TIMESTAMP NOT NULL,
+	ingress_rate INTEGER NOT NULL,
+	egress_rate INTEGER NOT NULL,
+	upload_success_ratio REAL NOT NULL,
+	download_success_ratio REAL NOT NULL,
 	free_bandwidth INTEGER NOT NULL,
 	free_disk INTEGER NOT NULL,
 	latency_90 INTEGER NOT NULL,
@@ -538,9 +848,78 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count INTEGER NOT NULL,
 	uptime_count INTEGER NOT NULL,
 	uptime_success_count INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
+CREATE TABLE pending_audits (
+	node_id BLOB NOT NULL,
+	path TEXT NOT NULL,
+	piece_id TEXT NOT NULL,
+	piece_num INTEGER NOT NULL,
+	stripe_index INTEGER NOT NULL,
+	share_size INTEGER NOT NULL,
+	expected_share_hash BLOB NOT NULL,
+	reverify_count INTEGER NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE prefix_quotas (
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	prefix TEXT NOT NULL,
+	max_bytes INTEGER NOT NULL,
+	max_objects INTEGER NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, prefix )
+);
+CREATE TABLE pricing_tiers (
+	name TEXT NOT NULL,
+	storage INTEGER NOT NULL,
+	egress INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE project_alerts (
+	id BLOB NOT NULL,
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	prefix TEXT NOT NULL,
+	threshold REAL NOT NULL,
+	webhook_url TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	triggered_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_deletions (
+	project_id BLOB NOT NULL,
+	deleted_segments INTEGER NOT NULL,
+	deleted_bytes INTEGER NOT NULL,
+	requested_at TIMESTAMP NOT NULL,
+	finished_at TIMESTAMP,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_exports (
+	project_id BLOB NOT NULL,
+	requested_by BLOB NOT NULL,
+	exported_objects INTEGER NOT NULL,
+	exported_bytes INTEGER NOT NULL,
+	last_path TEXT NOT NULL,
+	manifest BLOB NOT NULL,
+	requested_at TIMESTAMP NOT NULL,
+	finished_at TIMESTAMP,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_salts (
+	project_id BLOB NOT NULL,
+	salt BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_tiers (
+	project_id BLOB NOT NULL,
+	tier TEXT NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE projects (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
@@ -548,6 +927,19 @@ CREATE TABLE projects (
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE repair_slo_days (
+	interval_start TIMESTAMP NOT NULL,
+	injured INTEGER NOT NULL,
+	irreparable INTEGER NOT NULL,
+	repaired INTEGER NOT NULL,
+	failed INTEGER NOT NULL,
+	PRIMARY KEY ( interval_start )
+);
+CREATE TABLE sample_snapshots (
+	created_at TIMESTAMP NOT NULL,
+	data BLOB NOT NULL,
+	PRIMARY KEY ( created_at )
+);
 CREATE TABLE users (
 	id BLOB NOT NULL,
 	first_name TEXT NOT NULL,
@@ -636,6 +1028,154 @@ nextval:
 	fmt.Fprint(f, "]")
 }
 
+type AbuseBlock struct {
+	Id        int64
+	Kind      int
+	Value     string
+	Reason    string
+	CreatedBy string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (AbuseBlock) _Table() string { return "abuse_blocks" }
+
+type AbuseBlock_Update_Fields struct {
+}
+
+type AbuseBlock_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func AbuseBlock_Id(v int64) AbuseBlock_Id_Field {
+	return AbuseBlock_Id_Field{_set: true, _value: v}
+}
+
+func (f AbuseBlock_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AbuseBlock_Id_Field) _Column() string { return "id" }
+
+type AbuseBlock_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AbuseBlock_Kind(v int) AbuseBlock_Kind_Field {
+	return AbuseBlock_Kind_Field{_set: true, _value: v}
+}
+
+func (f AbuseBlock_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AbuseBlock_Kind_Field) _Column() string { return "kind" }
+
+type AbuseBlock_Value_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AbuseBlock_Value(v string) AbuseBlock_Value_Field {
+	return AbuseBlock_Value_Field{_set: true, _value: v}
+}
+
+func (f AbuseBlock_Value_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AbuseBlock_Value_Field) _Column() string { return "value" }
+
+type AbuseBlock_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AbuseBlock_Reason(v string) AbuseBlock_Reason_Field {
+	return AbuseBlock_Reason_Field{_set: true, _value: v}
+}
+
+func (f AbuseBlock_Reason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AbuseBlock_Reason_Field) _Column() string { return "reason" }
+
+type AbuseBlock_CreatedBy_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AbuseBlock_CreatedBy(v string) AbuseBlock_CreatedBy_Field {
+	return AbuseBlock_CreatedBy_Field{_set: true, _value: v}
+}
+
+func (f AbuseBlock_CreatedBy_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AbuseBlock_CreatedBy_Field) _Column() string { return "created_by" }
+
+type AbuseBlock_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func AbuseBlock_CreatedAt(v time.Time) AbuseBlock_CreatedAt_Field {
+	return AbuseBlock_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f AbuseBlock_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AbuseBlock_CreatedAt_Field) _Column() string { return "created_at" }
+
+type AbuseBlock_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func AbuseBlock_ExpiresAt(v time.Time) AbuseBlock_ExpiresAt_Field {
+	return AbuseBlock_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f AbuseBlock_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (AbuseBlock_ExpiresAt_Field) _Column() string { return "expires_at" }
+
 type AccountingRaw struct {
 	Id              int64
 	NodeId          []byte
@@ -1001,649 +1541,2077 @@ func (f AccountingTimestamps_Value_Field) value() interface{} {
 
 func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
-type Bwagreement struct {
-	Serialnum     string
-	StorageNodeId []byte
-	UplinkId      []byte
-	Action        int64
-	Total         int64
-	CreatedAt     time.Time
-	ExpiresAt     time.Time
+type AddressMismatch struct {
+	NodeId     []byte
+	Address    string
+	AnsweredId []byte
+	DetectedAt time.Time
 }
 
-func (Bwagreement) _Table() string { return "bwagreements" }
+func (AddressMismatch) _Table() string { return "address_mismatches" }
 
-type Bwagreement_Update_Fields struct {
+type AddressMismatch_Update_Fields struct {
 }
 
-type Bwagreement_Serialnum_Field struct {
+type AddressMismatch_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Bwagreement_Serialnum(v string) Bwagreement_Serialnum_Field {
-	return Bwagreement_Serialnum_Field{_set: true, _value: v}
+func AddressMismatch_NodeId(v []byte) AddressMismatch_NodeId_Field {
+	return AddressMismatch_NodeId_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_Serialnum_Field) value() interface{} {
+func (f AddressMismatch_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_Serialnum_Field) _Column() string { return "serialnum" }
+func (AddressMismatch_NodeId_Field) _Column() string { return "node_id" }
 
-type Bwagreement_StorageNodeId_Field struct {
+type AddressMismatch_Address_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func Bwagreement_StorageNodeId(v []byte) Bwagreement_StorageNodeId_Field {
-	return Bwagreement_StorageNodeId_Field{_set: true, _value: v}
+func AddressMismatch_Address(v string) AddressMismatch_Address_Field {
+	return AddressMismatch_Address_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_StorageNodeId_Field) value() interface{} {
+func (f AddressMismatch_Address_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_StorageNodeId_Field) _Column() string { return "storage_node_id" }
+func (AddressMismatch_Address_Field) _Column() string { return "address" }
 
-type Bwagreement_UplinkId_Field struct {
+type AddressMismatch_AnsweredId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Bwagreement_UplinkId(v []byte) Bwagreement_UplinkId_Field {
-	return Bwagreement_UplinkId_Field{_set: true, _value: v}
+func AddressMismatch_AnsweredId(v []byte) AddressMismatch_AnsweredId_Field {
+	return AddressMismatch_AnsweredId_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_UplinkId_Field) value() interface{} {
+func (f AddressMismatch_AnsweredId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_UplinkId_Field) _Column() string { return "uplink_id" }
+func (AddressMismatch_AnsweredId_Field) _Column() string { return "answered_id" }
 
-type Bwagreement_Action_Field struct {
+type AddressMismatch_DetectedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Bwagreement_Action(v int64) Bwagreement_Action_Field {
-	return Bwagreement_Action_Field{_set: true, _value: v}
+func AddressMismatch_DetectedAt(v time.Time) AddressMismatch_DetectedAt_Field {
+	return AddressMismatch_DetectedAt_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_Action_Field) value() interface{} {
+func (f AddressMismatch_DetectedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_Action_Field) _Column() string { return "action" }
+func (AddressMismatch_DetectedAt_Field) _Column() string { return "detected_at" }
 
-type Bwagreement_Total_Field struct {
+type AuditLog struct {
+	Id         int64
+	Actor      string
+	Action     string
+	Target     string
+	RemoteAddr string
+	CreatedAt  time.Time
+}
+
+func (AuditLog) _Table() string { return "audit_logs" }
+
+type AuditLog_Update_Fields struct {
+}
+
+type AuditLog_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Bwagreement_Total(v int64) Bwagreement_Total_Field {
-	return Bwagreement_Total_Field{_set: true, _value: v}
+func AuditLog_Id(v int64) AuditLog_Id_Field {
+	return AuditLog_Id_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_Total_Field) value() interface{} {
+func (f AuditLog_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_Total_Field) _Column() string { return "total" }
+func (AuditLog_Id_Field) _Column() string { return "id" }
 
-type Bwagreement_CreatedAt_Field struct {
+type AuditLog_Actor_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Bwagreement_CreatedAt(v time.Time) Bwagreement_CreatedAt_Field {
-	return Bwagreement_CreatedAt_Field{_set: true, _value: v}
+func AuditLog_Actor(v string) AuditLog_Actor_Field {
+	return AuditLog_Actor_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_CreatedAt_Field) value() interface{} {
+func (f AuditLog_Actor_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_CreatedAt_Field) _Column() string { return "created_at" }
+func (AuditLog_Actor_Field) _Column() string { return "actor" }
 
-type Bwagreement_ExpiresAt_Field struct {
+type AuditLog_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func Bwagreement_ExpiresAt(v time.Time) Bwagreement_ExpiresAt_Field {
-	return Bwagreement_ExpiresAt_Field{_set: true, _value: v}
+func AuditLog_Action(v string) AuditLog_Action_Field {
+	return AuditLog_Action_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_ExpiresAt_Field) value() interface{} {
+func (f AuditLog_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_ExpiresAt_Field) _Column() string { return "expires_at" }
-
-type CertRecord struct {
-	Publickey []byte
-	Id        []byte
-	UpdateAt  time.Time
-}
-
-func (CertRecord) _Table() string { return "certRecords" }
-
-type CertRecord_Update_Fields struct {
-}
+func (AuditLog_Action_Field) _Column() string { return "action" }
 
-type CertRecord_Publickey_Field struct {
+type AuditLog_Target_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func CertRecord_Publickey(v []byte) CertRecord_Publickey_Field {
-	return CertRecord_Publickey_Field{_set: true, _value: v}
+func AuditLog_Target(v string) AuditLog_Target_Field {
+	return AuditLog_Target_Field{_set: true, _value: v}
 }
 
-func (f CertRecord_Publickey_Field) value() interface{} {
+func (f AuditLog_Target_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CertRecord_Publickey_Field) _Column() string { return "publickey" }
+func (AuditLog_Target_Field) _Column() string { return "target" }
 
-type CertRecord_Id_Field struct {
+type AuditLog_RemoteAddr_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func CertRecord_Id(v []byte) CertRecord_Id_Field {
-	return CertRecord_Id_Field{_set: true, _value: v}
+func AuditLog_RemoteAddr(v string) AuditLog_RemoteAddr_Field {
+	return AuditLog_RemoteAddr_Field{_set: true, _value: v}
 }
 
-func (f CertRecord_Id_Field) value() interface{} {
+func (f AuditLog_RemoteAddr_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CertRecord_Id_Field) _Column() string { return "id" }
+func (AuditLog_RemoteAddr_Field) _Column() string { return "remote_addr" }
 
-type CertRecord_UpdateAt_Field struct {
+type AuditLog_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func CertRecord_UpdateAt(v time.Time) CertRecord_UpdateAt_Field {
-	return CertRecord_UpdateAt_Field{_set: true, _value: v}
+func AuditLog_CreatedAt(v time.Time) AuditLog_CreatedAt_Field {
+	return AuditLog_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f CertRecord_UpdateAt_Field) value() interface{} {
+func (f AuditLog_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CertRecord_UpdateAt_Field) _Column() string { return "update_at" }
+func (AuditLog_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Injuredsegment struct {
-	Id   int64
-	Info []byte
+type BucketLock struct {
+	ProjectId       []byte
+	BucketName      string
+	RetentionPeriod int64
+	LegalHold       bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
-func (Injuredsegment) _Table() string { return "injuredsegments" }
+func (BucketLock) _Table() string { return "bucket_locks" }
 
-type Injuredsegment_Update_Fields struct {
+type BucketLock_Update_Fields struct {
+	RetentionPeriod BucketLock_RetentionPeriod_Field
+	LegalHold       BucketLock_LegalHold_Field
 }
 
-type Injuredsegment_Id_Field struct {
+type BucketLock_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Injuredsegment_Id(v int64) Injuredsegment_Id_Field {
-	return Injuredsegment_Id_Field{_set: true, _value: v}
+func BucketLock_ProjectId(v []byte) BucketLock_ProjectId_Field {
+	return BucketLock_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Id_Field) value() interface{} {
+func (f BucketLock_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Id_Field) _Column() string { return "id" }
+func (BucketLock_ProjectId_Field) _Column() string { return "project_id" }
 
-type Injuredsegment_Info_Field struct {
+type BucketLock_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func Injuredsegment_Info(v []byte) Injuredsegment_Info_Field {
-	return Injuredsegment_Info_Field{_set: true, _value: v}
+func BucketLock_BucketName(v string) BucketLock_BucketName_Field {
+	return BucketLock_BucketName_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Info_Field) value() interface{} {
+func (f BucketLock_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Info_Field) _Column() string { return "info" }
-
-type Irreparabledb struct {
-	Segmentpath        []byte
-	Segmentdetail      []byte
-	PiecesLostCount    int64
-	SegDamagedUnixSec  int64
-	RepairAttemptCount int64
-}
-
-func (Irreparabledb) _Table() string { return "irreparabledbs" }
-
-type Irreparabledb_Update_Fields struct {
-	Segmentdetail      Irreparabledb_Segmentdetail_Field
-	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
-	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
-	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
-}
+func (BucketLock_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Irreparabledb_Segmentpath_Field struct {
+type BucketLock_RetentionPeriod_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
-	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+func BucketLock_RetentionPeriod(v int64) BucketLock_RetentionPeriod_Field {
+	return BucketLock_RetentionPeriod_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+func (f BucketLock_RetentionPeriod_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+func (BucketLock_RetentionPeriod_Field) _Column() string { return "retention_period" }
 
-type Irreparabledb_Segmentdetail_Field struct {
+type BucketLock_LegalHold_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
-	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+func BucketLock_LegalHold(v bool) BucketLock_LegalHold_Field {
+	return BucketLock_LegalHold_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+func (f BucketLock_LegalHold_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+func (BucketLock_LegalHold_Field) _Column() string { return "legal_hold" }
 
-type Irreparabledb_PiecesLostCount_Field struct {
+type BucketLock_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
-	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+func BucketLock_CreatedAt(v time.Time) BucketLock_CreatedAt_Field {
+	return BucketLock_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+func (f BucketLock_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+func (BucketLock_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Irreparabledb_SegDamagedUnixSec_Field struct {
+type BucketLock_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
-	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+func BucketLock_UpdatedAt(v time.Time) BucketLock_UpdatedAt_Field {
+	return BucketLock_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+func (f BucketLock_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+func (BucketLock_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Irreparabledb_RepairAttemptCount_Field struct {
+type BwagreementAnomaly struct {
+	Serialnum     string
+	StorageNodeId []byte
+	Action        int64
+	Total         int64
+	MaxSize       int64
+	CreatedAt     time.Time
+}
+
+func (BwagreementAnomaly) _Table() string { return "bwagreement_anomalies" }
+
+type BwagreementAnomaly_Update_Fields struct {
+}
+
+type BwagreementAnomaly_Serialnum_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
-	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+func BwagreementAnomaly_Serialnum(v string) BwagreementAnomaly_Serialnum_Field {
+	return BwagreementAnomaly_Serialnum_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+func (f BwagreementAnomaly_Serialnum_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
-
-type Node struct {
-	Id                 []byte
-	AuditSuccessCount  int64
-	TotalAuditCount    int64
-	AuditSuccessRatio  float64
-	UptimeSuccessCount int64
-	TotalUptimeCount   int64
-	UptimeRatio        float64
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
-}
-
-func (Node) _Table() string { return "nodes" }
-
-type Node_Update_Fields struct {
-	AuditSuccessCount  Node_AuditSuccessCount_Field
-	TotalAuditCount    Node_TotalAuditCount_Field
-	AuditSuccessRatio  Node_AuditSuccessRatio_Field
-	UptimeSuccessCount Node_UptimeSuccessCount_Field
-	TotalUptimeCount   Node_TotalUptimeCount_Field
-	UptimeRatio        Node_UptimeRatio_Field
-}
+func (BwagreementAnomaly_Serialnum_Field) _Column() string { return "serialnum" }
 
-type Node_Id_Field struct {
+type BwagreementAnomaly_StorageNodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func BwagreementAnomaly_StorageNodeId(v []byte) BwagreementAnomaly_StorageNodeId_Field {
+	return BwagreementAnomaly_StorageNodeId_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f BwagreementAnomaly_StorageNodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (BwagreementAnomaly_StorageNodeId_Field) _Column() string { return "storage_node_id" }
 
-type Node_AuditSuccessCount_Field struct {
+type BwagreementAnomaly_Action_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
-	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+func BwagreementAnomaly_Action(v int64) BwagreementAnomaly_Action_Field {
+	return BwagreementAnomaly_Action_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditSuccessCount_Field) value() interface{} {
+func (f BwagreementAnomaly_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (BwagreementAnomaly_Action_Field) _Column() string { return "action" }
 
-type Node_TotalAuditCount_Field struct {
+type BwagreementAnomaly_Total_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
-	return Node_TotalAuditCount_Field{_set: true, _value: v}
+func BwagreementAnomaly_Total(v int64) BwagreementAnomaly_Total_Field {
+	return BwagreementAnomaly_Total_Field{_set: true, _value: v}
 }
 
-func (f Node_TotalAuditCount_Field) value() interface{} {
+func (f BwagreementAnomaly_Total_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+func (BwagreementAnomaly_Total_Field) _Column() string { return "total" }
 
-type Node_AuditSuccessRatio_Field struct {
+type BwagreementAnomaly_MaxSize_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value int64
 }
 
-func Node_AuditSuccessRatio(v float64) Node_AuditSuccessRatio_Field {
-	return Node_AuditSuccessRatio_Field{_set: true, _value: v}
+func BwagreementAnomaly_MaxSize(v int64) BwagreementAnomaly_MaxSize_Field {
+	return BwagreementAnomaly_MaxSize_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditSuccessRatio_Field) value() interface{} {
+func (f BwagreementAnomaly_MaxSize_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+func (BwagreementAnomaly_MaxSize_Field) _Column() string { return "max_size" }
 
-type Node_UptimeSuccessCount_Field struct {
+type BwagreementAnomaly_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_UptimeSuccessCount(v int64) Node_UptimeSuccessCount_Field {
-	return Node_UptimeSuccessCount_Field{_set: true, _value: v}
+func BwagreementAnomaly_CreatedAt(v time.Time) BwagreementAnomaly_CreatedAt_Field {
+	return BwagreementAnomaly_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_UptimeSuccessCount_Field) value() interface{} {
+func (f BwagreementAnomaly_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+func (BwagreementAnomaly_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_TotalUptimeCount_Field struct {
+type BwagreementArchive struct {
+	Serialnum     string
+	StorageNodeId []byte
+	UplinkId      []byte
+	Action        int64
+	Total         int64
+	CreatedAt     time.Time
+	ExpiresAt     time.Time
+	ArchivedAt    time.Time
+}
+
+func (BwagreementArchive) _Table() string { return "bwagreement_archives" }
+
+type BwagreementArchive_Update_Fields struct {
+}
+
+type BwagreementArchive_Serialnum_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_TotalUptimeCount(v int64) Node_TotalUptimeCount_Field {
-	return Node_TotalUptimeCount_Field{_set: true, _value: v}
+func BwagreementArchive_Serialnum(v string) BwagreementArchive_Serialnum_Field {
+	return BwagreementArchive_Serialnum_Field{_set: true, _value: v}
 }
 
-func (f Node_TotalUptimeCount_Field) value() interface{} {
+func (f BwagreementArchive_Serialnum_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalUptimeCount_Field) _Column() string { return "total_uptime_count" }
+func (BwagreementArchive_Serialnum_Field) _Column() string { return "serialnum" }
 
-type Node_UptimeRatio_Field struct {
+type BwagreementArchive_StorageNodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func Node_UptimeRatio(v float64) Node_UptimeRatio_Field {
-	return Node_UptimeRatio_Field{_set: true, _value: v}
+func BwagreementArchive_StorageNodeId(v []byte) BwagreementArchive_StorageNodeId_Field {
+	return BwagreementArchive_StorageNodeId_Field{_set: true, _value: v}
 }
 
-func (f Node_UptimeRatio_Field) value() interface{} {
+func (f BwagreementArchive_StorageNodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeRatio_Field) _Column() string { return "uptime_ratio" }
+func (BwagreementArchive_StorageNodeId_Field) _Column() string { return "storage_node_id" }
 
-type Node_CreatedAt_Field struct {
+type BwagreementArchive_UplinkId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
-	return Node_CreatedAt_Field{_set: true, _value: v}
+func BwagreementArchive_UplinkId(v []byte) BwagreementArchive_UplinkId_Field {
+	return BwagreementArchive_UplinkId_Field{_set: true, _value: v}
 }
 
-func (f Node_CreatedAt_Field) value() interface{} {
+func (f BwagreementArchive_UplinkId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+func (BwagreementArchive_UplinkId_Field) _Column() string { return "uplink_id" }
 
-type Node_UpdatedAt_Field struct {
+type BwagreementArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
-	return Node_UpdatedAt_Field{_set: true, _value: v}
+func BwagreementArchive_Action(v int64) BwagreementArchive_Action_Field {
+	return BwagreementArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f Node_UpdatedAt_Field) value() interface{} {
+func (f BwagreementArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (BwagreementArchive_Action_Field) _Column() string { return "action" }
 
-type OverlayCacheNode struct {
-	NodeId             []byte
-	NodeType           int
-	Address            string
-	Protocol           int
-	OperatorEmail      string
-	OperatorWallet     string
-	FreeBandwidth      int64
-	FreeDisk           int64
-	Latency90          int64
-	AuditSuccessRatio  float64
-	AuditUptimeRatio   float64
-	AuditCount         int64
-	AuditSuccessCount  int64
-	UptimeCount        int64
-	UptimeSuccessCount int64
+type BwagreementArchive_Total_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (OverlayCacheNode) _Table() string { return "overlay_cache_nodes" }
+func BwagreementArchive_Total(v int64) BwagreementArchive_Total_Field {
+	return BwagreementArchive_Total_Field{_set: true, _value: v}
+}
 
-type OverlayCacheNode_Update_Fields struct {
-	Address            OverlayCacheNode_Address_Field
-	Protocol           OverlayCacheNode_Protocol_Field
-	OperatorEmail      OverlayCacheNode_OperatorEmail_Field
-	OperatorWallet     OverlayCacheNode_OperatorWallet_Field
-	FreeBandwidth      OverlayCacheNode_FreeBandwidth_Field
-	FreeDisk           OverlayCacheNode_FreeDisk_Field
-	Latency90          OverlayCacheNode_Latency90_Field
-	AuditSuccessRatio  OverlayCacheNode_AuditSuccessRatio_Field
-	AuditUptimeRatio   OverlayCacheNode_AuditUptimeRatio_Field
-	AuditCount         OverlayCacheNode_AuditCount_Field
-	AuditSuccessCount  OverlayCacheNode_AuditSuccessCount_Field
-	UptimeCount        OverlayCacheNode_UptimeCount_Field
-	UptimeSuccessCount OverlayCacheNode_UptimeSuccessCount_Field
+func (f BwagreementArchive_Total_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type OverlayCacheNode_NodeId_Field struct {
+func (BwagreementArchive_Total_Field) _Column() string { return "total" }
+
+type BwagreementArchive_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func OverlayCacheNode_NodeId(v []byte) OverlayCacheNode_NodeId_Field {
-	return OverlayCacheNode_NodeId_Field{_set: true, _value: v}
+func BwagreementArchive_CreatedAt(v time.Time) BwagreementArchive_CreatedAt_Field {
+	return BwagreementArchive_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_NodeId_Field) value() interface{} {
+func (f BwagreementArchive_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_NodeId_Field) _Column() string { return "node_id" }
+func (BwagreementArchive_CreatedAt_Field) _Column() string { return "created_at" }
 
-type OverlayCacheNode_NodeType_Field struct {
+type BwagreementArchive_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func OverlayCacheNode_NodeType(v int) OverlayCacheNode_NodeType_Field {
-	return OverlayCacheNode_NodeType_Field{_set: true, _value: v}
+func BwagreementArchive_ExpiresAt(v time.Time) BwagreementArchive_ExpiresAt_Field {
+	return BwagreementArchive_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_NodeType_Field) value() interface{} {
+func (f BwagreementArchive_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_NodeType_Field) _Column() string { return "node_type" }
+func (BwagreementArchive_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type BwagreementArchive_ArchivedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BwagreementArchive_ArchivedAt(v time.Time) BwagreementArchive_ArchivedAt_Field {
+	return BwagreementArchive_ArchivedAt_Field{_set: true, _value: v}
+}
+
+func (f BwagreementArchive_ArchivedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BwagreementArchive_ArchivedAt_Field) _Column() string { return "archived_at" }
+
+type BwagreementRollup struct {
+	StorageNodeId []byte
+	IntervalStart time.Time
+	Action        int64
+	Total         int64
+}
+
+func (BwagreementRollup) _Table() string { return "bwagreement_rollups" }
+
+type BwagreementRollup_Update_Fields struct {
+}
+
+type BwagreementRollup_StorageNodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BwagreementRollup_StorageNodeId(v []byte) BwagreementRollup_StorageNodeId_Field {
+	return BwagreementRollup_StorageNodeId_Field{_set: true, _value: v}
+}
+
+func (f BwagreementRollup_StorageNodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BwagreementRollup_StorageNodeId_Field) _Column() string { return "storage_node_id" }
+
+type BwagreementRollup_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BwagreementRollup_IntervalStart(v time.Time) BwagreementRollup_IntervalStart_Field {
+	return BwagreementRollup_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f BwagreementRollup_IntervalStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BwagreementRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type BwagreementRollup_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BwagreementRollup_Action(v int64) BwagreementRollup_Action_Field {
+	return BwagreementRollup_Action_Field{_set: true, _value: v}
+}
+
+func (f BwagreementRollup_Action_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BwagreementRollup_Action_Field) _Column() string { return "action" }
+
+type BwagreementRollup_Total_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BwagreementRollup_Total(v int64) BwagreementRollup_Total_Field {
+	return BwagreementRollup_Total_Field{_set: true, _value: v}
+}
+
+func (f BwagreementRollup_Total_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BwagreementRollup_Total_Field) _Column() string { return "total" }
+
+type Bwagreement struct {
+	Serialnum     string
+	StorageNodeId []byte
+	UplinkId      []byte
+	Action        int64
+	Total         int64
+	CreatedAt     time.Time
+	ExpiresAt     time.Time
+}
+
+func (Bwagreement) _Table() string { return "bwagreements" }
+
+type Bwagreement_Update_Fields struct {
+}
+
+type Bwagreement_Serialnum_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Bwagreement_Serialnum(v string) Bwagreement_Serialnum_Field {
+	return Bwagreement_Serialnum_Field{_set: true, _value: v}
+}
+
+func (f Bwagreement_Serialnum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Bwagreement_Serialnum_Field) _Column() string { return "serialnum" }
+
+type Bwagreement_StorageNodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Bwagreement_StorageNodeId(v []byte) Bwagreement_StorageNodeId_Field {
+	return Bwagreement_StorageNodeId_Field{_set: true, _value: v}
+}
+
+func (f Bwagreement_StorageNodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Bwagreement_StorageNodeId_Field) _Column() string { return "storage_node_id" }
+
+type Bwagreement_UplinkId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Bwagreement_UplinkId(v []byte) Bwagreement_UplinkId_Field {
+	return Bwagreement_UplinkId_Field{_set: true, _value: v}
+}
+
+func (f Bwagreement_UplinkId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Bwagreement_UplinkId_Field) _Column() string { return "uplink_id" }
+
+type Bwagreement_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Bwagreement_Action(v int64) Bwagreement_Action_Field {
+	return Bwagreement_Action_Field{_set: true, _value: v}
+}
+
+func (f Bwagreement_Action_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Bwagreement_Action_Field) _Column() string { return "action" }
+
+type Bwagreement_Total_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Bwagreement_Total(v int64) Bwagreement_Total_Field {
+	return Bwagreement_Total_Field{_set: true, _value: v}
+}
+
+func (f Bwagreement_Total_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Bwagreement_Total_Field) _Column() string { return "total" }
+
+type Bwagreement_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Bwagreement_CreatedAt(v time.Time) Bwagreement_CreatedAt_Field {
+	return Bwagreement_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Bwagreement_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Bwagreement_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Bwagreement_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Bwagreement_ExpiresAt(v time.Time) Bwagreement_ExpiresAt_Field {
+	return Bwagreement_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f Bwagreement_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Bwagreement_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type CertRecord struct {
+	Publickey []byte
+	Id        []byte
+	UpdateAt  time.Time
+}
+
+func (CertRecord) _Table() string { return "certRecords" }
+
+type CertRecord_Update_Fields struct {
+}
+
+type CertRecord_Publickey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CertRecord_Publickey(v []byte) CertRecord_Publickey_Field {
+	return CertRecord_Publickey_Field{_set: true, _value: v}
+}
+
+func (f CertRecord_Publickey_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CertRecord_Publickey_Field) _Column() string { return "publickey" }
+
+type CertRecord_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CertRecord_Id(v []byte) CertRecord_Id_Field {
+	return CertRecord_Id_Field{_set: true, _value: v}
+}
+
+func (f CertRecord_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CertRecord_Id_Field) _Column() string { return "id" }
+
+type CertRecord_UpdateAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func CertRecord_UpdateAt(v time.Time) CertRecord_UpdateAt_Field {
+	return CertRecord_UpdateAt_Field{_set: true, _value: v}
+}
+
+func (f CertRecord_UpdateAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CertRecord_UpdateAt_Field) _Column() string { return "update_at" }
+
+type EgressAttribution struct {
+	SerialNumber string
+	ProjectId    []byte
+	BucketName   string
+	ObjectPath   string
+	SampleRate   float64
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+func (EgressAttribution) _Table() string { return "egress_attributions" }
+
+type EgressAttribution_Update_Fields struct {
+}
+
+type EgressAttribution_SerialNumber_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func EgressAttribution_SerialNumber(v string) EgressAttribution_SerialNumber_Field {
+	return EgressAttribution_SerialNumber_Field{_set: true, _value: v}
+}
+
+func (f EgressAttribution_SerialNumber_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EgressAttribution_SerialNumber_Field) _Column() string { return "serial_number" }
+
+type EgressAttribution_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func EgressAttribution_ProjectId(v []byte) EgressAttribution_ProjectId_Field {
+	return EgressAttribution_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f EgressAttribution_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EgressAttribution_ProjectId_Field) _Column() string { return "project_id" }
+
+type EgressAttribution_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func EgressAttribution_BucketName(v string) EgressAttribution_BucketName_Field {
+	return EgressAttribution_BucketName_Field{_set: true, _value: v}
+}
+
+func (f EgressAttribution_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EgressAttribution_BucketName_Field) _Column() string { return "bucket_name" }
+
+type EgressAttribution_ObjectPath_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func EgressAttribution_ObjectPath(v string) EgressAttribution_ObjectPath_Field {
+	return EgressAttribution_ObjectPath_Field{_set: true, _value: v}
+}
+
+func (f EgressAttribution_ObjectPath_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EgressAttribution_ObjectPath_Field) _Column() string { return "object_path" }
+
+type EgressAttribution_SampleRate_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func EgressAttribution_SampleRate(v float64) EgressAttribution_SampleRate_Field {
+	return EgressAttribution_SampleRate_Field{_set: true, _value: v}
+}
+
+func (f EgressAttribution_SampleRate_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EgressAttribution_SampleRate_Field) _Column() string { return "sample_rate" }
+
+type EgressAttribution_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func EgressAttribution_ExpiresAt(v time.Time) EgressAttribution_ExpiresAt_Field {
+	return EgressAttribution_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f EgressAttribution_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EgressAttribution_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type EgressAttribution_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func EgressAttribution_CreatedAt(v time.Time) EgressAttribution_CreatedAt_Field {
+	return EgressAttribution_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f EgressAttribution_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (EgressAttribution_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Injuredsegment struct {
+	Id          int64
+	Info        []byte
+	LeasedUntil time.Time
+}
+
+func (Injuredsegment) _Table() string { return "injuredsegments" }
+
+type Injuredsegment_Update_Fields struct {
+}
+
+type Injuredsegment_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Injuredsegment_Id(v int64) Injuredsegment_Id_Field {
+	return Injuredsegment_Id_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Id_Field) _Column() string { return "id" }
+
+type Injuredsegment_Info_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Injuredsegment_Info(v []byte) Injuredsegment_Info_Field {
+	return Injuredsegment_Info_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Info_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Info_Field) _Column() string { return "info" }
+
+type Injuredsegment_LeasedUntil_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Injuredsegment_LeasedUntil(v time.Time) Injuredsegment_LeasedUntil_Field {
+	return Injuredsegment_LeasedUntil_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_LeasedUntil_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_LeasedUntil_Field) _Column() string { return "leased_until" }
+
+type Irreparabledb struct {
+	Segmentpath        []byte
+	Segmentdetail      []byte
+	PiecesLostCount    int64
+	SegDamagedUnixSec  int64
+	RepairAttemptCount int64
+}
+
+func (Irreparabledb) _Table() string { return "irreparabledbs" }
+
+type Irreparabledb_Update_Fields struct {
+	Segmentdetail      Irreparabledb_Segmentdetail_Field
+	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
+	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
+	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+}
+
+type Irreparabledb_Segmentpath_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
+	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+
+type Irreparabledb_Segmentdetail_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
+	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+
+type Irreparabledb_PiecesLostCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
+	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+
+type Irreparabledb_SegDamagedUnixSec_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
+	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+
+type Irreparabledb_RepairAttemptCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
+	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
+
+type NodeEvent struct {
+	Id        int64
+	NodeId    []byte
+	Kind      int
+	Reason    string
+	CreatedAt time.Time
+}
+
+func (NodeEvent) _Table() string { return "node_events" }
+
+type NodeEvent_Update_Fields struct {
+}
+
+type NodeEvent_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeEvent_Id(v int64) NodeEvent_Id_Field {
+	return NodeEvent_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Id_Field) _Column() string { return "id" }
+
+type NodeEvent_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeEvent_NodeId(v []byte) NodeEvent_NodeId_Field {
+	return NodeEvent_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeEvent_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeEvent_Kind(v int) NodeEvent_Kind_Field {
+	return NodeEvent_Kind_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Kind_Field) _Column() string { return "kind" }
+
+type NodeEvent_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeEvent_Reason(v string) NodeEvent_Reason_Field {
+	return NodeEvent_Reason_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Reason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Reason_Field) _Column() string { return "reason" }
+
+type NodeEvent_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeEvent_CreatedAt(v time.Time) NodeEvent_CreatedAt_Field {
+	return NodeEvent_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_CreatedAt_Field) _Column() string { return "created_at" }
+
+type NodeTag struct {
+	NodeId   []byte
+	Name     string
+	Value    string
+	SignerId []byte
+	Tag      []byte
+}
+
+func (NodeTag) _Table() string { return "node_tags" }
+
+type NodeTag_Update_Fields struct {
+}
+
+type NodeTag_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeTag_NodeId(v []byte) NodeTag_NodeId_Field {
+	return NodeTag_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeTag_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeTag_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeTag_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeTag_Name(v string) NodeTag_Name_Field {
+	return NodeTag_Name_Field{_set: true, _value: v}
+}
+
+func (f NodeTag_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeTag_Name_Field) _Column() string { return "name" }
+
+type NodeTag_Value_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeTag_Value(v string) NodeTag_Value_Field {
+	return NodeTag_Value_Field{_set: true, _value: v}
+}
+
+func (f NodeTag_Value_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeTag_Value_Field) _Column() string { return "value" }
+
+type NodeTag_SignerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeTag_SignerId(v []byte) NodeTag_SignerId_Field {
+	return NodeTag_SignerId_Field{_set: true, _value: v}
+}
+
+func (f NodeTag_SignerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeTag_SignerId_Field) _Column() string { return "signer_id" }
+
+type NodeTag_Tag_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeTag_Tag(v []byte) NodeTag_Tag_Field {
+	return NodeTag_Tag_Field{_set: true, _value: v}
+}
+
+func (f NodeTag_Tag_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeTag_Tag_Field) _Column() string { return "tag" }
+
+type Node struct {
+	Id                     []byte
+	AuditSuccessCount      int64
+	TotalAuditCount        int64
+	AuditSuccessRatio      float64
+	AuditReputationAlpha   float64
+	AuditReputationBeta    float64
+	UptimeSuccessCount     int64
+	TotalUptimeCount       int64
+	UptimeRatio            float64
+	UptimeReputationAlpha  float64
+	UptimeReputationBeta   float64
+	LastContactSuccess     time.Time
+	LastContactFailure     time.Time
+	VettedAt               time.Time
+	DisqualifiedAt         time.Time
+	DisqualificationReason string
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+func (Node) _Table() string { return "nodes" }
+
+type Node_Update_Fields struct {
+	AuditSuccessCount      Node_AuditSuccessCount_Field
+	TotalAuditCount        Node_TotalAuditCount_Field
+	AuditSuccessRatio      Node_AuditSuccessRatio_Field
+	AuditReputationAlpha   Node_AuditReputationAlpha_Field
+	AuditReputationBeta    Node_AuditReputationBeta_Field
+	UptimeSuccessCount     Node_UptimeSuccessCount_Field
+	TotalUptimeCount       Node_TotalUptimeCount_Field
+	UptimeRatio            Node_UptimeRatio_Field
+	UptimeReputationAlpha  Node_UptimeReputationAlpha_Field
+	UptimeReputationBeta   Node_UptimeReputationBeta_Field
+	LastContactSuccess     Node_LastContactSuccess_Field
+	LastContactFailure     Node_LastContactFailure_Field
+	VettedAt               Node_VettedAt_Field
+	DisqualifiedAt         Node_DisqualifiedAt_Field
+	DisqualificationReason Node_DisqualificationReason_Field
+}
+
+type Node_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
+}
+
+func (f Node_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Id_Field) _Column() string { return "id" }
+
+type Node_AuditSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
+	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+
+type Node_TotalAuditCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
+	return Node_TotalAuditCount_Field{_set: true, _value: v}
+}
+
+func (f Node_TotalAuditCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+
+type Node_AuditSuccessRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditSuccessRatio(v float64) Node_AuditSuccessRatio_Field {
+	return Node_AuditSuccessRatio_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditSuccessRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+
+type Node_AuditReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditReputationAlpha(v float64) Node_AuditReputationAlpha_Field {
+	return Node_AuditReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+
+type Node_AuditReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditReputationBeta(v float64) Node_AuditReputationBeta_Field {
+	return Node_AuditReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+
+type Node_UptimeSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_UptimeSuccessCount(v int64) Node_UptimeSuccessCount_Field {
+	return Node_UptimeSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+
+type Node_TotalUptimeCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_TotalUptimeCount(v int64) Node_TotalUptimeCount_Field {
+	return Node_TotalUptimeCount_Field{_set: true, _value: v}
+}
+
+func (f Node_TotalUptimeCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_TotalUptimeCount_Field) _Column() string { return "total_uptime_count" }
+
+type Node_UptimeRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UptimeRatio(v float64) Node_UptimeRatio_Field {
+	return Node_UptimeRatio_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeRatio_Field) _Column() string { return "uptime_ratio" }
+
+type Node_UptimeReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UptimeReputationAlpha(v float64) Node_UptimeReputationAlpha_Field {
+	return Node_UptimeReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeReputationAlpha_Field) _Column() string { return "uptime_reputation_alpha" }
+
+type Node_UptimeReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UptimeReputationBeta(v float64) Node_UptimeReputationBeta_Field {
+	return Node_UptimeReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeReputationBeta_Field) _Column() string { return "uptime_reputation_beta" }
+
+type Node_LastContactSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
+	return Node_LastContactSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+
+type Node_LastContactFailure_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
+	return Node_LastContactFailure_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactFailure_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+
+type Node_VettedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_VettedAt(v time.Time) Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_VettedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
+
+type Node_DisqualifiedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_DisqualifiedAt(v time.Time) Node_DisqualifiedAt_Field {
+	return Node_DisqualifiedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_DisqualifiedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_DisqualifiedAt_Field) _Column() string { return "disqualified_at" }
+
+type Node_DisqualificationReason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_DisqualificationReason(v string) Node_DisqualificationReason_Field {
+	return Node_DisqualificationReason_Field{_set: true, _value: v}
+}
+
+func (f Node_DisqualificationReason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+
+type Node_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
+	return Node_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Node_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
+	return Node_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type ObjectEgressRollup struct {
+	ProjectId     []byte
+	BucketName    string
+	ObjectPath    string
+	IntervalStart time.Time
+	Egress        int64
+}
+
+func (ObjectEgressRollup) _Table() string { return "object_egress_rollups" }
+
+type ObjectEgressRollup_Update_Fields struct {
+}
+
+type ObjectEgressRollup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ObjectEgressRollup_ProjectId(v []byte) ObjectEgressRollup_ProjectId_Field {
+	return ObjectEgressRollup_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ObjectEgressRollup_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ObjectEgressRollup_ProjectId_Field) _Column() string { return "project_id" }
+
+type ObjectEgressRollup_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ObjectEgressRollup_BucketName(v string) ObjectEgressRollup_BucketName_Field {
+	return ObjectEgressRollup_BucketName_Field{_set: true, _value: v}
+}
+
+func (f ObjectEgressRollup_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ObjectEgressRollup_BucketName_Field) _Column() string { return "bucket_name" }
+
+type ObjectEgressRollup_ObjectPath_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ObjectEgressRollup_ObjectPath(v string) ObjectEgressRollup_ObjectPath_Field {
+	return ObjectEgressRollup_ObjectPath_Field{_set: true, _value: v}
+}
+
+func (f ObjectEgressRollup_ObjectPath_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ObjectEgressRollup_ObjectPath_Field) _Column() string { return "object_path" }
+
+type ObjectEgressRollup_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ObjectEgressRollup_IntervalStart(v time.Time) ObjectEgressRollup_IntervalStart_Field {
+	return ObjectEgressRollup_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f ObjectEgressRollup_IntervalStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ObjectEgressRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type ObjectEgressRollup_Egress_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ObjectEgressRollup_Egress(v int64) ObjectEgressRollup_Egress_Field {
+	return ObjectEgressRollup_Egress_Field{_set: true, _value: v}
+}
+
+func (f ObjectEgressRollup_Egress_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ObjectEgressRollup_Egress_Field) _Column() string { return "egress" }
+
+type OverlayCacheNode struct {
+	NodeId                    []byte
+	NodeType                  int
+	Address                   string
+	Protocol                  int
+	CountryCode               string
+	OperatorEmail             string
+	OperatorWallet            string
+	OperatorWalletFeatures    string
+	OperatorEmailValidatedAt  time.Time
+	OperatorWalletValidatedAt time.Time
+	IngressRate               int64
+	EgressRate                int64
+	UploadSuccessRatio        float64
+	DownloadSuccessRatio      float64
+	FreeBandwidth             int64
+	FreeDisk                  int64
+	Latency90                 int64
+	AuditSuccessRatio         float64
+	AuditUptimeRatio          float64
+	AuditCount                int64
+	AuditSuccessCount         int64
+	UptimeCount               int64
+	UptimeSuccessCount        int64
+	UpdatedAt                 time.Time
+}
+
+func (OverlayCacheNode) _Table() string { return "overlay_cache_nodes" }
+
+type OverlayCacheNode_Update_Fields struct {
+	Address                   OverlayCacheNode_Address_Field
+	Protocol                  OverlayCacheNode_Protocol_Field
+	CountryCode               OverlayCacheNode_CountryCode_Field
+	OperatorEmail             OverlayCacheNode_OperatorEmail_Field
+	OperatorWallet            OverlayCacheNode_OperatorWallet_Field
+	OperatorWalletFeatures    OverlayCacheNode_OperatorWalletFeatures_Field
+	OperatorEmailValidatedAt  OverlayCacheNode_OperatorEmailValidatedAt_Field
+	OperatorWalletValidatedAt OverlayCacheNode_OperatorWalletValidatedAt_Field
+	IngressRate               OverlayCacheNode_IngressRate_Field
+	EgressRate                OverlayCacheNode_EgressRate_Field
+	UploadSuccessRatio        OverlayCacheNode_UploadSuccessRatio_Field
+	DownloadSuccessRatio      OverlayCacheNode_DownloadSuccessRatio_Field
+	FreeBandwidth             OverlayCacheNode_FreeBandwidth_Field
+	FreeDisk                  OverlayCacheNode_FreeDisk_Field
+	Latency90                 OverlayCacheNode_Latency90_Field
+	AuditSuccessRatio         OverlayCacheNode_AuditSuccessRatio_Field
+	AuditUptimeRatio          OverlayCacheNode_AuditUptimeRatio_Field
+	AuditCount                OverlayCacheNode_AuditCount_Field
+	AuditSuccessCount         OverlayCacheNode_AuditSuccessCount_Field
+	UptimeCount               OverlayCacheNode_UptimeCount_Field
+	UptimeSuccessCount        OverlayCacheNode_UptimeSuccessCount_Field
+}
+
+type OverlayCacheNode_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func OverlayCacheNode_NodeId(v []byte) OverlayCacheNode_NodeId_Field {
+	return OverlayCacheNode_NodeId_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_NodeId_Field) _Column() string { return "node_id" }
+
+type OverlayCacheNode_NodeType_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func OverlayCacheNode_NodeType(v int) OverlayCacheNode_NodeType_Field {
+	return OverlayCacheNode_NodeType_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_NodeType_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_NodeType_Field) _Column() string { return "node_type" }
 
 type OverlayCacheNode_Address_Field struct {
 	_set   bool
@@ -1651,335 +3619,1672 @@ type OverlayCacheNode_Address_Field struct {
 	_value string
 }
 
-func OverlayCacheNode_Address(v string) OverlayCacheNode_Address_Field {
-	return OverlayCacheNode_Address_Field{_set: true, _value: v}
+func OverlayCacheNode_Address(v string) OverlayCacheNode_Address_Field {
+	return OverlayCacheNode_Address_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_Address_Field) _Column() string { return "address" }
+
+type OverlayCacheNode_Protocol_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func OverlayCacheNode_Protocol(v int) OverlayCacheNode_Protocol_Field {
+	return OverlayCacheNode_Protocol_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_Protocol_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_Protocol_Field) _Column() string { return "protocol" }
+
+type OverlayCacheNode_CountryCode_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OverlayCacheNode_CountryCode(v string) OverlayCacheNode_CountryCode_Field {
+	return OverlayCacheNode_CountryCode_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_CountryCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_CountryCode_Field) _Column() string { return "country_code" }
+
+type OverlayCacheNode_OperatorEmail_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OverlayCacheNode_OperatorEmail(v string) OverlayCacheNode_OperatorEmail_Field {
+	return OverlayCacheNode_OperatorEmail_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_OperatorEmail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_OperatorEmail_Field) _Column() string { return "operator_email" }
+
+type OverlayCacheNode_OperatorWallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OverlayCacheNode_OperatorWallet(v string) OverlayCacheNode_OperatorWallet_Field {
+	return OverlayCacheNode_OperatorWallet_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_OperatorWallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_OperatorWallet_Field) _Column() string { return "operator_wallet" }
+
+type OverlayCacheNode_OperatorWalletFeatures_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OverlayCacheNode_OperatorWalletFeatures(v string) OverlayCacheNode_OperatorWalletFeatures_Field {
+	return OverlayCacheNode_OperatorWalletFeatures_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_OperatorWalletFeatures_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_OperatorWalletFeatures_Field) _Column() string {
+	return "operator_wallet_features"
+}
+
+type OverlayCacheNode_OperatorEmailValidatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OverlayCacheNode_OperatorEmailValidatedAt(v time.Time) OverlayCacheNode_OperatorEmailValidatedAt_Field {
+	return OverlayCacheNode_OperatorEmailValidatedAt_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_OperatorEmailValidatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_OperatorEmailValidatedAt_Field) _Column() string {
+	return "operator_email_validated_at"
+}
+
+type OverlayCacheNode_OperatorWalletValidatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OverlayCacheNode_OperatorWalletValidatedAt(v time.Time) OverlayCacheNode_OperatorWalletValidatedAt_Field {
+	return OverlayCacheNode_OperatorWalletValidatedAt_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_OperatorWalletValidatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_OperatorWalletValidatedAt_Field) _Column() string {
+	return "operator_wallet_validated_at"
+}
+
+type OverlayCacheNode_IngressRate_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func OverlayCacheNode_IngressRate(v int64) OverlayCacheNode_IngressRate_Field {
+	return OverlayCacheNode_IngressRate_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_IngressRate_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_IngressRate_Field) _Column() string { return "ingress_rate" }
+
+type OverlayCacheNode_EgressRate_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func OverlayCacheNode_EgressRate(v int64) OverlayCacheNode_EgressRate_Field {
+	return OverlayCacheNode_EgressRate_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_EgressRate_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_EgressRate_Field) _Column() string { return "egress_rate" }
+
+type OverlayCacheNode_UploadSuccessRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func OverlayCacheNode_UploadSuccessRatio(v float64) OverlayCacheNode_UploadSuccessRatio_Field {
+	return OverlayCacheNode_UploadSuccessRatio_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_UploadSuccessRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_UploadSuccessRatio_Field) _Column() string { return "upload_success_ratio" }
+
+type OverlayCacheNode_DownloadSuccessRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func OverlayCacheNode_DownloadSuccessRatio(v float64) OverlayCacheNode_DownloadSuccessRatio_Field {
+	return OverlayCacheNode_DownloadSuccessRatio_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_DownloadSuccessRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_DownloadSuccessRatio_Field) _Column() string { return "download_success_ratio" }
+
+type OverlayCacheNode_FreeBandwidth_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func OverlayCacheNode_FreeBandwidth(v int64) OverlayCacheNode_FreeBandwidth_Field {
+	return OverlayCacheNode_FreeBandwidth_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_FreeBandwidth_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_FreeBandwidth_Field) _Column() string { return "free_bandwidth" }
+
+type OverlayCacheNode_FreeDisk_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func OverlayCacheNode_FreeDisk(v int64) OverlayCacheNode_FreeDisk_Field {
+	return OverlayCacheNode_FreeDisk_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_FreeDisk_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_FreeDisk_Field) _Column() string { return "free_disk" }
+
+type OverlayCacheNode_Latency90_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func OverlayCacheNode_Latency90(v int64) OverlayCacheNode_Latency90_Field {
+	return OverlayCacheNode_Latency90_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_Latency90_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_Latency90_Field) _Column() string { return "latency_90" }
+
+type OverlayCacheNode_AuditSuccessRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func OverlayCacheNode_AuditSuccessRatio(v float64) OverlayCacheNode_AuditSuccessRatio_Field {
+	return OverlayCacheNode_AuditSuccessRatio_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditSuccessRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+
+type OverlayCacheNode_AuditUptimeRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func OverlayCacheNode_AuditUptimeRatio(v float64) OverlayCacheNode_AuditUptimeRatio_Field {
+	return OverlayCacheNode_AuditUptimeRatio_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditUptimeRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_AuditUptimeRatio_Field) _Column() string { return "audit_uptime_ratio" }
+
+type OverlayCacheNode_AuditCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func OverlayCacheNode_AuditCount(v int64) OverlayCacheNode_AuditCount_Field {
+	return OverlayCacheNode_AuditCount_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_AuditCount_Field) _Column() string { return "audit_count" }
+
+type OverlayCacheNode_AuditSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func OverlayCacheNode_AuditSuccessCount(v int64) OverlayCacheNode_AuditSuccessCount_Field {
+	return OverlayCacheNode_AuditSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_AuditSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+
+type OverlayCacheNode_UptimeCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func OverlayCacheNode_UptimeCount(v int64) OverlayCacheNode_UptimeCount_Field {
+	return OverlayCacheNode_UptimeCount_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_UptimeCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_UptimeCount_Field) _Column() string { return "uptime_count" }
+
+type OverlayCacheNode_UptimeSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func OverlayCacheNode_UptimeSuccessCount(v int64) OverlayCacheNode_UptimeSuccessCount_Field {
+	return OverlayCacheNode_UptimeSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_UptimeSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+
+type OverlayCacheNode_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func OverlayCacheNode_UpdatedAt(v time.Time) OverlayCacheNode_UpdatedAt_Field {
+	return OverlayCacheNode_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type PendingAudit struct {
+	NodeId            []byte
+	Path              string
+	PieceId           string
+	PieceNum          int
+	StripeIndex       int
+	ShareSize         int
+	ExpectedShareHash []byte
+	ReverifyCount     int
+}
+
+func (PendingAudit) _Table() string { return "pending_audits" }
+
+type PendingAudit_Update_Fields struct {
+}
+
+type PendingAudit_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudit_NodeId(v []byte) PendingAudit_NodeId_Field {
+	return PendingAudit_NodeId_Field{_set: true, _value: v}
+}
+
+func (f PendingAudit_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudit_NodeId_Field) _Column() string { return "node_id" }
+
+type PendingAudit_Path_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PendingAudit_Path(v string) PendingAudit_Path_Field {
+	return PendingAudit_Path_Field{_set: true, _value: v}
+}
+
+func (f PendingAudit_Path_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudit_Path_Field) _Column() string { return "path" }
+
+type PendingAudit_PieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PendingAudit_PieceId(v string) PendingAudit_PieceId_Field {
+	return PendingAudit_PieceId_Field{_set: true, _value: v}
+}
+
+func (f PendingAudit_PieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudit_PieceId_Field) _Column() string { return "piece_id" }
+
+type PendingAudit_PieceNum_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func PendingAudit_PieceNum(v int) PendingAudit_PieceNum_Field {
+	return PendingAudit_PieceNum_Field{_set: true, _value: v}
+}
+
+func (f PendingAudit_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudit_PieceNum_Field) _Column() string { return "piece_num" }
+
+type PendingAudit_StripeIndex_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func PendingAudit_StripeIndex(v int) PendingAudit_StripeIndex_Field {
+	return PendingAudit_StripeIndex_Field{_set: true, _value: v}
+}
+
+func (f PendingAudit_StripeIndex_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudit_StripeIndex_Field) _Column() string { return "stripe_index" }
+
+type PendingAudit_ShareSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func PendingAudit_ShareSize(v int) PendingAudit_ShareSize_Field {
+	return PendingAudit_ShareSize_Field{_set: true, _value: v}
+}
+
+func (f PendingAudit_ShareSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudit_ShareSize_Field) _Column() string { return "share_size" }
+
+type PendingAudit_ExpectedShareHash_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PendingAudit_ExpectedShareHash(v []byte) PendingAudit_ExpectedShareHash_Field {
+	return PendingAudit_ExpectedShareHash_Field{_set: true, _value: v}
+}
+
+func (f PendingAudit_ExpectedShareHash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudit_ExpectedShareHash_Field) _Column() string { return "expected_share_hash" }
+
+type PendingAudit_ReverifyCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func PendingAudit_ReverifyCount(v int) PendingAudit_ReverifyCount_Field {
+	return PendingAudit_ReverifyCount_Field{_set: true, _value: v}
+}
+
+func (f PendingAudit_ReverifyCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PendingAudit_ReverifyCount_Field) _Column() string { return "reverify_count" }
+
+type PrefixQuota struct {
+	ProjectId  []byte
+	BucketName string
+	Prefix     string
+	MaxBytes   int64
+	MaxObjects int64
+}
+
+func (PrefixQuota) _Table() string { return "prefix_quotas" }
+
+type PrefixQuota_Update_Fields struct {
+}
+
+type PrefixQuota_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PrefixQuota_ProjectId(v []byte) PrefixQuota_ProjectId_Field {
+	return PrefixQuota_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f PrefixQuota_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PrefixQuota_ProjectId_Field) _Column() string { return "project_id" }
+
+type PrefixQuota_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PrefixQuota_BucketName(v string) PrefixQuota_BucketName_Field {
+	return PrefixQuota_BucketName_Field{_set: true, _value: v}
+}
+
+func (f PrefixQuota_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PrefixQuota_BucketName_Field) _Column() string { return "bucket_name" }
+
+type PrefixQuota_Prefix_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PrefixQuota_Prefix(v string) PrefixQuota_Prefix_Field {
+	return PrefixQuota_Prefix_Field{_set: true, _value: v}
+}
+
+func (f PrefixQuota_Prefix_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PrefixQuota_Prefix_Field) _Column() string { return "prefix" }
+
+type PrefixQuota_MaxBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PrefixQuota_MaxBytes(v int64) PrefixQuota_MaxBytes_Field {
+	return PrefixQuota_MaxBytes_Field{_set: true, _value: v}
+}
+
+func (f PrefixQuota_MaxBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PrefixQuota_MaxBytes_Field) _Column() string { return "max_bytes" }
+
+type PrefixQuota_MaxObjects_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PrefixQuota_MaxObjects(v int64) PrefixQuota_MaxObjects_Field {
+	return PrefixQuota_MaxObjects_Field{_set: true, _value: v}
+}
+
+func (f PrefixQuota_MaxObjects_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PrefixQuota_MaxObjects_Field) _Column() string { return "max_objects" }
+
+type PricingTier struct {
+	Name      string
+	Storage   int64
+	Egress    int64
+	UpdatedAt time.Time
+}
+
+func (PricingTier) _Table() string { return "pricing_tiers" }
+
+type PricingTier_Update_Fields struct {
+}
+
+type PricingTier_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PricingTier_Name(v string) PricingTier_Name_Field {
+	return PricingTier_Name_Field{_set: true, _value: v}
+}
+
+func (f PricingTier_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PricingTier_Name_Field) _Column() string { return "name" }
+
+type PricingTier_Storage_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PricingTier_Storage(v int64) PricingTier_Storage_Field {
+	return PricingTier_Storage_Field{_set: true, _value: v}
+}
+
+func (f PricingTier_Storage_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PricingTier_Storage_Field) _Column() string { return "storage" }
+
+type PricingTier_Egress_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func PricingTier_Egress(v int64) PricingTier_Egress_Field {
+	return PricingTier_Egress_Field{_set: true, _value: v}
+}
+
+func (f PricingTier_Egress_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PricingTier_Egress_Field) _Column() string { return "egress" }
+
+type PricingTier_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PricingTier_UpdatedAt(v time.Time) PricingTier_UpdatedAt_Field {
+	return PricingTier_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f PricingTier_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (PricingTier_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type ProjectAlert struct {
+	Id          []byte
+	ProjectId   []byte
+	BucketName  string
+	Prefix      string
+	Threshold   float64
+	WebhookUrl  string
+	CreatedAt   time.Time
+	TriggeredAt *time.Time
+}
+
+func (ProjectAlert) _Table() string { return "project_alerts" }
+
+type ProjectAlert_Create_Fields struct {
+	TriggeredAt ProjectAlert_TriggeredAt_Field
+}
+
+type ProjectAlert_Update_Fields struct {
+	TriggeredAt ProjectAlert_TriggeredAt_Field
+}
+
+type ProjectAlert_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectAlert_Id(v []byte) ProjectAlert_Id_Field {
+	return ProjectAlert_Id_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_Id_Field) _Column() string { return "id" }
+
+type ProjectAlert_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectAlert_ProjectId(v []byte) ProjectAlert_ProjectId_Field {
+	return ProjectAlert_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectAlert_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectAlert_BucketName(v string) ProjectAlert_BucketName_Field {
+	return ProjectAlert_BucketName_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_BucketName_Field) _Column() string { return "bucket_name" }
+
+type ProjectAlert_Prefix_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectAlert_Prefix(v string) ProjectAlert_Prefix_Field {
+	return ProjectAlert_Prefix_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_Prefix_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_Prefix_Field) _Column() string { return "prefix" }
+
+type ProjectAlert_Threshold_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func ProjectAlert_Threshold(v float64) ProjectAlert_Threshold_Field {
+	return ProjectAlert_Threshold_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_Threshold_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_Threshold_Field) _Column() string { return "threshold" }
+
+type ProjectAlert_WebhookUrl_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectAlert_WebhookUrl(v string) ProjectAlert_WebhookUrl_Field {
+	return ProjectAlert_WebhookUrl_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_WebhookUrl_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_WebhookUrl_Field) _Column() string { return "webhook_url" }
+
+type ProjectAlert_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectAlert_CreatedAt(v time.Time) ProjectAlert_CreatedAt_Field {
+	return ProjectAlert_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectAlert_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectAlert_TriggeredAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func ProjectAlert_TriggeredAt(v time.Time) ProjectAlert_TriggeredAt_Field {
+	return ProjectAlert_TriggeredAt_Field{_set: true, _value: &v}
+}
+
+func ProjectAlert_TriggeredAt_Raw(v *time.Time) ProjectAlert_TriggeredAt_Field {
+	if v == nil {
+		return ProjectAlert_TriggeredAt_Null()
+	}
+	return ProjectAlert_TriggeredAt(*v)
+}
+
+func ProjectAlert_TriggeredAt_Null() ProjectAlert_TriggeredAt_Field {
+	return ProjectAlert_TriggeredAt_Field{_set: true, _null: true}
+}
+
+func (f ProjectAlert_TriggeredAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ProjectAlert_TriggeredAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectAlert_TriggeredAt_Field) _Column() string { return "triggered_at" }
+
+type ProjectDeletion struct {
+	ProjectId       []byte
+	DeletedSegments int64
+	DeletedBytes    int64
+	RequestedAt     time.Time
+	FinishedAt      *time.Time
+}
+
+func (ProjectDeletion) _Table() string { return "project_deletions" }
+
+type ProjectDeletion_Create_Fields struct {
+	FinishedAt ProjectDeletion_FinishedAt_Field
+}
+
+type ProjectDeletion_Update_Fields struct {
+	DeletedSegments ProjectDeletion_DeletedSegments_Field
+	DeletedBytes    ProjectDeletion_DeletedBytes_Field
+	FinishedAt      ProjectDeletion_FinishedAt_Field
+}
+
+type ProjectDeletion_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectDeletion_ProjectId(v []byte) ProjectDeletion_ProjectId_Field {
+	return ProjectDeletion_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectDeletion_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectDeletion_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectDeletion_DeletedSegments_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ProjectDeletion_DeletedSegments(v int64) ProjectDeletion_DeletedSegments_Field {
+	return ProjectDeletion_DeletedSegments_Field{_set: true, _value: v}
+}
+
+func (f ProjectDeletion_DeletedSegments_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectDeletion_DeletedSegments_Field) _Column() string { return "deleted_segments" }
+
+type ProjectDeletion_DeletedBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ProjectDeletion_DeletedBytes(v int64) ProjectDeletion_DeletedBytes_Field {
+	return ProjectDeletion_DeletedBytes_Field{_set: true, _value: v}
+}
+
+func (f ProjectDeletion_DeletedBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectDeletion_DeletedBytes_Field) _Column() string { return "deleted_bytes" }
+
+type ProjectDeletion_RequestedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectDeletion_RequestedAt(v time.Time) ProjectDeletion_RequestedAt_Field {
+	return ProjectDeletion_RequestedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectDeletion_RequestedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectDeletion_RequestedAt_Field) _Column() string { return "requested_at" }
+
+type ProjectDeletion_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func ProjectDeletion_FinishedAt(v time.Time) ProjectDeletion_FinishedAt_Field {
+	return ProjectDeletion_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func ProjectDeletion_FinishedAt_Raw(v *time.Time) ProjectDeletion_FinishedAt_Field {
+	if v == nil {
+		return ProjectDeletion_FinishedAt_Null()
+	}
+	return ProjectDeletion_FinishedAt(*v)
+}
+
+func ProjectDeletion_FinishedAt_Null() ProjectDeletion_FinishedAt_Field {
+	return ProjectDeletion_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f ProjectDeletion_FinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ProjectDeletion_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectDeletion_FinishedAt_Field) _Column() string { return "finished_at" }
+
+type ProjectExport struct {
+	ProjectId       []byte
+	RequestedBy     []byte
+	ExportedObjects int64
+	ExportedBytes   int64
+	LastPath        string
+	Manifest        []byte
+	RequestedAt     time.Time
+	FinishedAt      *time.Time
+}
+
+func (ProjectExport) _Table() string { return "project_exports" }
+
+type ProjectExport_Create_Fields struct {
+	FinishedAt ProjectExport_FinishedAt_Field
+}
+
+type ProjectExport_Update_Fields struct {
+	ExportedObjects ProjectExport_ExportedObjects_Field
+	ExportedBytes   ProjectExport_ExportedBytes_Field
+	LastPath        ProjectExport_LastPath_Field
+	Manifest        ProjectExport_Manifest_Field
+	FinishedAt      ProjectExport_FinishedAt_Field
+}
+
+type ProjectExport_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectExport_ProjectId(v []byte) ProjectExport_ProjectId_Field {
+	return ProjectExport_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectExport_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectExport_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectExport_RequestedBy_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectExport_RequestedBy(v []byte) ProjectExport_RequestedBy_Field {
+	return ProjectExport_RequestedBy_Field{_set: true, _value: v}
+}
+
+func (f ProjectExport_RequestedBy_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectExport_RequestedBy_Field) _Column() string { return "requested_by" }
+
+type ProjectExport_ExportedObjects_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ProjectExport_ExportedObjects(v int64) ProjectExport_ExportedObjects_Field {
+	return ProjectExport_ExportedObjects_Field{_set: true, _value: v}
+}
+
+func (f ProjectExport_ExportedObjects_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectExport_ExportedObjects_Field) _Column() string { return "exported_objects" }
+
+type ProjectExport_ExportedBytes_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ProjectExport_ExportedBytes(v int64) ProjectExport_ExportedBytes_Field {
+	return ProjectExport_ExportedBytes_Field{_set: true, _value: v}
+}
+
+func (f ProjectExport_ExportedBytes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectExport_ExportedBytes_Field) _Column() string { return "exported_bytes" }
+
+type ProjectExport_LastPath_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectExport_LastPath(v string) ProjectExport_LastPath_Field {
+	return ProjectExport_LastPath_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_Address_Field) value() interface{} {
+func (f ProjectExport_LastPath_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_Address_Field) _Column() string { return "address" }
+func (ProjectExport_LastPath_Field) _Column() string { return "last_path" }
 
-type OverlayCacheNode_Protocol_Field struct {
+type ProjectExport_Manifest_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func OverlayCacheNode_Protocol(v int) OverlayCacheNode_Protocol_Field {
-	return OverlayCacheNode_Protocol_Field{_set: true, _value: v}
+func ProjectExport_Manifest(v []byte) ProjectExport_Manifest_Field {
+	return ProjectExport_Manifest_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_Protocol_Field) value() interface{} {
+func (f ProjectExport_Manifest_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectExport_Manifest_Field) _Column() string { return "manifest" }
+
+type ProjectExport_RequestedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectExport_RequestedAt(v time.Time) ProjectExport_RequestedAt_Field {
+	return ProjectExport_RequestedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectExport_RequestedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectExport_RequestedAt_Field) _Column() string { return "requested_at" }
+
+type ProjectExport_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func ProjectExport_FinishedAt(v time.Time) ProjectExport_FinishedAt_Field {
+	return ProjectExport_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func ProjectExport_FinishedAt_Raw(v *time.Time) ProjectExport_FinishedAt_Field {
+	if v == nil {
+		return ProjectExport_FinishedAt_Null()
+	}
+	return ProjectExport_FinishedAt(*v)
+}
+
+func ProjectExport_FinishedAt_Null() ProjectExport_FinishedAt_Field {
+	return ProjectExport_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f ProjectExport_FinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f ProjectExport_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectExport_FinishedAt_Field) _Column() string { return "finished_at" }
+
+type ProjectSalt struct {
+	ProjectId []byte
+	Salt      []byte
+	CreatedAt time.Time
+}
+
+func (ProjectSalt) _Table() string { return "project_salts" }
+
+type ProjectSalt_Update_Fields struct {
+}
+
+type ProjectSalt_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectSalt_ProjectId(v []byte) ProjectSalt_ProjectId_Field {
+	return ProjectSalt_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectSalt_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_Protocol_Field) _Column() string { return "protocol" }
+func (ProjectSalt_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectSalt_Salt_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectSalt_Salt(v []byte) ProjectSalt_Salt_Field {
+	return ProjectSalt_Salt_Field{_set: true, _value: v}
+}
+
+func (f ProjectSalt_Salt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectSalt_Salt_Field) _Column() string { return "salt" }
+
+type ProjectSalt_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectSalt_CreatedAt(v time.Time) ProjectSalt_CreatedAt_Field {
+	return ProjectSalt_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectSalt_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectSalt_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectTier struct {
+	ProjectId []byte
+	Tier      string
+	UpdatedAt time.Time
+}
+
+func (ProjectTier) _Table() string { return "project_tiers" }
 
-type OverlayCacheNode_OperatorEmail_Field struct {
+type ProjectTier_Update_Fields struct {
+}
+
+type ProjectTier_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func OverlayCacheNode_OperatorEmail(v string) OverlayCacheNode_OperatorEmail_Field {
-	return OverlayCacheNode_OperatorEmail_Field{_set: true, _value: v}
+func ProjectTier_ProjectId(v []byte) ProjectTier_ProjectId_Field {
+	return ProjectTier_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_OperatorEmail_Field) value() interface{} {
+func (f ProjectTier_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_OperatorEmail_Field) _Column() string { return "operator_email" }
+func (ProjectTier_ProjectId_Field) _Column() string { return "project_id" }
 
-type OverlayCacheNode_OperatorWallet_Field struct {
+type ProjectTier_Tier_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func OverlayCacheNode_OperatorWallet(v string) OverlayCacheNode_OperatorWallet_Field {
-	return OverlayCacheNode_OperatorWallet_Field{_set: true, _value: v}
+func ProjectTier_Tier(v string) ProjectTier_Tier_Field {
+	return ProjectTier_Tier_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_OperatorWallet_Field) value() interface{} {
+func (f ProjectTier_Tier_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_OperatorWallet_Field) _Column() string { return "operator_wallet" }
+func (ProjectTier_Tier_Field) _Column() string { return "tier" }
 
-type OverlayCacheNode_FreeBandwidth_Field struct {
+type ProjectTier_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func OverlayCacheNode_FreeBandwidth(v int64) OverlayCacheNode_FreeBandwidth_Field {
-	return OverlayCacheNode_FreeBandwidth_Field{_set: true, _value: v}
+func ProjectTier_UpdatedAt(v time.Time) ProjectTier_UpdatedAt_Field {
+	return ProjectTier_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_FreeBandwidth_Field) value() interface{} {
+func (f ProjectTier_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_FreeBandwidth_Field) _Column() string { return "free_bandwidth" }
+func (ProjectTier_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type OverlayCacheNode_FreeDisk_Field struct {
+type Project struct {
+	Id          []byte
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
+func (Project) _Table() string { return "projects" }
+
+type Project_Update_Fields struct {
+	Description Project_Description_Field
+}
+
+type Project_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func OverlayCacheNode_FreeDisk(v int64) OverlayCacheNode_FreeDisk_Field {
-	return OverlayCacheNode_FreeDisk_Field{_set: true, _value: v}
+func Project_Id(v []byte) Project_Id_Field {
+	return Project_Id_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_FreeDisk_Field) value() interface{} {
+func (f Project_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_FreeDisk_Field) _Column() string { return "free_disk" }
+func (Project_Id_Field) _Column() string { return "id" }
 
-type OverlayCacheNode_Latency90_Field struct {
+type Project_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func OverlayCacheNode_Latency90(v int64) OverlayCacheNode_Latency90_Field {
-	return OverlayCacheNode_Latency90_Field{_set: true, _value: v}
+func Project_Name(v string) Project_Name_Field {
+	return Project_Name_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_Latency90_Field) value() interface{} {
+func (f Project_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_Latency90_Field) _Column() string { return "latency_90" }
+func (Project_Name_Field) _Column() string { return "name" }
 
-type OverlayCacheNode_AuditSuccessRatio_Field struct {
+type Project_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value string
 }
 
-func OverlayCacheNode_AuditSuccessRatio(v float64) OverlayCacheNode_AuditSuccessRatio_Field {
-	return OverlayCacheNode_AuditSuccessRatio_Field{_set: true, _value: v}
+func Project_Description(v string) Project_Description_Field {
+	return Project_Description_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_AuditSuccessRatio_Field) value() interface{} {
+func (f Project_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+func (Project_Description_Field) _Column() string { return "description" }
 
-type OverlayCacheNode_AuditUptimeRatio_Field struct {
+type Project_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func OverlayCacheNode_AuditUptimeRatio(v float64) OverlayCacheNode_AuditUptimeRatio_Field {
-	return OverlayCacheNode_AuditUptimeRatio_Field{_set: true, _value: v}
+func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
+	return Project_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_AuditUptimeRatio_Field) value() interface{} {
+func (f Project_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_AuditUptimeRatio_Field) _Column() string { return "audit_uptime_ratio" }
+func (Project_CreatedAt_Field) _Column() string { return "created_at" }
 
-type OverlayCacheNode_AuditCount_Field struct {
+type RepairSloDay struct {
+	IntervalStart time.Time
+	Injured       int64
+	Irreparable   int64
+	Repaired      int64
+	Failed        int64
+}
+
+func (RepairSloDay) _Table() string { return "repair_slo_days" }
+
+type RepairSloDay_Update_Fields struct {
+}
+
+type RepairSloDay_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func OverlayCacheNode_AuditCount(v int64) OverlayCacheNode_AuditCount_Field {
-	return OverlayCacheNode_AuditCount_Field{_set: true, _value: v}
+func RepairSloDay_IntervalStart(v time.Time) RepairSloDay_IntervalStart_Field {
+	return RepairSloDay_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_AuditCount_Field) value() interface{} {
+func (f RepairSloDay_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_AuditCount_Field) _Column() string { return "audit_count" }
+func (RepairSloDay_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type OverlayCacheNode_AuditSuccessCount_Field struct {
+type RepairSloDay_Injured_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func OverlayCacheNode_AuditSuccessCount(v int64) OverlayCacheNode_AuditSuccessCount_Field {
-	return OverlayCacheNode_AuditSuccessCount_Field{_set: true, _value: v}
+func RepairSloDay_Injured(v int64) RepairSloDay_Injured_Field {
+	return RepairSloDay_Injured_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_AuditSuccessCount_Field) value() interface{} {
+func (f RepairSloDay_Injured_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (RepairSloDay_Injured_Field) _Column() string { return "injured" }
 
-type OverlayCacheNode_UptimeCount_Field struct {
+type RepairSloDay_Irreparable_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func OverlayCacheNode_UptimeCount(v int64) OverlayCacheNode_UptimeCount_Field {
-	return OverlayCacheNode_UptimeCount_Field{_set: true, _value: v}
+func RepairSloDay_Irreparable(v int64) RepairSloDay_Irreparable_Field {
+	return RepairSloDay_Irreparable_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_UptimeCount_Field) value() interface{} {
+func (f RepairSloDay_Irreparable_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_UptimeCount_Field) _Column() string { return "uptime_count" }
+func (RepairSloDay_Irreparable_Field) _Column() string { return "irreparable" }
 
-type OverlayCacheNode_UptimeSuccessCount_Field struct {
+type RepairSloDay_Repaired_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func OverlayCacheNode_UptimeSuccessCount(v int64) OverlayCacheNode_UptimeSuccessCount_Field {
-	return OverlayCacheNode_UptimeSuccessCount_Field{_set: true, _value: v}
+func RepairSloDay_Repaired(v int64) RepairSloDay_Repaired_Field {
+	return RepairSloDay_Repaired_Field{_set: true, _value: v}
 }
 
-func (f OverlayCacheNode_UptimeSuccessCount_Field) value() interface{} {
+func (f RepairSloDay_Repaired_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OverlayCacheNode_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
-
-type Project struct {
-	Id          []byte
-	Name        string
-	Description string
-	CreatedAt   time.Time
-}
-
-func (Project) _Table() string { return "projects" }
-
-type Project_Update_Fields struct {
-	Description Project_Description_Field
-}
+func (RepairSloDay_Repaired_Field) _Column() string { return "repaired" }
 
-type Project_Id_Field struct {
+type RepairSloDay_Failed_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Project_Id(v []byte) Project_Id_Field {
-	return Project_Id_Field{_set: true, _value: v}
+func RepairSloDay_Failed(v int64) RepairSloDay_Failed_Field {
+	return RepairSloDay_Failed_Field{_set: true, _value: v}
 }
 
-func (f Project_Id_Field) value() interface{} {
+func (f RepairSloDay_Failed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Id_Field) _Column() string { return "id" }
+func (RepairSloDay_Failed_Field) _Column() string { return "failed" }
 
-type Project_Name_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+type SampleSnapshot struct {
+	CreatedAt time.Time
+	Data      []byte
 }
 
-func Project_Name(v string) Project_Name_Field {
-	return Project_Name_Field{_set: true, _value: v}
-}
+func (SampleSnapshot) _Table() string { return "sample_snapshots" }
 
-func (f Project_Name_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type SampleSnapshot_Update_Fields struct {
 }
 
-func (Project_Name_Field) _Column() string { return "name" }
-
-type Project_Description_Field struct {
+type SampleSnapshot_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func Project_Description(v string) Project_Description_Field {
-	return Project_Description_Field{_set: true, _value: v}
+func SampleSnapshot_CreatedAt(v time.Time) SampleSnapshot_CreatedAt_Field {
+	return SampleSnapshot_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Project_Description_Field) value() interface{} {
+func (f SampleSnapshot_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Description_Field) _Column() string { return "description" }
+func (SampleSnapshot_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project_CreatedAt_Field struct {
+type SampleSnapshot_Data_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
-	return Project_CreatedAt_Field{_set: true, _value: v}
+func SampleSnapshot_Data(v []byte) SampleSnapshot_Data_Field {
+	return SampleSnapshot_Data_Field{_set: true, _value: v}
 }
 
-func (f Project_CreatedAt_Field) value() interface{} {
+func (f SampleSnapshot_Data_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_CreatedAt_Field) _Column() string { return "created_at" }
+func (SampleSnapshot_Data_Field) _Column() string { return "data" }
 
 type User struct {
 	Id           []byte
@@ -2632,9 +5937,18 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	node_audit_success_count Node_AuditSuccessCount_Field,
 	node_total_audit_count Node_TotalAuditCount_Field,
 	node_audit_success_ratio Node_AuditSuccessRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
 	node_uptime_success_count Node_UptimeSuccessCount_Field,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
-	node_uptime_ratio Node_UptimeRatio_Field) (
+	node_uptime_ratio Node_UptimeRatio_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	node_last_contact_success Node_LastContactSuccess_Field,
+	node_last_contact_failure Node_LastContactFailure_Field,
+	node_vetted_at Node_VettedAt_Field,
+	node_disqualified_at Node_DisqualifiedAt_Field,
+	node_disqualification_reason Node_DisqualificationReason_Field) (
 	node *Node, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -2642,19 +5956,28 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	__audit_success_count_val := node_audit_success_count.value()
 	__total_audit_count_val := node_total_audit_count.value()
 	__audit_success_ratio_val := node_audit_success_ratio.value()
+	__audit_reputation_alpha_val := node_audit_reputation_alpha.value()
+	__audit_reputation_beta_val := node_audit_reputation_beta.value()
 	__uptime_success_count_val := node_uptime_success_count.value()
 	__total_uptime_count_val := node_total_uptime_count.value()
 	__uptime_ratio_val := node_uptime_ratio.value()
+	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
+	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
+	__last_contact_success_val := node_last_contact_success.value()
+	__last_contact_failure_val := node_last_contact_failure.value()
+	__vetted_at_val := node_vetted_at.value()
+	__disqualified_at_val := node_disqualified_at.value()
+	__disqualification_reason_val := node_disqualification_reason.value()
 	__created_at_val := __now
 	__updated_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, audit_reputation_alpha, audit_reputation_beta, uptime_success_count, total_uptime_count, uptime_ratio, uptime_reputation_alpha, uptime_reputation_beta, last_contact_success, last_contact_failure, vetted_at, disqualified_at, disqualification_reason, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.last_contact_success, nodes.last_contact_failure, nodes.vetted_at, nodes.disqualified_at, nodes.disqualification_reason, nodes.created_at, nodes.updated_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val)
+	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __last_contact_success_val, __last_contact_failure_val, __vetted_at_val, __disqualified_at_val, __disqualification_reason_val, __created_at_val, __updated_at_val)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __last_contact_success_val, __last_contact_failure_val, __vetted_at_val, __disqualified_at_val, __disqualification_reason_val, __created_at_val, __updated_at_val).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.LastContactSuccess, &node.LastContactFailure, &node.VettedAt, &node.DisqualifiedAt, &node.DisqualificationReason, &node.CreatedAt, &node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -2667,8 +5990,16 @@ func (obj *postgresImpl) Create_OverlayCacheNode(ctx context.Context,
 	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
 	overlay_cache_node_address OverlayCacheNode_Address_Field,
 	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+	overlay_cache_node_country_code OverlayCacheNode_CountryCode_Field,
 	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
 	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+	overlay_cache_node_operator_wallet_features OverlayCacheNode_OperatorWalletFeatures_Field,
+	overlay_cache_node_operator_email_validated_at OverlayCacheNode_OperatorEmailValidatedAt_Field,
+	overlay_cache_node_operator_wallet_validated_at OverlayCacheNode_OperatorWalletValidatedAt_Field,
+	overlay_cache_node_ingress_rate OverlayCacheNode_IngressRate_Field,
+	overlay_cache_node_egress_rate OverlayCacheNode_EgressRate_Field,
+	overlay_cache_node_upload_success_ratio OverlayCacheNode_UploadSuccessRatio_Field,
+	overlay_cache_node_download_success_ratio OverlayCacheNode_DownloadSuccessRatio_Field,
 	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
 	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
 	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
@@ -2679,12 +6010,22 @@ func (obj *postgresImpl) Create_OverlayCacheNode(ctx context.Context,
 	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
 	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
 	overlay_cache_node *OverlayCacheNode, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
 	__node_id_val := overlay_cache_node_node_id.value()
 	__node_type_val := overlay_cache_node_node_type.value()
 	__address_val := overlay_cache_node_address.value()
 	__protocol_val := overlay_cache_node_protocol.value()
+	__country_code_val := overlay_cache_node_country_code.value()
 	__operator_email_val := overlay_cache_node_operator_email.value()
 	__operator_wallet_val := overlay_cache_node_operator_wallet.value()
+	__operator_wallet_features_val := overlay_cache_node_operator_wallet_features.value()
+	__operator_email_validated_at_val := overlay_cache_node_operator_email_validated_at.value()
+	__operator_wallet_validated_at_val := overlay_cache_node_operator_wallet_validated_at.value()
+	__ingress_rate_val := overlay_cache_node_ingress_rate.value()
+	__egress_rate_val := overlay_cache_node_egress_rate.value()
+	__upload_success_ratio_val := overlay_cache_node_upload_success_ratio.value()
+	__download_success_ratio_val := overlay_cache_node_download_success_ratio.value()
 	__free_bandwidth_val := overlay_cache_node_free_bandwidth.value()
 	__free_disk_val := overlay_cache_node_free_disk.value()
 	__latency_90_val := overlay_cache_node_latency_90.value()
@@ -2694,14 +6035,15 @@ func (obj *postgresImpl) Create_OverlayCacheNode(ctx context.Context,
 	__audit_success_count_val := overlay_cache_node_audit_success_count.value()
 	__uptime_count_val := overlay_cache_node_uptime_count.value()
 	__uptime_success_count_val := overlay_cache_node_uptime_success_count.value()
+	__updated_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, operator_email, operator_wallet, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, country_code, operator_email, operator_wallet, operator_wallet_features, operator_email_validated_at, operator_wallet_validated_at, ingress_rate, egress_rate, upload_success_ratio, download_success_ratio, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.country_code, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_wallet_features, overlay_cache_nodes.operator_email_validated_at, overlay_cache_nodes.operator_wallet_validated_at, overlay_cache_nodes.ingress_rate, overlay_cache_nodes.egress_rate, overlay_cache_nodes.upload_success_ratio, overlay_cache_nodes.download_success_ratio, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.updated_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val)
+	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __country_code_val, __operator_email_val, __operator_wallet_val, __operator_wallet_features_val, __operator_email_validated_at_val, __operator_wallet_validated_at_val, __ingress_rate_val, __egress_rate_val, __upload_success_ratio_val, __download_success_ratio_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __updated_at_val)
 
 	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __country_code_val, __operator_email_val, __operator_wallet_val, __operator_wallet_features_val, __operator_email_validated_at_val, __operator_wallet_validated_at_val, __ingress_rate_val, __egress_rate_val, __upload_success_ratio_val, __download_success_ratio_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __updated_at_val).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.CountryCode, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorWalletFeatures, &overlay_cache_node.OperatorEmailValidatedAt, &overlay_cache_node.OperatorWalletValidatedAt, &overlay_cache_node.IngressRate, &overlay_cache_node.EgressRate, &overlay_cache_node.UploadSuccessRatio, &overlay_cache_node.DownloadSuccessRatio, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -2710,17 +6052,19 @@ func (obj *postgresImpl) Create_OverlayCacheNode(ctx context.Context,
 }
 
 func (obj *postgresImpl) Create_Injuredsegment(ctx context.Context,
-	injuredsegment_info Injuredsegment_Info_Field) (
+	injuredsegment_info Injuredsegment_Info_Field,
+	injuredsegment_leased_until Injuredsegment_LeasedUntil_Field) (
 	injuredsegment *Injuredsegment, err error) {
 	__info_val := injuredsegment_info.value()
+	__leased_until_val := injuredsegment_leased_until.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( info ) VALUES ( ? ) RETURNING injuredsegments.id, injuredsegments.info")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( info, leased_until ) VALUES ( ?, ? ) RETURNING injuredsegments.id, injuredsegments.info, injuredsegments.leased_until")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __info_val)
+	obj.logStmt(__stmt, __info_val, __leased_until_val)
 
 	injuredsegment = &Injuredsegment{}
-	err = obj.driver.QueryRow(__stmt, __info_val).Scan(&injuredsegment.Id, &injuredsegment.Info)
+	err = obj.driver.QueryRow(__stmt, __info_val, __leased_until_val).Scan(&injuredsegment.Id, &injuredsegment.Info, &injuredsegment.LeasedUntil)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -2790,73 +6134,212 @@ func (obj *postgresImpl) Create_ProjectMember(ctx context.Context,
 	project_member *ProjectMember, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__member_id_val := project_member_member_id.value()
-	__project_id_val := project_member_project_id.value()
-	__created_at_val := __now
+	__member_id_val := project_member_member_id.value()
+	__project_id_val := project_member_project_id.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+
+	project_member = &ProjectMember{}
+	err = obj.driver.QueryRow(__stmt, __member_id_val, __project_id_val, __created_at_val).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+
+}
+
+func (obj *postgresImpl) Create_ApiKey(ctx context.Context,
+	api_key_id ApiKey_Id_Field,
+	api_key_project_id ApiKey_ProjectId_Field,
+	api_key_key ApiKey_Key_Field,
+	api_key_name ApiKey_Name_Field) (
+	api_key *ApiKey, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__id_val := api_key_id.value()
+	__project_id_val := api_key_project_id.value()
+	__key_val := api_key_key.value()
+	__name_val := api_key_name.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, name, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *postgresImpl) Create_CertRecord(ctx context.Context,
+	certRecord_publickey CertRecord_Publickey_Field,
+	certRecord_id CertRecord_Id_Field) (
+	certRecord *CertRecord, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__publickey_val := certRecord_publickey.value()
+	__id_val := certRecord_id.value()
+	__update_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO certRecords ( publickey, id, update_at ) VALUES ( ?, ?, ? ) RETURNING certRecords.publickey, certRecords.id, certRecords.update_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __publickey_val, __id_val, __update_at_val)
+
+	certRecord = &CertRecord{}
+	err = obj.driver.QueryRow(__stmt, __publickey_val, __id_val, __update_at_val).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return certRecord, nil
+
+}
+
+func (obj *postgresImpl) Create_AbuseBlock(ctx context.Context,
+	abuse_block_kind AbuseBlock_Kind_Field,
+	abuse_block_value AbuseBlock_Value_Field,
+	abuse_block_reason AbuseBlock_Reason_Field,
+	abuse_block_created_by AbuseBlock_CreatedBy_Field,
+	abuse_block_created_at AbuseBlock_CreatedAt_Field,
+	abuse_block_expires_at AbuseBlock_ExpiresAt_Field) (
+	abuse_block *AbuseBlock, err error) {
+	__kind_val := abuse_block_kind.value()
+	__value_val := abuse_block_value.value()
+	__reason_val := abuse_block_reason.value()
+	__created_by_val := abuse_block_created_by.value()
+	__created_at_val := abuse_block_created_at.value()
+	__expires_at_val := abuse_block_expires_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO abuse_blocks ( kind, value, reason, created_by, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING abuse_blocks.id, abuse_blocks.kind, abuse_blocks.value, abuse_blocks.reason, abuse_blocks.created_by, abuse_blocks.created_at, abuse_blocks.expires_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __kind_val, __value_val, __reason_val, __created_by_val, __created_at_val, __expires_at_val)
+
+	abuse_block = &AbuseBlock{}
+	err = obj.driver.QueryRow(__stmt, __kind_val, __value_val, __reason_val, __created_by_val, __created_at_val, __expires_at_val).Scan(&abuse_block.Id, &abuse_block.Kind, &abuse_block.Value, &abuse_block.Reason, &abuse_block.CreatedBy, &abuse_block.CreatedAt, &abuse_block.ExpiresAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return abuse_block, nil
+
+}
+
+func (obj *postgresImpl) Create_AuditLog(ctx context.Context,
+	audit_log_actor AuditLog_Actor_Field,
+	audit_log_action AuditLog_Action_Field,
+	audit_log_target AuditLog_Target_Field,
+	audit_log_remote_addr AuditLog_RemoteAddr_Field) (
+	audit_log *AuditLog, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__actor_val := audit_log_actor.value()
+	__action_val := audit_log_action.value()
+	__target_val := audit_log_target.value()
+	__remote_addr_val := audit_log_remote_addr.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO audit_logs ( actor, action, target, remote_addr, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING audit_logs.id, audit_logs.actor, audit_logs.action, audit_logs.target, audit_logs.remote_addr, audit_logs.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __actor_val, __action_val, __target_val, __remote_addr_val, __created_at_val)
+
+	audit_log = &AuditLog{}
+	err = obj.driver.QueryRow(__stmt, __actor_val, __action_val, __target_val, __remote_addr_val, __created_at_val).Scan(&audit_log.Id, &audit_log.Actor, &audit_log.Action, &audit_log.Target, &audit_log.RemoteAddr, &audit_log.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_log, nil
+
+}
+
+func (obj *postgresImpl) Create_ProjectDeletion(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	project_deletion_deleted_segments ProjectDeletion_DeletedSegments_Field,
+	project_deletion_deleted_bytes ProjectDeletion_DeletedBytes_Field,
+	optional ProjectDeletion_Create_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_deletion_project_id.value()
+	__deleted_segments_val := project_deletion_deleted_segments.value()
+	__deleted_bytes_val := project_deletion_deleted_bytes.value()
+	__requested_at_val := __now
+	__finished_at_val := optional.FinishedAt.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_members ( member_id, project_id, created_at ) VALUES ( ?, ?, ? ) RETURNING project_members.member_id, project_members.project_id, project_members.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_deletions ( project_id, deleted_segments, deleted_bytes, requested_at, finished_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING project_deletions.project_id, project_deletions.deleted_segments, project_deletions.deleted_bytes, project_deletions.requested_at, project_deletions.finished_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __member_id_val, __project_id_val, __created_at_val)
+	obj.logStmt(__stmt, __project_id_val, __deleted_segments_val, __deleted_bytes_val, __requested_at_val, __finished_at_val)
 
-	project_member = &ProjectMember{}
-	err = obj.driver.QueryRow(__stmt, __member_id_val, __project_id_val, __created_at_val).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+	project_deletion = &ProjectDeletion{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __deleted_segments_val, __deleted_bytes_val, __requested_at_val, __finished_at_val).Scan(&project_deletion.ProjectId, &project_deletion.DeletedSegments, &project_deletion.DeletedBytes, &project_deletion.RequestedAt, &project_deletion.FinishedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return project_member, nil
+	return project_deletion, nil
 
 }
 
-func (obj *postgresImpl) Create_ApiKey(ctx context.Context,
-	api_key_id ApiKey_Id_Field,
-	api_key_project_id ApiKey_ProjectId_Field,
-	api_key_key ApiKey_Key_Field,
-	api_key_name ApiKey_Name_Field) (
-	api_key *ApiKey, err error) {
-
-	__now := obj.db.Hooks.Now().UTC()
-	__id_val := api_key_id.value()
-	__project_id_val := api_key_project_id.value()
-	__key_val := api_key_key.value()
-	__name_val := api_key_name.value()
-	__created_at_val := __now
+func (obj *postgresImpl) Create_NodeTag(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field,
+	node_tag_name NodeTag_Name_Field,
+	node_tag_value NodeTag_Value_Field,
+	node_tag_signer_id NodeTag_SignerId_Field,
+	node_tag_tag NodeTag_Tag_Field) (
+	node_tag *NodeTag, err error) {
+	__node_id_val := node_tag_node_id.value()
+	__name_val := node_tag_name.value()
+	__value_val := node_tag_value.value()
+	__signer_id_val := node_tag_signer_id.value()
+	__tag_val := node_tag_tag.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO api_keys ( id, project_id, key, name, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_tags ( node_id, name, value, signer_id, tag ) VALUES ( ?, ?, ?, ?, ? ) RETURNING node_tags.node_id, node_tags.name, node_tags.value, node_tags.signer_id, node_tags.tag")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val)
+	obj.logStmt(__stmt, __node_id_val, __name_val, __value_val, __signer_id_val, __tag_val)
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __project_id_val, __key_val, __name_val, __created_at_val).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	node_tag = &NodeTag{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __name_val, __value_val, __signer_id_val, __tag_val).Scan(&node_tag.NodeId, &node_tag.Name, &node_tag.Value, &node_tag.SignerId, &node_tag.Tag)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return api_key, nil
+	return node_tag, nil
 
 }
 
-func (obj *postgresImpl) Create_CertRecord(ctx context.Context,
-	certRecord_publickey CertRecord_Publickey_Field,
-	certRecord_id CertRecord_Id_Field) (
-	certRecord *CertRecord, err error) {
-
-	__now := obj.db.Hooks.Now().UTC()
-	__publickey_val := certRecord_publickey.value()
-	__id_val := certRecord_id.value()
-	__update_at_val := __now
+func (obj *postgresImpl) Create_PrefixQuota(ctx context.Context,
+	prefix_quota_project_id PrefixQuota_ProjectId_Field,
+	prefix_quota_bucket_name PrefixQuota_BucketName_Field,
+	prefix_quota_prefix PrefixQuota_Prefix_Field,
+	prefix_quota_max_bytes PrefixQuota_MaxBytes_Field,
+	prefix_quota_max_objects PrefixQuota_MaxObjects_Field) (
+	prefix_quota *PrefixQuota, err error) {
+	__project_id_val := prefix_quota_project_id.value()
+	__bucket_name_val := prefix_quota_bucket_name.value()
+	__prefix_val := prefix_quota_prefix.value()
+	__max_bytes_val := prefix_quota_max_bytes.value()
+	__max_objects_val := prefix_quota_max_objects.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO certRecords ( publickey, id, update_at ) VALUES ( ?, ?, ? ) RETURNING certRecords.publickey, certRecords.id, certRecords.update_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO prefix_quotas ( project_id, bucket_name, prefix, max_bytes, max_objects ) VALUES ( ?, ?, ?, ?, ? ) RETURNING prefix_quotas.project_id, prefix_quotas.bucket_name, prefix_quotas.prefix, prefix_quotas.max_bytes, prefix_quotas.max_objects")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __publickey_val, __id_val, __update_at_val)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __prefix_val, __max_bytes_val, __max_objects_val)
 
-	certRecord = &CertRecord{}
-	err = obj.driver.QueryRow(__stmt, __publickey_val, __id_val, __update_at_val).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	prefix_quota = &PrefixQuota{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __bucket_name_val, __prefix_val, __max_bytes_val, __max_objects_val).Scan(&prefix_quota.ProjectId, &prefix_quota.BucketName, &prefix_quota.Prefix, &prefix_quota.MaxBytes, &prefix_quota.MaxObjects)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return certRecord, nil
+	return prefix_quota, nil
 
 }
 
@@ -3149,7 +6632,7 @@ func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.last_contact_success, nodes.last_contact_failure, nodes.vetted_at, nodes.disqualified_at, nodes.disqualification_reason, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -3158,7 +6641,7 @@ func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.LastContactSuccess, &node.LastContactFailure, &node.VettedAt, &node.DisqualifiedAt, &node.DisqualificationReason, &node.CreatedAt, &node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -3202,7 +6685,7 @@ func (obj *postgresImpl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
 	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
 	overlay_cache_node *OverlayCacheNode, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.country_code, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_wallet_features, overlay_cache_nodes.operator_email_validated_at, overlay_cache_nodes.operator_wallet_validated_at, overlay_cache_nodes.ingress_rate, overlay_cache_nodes.egress_rate, overlay_cache_nodes.upload_success_ratio, overlay_cache_nodes.download_success_ratio, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.updated_at FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, overlay_cache_node_node_id.value())
@@ -3211,7 +6694,7 @@ func (obj *postgresImpl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.CountryCode, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorWalletFeatures, &overlay_cache_node.OperatorEmailValidatedAt, &overlay_cache_node.OperatorWalletValidatedAt, &overlay_cache_node.IngressRate, &overlay_cache_node.EgressRate, &overlay_cache_node.UploadSuccessRatio, &overlay_cache_node.DownloadSuccessRatio, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -3245,7 +6728,7 @@ func (obj *postgresImpl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx c
 	limit int, offset int64) (
 	rows []*OverlayCacheNode, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.country_code, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_wallet_features, overlay_cache_nodes.operator_email_validated_at, overlay_cache_nodes.operator_wallet_validated_at, overlay_cache_nodes.ingress_rate, overlay_cache_nodes.egress_rate, overlay_cache_nodes.upload_success_ratio, overlay_cache_nodes.download_success_ratio, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.updated_at FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, overlay_cache_node_node_id_greater_or_equal.value())
@@ -3263,7 +6746,7 @@ func (obj *postgresImpl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx c
 
 	for __rows.Next() {
 		overlay_cache_node := &OverlayCacheNode{}
-		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.CountryCode, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorWalletFeatures, &overlay_cache_node.OperatorEmailValidatedAt, &overlay_cache_node.OperatorWalletValidatedAt, &overlay_cache_node.IngressRate, &overlay_cache_node.EgressRate, &overlay_cache_node.UploadSuccessRatio, &overlay_cache_node.DownloadSuccessRatio, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.UpdatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -3279,7 +6762,7 @@ func (obj *postgresImpl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx c
 func (obj *postgresImpl) First_Injuredsegment(ctx context.Context) (
 	injuredsegment *Injuredsegment, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT 1 OFFSET 0")
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info, injuredsegments.leased_until FROM injuredsegments LIMIT 1 OFFSET 0")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -3301,7 +6784,7 @@ func (obj *postgresImpl) First_Injuredsegment(ctx context.Context) (
 	}
 
 	injuredsegment = &Injuredsegment{}
-	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
+	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info, &injuredsegment.LeasedUntil)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -3314,7 +6797,7 @@ func (obj *postgresImpl) Limited_Injuredsegment(ctx context.Context,
 	limit int, offset int64) (
 	rows []*Injuredsegment, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info, injuredsegments.leased_until FROM injuredsegments LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -3332,7 +6815,7 @@ func (obj *postgresImpl) Limited_Injuredsegment(ctx context.Context,
 
 	for __rows.Next() {
 		injuredsegment := &Injuredsegment{}
-		err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
+		err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info, &injuredsegment.LeasedUntil)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -3645,6 +7128,196 @@ func (obj *postgresImpl) Get_CertRecord_By_Id(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) All_AbuseBlock_By_ExpiresAt_Greater(ctx context.Context,
+	abuse_block_expires_at_greater AbuseBlock_ExpiresAt_Field) (
+	rows []*AbuseBlock, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT abuse_blocks.id, abuse_blocks.kind, abuse_blocks.value, abuse_blocks.reason, abuse_blocks.created_by, abuse_blocks.created_at, abuse_blocks.expires_at FROM abuse_blocks WHERE abuse_blocks.expires_at > ?")
+
+	var __values []interface{}
+	__values = append(__values, abuse_block_expires_at_greater.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		abuse_block := &AbuseBlock{}
+		err = __rows.Scan(&abuse_block.Id, &abuse_block.Kind, &abuse_block.Value, &abuse_block.Reason, &abuse_block.CreatedBy, &abuse_block.CreatedAt, &abuse_block.ExpiresAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, abuse_block)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Limited_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_Id(ctx context.Context,
+	audit_log_created_at_greater_or_equal AuditLog_CreatedAt_Field,
+	audit_log_created_at_less AuditLog_CreatedAt_Field,
+	limit int, offset int64) (
+	rows []*AuditLog, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_logs.id, audit_logs.actor, audit_logs.action, audit_logs.target, audit_logs.remote_addr, audit_logs.created_at FROM audit_logs WHERE audit_logs.created_at >= ? AND audit_logs.created_at < ? ORDER BY audit_logs.id LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, audit_log_created_at_greater_or_equal.value(), audit_log_created_at_less.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		audit_log := &AuditLog{}
+		err = __rows.Scan(&audit_log.Id, &audit_log.Actor, &audit_log.Action, &audit_log.Target, &audit_log.RemoteAddr, &audit_log.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, audit_log)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) Get_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+	project_deletion *ProjectDeletion, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.deleted_segments, project_deletions.deleted_bytes, project_deletions.requested_at, project_deletions.finished_at FROM project_deletions WHERE project_deletions.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_deletion_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_deletion = &ProjectDeletion{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_deletion.ProjectId, &project_deletion.DeletedSegments, &project_deletion.DeletedBytes, &project_deletion.RequestedAt, &project_deletion.FinishedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_deletion, nil
+
+}
+
+func (obj *postgresImpl) All_ProjectDeletion_OrderBy_Asc_RequestedAt(ctx context.Context) (
+	rows []*ProjectDeletion, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.deleted_segments, project_deletions.deleted_bytes, project_deletions.requested_at, project_deletions.finished_at FROM project_deletions ORDER BY project_deletions.requested_at")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_deletion := &ProjectDeletion{}
+		err = __rows.Scan(&project_deletion.ProjectId, &project_deletion.DeletedSegments, &project_deletion.DeletedBytes, &project_deletion.RequestedAt, &project_deletion.FinishedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_deletion)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_NodeTag_By_NodeId(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field) (
+	rows []*NodeTag, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_tags.node_id, node_tags.name, node_tags.value, node_tags.signer_id, node_tags.tag FROM node_tags WHERE node_tags.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_tag_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node_tag := &NodeTag{}
+		err = __rows.Scan(&node_tag.NodeId, &node_tag.Name, &node_tag.Value, &node_tag.SignerId, &node_tag.Tag)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node_tag)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_PrefixQuota_By_ProjectId_And_BucketName(ctx context.Context,
+	prefix_quota_project_id PrefixQuota_ProjectId_Field,
+	prefix_quota_bucket_name PrefixQuota_BucketName_Field) (
+	rows []*PrefixQuota, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT prefix_quotas.project_id, prefix_quotas.bucket_name, prefix_quotas.prefix, prefix_quotas.max_bytes, prefix_quotas.max_objects FROM prefix_quotas WHERE prefix_quotas.project_id = ? AND prefix_quotas.bucket_name = ?")
+
+	var __values []interface{}
+	__values = append(__values, prefix_quota_project_id.value(), prefix_quota_bucket_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		prefix_quota := &PrefixQuota{}
+		err = __rows.Scan(&prefix_quota.ProjectId, &prefix_quota.BucketName, &prefix_quota.Prefix, &prefix_quota.MaxBytes, &prefix_quota.MaxObjects)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, prefix_quota)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
 func (obj *postgresImpl) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 	update Irreparabledb_Update_Fields) (
@@ -3746,7 +7419,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.last_contact_success, nodes.last_contact_failure, nodes.vetted_at, nodes.disqualified_at, nodes.disqualification_reason, nodes.created_at, nodes.updated_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -3767,6 +7440,16 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
 	}
 
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
 	if update.UptimeSuccessCount._set {
 		__values = append(__values, update.UptimeSuccessCount.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
@@ -3782,6 +7465,41 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_ratio = ?"))
 	}
 
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
+	if update.LastContactSuccess._set {
+		__values = append(__values, update.LastContactSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
+	}
+
+	if update.LastContactFailure._set {
+		__values = append(__values, update.LastContactFailure.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
+	}
+
+	if update.VettedAt._set {
+		__values = append(__values, update.VettedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	}
+
+	if update.DisqualifiedAt._set {
+		__values = append(__values, update.DisqualifiedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified_at = ?"))
+	}
+
+	if update.DisqualificationReason._set {
+		__values = append(__values, update.DisqualificationReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -3796,7 +7514,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.LastContactSuccess, &node.LastContactFailure, &node.VettedAt, &node.DisqualifiedAt, &node.DisqualificationReason, &node.CreatedAt, &node.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -3812,7 +7530,7 @@ func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 	overlay_cache_node *OverlayCacheNode, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE overlay_cache_nodes SET "), __sets, __sqlbundle_Literal(" WHERE overlay_cache_nodes.node_id = ? RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE overlay_cache_nodes SET "), __sets, __sqlbundle_Literal(" WHERE overlay_cache_nodes.node_id = ? RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.country_code, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_wallet_features, overlay_cache_nodes.operator_email_validated_at, overlay_cache_nodes.operator_wallet_validated_at, overlay_cache_nodes.ingress_rate, overlay_cache_nodes.egress_rate, overlay_cache_nodes.upload_success_ratio, overlay_cache_nodes.download_success_ratio, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.updated_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -3828,6 +7546,11 @@ func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
 	}
 
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
 	if update.OperatorEmail._set {
 		__values = append(__values, update.OperatorEmail.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_email = ?"))
@@ -3838,6 +7561,41 @@ func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet = ?"))
 	}
 
+	if update.OperatorWalletFeatures._set {
+		__values = append(__values, update.OperatorWalletFeatures.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet_features = ?"))
+	}
+
+	if update.OperatorEmailValidatedAt._set {
+		__values = append(__values, update.OperatorEmailValidatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_email_validated_at = ?"))
+	}
+
+	if update.OperatorWalletValidatedAt._set {
+		__values = append(__values, update.OperatorWalletValidatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet_validated_at = ?"))
+	}
+
+	if update.IngressRate._set {
+		__values = append(__values, update.IngressRate.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ingress_rate = ?"))
+	}
+
+	if update.EgressRate._set {
+		__values = append(__values, update.EgressRate.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_rate = ?"))
+	}
+
+	if update.UploadSuccessRatio._set {
+		__values = append(__values, update.UploadSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("upload_success_ratio = ?"))
+	}
+
+	if update.DownloadSuccessRatio._set {
+		__values = append(__values, update.DownloadSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("download_success_ratio = ?"))
+	}
+
 	if update.FreeBandwidth._set {
 		__values = append(__values, update.FreeBandwidth.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_bandwidth = ?"))
@@ -3883,9 +7641,10 @@ func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
 	}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
-	}
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
 
 	__args = append(__args, overlay_cache_node_node_id.value())
 
@@ -3896,7 +7655,7 @@ func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.CountryCode, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorWalletFeatures, &overlay_cache_node.OperatorEmailValidatedAt, &overlay_cache_node.OperatorWalletValidatedAt, &overlay_cache_node.IngressRate, &overlay_cache_node.EgressRate, &overlay_cache_node.UploadSuccessRatio, &overlay_cache_node.DownloadSuccessRatio, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -4077,6 +7836,56 @@ func (obj *postgresImpl) Update_CertRecord_By_Id(ctx context.Context,
 	return certRecord, nil
 }
 
+func (obj *postgresImpl) Update_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	update ProjectDeletion_Update_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_deletions SET "), __sets, __sqlbundle_Literal(" WHERE project_deletions.project_id = ? RETURNING project_deletions.project_id, project_deletions.deleted_segments, project_deletions.deleted_bytes, project_deletions.requested_at, project_deletions.finished_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.DeletedSegments._set {
+		__values = append(__values, update.DeletedSegments.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("deleted_segments = ?"))
+	}
+
+	if update.DeletedBytes._set {
+		__values = append(__values, update.DeletedBytes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("deleted_bytes = ?"))
+	}
+
+	if update.FinishedAt._set {
+		__values = append(__values, update.FinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("finished_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_deletion_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_deletion = &ProjectDeletion{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_deletion.ProjectId, &project_deletion.DeletedSegments, &project_deletion.DeletedBytes, &project_deletion.RequestedAt, &project_deletion.FinishedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_deletion, nil
+}
+
 func (obj *postgresImpl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 	deleted bool, err error) {
@@ -4364,6 +8173,87 @@ func (obj *postgresImpl) Delete_CertRecord_By_Id(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) Delete_AbuseBlock_By_Id(ctx context.Context,
+	abuse_block_id AbuseBlock_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM abuse_blocks WHERE abuse_blocks.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, abuse_block_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_NodeTag_By_NodeId_And_Name(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field,
+	node_tag_name NodeTag_Name_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_tags WHERE node_tags.node_id = ? AND node_tags.name = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_tag_node_id.value(), node_tag_name.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *postgresImpl) Delete_PrefixQuota_By_ProjectId_And_BucketName_And_Prefix(ctx context.Context,
+	prefix_quota_project_id PrefixQuota_ProjectId_Field,
+	prefix_quota_bucket_name PrefixQuota_BucketName_Field,
+	prefix_quota_prefix PrefixQuota_Prefix_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM prefix_quotas WHERE prefix_quotas.project_id = ? AND prefix_quotas.bucket_name = ? AND prefix_quotas.prefix = ?")
+
+	var __values []interface{}
+	__values = append(__values, prefix_quota_project_id.value(), prefix_quota_bucket_name.value(), prefix_quota_prefix.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
 func (impl postgresImpl) isConstraintError(err error) (
 	constraint string, ok bool) {
 	if e, ok := err.(*pq.Error); ok {
@@ -4402,6 +8292,26 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM sample_snapshots;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM repair_slo_days;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -4412,6 +8322,86 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_tiers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_salts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_exports;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_deletions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM pricing_tiers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM prefix_quotas;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM pending_audits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -4422,6 +8412,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM object_egress_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -4432,6 +8432,26 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -4452,6 +8472,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM egress_attributions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -4472,6 +8502,66 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreement_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreement_archives;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bwagreement_anomalies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_locks;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM audit_logs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM address_mismatches;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -4487,7 +8577,17 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM accounting_rollups;")
+	__res, err = obj.driver.Exec("DELETE FROM accounting_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM accounting_raws;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -4497,7 +8597,7 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM accounting_raws;")
+	__res, err = obj.driver.Exec("DELETE FROM abuse_blocks;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -4672,9 +8772,18 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	node_audit_success_count Node_AuditSuccessCount_Field,
 	node_total_audit_count Node_TotalAuditCount_Field,
 	node_audit_success_ratio Node_AuditSuccessRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
 	node_uptime_success_count Node_UptimeSuccessCount_Field,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
-	node_uptime_ratio Node_UptimeRatio_Field) (
+	node_uptime_ratio Node_UptimeRatio_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	node_last_contact_success Node_LastContactSuccess_Field,
+	node_last_contact_failure Node_LastContactFailure_Field,
+	node_vetted_at Node_VettedAt_Field,
+	node_disqualified_at Node_DisqualifiedAt_Field,
+	node_disqualification_reason Node_DisqualificationReason_Field) (
 	node *Node, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -4682,18 +8791,27 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	__audit_success_count_val := node_audit_success_count.value()
 	__total_audit_count_val := node_total_audit_count.value()
 	__audit_success_ratio_val := node_audit_success_ratio.value()
+	__audit_reputation_alpha_val := node_audit_reputation_alpha.value()
+	__audit_reputation_beta_val := node_audit_reputation_beta.value()
 	__uptime_success_count_val := node_uptime_success_count.value()
 	__total_uptime_count_val := node_total_uptime_count.value()
 	__uptime_ratio_val := node_uptime_ratio.value()
+	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
+	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
+	__last_contact_success_val := node_last_contact_success.value()
+	__last_contact_failure_val := node_last_contact_failure.value()
+	__vetted_at_val := node_vetted_at.value()
+	__disqualified_at_val := node_disqualified_at.value()
+	__disqualification_reason_val := node_disqualification_reason.value()
 	__created_at_val := __now
 	__updated_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, audit_reputation_alpha, audit_reputation_beta, uptime_success_count, total_uptime_count, uptime_ratio, uptime_reputation_alpha, uptime_reputation_beta, last_contact_success, last_contact_failure, vetted_at, disqualified_at, disqualification_reason, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val)
+	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __last_contact_success_val, __last_contact_failure_val, __vetted_at_val, __disqualified_at_val, __disqualification_reason_val, __created_at_val, __updated_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __last_contact_success_val, __last_contact_failure_val, __vetted_at_val, __disqualified_at_val, __disqualification_reason_val, __created_at_val, __updated_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4710,8 +8828,16 @@ func (obj *sqlite3Impl) Create_OverlayCacheNode(ctx context.Context,
 	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
 	overlay_cache_node_address OverlayCacheNode_Address_Field,
 	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+	overlay_cache_node_country_code OverlayCacheNode_CountryCode_Field,
 	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
 	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+	overlay_cache_node_operator_wallet_features OverlayCacheNode_OperatorWalletFeatures_Field,
+	overlay_cache_node_operator_email_validated_at OverlayCacheNode_OperatorEmailValidatedAt_Field,
+	overlay_cache_node_operator_wallet_validated_at OverlayCacheNode_OperatorWalletValidatedAt_Field,
+	overlay_cache_node_ingress_rate OverlayCacheNode_IngressRate_Field,
+	overlay_cache_node_egress_rate OverlayCacheNode_EgressRate_Field,
+	overlay_cache_node_upload_success_ratio OverlayCacheNode_UploadSuccessRatio_Field,
+	overlay_cache_node_download_success_ratio OverlayCacheNode_DownloadSuccessRatio_Field,
 	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
 	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
 	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
@@ -4722,12 +8848,22 @@ func (obj *sqlite3Impl) Create_OverlayCacheNode(ctx context.Context,
 	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
 	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
 	overlay_cache_node *OverlayCacheNode, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
 	__node_id_val := overlay_cache_node_node_id.value()
 	__node_type_val := overlay_cache_node_node_type.value()
 	__address_val := overlay_cache_node_address.value()
 	__protocol_val := overlay_cache_node_protocol.value()
+	__country_code_val := overlay_cache_node_country_code.value()
 	__operator_email_val := overlay_cache_node_operator_email.value()
 	__operator_wallet_val := overlay_cache_node_operator_wallet.value()
+	__operator_wallet_features_val := overlay_cache_node_operator_wallet_features.value()
+	__operator_email_validated_at_val := overlay_cache_node_operator_email_validated_at.value()
+	__operator_wallet_validated_at_val := overlay_cache_node_operator_wallet_validated_at.value()
+	__ingress_rate_val := overlay_cache_node_ingress_rate.value()
+	__egress_rate_val := overlay_cache_node_egress_rate.value()
+	__upload_success_ratio_val := overlay_cache_node_upload_success_ratio.value()
+	__download_success_ratio_val := overlay_cache_node_download_success_ratio.value()
 	__free_bandwidth_val := overlay_cache_node_free_bandwidth.value()
 	__free_disk_val := overlay_cache_node_free_disk.value()
 	__latency_90_val := overlay_cache_node_latency_90.value()
@@ -4737,13 +8873,14 @@ func (obj *sqlite3Impl) Create_OverlayCacheNode(ctx context.Context,
 	__audit_success_count_val := overlay_cache_node_audit_success_count.value()
 	__uptime_count_val := overlay_cache_node_uptime_count.value()
 	__uptime_success_count_val := overlay_cache_node_uptime_success_count.value()
+	__updated_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, operator_email, operator_wallet, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, country_code, operator_email, operator_wallet, operator_wallet_features, operator_email_validated_at, operator_wallet_validated_at, ingress_rate, egress_rate, upload_success_ratio, download_success_ratio, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val)
+	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __country_code_val, __operator_email_val, __operator_wallet_val, __operator_wallet_features_val, __operator_email_validated_at_val, __operator_wallet_validated_at_val, __ingress_rate_val, __egress_rate_val, __upload_success_ratio_val, __download_success_ratio_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __updated_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val)
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __country_code_val, __operator_email_val, __operator_wallet_val, __operator_wallet_features_val, __operator_email_validated_at_val, __operator_wallet_validated_at_val, __ingress_rate_val, __egress_rate_val, __upload_success_ratio_val, __download_success_ratio_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __updated_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4756,16 +8893,18 @@ func (obj *sqlite3Impl) Create_OverlayCacheNode(ctx context.Context,
 }
 
 func (obj *sqlite3Impl) Create_Injuredsegment(ctx context.Context,
-	injuredsegment_info Injuredsegment_Info_Field) (
+	injuredsegment_info Injuredsegment_Info_Field,
+	injuredsegment_leased_until Injuredsegment_LeasedUntil_Field) (
 	injuredsegment *Injuredsegment, err error) {
 	__info_val := injuredsegment_info.value()
+	__leased_until_val := injuredsegment_leased_until.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( info ) VALUES ( ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO injuredsegments ( info, leased_until ) VALUES ( ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __info_val)
+	obj.logStmt(__stmt, __info_val, __leased_until_val)
 
-	__res, err := obj.driver.Exec(__stmt, __info_val)
+	__res, err := obj.driver.Exec(__stmt, __info_val, __leased_until_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4924,6 +9063,160 @@ func (obj *sqlite3Impl) Create_CertRecord(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Create_AbuseBlock(ctx context.Context,
+	abuse_block_kind AbuseBlock_Kind_Field,
+	abuse_block_value AbuseBlock_Value_Field,
+	abuse_block_reason AbuseBlock_Reason_Field,
+	abuse_block_created_by AbuseBlock_CreatedBy_Field,
+	abuse_block_created_at AbuseBlock_CreatedAt_Field,
+	abuse_block_expires_at AbuseBlock_ExpiresAt_Field) (
+	abuse_block *AbuseBlock, err error) {
+	__kind_val := abuse_block_kind.value()
+	__value_val := abuse_block_value.value()
+	__reason_val := abuse_block_reason.value()
+	__created_by_val := abuse_block_created_by.value()
+	__created_at_val := abuse_block_created_at.value()
+	__expires_at_val := abuse_block_expires_at.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO abuse_blocks ( kind, value, reason, created_by, created_at, expires_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __kind_val, __value_val, __reason_val, __created_by_val, __created_at_val, __expires_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __kind_val, __value_val, __reason_val, __created_by_val, __created_at_val, __expires_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAbuseBlock(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_AuditLog(ctx context.Context,
+	audit_log_actor AuditLog_Actor_Field,
+	audit_log_action AuditLog_Action_Field,
+	audit_log_target AuditLog_Target_Field,
+	audit_log_remote_addr AuditLog_RemoteAddr_Field) (
+	audit_log *AuditLog, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__actor_val := audit_log_actor.value()
+	__action_val := audit_log_action.value()
+	__target_val := audit_log_target.value()
+	__remote_addr_val := audit_log_remote_addr.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO audit_logs ( actor, action, target, remote_addr, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __actor_val, __action_val, __target_val, __remote_addr_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __actor_val, __action_val, __target_val, __remote_addr_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAuditLog(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_ProjectDeletion(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	project_deletion_deleted_segments ProjectDeletion_DeletedSegments_Field,
+	project_deletion_deleted_bytes ProjectDeletion_DeletedBytes_Field,
+	optional ProjectDeletion_Create_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_deletion_project_id.value()
+	__deleted_segments_val := project_deletion_deleted_segments.value()
+	__deleted_bytes_val := project_deletion_deleted_bytes.value()
+	__requested_at_val := __now
+	__finished_at_val := optional.FinishedAt.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_deletions ( project_id, deleted_segments, deleted_bytes, requested_at, finished_at ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __deleted_segments_val, __deleted_bytes_val, __requested_at_val, __finished_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __deleted_segments_val, __deleted_bytes_val, __requested_at_val, __finished_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProjectDeletion(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_NodeTag(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field,
+	node_tag_name NodeTag_Name_Field,
+	node_tag_value NodeTag_Value_Field,
+	node_tag_signer_id NodeTag_SignerId_Field,
+	node_tag_tag NodeTag_Tag_Field) (
+	node_tag *NodeTag, err error) {
+	__node_id_val := node_tag_node_id.value()
+	__name_val := node_tag_name.value()
+	__value_val := node_tag_value.value()
+	__signer_id_val := node_tag_signer_id.value()
+	__tag_val := node_tag_tag.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_tags ( node_id, name, value, signer_id, tag ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __name_val, __value_val, __signer_id_val, __tag_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __name_val, __value_val, __signer_id_val, __tag_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastNodeTag(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_PrefixQuota(ctx context.Context,
+	prefix_quota_project_id PrefixQuota_ProjectId_Field,
+	prefix_quota_bucket_name PrefixQuota_BucketName_Field,
+	prefix_quota_prefix PrefixQuota_Prefix_Field,
+	prefix_quota_max_bytes PrefixQuota_MaxBytes_Field,
+	prefix_quota_max_objects PrefixQuota_MaxObjects_Field) (
+	prefix_quota *PrefixQuota, err error) {
+	__project_id_val := prefix_quota_project_id.value()
+	__bucket_name_val := prefix_quota_bucket_name.value()
+	__prefix_val := prefix_quota_prefix.value()
+	__max_bytes_val := prefix_quota_max_bytes.value()
+	__max_objects_val := prefix_quota_max_objects.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO prefix_quotas ( project_id, bucket_name, prefix, max_bytes, max_objects ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __prefix_val, __max_bytes_val, __max_objects_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __bucket_name_val, __prefix_val, __max_bytes_val, __max_objects_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastPrefixQuota(ctx, __pk)
+
+}
+
 func (obj *sqlite3Impl) Limited_Bwagreement(ctx context.Context,
 	limit int, offset int64) (
 	rows []*Bwagreement, err error) {
@@ -5213,7 +9506,7 @@ func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.last_contact_success, nodes.last_contact_failure, nodes.vetted_at, nodes.disqualified_at, nodes.disqualification_reason, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -5222,7 +9515,7 @@ func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.LastContactSuccess, &node.LastContactFailure, &node.VettedAt, &node.DisqualifiedAt, &node.DisqualificationReason, &node.CreatedAt, &node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5266,7 +9559,7 @@ func (obj *sqlite3Impl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
 	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
 	overlay_cache_node *OverlayCacheNode, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.country_code, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_wallet_features, overlay_cache_nodes.operator_email_validated_at, overlay_cache_nodes.operator_wallet_validated_at, overlay_cache_nodes.ingress_rate, overlay_cache_nodes.egress_rate, overlay_cache_nodes.upload_success_ratio, overlay_cache_nodes.download_success_ratio, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.updated_at FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, overlay_cache_node_node_id.value())
@@ -5275,7 +9568,7 @@ func (obj *sqlite3Impl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.CountryCode, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorWalletFeatures, &overlay_cache_node.OperatorEmailValidatedAt, &overlay_cache_node.OperatorWalletValidatedAt, &overlay_cache_node.IngressRate, &overlay_cache_node.EgressRate, &overlay_cache_node.UploadSuccessRatio, &overlay_cache_node.DownloadSuccessRatio, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5309,7 +9602,7 @@ func (obj *sqlite3Impl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx co
 	limit int, offset int64) (
 	rows []*OverlayCacheNode, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.country_code, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_wallet_features, overlay_cache_nodes.operator_email_validated_at, overlay_cache_nodes.operator_wallet_validated_at, overlay_cache_nodes.ingress_rate, overlay_cache_nodes.egress_rate, overlay_cache_nodes.upload_success_ratio, overlay_cache_nodes.download_success_ratio, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.updated_at FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, overlay_cache_node_node_id_greater_or_equal.value())
@@ -5327,7 +9620,7 @@ func (obj *sqlite3Impl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx co
 
 	for __rows.Next() {
 		overlay_cache_node := &OverlayCacheNode{}
-		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.CountryCode, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorWalletFeatures, &overlay_cache_node.OperatorEmailValidatedAt, &overlay_cache_node.OperatorWalletValidatedAt, &overlay_cache_node.IngressRate, &overlay_cache_node.EgressRate, &overlay_cache_node.UploadSuccessRatio, &overlay_cache_node.DownloadSuccessRatio, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.UpdatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5343,7 +9636,7 @@ func (obj *sqlite3Impl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx co
 func (obj *sqlite3Impl) First_Injuredsegment(ctx context.Context) (
 	injuredsegment *Injuredsegment, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT 1 OFFSET 0")
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info, injuredsegments.leased_until FROM injuredsegments LIMIT 1 OFFSET 0")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -5365,7 +9658,7 @@ func (obj *sqlite3Impl) First_Injuredsegment(ctx context.Context) (
 	}
 
 	injuredsegment = &Injuredsegment{}
-	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
+	err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info, &injuredsegment.LeasedUntil)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5378,7 +9671,7 @@ func (obj *sqlite3Impl) Limited_Injuredsegment(ctx context.Context,
 	limit int, offset int64) (
 	rows []*Injuredsegment, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info, injuredsegments.leased_until FROM injuredsegments LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -5396,7 +9689,7 @@ func (obj *sqlite3Impl) Limited_Injuredsegment(ctx context.Context,
 
 	for __rows.Next() {
 		injuredsegment := &Injuredsegment{}
-		err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info)
+		err = __rows.Scan(&injuredsegment.Id, &injuredsegment.Info, &injuredsegment.LeasedUntil)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5515,43 +9808,208 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+
+	var __values []interface{}
+	__values = append(__values, project_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project := &Project{}
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field) (
+	rows []*ProjectMember, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_member_member_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_member := &ProjectMember{}
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_member)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
+	project_member_project_id ProjectMember_ProjectId_Field,
+	limit int, offset int64) (
+	rows []*ProjectMember, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+	__values = append(__values, project_member_project_id.value())
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_member := &ProjectMember{}
+		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_member)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
+	api_key *ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *sqlite3Impl) Get_ApiKey_By_Key(ctx context.Context,
+	api_key_key ApiKey_Key_Field) (
+	api_key *ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+
+	var __values []interface{}
+	__values = append(__values, api_key_key.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
+	api_key_project_id ApiKey_ProjectId_Field) (
+	rows []*ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
+
+	var __values []interface{}
+	__values = append(__values, api_key_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		api_key := &ApiKey{}
+		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, api_key)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) Get_CertRecord_By_Id(ctx context.Context,
+	certRecord_id CertRecord_Id_Field) (
+	certRecord *CertRecord, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT certRecords.publickey, certRecords.id, certRecords.update_at FROM certRecords WHERE certRecords.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value())
+	__values = append(__values, certRecord_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	__rows, err := obj.driver.Query(__stmt, __values...)
+	certRecord = &CertRecord{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	defer __rows.Close()
-
-	for __rows.Next() {
-		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.CreatedAt)
-		if err != nil {
-			return nil, obj.makeErr(err)
-		}
-		rows = append(rows, project)
-	}
-	if err := __rows.Err(); err != nil {
-		return nil, obj.makeErr(err)
-	}
-	return rows, nil
+	return certRecord, nil
 
 }
 
-func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field) (
-	rows []*ProjectMember, err error) {
+func (obj *sqlite3Impl) All_AbuseBlock_By_ExpiresAt_Greater(ctx context.Context,
+	abuse_block_expires_at_greater AbuseBlock_ExpiresAt_Field) (
+	rows []*AbuseBlock, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.member_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT abuse_blocks.id, abuse_blocks.kind, abuse_blocks.value, abuse_blocks.reason, abuse_blocks.created_by, abuse_blocks.created_at, abuse_blocks.expires_at FROM abuse_blocks WHERE abuse_blocks.expires_at > ?")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value())
+	__values = append(__values, abuse_block_expires_at_greater.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5563,12 +10021,12 @@ func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
 	defer __rows.Close()
 
 	for __rows.Next() {
-		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		abuse_block := &AbuseBlock{}
+		err = __rows.Scan(&abuse_block.Id, &abuse_block.Kind, &abuse_block.Value, &abuse_block.Reason, &abuse_block.CreatedBy, &abuse_block.CreatedAt, &abuse_block.ExpiresAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, project_member)
+		rows = append(rows, abuse_block)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -5577,15 +10035,16 @@ func (obj *sqlite3Impl) All_ProjectMember_By_MemberId(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
-	project_member_project_id ProjectMember_ProjectId_Field,
+func (obj *sqlite3Impl) Limited_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_Id(ctx context.Context,
+	audit_log_created_at_greater_or_equal AuditLog_CreatedAt_Field,
+	audit_log_created_at_less AuditLog_CreatedAt_Field,
 	limit int, offset int64) (
-	rows []*ProjectMember, err error) {
+	rows []*AuditLog, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT project_members.member_id, project_members.project_id, project_members.created_at FROM project_members WHERE project_members.project_id = ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_logs.id, audit_logs.actor, audit_logs.action, audit_logs.target, audit_logs.remote_addr, audit_logs.created_at FROM audit_logs WHERE audit_logs.created_at >= ? AND audit_logs.created_at < ? ORDER BY audit_logs.id LIMIT ? OFFSET ?")
 
 	var __values []interface{}
-	__values = append(__values, project_member_project_id.value())
+	__values = append(__values, audit_log_created_at_greater_or_equal.value(), audit_log_created_at_less.value())
 
 	__values = append(__values, limit, offset)
 
@@ -5599,12 +10058,12 @@ func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 	defer __rows.Close()
 
 	for __rows.Next() {
-		project_member := &ProjectMember{}
-		err = __rows.Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
+		audit_log := &AuditLog{}
+		err = __rows.Scan(&audit_log.Id, &audit_log.Actor, &audit_log.Action, &audit_log.Target, &audit_log.RemoteAddr, &audit_log.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, project_member)
+		rows = append(rows, audit_log)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -5613,56 +10072,67 @@ func (obj *sqlite3Impl) Limited_ProjectMember_By_ProjectId(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Get_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
-	api_key *ApiKey, err error) {
+func (obj *sqlite3Impl) Get_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+	project_deletion *ProjectDeletion, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.deleted_segments, project_deletions.deleted_bytes, project_deletions.requested_at, project_deletions.finished_at FROM project_deletions WHERE project_deletions.project_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, api_key_id.value())
+	__values = append(__values, project_deletion_project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	project_deletion = &ProjectDeletion{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_deletion.ProjectId, &project_deletion.DeletedSegments, &project_deletion.DeletedBytes, &project_deletion.RequestedAt, &project_deletion.FinishedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return api_key, nil
+	return project_deletion, nil
 
 }
 
-func (obj *sqlite3Impl) Get_ApiKey_By_Key(ctx context.Context,
-	api_key_key ApiKey_Key_Field) (
-	api_key *ApiKey, err error) {
+func (obj *sqlite3Impl) All_ProjectDeletion_OrderBy_Asc_RequestedAt(ctx context.Context) (
+	rows []*ProjectDeletion, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.key = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.deleted_segments, project_deletions.deleted_bytes, project_deletions.requested_at, project_deletions.finished_at FROM project_deletions ORDER BY project_deletions.requested_at")
 
 	var __values []interface{}
-	__values = append(__values, api_key_key.value())
+	__values = append(__values)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return api_key, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		project_deletion := &ProjectDeletion{}
+		err = __rows.Scan(&project_deletion.ProjectId, &project_deletion.DeletedSegments, &project_deletion.DeletedBytes, &project_deletion.RequestedAt, &project_deletion.FinishedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, project_deletion)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
-	api_key_project_id ApiKey_ProjectId_Field) (
-	rows []*ApiKey, err error) {
+func (obj *sqlite3Impl) All_NodeTag_By_NodeId(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field) (
+	rows []*NodeTag, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE api_keys.project_id = ? ORDER BY api_keys.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_tags.node_id, node_tags.name, node_tags.value, node_tags.signer_id, node_tags.tag FROM node_tags WHERE node_tags.node_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, api_key_project_id.value())
+	__values = append(__values, node_tag_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -5674,12 +10144,12 @@ func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Con
 	defer __rows.Close()
 
 	for __rows.Next() {
-		api_key := &ApiKey{}
-		err = __rows.Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+		node_tag := &NodeTag{}
+		err = __rows.Scan(&node_tag.NodeId, &node_tag.Name, &node_tag.Value, &node_tag.SignerId, &node_tag.Tag)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, api_key)
+		rows = append(rows, node_tag)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -5688,24 +10158,37 @@ func (obj *sqlite3Impl) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Con
 
 }
 
-func (obj *sqlite3Impl) Get_CertRecord_By_Id(ctx context.Context,
-	certRecord_id CertRecord_Id_Field) (
-	certRecord *CertRecord, err error) {
+func (obj *sqlite3Impl) All_PrefixQuota_By_ProjectId_And_BucketName(ctx context.Context,
+	prefix_quota_project_id PrefixQuota_ProjectId_Field,
+	prefix_quota_bucket_name PrefixQuota_BucketName_Field) (
+	rows []*PrefixQuota, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT certRecords.publickey, certRecords.id, certRecords.update_at FROM certRecords WHERE certRecords.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT prefix_quotas.project_id, prefix_quotas.bucket_name, prefix_quotas.prefix, prefix_quotas.max_bytes, prefix_quotas.max_objects FROM prefix_quotas WHERE prefix_quotas.project_id = ? AND prefix_quotas.bucket_name = ?")
 
 	var __values []interface{}
-	__values = append(__values, certRecord_id.value())
+	__values = append(__values, prefix_quota_project_id.value(), prefix_quota_bucket_name.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	certRecord = &CertRecord{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	__rows, err := obj.driver.Query(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return certRecord, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		prefix_quota := &PrefixQuota{}
+		err = __rows.Scan(&prefix_quota.ProjectId, &prefix_quota.BucketName, &prefix_quota.Prefix, &prefix_quota.MaxBytes, &prefix_quota.MaxObjects)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, prefix_quota)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
@@ -5851,6 +10334,16 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
 	}
 
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
 	if update.UptimeSuccessCount._set {
 		__values = append(__values, update.UptimeSuccessCount.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
@@ -5866,6 +10359,41 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_ratio = ?"))
 	}
 
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
+	if update.LastContactSuccess._set {
+		__values = append(__values, update.LastContactSuccess.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_success = ?"))
+	}
+
+	if update.LastContactFailure._set {
+		__values = append(__values, update.LastContactFailure.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_contact_failure = ?"))
+	}
+
+	if update.VettedAt._set {
+		__values = append(__values, update.VettedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("vetted_at = ?"))
+	}
+
+	if update.DisqualifiedAt._set {
+		__values = append(__values, update.DisqualifiedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualified_at = ?"))
+	}
+
+	if update.DisqualificationReason._set {
+		__values = append(__values, update.DisqualificationReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("disqualification_reason = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -5885,12 +10413,12 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.last_contact_success, nodes.last_contact_failure, nodes.vetted_at, nodes.disqualified_at, nodes.disqualification_reason, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.LastContactSuccess, &node.LastContactFailure, &node.VettedAt, &node.DisqualifiedAt, &node.DisqualificationReason, &node.CreatedAt, &node.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -5922,6 +10450,11 @@ func (obj *sqlite3Impl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocol = ?"))
 	}
 
+	if update.CountryCode._set {
+		__values = append(__values, update.CountryCode.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("country_code = ?"))
+	}
+
 	if update.OperatorEmail._set {
 		__values = append(__values, update.OperatorEmail.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_email = ?"))
@@ -5932,6 +10465,41 @@ func (obj *sqlite3Impl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet = ?"))
 	}
 
+	if update.OperatorWalletFeatures._set {
+		__values = append(__values, update.OperatorWalletFeatures.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet_features = ?"))
+	}
+
+	if update.OperatorEmailValidatedAt._set {
+		__values = append(__values, update.OperatorEmailValidatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_email_validated_at = ?"))
+	}
+
+	if update.OperatorWalletValidatedAt._set {
+		__values = append(__values, update.OperatorWalletValidatedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("operator_wallet_validated_at = ?"))
+	}
+
+	if update.IngressRate._set {
+		__values = append(__values, update.IngressRate.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ingress_rate = ?"))
+	}
+
+	if update.EgressRate._set {
+		__values = append(__values, update.EgressRate.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_rate = ?"))
+	}
+
+	if update.UploadSuccessRatio._set {
+		__values = append(__values, update.UploadSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("upload_success_ratio = ?"))
+	}
+
+	if update.DownloadSuccessRatio._set {
+		__values = append(__values, update.DownloadSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("download_success_ratio = ?"))
+	}
+
 	if update.FreeBandwidth._set {
 		__values = append(__values, update.FreeBandwidth.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("free_bandwidth = ?"))
@@ -5977,9 +10545,10 @@ func (obj *sqlite3Impl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
 	}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
-	}
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
 
 	__args = append(__args, overlay_cache_node_node_id.value())
 
@@ -5995,12 +10564,12 @@ func (obj *sqlite3Impl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.country_code, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_wallet_features, overlay_cache_nodes.operator_email_validated_at, overlay_cache_nodes.operator_wallet_validated_at, overlay_cache_nodes.ingress_rate, overlay_cache_nodes.egress_rate, overlay_cache_nodes.upload_success_ratio, overlay_cache_nodes.download_success_ratio, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.updated_at FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.CountryCode, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorWalletFeatures, &overlay_cache_node.OperatorEmailValidatedAt, &overlay_cache_node.OperatorWalletValidatedAt, &overlay_cache_node.IngressRate, &overlay_cache_node.EgressRate, &overlay_cache_node.UploadSuccessRatio, &overlay_cache_node.DownloadSuccessRatio, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -6221,6 +10790,66 @@ func (obj *sqlite3Impl) Update_CertRecord_By_Id(ctx context.Context,
 	return certRecord, nil
 }
 
+func (obj *sqlite3Impl) Update_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	update ProjectDeletion_Update_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_deletions SET "), __sets, __sqlbundle_Literal(" WHERE project_deletions.project_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.DeletedSegments._set {
+		__values = append(__values, update.DeletedSegments.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("deleted_segments = ?"))
+	}
+
+	if update.DeletedBytes._set {
+		__values = append(__values, update.DeletedBytes.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("deleted_bytes = ?"))
+	}
+
+	if update.FinishedAt._set {
+		__values = append(__values, update.FinishedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("finished_at = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, project_deletion_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_deletion = &ProjectDeletion{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.deleted_segments, project_deletions.deleted_bytes, project_deletions.requested_at, project_deletions.finished_at FROM project_deletions WHERE project_deletions.project_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project_deletion.ProjectId, &project_deletion.DeletedSegments, &project_deletion.DeletedBytes, &project_deletion.RequestedAt, &project_deletion.FinishedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_deletion, nil
+}
+
 func (obj *sqlite3Impl) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 	deleted bool, err error) {
@@ -6355,10 +10984,89 @@ func (obj *sqlite3Impl) Delete_Injuredsegment_By_Id(ctx context.Context,
 	injuredsegment_id Injuredsegment_Id_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM injuredsegments WHERE injuredsegments.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, injuredsegment_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_User_By_Id(ctx context.Context,
+	user_id User_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, user_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_Project_By_Id(ctx context.Context,
+	project_id Project_Id_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM projects WHERE projects.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *sqlite3Impl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
+	project_member_member_id ProjectMember_MemberId_Field,
+	project_member_project_id ProjectMember_ProjectId_Field) (
+	deleted bool, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
 
 	var __values []interface{}
-	__values = append(__values, injuredsegment_id.value())
+	__values = append(__values, project_member_member_id.value(), project_member_project_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -6377,14 +11085,14 @@ func (obj *sqlite3Impl) Delete_Injuredsegment_By_Id(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Delete_User_By_Id(ctx context.Context,
-	user_id User_Id_Field) (
+func (obj *sqlite3Impl) Delete_ApiKey_By_Id(ctx context.Context,
+	api_key_id ApiKey_Id_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM users WHERE users.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, user_id.value())
+	__values = append(__values, api_key_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -6403,14 +11111,14 @@ func (obj *sqlite3Impl) Delete_User_By_Id(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Delete_Project_By_Id(ctx context.Context,
-	project_id Project_Id_Field) (
+func (obj *sqlite3Impl) Delete_CertRecord_By_Id(ctx context.Context,
+	certRecord_id CertRecord_Id_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM certRecords WHERE certRecords.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_id.value())
+	__values = append(__values, certRecord_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -6429,15 +11137,14 @@ func (obj *sqlite3Impl) Delete_Project_By_Id(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
-	project_member_member_id ProjectMember_MemberId_Field,
-	project_member_project_id ProjectMember_ProjectId_Field) (
+func (obj *sqlite3Impl) Delete_AbuseBlock_By_Id(ctx context.Context,
+	abuse_block_id AbuseBlock_Id_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM project_members WHERE project_members.member_id = ? AND project_members.project_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM abuse_blocks WHERE abuse_blocks.id = ?")
 
 	var __values []interface{}
-	__values = append(__values, project_member_member_id.value(), project_member_project_id.value())
+	__values = append(__values, abuse_block_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -6456,14 +11163,15 @@ func (obj *sqlite3Impl) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx conte
 
 }
 
-func (obj *sqlite3Impl) Delete_ApiKey_By_Id(ctx context.Context,
-	api_key_id ApiKey_Id_Field) (
+func (obj *sqlite3Impl) Delete_NodeTag_By_NodeId_And_Name(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field,
+	node_tag_name NodeTag_Name_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM api_keys WHERE api_keys.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_tags WHERE node_tags.node_id = ? AND node_tags.name = ?")
 
 	var __values []interface{}
-	__values = append(__values, api_key_id.value())
+	__values = append(__values, node_tag_node_id.value(), node_tag_name.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -6482,14 +11190,16 @@ func (obj *sqlite3Impl) Delete_ApiKey_By_Id(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Delete_CertRecord_By_Id(ctx context.Context,
-	certRecord_id CertRecord_Id_Field) (
+func (obj *sqlite3Impl) Delete_PrefixQuota_By_ProjectId_And_BucketName_And_Prefix(ctx context.Context,
+	prefix_quota_project_id PrefixQuota_ProjectId_Field,
+	prefix_quota_bucket_name PrefixQuota_BucketName_Field,
+	prefix_quota_prefix PrefixQuota_Prefix_Field) (
 	deleted bool, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM certRecords WHERE certRecords.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM prefix_quotas WHERE prefix_quotas.project_id = ? AND prefix_quotas.bucket_name = ? AND prefix_quotas.prefix = ?")
 
 	var __values []interface{}
-	__values = append(__values, certRecord_id.value())
+	__values = append(__values, prefix_quota_project_id.value(), prefix_quota_bucket_name.value(), prefix_quota_prefix.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -6602,13 +11312,13 @@ func (obj *sqlite3Impl) getLastNode(ctx context.Context,
 	pk int64) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.last_contact_success, nodes.last_contact_failure, nodes.vetted_at, nodes.disqualified_at, nodes.disqualification_reason, nodes.created_at, nodes.updated_at FROM nodes WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.LastContactSuccess, &node.LastContactFailure, &node.VettedAt, &node.DisqualifiedAt, &node.DisqualificationReason, &node.CreatedAt, &node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6620,13 +11330,13 @@ func (obj *sqlite3Impl) getLastOverlayCacheNode(ctx context.Context,
 	pk int64) (
 	overlay_cache_node *OverlayCacheNode, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.country_code, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.operator_wallet_features, overlay_cache_nodes.operator_email_validated_at, overlay_cache_nodes.operator_wallet_validated_at, overlay_cache_nodes.ingress_rate, overlay_cache_nodes.egress_rate, overlay_cache_nodes.upload_success_ratio, overlay_cache_nodes.download_success_ratio, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.updated_at FROM overlay_cache_nodes WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.CountryCode, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.OperatorWalletFeatures, &overlay_cache_node.OperatorEmailValidatedAt, &overlay_cache_node.OperatorWalletValidatedAt, &overlay_cache_node.IngressRate, &overlay_cache_node.EgressRate, &overlay_cache_node.UploadSuccessRatio, &overlay_cache_node.DownloadSuccessRatio, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6638,13 +11348,13 @@ func (obj *sqlite3Impl) getLastInjuredsegment(ctx context.Context,
 	pk int64) (
 	injuredsegment *Injuredsegment, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info FROM injuredsegments WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT injuredsegments.id, injuredsegments.info, injuredsegments.leased_until FROM injuredsegments WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	injuredsegment = &Injuredsegment{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&injuredsegment.Id, &injuredsegment.Info)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&injuredsegment.Id, &injuredsegment.Info, &injuredsegment.LeasedUntil)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6700,67 +11410,357 @@ func (obj *sqlite3Impl) getLastProjectMember(ctx context.Context,
 	project_member = &ProjectMember{}
 	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_member.MemberId, &project_member.ProjectId, &project_member.CreatedAt)
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return nil, obj.makeErr(err)
+	}
+	return project_member, nil
+
+}
+
+func (obj *sqlite3Impl) getLastApiKey(ctx context.Context,
+	pk int64) (
+	api_key *ApiKey, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	api_key = &ApiKey{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return api_key, nil
+
+}
+
+func (obj *sqlite3Impl) getLastCertRecord(ctx context.Context,
+	pk int64) (
+	certRecord *CertRecord, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT certRecords.publickey, certRecords.id, certRecords.update_at FROM certRecords WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	certRecord = &CertRecord{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return certRecord, nil
+
+}
+
+func (obj *sqlite3Impl) getLastAbuseBlock(ctx context.Context,
+	pk int64) (
+	abuse_block *AbuseBlock, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT abuse_blocks.id, abuse_blocks.kind, abuse_blocks.value, abuse_blocks.reason, abuse_blocks.created_by, abuse_blocks.created_at, abuse_blocks.expires_at FROM abuse_blocks WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	abuse_block = &AbuseBlock{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&abuse_block.Id, &abuse_block.Kind, &abuse_block.Value, &abuse_block.Reason, &abuse_block.CreatedBy, &abuse_block.CreatedAt, &abuse_block.ExpiresAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return abuse_block, nil
+
+}
+
+func (obj *sqlite3Impl) getLastAuditLog(ctx context.Context,
+	pk int64) (
+	audit_log *AuditLog, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT audit_logs.id, audit_logs.actor, audit_logs.action, audit_logs.target, audit_logs.remote_addr, audit_logs.created_at FROM audit_logs WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	audit_log = &AuditLog{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&audit_log.Id, &audit_log.Actor, &audit_log.Action, &audit_log.Target, &audit_log.RemoteAddr, &audit_log.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return audit_log, nil
+
+}
+
+func (obj *sqlite3Impl) getLastProjectDeletion(ctx context.Context,
+	pk int64) (
+	project_deletion *ProjectDeletion, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_deletions.project_id, project_deletions.deleted_segments, project_deletions.deleted_bytes, project_deletions.requested_at, project_deletions.finished_at FROM project_deletions WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	project_deletion = &ProjectDeletion{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_deletion.ProjectId, &project_deletion.DeletedSegments, &project_deletion.DeletedBytes, &project_deletion.RequestedAt, &project_deletion.FinishedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_deletion, nil
+
+}
+
+func (obj *sqlite3Impl) getLastNodeTag(ctx context.Context,
+	pk int64) (
+	node_tag *NodeTag, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_tags.node_id, node_tags.name, node_tags.value, node_tags.signer_id, node_tags.tag FROM node_tags WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	node_tag = &NodeTag{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node_tag.NodeId, &node_tag.Name, &node_tag.Value, &node_tag.SignerId, &node_tag.Tag)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node_tag, nil
+
+}
+
+func (obj *sqlite3Impl) getLastPrefixQuota(ctx context.Context,
+	pk int64) (
+	prefix_quota *PrefixQuota, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT prefix_quotas.project_id, prefix_quotas.bucket_name, prefix_quotas.prefix, prefix_quotas.max_bytes, prefix_quotas.max_objects FROM prefix_quotas WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	prefix_quota = &PrefixQuota{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&prefix_quota.ProjectId, &prefix_quota.BucketName, &prefix_quota.Prefix, &prefix_quota.MaxBytes, &prefix_quota.MaxObjects)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return prefix_quota, nil
+
+}
+
+func (impl sqlite3Impl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(sqlite3.Error); ok {
+		if e.Code == sqlite3.ErrConstraint {
+			msg := err.Error()
+			colon := strings.LastIndex(msg, ":")
+			if colon != -1 {
+				return strings.TrimSpace(msg[colon:]), true
+			}
+			return "", true
+		}
+	}
+	return "", false
+}
+
+func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error) {
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.Exec("DELETE FROM project_members;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM api_keys;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM users;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM sample_snapshots;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM repair_slo_days;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM projects;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_tiers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_salts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_exports;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_deletions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM pricing_tiers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
-	return project_member, nil
-
-}
-
-func (obj *sqlite3Impl) getLastApiKey(ctx context.Context,
-	pk int64) (
-	api_key *ApiKey, err error) {
-
-	var __embed_stmt = __sqlbundle_Literal("SELECT api_keys.id, api_keys.project_id, api_keys.key, api_keys.name, api_keys.created_at FROM api_keys WHERE _rowid_ = ?")
-
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
 
-	api_key = &ApiKey{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&api_key.Id, &api_key.ProjectId, &api_key.Key, &api_key.Name, &api_key.CreatedAt)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM prefix_quotas;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
-	return api_key, nil
 
-}
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM pending_audits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-func (obj *sqlite3Impl) getLastCertRecord(ctx context.Context,
-	pk int64) (
-	certRecord *CertRecord, err error) {
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM overlay_cache_nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT certRecords.publickey, certRecords.id, certRecords.update_at FROM certRecords WHERE _rowid_ = ?")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM object_egress_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-	certRecord = &CertRecord{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&certRecord.Publickey, &certRecord.Id, &certRecord.UpdateAt)
+	__count, err = __res.RowsAffected()
 	if err != nil {
-		return nil, obj.makeErr(err)
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_tags;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
-	return certRecord, nil
 
-}
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM node_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
-func (impl sqlite3Impl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(sqlite3.Error); ok {
-		if e.Code == sqlite3.ErrConstraint {
-			msg := err.Error()
-			colon := strings.LastIndex(msg, ":")
-			if colon != -1 {
-				return strings.TrimSpace(msg[colon:]), true
-			}
-			return "", true
-		}
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM irreparabledbs;")
+	if err != nil {
+		return 0, obj.makeErr(err)
 	}
-	return "", false
-}
 
-func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error) {
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.Exec("DELETE FROM project_members;")
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM injuredsegments;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -6770,7 +11770,7 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM api_keys;")
+	__res, err = obj.driver.Exec("DELETE FROM egress_attributions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -6780,7 +11780,7 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM users;")
+	__res, err = obj.driver.Exec("DELETE FROM certRecords;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -6790,7 +11790,7 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM projects;")
+	__res, err = obj.driver.Exec("DELETE FROM bwagreements;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -6800,7 +11800,7 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM overlay_cache_nodes;")
+	__res, err = obj.driver.Exec("DELETE FROM bwagreement_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -6810,7 +11810,7 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM nodes;")
+	__res, err = obj.driver.Exec("DELETE FROM bwagreement_archives;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -6820,7 +11820,7 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM irreparabledbs;")
+	__res, err = obj.driver.Exec("DELETE FROM bwagreement_anomalies;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -6830,7 +11830,7 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM injuredsegments;")
+	__res, err = obj.driver.Exec("DELETE FROM bucket_locks;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -6840,7 +11840,7 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM certRecords;")
+	__res, err = obj.driver.Exec("DELETE FROM audit_logs;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -6850,7 +11850,7 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.Exec("DELETE FROM bwagreements;")
+	__res, err = obj.driver.Exec("DELETE FROM address_mismatches;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -6885,6 +11885,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM abuse_blocks;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -6937,6 +11947,16 @@ func (rx *Rx) Rollback() (err error) {
 	return err
 }
 
+func (rx *Rx) All_AbuseBlock_By_ExpiresAt_Greater(ctx context.Context,
+	abuse_block_expires_at_greater AbuseBlock_ExpiresAt_Field) (
+	rows []*AbuseBlock, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_AbuseBlock_By_ExpiresAt_Greater(ctx, abuse_block_expires_at_greater)
+}
+
 func (rx *Rx) All_AccountingRaw(ctx context.Context) (
 	rows []*AccountingRaw, err error) {
 	var tx *Tx
@@ -6995,6 +12015,16 @@ func (rx *Rx) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
 	return tx.All_Bwagreement_By_CreatedAt_Greater(ctx, bwagreement_created_at_greater)
 }
 
+func (rx *Rx) All_NodeTag_By_NodeId(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field) (
+	rows []*NodeTag, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_NodeTag_By_NodeId(ctx, node_tag_node_id)
+}
+
 func (rx *Rx) All_Node_Id(ctx context.Context) (
 	rows []*Id_Row, err error) {
 	var tx *Tx
@@ -7004,6 +12034,17 @@ func (rx *Rx) All_Node_Id(ctx context.Context) (
 	return tx.All_Node_Id(ctx)
 }
 
+func (rx *Rx) All_PrefixQuota_By_ProjectId_And_BucketName(ctx context.Context,
+	prefix_quota_project_id PrefixQuota_ProjectId_Field,
+	prefix_quota_bucket_name PrefixQuota_BucketName_Field) (
+	rows []*PrefixQuota, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_PrefixQuota_By_ProjectId_And_BucketName(ctx, prefix_quota_project_id, prefix_quota_bucket_name)
+}
+
 func (rx *Rx) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 	var tx *Tx
@@ -7013,6 +12054,15 @@ func (rx *Rx) All_Project(ctx context.Context) (
 	return tx.All_Project(ctx)
 }
 
+func (rx *Rx) All_ProjectDeletion_OrderBy_Asc_RequestedAt(ctx context.Context) (
+	rows []*ProjectDeletion, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ProjectDeletion_OrderBy_Asc_RequestedAt(ctx)
+}
+
 func (rx *Rx) All_ProjectMember_By_MemberId(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*ProjectMember, err error) {
@@ -7033,6 +12083,22 @@ func (rx *Rx) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx
 	return tx.All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Project_Name(ctx, project_member_member_id)
 }
 
+func (rx *Rx) Create_AbuseBlock(ctx context.Context,
+	abuse_block_kind AbuseBlock_Kind_Field,
+	abuse_block_value AbuseBlock_Value_Field,
+	abuse_block_reason AbuseBlock_Reason_Field,
+	abuse_block_created_by AbuseBlock_CreatedBy_Field,
+	abuse_block_created_at AbuseBlock_CreatedAt_Field,
+	abuse_block_expires_at AbuseBlock_ExpiresAt_Field) (
+	abuse_block *AbuseBlock, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_AbuseBlock(ctx, abuse_block_kind, abuse_block_value, abuse_block_reason, abuse_block_created_by, abuse_block_created_at, abuse_block_expires_at)
+
+}
+
 func (rx *Rx) Create_AccountingRaw(ctx context.Context,
 	accounting_raw_node_id AccountingRaw_NodeId_Field,
 	accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
@@ -7092,6 +12158,20 @@ func (rx *Rx) Create_ApiKey(ctx context.Context,
 
 }
 
+func (rx *Rx) Create_AuditLog(ctx context.Context,
+	audit_log_actor AuditLog_Actor_Field,
+	audit_log_action AuditLog_Action_Field,
+	audit_log_target AuditLog_Target_Field,
+	audit_log_remote_addr AuditLog_RemoteAddr_Field) (
+	audit_log *AuditLog, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_AuditLog(ctx, audit_log_actor, audit_log_action, audit_log_target, audit_log_remote_addr)
+
+}
+
 func (rx *Rx) Create_Bwagreement(ctx context.Context,
 	bwagreement_serialnum Bwagreement_Serialnum_Field,
 	bwagreement_storage_node_id Bwagreement_StorageNodeId_Field,
@@ -7121,13 +12201,14 @@ func (rx *Rx) Create_CertRecord(ctx context.Context,
 }
 
 func (rx *Rx) Create_Injuredsegment(ctx context.Context,
-	injuredsegment_info Injuredsegment_Info_Field) (
+	injuredsegment_info Injuredsegment_Info_Field,
+	injuredsegment_leased_until Injuredsegment_LeasedUntil_Field) (
 	injuredsegment *Injuredsegment, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Injuredsegment(ctx, injuredsegment_info)
+	return tx.Create_Injuredsegment(ctx, injuredsegment_info, injuredsegment_leased_until)
 
 }
 
@@ -7151,15 +12232,39 @@ func (rx *Rx) Create_Node(ctx context.Context,
 	node_audit_success_count Node_AuditSuccessCount_Field,
 	node_total_audit_count Node_TotalAuditCount_Field,
 	node_audit_success_ratio Node_AuditSuccessRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
 	node_uptime_success_count Node_UptimeSuccessCount_Field,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
-	node_uptime_ratio Node_UptimeRatio_Field) (
+	node_uptime_ratio Node_UptimeRatio_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	node_last_contact_success Node_LastContactSuccess_Field,
+	node_last_contact_failure Node_LastContactFailure_Field,
+	node_vetted_at Node_VettedAt_Field,
+	node_disqualified_at Node_DisqualifiedAt_Field,
+	node_disqualification_reason Node_DisqualificationReason_Field) (
 	node *Node, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Node(ctx, node_id, node_audit_success_count, node_total_audit_count, node_audit_success_ratio, node_uptime_success_count, node_total_uptime_count, node_uptime_ratio)
+	return tx.Create_Node(ctx, node_id, node_audit_success_count, node_total_audit_count, node_audit_success_ratio, node_audit_reputation_alpha, node_audit_reputation_beta, node_uptime_success_count, node_total_uptime_count, node_uptime_ratio, node_uptime_reputation_alpha, node_uptime_reputation_beta, node_last_contact_success, node_last_contact_failure, node_vetted_at, node_disqualified_at, node_disqualification_reason)
+
+}
+
+func (rx *Rx) Create_NodeTag(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field,
+	node_tag_name NodeTag_Name_Field,
+	node_tag_value NodeTag_Value_Field,
+	node_tag_signer_id NodeTag_SignerId_Field,
+	node_tag_tag NodeTag_Tag_Field) (
+	node_tag *NodeTag, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_NodeTag(ctx, node_tag_node_id, node_tag_name, node_tag_value, node_tag_signer_id, node_tag_tag)
 
 }
 
@@ -7168,8 +12273,16 @@ func (rx *Rx) Create_OverlayCacheNode(ctx context.Context,
 	overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
 	overlay_cache_node_address OverlayCacheNode_Address_Field,
 	overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+	overlay_cache_node_country_code OverlayCacheNode_CountryCode_Field,
 	overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
 	overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+	overlay_cache_node_operator_wallet_features OverlayCacheNode_OperatorWalletFeatures_Field,
+	overlay_cache_node_operator_email_validated_at OverlayCacheNode_OperatorEmailValidatedAt_Field,
+	overlay_cache_node_operator_wallet_validated_at OverlayCacheNode_OperatorWalletValidatedAt_Field,
+	overlay_cache_node_ingress_rate OverlayCacheNode_IngressRate_Field,
+	overlay_cache_node_egress_rate OverlayCacheNode_EgressRate_Field,
+	overlay_cache_node_upload_success_ratio OverlayCacheNode_UploadSuccessRatio_Field,
+	overlay_cache_node_download_success_ratio OverlayCacheNode_DownloadSuccessRatio_Field,
 	overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
 	overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
 	overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
@@ -7184,7 +12297,22 @@ func (rx *Rx) Create_OverlayCacheNode(ctx context.Context,
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_OverlayCacheNode(ctx, overlay_cache_node_node_id, overlay_cache_node_node_type, overlay_cache_node_address, overlay_cache_node_protocol, overlay_cache_node_operator_email, overlay_cache_node_operator_wallet, overlay_cache_node_free_bandwidth, overlay_cache_node_free_disk, overlay_cache_node_latency_90, overlay_cache_node_audit_success_ratio, overlay_cache_node_audit_uptime_ratio, overlay_cache_node_audit_count, overlay_cache_node_audit_success_count, overlay_cache_node_uptime_count, overlay_cache_node_uptime_success_count)
+	return tx.Create_OverlayCacheNode(ctx, overlay_cache_node_node_id, overlay_cache_node_node_type, overlay_cache_node_address, overlay_cache_node_protocol, overlay_cache_node_country_code, overlay_cache_node_operator_email, overlay_cache_node_operator_wallet, overlay_cache_node_operator_wallet_features, overlay_cache_node_operator_email_validated_at, overlay_cache_node_operator_wallet_validated_at, overlay_cache_node_ingress_rate, overlay_cache_node_egress_rate, overlay_cache_node_upload_success_ratio, overlay_cache_node_download_success_ratio, overlay_cache_node_free_bandwidth, overlay_cache_node_free_disk, overlay_cache_node_latency_90, overlay_cache_node_audit_success_ratio, overlay_cache_node_audit_uptime_ratio, overlay_cache_node_audit_count, overlay_cache_node_audit_success_count, overlay_cache_node_uptime_count, overlay_cache_node_uptime_success_count)
+
+}
+
+func (rx *Rx) Create_PrefixQuota(ctx context.Context,
+	prefix_quota_project_id PrefixQuota_ProjectId_Field,
+	prefix_quota_bucket_name PrefixQuota_BucketName_Field,
+	prefix_quota_prefix PrefixQuota_Prefix_Field,
+	prefix_quota_max_bytes PrefixQuota_MaxBytes_Field,
+	prefix_quota_max_objects PrefixQuota_MaxObjects_Field) (
+	prefix_quota *PrefixQuota, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_PrefixQuota(ctx, prefix_quota_project_id, prefix_quota_bucket_name, prefix_quota_prefix, prefix_quota_max_bytes, prefix_quota_max_objects)
 
 }
 
@@ -7201,6 +12329,20 @@ func (rx *Rx) Create_Project(ctx context.Context,
 
 }
 
+func (rx *Rx) Create_ProjectDeletion(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	project_deletion_deleted_segments ProjectDeletion_DeletedSegments_Field,
+	project_deletion_deleted_bytes ProjectDeletion_DeletedBytes_Field,
+	optional ProjectDeletion_Create_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_ProjectDeletion(ctx, project_deletion_project_id, project_deletion_deleted_segments, project_deletion_deleted_bytes, optional)
+
+}
+
 func (rx *Rx) Create_ProjectMember(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
 	project_member_project_id ProjectMember_ProjectId_Field) (
@@ -7228,6 +12370,16 @@ func (rx *Rx) Create_User(ctx context.Context,
 
 }
 
+func (rx *Rx) Delete_AbuseBlock_By_Id(ctx context.Context,
+	abuse_block_id AbuseBlock_Id_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_AbuseBlock_By_Id(ctx, abuse_block_id)
+}
+
 func (rx *Rx) Delete_AccountingRaw_By_Id(ctx context.Context,
 	accounting_raw_id AccountingRaw_Id_Field) (
 	deleted bool, err error) {
@@ -7288,6 +12440,17 @@ func (rx *Rx) Delete_Irreparabledb_By_Segmentpath(ctx context.Context,
 	return tx.Delete_Irreparabledb_By_Segmentpath(ctx, irreparabledb_segmentpath)
 }
 
+func (rx *Rx) Delete_NodeTag_By_NodeId_And_Name(ctx context.Context,
+	node_tag_node_id NodeTag_NodeId_Field,
+	node_tag_name NodeTag_Name_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_NodeTag_By_NodeId_And_Name(ctx, node_tag_node_id, node_tag_name)
+}
+
 func (rx *Rx) Delete_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	deleted bool, err error) {
@@ -7308,6 +12471,18 @@ func (rx *Rx) Delete_OverlayCacheNode_By_NodeId(ctx context.Context,
 	return tx.Delete_OverlayCacheNode_By_NodeId(ctx, overlay_cache_node_node_id)
 }
 
+func (rx *Rx) Delete_PrefixQuota_By_ProjectId_And_BucketName_And_Prefix(ctx context.Context,
+	prefix_quota_project_id PrefixQuota_ProjectId_Field,
+	prefix_quota_bucket_name PrefixQuota_BucketName_Field,
+	prefix_quota_prefix PrefixQuota_Prefix_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_PrefixQuota_By_ProjectId_And_BucketName_And_Prefix(ctx, prefix_quota_project_id, prefix_quota_bucket_name, prefix_quota_prefix)
+}
+
 func (rx *Rx) Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 	project_member_member_id ProjectMember_MemberId_Field,
 	project_member_project_id ProjectMember_ProjectId_Field) (
@@ -7448,6 +12623,16 @@ func (rx *Rx) Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx context.Context,
 	return tx.Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx, overlay_cache_node_node_id)
 }
 
+func (rx *Rx) Get_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+	project_deletion *ProjectDeletion, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_ProjectDeletion_By_ProjectId(ctx, project_deletion_project_id)
+}
+
 func (rx *Rx) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
@@ -7478,6 +12663,18 @@ func (rx *Rx) Get_User_By_Id(ctx context.Context,
 	return tx.Get_User_By_Id(ctx, user_id)
 }
 
+func (rx *Rx) Limited_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_Id(ctx context.Context,
+	audit_log_created_at_greater_or_equal AuditLog_CreatedAt_Field,
+	audit_log_created_at_less AuditLog_CreatedAt_Field,
+	limit int, offset int64) (
+	rows []*AuditLog, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Limited_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_Id(ctx, audit_log_created_at_greater_or_equal, audit_log_created_at_less, limit, offset)
+}
+
 func (rx *Rx) Limited_Bwagreement(ctx context.Context,
 	limit int, offset int64) (
 	rows []*Bwagreement, err error) {
@@ -7586,6 +12783,17 @@ func (rx *Rx) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 	return tx.Update_OverlayCacheNode_By_NodeId(ctx, overlay_cache_node_node_id, update)
 }
 
+func (rx *Rx) Update_ProjectDeletion_By_ProjectId(ctx context.Context,
+	project_deletion_project_id ProjectDeletion_ProjectId_Field,
+	update ProjectDeletion_Update_Fields) (
+	project_deletion *ProjectDeletion, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectDeletion_By_ProjectId(ctx, project_deletion_project_id, update)
+}
+
 func (rx *Rx) Update_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field,
 	update Project_Update_Fields) (
@@ -7609,6 +12817,10 @@ func (rx *Rx) Update_User_By_Id(ctx context.Context,
 }
 
 type Methods interface {
+	All_AbuseBlock_By_ExpiresAt_Greater(ctx context.Context,
+		abuse_block_expires_at_greater AbuseBlock_ExpiresAt_Field) (
+		rows []*AbuseBlock, err error)
+
 	All_AccountingRaw(ctx context.Context) (
 		rows []*AccountingRaw, err error)
 
@@ -7631,12 +12843,24 @@ type Methods interface {
 		bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
 		rows []*Bwagreement, err error)
 
+	All_NodeTag_By_NodeId(ctx context.Context,
+		node_tag_node_id NodeTag_NodeId_Field) (
+		rows []*NodeTag, err error)
+
 	All_Node_Id(ctx context.Context) (
 		rows []*Id_Row, err error)
 
+	All_PrefixQuota_By_ProjectId_And_BucketName(ctx context.Context,
+		prefix_quota_project_id PrefixQuota_ProjectId_Field,
+		prefix_quota_bucket_name PrefixQuota_BucketName_Field) (
+		rows []*PrefixQuota, err error)
+
 	All_Project(ctx context.Context) (
 		rows []*Project, err error)
 
+	All_ProjectDeletion_OrderBy_Asc_RequestedAt(ctx context.Context) (
+		rows []*ProjectDeletion, err error)
+
 	All_ProjectMember_By_MemberId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field) (
 		rows []*ProjectMember, err error)
@@ -7645,6 +12869,15 @@ type Methods interface {
 		project_member_member_id ProjectMember_MemberId_Field) (
 		rows []*Project, err error)
 
+	Create_AbuseBlock(ctx context.Context,
+		abuse_block_kind AbuseBlock_Kind_Field,
+		abuse_block_value AbuseBlock_Value_Field,
+		abuse_block_reason AbuseBlock_Reason_Field,
+		abuse_block_created_by AbuseBlock_CreatedBy_Field,
+		abuse_block_created_at AbuseBlock_CreatedAt_Field,
+		abuse_block_expires_at AbuseBlock_ExpiresAt_Field) (
+		abuse_block *AbuseBlock, err error)
+
 	Create_AccountingRaw(ctx context.Context,
 		accounting_raw_node_id AccountingRaw_NodeId_Field,
 		accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
@@ -7676,6 +12909,13 @@ type Methods interface {
 		api_key_name ApiKey_Name_Field) (
 		api_key *ApiKey, err error)
 
+	Create_AuditLog(ctx context.Context,
+		audit_log_actor AuditLog_Actor_Field,
+		audit_log_action AuditLog_Action_Field,
+		audit_log_target AuditLog_Target_Field,
+		audit_log_remote_addr AuditLog_RemoteAddr_Field) (
+		audit_log *AuditLog, err error)
+
 	Create_Bwagreement(ctx context.Context,
 		bwagreement_serialnum Bwagreement_Serialnum_Field,
 		bwagreement_storage_node_id Bwagreement_StorageNodeId_Field,
@@ -7691,7 +12931,8 @@ type Methods interface {
 		certRecord *CertRecord, err error)
 
 	Create_Injuredsegment(ctx context.Context,
-		injuredsegment_info Injuredsegment_Info_Field) (
+		injuredsegment_info Injuredsegment_Info_Field,
+		injuredsegment_leased_until Injuredsegment_LeasedUntil_Field) (
 		injuredsegment *Injuredsegment, err error)
 
 	Create_Irreparabledb(ctx context.Context,
@@ -7707,18 +12948,43 @@ type Methods interface {
 		node_audit_success_count Node_AuditSuccessCount_Field,
 		node_total_audit_count Node_TotalAuditCount_Field,
 		node_audit_success_ratio Node_AuditSuccessRatio_Field,
+		node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+		node_audit_reputation_beta Node_AuditReputationBeta_Field,
 		node_uptime_success_count Node_UptimeSuccessCount_Field,
 		node_total_uptime_count Node_TotalUptimeCount_Field,
-		node_uptime_ratio Node_UptimeRatio_Field) (
+		node_uptime_ratio Node_UptimeRatio_Field,
+		node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+		node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+		node_last_contact_success Node_LastContactSuccess_Field,
+		node_last_contact_failure Node_LastContactFailure_Field,
+		node_vetted_at Node_VettedAt_Field,
+		node_disqualified_at Node_DisqualifiedAt_Field,
+		node_disqualification_reason Node_DisqualificationReason_Field) (
 		node *Node, err error)
 
+	Create_NodeTag(ctx context.Context,
+		node_tag_node_id NodeTag_NodeId_Field,
+		node_tag_name NodeTag_Name_Field,
+		node_tag_value NodeTag_Value_Field,
+		node_tag_signer_id NodeTag_SignerId_Field,
+		node_tag_tag NodeTag_Tag_Field) (
+		node_tag *NodeTag, err error)
+
 	Create_OverlayCacheNode(ctx context.Context,
 		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field,
 		overlay_cache_node_node_type OverlayCacheNode_NodeType_Field,
 		overlay_cache_node_address OverlayCacheNode_Address_Field,
 		overlay_cache_node_protocol OverlayCacheNode_Protocol_Field,
+		overlay_cache_node_country_code OverlayCacheNode_CountryCode_Field,
 		overlay_cache_node_operator_email OverlayCacheNode_OperatorEmail_Field,
 		overlay_cache_node_operator_wallet OverlayCacheNode_OperatorWallet_Field,
+		overlay_cache_node_operator_wallet_features OverlayCacheNode_OperatorWalletFeatures_Field,
+		overlay_cache_node_operator_email_validated_at OverlayCacheNode_OperatorEmailValidatedAt_Field,
+		overlay_cache_node_operator_wallet_validated_at OverlayCacheNode_OperatorWalletValidatedAt_Field,
+		overlay_cache_node_ingress_rate OverlayCacheNode_IngressRate_Field,
+		overlay_cache_node_egress_rate OverlayCacheNode_EgressRate_Field,
+		overlay_cache_node_upload_success_ratio OverlayCacheNode_UploadSuccessRatio_Field,
+		overlay_cache_node_download_success_ratio OverlayCacheNode_DownloadSuccessRatio_Field,
 		overlay_cache_node_free_bandwidth OverlayCacheNode_FreeBandwidth_Field,
 		overlay_cache_node_free_disk OverlayCacheNode_FreeDisk_Field,
 		overlay_cache_node_latency_90 OverlayCacheNode_Latency90_Field,
@@ -7730,12 +12996,27 @@ type Methods interface {
 		overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
 		overlay_cache_node *OverlayCacheNode, err error)
 
+	Create_PrefixQuota(ctx context.Context,
+		prefix_quota_project_id PrefixQuota_ProjectId_Field,
+		prefix_quota_bucket_name PrefixQuota_BucketName_Field,
+		prefix_quota_prefix PrefixQuota_Prefix_Field,
+		prefix_quota_max_bytes PrefixQuota_MaxBytes_Field,
+		prefix_quota_max_objects PrefixQuota_MaxObjects_Field) (
+		prefix_quota *PrefixQuota, err error)
+
 	Create_Project(ctx context.Context,
 		project_id Project_Id_Field,
 		project_name Project_Name_Field,
 		project_description Project_Description_Field) (
 		project *Project, err error)
 
+	Create_ProjectDeletion(ctx context.Context,
+		project_deletion_project_id ProjectDeletion_ProjectId_Field,
+		project_deletion_deleted_segments ProjectDeletion_DeletedSegments_Field,
+		project_deletion_deleted_bytes ProjectDeletion_DeletedBytes_Field,
+		optional ProjectDeletion_Create_Fields) (
+		project_deletion *ProjectDeletion, err error)
+
 	Create_ProjectMember(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
@@ -7749,6 +13030,10 @@ type Methods interface {
 		optional User_Create_Fields) (
 		user *User, err error)
 
+	Delete_AbuseBlock_By_Id(ctx context.Context,
+		abuse_block_id AbuseBlock_Id_Field) (
+		deleted bool, err error)
+
 	Delete_AccountingRaw_By_Id(ctx context.Context,
 		accounting_raw_id AccountingRaw_Id_Field) (
 		deleted bool, err error)
@@ -7773,6 +13058,11 @@ type Methods interface {
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 		deleted bool, err error)
 
+	Delete_NodeTag_By_NodeId_And_Name(ctx context.Context,
+		node_tag_node_id NodeTag_NodeId_Field,
+		node_tag_name NodeTag_Name_Field) (
+		deleted bool, err error)
+
 	Delete_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field) (
 		deleted bool, err error)
@@ -7781,6 +13071,12 @@ type Methods interface {
 		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
 		deleted bool, err error)
 
+	Delete_PrefixQuota_By_ProjectId_And_BucketName_And_Prefix(ctx context.Context,
+		prefix_quota_project_id PrefixQuota_ProjectId_Field,
+		prefix_quota_bucket_name PrefixQuota_BucketName_Field,
+		prefix_quota_prefix PrefixQuota_Prefix_Field) (
+		deleted bool, err error)
+
 	Delete_ProjectMember_By_MemberId_And_ProjectId(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
@@ -7837,6 +13133,10 @@ type Methods interface {
 		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
 		row *OperatorWallet_Row, err error)
 
+	Get_ProjectDeletion_By_ProjectId(ctx context.Context,
+		project_deletion_project_id ProjectDeletion_ProjectId_Field) (
+		project_deletion *ProjectDeletion, err error)
+
 	Get_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field) (
 		project *Project, err error)
@@ -7849,6 +13149,12 @@ type Methods interface {
 		user_id User_Id_Field) (
 		user *User, err error)
 
+	Limited_AuditLog_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_Id(ctx context.Context,
+		audit_log_created_at_greater_or_equal AuditLog_CreatedAt_Field,
+		audit_log_created_at_less AuditLog_CreatedAt_Field,
+		limit int, offset int64) (
+		rows []*AuditLog, err error)
+
 	Limited_Bwagreement(ctx context.Context,
 		limit int, offset int64) (
 		rows []*Bwagreement, err error)
@@ -7897,6 +13203,11 @@ type Methods interface {
 		update OverlayCacheNode_Update_Fields) (
 		overlay_cache_node *OverlayCacheNode, err error)
 
+	Update_ProjectDeletion_By_ProjectId(ctx context.Context,
+		project_deletion_project_id ProjectDeletion_ProjectId_Field,
+		update ProjectDeletion_Update_Fields) (
+		project_deletion *ProjectDeletion, err error)
+
 	Update_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field,
 		update Project_Update_Fields) (
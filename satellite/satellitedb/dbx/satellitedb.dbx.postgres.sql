@@ -1,5 +1,15 @@
 -- AUTOGENERATED BY gopkg.in/spacemonkeygo/dbx.v1
 -- DO NOT EDIT
+CREATE TABLE abuse_blocks (
+	id bigserial NOT NULL,
+	kind integer NOT NULL,
+	value text NOT NULL,
+	reason text NOT NULL,
+	created_by text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE accounting_raws (
 	id bigserial NOT NULL,
 	node_id bytea NOT NULL,
@@ -26,6 +36,58 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE address_mismatches (
+	node_id bytea NOT NULL,
+	address text NOT NULL,
+	answered_id bytea NOT NULL,
+	detected_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE audit_logs (
+	id bigserial NOT NULL,
+	actor text NOT NULL,
+	action text NOT NULL,
+	target text NOT NULL,
+	remote_addr text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_locks (
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	retention_period bigint NOT NULL,
+	legal_hold boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bwagreement_anomalies (
+	serialnum text NOT NULL,
+	storage_node_id bytea NOT NULL,
+	action bigint NOT NULL,
+	total bigint NOT NULL,
+	max_size bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( serialnum )
+);
+CREATE TABLE bwagreement_archives (
+	serialnum text NOT NULL,
+	storage_node_id bytea NOT NULL,
+	uplink_id bytea NOT NULL,
+	action bigint NOT NULL,
+	total bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	archived_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( serialnum )
+);
+CREATE TABLE bwagreement_rollups (
+	storage_node_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	action bigint NOT NULL,
+	total bigint NOT NULL,
+	PRIMARY KEY ( storage_node_id, interval_start, action )
+);
 CREATE TABLE bwagreements (
 	serialnum text NOT NULL,
 	storage_node_id bytea NOT NULL,
@@ -42,9 +104,20 @@ CREATE TABLE certRecords (
 	update_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE egress_attributions (
+	serial_number text NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	object_path text NOT NULL,
+	sample_rate double precision NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
 CREATE TABLE injuredsegments (
 	id bigserial NOT NULL,
 	info bytea NOT NULL,
+	leased_until timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE irreparabledbs (
@@ -55,25 +128,66 @@ CREATE TABLE irreparabledbs (
 	repair_attempt_count bigint NOT NULL,
 	PRIMARY KEY ( segmentpath )
 );
+CREATE TABLE node_events (
+	id bigserial NOT NULL,
+	node_id bytea NOT NULL,
+	kind integer NOT NULL,
+	reason text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_tags (
+	node_id bytea NOT NULL,
+	name text NOT NULL,
+	value text NOT NULL,
+	signer_id bytea NOT NULL,
+	tag bytea NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	audit_success_count bigint NOT NULL,
 	total_audit_count bigint NOT NULL,
 	audit_success_ratio double precision NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
 	uptime_success_count bigint NOT NULL,
 	total_uptime_count bigint NOT NULL,
 	uptime_ratio double precision NOT NULL,
+	uptime_reputation_alpha double precision NOT NULL,
+	uptime_reputation_beta double precision NOT NULL,
+	last_contact_success timestamp with time zone NOT NULL,
+	last_contact_failure timestamp with time zone NOT NULL,
+	vetted_at timestamp with time zone NOT NULL,
+	disqualified_at timestamp with time zone NOT NULL,
+	disqualification_reason text NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE object_egress_rollups (
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	object_path text NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	egress bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, object_path, interval_start )
+);
 CREATE TABLE overlay_cache_nodes (
 	node_id bytea NOT NULL,
 	node_type integer NOT NULL,
 	address text NOT NULL,
 	protocol integer NOT NULL,
+	country_code text NOT NULL,
 	operator_email text NOT NULL,
 	operator_wallet text NOT NULL,
+	operator_wallet_features text NOT NULL,
+	operator_email_validated_at timestamp with time zone NOT NULL,
+	operator_wallet_validated_at timestamp with time zone NOT NULL,
+	ingress_rate bigint NOT NULL,
+	egress_rate bigint NOT NULL,
+	upload_success_ratio double precision NOT NULL,
+	download_success_ratio double precision NOT NULL,
 	free_bandwidth bigint NOT NULL,
 	free_disk bigint NOT NULL,
 	latency_90 bigint NOT NULL,
@@ -83,9 +197,78 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count bigint NOT NULL,
 	uptime_count bigint NOT NULL,
 	uptime_success_count bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
+CREATE TABLE pending_audits (
+	node_id bytea NOT NULL,
+	path text NOT NULL,
+	piece_id text NOT NULL,
+	piece_num integer NOT NULL,
+	stripe_index integer NOT NULL,
+	share_size integer NOT NULL,
+	expected_share_hash bytea NOT NULL,
+	reverify_count integer NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE prefix_quotas (
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	prefix text NOT NULL,
+	max_bytes bigint NOT NULL,
+	max_objects bigint NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, prefix )
+);
+CREATE TABLE pricing_tiers (
+	name text NOT NULL,
+	storage bigint NOT NULL,
+	egress bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE project_alerts (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	prefix text NOT NULL,
+	threshold double precision NOT NULL,
+	webhook_url text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	triggered_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_deletions (
+	project_id bytea NOT NULL,
+	deleted_segments bigint NOT NULL,
+	deleted_bytes bigint NOT NULL,
+	requested_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_exports (
+	project_id bytea NOT NULL,
+	requested_by bytea NOT NULL,
+	exported_objects bigint NOT NULL,
+	exported_bytes bigint NOT NULL,
+	last_path text NOT NULL,
+	manifest bytea NOT NULL,
+	requested_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_salts (
+	project_id bytea NOT NULL,
+	salt bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_tiers (
+	project_id bytea NOT NULL,
+	tier text NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -93,6 +276,19 @@ CREATE TABLE projects (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE repair_slo_days (
+	interval_start timestamp with time zone NOT NULL,
+	injured bigint NOT NULL,
+	irreparable bigint NOT NULL,
+	repaired bigint NOT NULL,
+	failed bigint NOT NULL,
+	PRIMARY KEY ( interval_start )
+);
+CREATE TABLE sample_snapshots (
+	created_at timestamp with time zone NOT NULL,
+	data bytea NOT NULL,
+	PRIMARY KEY ( created_at )
+);
 CREATE TABLE users (
 	id bytea NOT NULL,
 	first_name text NOT NULL,
@@ -1,5 +1,15 @@
 -- AUTOGENERATED BY gopkg.in/spacemonkeygo/dbx.v1
 -- DO NOT EDIT
+CREATE TABLE abuse_blocks (
+	id INTEGER NOT NULL,
+	kind INTEGER NOT NULL,
+	value TEXT NOT NULL,
+	reason TEXT NOT NULL,
+	created_by TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE accounting_raws (
 	id INTEGER NOT NULL,
 	node_id BLOB NOT NULL,
@@ -26,6 +36,58 @@ CREATE TABLE accounting_timestamps (
 	value TIMESTAMP NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE address_mismatches (
+	node_id BLOB NOT NULL,
+	address TEXT NOT NULL,
+	answered_id BLOB NOT NULL,
+	detected_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE audit_logs (
+	id INTEGER NOT NULL,
+	actor TEXT NOT NULL,
+	action TEXT NOT NULL,
+	target TEXT NOT NULL,
+	remote_addr TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_locks (
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	retention_period INTEGER NOT NULL,
+	legal_hold INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bwagreement_anomalies (
+	serialnum TEXT NOT NULL,
+	storage_node_id BLOB NOT NULL,
+	action INTEGER NOT NULL,
+	total INTEGER NOT NULL,
+	max_size INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( serialnum )
+);
+CREATE TABLE bwagreement_archives (
+	serialnum TEXT NOT NULL,
+	storage_node_id BLOB NOT NULL,
+	uplink_id BLOB NOT NULL,
+	action INTEGER NOT NULL,
+	total INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	archived_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( serialnum )
+);
+CREATE TABLE bwagreement_rollups (
+	storage_node_id BLOB NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	action INTEGER NOT NULL,
+	total INTEGER NOT NULL,
+	PRIMARY KEY ( storage_node_id, interval_start, action )
+);
 CREATE TABLE bwagreements (
 	serialnum TEXT NOT NULL,
 	storage_node_id BLOB NOT NULL,
@@ -42,9 +104,20 @@ CREATE TABLE certRecords (
 	update_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE egress_attributions (
+	serial_number TEXT NOT NULL,
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	object_path TEXT NOT NULL,
+	sample_rate REAL NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( serial_number )
+);
 CREATE TABLE injuredsegments (
 	id INTEGER NOT NULL,
 	info BLOB NOT NULL,
+	leased_until TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE irreparabledbs (
@@ -55,25 +128,66 @@ CREATE TABLE irreparabledbs (
 	repair_attempt_count INTEGER NOT NULL,
 	PRIMARY KEY ( segmentpath )
 );
+CREATE TABLE node_events (
+	id INTEGER NOT NULL,
+	node_id BLOB NOT NULL,
+	kind INTEGER NOT NULL,
+	reason TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE node_tags (
+	node_id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	value TEXT NOT NULL,
+	signer_id BLOB NOT NULL,
+	tag BLOB NOT NULL,
+	PRIMARY KEY ( node_id, name )
+);
 CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	audit_success_count INTEGER NOT NULL,
 	total_audit_count INTEGER NOT NULL,
 	audit_success_ratio REAL NOT NULL,
+	audit_reputation_alpha REAL NOT NULL,
+	audit_reputation_beta REAL NOT NULL,
 	uptime_success_count INTEGER NOT NULL,
 	total_uptime_count INTEGER NOT NULL,
 	uptime_ratio REAL NOT NULL,
+	uptime_reputation_alpha REAL NOT NULL,
+	uptime_reputation_beta REAL NOT NULL,
+	last_contact_success TIMESTAMP NOT NULL,
+	last_contact_failure TIMESTAMP NOT NULL,
+	vetted_at TIMESTAMP NOT NULL,
+	disqualified_at TIMESTAMP NOT NULL,
+	disqualification_reason TEXT NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	updated_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE object_egress_rollups (
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	object_path TEXT NOT NULL,
+	interval_start TIMESTAMP NOT NULL,
+	egress INTEGER NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, object_path, interval_start )
+);
 CREATE TABLE overlay_cache_nodes (
 	node_id BLOB NOT NULL,
 	node_type INTEGER NOT NULL,
 	address TEXT NOT NULL,
 	protocol INTEGER NOT NULL,
+	country_code TEXT NOT NULL,
 	operator_email TEXT NOT NULL,
 	operator_wallet TEXT NOT NULL,
+	operator_wallet_features TEXT NOT NULL,
+	operator_email_validated_at TIMESTAMP NOT NULL,
+	operator_wallet_validated_at TIMESTAMP NOT NULL,
+	ingress_rate INTEGER NOT NULL,
+	egress_rate INTEGER NOT NULL,
+	upload_success_ratio REAL NOT NULL,
+	download_success_ratio REAL NOT NULL,
 	free_bandwidth INTEGER NOT NULL,
 	free_disk INTEGER NOT NULL,
 	latency_90 INTEGER NOT NULL,
@@ -83,9 +197,78 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count INTEGER NOT NULL,
 	uptime_count INTEGER NOT NULL,
 	uptime_success_count INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
+CREATE TABLE pending_audits (
+	node_id BLOB NOT NULL,
+	path TEXT NOT NULL,
+	piece_id TEXT NOT NULL,
+	piece_num INTEGER NOT NULL,
+	stripe_index INTEGER NOT NULL,
+	share_size INTEGER NOT NULL,
+	expected_share_hash BLOB NOT NULL,
+	reverify_count INTEGER NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE prefix_quotas (
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	prefix TEXT NOT NULL,
+	max_bytes INTEGER NOT NULL,
+	max_objects INTEGER NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name, prefix )
+);
+CREATE TABLE pricing_tiers (
+	name TEXT NOT NULL,
+	storage INTEGER NOT NULL,
+	egress INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE project_alerts (
+	id BLOB NOT NULL,
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	prefix TEXT NOT NULL,
+	threshold REAL NOT NULL,
+	webhook_url TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	triggered_at TIMESTAMP,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_deletions (
+	project_id BLOB NOT NULL,
+	deleted_segments INTEGER NOT NULL,
+	deleted_bytes INTEGER NOT NULL,
+	requested_at TIMESTAMP NOT NULL,
+	finished_at TIMESTAMP,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_exports (
+	project_id BLOB NOT NULL,
+	requested_by BLOB NOT NULL,
+	exported_objects INTEGER NOT NULL,
+	exported_bytes INTEGER NOT NULL,
+	last_path TEXT NOT NULL,
+	manifest BLOB NOT NULL,
+	requested_at TIMESTAMP NOT NULL,
+	finished_at TIMESTAMP,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_salts (
+	project_id BLOB NOT NULL,
+	salt BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_tiers (
+	project_id BLOB NOT NULL,
+	tier TEXT NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE projects (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
@@ -93,6 +276,19 @@ CREATE TABLE projects (
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE repair_slo_days (
+	interval_start TIMESTAMP NOT NULL,
+	injured INTEGER NOT NULL,
+	irreparable INTEGER NOT NULL,
+	repaired INTEGER NOT NULL,
+	failed INTEGER NOT NULL,
+	PRIMARY KEY ( interval_start )
+);
+CREATE TABLE sample_snapshots (
+	created_at TIMESTAMP NOT NULL,
+	data BLOB NOT NULL,
+	PRIMARY KEY ( created_at )
+);
 CREATE TABLE users (
 	id BLOB NOT NULL,
 	first_name TEXT NOT NULL,
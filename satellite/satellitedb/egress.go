@@ -0,0 +1,211 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/accounting/egress"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// attributionBatchSize is the number of serial numbers looked up at once
+const attributionBatchSize = 100
+
+// egressAttributions is an implementation of egress.DB
+type egressAttributions struct {
+	db *dbx.DB
+}
+
+// SaveAttribution records the object of a download allocation
+func (attributions *egressAttributions) SaveAttribution(ctx context.Context, attribution egress.Attribution) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = attributions.db.ExecContext(ctx, attributions.db.Rebind(`INSERT INTO egress_attributions
+		( serial_number, project_id, bucket_name, object_path, sample_rate, expires_at, created_at )
+		VALUES ( ?, ?, ?, ?, ?, ?, ? )`),
+		attribution.SerialNumber, attribution.ProjectID[:], attribution.Bucket, attribution.ObjectPath,
+		attribution.SampleRate, attribution.ExpiresAt.UTC(), time.Now().UTC())
+	return Error.Wrap(err)
+}
+
+// objectKey identifies an object in the rollup
+type objectKey struct {
+	projectID  uuid.UUID
+	bucket     string
+	objectPath string
+}
+
+// RollupEgress sums the GET agreements stored after from until to per
+// attributed object and saves the sums as the egress of the hour starting at from
+func (attributions *egressAttributions) RollupEgress(ctx context.Context, from, to time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	totals, err := attributions.getTotals(ctx, from, to)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	serials := make([]string, 0, len(totals))
+	for serial := range totals {
+		serials = append(serials, serial)
+	}
+
+	sums := map[objectKey]float64{}
+	for len(serials) > 0 {
+		batch := serials
+		if len(batch) > attributionBatchSize {
+			batch = batch[:attributionBatchSize]
+		}
+		serials = serials[len(batch):]
+
+		if err := attributions.sumBatch(ctx, batch, totals, sums); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	tx, err := attributions.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for object, sum := range sums {
+		_, err = tx.Tx.ExecContext(ctx, attributions.db.Rebind(`INSERT INTO object_egress_rollups
+			( project_id, bucket_name, object_path, interval_start, egress ) VALUES ( ?, ?, ?, ?, ? )`),
+			object.projectID[:], object.bucket, object.objectPath, from.UTC(), int64(math.Round(sum)))
+		if err != nil {
+			return Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	}
+	return Error.Wrap(tx.Commit())
+}
+
+// getTotals returns the totals of the GET agreements stored after from until
+// to by the serial number of their allocation
+func (attributions *egressAttributions) getTotals(ctx context.Context, from, to time.Time) (_ map[string]int64, err error) {
+	rows, err := attributions.db.QueryContext(ctx, attributions.db.Rebind(`SELECT serialnum, storage_node_id, total
+		FROM bwagreements WHERE action = ? AND created_at > ? AND created_at <= ?`),
+		int64(pb.BandwidthAction_GET), from.UTC(), to.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	totals := map[string]int64{}
+	for rows.Next() {
+		var serialnum string
+		var nodeID []byte
+		var total int64
+		if err := rows.Scan(&serialnum, &nodeID, &total); err != nil {
+			return nil, err
+		}
+		id, err := storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, err
+		}
+		// the agreements are stored with the storage node id appended to the serial number
+		totals[strings.TrimSuffix(serialnum, id.String())] += total
+	}
+	return totals, rows.Err()
+}
+
+// sumBatch adds the totals of the attributed serial numbers of the batch to
+// the sums of their objects, scaled by their sample rate
+func (attributions *egressAttributions) sumBatch(ctx context.Context, batch []string, totals map[string]int64, sums map[objectKey]float64) (err error) {
+	args := make([]interface{}, len(batch))
+	for i, serial := range batch {
+		args[i] = serial
+	}
+
+	rows, err := attributions.db.QueryContext(ctx, attributions.db.Rebind(`SELECT serial_number, project_id, bucket_name, object_path, sample_rate
+		FROM egress_attributions WHERE serial_number IN (?`+strings.Repeat(", ?", len(batch)-1)+`)`), args...)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var serial string
+		var projectID []byte
+		var object objectKey
+		var rate float64
+		if err := rows.Scan(&serial, &projectID, &object.bucket, &object.objectPath, &rate); err != nil {
+			return err
+		}
+		id, err := bytesToUUID(projectID)
+		if err != nil {
+			return err
+		}
+		object.projectID = id
+
+		if rate <= 0 {
+			rate = 1
+		}
+		sums[object] += float64(totals[serial]) / rate
+	}
+	return rows.Err()
+}
+
+// LastRollup returns the end of the last hour, which has been rolled up, or the zero time
+func (attributions *egressAttributions) LastRollup(ctx context.Context) (last time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = attributions.db.QueryRowContext(ctx, `SELECT interval_start FROM object_egress_rollups
+		ORDER BY interval_start DESC LIMIT 1`).Scan(&last)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, Error.Wrap(err)
+	}
+	return last.Add(time.Hour), nil
+}
+
+// DeleteExpired deletes the attributions of allocations, which expired before the given time
+func (attributions *egressAttributions) DeleteExpired(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := attributions.db.ExecContext(ctx, attributions.db.Rebind(`DELETE FROM egress_attributions
+		WHERE expires_at < ?`), before.UTC())
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	deleted, err := result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
+
+// TopObjects returns up to limit objects of a project with the most egress
+// during the hours starting from from until to, the most egress first
+func (attributions *egressAttributions) TopObjects(ctx context.Context, projectID uuid.UUID, from, to time.Time, limit int) (_ []egress.ObjectEgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := attributions.db.QueryContext(ctx, attributions.db.Rebind(`SELECT bucket_name, object_path, SUM(egress) AS total
+		FROM object_egress_rollups
+		WHERE project_id = ? AND interval_start >= ? AND interval_start < ?
+		GROUP BY bucket_name, object_path
+		ORDER BY total DESC, bucket_name, object_path
+		LIMIT ?`), projectID[:], from.UTC(), to.UTC(), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var objects []egress.ObjectEgress
+	for rows.Next() {
+		var object egress.ObjectEgress
+		if err := rows.Scan(&object.Bucket, &object.ObjectPath, &object.Egress); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		objects = append(objects, object)
+	}
+	return objects, Error.Wrap(rows.Err())
+}
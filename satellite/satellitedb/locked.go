@@ -13,13 +13,22 @@ import (
 
 	"github.com/skyrings/skyring-common/tools/uuid"
 
+	"storj.io/storj/pkg/abuse"
 	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/accounting/egress"
+	"storj.io/storj/pkg/audit"
+	"storj.io/storj/pkg/auditlog"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/certdb"
 	"storj.io/storj/pkg/datarepair/irreparable"
 	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/datarepair/slo"
+	"storj.io/storj/pkg/nodestate"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/pricing"
+	"storj.io/storj/pkg/sampling"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite"
@@ -37,6 +46,40 @@ func newLocked(db satellite.DB) satellite.DB {
 	return &locked{&sync.Mutex{}, db}
 }
 
+// Abuse returns database for blocked uplinks, api keys and ip ranges
+func (m *locked) Abuse() abuse.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedAbuse{m.Locker, m.db.Abuse()}
+}
+
+// lockedAbuse implements locking wrapper for abuse.DB
+type lockedAbuse struct {
+	sync.Locker
+	db abuse.DB
+}
+
+// Create adds a block and returns it with its ID set
+func (m *lockedAbuse) Create(ctx context.Context, block abuse.Block) (abuse.Block, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Create(ctx, block)
+}
+
+// Delete removes a block, returning ErrNotFound when it doesn't exist
+func (m *lockedAbuse) Delete(ctx context.Context, id int64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, id)
+}
+
+// ListActive returns the blocks which expire after now
+func (m *lockedAbuse) ListActive(ctx context.Context, now time.Time) ([]abuse.Block, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListActive(ctx, now)
+}
+
 // Accounting returns database for storing information about data use
 func (m *locked) Accounting() accounting.DB {
 	m.Lock()
@@ -50,6 +93,13 @@ type lockedAccounting struct {
 	db accounting.DB
 }
 
+// DeleteRawForNodes removes the raw tallies of nodes which haven't been rolled up yet
+func (m *lockedAccounting) DeleteRawForNodes(ctx context.Context, nodeIDs storj.NodeIDList) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteRawForNodes(ctx, nodeIDs)
+}
+
 // GetRaw retrieves all raw tallies
 func (m *lockedAccounting) GetRaw(ctx context.Context) ([]*accounting.Raw, error) {
 	m.Lock()
@@ -57,6 +107,13 @@ func (m *lockedAccounting) GetRaw(ctx context.Context) ([]*accounting.Raw, error
 	return m.db.GetRaw(ctx)
 }
 
+// GetRawAfter returns up to limit raw tallies with an id greater than id, ordered by id
+func (m *lockedAccounting) GetRawAfter(ctx context.Context, id int64, limit int) ([]*accounting.Raw, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetRawAfter(ctx, id, limit)
+}
+
 // GetRawSince r retrieves all raw tallies sinces
 func (m *lockedAccounting) GetRawSince(ctx context.Context, latestRollup time.Time) ([]*accounting.Raw, error) {
 	m.Lock()
@@ -64,6 +121,13 @@ func (m *lockedAccounting) GetRawSince(ctx context.Context, latestRollup time.Ti
 	return m.db.GetRawSince(ctx, latestRollup)
 }
 
+// GetRollupsAfter returns up to limit rollups with an id greater than id, ordered by id
+func (m *lockedAccounting) GetRollupsAfter(ctx context.Context, id int64, limit int) ([]*accounting.Rollup, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetRollupsAfter(ctx, id, limit)
+}
+
 // LastTimestamp records the latest last tallied time.
 func (m *lockedAccounting) LastTimestamp(ctx context.Context, timestampType string) (time.Time, error) {
 	m.Lock()
@@ -99,6 +163,33 @@ func (m *lockedAccounting) SaveRollup(ctx context.Context, latestTally time.Time
 	return m.db.SaveRollup(ctx, latestTally, stats)
 }
 
+// AuditLog returns the audit log of console and admin api operations
+func (m *locked) AuditLog() auditlog.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedAuditLog{m.Locker, m.db.AuditLog()}
+}
+
+// lockedAuditLog implements locking wrapper for auditlog.DB
+type lockedAuditLog struct {
+	sync.Locker
+	db auditlog.DB
+}
+
+// Append adds an entry and returns it with its ID and creation time set
+func (m *lockedAuditLog) Append(ctx context.Context, entry auditlog.Entry) (auditlog.Entry, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Append(ctx, entry)
+}
+
+// List returns up to limit entries created in [since, until), oldest first
+func (m *lockedAuditLog) List(ctx context.Context, since time.Time, until time.Time, limit int, offset int64) ([]auditlog.Entry, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.List(ctx, since, until, limit, offset)
+}
+
 // BandwidthAgreement returns database for storing bandwidth agreements
 func (m *locked) BandwidthAgreement() bwagreement.DB {
 	m.Lock()
@@ -112,6 +203,13 @@ type lockedBandwidthAgreement struct {
 	db bwagreement.DB
 }
 
+// ArchiveExpired moves the agreements, which were created and expired before the given time, to the archive.
+func (m *lockedBandwidthAgreement) ArchiveExpired(ctx context.Context, a1 time.Time) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ArchiveExpired(ctx, a1)
+}
+
 // CreateAgreement adds a new bandwidth agreement.
 func (m *lockedBandwidthAgreement) CreateAgreement(ctx context.Context, a1 *pb.RenterBandwidthAllocation) error {
 	m.Lock()
@@ -119,6 +217,20 @@ func (m *lockedBandwidthAgreement) CreateAgreement(ctx context.Context, a1 *pb.R
 	return m.db.CreateAgreement(ctx, a1)
 }
 
+// CreateAgreements adds new bandwidth agreements in a single transaction.
+func (m *lockedBandwidthAgreement) CreateAgreements(ctx context.Context, a1 []*pb.RenterBandwidthAllocation) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CreateAgreements(ctx, a1)
+}
+
+// DeleteExpired deletes the agreements, which were created and expired before the given time.
+func (m *lockedBandwidthAgreement) DeleteExpired(ctx context.Context, a1 time.Time) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteExpired(ctx, a1)
+}
+
 // GetTotalsSince returns the sum of each bandwidth type after (exluding) a given date range
 func (m *lockedBandwidthAgreement) GetTotals(ctx context.Context, a1 time.Time, a2 time.Time) (map[storj.NodeID][]int64, error) {
 	m.Lock()
@@ -126,6 +238,22 @@ func (m *lockedBandwidthAgreement) GetTotals(ctx context.Context, a1 time.Time,
 	return m.db.GetTotals(ctx, a1, a2)
 }
 
+// GetTotalsBucketed returns the rolled up totals of each storage node between from (inclusive) and to
+// (exclusive), summed into buckets of the given interval.
+func (m *lockedBandwidthAgreement) GetTotalsBucketed(ctx context.Context, from time.Time, to time.Time, interval time.Duration) ([]bwagreement.TotalsBucket, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetTotalsBucketed(ctx, from, to, interval)
+}
+
+// GetSuspiciousNodes returns the storage nodes with at least minAnomalies agreements flagged since the
+// given time, most flagged first.
+func (m *lockedBandwidthAgreement) GetSuspiciousNodes(ctx context.Context, since time.Time, minAnomalies int) ([]bwagreement.SuspiciousNode, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetSuspiciousNodes(ctx, since, minAnomalies)
+}
+
 // GetTotals returns stats about an uplink
 func (m *lockedBandwidthAgreement) GetUplinkStats(ctx context.Context, a1 time.Time, a2 time.Time) ([]bwagreement.UplinkStat, error) {
 	m.Lock()
@@ -133,6 +261,75 @@ func (m *lockedBandwidthAgreement) GetUplinkStats(ctx context.Context, a1 time.T
 	return m.db.GetUplinkStats(ctx, a1, a2)
 }
 
+// GetUplinkStatsPage returns a page of the stats of the uplinks selected by opts.
+func (m *lockedBandwidthAgreement) GetUplinkStatsPage(ctx context.Context, opts bwagreement.UplinkStatsOptions) (bwagreement.UplinkStatsPage, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetUplinkStatsPage(ctx, opts)
+}
+
+// HasAgreement returns whether the storage node has already submitted the agreement with the serial number.
+func (m *lockedBandwidthAgreement) HasAgreement(ctx context.Context, serialNumber string, storageNodeID storj.NodeID) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.HasAgreement(ctx, serialNumber, storageNodeID)
+}
+
+// LastRollup returns the end of the last hour, which has been rolled up, or the zero time.
+func (m *lockedBandwidthAgreement) LastRollup(ctx context.Context) (time.Time, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.LastRollup(ctx)
+}
+
+// ReplayAgreement adds a bandwidth agreement with the creation time of its payer allocation.
+func (m *lockedBandwidthAgreement) ReplayAgreement(ctx context.Context, a1 *pb.RenterBandwidthAllocation) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ReplayAgreement(ctx, a1)
+}
+
+// SaveAnomaly flags an agreement, which claims a total near or above the max size of its allocation.
+func (m *lockedBandwidthAgreement) SaveAnomaly(ctx context.Context, a1 *pb.RenterBandwidthAllocation) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SaveAnomaly(ctx, a1)
+}
+
+// SaveRollup stores the totals of each storage node during the hour starting at the given time.
+func (m *lockedBandwidthAgreement) SaveRollup(ctx context.Context, a1 time.Time, a2 map[storj.NodeID][]int64) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SaveRollup(ctx, a1, a2)
+}
+
+// BucketLocks returns database for the object locks of buckets
+func (m *locked) BucketLocks() pointerdb.BucketLocks {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedBucketLocks{m.Locker, m.db.BucketLocks()}
+}
+
+// lockedBucketLocks implements locking wrapper for pointerdb.BucketLocks
+type lockedBucketLocks struct {
+	sync.Locker
+	db pointerdb.BucketLocks
+}
+
+// Get returns the lock of a bucket, it's nil when the bucket has none
+func (m *lockedBucketLocks) Get(ctx context.Context, projectID uuid.UUID, bucket string) (*pointerdb.BucketLock, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID, bucket)
+}
+
+// Set adds or replaces the lock of a bucket
+func (m *lockedBucketLocks) Set(ctx context.Context, lock pointerdb.BucketLock) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Set(ctx, lock)
+}
+
 // CertDB returns database for storing uplink's public key & ID
 func (m *locked) CertDB() certdb.DB {
 	m.Lock()
@@ -249,6 +446,143 @@ func (m *lockedConsole) CreateTables() error {
 	return m.db.CreateTables()
 }
 
+// ProjectAlerts is a getter for ProjectAlerts repository
+func (m *lockedConsole) ProjectAlerts() console.ProjectAlerts {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedProjectAlerts{m.Locker, m.db.ProjectAlerts()}
+}
+
+// lockedProjectAlerts implements locking wrapper for console.ProjectAlerts
+type lockedProjectAlerts struct {
+	sync.Locker
+	db console.ProjectAlerts
+}
+
+// Delete is a method for deleting an alert by id from the database.
+func (m *lockedProjectAlerts) Delete(ctx context.Context, id uuid.UUID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, id)
+}
+
+// Get is a method for querying an alert from the database by id.
+func (m *lockedProjectAlerts) Get(ctx context.Context, id uuid.UUID) (*console.ProjectAlert, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, id)
+}
+
+// GetAll is a method for querying all alerts from the database.
+func (m *lockedProjectAlerts) GetAll(ctx context.Context) ([]console.ProjectAlert, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetAll(ctx)
+}
+
+// GetByProjectID is a method for querying the alerts of a project from the database.
+func (m *lockedProjectAlerts) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]console.ProjectAlert, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByProjectID(ctx, projectID)
+}
+
+// Insert is a method for inserting an alert into the database.
+func (m *lockedProjectAlerts) Insert(ctx context.Context, alert *console.ProjectAlert) (*console.ProjectAlert, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, alert)
+}
+
+// UpdateTriggered is a method for recording when an alert was triggered, zero time resets it.
+func (m *lockedProjectAlerts) UpdateTriggered(ctx context.Context, id uuid.UUID, triggeredAt time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateTriggered(ctx, id, triggeredAt)
+}
+
+// ProjectDeletions is a getter for ProjectDeletions repository
+func (m *lockedConsole) ProjectDeletions() console.ProjectDeletions {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedProjectDeletions{m.Locker, m.db.ProjectDeletions()}
+}
+
+// lockedProjectDeletions implements locking wrapper for console.ProjectDeletions
+type lockedProjectDeletions struct {
+	sync.Locker
+	db console.ProjectDeletions
+}
+
+// Get is a method for querying the deletion of a project by project id.
+func (m *lockedProjectDeletions) Get(ctx context.Context, projectID uuid.UUID) (*console.ProjectDeletion, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID)
+}
+
+// GetUnfinished is a method for querying deletions, which still have data to purge, oldest first.
+func (m *lockedProjectDeletions) GetUnfinished(ctx context.Context) ([]console.ProjectDeletion, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetUnfinished(ctx)
+}
+
+// Insert is a method for marking a project as deleted.
+func (m *lockedProjectDeletions) Insert(ctx context.Context, projectID uuid.UUID) (*console.ProjectDeletion, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, projectID)
+}
+
+// Update is a method for updating the progress of a deletion.
+func (m *lockedProjectDeletions) Update(ctx context.Context, deletion *console.ProjectDeletion) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Update(ctx, deletion)
+}
+
+// ProjectExports is a getter for ProjectExports repository
+func (m *lockedConsole) ProjectExports() console.ProjectExports {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedProjectExports{m.Locker, m.db.ProjectExports()}
+}
+
+// lockedProjectExports implements locking wrapper for console.ProjectExports
+type lockedProjectExports struct {
+	sync.Locker
+	db console.ProjectExports
+}
+
+// Get is a method for querying the export of a project by project id, it's nil when there is none.
+func (m *lockedProjectExports) Get(ctx context.Context, projectID uuid.UUID) (*console.ProjectExport, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, projectID)
+}
+
+// GetUnfinished is a method for querying exports, which still have objects to export, oldest first.
+func (m *lockedProjectExports) GetUnfinished(ctx context.Context) ([]console.ProjectExport, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetUnfinished(ctx)
+}
+
+// Insert is a method for requesting the export of a project, it replaces a previous export.
+func (m *lockedProjectExports) Insert(ctx context.Context, projectID uuid.UUID, requestedBy uuid.UUID) (*console.ProjectExport, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Insert(ctx, projectID, requestedBy)
+}
+
+// Update is a method for updating the progress and the manifest of an export.
+func (m *lockedProjectExports) Update(ctx context.Context, export *console.ProjectExport) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Update(ctx, export)
+}
+
 // ProjectMembers is a getter for ProjectMembers repository
 func (m *lockedConsole) ProjectMembers() console.ProjectMembers {
 	m.Lock()
@@ -393,6 +727,40 @@ func (m *lockedUsers) Update(ctx context.Context, user *console.User) error {
 	return m.db.Update(ctx, user)
 }
 
+// Containment returns database for the pending audits of contained nodes
+func (m *locked) Containment() audit.Containment {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedContainment{m.Locker, m.db.Containment()}
+}
+
+// lockedContainment implements locking wrapper for audit.Containment
+type lockedContainment struct {
+	sync.Locker
+	db audit.Containment
+}
+
+// Delete releases a node from containment, it returns whether the node was contained
+func (m *lockedContainment) Delete(ctx context.Context, nodeID storj.NodeID) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, nodeID)
+}
+
+// Get returns the pending audit of a node, ErrContainedNotFound when it isn't contained
+func (m *lockedContainment) Get(ctx context.Context, nodeID storj.NodeID) (*audit.PendingAudit, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Get(ctx, nodeID)
+}
+
+// IncrementPending contains a node for the pending audit, or increments the reverify count
+func (m *lockedContainment) IncrementPending(ctx context.Context, pending *audit.PendingAudit) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.IncrementPending(ctx, pending)
+}
+
 // CreateSchema sets the schema
 func (m *locked) CreateSchema(schema string) error {
 	m.Lock()
@@ -414,6 +782,56 @@ func (m *locked) DropSchema(schema string) error {
 	return m.db.DropSchema(schema)
 }
 
+// EgressAttributions returns database for the egress attributed to objects
+func (m *locked) EgressAttributions() egress.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedEgressAttributions{m.Locker, m.db.EgressAttributions()}
+}
+
+// lockedEgressAttributions implements locking wrapper for egress.DB
+type lockedEgressAttributions struct {
+	sync.Locker
+	db egress.DB
+}
+
+// DeleteExpired deletes the attributions of allocations, which expired before the given time
+func (m *lockedEgressAttributions) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.DeleteExpired(ctx, before)
+}
+
+// LastRollup returns the end of the last hour, which has been rolled up, or the zero time
+func (m *lockedEgressAttributions) LastRollup(ctx context.Context) (time.Time, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.LastRollup(ctx)
+}
+
+// RollupEgress sums the GET agreements stored after from until to per
+// attributed object and saves the sums as the egress of the hour starting at from
+func (m *lockedEgressAttributions) RollupEgress(ctx context.Context, from time.Time, to time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.RollupEgress(ctx, from, to)
+}
+
+// SaveAttribution records the object of a download allocation
+func (m *lockedEgressAttributions) SaveAttribution(ctx context.Context, attribution egress.Attribution) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SaveAttribution(ctx, attribution)
+}
+
+// TopObjects returns up to limit objects of a project with the most
+// egress during the hours starting from from until to, the most egress first
+func (m *lockedEgressAttributions) TopObjects(ctx context.Context, projectID uuid.UUID, from time.Time, to time.Time, limit int) ([]egress.ObjectEgress, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.TopObjects(ctx, projectID, from, to, limit)
+}
+
 // Irreparable returns database for failed repairs
 func (m *locked) Irreparable() irreparable.DB {
 	m.Lock()
@@ -448,6 +866,54 @@ func (m *lockedIrreparable) IncrementRepairAttempts(ctx context.Context, segment
 	return m.db.IncrementRepairAttempts(ctx, segmentInfo)
 }
 
+// NodeEvents returns the log of node state changes
+func (m *locked) NodeEvents() nodestate.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedNodeEvents{m.Locker, m.db.NodeEvents()}
+}
+
+// lockedNodeEvents implements locking wrapper for nodestate.DB
+type lockedNodeEvents struct {
+	sync.Locker
+	db nodestate.DB
+}
+
+// Append adds an event to the log
+func (m *lockedNodeEvents) Append(ctx context.Context, event nodestate.Event) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Append(ctx, event)
+}
+
+// Last returns the most recent event of a node with one of the given kinds, nil when there is none
+func (m *lockedNodeEvents) Last(ctx context.Context, nodeID storj.NodeID, kinds ...nodestate.Kind) (*nodestate.Event, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Last(ctx, nodeID, kinds...)
+}
+
+// List returns the events of a node, oldest first
+func (m *lockedNodeEvents) List(ctx context.Context, nodeID storj.NodeID) ([]nodestate.Event, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.List(ctx, nodeID)
+}
+
+// ListAfter returns up to limit events of all nodes with an ID greater than afterID, oldest first
+func (m *lockedNodeEvents) ListAfter(ctx context.Context, afterID int64, limit int) ([]nodestate.Event, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListAfter(ctx, afterID, limit)
+}
+
+// LastID returns the ID of the most recent event of all nodes, 0 when there is none
+func (m *lockedNodeEvents) LastID(ctx context.Context) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.LastID(ctx)
+}
+
 // OverlayCache returns database for caching overlay information
 func (m *locked) OverlayCache() overlay.DB {
 	m.Lock()
@@ -461,6 +927,13 @@ type lockedOverlayCache struct {
 	db overlay.DB
 }
 
+// ClearAddressMismatch removes the flag of a node
+func (m *lockedOverlayCache) ClearAddressMismatch(ctx context.Context, id storj.NodeID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ClearAddressMismatch(ctx, id)
+}
+
 // Delete deletes node based on id
 func (m *lockedOverlayCache) Delete(ctx context.Context, id storj.NodeID) error {
 	m.Lock()
@@ -468,6 +941,13 @@ func (m *lockedOverlayCache) Delete(ctx context.Context, id storj.NodeID) error
 	return m.db.Delete(ctx, id)
 }
 
+// FlagAddressMismatch flags the address of a node as answered by a different node, nodes aren't selected while they advertise a flagged address
+func (m *lockedOverlayCache) FlagAddressMismatch(ctx context.Context, mismatch overlay.AddressMismatch) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.FlagAddressMismatch(ctx, mismatch)
+}
+
 // Get looks up the node by nodeID
 func (m *lockedOverlayCache) Get(ctx context.Context, nodeID storj.NodeID) (*pb.Node, error) {
 	m.Lock()
@@ -482,6 +962,34 @@ func (m *lockedOverlayCache) GetAll(ctx context.Context, nodeIDs storj.NodeIDLis
 	return m.db.GetAll(ctx, nodeIDs)
 }
 
+// GetByWallet returns the nodes paid to the wallet address
+func (m *lockedOverlayCache) GetByWallet(ctx context.Context, wallet string) ([]*pb.Node, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetByWallet(ctx, wallet)
+}
+
+// GetMetadataValidation returns when the operator's email and wallet were last found valid
+func (m *lockedOverlayCache) GetMetadataValidation(ctx context.Context, id storj.NodeID) (overlay.MetadataValidation, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetMetadataValidation(ctx, id)
+}
+
+// GetMissingWallet returns up to limit storage nodes without a wallet address ordered by id, starting after cursor
+func (m *lockedOverlayCache) GetMissingWallet(ctx context.Context, cursor storj.NodeID, limit int) ([]*pb.Node, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetMissingWallet(ctx, cursor, limit)
+}
+
+// GetTags returns the signed tags of a node
+func (m *lockedOverlayCache) GetTags(ctx context.Context, nodeID storj.NodeID) ([]*pb.NodeTag, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetTags(ctx, nodeID)
+}
+
 // GetWalletAddress gets the node's wallet address
 func (m *lockedOverlayCache) GetWalletAddress(ctx context.Context, id storj.NodeID) (string, error) {
 	m.Lock()
@@ -496,6 +1004,21 @@ func (m *lockedOverlayCache) List(ctx context.Context, cursor storj.NodeID, limi
 	return m.db.List(ctx, cursor, limit)
 }
 
+// ListAddressMismatches returns up to limit flagged nodes, the most recently detected first
+func (m *lockedOverlayCache) ListAddressMismatches(ctx context.Context, limit int) ([]overlay.AddressMismatch, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListAddressMismatches(ctx, limit)
+}
+
+// ListStray lists up to limit storage nodes with less than auditThreshold audits,
+// which haven't been updated since lastSeenBefore
+func (m *lockedOverlayCache) ListStray(ctx context.Context, auditThreshold int64, lastSeenBefore time.Time, limit int) (storj.NodeIDList, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListStray(ctx, auditThreshold, lastSeenBefore, limit)
+}
+
 // Paginate will page through the database nodes
 func (m *lockedOverlayCache) Paginate(ctx context.Context, offset int64, limit int) ([]*pb.Node, bool, error) {
 	m.Lock()
@@ -503,6 +1026,13 @@ func (m *lockedOverlayCache) Paginate(ctx context.Context, offset int64, limit i
 	return m.db.Paginate(ctx, offset, limit)
 }
 
+// QueryNodes returns the nodes matching the query and whether there are more of them
+func (m *lockedOverlayCache) QueryNodes(ctx context.Context, query overlay.NodeQuery) ([]*pb.Node, bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.QueryNodes(ctx, query)
+}
+
 // SelectNewNodes looks up nodes based on new node criteria
 func (m *lockedOverlayCache) SelectNewNodes(ctx context.Context, count int, criteria *overlay.NewNodeCriteria) ([]*pb.Node, error) {
 	m.Lock()
@@ -524,6 +1054,136 @@ func (m *lockedOverlayCache) Update(ctx context.Context, value *pb.Node) error {
 	return m.db.Update(ctx, value)
 }
 
+// UpdateAll updates the information of multiple nodes in a single transaction
+func (m *lockedOverlayCache) UpdateAll(ctx context.Context, values []*pb.Node) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateAll(ctx, values)
+}
+
+// UpdateMetadataValidation records when the operator's email and wallet were last found valid
+func (m *lockedOverlayCache) UpdateMetadataValidation(ctx context.Context, id storj.NodeID, validation overlay.MetadataValidation) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateMetadataValidation(ctx, id, validation)
+}
+
+// UpdateTag stores a signed tag of a node, replacing an earlier tag with the same name
+func (m *lockedOverlayCache) UpdateTag(ctx context.Context, tag *pb.NodeTag) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateTag(ctx, tag)
+}
+
+// UpdateThroughput stores the recent throughput reported by the node
+func (m *lockedOverlayCache) UpdateThroughput(ctx context.Context, id storj.NodeID, throughput *pb.NodeThroughput) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateThroughput(ctx, id, throughput)
+}
+
+// Ping checks whether the database is reachable
+func (m *locked) Ping(ctx context.Context) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Ping(ctx)
+}
+
+// PrefixQuotas returns database for the prefix quotas of buckets
+func (m *locked) PrefixQuotas() pointerdb.PrefixQuotas {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedPrefixQuotas{m.Locker, m.db.PrefixQuotas()}
+}
+
+// lockedPrefixQuotas implements locking wrapper for pointerdb.PrefixQuotas
+type lockedPrefixQuotas struct {
+	sync.Locker
+	db pointerdb.PrefixQuotas
+}
+
+// Delete removes the quota of a prefix
+func (m *lockedPrefixQuotas) Delete(ctx context.Context, projectID uuid.UUID, bucket string, prefix string) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Delete(ctx, projectID, bucket, prefix)
+}
+
+// GetAll returns the quotas of all prefixes of a bucket
+func (m *lockedPrefixQuotas) GetAll(ctx context.Context, projectID uuid.UUID, bucket string) ([]pointerdb.PrefixQuota, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetAll(ctx, projectID, bucket)
+}
+
+// Set adds or replaces the quota of a prefix
+func (m *lockedPrefixQuotas) Set(ctx context.Context, quota pointerdb.PrefixQuota) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Set(ctx, quota)
+}
+
+// Pricing returns database for the pricing tiers and the tiers of the projects
+func (m *locked) Pricing() pricing.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedPricing{m.Locker, m.db.Pricing()}
+}
+
+// lockedPricing implements locking wrapper for pricing.DB
+type lockedPricing struct {
+	sync.Locker
+	db pricing.DB
+}
+
+// GetProjectTier returns the tier of a project, it's empty when the project hasn't been assigned one
+func (m *lockedPricing) GetProjectTier(ctx context.Context, projectID uuid.UUID) (pricing.Tier, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetProjectTier(ctx, projectID)
+}
+
+// GetRates returns the rates of a tier, it's nil when they haven't been set
+func (m *lockedPricing) GetRates(ctx context.Context, tier pricing.Tier) (*pricing.Rates, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetRates(ctx, tier)
+}
+
+// SetProjectTier assigns a tier to a project
+func (m *lockedPricing) SetProjectTier(ctx context.Context, projectID uuid.UUID, tier pricing.Tier) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SetProjectTier(ctx, projectID, tier)
+}
+
+// SetRates adds or replaces the rates of a tier
+func (m *lockedPricing) SetRates(ctx context.Context, rates pricing.Rates) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SetRates(ctx, rates)
+}
+
+// ProjectSalts returns database for the salts of the projects
+func (m *locked) ProjectSalts() pointerdb.ProjectSalts {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedProjectSalts{m.Locker, m.db.ProjectSalts()}
+}
+
+// lockedProjectSalts implements locking wrapper for pointerdb.ProjectSalts
+type lockedProjectSalts struct {
+	sync.Locker
+	db pointerdb.ProjectSalts
+}
+
+// GetOrCreate returns the salt of a project, the given salt is stored when the project has none yet
+func (m *lockedProjectSalts) GetOrCreate(ctx context.Context, projectID uuid.UUID, salt []byte) ([]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetOrCreate(ctx, projectID, salt)
+}
+
 // RepairQueue returns queue for segments that need repairing
 func (m *locked) RepairQueue() queue.RepairQueue {
 	m.Lock()
@@ -537,6 +1197,20 @@ type lockedRepairQueue struct {
 	db queue.RepairQueue
 }
 
+// Claim leases the next injured segment that isn't leased by another worker.
+func (m *lockedRepairQueue) Claim(ctx context.Context, ttl time.Duration) (*queue.Lease, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Claim(ctx, ttl)
+}
+
+// Complete removes a leased segment after it has been repaired.
+func (m *lockedRepairQueue) Complete(ctx context.Context, lease *queue.Lease) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Complete(ctx, lease)
+}
+
 // Dequeue removes an injured segment.
 func (m *lockedRepairQueue) Dequeue(ctx context.Context) (pb.InjuredSegment, error) {
 	m.Lock()
@@ -551,6 +1225,13 @@ func (m *lockedRepairQueue) Enqueue(ctx context.Context, qi *pb.InjuredSegment)
 	return m.db.Enqueue(ctx, qi)
 }
 
+// Extend keeps a lease alive for another ttl.
+func (m *lockedRepairQueue) Extend(ctx context.Context, lease *queue.Lease, ttl time.Duration) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Extend(ctx, lease, ttl)
+}
+
 // Peekqueue lists limit amount of injured segments.
 func (m *lockedRepairQueue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSegment, error) {
 	m.Lock()
@@ -558,6 +1239,60 @@ func (m *lockedRepairQueue) Peekqueue(ctx context.Context, limit int) ([]pb.Inju
 	return m.db.Peekqueue(ctx, limit)
 }
 
+// RepairSLO returns database for the daily repair counts of the slo report
+func (m *locked) RepairSLO() slo.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedRepairSLO{m.Locker, m.db.RepairSLO()}
+}
+
+// lockedRepairSLO implements locking wrapper for slo.DB
+type lockedRepairSLO struct {
+	sync.Locker
+	db slo.DB
+}
+
+// Increment adds the counts to the day starting at intervalStart
+func (m *lockedRepairSLO) Increment(ctx context.Context, intervalStart time.Time, counts slo.Counts) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Increment(ctx, intervalStart, counts)
+}
+
+// List returns the counts of the days since the day starting at since, the oldest first
+func (m *lockedRepairSLO) List(ctx context.Context, since time.Time) ([]*slo.Day, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.List(ctx, since)
+}
+
+// Samples returns database for the snapshots of the pointer samples
+func (m *locked) Samples() sampling.DB {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedSamples{m.Locker, m.db.Samples()}
+}
+
+// lockedSamples implements locking wrapper for sampling.DB
+type lockedSamples struct {
+	sync.Locker
+	db sampling.DB
+}
+
+// List returns up to limit of the latest snapshots, the newest first
+func (m *lockedSamples) List(ctx context.Context, limit int) ([]*sampling.Snapshot, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.List(ctx, limit)
+}
+
+// Save stores a snapshot
+func (m *lockedSamples) Save(ctx context.Context, snapshot *sampling.Snapshot) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Save(ctx, snapshot)
+}
+
 // StatDB returns database for storing node statistics
 func (m *locked) StatDB() statdb.DB {
 	m.Lock()
@@ -585,6 +1320,13 @@ func (m *lockedStatDB) CreateEntryIfNotExists(ctx context.Context, nodeID storj.
 	return m.db.CreateEntryIfNotExists(ctx, nodeID)
 }
 
+// Disqualify marks the node as disqualified, the time and reason of an earlier disqualification are kept.
+func (m *lockedStatDB) Disqualify(ctx context.Context, nodeID storj.NodeID, reason string) (stats *statdb.NodeStats, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Disqualify(ctx, nodeID, reason)
+}
+
 // FindInvalidNodes finds a subset of storagenodes that have stats below provided reputation requirements.
 func (m *lockedStatDB) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *statdb.NodeStats) (invalid storj.NodeIDList, err error) {
 	m.Lock()
@@ -599,6 +1341,20 @@ func (m *lockedStatDB) Get(ctx context.Context, nodeID storj.NodeID) (stats *sta
 	return m.db.Get(ctx, nodeID)
 }
 
+// ListDisqualified returns up to limit disqualified nodes, most recently disqualified first.
+func (m *lockedStatDB) ListDisqualified(ctx context.Context, limit int) (statslist []*statdb.NodeStats, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListDisqualified(ctx, limit)
+}
+
+// Reinstate lifts the disqualification of the node.
+func (m *lockedStatDB) Reinstate(ctx context.Context, nodeID storj.NodeID) (stats *statdb.NodeStats, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Reinstate(ctx, nodeID)
+}
+
 // Update all parts of single storagenode's stats.
 func (m *lockedStatDB) Update(ctx context.Context, request *statdb.UpdateRequest) (stats *statdb.NodeStats, err error) {
 	m.Lock()
@@ -613,7 +1369,7 @@ func (m *lockedStatDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.Node
 	return m.db.UpdateAuditSuccess(ctx, nodeID, auditSuccess)
 }
 
-// UpdateBatch for updating multiple storage nodes' stats.
+// UpdateBatch updates multiple storage nodes' stats in a single transaction, the requests of unknown nodes are returned as failed.
 func (m *lockedStatDB) UpdateBatch(ctx context.Context, requests []*statdb.UpdateRequest) (statslist []*statdb.NodeStats, failed []*statdb.UpdateRequest, err error) {
 	m.Lock()
 	defer m.Unlock()
@@ -626,3 +1382,10 @@ func (m *lockedStatDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, is
 	defer m.Unlock()
 	return m.db.UpdateUptime(ctx, nodeID, isUp)
 }
+
+// Vet marks the node as vetted when its stats meet the criteria, vetted nodes stay vetted.
+func (m *lockedStatDB) Vet(ctx context.Context, nodeID storj.NodeID, criteria *statdb.VettingCriteria) (stats *statdb.NodeStats, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.Vet(ctx, nodeID, criteria)
+}
@@ -0,0 +1,413 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"database/sql"
+	"sort"
+	"strings"
+
+	"storj.io/storj/pkg/utils"
+)
+
+// schemaIdentifier is the id of the schema of the database in table_schemas
+const schemaIdentifier = "database"
+
+// migration is a step from one version of the schema to the next one. The
+// definitions of the created tables and added columns are taken from the
+// current schema.
+type migration struct {
+	// description describes the change of the schema
+	description string
+	// tables are the created tables
+	tables []string
+	// columns are the columns added to existing tables
+	columns []column
+	// update initializes the added columns of the existing rows
+	update string
+}
+
+// column is a column added to an existing table, with the value of the
+// existing rows
+type column struct {
+	table, name, value string
+}
+
+// zeroTime is the value of the timestamp columns of existing rows, which is
+// read as the zero time
+const zeroTime = `'0001-01-01 00:00:00+00:00'`
+
+// migrations are the steps from the schema without version to the current
+// schema in order. A change of the schema appends a step, which brings the
+// existing databases to the new schema.
+var migrations = []migration{
+	{
+		description: "add the reputations of the nodes",
+		columns: []column{
+			{"nodes", "audit_reputation_alpha", "0"},
+			{"nodes", "audit_reputation_beta", "0"},
+			{"nodes", "uptime_reputation_alpha", "0"},
+			{"nodes", "uptime_reputation_beta", "0"},
+		},
+		// the reputations weigh the earlier results equally
+		update: `UPDATE nodes SET
+			audit_reputation_alpha = audit_success_count,
+			audit_reputation_beta = total_audit_count - audit_success_count,
+			uptime_reputation_alpha = uptime_success_count,
+			uptime_reputation_beta = total_uptime_count - uptime_success_count;`,
+	},
+	{
+		description: "add the wallet features of the node operators",
+		columns: []column{
+			{"overlay_cache_nodes", "operator_wallet_features", "''"},
+		},
+	},
+	{
+		description: "add the leases of the injured segments",
+		columns: []column{
+			// the existing segments aren't leased
+			{"injuredsegments", "leased_until", zeroTime},
+		},
+	},
+	{
+		description: "add the throughput of the nodes",
+		columns: []column{
+			// the existing nodes haven't reported their throughput yet
+			{"overlay_cache_nodes", "ingress_rate", "0"},
+			{"overlay_cache_nodes", "egress_rate", "0"},
+			{"overlay_cache_nodes", "upload_success_ratio", "-1"},
+			{"overlay_cache_nodes", "download_success_ratio", "-1"},
+		},
+	},
+	{
+		description: "add the abuse blocks",
+		tables:      []string{"abuse_blocks"},
+	},
+	{
+		description: "add the update times of the nodes",
+		columns: []column{
+			{"overlay_cache_nodes", "updated_at", zeroTime},
+		},
+		// the existing nodes aren't stray before they had time to be updated
+		update: `UPDATE overlay_cache_nodes SET updated_at = CURRENT_TIMESTAMP;`,
+	},
+	{
+		description: "add the audit log",
+		tables:      []string{"audit_logs"},
+	},
+	{
+		description: "add the project deletions",
+		tables:      []string{"project_deletions"},
+	},
+	{
+		description: "add the node tags",
+		tables:      []string{"node_tags"},
+	},
+	{
+		description: "add the prefix quotas",
+		tables:      []string{"prefix_quotas"},
+	},
+	{
+		description: "add the archived bandwidth agreements",
+		tables:      []string{"bwagreement_archives"},
+	},
+	{
+		description: "add the bandwidth agreement rollups",
+		tables:      []string{"bwagreement_rollups"},
+	},
+	{
+		description: "add the bandwidth agreement anomalies",
+		tables:      []string{"bwagreement_anomalies"},
+	},
+	{
+		description: "add the node events",
+		tables:      []string{"node_events"},
+	},
+	{
+		description: "add the project alerts",
+		tables:      []string{"project_alerts"},
+	},
+	{
+		description: "add the bucket locks",
+		tables:      []string{"bucket_locks"},
+	},
+	{
+		description: "add the countries of the nodes",
+		columns: []column{
+			// the country of the existing nodes is resolved at their next check-in
+			{"overlay_cache_nodes", "country_code", "''"},
+		},
+	},
+	{
+		description: "add the sample snapshots",
+		tables:      []string{"sample_snapshots"},
+	},
+	{
+		description: "add the contact times of the nodes",
+		columns: []column{
+			{"nodes", "last_contact_success", zeroTime},
+			{"nodes", "last_contact_failure", zeroTime},
+		},
+		// the existing nodes were last contacted, when their stats were updated
+		update: `UPDATE nodes SET last_contact_success = updated_at;`,
+	},
+	{
+		description: "add the egress attributions",
+		tables:      []string{"egress_attributions", "object_egress_rollups"},
+	},
+	{
+		description: "add the vetting times of the nodes",
+		columns: []column{
+			{"nodes", "vetted_at", zeroTime},
+		},
+		// the existing nodes were already selected for uploads, so they stay vetted
+		update: `UPDATE nodes SET vetted_at = CURRENT_TIMESTAMP;`,
+	},
+	{
+		description: "add the address mismatches",
+		tables:      []string{"address_mismatches"},
+	},
+	{
+		description: "add the disqualifications of the nodes",
+		columns: []column{
+			// the existing nodes aren't disqualified
+			{"nodes", "disqualified_at", zeroTime},
+			{"nodes", "disqualification_reason", "''"},
+		},
+	},
+	{
+		description: "add the pricing tiers",
+		tables:      []string{"pricing_tiers", "project_tiers"},
+	},
+	{
+		description: "add the pending audits",
+		tables:      []string{"pending_audits"},
+	},
+	{
+		description: "add the validation times of the operator addresses",
+		columns: []column{
+			// the addresses of the existing nodes are validated at their next check-in
+			{"overlay_cache_nodes", "operator_email_validated_at", zeroTime},
+			{"overlay_cache_nodes", "operator_wallet_validated_at", zeroTime},
+		},
+	},
+	{
+		description: "add the repair slo days",
+		tables:      []string{"repair_slo_days"},
+	},
+	{
+		description: "add the project exports",
+		tables:      []string{"project_exports"},
+	},
+	{
+		description: "add the project salts",
+		tables:      []string{"project_salts"},
+	},
+}
+
+// migrate applies the steps of migrations, which haven't been applied to the
+// database yet, and updates the stored schema and version. A database, which
+// has no version yet, starts at the first step, where the tables and columns
+// which already exist are skipped.
+func (db *DB) migrate() (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = utils.CombineErrors(err, tx.Rollback())
+		}
+	}()
+
+	_, err = tx.Exec(`CREATE TABLE IF NOT EXISTS table_schemas (id text, schemaText text);`)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	_, err = tx.Exec(`CREATE TABLE IF NOT EXISTS table_versions (id text, version integer);`)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var version int
+	err = tx.QueryRow(db.db.Rebind(`SELECT version FROM table_versions WHERE id = ?;`), schemaIdentifier).Scan(&version)
+	if err == sql.ErrNoRows {
+		_, err = tx.Exec(db.db.Rebind(`INSERT INTO table_versions (id, version) VALUES (?, 0);`), schemaIdentifier)
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var previous string
+	err = tx.QueryRow(db.db.Rebind(`SELECT schemaText FROM table_schemas WHERE id = ?;`), schemaIdentifier).Scan(&previous)
+	if err == sql.ErrNoRows {
+		// the tables haven't been created yet, they are created with the current schema
+		_, err = tx.Exec(db.db.Rebind(`UPDATE table_versions SET version = ? WHERE id = ?;`), len(migrations), schemaIdentifier)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		return Error.Wrap(tx.Commit())
+	}
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if version >= len(migrations) {
+		return Error.Wrap(tx.Rollback())
+	}
+
+	current := db.db.Schema()
+	migrated := previous
+	for _, step := range migrations[version:] {
+		migrated, err = step.apply(tx, current, migrated)
+		if err != nil {
+			return Error.New("%s: %v", step.description, err)
+		}
+	}
+
+	// the schema check reports the difference, when the steps don't result in
+	// the current schema
+	if sameStatements(migrated, current) {
+		migrated = current
+	}
+
+	_, err = tx.Exec(db.db.Rebind(`UPDATE table_schemas SET schemaText = ? WHERE id = ?;`), migrated, schemaIdentifier)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	_, err = tx.Exec(db.db.Rebind(`UPDATE table_versions SET version = ? WHERE id = ?;`), len(migrations), schemaIdentifier)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(tx.Commit())
+}
+
+// apply applies the step to the database with the schema migrated and returns
+// the schema after the step. The update only runs when a column was added.
+func (step *migration) apply(tx *sql.Tx, current, migrated string) (string, error) {
+	for _, table := range step.tables {
+		if tableDefinition(migrated, table) != "" {
+			continue
+		}
+		definition := tableDefinition(current, table)
+		if definition == "" {
+			return "", Error.New("table %s isn't in the current schema", table)
+		}
+		if _, err := tx.Exec(definition); err != nil {
+			return "", err
+		}
+		migrated += definition + "\n"
+	}
+
+	added := false
+	for _, column := range step.columns {
+		if tableColumn(migrated, column.table, column.name) != "" {
+			continue
+		}
+		// the column follows the last column before it, which already exists
+		definition, after := tableColumn(current, column.table, column.name), ""
+		for _, line := range tableColumns(current, column.table) {
+			if line == definition {
+				break
+			}
+			if tableColumn(migrated, column.table, columnName(line)) == line {
+				after = line
+			}
+		}
+		if definition == "" || after == "" {
+			return "", Error.New("column %s.%s can't be added to the schema", column.table, column.name)
+		}
+
+		// the column is added with a default, so existing rows get a value
+		_, err := tx.Exec(`ALTER TABLE ` + column.table + ` ADD COLUMN ` +
+			strings.TrimSuffix(strings.TrimSpace(definition), ",") + ` DEFAULT ` + column.value + `;`)
+		if err != nil {
+			return "", err
+		}
+		table := tableDefinition(migrated, column.table)
+		migrated = strings.Replace(migrated, table, strings.Replace(table, after, after+definition, 1), 1)
+		added = true
+	}
+
+	if added && step.update != "" {
+		if _, err := tx.Exec(step.update); err != nil {
+			return "", err
+		}
+	}
+	return migrated, nil
+}
+
+// tableDefinition returns the CREATE TABLE statement of the table in the
+// schema, or an empty string when there's no such table
+func tableDefinition(schema, table string) string {
+	start := strings.Index(schema, "CREATE TABLE "+table+" (\n")
+	if start < 0 {
+		return ""
+	}
+	end := strings.Index(schema[start:], ");")
+	if end < 0 {
+		return ""
+	}
+	return schema[start : start+end+len(");")]
+}
+
+// tableColumns returns the lines defining the columns of the table in the
+// schema, including the line breaks
+func tableColumns(schema, table string) (columns []string) {
+	definition := tableDefinition(schema, table)
+	for _, line := range strings.SplitAfter(definition, "\n")[1:] {
+		if columnName(line) == "" {
+			break
+		}
+		columns = append(columns, line)
+	}
+	return columns
+}
+
+// tableColumn returns the line defining the column in the CREATE TABLE
+// statement of the table in the schema, including the line break, or an
+// empty string when there's no such column
+func tableColumn(schema, table, column string) string {
+	for _, line := range tableColumns(schema, table) {
+		if columnName(line) == column {
+			return line
+		}
+	}
+	return ""
+}
+
+// columnName returns the name of the column defined by the line of a CREATE
+// TABLE statement, or an empty string when it doesn't define a column
+func columnName(line string) string {
+	fields := strings.Fields(line)
+	if !strings.HasPrefix(line, "\t") || len(fields) < 2 ||
+		fields[0] == "PRIMARY" || fields[0] == "UNIQUE" {
+		return ""
+	}
+	return fields[0]
+}
+
+// sameStatements returns whether the schemas consist of the same statements,
+// regardless of their order
+func sameStatements(a, b string) bool {
+	statements := func(schema string) []string {
+		list := strings.SplitAfter(schema, ";")
+		for i := range list {
+			list[i] = strings.TrimSpace(list[i])
+		}
+		sort.Strings(list)
+		return list
+	}
+
+	as, bs := statements(a), statements(b)
+	if len(as) != len(bs) {
+		return false
+	}
+	for i := range as {
+		if as[i] != bs[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/teststorj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// previousSchema returns the current schema without the tables and columns
+// added by the migrations
+func previousSchema(current string) string {
+	previous := current
+	for _, step := range migrations {
+		for _, table := range step.tables {
+			previous = strings.Replace(previous, tableDefinition(previous, table)+"\n", "", 1)
+		}
+		for _, column := range step.columns {
+			table := tableDefinition(previous, column.table)
+			previous = strings.Replace(previous, table,
+				strings.Replace(table, tableColumn(previous, column.table, column.name), "", 1), 1)
+		}
+	}
+	return previous
+}
+
+// createPrevious creates the tables of the previous schema without a version
+func createPrevious(t *testing.T, dbxDB *dbx.DB, previous string) {
+	_, err := dbxDB.Exec(previous)
+	require.NoError(t, err)
+	_, err = dbxDB.Exec(`CREATE TABLE table_schemas (id text, schemaText text);`)
+	require.NoError(t, err)
+	_, err = dbxDB.Exec(`INSERT INTO table_schemas (id, schemaText) VALUES (?, ?);`, schemaIdentifier, previous)
+	require.NoError(t, err)
+}
+
+func TestMigrations(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dbxDB, err := dbx.Open("sqlite3", "file:"+ctx.File("satellite.db"))
+	require.NoError(t, err)
+	defer ctx.Check(dbxDB.Close)
+	db := &DB{db: dbxDB, driver: "sqlite3"}
+
+	previous := previousSchema(dbxDB.Schema())
+	require.NotEqual(t, dbxDB.Schema(), previous)
+	createPrevious(t, dbxDB, previous)
+
+	require.NoError(t, db.CreateTables())
+	// the migrations are only applied once
+	require.NoError(t, db.CreateTables())
+
+	var schema string
+	var version int
+	require.NoError(t, dbxDB.QueryRow(`SELECT schemaText FROM table_schemas WHERE id = ?;`, schemaIdentifier).Scan(&schema))
+	require.NoError(t, dbxDB.QueryRow(`SELECT version FROM table_versions WHERE id = ?;`, schemaIdentifier).Scan(&version))
+	assert.Equal(t, dbxDB.Schema(), schema)
+	assert.Equal(t, len(migrations), version)
+}
+
+func TestMigrationsCreated(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dbxDB, err := dbx.Open("sqlite3", "file:"+ctx.File("satellite.db"))
+	require.NoError(t, err)
+	defer ctx.Check(dbxDB.Close)
+	db := &DB{db: dbxDB, driver: "sqlite3"}
+
+	// a created database starts at the last version
+	require.NoError(t, db.CreateTables())
+	require.NoError(t, db.CreateTables())
+
+	var version int
+	require.NoError(t, dbxDB.QueryRow(`SELECT version FROM table_versions WHERE id = ?;`, schemaIdentifier).Scan(&version))
+	assert.Equal(t, len(migrations), version)
+}
+
+func TestMigrateReputation(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dbxDB, err := dbx.Open("sqlite3", "file:"+ctx.File("satellite.db"))
+	require.NoError(t, err)
+	defer ctx.Check(dbxDB.Close)
+	db := &DB{db: dbxDB, driver: "sqlite3"}
+
+	// create the schema as it was before the reputation columns
+	previous := dbxDB.Schema()
+	for _, column := range []string{
+		"audit_reputation_alpha", "audit_reputation_beta",
+		"uptime_reputation_alpha", "uptime_reputation_beta",
+	} {
+		previous = strings.Replace(previous, tableColumn(previous, "nodes", column), "", 1)
+	}
+	require.NotEqual(t, dbxDB.Schema(), previous)
+	createPrevious(t, dbxDB, previous)
+
+	nodeID := teststorj.NodeIDFromString("node")
+	zero := time.Time{}
+	_, err = dbxDB.Exec(`INSERT INTO nodes
+		( id, audit_success_count, total_audit_count, audit_success_ratio,
+		  uptime_success_count, total_uptime_count, uptime_ratio,
+		  last_contact_success, last_contact_failure, vetted_at,
+		  disqualified_at, disqualification_reason, created_at, updated_at )
+		VALUES ( ?, 3, 4, 0.75, 1, 4, 0.25, ?, ?, ?, ?, '', ?, ? )`,
+		nodeID.Bytes(), zero, zero, zero, zero, zero, zero)
+	require.NoError(t, err)
+
+	require.NoError(t, db.CreateTables())
+	// the migration is only applied once
+	require.NoError(t, db.CreateTables())
+
+	stats, err := db.StatDB().Get(ctx, nodeID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, stats.AuditReputationAlpha)
+	assert.EqualValues(t, 1, stats.AuditReputationBeta)
+	assert.EqualValues(t, 1, stats.UptimeReputationAlpha)
+	assert.EqualValues(t, 3, stats.UptimeReputationBeta)
+	assert.EqualValues(t, 0.75, stats.AuditSuccessRatio)
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/nodestate"
+	"storj.io/storj/pkg/storj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type nodeEvents struct {
+	db *dbx.DB
+}
+
+// Append adds an event to the log
+func (db *nodeEvents) Append(ctx context.Context, event nodestate.Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`INSERT INTO node_events
+		( node_id, kind, reason, created_at )
+		VALUES ( ?, ?, ?, ? )`),
+		event.NodeID.Bytes(), int(event.Kind), event.Reason, event.CreatedAt.UTC())
+	return Error.Wrap(err)
+}
+
+// List returns the events of a node, oldest first
+func (db *nodeEvents) List(ctx context.Context, nodeID storj.NodeID) (_ []nodestate.Event, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`SELECT id, node_id, kind, reason, created_at
+		FROM node_events WHERE node_id = ? ORDER BY id`), nodeID.Bytes())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanNodeEvents(rows)
+}
+
+// Last returns the most recent event of a node with one of the given kinds, nil when there is none
+func (db *nodeEvents) Last(ctx context.Context, nodeID storj.NodeID, kinds ...nodestate.Kind) (_ *nodestate.Event, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(kinds) == 0 {
+		return nil, nil
+	}
+
+	args := []interface{}{nodeID.Bytes()}
+	for _, kind := range kinds {
+		args = append(args, int(kind))
+	}
+
+	row := db.db.QueryRowContext(ctx, db.db.Rebind(`SELECT id, node_id, kind, reason, created_at
+		FROM node_events WHERE node_id = ? AND kind IN (?`+strings.Repeat(", ?", len(kinds)-1)+`)
+		ORDER BY id DESC LIMIT 1`), args...)
+
+	event, err := scanNodeEvent(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &event, nil
+}
+
+// ListAfter returns up to limit events of all nodes with an ID greater than afterID, oldest first
+func (db *nodeEvents) ListAfter(ctx context.Context, afterID int64, limit int) (_ []nodestate.Event, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`SELECT id, node_id, kind, reason, created_at
+		FROM node_events WHERE id > ? ORDER BY id LIMIT ?`), afterID, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanNodeEvents(rows)
+}
+
+// LastID returns the ID of the most recent event of all nodes, 0 when there is none
+func (db *nodeEvents) LastID(ctx context.Context) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var lastID sql.NullInt64
+	err = db.db.QueryRowContext(ctx, `SELECT MAX(id) FROM node_events`).Scan(&lastID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return lastID.Int64, nil
+}
+
+// scanner is implemented by *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanNodeEvent(row scanner) (event nodestate.Event, err error) {
+	var nodeID []byte
+	var kind int
+	if err := row.Scan(&event.ID, &nodeID, &kind, &event.Reason, &event.CreatedAt); err != nil {
+		return event, err
+	}
+	event.NodeID, err = storj.NodeIDFromBytes(nodeID)
+	event.Kind = nodestate.Kind(kind)
+	return event, err
+}
+
+func scanNodeEvents(rows *sql.Rows) (events []nodestate.Event, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		event, err := scanNodeEvent(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		events = append(events, event)
+	}
+	return events, Error.Wrap(rows.Err())
+}
@@ -6,12 +6,16 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storj"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 	"storj.io/storj/storage"
@@ -20,35 +24,111 @@ import (
 var _ overlay.DB = (*overlaycache)(nil)
 
 type overlaycache struct {
-	db *dbx.DB
+	db       *dbx.DB
+	replicas *replicaSet
 }
 
 func (cache *overlaycache) SelectNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
-	return cache.queryFilteredNodes(ctx, criteria.Excluded, count, `
+	safeCountries, countryArgs := countryFilter(criteria.AllowedCountries, criteria.ExcludedCountries)
+	safeOnline, onlineArgs := onlineFilter(criteria.OnlineWindow)
+	var safeVetted string
+	var vettedArgs []interface{}
+	if criteria.Vetted {
+		safeVetted, vettedArgs = vettedFilter(true)
+	}
+	return cache.queryFilteredNodes(ctx, criteria.ExcludedIDs, criteria.DistinctIPPrefix, criteria.Tags, count, `
 		WHERE node_type = ? AND free_bandwidth >= ? AND free_disk >= ?
 		  AND audit_count >= ?
 		  AND audit_success_ratio >= ?
 		  AND uptime_count >= ?
 		  AND audit_uptime_ratio >= ?
-		`, int(criteria.Type), criteria.FreeBandwidth, criteria.FreeDisk,
+		  AND (upload_success_ratio < 0 OR upload_success_ratio >= ?)
+		`+safeCountries+safeOnline+safeVetted, append(append(append([]interface{}{int(criteria.Type), criteria.FreeBandwidth, criteria.FreeDisk,
 		criteria.AuditCount, criteria.AuditSuccessRatio, criteria.UptimeCount, criteria.UptimeSuccessRatio,
+		criteria.UploadSuccessRatio}, countryArgs...), onlineArgs...), vettedArgs...)...,
 	)
 }
 
 func (cache *overlaycache) SelectNewNodes(ctx context.Context, count int, criteria *overlay.NewNodeCriteria) ([]*pb.Node, error) {
-	return cache.queryFilteredNodes(ctx, criteria.Excluded, count, `
+	safeCountries, countryArgs := countryFilter(criteria.AllowedCountries, criteria.ExcludedCountries)
+	safeOnline, onlineArgs := onlineFilter(criteria.OnlineWindow)
+	safeNew, newArgs := ` AND audit_count < ?`, []interface{}{criteria.AuditThreshold}
+	if criteria.Unvetted {
+		safeNew, newArgs = vettedFilter(false)
+	}
+	return cache.queryFilteredNodes(ctx, criteria.ExcludedIDs, criteria.DistinctIPPrefix, criteria.Tags, count, `
 		WHERE node_type = ? AND free_bandwidth >= ? AND free_disk >= ?
-		  AND audit_count < ?
-	`, int(criteria.Type), criteria.FreeBandwidth, criteria.FreeDisk,
-		criteria.AuditThreshold,
+	`+safeNew+safeCountries+safeOnline, append(append(append([]interface{}{int(criteria.Type), criteria.FreeBandwidth, criteria.FreeDisk},
+		newArgs...), countryArgs...), onlineArgs...)...,
 	)
 }
 
-func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []storj.NodeID, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
+// countryFilter returns the conditions restricting the countries of nodes,
+// nodes with an unknown country are only left out when countries are allowed
+func countryFilter(allowed, excluded []string) (safeQuery string, args []interface{}) {
+	if len(allowed) > 0 {
+		safeQuery += ` AND country_code IN (?` + strings.Repeat(", ?", len(allowed)-1) + `)`
+		for _, country := range allowed {
+			args = append(args, country)
+		}
+	}
+	if len(excluded) > 0 {
+		safeQuery += ` AND country_code NOT IN (?` + strings.Repeat(", ?", len(excluded)-1) + `)`
+		for _, country := range excluded {
+			args = append(args, country)
+		}
+	}
+	return safeQuery, args
+}
+
+// onlineFilter returns the condition restricting nodes to the ones, whose
+// last uptime check succeeded within the window
+func onlineFilter(window time.Duration) (safeQuery string, args []interface{}) {
+	if window <= 0 {
+		return "", nil
+	}
+	return ` AND node_id IN (SELECT id FROM nodes
+		WHERE last_contact_success > ? AND last_contact_success >= last_contact_failure)`,
+		[]interface{}{time.Now().UTC().Add(-window)}
+}
+
+// vettedFilter returns the condition restricting nodes to the vetted or to the
+// unvetted ones, nodes without stats haven't been vetted
+func vettedFilter(vetted bool) (safeQuery string, args []interface{}) {
+	args = []interface{}{time.Time{}}
+	if vetted {
+		return ` AND node_id IN (SELECT id FROM nodes WHERE vetted_at > ?)`, args
+	}
+	return ` AND node_id NOT IN (SELECT id FROM nodes WHERE vetted_at > ?)`, args
+}
+
+func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []storj.NodeID, distinct bool, tags map[string]string, count int, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
 	if count == 0 {
 		return nil, nil
 	}
 
+	// the subnets of the excluded nodes are taken, when the nodes have to be distinct
+	var prefixes map[string]struct{}
+	if distinct {
+		prefixes, err = cache.networkPrefixes(ctx, excluded)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// sort the tags, so the same criteria result in the same query
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	safeTags := ""
+	for _, name := range names {
+		safeTags += ` AND node_id IN (SELECT node_id FROM node_tags WHERE name = ? AND value = ?)`
+		args = append(args, name, tags[name])
+	}
+
 	safeExcludeNodes := ""
 	if len(excluded) > 0 {
 		safeExcludeNodes = ` AND node_id NOT IN (?` + strings.Repeat(", ?", len(excluded)-1) + `)`
@@ -56,16 +136,28 @@ func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []st
 	for _, id := range excluded {
 		args = append(args, id.Bytes())
 	}
-	args = append(args, count)
 
-	rows, err := cache.db.Query(cache.db.Rebind(`SELECT node_id,
-		node_type, address, free_bandwidth, free_disk, audit_success_ratio,
-		audit_uptime_ratio, audit_count, audit_success_count, uptime_count,
-		uptime_success_count
+	// nodes advertising an address answered by a different node aren't selected
+	safeAddressMismatch := ` AND NOT EXISTS (SELECT 1 FROM address_mismatches
+		WHERE address_mismatches.node_id = overlay_cache_nodes.node_id
+		  AND address_mismatches.address = overlay_cache_nodes.address)`
+
+	// disqualified nodes aren't selected until they're reinstated
+	safeDisqualified := ` AND node_id NOT IN (SELECT id FROM nodes WHERE disqualified_at > ?)`
+	args = append(args, time.Time{})
+
+	// nodes of taken subnets are skipped, so the number of rows can't be limited
+	safeLimit := ""
+	if !distinct {
+		safeLimit = `LIMIT ?`
+		args = append(args, count)
+	}
+
+	rows, err := cache.db.Query(cache.db.Rebind(`SELECT `+overlayNodeColumns+`
 		FROM overlay_cache_nodes
-		`+safeQuery+safeExcludeNodes+`
+		`+safeQuery+safeTags+safeExcludeNodes+safeAddressMismatch+safeDisqualified+`
 		ORDER BY RANDOM()
-		LIMIT ?`), args...)
+		`+safeLimit), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -73,26 +165,109 @@ func (cache *overlaycache) queryFilteredNodes(ctx context.Context, excluded []st
 
 	var nodes []*pb.Node
 	for rows.Next() {
-		overlayNode := &dbx.OverlayCacheNode{}
-		err = rows.Scan(&overlayNode.NodeId, &overlayNode.NodeType,
-			&overlayNode.Address, &overlayNode.FreeBandwidth, &overlayNode.FreeDisk,
-			&overlayNode.AuditSuccessRatio, &overlayNode.AuditUptimeRatio,
-			&overlayNode.AuditCount, &overlayNode.AuditSuccessCount,
-			&overlayNode.UptimeCount, &overlayNode.UptimeSuccessCount)
+		overlayNode, err := scanOverlayNode(rows)
 		if err != nil {
 			return nil, err
 		}
 
+		if distinct {
+			prefix := pointerdb.NetworkPrefix(overlayNode.Address)
+			if _, taken := prefixes[prefix]; taken {
+				continue
+			}
+			if prefix != "" {
+				prefixes[prefix] = struct{}{}
+			}
+		}
+
 		node, err := convertOverlayNode(overlayNode)
 		if err != nil {
 			return nil, err
 		}
 		nodes = append(nodes, node)
+		if len(nodes) >= count {
+			break
+		}
 	}
 
 	return nodes, rows.Err()
 }
 
+// overlayNodeColumns are the columns read by scanOverlayNode
+const overlayNodeColumns = `node_id,
+		node_type, address, free_bandwidth, free_disk, audit_success_ratio,
+		audit_uptime_ratio, audit_count, audit_success_count, uptime_count,
+		uptime_success_count, ingress_rate, egress_rate, upload_success_ratio,
+		download_success_ratio, country_code`
+
+// operatorNodeColumns are the columns read by scanOperatorNode
+const operatorNodeColumns = overlayNodeColumns + `,
+		operator_email, operator_wallet, operator_wallet_features`
+
+// overlayNodeFields returns the destinations of overlayNodeColumns
+func overlayNodeFields(overlayNode *dbx.OverlayCacheNode) []interface{} {
+	return []interface{}{&overlayNode.NodeId, &overlayNode.NodeType,
+		&overlayNode.Address, &overlayNode.FreeBandwidth, &overlayNode.FreeDisk,
+		&overlayNode.AuditSuccessRatio, &overlayNode.AuditUptimeRatio,
+		&overlayNode.AuditCount, &overlayNode.AuditSuccessCount,
+		&overlayNode.UptimeCount, &overlayNode.UptimeSuccessCount,
+		&overlayNode.IngressRate, &overlayNode.EgressRate,
+		&overlayNode.UploadSuccessRatio, &overlayNode.DownloadSuccessRatio,
+		&overlayNode.CountryCode}
+}
+
+// scanOverlayNode scans a row of overlayNodeColumns
+func scanOverlayNode(rows *sql.Rows) (*dbx.OverlayCacheNode, error) {
+	overlayNode := &dbx.OverlayCacheNode{}
+	if err := rows.Scan(overlayNodeFields(overlayNode)...); err != nil {
+		return nil, err
+	}
+	return overlayNode, nil
+}
+
+// scanOperatorNode scans a row of operatorNodeColumns
+func scanOperatorNode(rows *sql.Rows) (*dbx.OverlayCacheNode, error) {
+	overlayNode := &dbx.OverlayCacheNode{}
+	fields := append(overlayNodeFields(overlayNode),
+		&overlayNode.OperatorEmail, &overlayNode.OperatorWallet, &overlayNode.OperatorWalletFeatures)
+	if err := rows.Scan(fields...); err != nil {
+		return nil, err
+	}
+	return overlayNode, nil
+}
+
+// networkPrefixes returns the subnets of the nodes
+func (cache *overlaycache) networkPrefixes(ctx context.Context, ids []storj.NodeID) (_ map[string]struct{}, err error) {
+	prefixes := map[string]struct{}{}
+	if len(ids) == 0 {
+		return prefixes, nil
+	}
+
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id.Bytes())
+	}
+
+	rows, err := cache.db.QueryContext(ctx, cache.db.Rebind(`SELECT address
+		FROM overlay_cache_nodes
+		WHERE node_id IN (?`+strings.Repeat(", ?", len(ids)-1)+`)`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var address string
+		if err := rows.Scan(&address); err != nil {
+			return nil, err
+		}
+		if prefix := pointerdb.NetworkPrefix(address); prefix != "" {
+			prefixes[prefix] = struct{}{}
+		}
+	}
+	return prefixes, rows.Err()
+}
+
 // Get looks up the node by nodeID
 func (cache *overlaycache) Get(ctx context.Context, id storj.NodeID) (*pb.Node, error) {
 	if id.IsZero() {
@@ -162,7 +337,7 @@ func (cache *overlaycache) Paginate(ctx context.Context, offset int64, limit int
 		limit = storage.LookupLimit
 	}
 
-	dbxInfos, err := cache.db.Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx,
+	dbxInfos, err := cache.replicas.Read(ctx).Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx,
 		dbx.OverlayCacheNode_NodeId(cursor.Bytes()),
 		limit, offset,
 	)
@@ -185,6 +360,87 @@ func (cache *overlaycache) Paginate(ctx context.Context, offset int64, limit int
 	return infos, more, nil
 }
 
+// QueryNodes returns the nodes matching the query and whether there are more of them
+func (cache *overlaycache) QueryNodes(ctx context.Context, query overlay.NodeQuery) (_ []*pb.Node, more bool, err error) {
+	limit := query.Limit
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+
+	safeQuery, args := `WHERE audit_count >= ?`, []interface{}{query.MinAuditCount}
+	filter := func(safeCondition string, enabled bool, value interface{}) {
+		if enabled {
+			safeQuery += ` AND ` + safeCondition
+			args = append(args, value)
+		}
+	}
+	filter(`node_type = ?`, query.Type != pb.NodeType_INVALID, int(query.Type))
+	filter(`audit_success_ratio >= ?`, query.MinAuditSuccessRatio > 0, query.MinAuditSuccessRatio)
+	filter(`audit_success_ratio < ?`, query.AuditSuccessRatioBelow > 0, query.AuditSuccessRatioBelow)
+	filter(`audit_uptime_ratio >= ?`, query.MinUptimeRatio > 0, query.MinUptimeRatio)
+	filter(`audit_uptime_ratio < ?`, query.UptimeRatioBelow > 0, query.UptimeRatioBelow)
+	filter(`free_disk >= ?`, query.MinFreeDisk > 0, query.MinFreeDisk)
+	filter(`free_disk < ?`, query.FreeDiskBelow > 0, query.FreeDiskBelow)
+	filter(`free_bandwidth >= ?`, query.MinFreeBandwidth > 0, query.MinFreeBandwidth)
+	filter(`free_bandwidth < ?`, query.FreeBandwidthBelow > 0, query.FreeBandwidthBelow)
+
+	var safeOrder string
+	switch query.OrderBy {
+	case overlay.NodeOrderByID:
+		safeOrder = `node_id`
+	case overlay.NodeOrderByAuditSuccessRatio:
+		safeOrder = `audit_success_ratio`
+	case overlay.NodeOrderByUptimeRatio:
+		safeOrder = `audit_uptime_ratio`
+	case overlay.NodeOrderByFreeDisk:
+		safeOrder = `free_disk`
+	case overlay.NodeOrderByFreeBandwidth:
+		safeOrder = `free_bandwidth`
+	default:
+		return nil, false, Error.New("unknown node order %d", query.OrderBy)
+	}
+	if query.Descending {
+		safeOrder += ` DESC`
+	}
+	// nodes with the same value are ordered by id, so the pages don't overlap
+	if query.OrderBy != overlay.NodeOrderByID {
+		safeOrder += `, node_id`
+	}
+
+	// an additional row tells whether there are more nodes
+	args = append(args, limit+1, query.Offset)
+
+	db := cache.replicas.Read(ctx)
+	rows, err := db.QueryContext(ctx, db.Rebind(`SELECT `+overlayNodeColumns+`
+		FROM overlay_cache_nodes
+		`+safeQuery+`
+		ORDER BY `+safeOrder+`
+		LIMIT ? OFFSET ?`), args...)
+	if err != nil {
+		return nil, false, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var nodes []*pb.Node
+	for rows.Next() {
+		if len(nodes) == limit {
+			more = true
+			break
+		}
+
+		overlayNode, err := scanOverlayNode(rows)
+		if err != nil {
+			return nil, false, Error.Wrap(err)
+		}
+		node, err := convertOverlayNode(overlayNode)
+		if err != nil {
+			return nil, false, Error.Wrap(err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, more, Error.Wrap(rows.Err())
+}
+
 // Update updates node information
 func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error) {
 	if info == nil || info.Id.IsZero() {
@@ -232,9 +488,20 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 			dbx.OverlayCacheNode_NodeType(int(info.Type)),
 			dbx.OverlayCacheNode_Address(address.Address),
 			dbx.OverlayCacheNode_Protocol(int(address.Transport)),
+			dbx.OverlayCacheNode_CountryCode(info.CountryCode),
 
 			dbx.OverlayCacheNode_OperatorEmail(metadata.Email),
 			dbx.OverlayCacheNode_OperatorWallet(metadata.Wallet),
+			dbx.OverlayCacheNode_OperatorWalletFeatures(encodeWalletFeatures(metadata.WalletFeatures)),
+			// the addresses are validated at check-in
+			dbx.OverlayCacheNode_OperatorEmailValidatedAt(time.Time{}),
+			dbx.OverlayCacheNode_OperatorWalletValidatedAt(time.Time{}),
+
+			// nodes haven't reported their throughput yet
+			dbx.OverlayCacheNode_IngressRate(0),
+			dbx.OverlayCacheNode_EgressRate(0),
+			dbx.OverlayCacheNode_UploadSuccessRatio(-1),
+			dbx.OverlayCacheNode_DownloadSuccessRatio(-1),
 
 			dbx.OverlayCacheNode_FreeBandwidth(restrictions.FreeBandwidth),
 			dbx.OverlayCacheNode_FreeDisk(restrictions.FreeDisk),
@@ -254,8 +521,9 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 	} else {
 		update := dbx.OverlayCacheNode_Update_Fields{
 			// TODO: should we be able to update node type?
-			Address:  dbx.OverlayCacheNode_Address(address.Address),
-			Protocol: dbx.OverlayCacheNode_Protocol(int(address.Transport)),
+			Address:     dbx.OverlayCacheNode_Address(address.Address),
+			Protocol:    dbx.OverlayCacheNode_Protocol(int(address.Transport)),
+			CountryCode: dbx.OverlayCacheNode_CountryCode(info.CountryCode),
 
 			Latency90:          dbx.OverlayCacheNode_Latency90(info.Reputation.Latency_90),
 			AuditSuccessRatio:  dbx.OverlayCacheNode_AuditSuccessRatio(info.Reputation.AuditSuccessRatio),
@@ -269,6 +537,7 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 		if info.Metadata != nil {
 			update.OperatorEmail = dbx.OverlayCacheNode_OperatorEmail(info.Metadata.Email)
 			update.OperatorWallet = dbx.OverlayCacheNode_OperatorWallet(info.Metadata.Wallet)
+			update.OperatorWalletFeatures = dbx.OverlayCacheNode_OperatorWalletFeatures(encodeWalletFeatures(info.Metadata.WalletFeatures))
 		}
 
 		if info.Restrictions != nil {
@@ -288,12 +557,415 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 	return Error.Wrap(tx.Commit())
 }
 
+// overlayUpsertBatch is the number of nodes per upsert statement, it keeps
+// the number of arguments below the sqlite limit of 999
+const overlayUpsertBatch = 40
+
+// overlayUpsertColumns are the columns written by UpdateAll
+var overlayUpsertColumns = []string{
+	"node_id", "node_type", "address", "protocol", "country_code",
+	"operator_email", "operator_wallet", "operator_wallet_features",
+	"operator_email_validated_at", "operator_wallet_validated_at",
+	"ingress_rate", "egress_rate", "upload_success_ratio", "download_success_ratio",
+	"free_bandwidth", "free_disk",
+	"latency_90", "audit_success_ratio", "audit_uptime_ratio", "audit_count", "audit_success_count",
+	"uptime_count", "uptime_success_count",
+	"updated_at",
+}
+
+// overlayUpsertKind selects the columns which are updated for existing nodes,
+// nodes without metadata or restrictions keep their stored values like in Update
+type overlayUpsertKind struct {
+	metadata     bool
+	restrictions bool
+}
+
+// UpdateAll updates the information of multiple nodes, inserting the missing ones.
+// The nodes are written with batched upserts in a single transaction, so either all
+// or none of them are stored.
+func (cache *overlaycache) UpdateAll(ctx context.Context, infos []*pb.Node) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var kinds []overlayUpsertKind
+	groups := map[overlayUpsertKind][]*pb.Node{}
+	for _, info := range infos {
+		if info == nil || info.Id.IsZero() {
+			return overlay.ErrEmptyNode
+		}
+		kind := overlayUpsertKind{
+			metadata:     info.Metadata != nil,
+			restrictions: info.Restrictions != nil,
+		}
+		if _, ok := groups[kind]; !ok {
+			kinds = append(kinds, kind)
+		}
+		groups[kind] = append(groups[kind], info)
+	}
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	tx, err := cache.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := time.Now().UTC()
+	for _, kind := range kinds {
+		nodes := groups[kind]
+		for len(nodes) > 0 {
+			batch := nodes
+			if len(batch) > overlayUpsertBatch {
+				batch = batch[:overlayUpsertBatch]
+			}
+			nodes = nodes[len(batch):]
+
+			query, args := kind.upsert(batch, now)
+			_, err = tx.Tx.ExecContext(ctx, cache.db.Rebind(query), args...)
+			if err != nil {
+				return Error.Wrap(errs.Combine(err, tx.Rollback()))
+			}
+		}
+	}
+
+	return Error.Wrap(tx.Commit())
+}
+
+// upsert returns the statement and arguments inserting or updating the nodes
+func (kind overlayUpsertKind) upsert(nodes []*pb.Node, now time.Time) (string, []interface{}) {
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(overlayUpsertColumns)), ", ") + ")"
+
+	var values []string
+	args := make([]interface{}, 0, len(nodes)*len(overlayUpsertColumns))
+	for _, info := range nodes {
+		address := info.Address
+		if address == nil {
+			address = &pb.NodeAddress{}
+		}
+		metadata := info.Metadata
+		if metadata == nil {
+			metadata = &pb.NodeMetadata{}
+		}
+		restrictions := info.Restrictions
+		if restrictions == nil {
+			restrictions = &pb.NodeRestrictions{
+				FreeBandwidth: -1,
+				FreeDisk:      -1,
+			}
+		}
+		reputation := info.Reputation
+		if reputation == nil {
+			reputation = &pb.NodeStats{}
+		}
+
+		values = append(values, placeholders)
+		args = append(args,
+			info.Id.Bytes(), int(info.Type), address.Address, int(address.Transport), info.CountryCode,
+			metadata.Email, metadata.Wallet, encodeWalletFeatures(metadata.WalletFeatures),
+			// the addresses are validated at check-in, the times aren't updated
+			time.Time{}, time.Time{},
+			// nodes haven't reported their throughput yet, the rates aren't updated
+			int64(0), int64(0), float64(-1), float64(-1),
+			restrictions.FreeBandwidth, restrictions.FreeDisk,
+			reputation.Latency_90, reputation.AuditSuccessRatio, reputation.UptimeRatio, reputation.AuditCount, reputation.AuditSuccessCount,
+			reputation.UptimeCount, reputation.UptimeSuccessCount,
+			now,
+		)
+	}
+
+	updated := []string{
+		"address", "protocol", "country_code",
+		"latency_90", "audit_success_ratio", "audit_uptime_ratio", "audit_count", "audit_success_count",
+		"uptime_count", "uptime_success_count",
+		"updated_at",
+	}
+	if kind.metadata {
+		updated = append(updated, "operator_email", "operator_wallet", "operator_wallet_features")
+	}
+	if kind.restrictions {
+		updated = append(updated, "free_bandwidth", "free_disk")
+	}
+
+	var sets []string
+	for _, column := range updated {
+		sets = append(sets, column+" = excluded."+column)
+	}
+
+	query := `INSERT INTO overlay_cache_nodes ( ` + strings.Join(overlayUpsertColumns, ", ") + ` )
+		VALUES ` + strings.Join(values, ", ") + `
+		ON CONFLICT ( node_id ) DO UPDATE SET ` + strings.Join(sets, ", ")
+	return query, args
+}
+
+// UpdateThroughput stores the recent throughput reported by the node
+func (cache *overlaycache) UpdateThroughput(ctx context.Context, id storj.NodeID, throughput *pb.NodeThroughput) error {
+	_, err := cache.db.Update_OverlayCacheNode_By_NodeId(ctx,
+		dbx.OverlayCacheNode_NodeId(id.Bytes()),
+		dbx.OverlayCacheNode_Update_Fields{
+			IngressRate:          dbx.OverlayCacheNode_IngressRate(throughput.IngressRate),
+			EgressRate:           dbx.OverlayCacheNode_EgressRate(throughput.EgressRate),
+			UploadSuccessRatio:   dbx.OverlayCacheNode_UploadSuccessRatio(throughput.UploadSuccessRatio),
+			DownloadSuccessRatio: dbx.OverlayCacheNode_DownloadSuccessRatio(throughput.DownloadSuccessRatio),
+		},
+	)
+	return Error.Wrap(err)
+}
+
+// UpdateMetadataValidation records when the operator's email and wallet
+// were last found valid, zero times keep the stored ones
+func (cache *overlaycache) UpdateMetadataValidation(ctx context.Context, id storj.NodeID, validation overlay.MetadataValidation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if validation.EmailValidatedAt.IsZero() && validation.WalletValidatedAt.IsZero() {
+		return nil
+	}
+
+	update := dbx.OverlayCacheNode_Update_Fields{}
+	if !validation.EmailValidatedAt.IsZero() {
+		update.OperatorEmailValidatedAt = dbx.OverlayCacheNode_OperatorEmailValidatedAt(validation.EmailValidatedAt.UTC())
+	}
+	if !validation.WalletValidatedAt.IsZero() {
+		update.OperatorWalletValidatedAt = dbx.OverlayCacheNode_OperatorWalletValidatedAt(validation.WalletValidatedAt.UTC())
+	}
+
+	_, err = cache.db.Update_OverlayCacheNode_By_NodeId(ctx, dbx.OverlayCacheNode_NodeId(id.Bytes()), update)
+	return Error.Wrap(err)
+}
+
+// GetMetadataValidation returns when the operator's email and wallet were last found valid
+func (cache *overlaycache) GetMetadataValidation(ctx context.Context, id storj.NodeID) (_ overlay.MetadataValidation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := cache.db.Get_OverlayCacheNode_By_NodeId(ctx, dbx.OverlayCacheNode_NodeId(id.Bytes()))
+	if err == sql.ErrNoRows {
+		return overlay.MetadataValidation{}, overlay.ErrNodeNotFound
+	}
+	if err != nil {
+		return overlay.MetadataValidation{}, Error.Wrap(err)
+	}
+	return overlay.MetadataValidation{
+		EmailValidatedAt:  node.OperatorEmailValidatedAt,
+		WalletValidatedAt: node.OperatorWalletValidatedAt,
+	}, nil
+}
+
+// ListStray lists up to limit storage nodes with less than auditThreshold audits,
+// which haven't been updated since lastSeenBefore
+func (cache *overlaycache) ListStray(ctx context.Context, auditThreshold int64, lastSeenBefore time.Time, limit int) (_ storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, cache.db.Rebind(`SELECT node_id
+		FROM overlay_cache_nodes
+		WHERE node_type = ? AND audit_count < ? AND updated_at < ?
+		ORDER BY updated_at
+		LIMIT ?`), int(pb.NodeType_STORAGE), auditThreshold, lastSeenBefore.UTC(), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var ids storj.NodeIDList
+	for rows.Next() {
+		var nodeID []byte
+		if err := rows.Scan(&nodeID); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		id, err := storj.NodeIDFromBytes(nodeID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, Error.Wrap(rows.Err())
+}
+
+// GetByWallet returns the nodes paid to the wallet address, the address is
+// matched case insensitively
+func (cache *overlaycache) GetByWallet(ctx context.Context, wallet string) (_ []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if wallet == "" {
+		return nil, Error.New("wallet address is missing")
+	}
+
+	return cache.queryOperatorNodes(ctx, `WHERE LOWER(operator_wallet) = LOWER(?)
+		ORDER BY node_id`, wallet)
+}
+
+// GetMissingWallet returns up to limit storage nodes without a wallet address
+// ordered by id, starting after cursor
+func (cache *overlaycache) GetMissingWallet(ctx context.Context, cursor storj.NodeID, limit int) (_ []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+
+	return cache.queryOperatorNodes(ctx, `WHERE node_type = ? AND operator_wallet = '' AND node_id > ?
+		ORDER BY node_id
+		LIMIT ?`, int(pb.NodeType_STORAGE), cursor.Bytes(), limit)
+}
+
+// queryOperatorNodes returns the nodes matching the query including their operator metadata
+func (cache *overlaycache) queryOperatorNodes(ctx context.Context, safeQuery string, args ...interface{}) (_ []*pb.Node, err error) {
+	db := cache.replicas.Read(ctx)
+	rows, err := db.QueryContext(ctx, db.Rebind(`SELECT `+operatorNodeColumns+`
+		FROM overlay_cache_nodes
+		`+safeQuery), args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var nodes []*pb.Node
+	for rows.Next() {
+		overlayNode, err := scanOperatorNode(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		node, err := convertOverlayNode(overlayNode)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, Error.Wrap(rows.Err())
+}
+
+// UpdateTag stores a signed tag of a node, replacing an earlier tag with the same name
+func (cache *overlaycache) UpdateTag(ctx context.Context, tag *pb.NodeTag) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tagBytes, err := proto.Marshal(tag)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	tx, err := cache.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = tx.Delete_NodeTag_By_NodeId_And_Name(ctx,
+		dbx.NodeTag_NodeId(tag.NodeId.Bytes()),
+		dbx.NodeTag_Name(tag.Name),
+	)
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	_, err = tx.Create_NodeTag(ctx,
+		dbx.NodeTag_NodeId(tag.NodeId.Bytes()),
+		dbx.NodeTag_Name(tag.Name),
+		dbx.NodeTag_Value(tag.Value),
+		dbx.NodeTag_SignerId(tag.SignerId.Bytes()),
+		dbx.NodeTag_Tag(tagBytes),
+	)
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	return Error.Wrap(tx.Commit())
+}
+
+// GetTags returns the signed tags of a node
+func (cache *overlaycache) GetTags(ctx context.Context, nodeID storj.NodeID) (_ []*pb.NodeTag, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.All_NodeTag_By_NodeId(ctx, dbx.NodeTag_NodeId(nodeID.Bytes()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	tags := make([]*pb.NodeTag, 0, len(rows))
+	for _, row := range rows {
+		tag := &pb.NodeTag{}
+		if err := proto.Unmarshal(row.Tag, tag); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// FlagAddressMismatch flags the address of a node as answered by a different
+// node, replacing an earlier flag of the node
+func (cache *overlaycache) FlagAddressMismatch(ctx context.Context, mismatch overlay.AddressMismatch) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := cache.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = tx.Tx.ExecContext(ctx, cache.db.Rebind(`DELETE FROM address_mismatches WHERE node_id = ?`),
+		mismatch.NodeID.Bytes())
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	_, err = tx.Tx.ExecContext(ctx, cache.db.Rebind(`INSERT INTO address_mismatches
+		( node_id, address, answered_id, detected_at ) VALUES ( ?, ?, ?, ? )`),
+		mismatch.NodeID.Bytes(), mismatch.Address, mismatch.AnsweredID.Bytes(), mismatch.DetectedAt.UTC())
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	return Error.Wrap(tx.Commit())
+}
+
+// ClearAddressMismatch removes the flag of a node
+func (cache *overlaycache) ClearAddressMismatch(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = cache.db.ExecContext(ctx, cache.db.Rebind(`DELETE FROM address_mismatches WHERE node_id = ?`), id.Bytes())
+	return Error.Wrap(err)
+}
+
+// ListAddressMismatches returns up to limit flagged nodes, the most recently detected first
+func (cache *overlaycache) ListAddressMismatches(ctx context.Context, limit int) (_ []overlay.AddressMismatch, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+
+	rows, err := cache.db.QueryContext(ctx, cache.db.Rebind(`SELECT node_id, address, answered_id, detected_at
+		FROM address_mismatches
+		ORDER BY detected_at DESC
+		LIMIT ?`), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var mismatches []overlay.AddressMismatch
+	for rows.Next() {
+		var nodeID, answeredID []byte
+		var mismatch overlay.AddressMismatch
+		if err := rows.Scan(&nodeID, &mismatch.Address, &answeredID, &mismatch.DetectedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if mismatch.NodeID, err = storj.NodeIDFromBytes(nodeID); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if mismatch.AnsweredID, err = storj.NodeIDFromBytes(answeredID); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+	return mismatches, Error.Wrap(rows.Err())
+}
+
 // Delete deletes node based on id
 func (cache *overlaycache) Delete(ctx context.Context, id storj.NodeID) error {
 	_, err := cache.db.Delete_OverlayCacheNode_By_NodeId(ctx,
 		dbx.OverlayCacheNode_NodeId(id.Bytes()),
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	return cache.ClearAddressMismatch(ctx, id)
 }
 
 func convertOverlayNode(info *dbx.OverlayCacheNode) (*pb.Node, error) {
@@ -307,15 +979,23 @@ func convertOverlayNode(info *dbx.OverlayCacheNode) (*pb.Node, error) {
 	}
 
 	node := &pb.Node{
-		Id:   id,
-		Type: pb.NodeType(info.NodeType),
+		Id:          id,
+		Type:        pb.NodeType(info.NodeType),
+		CountryCode: info.CountryCode,
 		Address: &pb.NodeAddress{
 			Address:   info.Address,
 			Transport: pb.NodeTransport(info.Protocol),
 		},
 		Metadata: &pb.NodeMetadata{
-			Email:  info.OperatorEmail,
-			Wallet: info.OperatorWallet,
+			Email:          info.OperatorEmail,
+			Wallet:         info.OperatorWallet,
+			WalletFeatures: decodeWalletFeatures(info.OperatorWalletFeatures),
+			Throughput: &pb.NodeThroughput{
+				IngressRate:          info.IngressRate,
+				EgressRate:           info.EgressRate,
+				UploadSuccessRatio:   info.UploadSuccessRatio,
+				DownloadSuccessRatio: info.DownloadSuccessRatio,
+			},
 		},
 		Restrictions: &pb.NodeRestrictions{
 			FreeBandwidth: info.FreeBandwidth,
@@ -336,7 +1016,10 @@ func convertOverlayNode(info *dbx.OverlayCacheNode) (*pb.Node, error) {
 	if node.Address.Address == "" {
 		node.Address = nil
 	}
-	if node.Metadata.Email == "" && node.Metadata.Wallet == "" {
+	if node.Metadata.Throughput.UploadSuccessRatio < 0 {
+		node.Metadata.Throughput = nil
+	}
+	if node.Metadata.Email == "" && node.Metadata.Wallet == "" && node.Metadata.Throughput == nil {
 		node.Metadata = nil
 	}
 	if node.Restrictions.FreeBandwidth < 0 && node.Restrictions.FreeDisk < 0 {
@@ -357,3 +1040,16 @@ func (cache *overlaycache) GetWalletAddress(ctx context.Context, id storj.NodeID
 	}
 	return w.OperatorWallet, nil
 }
+
+// encodeWalletFeatures converts wallet features to the comma separated form stored in the database
+func encodeWalletFeatures(features []string) string {
+	return strings.Join(features, ",")
+}
+
+// decodeWalletFeatures converts the stored comma separated wallet features back to a list
+func decodeWalletFeatures(features string) []string {
+	if features == "" {
+		return nil
+	}
+	return strings.Split(features, ",")
+}
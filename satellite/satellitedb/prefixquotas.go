@@ -0,0 +1,84 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/pointerdb"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// prefixQuotas is an implementation of pointerdb.PrefixQuotas
+type prefixQuotas struct {
+	db *dbx.DB
+}
+
+// Set adds or replaces the quota of a prefix
+func (quotas *prefixQuotas) Set(ctx context.Context, quota pointerdb.PrefixQuota) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := quotas.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = tx.Delete_PrefixQuota_By_ProjectId_And_BucketName_And_Prefix(ctx,
+		dbx.PrefixQuota_ProjectId(quota.ProjectID[:]),
+		dbx.PrefixQuota_BucketName(quota.Bucket),
+		dbx.PrefixQuota_Prefix(quota.Prefix))
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	_, err = tx.Create_PrefixQuota(ctx,
+		dbx.PrefixQuota_ProjectId(quota.ProjectID[:]),
+		dbx.PrefixQuota_BucketName(quota.Bucket),
+		dbx.PrefixQuota_Prefix(quota.Prefix),
+		dbx.PrefixQuota_MaxBytes(quota.MaxBytes),
+		dbx.PrefixQuota_MaxObjects(quota.MaxObjects))
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	return Error.Wrap(tx.Commit())
+}
+
+// Delete removes the quota of a prefix
+func (quotas *prefixQuotas) Delete(ctx context.Context, projectID uuid.UUID, bucket string, prefix string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = quotas.db.Delete_PrefixQuota_By_ProjectId_And_BucketName_And_Prefix(ctx,
+		dbx.PrefixQuota_ProjectId(projectID[:]),
+		dbx.PrefixQuota_BucketName(bucket),
+		dbx.PrefixQuota_Prefix(prefix))
+	return Error.Wrap(err)
+}
+
+// GetAll returns the quotas of all prefixes of a bucket
+func (quotas *prefixQuotas) GetAll(ctx context.Context, projectID uuid.UUID, bucket string) (_ []pointerdb.PrefixQuota, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := quotas.db.All_PrefixQuota_By_ProjectId_And_BucketName(ctx,
+		dbx.PrefixQuota_ProjectId(projectID[:]),
+		dbx.PrefixQuota_BucketName(bucket))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var all []pointerdb.PrefixQuota
+	for _, row := range rows {
+		all = append(all, pointerdb.PrefixQuota{
+			ProjectID:  projectID,
+			Bucket:     row.BucketName,
+			Prefix:     row.Prefix,
+			MaxBytes:   row.MaxBytes,
+			MaxObjects: row.MaxObjects,
+		})
+	}
+	return all, nil
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/pricing"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// pricingDB is an implementation of pricing.DB
+type pricingDB struct {
+	db *dbx.DB
+}
+
+// SetRates adds or replaces the rates of a tier
+func (db *pricingDB) SetRates(ctx context.Context, rates pricing.Rates) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`INSERT INTO pricing_tiers
+		( name, storage, egress, updated_at )
+		VALUES ( ?, ?, ?, ? )
+		ON CONFLICT ( name ) DO UPDATE SET
+			storage = excluded.storage,
+			egress = excluded.egress,
+			updated_at = excluded.updated_at`),
+		string(rates.Tier), rates.Storage, rates.Egress, time.Now().UTC())
+	return Error.Wrap(err)
+}
+
+// GetRates returns the rates of a tier, it's nil when they haven't been set
+func (db *pricingDB) GetRates(ctx context.Context, tier pricing.Tier) (_ *pricing.Rates, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rates := &pricing.Rates{Tier: tier}
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`SELECT storage, egress
+		FROM pricing_tiers WHERE name = ?`), string(tier)).Scan(&rates.Storage, &rates.Egress)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return rates, nil
+}
+
+// SetProjectTier assigns a tier to a project
+func (db *pricingDB) SetProjectTier(ctx context.Context, projectID uuid.UUID, tier pricing.Tier) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`INSERT INTO project_tiers
+		( project_id, tier, updated_at )
+		VALUES ( ?, ?, ? )
+		ON CONFLICT ( project_id ) DO UPDATE SET
+			tier = excluded.tier,
+			updated_at = excluded.updated_at`),
+		projectID[:], string(tier), time.Now().UTC())
+	return Error.Wrap(err)
+}
+
+// GetProjectTier returns the tier of a project, it's empty when the project hasn't been assigned one
+func (db *pricingDB) GetProjectTier(ctx context.Context, projectID uuid.UUID) (_ pricing.Tier, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var tier string
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(`SELECT tier
+		FROM project_tiers WHERE project_id = ?`), projectID[:]).Scan(&tier)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return pricing.Tier(tier), nil
+}
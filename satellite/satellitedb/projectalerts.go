@@ -0,0 +1,145 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// projectAlerts is an implementation of console.ProjectAlerts
+type projectAlerts struct {
+	db *dbx.DB
+}
+
+const projectAlertColumns = `id, project_id, bucket_name, prefix, threshold, webhook_url, created_at, triggered_at`
+
+// GetAll is a method for querying all alerts from the database
+func (alerts *projectAlerts) GetAll(ctx context.Context) ([]console.ProjectAlert, error) {
+	rows, err := alerts.db.QueryContext(ctx, alerts.db.Rebind(`SELECT `+projectAlertColumns+`
+		FROM project_alerts ORDER BY created_at`))
+	if err != nil {
+		return nil, err
+	}
+	return scanProjectAlerts(rows)
+}
+
+// GetByProjectID is a method for querying the alerts of a project from the database
+func (alerts *projectAlerts) GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]console.ProjectAlert, error) {
+	rows, err := alerts.db.QueryContext(ctx, alerts.db.Rebind(`SELECT `+projectAlertColumns+`
+		FROM project_alerts WHERE project_id = ? ORDER BY created_at`), projectID[:])
+	if err != nil {
+		return nil, err
+	}
+	return scanProjectAlerts(rows)
+}
+
+// Get is a method for querying an alert from the database by id
+func (alerts *projectAlerts) Get(ctx context.Context, id uuid.UUID) (*console.ProjectAlert, error) {
+	row := alerts.db.QueryRowContext(ctx, alerts.db.Rebind(`SELECT `+projectAlertColumns+`
+		FROM project_alerts WHERE id = ?`), id[:])
+
+	alert := &dbx.ProjectAlert{}
+	err := row.Scan(&alert.Id, &alert.ProjectId, &alert.BucketName, &alert.Prefix, &alert.Threshold, &alert.WebhookUrl, &alert.CreatedAt, &alert.TriggeredAt)
+	if err != nil {
+		return nil, err
+	}
+	return projectAlertFromDBX(alert)
+}
+
+// Insert is a method for inserting an alert into the database
+func (alerts *projectAlerts) Insert(ctx context.Context, alert *console.ProjectAlert) (*console.ProjectAlert, error) {
+	id, err := uuid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = alerts.db.ExecContext(ctx, alerts.db.Rebind(`INSERT INTO project_alerts
+		( id, project_id, bucket_name, prefix, threshold, webhook_url, created_at )
+		VALUES ( ?, ?, ?, ?, ?, ?, ? )`),
+		id[:], alert.ProjectID[:], alert.Bucket, alert.Prefix, alert.Threshold, alert.WebhookURL, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	return alerts.Get(ctx, *id)
+}
+
+// UpdateTriggered is a method for recording when an alert was triggered, zero time resets it
+func (alerts *projectAlerts) UpdateTriggered(ctx context.Context, id uuid.UUID, triggeredAt time.Time) error {
+	var triggered *time.Time
+	if !triggeredAt.IsZero() {
+		utc := triggeredAt.UTC()
+		triggered = &utc
+	}
+
+	_, err := alerts.db.ExecContext(ctx, alerts.db.Rebind(`UPDATE project_alerts SET triggered_at = ? WHERE id = ?`), triggered, id[:])
+	return err
+}
+
+// Delete is a method for deleting an alert by id from the database
+func (alerts *projectAlerts) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := alerts.db.ExecContext(ctx, alerts.db.Rebind(`DELETE FROM project_alerts WHERE id = ?`), id[:])
+	return err
+}
+
+// scanProjectAlerts converts the rows of a project_alerts query and closes them
+func scanProjectAlerts(rows *sql.Rows) (result []console.ProjectAlert, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		alert := &dbx.ProjectAlert{}
+		err := rows.Scan(&alert.Id, &alert.ProjectId, &alert.BucketName, &alert.Prefix, &alert.Threshold, &alert.WebhookUrl, &alert.CreatedAt, &alert.TriggeredAt)
+		if err != nil {
+			return nil, err
+		}
+
+		converted, err := projectAlertFromDBX(alert)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *converted)
+	}
+
+	return result, rows.Err()
+}
+
+// projectAlertFromDBX is used for creating ProjectAlert entity from autogenerated dbx.ProjectAlert struct
+func projectAlertFromDBX(alert *dbx.ProjectAlert) (*console.ProjectAlert, error) {
+	if alert == nil {
+		return nil, errs.New("project alert parameter is nil")
+	}
+
+	id, err := bytesToUUID(alert.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	projectID, err := bytesToUUID(alert.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &console.ProjectAlert{
+		ID:         id,
+		ProjectID:  projectID,
+		Bucket:     alert.BucketName,
+		Prefix:     alert.Prefix,
+		Threshold:  alert.Threshold,
+		WebhookURL: alert.WebhookUrl,
+		CreatedAt:  alert.CreatedAt,
+	}
+	if alert.TriggeredAt != nil {
+		result.TriggeredAt = *alert.TriggeredAt
+	}
+
+	return result, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// projectDeletions is an implementation of console.ProjectDeletions
+type projectDeletions struct {
+	db dbx.Methods
+}
+
+// Insert is a method for marking a project as deleted
+func (deletions *projectDeletions) Insert(ctx context.Context, projectID uuid.UUID) (*console.ProjectDeletion, error) {
+	deletion, err := deletions.db.Create_ProjectDeletion(ctx,
+		dbx.ProjectDeletion_ProjectId(projectID[:]),
+		dbx.ProjectDeletion_DeletedSegments(0),
+		dbx.ProjectDeletion_DeletedBytes(0),
+		dbx.ProjectDeletion_Create_Fields{})
+	if err != nil {
+		return nil, err
+	}
+
+	return projectDeletionFromDBX(deletion)
+}
+
+// Get is a method for querying the deletion of a project by project id
+func (deletions *projectDeletions) Get(ctx context.Context, projectID uuid.UUID) (*console.ProjectDeletion, error) {
+	deletion, err := deletions.db.Get_ProjectDeletion_By_ProjectId(ctx, dbx.ProjectDeletion_ProjectId(projectID[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	return projectDeletionFromDBX(deletion)
+}
+
+// GetUnfinished is a method for querying deletions, which still have data to purge, oldest first
+func (deletions *projectDeletions) GetUnfinished(ctx context.Context) ([]console.ProjectDeletion, error) {
+	rows, err := deletions.db.All_ProjectDeletion_OrderBy_Asc_RequestedAt(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var unfinished []console.ProjectDeletion
+	var errors []error
+	for _, row := range rows {
+		if row.FinishedAt != nil {
+			continue
+		}
+
+		deletion, err := projectDeletionFromDBX(row)
+		if err != nil {
+			errors = append(errors, err)
+			continue
+		}
+		unfinished = append(unfinished, *deletion)
+	}
+
+	return unfinished, errs.Combine(errors...)
+}
+
+// Update is a method for updating the progress of a deletion
+func (deletions *projectDeletions) Update(ctx context.Context, deletion *console.ProjectDeletion) error {
+	updateFields := dbx.ProjectDeletion_Update_Fields{
+		DeletedSegments: dbx.ProjectDeletion_DeletedSegments(deletion.DeletedSegments),
+		DeletedBytes:    dbx.ProjectDeletion_DeletedBytes(deletion.DeletedBytes),
+	}
+	if deletion.Finished() {
+		updateFields.FinishedAt = dbx.ProjectDeletion_FinishedAt(deletion.FinishedAt)
+	}
+
+	_, err := deletions.db.Update_ProjectDeletion_By_ProjectId(ctx,
+		dbx.ProjectDeletion_ProjectId(deletion.ProjectID[:]),
+		updateFields)
+
+	return err
+}
+
+// projectDeletionFromDBX is used for creating ProjectDeletion entity from autogenerated dbx.ProjectDeletion struct
+func projectDeletionFromDBX(deletion *dbx.ProjectDeletion) (*console.ProjectDeletion, error) {
+	if deletion == nil {
+		return nil, errs.New("project deletion parameter is nil")
+	}
+
+	projectID, err := bytesToUUID(deletion.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &console.ProjectDeletion{
+		ProjectID:       projectID,
+		DeletedSegments: deletion.DeletedSegments,
+		DeletedBytes:    deletion.DeletedBytes,
+		RequestedAt:     deletion.RequestedAt,
+	}
+	if deletion.FinishedAt != nil {
+		result.FinishedAt = *deletion.FinishedAt
+	}
+
+	return result, nil
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// projectExports is an implementation of console.ProjectExports
+type projectExports struct {
+	db *dbx.DB
+}
+
+const projectExportColumns = `project_id, requested_by, exported_objects, exported_bytes, last_path, manifest, requested_at, finished_at`
+
+// Insert is a method for requesting the export of a project, it replaces a previous export
+func (exports *projectExports) Insert(ctx context.Context, projectID, requestedBy uuid.UUID) (_ *console.ProjectExport, err error) {
+	tx, err := exports.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			err = errs.Combine(err, tx.Rollback())
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, exports.db.Rebind(`DELETE FROM project_exports WHERE project_id = ?`), projectID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	export := &console.ProjectExport{
+		ProjectID:   projectID,
+		RequestedBy: requestedBy,
+		RequestedAt: time.Now().UTC(),
+	}
+	_, err = tx.ExecContext(ctx, exports.db.Rebind(`INSERT INTO project_exports
+		( `+projectExportColumns+` )
+		VALUES ( ?, ?, 0, 0, '', ?, ?, NULL )`),
+		projectID[:], requestedBy[:], []byte{}, export.RequestedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// Get is a method for querying the export of a project by project id, it's nil when there is none
+func (exports *projectExports) Get(ctx context.Context, projectID uuid.UUID) (*console.ProjectExport, error) {
+	row := exports.db.QueryRowContext(ctx, exports.db.Rebind(`SELECT `+projectExportColumns+`
+		FROM project_exports WHERE project_id = ?`), projectID[:])
+
+	export := &dbx.ProjectExport{}
+	err := row.Scan(&export.ProjectId, &export.RequestedBy, &export.ExportedObjects, &export.ExportedBytes,
+		&export.LastPath, &export.Manifest, &export.RequestedAt, &export.FinishedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return projectExportFromDBX(export)
+}
+
+// GetUnfinished is a method for querying exports, which still have objects to export, oldest first
+func (exports *projectExports) GetUnfinished(ctx context.Context) (unfinished []console.ProjectExport, err error) {
+	rows, err := exports.db.QueryContext(ctx, exports.db.Rebind(`SELECT `+projectExportColumns+`
+		FROM project_exports WHERE finished_at IS NULL ORDER BY requested_at`))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		export := &dbx.ProjectExport{}
+		err := rows.Scan(&export.ProjectId, &export.RequestedBy, &export.ExportedObjects, &export.ExportedBytes,
+			&export.LastPath, &export.Manifest, &export.RequestedAt, &export.FinishedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		converted, err := projectExportFromDBX(export)
+		if err != nil {
+			return nil, err
+		}
+		unfinished = append(unfinished, *converted)
+	}
+
+	return unfinished, rows.Err()
+}
+
+// Update is a method for updating the progress and the manifest of an export
+func (exports *projectExports) Update(ctx context.Context, export *console.ProjectExport) error {
+	manifest, err := json.Marshal(export.Manifest)
+	if err != nil {
+		return err
+	}
+
+	var finished *time.Time
+	if export.Finished() {
+		utc := export.FinishedAt.UTC()
+		finished = &utc
+	}
+
+	_, err = exports.db.ExecContext(ctx, exports.db.Rebind(`UPDATE project_exports
+		SET exported_objects = ?, exported_bytes = ?, last_path = ?, manifest = ?, finished_at = ?
+		WHERE project_id = ?`),
+		export.ExportedObjects, export.ExportedBytes, export.LastPath, manifest, finished, export.ProjectID[:])
+	return err
+}
+
+// projectExportFromDBX is used for creating ProjectExport entity from autogenerated dbx.ProjectExport struct
+func projectExportFromDBX(export *dbx.ProjectExport) (*console.ProjectExport, error) {
+	if export == nil {
+		return nil, errs.New("project export parameter is nil")
+	}
+
+	projectID, err := bytesToUUID(export.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	requestedBy, err := bytesToUUID(export.RequestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &console.ProjectExport{
+		ProjectID:       projectID,
+		RequestedBy:     requestedBy,
+		ExportedObjects: export.ExportedObjects,
+		ExportedBytes:   export.ExportedBytes,
+		LastPath:        export.LastPath,
+		RequestedAt:     export.RequestedAt,
+	}
+	if len(export.Manifest) > 0 {
+		if err := json.Unmarshal(export.Manifest, &result.Manifest); err != nil {
+			return nil, err
+		}
+	}
+	if export.FinishedAt != nil {
+		result.FinishedAt = *export.FinishedAt
+	}
+
+	return result, nil
+}
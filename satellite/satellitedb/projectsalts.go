@@ -0,0 +1,42 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// projectSalts is an implementation of pointerdb.ProjectSalts
+type projectSalts struct {
+	db *dbx.DB
+}
+
+// GetOrCreate returns the salt of a project, the given salt is stored
+// when the project has none yet
+func (salts *projectSalts) GetOrCreate(ctx context.Context, projectID uuid.UUID, salt []byte) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// concurrent requests of a new project store a single salt
+	_, err = salts.db.ExecContext(ctx, salts.db.Rebind(`INSERT INTO project_salts
+		( project_id, salt, created_at )
+		VALUES ( ?, ?, ? )
+		ON CONFLICT ( project_id ) DO NOTHING`),
+		projectID[:], salt, time.Now().UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	stored := &dbx.ProjectSalt{}
+	err = salts.db.QueryRowContext(ctx, salts.db.Rebind(`SELECT salt
+		FROM project_salts WHERE project_id = ?`), projectID[:]).Scan(&stored.Salt)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return stored.Salt, nil
+}
@@ -5,9 +5,12 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 
+	"storj.io/storj/pkg/datarepair/queue"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/utils"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
@@ -27,6 +30,7 @@ func (r *repairQueue) Enqueue(ctx context.Context, seg *pb.InjuredSegment) error
 	_, err = r.db.Create_Injuredsegment(
 		ctx,
 		dbx.Injuredsegment_Info(val),
+		dbx.Injuredsegment_LeasedUntil(time.Time{}),
 	)
 	return err
 }
@@ -84,3 +88,89 @@ func (r *repairQueue) Peekqueue(ctx context.Context, limit int) ([]pb.InjuredSeg
 	}
 	return segments, nil
 }
+
+// Claim leases the oldest segment whose lease has expired. Leases are taken
+// with a compare-and-swap on leased_until, so concurrent workers never end up
+// holding the same segment.
+func (r *repairQueue) Claim(ctx context.Context, ttl time.Duration) (*queue.Lease, error) {
+	for {
+		now := time.Now().UTC()
+
+		var id int64
+		var info []byte
+		var leasedUntil time.Time
+		err := r.db.QueryRow(r.db.Rebind(
+			`SELECT id, info, leased_until FROM injuredsegments
+			WHERE leased_until <= ?
+			ORDER BY id LIMIT 1`), now).Scan(&id, &info, &leasedUntil)
+		if err == sql.ErrNoRows {
+			return nil, Error.Wrap(storage.ErrEmptyQueue.New(""))
+		}
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		expires := now.Add(ttl)
+		claimed, err := r.swapLease(id, leasedUntil, expires)
+		if err != nil {
+			return nil, err
+		}
+		if !claimed {
+			// another worker claimed it first
+			continue
+		}
+
+		seg := pb.InjuredSegment{}
+		if err = proto.Unmarshal(info, &seg); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return &queue.Lease{ID: id, Segment: seg, Expires: expires}, nil
+	}
+}
+
+// Extend keeps a lease alive for another ttl.
+func (r *repairQueue) Extend(ctx context.Context, lease *queue.Lease, ttl time.Duration) error {
+	expires := time.Now().UTC().Add(ttl)
+	extended, err := r.swapLease(lease.ID, lease.Expires, expires)
+	if err != nil {
+		return err
+	}
+	if !extended {
+		return queue.ErrLeaseLost.New("%d", lease.ID)
+	}
+	lease.Expires = expires
+	return nil
+}
+
+// Complete removes a leased segment after it has been repaired.
+func (r *repairQueue) Complete(ctx context.Context, lease *queue.Lease) error {
+	res, err := r.db.Exec(r.db.Rebind(
+		`DELETE FROM injuredsegments WHERE id = ? AND leased_until = ?`),
+		lease.ID, lease.Expires.UTC())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return queue.ErrLeaseLost.New("%d", lease.ID)
+	}
+	return nil
+}
+
+// swapLease updates leased_until of segment id, when it still matches current
+func (r *repairQueue) swapLease(id int64, current, next time.Time) (bool, error) {
+	res, err := r.db.Exec(r.db.Rebind(
+		`UPDATE injuredsegments SET leased_until = ? WHERE id = ? AND leased_until = ?`),
+		next.UTC(), id, current.UTC())
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return affected == 1, nil
+}
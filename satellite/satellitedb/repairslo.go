@@ -0,0 +1,61 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/datarepair/slo"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// repairSLO is an implementation of slo.DB
+type repairSLO struct {
+	db *dbx.DB
+}
+
+// Increment adds the counts to the day starting at intervalStart
+func (db *repairSLO) Increment(ctx context.Context, intervalStart time.Time, counts slo.Counts) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, db.db.Rebind(`INSERT INTO repair_slo_days
+		( interval_start, injured, irreparable, repaired, failed )
+		VALUES ( ?, ?, ?, ?, ? )
+		ON CONFLICT ( interval_start ) DO UPDATE SET
+			injured = repair_slo_days.injured + excluded.injured,
+			irreparable = repair_slo_days.irreparable + excluded.irreparable,
+			repaired = repair_slo_days.repaired + excluded.repaired,
+			failed = repair_slo_days.failed + excluded.failed`),
+		intervalStart.UTC(), counts.Injured, counts.Irreparable, counts.Repaired, counts.Failed)
+	return Error.Wrap(err)
+}
+
+// List returns the counts of the days since the day starting at since, the oldest first
+func (db *repairSLO) List(ctx context.Context, since time.Time) (_ []*slo.Day, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, db.db.Rebind(`SELECT
+		interval_start, injured, irreparable, repaired, failed
+		FROM repair_slo_days WHERE interval_start >= ?
+		ORDER BY interval_start`), since.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var days []*slo.Day
+	for rows.Next() {
+		day := &slo.Day{}
+		err := rows.Scan(&day.IntervalStart, &day.Injured, &day.Irreparable, &day.Repaired, &day.Failed)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		day.IntervalStart = day.IntervalStart.UTC()
+		days = append(days, day)
+	}
+	return days, Error.Wrap(rows.Err())
+}
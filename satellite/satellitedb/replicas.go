@@ -0,0 +1,144 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/utils"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ReplicaConfig configures the read replicas used for heavy read-only queries
+type ReplicaConfig struct {
+	URLs          string        `help:"comma separated connection strings of read replicas for reports, totals and listings" default:""`
+	MaxLag        time.Duration `help:"maximum replication lag of a replica before queries fall back to the primary" default:"30s"`
+	CheckInterval time.Duration `help:"how often the replication lag of a replica is checked" default:"10s"`
+}
+
+// replicaSet routes read-only queries to replicas which are not lagging
+// behind the primary, or to the primary when no replica can be used.
+type replicaSet struct {
+	primary  *dbx.DB
+	driver   string
+	replicas []*replica
+	config   ReplicaConfig
+	next     uint32
+
+	// lag returns how far a replica is behind the primary
+	lag func(ctx context.Context, replica *replica) (time.Duration, error)
+}
+
+// replica is a read replica with its last known health
+type replica struct {
+	db *dbx.DB
+
+	mu      sync.Mutex
+	checked time.Time
+	healthy bool
+}
+
+// openReplicas opens all replicas listed in config
+func openReplicas(primary *dbx.DB, driver string, config ReplicaConfig) (_ *replicaSet, err error) {
+	set := &replicaSet{primary: primary, driver: driver, config: config}
+	set.lag = func(ctx context.Context, replica *replica) (time.Duration, error) {
+		return replica.lag(ctx, driver)
+	}
+	defer func() {
+		if err != nil {
+			err = errs.Combine(err, set.Close())
+		}
+	}()
+
+	for _, url := range strings.Split(config.URLs, ",") {
+		url = strings.TrimSpace(url)
+		if url == "" {
+			continue
+		}
+
+		replicaDriver, source, err := utils.SplitDBURL(url)
+		if err != nil {
+			return nil, err
+		}
+		if replicaDriver != driver {
+			return nil, Error.New("replica driver %q doesn't match primary driver %q", replicaDriver, driver)
+		}
+
+		db, err := dbx.Open(replicaDriver, source)
+		if err != nil {
+			return nil, Error.New("failed opening replica %q, %q: %v", replicaDriver, source, err)
+		}
+		set.replicas = append(set.replicas, &replica{db: db})
+	}
+	return set, nil
+}
+
+// Read returns the database to use for a heavy read-only query
+func (set *replicaSet) Read(ctx context.Context) *dbx.DB {
+	if len(set.replicas) == 0 {
+		return set.primary
+	}
+
+	start := atomic.AddUint32(&set.next, 1)
+	for i := range set.replicas {
+		replica := set.replicas[(int(start)+i)%len(set.replicas)]
+		if replica.usable(ctx, set.lag, set.config) {
+			return replica.db
+		}
+	}
+
+	mon.Meter("replica_fallback").Mark(1)
+	return set.primary
+}
+
+// Close closes all replicas
+func (set *replicaSet) Close() error {
+	var group errs.Group
+	for _, replica := range set.replicas {
+		group.Add(replica.db.Close())
+	}
+	return group.Err()
+}
+
+// usable returns whether the replica is reachable and not lagging,
+// checking it at most once per check interval
+func (replica *replica) usable(ctx context.Context, check func(context.Context, *replica) (time.Duration, error), config ReplicaConfig) bool {
+	replica.mu.Lock()
+	defer replica.mu.Unlock()
+
+	if time.Since(replica.checked) < config.CheckInterval {
+		return replica.healthy
+	}
+
+	lag, err := check(ctx, replica)
+	replica.checked = time.Now()
+	replica.healthy = err == nil && lag <= config.MaxLag
+	return replica.healthy
+}
+
+// lag returns how far the replica is behind the primary
+func (replica *replica) lag(ctx context.Context, driver string) (time.Duration, error) {
+	if driver != "postgres" {
+		// other databases don't report replication lag, only check they're reachable
+		return 0, replica.db.DB.PingContext(ctx)
+	}
+
+	// an idle replica which has replayed everything it received isn't lagging,
+	// even though its last replayed transaction may be old
+	var seconds float64
+	err := replica.db.DB.QueryRowContext(ctx, `SELECT CASE
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+		END`).Scan(&seconds)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/internal/testcontext"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+func TestReplicaSet_Lagging(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	primary, err := dbx.Open("sqlite3", "file::memory:?mode=memory")
+	require.NoError(t, err)
+	defer ctx.Check(primary.Close)
+
+	set, err := openReplicas(primary, "sqlite3", ReplicaConfig{
+		URLs:   "sqlite3://file::memory:?mode=memory, sqlite3://file::memory:?mode=memory",
+		MaxLag: time.Minute,
+	})
+	require.NoError(t, err)
+	defer ctx.Check(set.Close)
+	require.Len(t, set.replicas, 2)
+
+	first, second := set.replicas[0], set.replicas[1]
+	lags := map[*replica]time.Duration{}
+	set.lag = func(ctx context.Context, replica *replica) (time.Duration, error) {
+		return lags[replica], nil
+	}
+
+	// queries rotate between the replicas which aren't lagging
+	used := map[*dbx.DB]bool{}
+	for i := 0; i < 4; i++ {
+		used[set.Read(ctx)] = true
+	}
+	assert.Equal(t, map[*dbx.DB]bool{first.db: true, second.db: true}, used)
+
+	// a replica lagging more than the maximum lag is skipped
+	lags[first] = 2 * time.Minute
+	for i := 0; i < 4; i++ {
+		assert.Equal(t, second.db, set.Read(ctx))
+	}
+
+	// and the primary is used when all of them lag
+	lags[second] = time.Hour
+	assert.Equal(t, primary, set.Read(ctx))
+
+	// replicas which caught up are used again
+	lags[first] = time.Second
+	assert.Equal(t, first.db, set.Read(ctx))
+}
+
+func TestReplicaSet_CheckInterval(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	primary, err := dbx.Open("sqlite3", "file::memory:?mode=memory")
+	require.NoError(t, err)
+	defer ctx.Check(primary.Close)
+
+	set, err := openReplicas(primary, "sqlite3", ReplicaConfig{
+		URLs:          "sqlite3://file::memory:?mode=memory",
+		MaxLag:        time.Minute,
+		CheckInterval: time.Hour,
+	})
+	require.NoError(t, err)
+	defer ctx.Check(set.Close)
+
+	checks := 0
+	lag := time.Hour
+	set.lag = func(ctx context.Context, replica *replica) (time.Duration, error) {
+		checks++
+		return lag, nil
+	}
+
+	// the lag is only checked once per check interval
+	assert.Equal(t, primary, set.Read(ctx))
+	lag = 0
+	assert.Equal(t, primary, set.Read(ctx))
+	assert.Equal(t, 1, checks)
+}
+
+func TestReplicaSet_AllDown(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	primary, err := dbx.Open("sqlite3", "file::memory:?mode=memory")
+	require.NoError(t, err)
+
+	dir := ctx.Dir("replicas")
+	set, err := openReplicas(primary, "sqlite3", ReplicaConfig{
+		URLs: "sqlite3://" + filepath.Join(dir, "first.db") + "," +
+			"sqlite3://" + filepath.Join(dir, "second.db"),
+		MaxLag: time.Minute,
+	})
+	require.NoError(t, err)
+	require.Len(t, set.replicas, 2)
+
+	// replicas which can't be reached aren't used
+	for _, replica := range set.replicas {
+		require.NoError(t, replica.db.Close())
+	}
+	assert.Equal(t, primary, set.Read(ctx))
+
+	db := &DB{db: primary, driver: "sqlite3", replicas: set}
+	defer ctx.Check(db.Close)
+	require.NoError(t, db.CreateTables())
+
+	// and heavy reads fall back to the primary
+	_, _, err = db.OverlayCache().Paginate(ctx, 0, 10)
+	require.NoError(t, err)
+
+	stats, err := db.BandwidthAgreement().GetUplinkStats(ctx, time.Now().Add(-time.Hour), time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, stats)
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/sampling"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// samples is an implementation of sampling.DB
+type samples struct {
+	db *dbx.DB
+}
+
+// Save stores a snapshot
+func (samples *samples) Save(ctx context.Context, snapshot *sampling.Snapshot) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = samples.db.ExecContext(ctx, samples.db.Rebind(`INSERT INTO sample_snapshots
+		( created_at, data ) VALUES ( ?, ? )`), snapshot.CreatedAt.UTC(), data)
+	return Error.Wrap(err)
+}
+
+// List returns up to limit of the latest snapshots, the newest first
+func (samples *samples) List(ctx context.Context, limit int) (_ []*sampling.Snapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := samples.db.QueryContext(ctx, samples.db.Rebind(`SELECT data
+		FROM sample_snapshots ORDER BY created_at DESC LIMIT ?`), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var snapshots []*sampling.Snapshot
+	for rows.Next() {
+		row := &dbx.SampleSnapshot{}
+		if err := rows.Scan(&row.Data); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		snapshot := &sampling.Snapshot{}
+		if err := json.Unmarshal(row.Data, snapshot); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, Error.Wrap(rows.Err())
+}
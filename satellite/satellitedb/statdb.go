@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -37,6 +38,21 @@ func getNodeStats(nodeID storj.NodeID, dbNode *dbx.Node) *statdb.NodeStats {
 		UptimeRatio:        dbNode.UptimeRatio,
 		UptimeSuccessCount: dbNode.UptimeSuccessCount,
 		UptimeCount:        dbNode.TotalUptimeCount,
+
+		AuditReputationAlpha:  dbNode.AuditReputationAlpha,
+		AuditReputationBeta:   dbNode.AuditReputationBeta,
+		UptimeReputationAlpha: dbNode.UptimeReputationAlpha,
+		UptimeReputationBeta:  dbNode.UptimeReputationBeta,
+
+		LastContactSuccess: dbNode.LastContactSuccess,
+		LastContactFailure: dbNode.LastContactFailure,
+		Vetted:             !dbNode.VettedAt.IsZero(),
+		VettedAt:           dbNode.VettedAt,
+		CreatedAt:          dbNode.CreatedAt,
+
+		Disqualified:           !dbNode.DisqualifiedAt.IsZero(),
+		DisqualifiedAt:         dbNode.DisqualifiedAt,
+		DisqualificationReason: dbNode.DisqualificationReason,
 	}
 	return nodeStats
 }
@@ -52,6 +68,9 @@ func (s *statDB) Create(ctx context.Context, nodeID storj.NodeID, startingStats
 		totalUptimeCount   int64
 		uptimeSuccessCount int64
 		uptimeRatio        float64
+		lastContactSuccess time.Time
+		lastContactFailure time.Time
+		vettedAt           time.Time
 	)
 
 	if startingStats != nil {
@@ -68,6 +87,10 @@ func (s *statDB) Create(ctx context.Context, nodeID storj.NodeID, startingStats
 		if err != nil {
 			return nil, errUptime.Wrap(err)
 		}
+
+		lastContactSuccess = startingStats.LastContactSuccess.UTC()
+		lastContactFailure = startingStats.LastContactFailure.UTC()
+		vettedAt = startingStats.VettedAt.UTC()
 	}
 
 	dbNode, err := s.db.Create_Node(
@@ -76,9 +99,19 @@ func (s *statDB) Create(ctx context.Context, nodeID storj.NodeID, startingStats
 		dbx.Node_AuditSuccessCount(auditSuccessCount),
 		dbx.Node_TotalAuditCount(totalAuditCount),
 		dbx.Node_AuditSuccessRatio(auditSuccessRatio),
+		// the reputation of the starting stats weighs all results equally
+		dbx.Node_AuditReputationAlpha(float64(auditSuccessCount)),
+		dbx.Node_AuditReputationBeta(float64(totalAuditCount-auditSuccessCount)),
 		dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
 		dbx.Node_TotalUptimeCount(totalUptimeCount),
 		dbx.Node_UptimeRatio(uptimeRatio),
+		dbx.Node_UptimeReputationAlpha(float64(uptimeSuccessCount)),
+		dbx.Node_UptimeReputationBeta(float64(totalUptimeCount-uptimeSuccessCount)),
+		dbx.Node_LastContactSuccess(lastContactSuccess),
+		dbx.Node_LastContactFailure(lastContactFailure),
+		dbx.Node_VettedAt(vettedAt),
+		dbx.Node_DisqualifiedAt(time.Time{}),
+		dbx.Node_DisqualificationReason(""),
 	)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -93,6 +126,9 @@ func (s *statDB) Get(ctx context.Context, nodeID storj.NodeID) (stats *statdb.No
 	defer mon.Task()(&ctx)(&err)
 
 	dbNode, err := s.db.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
+	if err == sql.ErrNoRows {
+		return nil, statdb.ErrNodeNotFound.New("%s", nodeID)
+	}
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
@@ -172,39 +208,7 @@ func (s *statDB) Update(ctx context.Context, updateReq *statdb.UpdateRequest) (s
 		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
 	}
 
-	auditSuccessCount := dbNode.AuditSuccessCount
-	totalAuditCount := dbNode.TotalAuditCount
-	var auditSuccessRatio float64
-	uptimeSuccessCount := dbNode.UptimeSuccessCount
-	totalUptimeCount := dbNode.TotalUptimeCount
-	var uptimeRatio float64
-
-	auditSuccessCount, totalAuditCount, auditSuccessRatio = updateRatioVars(
-		updateReq.AuditSuccess,
-		auditSuccessCount,
-		totalAuditCount,
-	)
-
-	uptimeSuccessCount, totalUptimeCount, uptimeRatio = updateRatioVars(
-		updateReq.IsUp,
-		uptimeSuccessCount,
-		totalUptimeCount,
-	)
-
-	updateFields := dbx.Node_Update_Fields{
-		AuditSuccessCount:  dbx.Node_AuditSuccessCount(auditSuccessCount),
-		TotalAuditCount:    dbx.Node_TotalAuditCount(totalAuditCount),
-		AuditSuccessRatio:  dbx.Node_AuditSuccessRatio(auditSuccessRatio),
-		UptimeSuccessCount: dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
-		TotalUptimeCount:   dbx.Node_TotalUptimeCount(totalUptimeCount),
-		UptimeRatio:        dbx.Node_UptimeRatio(uptimeRatio),
-	}
-
-	updateFields.UptimeSuccessCount = dbx.Node_UptimeSuccessCount(uptimeSuccessCount)
-	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
-	updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
-
-	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateRequestFields(updateReq, dbNode))
 	if err != nil {
 		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
 	}
@@ -213,105 +217,119 @@ func (s *statDB) Update(ctx context.Context, updateReq *statdb.UpdateRequest) (s
 	return nodeStats, Error.Wrap(tx.Commit())
 }
 
-// UpdateUptime updates a single storagenode's uptime stats in the db
-func (s *statDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *statdb.NodeStats, err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	tx, err := s.db.Open(ctx)
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-	dbNode, err := tx.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
-	if err != nil {
-		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
-	}
-
-	uptimeSuccessCount := dbNode.UptimeSuccessCount
-	totalUptimeCount := dbNode.TotalUptimeCount
-	var uptimeRatio float64
-
+// updateRequestFields returns the fields updating the stats of the node with
+// the outcomes of the request, the ratios are the ratios of the reputations
+func updateRequestFields(updateReq *statdb.UpdateRequest, dbNode *dbx.Node) dbx.Node_Update_Fields {
 	updateFields := dbx.Node_Update_Fields{}
 
-	uptimeSuccessCount, totalUptimeCount, uptimeRatio = updateRatioVars(
-		isUp,
-		uptimeSuccessCount,
-		totalUptimeCount,
-	)
-
-	updateFields.UptimeSuccessCount = dbx.Node_UptimeSuccessCount(uptimeSuccessCount)
-	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
-	updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
+	if !updateReq.UptimeOnly {
+		auditSuccessCount, totalAuditCount := updateCounts(
+			updateReq.AuditSuccess,
+			dbNode.AuditSuccessCount,
+			dbNode.TotalAuditCount,
+		)
+		alpha, beta := statdb.UpdateReputation(
+			dbNode.AuditReputationAlpha,
+			dbNode.AuditReputationBeta,
+			updateReq.AuditLambda,
+			updateReq.AuditSuccess,
+		)
+		updateFields.AuditSuccessCount = dbx.Node_AuditSuccessCount(auditSuccessCount)
+		updateFields.TotalAuditCount = dbx.Node_TotalAuditCount(totalAuditCount)
+		updateFields.AuditReputationAlpha = dbx.Node_AuditReputationAlpha(alpha)
+		updateFields.AuditReputationBeta = dbx.Node_AuditReputationBeta(beta)
+		updateFields.AuditSuccessRatio = dbx.Node_AuditSuccessRatio(statdb.ReputationRatio(alpha, beta))
+	}
 
-	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
-	if err != nil {
-		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+	if !updateReq.AuditOnly {
+		uptimeSuccessCount, totalUptimeCount := updateCounts(
+			updateReq.IsUp,
+			dbNode.UptimeSuccessCount,
+			dbNode.TotalUptimeCount,
+		)
+		alpha, beta := statdb.UpdateReputation(
+			dbNode.UptimeReputationAlpha,
+			dbNode.UptimeReputationBeta,
+			updateReq.UptimeLambda,
+			updateReq.IsUp,
+		)
+		updateFields.UptimeSuccessCount = dbx.Node_UptimeSuccessCount(uptimeSuccessCount)
+		updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
+		updateFields.UptimeReputationAlpha = dbx.Node_UptimeReputationAlpha(alpha)
+		updateFields.UptimeReputationBeta = dbx.Node_UptimeReputationBeta(beta)
+		updateFields.UptimeRatio = dbx.Node_UptimeRatio(statdb.ReputationRatio(alpha, beta))
+		setLastContact(&updateFields, updateReq.IsUp)
 	}
 
-	nodeStats := getNodeStats(nodeID, dbNode)
-	return nodeStats, Error.Wrap(tx.Commit())
+	return updateFields
 }
 
-// UpdateAuditSuccess updates a single storagenode's uptime stats in the db
-func (s *statDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool) (stats *statdb.NodeStats, err error) {
+// UpdateUptime updates a single storagenode's uptime stats in the db, all
+// uptime checks are weighed equally
+func (s *statDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *statdb.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	tx, err := s.db.Open(ctx)
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-	dbNode, err := tx.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
-	if err != nil {
-		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
-	}
-
-	auditSuccessCount := dbNode.AuditSuccessCount
-	totalAuditCount := dbNode.TotalAuditCount
-	var auditRatio float64
-
-	updateFields := dbx.Node_Update_Fields{}
-
-	auditSuccessCount, totalAuditCount, auditRatio = updateRatioVars(
-		auditSuccess,
-		auditSuccessCount,
-		totalAuditCount,
-	)
-
-	updateFields.AuditSuccessCount = dbx.Node_AuditSuccessCount(auditSuccessCount)
-	updateFields.TotalAuditCount = dbx.Node_TotalAuditCount(totalAuditCount)
-	updateFields.AuditSuccessRatio = dbx.Node_AuditSuccessRatio(auditRatio)
+	return s.Update(ctx, &statdb.UpdateRequest{
+		NodeID:     nodeID,
+		IsUp:       isUp,
+		UptimeOnly: true,
+	})
+}
 
-	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
-	if err != nil {
-		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
-	}
+// UpdateAuditSuccess updates a single storagenode's audit stats in the db,
+// all audits are weighed equally
+func (s *statDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	nodeStats := getNodeStats(nodeID, dbNode)
-	return nodeStats, Error.Wrap(tx.Commit())
+	return s.Update(ctx, &statdb.UpdateRequest{
+		NodeID:       nodeID,
+		AuditSuccess: auditSuccess,
+		AuditOnly:    true,
+	})
 }
 
-// UpdateBatch for updating multiple storage nodes' stats in the db
+// UpdateBatch updates multiple storage nodes' stats in a single transaction,
+// the requests of unknown nodes are returned as failed without affecting the
+// other requests. When the transaction fails, all requests are returned as failed.
 func (s *statDB) UpdateBatch(ctx context.Context, updateReqList []*statdb.UpdateRequest) (
 	statsList []*statdb.NodeStats, failedUpdateReqs []*statdb.UpdateRequest, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var nodeStatsList []*statdb.NodeStats
+	if len(updateReqList) == 0 {
+		return nil, nil, nil
+	}
+
+	tx, err := s.db.Open(ctx)
+	if err != nil {
+		return nil, updateReqList, Error.Wrap(err)
+	}
+
 	var allErrors []error
-	failedUpdateReqs = []*statdb.UpdateRequest{}
 	for _, updateReq := range updateReqList {
+		nodeID := updateReq.NodeID
 
-		nodeStats, err := s.Update(ctx, updateReq)
+		dbNode, err := tx.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
 		if err != nil {
 			allErrors = append(allErrors, err)
 			failedUpdateReqs = append(failedUpdateReqs, updateReq)
-		} else {
-			nodeStatsList = append(nodeStatsList, nodeStats)
+			continue
+		}
+
+		dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateRequestFields(updateReq, dbNode))
+		if err != nil {
+			return nil, updateReqList, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
 		}
+		statsList = append(statsList, getNodeStats(nodeID, dbNode))
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, updateReqList, Error.Wrap(err)
 	}
 
 	if len(allErrors) > 0 {
-		return nodeStatsList, failedUpdateReqs, Error.Wrap(utils.CombineErrors(allErrors...))
+		return statsList, failedUpdateReqs, Error.Wrap(utils.CombineErrors(allErrors...))
 	}
-	return nodeStatsList, nil, nil
+	return statsList, nil, nil
 }
 
 // CreateEntryIfNotExists creates a statdb node entry and saves to statdb if it didn't already exist
@@ -319,8 +337,7 @@ func (s *statDB) CreateEntryIfNotExists(ctx context.Context, nodeID storj.NodeID
 	defer mon.Task()(&ctx)(&err)
 
 	getStats, err := s.Get(ctx, nodeID)
-	// TODO: figure out better way to confirm error is type dbx.ErrorCode_NoRows
-	if err != nil && strings.Contains(err.Error(), "no rows in result set") {
+	if statdb.ErrNodeNotFound.Has(err) {
 		createStats, err := s.Create(ctx, nodeID, nil)
 		if err != nil {
 			return nil, err
@@ -333,13 +350,113 @@ func (s *statDB) CreateEntryIfNotExists(ctx context.Context, nodeID storj.NodeID
 	return getStats, nil
 }
 
-func updateRatioVars(newStatus bool, successCount, totalCount int64) (int64, int64, float64) {
+// Vet marks the node as vetted when its stats meet the criteria, vetted nodes stay vetted
+func (s *statDB) Vet(ctx context.Context, nodeID storj.NodeID, criteria *statdb.VettingCriteria) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the criteria are checked by the update itself, so concurrent updates
+	// of the stats can't vet a node, which doesn't meet them anymore
+	now := time.Now().UTC()
+	_, err = s.db.ExecContext(ctx, s.db.Rebind(`UPDATE nodes SET vetted_at = ?, updated_at = ?
+		WHERE id = ? AND vetted_at <= ?
+		  AND total_audit_count >= ? AND audit_success_ratio >= ?
+		  AND total_uptime_count >= ? AND uptime_ratio >= ?`),
+		now, now, nodeID.Bytes(), time.Time{},
+		criteria.AuditCount, criteria.AuditSuccessRatio, criteria.UptimeCount, criteria.UptimeRatio)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return s.Get(ctx, nodeID)
+}
+
+// Disqualify marks the node as disqualified, the time and reason of an earlier disqualification are kept
+func (s *statDB) Disqualify(ctx context.Context, nodeID storj.NodeID, reason string) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now().UTC()
+	_, err = s.db.ExecContext(ctx, s.db.Rebind(`UPDATE nodes
+		SET disqualified_at = ?, disqualification_reason = ?, updated_at = ?
+		WHERE id = ? AND disqualified_at <= ?`),
+		now, reason, now, nodeID.Bytes(), time.Time{})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return s.Get(ctx, nodeID)
+}
+
+// Reinstate lifts the disqualification of the node
+func (s *statDB) Reinstate(ctx context.Context, nodeID storj.NodeID) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbNode, err := s.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		DisqualifiedAt:         dbx.Node_DisqualifiedAt(time.Time{}),
+		DisqualificationReason: dbx.Node_DisqualificationReason(""),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if dbNode == nil {
+		return nil, Error.New("node %s not found", nodeID)
+	}
+
+	return getNodeStats(nodeID, dbNode), nil
+}
+
+// ListDisqualified returns up to limit disqualified nodes, most recently disqualified first
+func (s *statDB) ListDisqualified(ctx context.Context, limit int) (statsList []*statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := s.db.QueryContext(ctx, s.db.Rebind(`SELECT id FROM nodes
+		WHERE disqualified_at > ?
+		ORDER BY disqualified_at DESC
+		LIMIT ?`), time.Time{}, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var nodeIDs storj.NodeIDList
+	for rows.Next() {
+		var idBytes []byte
+		if err := rows.Scan(&idBytes); err != nil {
+			return nil, Error.Wrap(utils.CombineErrors(err, rows.Close()))
+		}
+		nodeID, err := storj.NodeIDFromBytes(idBytes)
+		if err != nil {
+			return nil, Error.Wrap(utils.CombineErrors(err, rows.Close()))
+		}
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	if err := utils.CombineErrors(rows.Err(), rows.Close()); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, nodeID := range nodeIDs {
+		stats, err := s.Get(ctx, nodeID)
+		if err != nil {
+			return nil, err
+		}
+		statsList = append(statsList, stats)
+	}
+	return statsList, nil
+}
+
+// setLastContact sets the time of the last successful or failed contact
+func setLastContact(updateFields *dbx.Node_Update_Fields, isUp bool) {
+	if isUp {
+		updateFields.LastContactSuccess = dbx.Node_LastContactSuccess(time.Now().UTC())
+	} else {
+		updateFields.LastContactFailure = dbx.Node_LastContactFailure(time.Now().UTC())
+	}
+}
+
+func updateCounts(newStatus bool, successCount, totalCount int64) (int64, int64) {
 	totalCount++
 	if newStatus {
 		successCount++
 	}
-	newRatio := float64(successCount) / float64(totalCount)
-	return successCount, totalCount, newRatio
+	return successCount, totalCount
 }
 
 func checkRatioVars(successCount, totalCount int64) (ratio float64, err error) {
@@ -6,12 +6,14 @@ package storagenode
 import (
 	"context"
 	"net"
+	"net/http"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 
+	"storj.io/storj/pkg/health"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
@@ -45,6 +47,7 @@ type Config struct {
 	Server   server.Config
 	Kademlia kademlia.Config
 	Storage  psserver.Config
+	Health   health.Config
 }
 
 // Verify verifies whether configuration is consistent and acceptable.
@@ -77,14 +80,24 @@ type Peer struct {
 	}
 
 	Storage struct {
-		Endpoint  *psserver.Server // TODO: separate into endpoint and service
-		Monitor   *psserver.Monitor
-		Collector *psserver.Collector
+		Endpoint         *psserver.Server // TODO: separate into endpoint and service
+		Monitor          *psserver.Monitor
+		Collector        *psserver.Collector
+		SatelliteCleaner *psserver.SatelliteCleaner
+		Usage            *psserver.UsageRecalculator
+		Payouts          *psserver.PayoutEstimator
 	}
 
 	Agreements struct {
 		Sender *agreementsender.AgreementSender
 	}
+
+	Health struct {
+		Service  *health.Service
+		Endpoint *health.Endpoint
+		Listener net.Listener
+		Server   *health.Server
+	}
 }
 
 // New creates a new Storage Node.
@@ -110,7 +123,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config) (*P
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Public.Server, err = server.New(publicOptions, peer.Public.Listener, nil)
+		peer.Public.Server, err = server.New(publicOptions, peer.Public.Listener, nil, nil)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -131,8 +144,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config) (*P
 				Address:   config.ExternalAddress,
 			},
 			Metadata: &pb.NodeMetadata{
-				Email:  config.Operator.Email,
-				Wallet: config.Operator.Wallet,
+				Email:          config.Operator.Email,
+				Wallet:         config.Operator.Wallet,
+				WalletFeatures: config.Operator.Features(),
 			},
 		}
 
@@ -168,7 +182,14 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config) (*P
 
 		// TODO: organize better
 		peer.Storage.Monitor = psserver.NewMonitor(peer.Log.Named("piecestore:monitor"), config.KBucketRefreshInterval, peer.Kademlia.RoutingTable, peer.Storage.Endpoint)
-		peer.Storage.Collector = psserver.NewCollector(peer.Log.Named("piecestore:collector"), peer.DB.PSDB(), peer.DB.Storage(), config.CollectorInterval)
+		peer.Storage.Collector = psserver.NewCollector(peer.Log.Named("piecestore:collector"), peer.DB.PSDB(), peer.DB.Storage(), config.CollectorInterval, config.PartialUploadExpiration, config.TrashRetention)
+		peer.Storage.SatelliteCleaner = psserver.NewSatelliteCleaner(peer.Log.Named("piecestore:satellitecleaner"), peer.Storage.Endpoint, config.SatelliteCleanupInterval, config.SatelliteCleanupGracePeriod)
+		peer.Storage.Usage = psserver.NewUsageRecalculator(peer.Log.Named("piecestore:usage"), peer.DB.PSDB(), config.UsageRecalculationInterval)
+
+		peer.Storage.Payouts = psserver.NewPayoutEstimator(peer.Log.Named("piecestore:payouts"), peer.DB.PSDB(), peer.Kademlia.Service, peer.Transport, config.PayoutPricingInterval)
+		if config.PayoutPricingInterval > 0 {
+			peer.Storage.Endpoint.Payouts = peer.Storage.Payouts
+		}
 	}
 
 	{ // agreements
@@ -180,6 +201,25 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config) (*P
 		)
 	}
 
+	{ // setup health
+		config := config.Health
+
+		peer.Health.Service = health.NewService(config)
+		peer.Health.Service.Add("database", peer.DB.PSDB().Ping)
+		peer.Health.Service.Add("disk", peer.DB.Storage().Check)
+
+		peer.Health.Endpoint = health.NewEndpoint(peer.Health.Service)
+		pb.RegisterHealthServer(peer.Public.Server.GRPC(), peer.Health.Endpoint)
+
+		if config.Address != "" {
+			peer.Health.Listener, err = net.Listen("tcp", config.Address)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Health.Server = health.NewServer(peer.Health.Service, peer.Health.Listener)
+		}
+	}
+
 	return peer, nil
 }
 
@@ -202,17 +242,31 @@ func (peer *Peer) Run(ctx context.Context) error {
 	group.Go(func() error {
 		return ignoreCancel(peer.Storage.Collector.Run(ctx))
 	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Storage.SatelliteCleaner.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Storage.Usage.Run(ctx))
+	})
+	group.Go(func() error {
+		return ignoreCancel(peer.Storage.Payouts.Run(ctx))
+	})
 	group.Go(func() error {
 		// TODO: move the message into Server instead
 		peer.Log.Sugar().Infof("Node %s started on %s", peer.Identity.ID, peer.Public.Server.Addr().String())
 		return ignoreCancel(peer.Public.Server.Run(ctx))
 	})
+	if peer.Health.Server != nil {
+		group.Go(func() error {
+			return ignoreCancel(peer.Health.Server.Run(ctx))
+		})
+	}
 
 	return group.Wait()
 }
 
 func ignoreCancel(err error) error {
-	if err == context.Canceled || err == grpc.ErrServerStopped {
+	if err == context.Canceled || err == grpc.ErrServerStopped || err == http.ErrServerClosed {
 		return nil
 	}
 	return err
@@ -234,6 +288,12 @@ func (peer *Peer) Close() error {
 		}
 	}
 
+	if peer.Health.Server != nil {
+		errlist.Add(peer.Health.Server.Close())
+	} else if peer.Health.Listener != nil {
+		errlist.Add(peer.Health.Listener.Close())
+	}
+
 	// close services in reverse initialization order
 	if peer.Storage.Endpoint != nil {
 		errlist.Add(peer.Storage.Endpoint.Close())